@@ -9,12 +9,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/BradHacker/compsole/compsole/allowlist"
+	"github.com/BradHacker/compsole/compsole/schedule"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/viewer"
 	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
 	"github.com/BradHacker/compsole/ent/token"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -31,6 +36,11 @@ type APIError struct {
 // to prevent collisions between different context uses
 var userCtxKey = &contextKey{"user"}
 var ipCtxKey = &contextKey{"ip"}
+var sessionCtxKey = &contextKey{"session"}
+var impersonatorCtxKey = &contextKey{"impersonator"}
+
+// sessionActivityInterval limits how often a session's last used time is written
+const sessionActivityInterval = time.Minute
 
 type contextKey struct {
 	name string
@@ -38,9 +48,8 @@ type contextKey struct {
 
 // CompsoleJWTClaims Create a struct that will be encoded to a JWT.
 type CompsoleJWTClaims struct {
-	ApiKey   string
-	IssuedAt int64
-	jwt.StandardClaims
+	ApiKey string `json:"ApiKey,omitempty"`
+	jwt.RegisteredClaims
 }
 
 type ServiceAccountHeader struct {
@@ -49,21 +58,23 @@ type ServiceAccountHeader struct {
 
 func UnauthenticatedMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		clientIpValues, exists := ctx.Request.Header["X-Forwarded-For"]
-		clientIp := ""
-		if exists {
-			clientIp = clientIpValues[0]
-		} else {
-			clientIp = ctx.RemoteIP()
-		}
-		// put it in context
-		c := context.WithValue(ctx, ipCtxKey, clientIp)
+		// Only trusted proxies can set the client IP with X-Forwarded-For (see TRUSTED_PROXIES)
+		c := context.WithValue(ctx.Request.Context(), ipCtxKey, ctx.ClientIP())
 		ctx.Request = ctx.Request.WithContext(c)
 
 		ctx.Next()
 	}
 }
 
+// AnonymousMiddleware lets endpoints which don't require an account, like signing in and console share links, bypass
+// the privacy policies. There is no viewer for the policies to filter by, so these endpoints check access themselves.
+func AnonymousMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request = ctx.Request.WithContext(viewer.SystemContext(ctx.Request.Context()))
+		ctx.Next()
+	}
+}
+
 // Middleware decodes the share session cookie and packs the session into context
 func Middleware(client *ent.Client) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -94,24 +105,11 @@ func Middleware(client *ent.Client) gin.HandlerFunc {
 		// Initialize a new instance of `Claims`
 		claims := &CompsoleJWTClaims{}
 
-		jwtKey, exists := os.LookupEnv("JWT_SECRET")
-		if !exists {
-			if secure_cookie {
-				ctx.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
-			} else {
-				ctx.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
-			}
-			ctx.AbortWithStatus(http.StatusUnauthorized)
-			return
-		}
-
 		// Parse the JWT string and store the result in `claims`.
-		// Note that we are passing the key in this method as well. This method will return an error
+		// The signing key is picked from the `kid` header. This method will return an error
 		// if the token is invalid (if it has expired according to the expiry time we set on sign in),
 		// or if the signature does not match
-		tkn, err := jwt.ParseWithClaims(tknStr, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtKey), nil
-		})
+		tkn, err := signing.ParseWithClaims(tknStr, claims)
 
 		if err != nil {
 			if secure_cookie {
@@ -119,7 +117,7 @@ func Middleware(client *ent.Client) gin.HandlerFunc {
 			} else {
 				ctx.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
 			}
-			if err == jwt.ErrSignatureInvalid {
+			if errors.Is(err, jwt.ErrTokenSignatureInvalid) {
 				ctx.AbortWithStatus(http.StatusUnauthorized)
 				return
 			}
@@ -147,7 +145,8 @@ func Middleware(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		entUser, err := entToken.QueryTokenToUser().Only(ctx)
+		// The user isn't known yet, so the privacy policies can't be applied to looking them up
+		entUser, err := entToken.QueryTokenToUser().WithUserToCustomRole().Only(viewer.SystemContext(ctx))
 		if err != nil {
 			if secure_cookie {
 				ctx.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
@@ -157,24 +156,108 @@ func Middleware(client *ent.Client) gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err})
 			return
 		}
-		// put it in context
-		c := context.WithValue(ctx.Request.Context(), userCtxKey, entUser)
-
-		clientIpValues, exists := ctx.Request.Header["X-Forwarded-For"]
-		clientIp := ""
-		if exists {
-			clientIp = clientIpValues[0]
-		} else {
-			clientIp = ctx.RemoteIP()
+		// Admins can view Compsole as another user, in which case the request acts as that user
+		entImpersonatedUser, err := entToken.QueryTokenToImpersonatedUser().WithUserToCustomRole().Only(viewer.SystemContext(ctx))
+		if err != nil && !ent.IsNotFound(err) {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+		clientIp, err := ForContextIp(ctx)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
+		}
+		// Sessions can't be carried off of the team's network. Only the signed in user is checked, since admins can
+		// impersonate from anywhere.
+		allowed, err := allowlist.Check(viewer.SystemContext(ctx), client, entUser, clientIp, "use their session")
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+		if !allowed {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "you can't use compsole from this network"})
+			return
+		}
+		// Sessions issued before the competition closed stop working with it
+		canSignIn, err := schedule.CanSignIn(viewer.SystemContext(ctx), entUser)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+		if !canSignIn {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "your competition is not open right now"})
+			return
+		}
+		if entToken.LastUsedAt == nil || time.Since(*entToken.LastUsedAt) > sessionActivityInterval {
+			if updatedToken, err := entToken.Update().SetLastUsedAt(time.Now()).Save(ctx); err != nil {
+				logrus.Warnf("failed to update session last used time: %v", err)
+			} else {
+				entToken = updatedToken
+			}
 		}
 		// put it in context
-		c = context.WithValue(c, ipCtxKey, clientIp)
+		requestViewer := &viewer.Viewer{User: entUser}
+		c := context.WithValue(ctx.Request.Context(), sessionCtxKey, entToken)
+		if entImpersonatedUser != nil {
+			requestViewer = &viewer.Viewer{User: entImpersonatedUser, Impersonator: entUser}
+			c = context.WithValue(c, impersonatorCtxKey, entUser)
+		}
+		c = context.WithValue(c, userCtxKey, requestViewer.User)
+		c = viewer.NewContext(c, requestViewer)
 		ctx.Request = ctx.Request.WithContext(c)
 
 		ctx.Next()
 	}
 }
 
+// NotImpersonatingMiddleware rejects requests made while an admin is impersonating a user, for endpoints which would
+// act as the impersonated user. REQUIRES Middleware to have run.
+func NotImpersonatingMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if _, err := ForContextImpersonator(ctx.Request.Context()); err == nil {
+			ReturnError(ctx, http.StatusForbidden, "not allowed while impersonating a user", fmt.Errorf("stop impersonating first"))
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// UsableServiceAccount matches service accounts which are allowed to authenticate
+func UsableServiceAccount() predicate.ServiceAccount {
+	return serviceaccount.And(
+		serviceaccount.ActiveEQ(true),
+		serviceaccount.Or(
+			serviceaccount.ExpiresAtIsNil(),
+			serviceaccount.ExpiresAtGT(time.Now()),
+		),
+	)
+}
+
+// ServiceTokenUsable returns whether the session was started with a secret the service account still accepts. Sessions
+// started with the previous secret during a rotation's overlap end with the overlap, even when they are refreshed.
+func ServiceTokenUsable(entServiceToken *ent.ServiceToken, entServiceAccount *ent.ServiceAccount) bool {
+	if entServiceToken.SecretGeneration == entServiceAccount.SecretGeneration {
+		return true
+	}
+	return entServiceToken.SecretGeneration == entServiceAccount.SecretGeneration-1 &&
+		entServiceAccount.PreviousAPISecretExpiresAt != nil &&
+		time.Now().Before(*entServiceAccount.PreviousAPISecretExpiresAt)
+}
+
+// RecordServiceAccountUse updates when and where the service account was last used. Only writes once per
+// sessionActivityInterval unless the IP changes.
+func RecordServiceAccountUse(ctx context.Context, entServiceAccount *ent.ServiceAccount, clientIp string) {
+	if entServiceAccount.LastUsedAt != nil && time.Since(*entServiceAccount.LastUsedAt) < sessionActivityInterval && entServiceAccount.LastUsedIP == clientIp {
+		return
+	}
+	err := entServiceAccount.Update().
+		SetLastUsedAt(time.Now()).
+		SetLastUsedIP(clientIp).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to update service account last used time: %v", err)
+	}
+}
+
 func ServiceMiddleware(client *ent.Client) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		headers := &ServiceAccountHeader{}
@@ -184,13 +267,6 @@ func ServiceMiddleware(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		jwtKey, exists := os.LookupEnv("JWT_SECRET")
-		if !exists {
-			ctx.AbortWithStatus(http.StatusInternalServerError)
-			logrus.Errorf("failed to lookup env var JWT_SECRET")
-			return
-		}
-
 		authorizationParts := strings.Split(*headers.Authorization, "Bearer ")
 		if len(authorizationParts) < 2 {
 			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Must provide authorization token"})
@@ -198,9 +274,7 @@ func ServiceMiddleware(client *ent.Client) gin.HandlerFunc {
 		}
 		jwtToken := authorizationParts[1]
 
-		authToken, err := jwt.ParseWithClaims(jwtToken, &CompsoleJWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtKey), nil
-		})
+		authToken, err := signing.ParseWithClaims(jwtToken, &CompsoleJWTClaims{})
 		if err != nil || !authToken.Valid {
 			ctx.AbortWithStatus(http.StatusUnauthorized)
 			return
@@ -223,10 +297,8 @@ func ServiceMiddleware(client *ent.Client) gin.HandlerFunc {
 		entServiceToken, err := client.ServiceToken.Query().Where(
 			servicetoken.And(
 				servicetoken.HasTokenToServiceAccountWith(
-					serviceaccount.And(
-						serviceaccount.APIKeyEQ(apiKeyUUID),
-						serviceaccount.ActiveEQ(true),
-					),
+					serviceaccount.APIKeyEQ(apiKeyUUID),
+					UsableServiceAccount(),
 				),
 				servicetoken.TokenEQ(jwtToken),
 			),
@@ -239,31 +311,40 @@ func ServiceMiddleware(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		entServiceAccount, err := entServiceToken.TokenToServiceAccount(ctx)
+		// The competitions and teams are needed to enforce the service account's restrictions
+		entServiceAccount, err := entServiceToken.QueryTokenToServiceAccount().
+			WithServiceAccountToCompetitions().
+			WithServiceAccountToTeams().
+			Only(viewer.SystemContext(ctx))
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
 			return
 		}
+		if !ServiceTokenUsable(entServiceToken, entServiceAccount) {
+			if err := client.ServiceToken.DeleteOne(entServiceToken).Exec(ctx); err != nil {
+				logrus.Warnf("failed to delete service token from a rotated secret: %v", err)
+			}
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "the secret this session was started with has been rotated"})
+			return
+		}
 
 		// put it in context
 		c := context.WithValue(ctx.Request.Context(), userCtxKey, entServiceAccount)
 
-		clientIpValues, exists := ctx.Request.Header["X-Forwarded-For"]
-		clientIp := ""
-		if exists {
-			clientIp = clientIpValues[0]
-		} else {
-			clientIp = ctx.RemoteIP()
+		clientIp, err := ForContextIp(ctx)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
 		}
-		// put it in context
-		c = context.WithValue(c, ipCtxKey, clientIp)
+		RecordServiceAccountUse(ctx, entServiceAccount, clientIp)
+		c = viewer.NewContext(c, &viewer.Viewer{ServiceAccount: entServiceAccount})
 		ctx.Request = ctx.Request.WithContext(c)
 
 		ctx.Next()
 	}
 }
 
-// ForContext finds the user from the context. REQUIRES Middleware to have run.
+// ForContext finds the user from the context. While an admin is impersonating someone, this is the impersonated user.
+// REQUIRES Middleware to have run.
 func ForContext(ctx context.Context) (*ent.User, error) {
 	raw, ok := ctx.Value(userCtxKey).(*ent.User)
 	if ok {
@@ -272,6 +353,25 @@ func ForContext(ctx context.Context) (*ent.User, error) {
 	return nil, errors.New("unable to get user from context")
 }
 
+// ForContextSession finds the user's current session from the context. REQUIRES Middleware to have run.
+func ForContextSession(ctx context.Context) (*ent.Token, error) {
+	raw, ok := ctx.Value(sessionCtxKey).(*ent.Token)
+	if ok {
+		return raw, nil
+	}
+	return nil, errors.New("unable to get session from context")
+}
+
+// ForContextImpersonator finds the admin who is impersonating the user from the context. Returns an error if the
+// request isn't impersonating anyone. REQUIRES Middleware to have run.
+func ForContextImpersonator(ctx context.Context) (*ent.User, error) {
+	raw, ok := ctx.Value(impersonatorCtxKey).(*ent.User)
+	if ok {
+		return raw, nil
+	}
+	return nil, errors.New("not impersonating a user")
+}
+
 func ForContextIp(ctx *gin.Context) (string, error) {
 	if ip, ok := ctx.Request.Context().Value(ipCtxKey).(string); ok {
 		return ip, nil
@@ -279,11 +379,6 @@ func ForContextIp(ctx *gin.Context) (string, error) {
 	return "", fmt.Errorf("unable to get ip from context")
 }
 
-// ClearTokens Clears Old tokens from DB
-func ClearTokens(client *ent.Client, ctx context.Context) {
-	client.Token.Delete().Where(token.ExpireAtLT(time.Now().Unix())).Exec(ctx)
-}
-
 func ReturnError(ctx *gin.Context, code int, message string, err error) {
 	ctx.AbortWithStatusJSON(code, APIError{
 		Message: message,
@@ -1,6 +1,14 @@
 package auth
 
 import (
+	"fmt"
+	"net/http"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/signing"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/gin-gonic/gin"
 )
@@ -10,7 +18,109 @@ type APIError struct {
 	Error   error  `json:"error"`
 }
 
-func RegisterAuthEndpoints(client *ent.Client, r *gin.RouterGroup) {
-	r.POST("/local/login", LocalLogin(client))
-	r.GET("/logout", Logout(client))
+// LoginMethod model info
+//
+//	@Description	An external login method which is enabled on this server
+type LoginMethod struct {
+	Name     string `json:"name" example:"GitLab"`                      // The display name of the login method
+	LoginURL string `json:"login_url" example:"/api/auth/gitlab/login"` // The url to send the browser to in order to login
+	Password bool   `json:"password" example:"false"`                   // If true, the username and password should be POSTed to the login url instead
+	Passkey  bool   `json:"passkey" example:"false"`                    // If true, the login url starts a WebAuthn ceremony instead
+}
+
+func RegisterAuthEndpoints(client *ent.Client, limiter *ratelimit.Limiter, challenges *challenge.Store, sessionManager *sessions.Manager, r *gin.RouterGroup) error {
+	// Nobody is signed in yet on these endpoints
+	signIn := r.Group("", api.AnonymousMiddleware())
+	signIn.POST("/local/login", LocalLogin(client, limiter, challenges))
+	signIn.POST("/mfa/login", MFALogin(client, limiter, challenges))
+	signIn.GET("/logout", Logout(client))
+
+	loginMethods := []LoginMethod{}
+
+	gitlabConfig, err := LoadGitLabConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load gitlab config: %v", err)
+	}
+	if gitlabConfig != nil {
+		r.GET("/gitlab/login", GitLabLogin(gitlabConfig))
+		signIn.GET("/gitlab/callback", GitLabCallback(client, sessionManager, gitlabConfig))
+		loginMethods = append(loginMethods, LoginMethod{Name: "GitLab", LoginURL: "/api/auth/gitlab/login"})
+	}
+
+	oidcIssuers, err := LoadOIDCIssuers()
+	if err != nil {
+		return fmt.Errorf("failed to load oidc config: %v", err)
+	}
+	for _, issuer := range oidcIssuers {
+		r.GET(fmt.Sprintf("/oidc/%s/login", issuer.Name), OIDCLogin(issuer))
+		signIn.GET(fmt.Sprintf("/oidc/%s/callback", issuer.Name), OIDCCallback(client, sessionManager, issuer))
+		loginMethods = append(loginMethods, LoginMethod{Name: issuer.DisplayName, LoginURL: fmt.Sprintf("/api/auth/oidc/%s/login", issuer.Name)})
+	}
+
+	ldapConfig, err := LoadLDAPConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load ldap config: %v", err)
+	}
+	if ldapConfig != nil {
+		signIn.POST("/ldap/login", LDAPLogin(client, sessionManager, ldapConfig, limiter))
+		loginMethods = append(loginMethods, LoginMethod{Name: "LDAP", LoginURL: "/api/auth/ldap/login", Password: true})
+	}
+
+	samlConfig, err := LoadSAMLConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load saml config: %v", err)
+	}
+	if samlConfig != nil {
+		r.GET("/saml/metadata", SAMLMetadata(samlConfig))
+		r.GET("/saml/login", SAMLLogin(samlConfig))
+		signIn.POST("/saml/acs", SAMLACS(client, sessionManager, samlConfig))
+		loginMethods = append(loginMethods, LoginMethod{Name: samlConfig.DisplayName, LoginURL: "/api/auth/saml/login"})
+	}
+
+	wa, err := LoadWebAuthnConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load webauthn config: %v", err)
+	}
+	signIn.POST("/webauthn/login/begin", WebAuthnLoginBegin(client, wa, challenges))
+	signIn.POST("/webauthn/login/finish", WebAuthnLoginFinish(client, wa, challenges))
+	webauthnRegister := r.Group("/webauthn/register")
+	webauthnRegister.Use(api.Middleware(client), api.NotImpersonatingMiddleware())
+	webauthnRegister.POST("/begin", WebAuthnRegisterBegin(client, wa, challenges))
+	webauthnRegister.POST("/finish", WebAuthnRegisterFinish(client, wa, challenges))
+	loginMethods = append(loginMethods, LoginMethod{Name: "Passkey", LoginURL: "/api/auth/webauthn/login/begin", Passkey: true})
+
+	r.GET("/methods", LoginMethods(loginMethods))
+	return nil
+}
+
+// LoginMethods godoc
+//
+//	@Summary		List external login methods
+//	@Schemes		http https
+//	@Description	Lists the external login methods which are enabled on this server
+//	@Tags			Auth API
+//	@Produce		json
+//	@Success		200	{array}	auth.LoginMethod
+//	@Router			/api/auth/methods [get]
+func LoginMethods(loginMethods []LoginMethod) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(200, loginMethods)
+	}
+}
+
+// JWKS godoc
+//
+//	@Summary		List the token verification keys
+//	@Schemes		http https
+//	@Description	Lists the public keys which verify tokens issued by Compsole as a JSON Web Key Set. Tokens name the key they were signed with in their `kid` header.
+//	@Tags			Auth API
+//	@Produce		json
+//	@Success		200	{object}	signing.JSONWebKeySet
+//	@Router			/.well-known/jwks.json [get]
+func JWKS() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Keep the cache short so verifiers pick up rotated keys quickly
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, signing.KeySet())
+	}
 }
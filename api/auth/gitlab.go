@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// GitLabConfig is the OAuth2 application used to sign in with GitLab
+type GitLabConfig struct {
+	// URL is the base url of the GitLab instance (eg. https://gitlab.com)
+	URL      string
+	OAuth2   *oauth2.Config
+	Mappings []GroupMapping
+}
+
+// LoadGitLabConfig loads the GitLab application from the environment. Returns nil if GitLab logins are not configured.
+func LoadGitLabConfig() (*GitLabConfig, error) {
+	clientId, exists := os.LookupEnv("GITLAB_KEY")
+	if !exists || clientId == "" {
+		return nil, nil
+	}
+	clientSecret, exists := os.LookupEnv("GITLAB_SECRET")
+	if !exists || clientSecret == "" {
+		return nil, fmt.Errorf("env var GITLAB_SECRET must be set when GITLAB_KEY is set")
+	}
+	gitlabUrl := "https://gitlab.com"
+	if envValue, exists := os.LookupEnv("GITLAB_URL"); exists && envValue != "" {
+		gitlabUrl = strings.TrimSuffix(envValue, "/")
+	}
+	callbackUrl := externalURL("/api/auth/gitlab/callback")
+	if envValue, exists := os.LookupEnv("GITLAB_CALLBACK_URL"); exists && envValue != "" {
+		callbackUrl = envValue
+	}
+	mappings, err := LoadGroupMappings(os.Getenv("GITLAB_GROUP_MAPPINGS"))
+	if err != nil {
+		return nil, err
+	}
+	return &GitLabConfig{
+		URL: gitlabUrl,
+		OAuth2: &oauth2.Config{
+			ClientID:     clientId,
+			ClientSecret: clientSecret,
+			RedirectURL:  callbackUrl,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  gitlabUrl + "/oauth/authorize",
+				TokenURL: gitlabUrl + "/oauth/token",
+			},
+			// openid is required for the userinfo endpoint to return the user's groups
+			Scopes: []string{"openid", "profile"},
+		},
+		Mappings: mappings,
+	}, nil
+}
+
+// gitlabUserInfo is the response from GitLab's userinfo endpoint
+type gitlabUserInfo struct {
+	Sub      string   `json:"sub"`
+	Nickname string   `json:"nickname"`
+	Name     string   `json:"name"`
+	Groups   []string `json:"groups"`
+}
+
+// GitLabLogin godoc
+//
+//	@Summary		Login with GitLab
+//	@Schemes		http https
+//	@Description	Redirects to GitLab to login
+//	@Tags			Auth API
+//	@Success		302
+//	@Router			/api/auth/gitlab/login [get]
+func GitLabLogin(config *GitLabConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := startOAuth(c, config.OAuth2); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+	}
+}
+
+// GitLabCallback godoc
+//
+//	@Summary		GitLab login callback
+//	@Schemes		http https
+//	@Description	Completes a GitLab login, creating the user if they have never logged in before
+//	@Tags			Auth API
+//	@Param			code	query	string	true	"The authorization code from GitLab"
+//	@Param			state	query	string	true	"The state passed to GitLab"
+//	@Success		302
+//	@Header			302	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Router			/api/auth/gitlab/callback [get]
+func GitLabCallback(client *ent.Client, sessionManager *sessions.Manager, config *GitLabConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		oauthToken, err := finishOAuth(c, config.OAuth2)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed gitlab sign in: %v", err), err)
+			return
+		}
+
+		userInfo, err := fetchGitLabUserInfo(c, config, oauthToken)
+		if err != nil {
+			logrus.Errorf("failed to get gitlab user: %v", err)
+			failedSignIn(c, client, nil, fmt.Sprintf("failed gitlab sign in: %v", err), fmt.Errorf("failed to get user from gitlab"))
+			return
+		}
+
+		if userInfo.Sub == "" {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed gitlab sign in for \"%s\": no user id", userInfo.Nickname), fmt.Errorf("gitlab didn't return a user id"))
+			return
+		}
+
+		firstName, lastName := splitName(userInfo.Name)
+		entUser, err := provisionUser(c, client, sessionManager, user.ProviderGITLAB, &ExternalUser{
+			Issuer:    config.URL,
+			Subject:   userInfo.Sub,
+			Username:  userInfo.Nickname,
+			FirstName: firstName,
+			LastName:  lastName,
+			Groups:    userInfo.Groups,
+		}, config.Mappings)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed gitlab sign in for \"%s\": %v", userInfo.Nickname, err), err)
+			return
+		}
+
+		if err = issueSession(c, client, entUser, "gitlab"); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Redirect(http.StatusFound, loginRedirectURL())
+	}
+}
+
+// fetchGitLabUserInfo gets the signed in user and their group memberships from GitLab
+func fetchGitLabUserInfo(c *gin.Context, config *GitLabConfig, oauthToken *oauth2.Token) (*gitlabUserInfo, error) {
+	res, err := config.OAuth2.Client(c.Request.Context(), oauthToken).Get(config.URL + "/oauth/userinfo")
+	if err != nil {
+		return nil, fmt.Errorf("failed to request userinfo: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo returned status %d", res.StatusCode)
+	}
+	var userInfo gitlabUserInfo
+	if err := json.NewDecoder(res.Body).Decode(&userInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode userinfo: %v", err)
+	}
+	return &userInfo, nil
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/oauth2"
+)
+
+// newTestClient opens an in-memory database with a signing key, so sessions can be issued
+func newTestClient(t *testing.T) (context.Context, *ent.Client) {
+	t.Helper()
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+	if err := signing.Init(ctx, client); err != nil {
+		t.Fatalf("failed to init signing keys: %v", err)
+	}
+	return ctx, client
+}
+
+// newTestSessions returns a session manager backed by an in-memory redis
+func newTestSessions(t *testing.T, client *ent.Client) *sessions.Manager {
+	t.Helper()
+	return sessions.New(client, redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+}
+
+// newCallbackRouter serves the login callback the same way server.go does
+func newCallbackRouter(callback gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.GET("/callback", api.UnauthenticatedMiddleware(), api.AnonymousMiddleware(), callback)
+	return router
+}
+
+// callbackRequest is the browser being redirected back from the provider with an authorization code
+func callbackRequest(code string, cookies ...*http.Cookie) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/callback?"+url.Values{"code": {code}, "state": {"test-state"}}.Encode(), nil)
+	req.AddCookie(&http.Cookie{Name: "oauth-state", Value: "test-state"})
+	req.AddCookie(&http.Cookie{Name: "oauth-verifier", Value: "test-verifier"})
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	return req
+}
+
+// newFakeGitLab serves the token and userinfo endpoints of a GitLab instance. Each authorization code signs in as the
+// matching user.
+func newFakeGitLab(t *testing.T, users map[string]gitlabUserInfo) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"access_token": r.Form.Get("code"), "token_type": "Bearer"})
+	})
+	mux.HandleFunc("/oauth/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		userInfo, ok := users[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		if !ok {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(userInfo)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitLabCallback(t *testing.T) {
+	ctx, client := newTestClient(t)
+	gitlab := newFakeGitLab(t, map[string]gitlabUserInfo{
+		"alice":         {Sub: "1", Nickname: "alice", Name: "Alice Smith"},
+		"alice-renamed": {Sub: "1", Nickname: "alice2", Name: "Alice Smith"},
+		"impostor":      {Sub: "2", Nickname: "alice", Name: "Mallory Jones"},
+		"no-id":         {Nickname: "bob", Name: "Bob Brown"},
+	})
+	config := &GitLabConfig{
+		URL: gitlab.URL,
+		OAuth2: &oauth2.Config{
+			ClientID:     "compsole",
+			ClientSecret: "secret",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  gitlab.URL + "/oauth/authorize",
+				TokenURL: gitlab.URL + "/oauth/token",
+			},
+		},
+	}
+	router := newCallbackRouter(GitLabCallback(client, newTestSessions(t, client), config))
+
+	tests := []struct {
+		name         string
+		code         string
+		wantStatus   int
+		wantSubject  string
+		wantUsername string
+	}{
+		{"first login creates the user", "alice", http.StatusFound, "1", "alice"},
+		{"renamed user keeps their account", "alice-renamed", http.StatusFound, "1", "alice"},
+		{"same username on a different account gets a new user", "impostor", http.StatusFound, "2", "alice-2"},
+		{"user without an id is rejected", "no-id", http.StatusUnauthorized, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, callbackRequest(tt.code))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantSubject == "" {
+				return
+			}
+			entUser, err := client.User.Query().Where(
+				user.ProviderEQ(user.ProviderGITLAB),
+				user.ExternalIssuerEQ(gitlab.URL),
+				user.ExternalSubjectEQ(tt.wantSubject),
+			).Only(ctx)
+			if err != nil {
+				t.Fatalf("failed to query user: %v", err)
+			}
+			if entUser.Username != tt.wantUsername {
+				t.Errorf("got username %q, want %q", entUser.Username, tt.wantUsername)
+			}
+		})
+	}
+
+	userCount, err := client.User.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if userCount != 2 {
+		t.Errorf("got %d users, want 2", userCount)
+	}
+}
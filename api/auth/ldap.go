@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// LDAPConfig is the directory users can login against (eg. Active Directory)
+type LDAPConfig struct {
+	// URL is the directory server (eg. ldap://dc.example.com:389 or ldaps://dc.example.com:636)
+	URL string
+	// StartTLS upgrades ldap:// connections to TLS before binding
+	StartTLS  bool
+	TLSConfig *tls.Config
+	// BindDN and BindPassword are used to search for users. Searches are anonymous if BindDN is empty.
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserFilter finds the user logging in, %s is replaced with the escaped username
+	UserFilter         string
+	FirstNameAttribute string
+	LastNameAttribute  string
+	// GroupAttribute lists the DNs of the groups the user is a member of
+	GroupAttribute string
+	Mappings       []GroupMapping
+}
+
+// LoadLDAPConfig loads the directory from the environment. Returns nil if LDAP logins are not configured.
+func LoadLDAPConfig() (*LDAPConfig, error) {
+	ldapUrl, exists := os.LookupEnv("LDAP_URL")
+	if !exists || ldapUrl == "" {
+		return nil, nil
+	}
+	parsedUrl, err := url.Parse(ldapUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse LDAP_URL: %v", err)
+	}
+	baseDn, exists := os.LookupEnv("LDAP_BASE_DN")
+	if !exists || baseDn == "" {
+		return nil, fmt.Errorf("env var LDAP_BASE_DN must be set when LDAP_URL is set")
+	}
+	config := &LDAPConfig{
+		URL:      ldapUrl,
+		StartTLS: os.Getenv("LDAP_START_TLS") == "true",
+		TLSConfig: &tls.Config{
+			ServerName:         parsedUrl.Hostname(),
+			InsecureSkipVerify: os.Getenv("LDAP_INSECURE_SKIP_VERIFY") == "true",
+		},
+		BindDN:             os.Getenv("LDAP_BIND_DN"),
+		BindPassword:       os.Getenv("LDAP_BIND_PASSWORD"),
+		BaseDN:             baseDn,
+		UserFilter:         "(&(objectClass=person)(uid=%s))",
+		FirstNameAttribute: "givenName",
+		LastNameAttribute:  "sn",
+		GroupAttribute:     "memberOf",
+	}
+	if envValue, exists := os.LookupEnv("LDAP_USER_FILTER"); exists && envValue != "" {
+		if !strings.Contains(envValue, "%s") {
+			return nil, fmt.Errorf("env var LDAP_USER_FILTER must contain %%s for the username")
+		}
+		config.UserFilter = envValue
+	}
+	if envValue, exists := os.LookupEnv("LDAP_GROUP_ATTRIBUTE"); exists && envValue != "" {
+		config.GroupAttribute = envValue
+	}
+	config.Mappings, err = LoadGroupMappings(os.Getenv("LDAP_GROUP_MAPPINGS"))
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// authenticate verifies the user's password by binding as them and returns their directory entry
+func (config *LDAPConfig) authenticate(username string, password string) (*ldap.Entry, error) {
+	conn, err := ldap.DialURL(config.URL, ldap.DialWithTLSConfig(config.TLSConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ldap server: %v", err)
+	}
+	defer conn.Close()
+	if config.StartTLS {
+		if err := conn.StartTLS(config.TLSConfig); err != nil {
+			return nil, fmt.Errorf("failed to start tls: %v", err)
+		}
+	}
+
+	if config.BindDN != "" {
+		err = conn.Bind(config.BindDN, config.BindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind to ldap server: %v", err)
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		config.BaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf(config.UserFilter, ldap.EscapeFilter(username)),
+		[]string{config.FirstNameAttribute, config.LastNameAttribute, config.GroupAttribute},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("failed to search for user: %v", err)
+	}
+	if result == nil || len(result.Entries) != 1 {
+		return nil, fmt.Errorf("user not found")
+	}
+	entry := result.Entries[0]
+
+	// Binding as the user is what checks their password
+	if err := conn.Bind(entry.DN, password); err != nil {
+		return nil, fmt.Errorf("wrong password")
+	}
+	return entry, nil
+}
+
+// LDAPLogin godoc
+//
+//	@Summary		Login with an LDAP account
+//	@Schemes		http https
+//	@Description	Login with an LDAP or Active Directory account, creating the user if they have never logged in before
+//	@Tags			Auth API
+//	@Accept			json,mpfd
+//	@Param			login	body	auth.UserLoginVals	true	"User account details"
+//	@Produce		json
+//	@Success		200	{object}	auth.UserModel
+//	@Header			200	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Failure		429	{object}	api.APIError
+//	@Router			/api/auth/ldap/login [post]
+func LDAPLogin(client *ent.Client, sessionManager *sessions.Manager, config *LDAPConfig, limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var loginVals UserLoginVals
+		if err := c.ShouldBind(&loginVals); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err})
+			return
+		}
+		username := strings.ToLower(loginVals.Username) // Always lowercase username
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
+		}
+		limitSubjects := []ratelimit.Subject{ratelimit.Username(username), ratelimit.IP(clientIp)}
+		if loginThrottled(c, client, limiter, username, limitSubjects...) {
+			return
+		}
+		// An empty password would be an unauthenticated bind, which most servers accept
+		if loginVals.Password == "" {
+			failedSignIn(c, client, nil, fmt.Sprintf("empty ldap password for user \"%s\"", username), fmt.Errorf("User not found"))
+			return
+		}
+
+		entry, err := config.authenticate(username, loginVals.Password)
+		if err != nil {
+			logrus.Debugf("ldap login failed for \"%s\": %v", username, err)
+			api.RecordLoginFailure(c, client, limiter, limitSubjects...)
+			failedSignIn(c, client, nil, fmt.Sprintf("failed ldap sign in for \"%s\": %v", username, err), fmt.Errorf("User not found"))
+			return
+		}
+
+		entUser, err := provisionUser(c, client, sessionManager, user.ProviderLDAP, &ExternalUser{
+			Username:  username,
+			FirstName: entry.GetAttributeValue(config.FirstNameAttribute),
+			LastName:  entry.GetAttributeValue(config.LastNameAttribute),
+			Groups:    entry.GetAttributeValues(config.GroupAttribute),
+		}, config.Mappings)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed ldap sign in for \"%s\": %v", username, err), err)
+			return
+		}
+
+		if err = issueSession(c, client, entUser, "ldap"); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		api.RecordLoginSuccess(c, limiter, ratelimit.Username(username))
+
+		entUser.Password = ""
+		c.JSON(200, UserEntToModel(entUser))
+	}
+}
@@ -1,21 +1,23 @@
 package auth
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
-	"strconv"
 	"strings"
-	"time"
 
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/signing"
 	"github.com/BradHacker/compsole/compsole/utils"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/sirupsen/logrus"
 )
 
@@ -29,20 +31,16 @@ import (
 //	@Param			login	body	auth.UserLoginVals	true	"User account details"
 //	@Produce		json
 //	@Success		200	{object}	auth.UserModel
+//	@Success		202	{object}	auth.MFAChallengeModel
 //	@Header			200	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		429	{object}	api.APIError
 //	@Router			/api/auth/local/login [post]
-func LocalLogin(client *ent.Client) gin.HandlerFunc {
+func LocalLogin(client *ent.Client, limiter *ratelimit.Limiter, challenges *challenge.Store) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		hostname, ok := os.LookupEnv("GRAPHQL_HOSTNAME")
 		if !ok {
 			hostname = "localhost"
 		}
-		cookie_timeout := 60
-		if env_value, exists := os.LookupEnv("COOKIE_TIMEOUT"); exists {
-			if atio_value, err := strconv.Atoi(env_value); err == nil {
-				cookie_timeout = atio_value
-			}
-		}
 		secure_cookie := false
 		if env_value, exists := os.LookupEnv("HTTPS_ENABLED"); exists {
 			if env_value == "true" {
@@ -72,6 +70,12 @@ func LocalLogin(client *ent.Client) gin.HandlerFunc {
 			logrus.Warnf("failed to get IP from gin context: %v", err)
 		}
 
+		// Failures are counted for usernames which don't exist too, so the response doesn't reveal which do
+		limitSubjects := []ratelimit.Subject{ratelimit.Username(username), ratelimit.IP(clientIp)}
+		if loginThrottled(c, client, limiter, username, limitSubjects...) {
+			return
+		}
+
 		entUser, err := client.User.Query().Where(
 			user.And(
 				user.UsernameEQ(username),
@@ -90,6 +94,7 @@ func LocalLogin(client *ent.Client) gin.HandlerFunc {
 				if err != nil {
 					logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
 				}
+				api.RecordLoginFailure(c, client, limiter, limitSubjects...)
 			}
 			if secure_cookie {
 				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
@@ -110,6 +115,7 @@ func LocalLogin(client *ent.Client) gin.HandlerFunc {
 			if err != nil {
 				logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
 			}
+			api.RecordLoginFailure(c, client, limiter, limitSubjects...)
 			if secure_cookie {
 				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
 			} else {
@@ -119,68 +125,29 @@ func LocalLogin(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		expiresAt := time.Now().Add(time.Minute * time.Duration(cookie_timeout)).Unix()
-
-		claims := &api.CompsoleJWTClaims{
-			IssuedAt: time.Now().Unix(),
-			StandardClaims: jwt.StandardClaims{
-				ExpiresAt: expiresAt,
-			},
-		}
-
-		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-		jwtKey, exists := os.LookupEnv("JWT_SECRET")
-		if !exists {
-			if secure_cookie {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
-			} else {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
-			}
-			logrus.Warn("env var JWT_SECRET is not set")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Error signing token"})
-			return
-		}
-
-		tokenString, err := token.SignedString([]byte(jwtKey))
+		// The session isn't issued until the second factor is checked
+		methods, err := mfaMethods(c, entUser)
 		if err != nil {
-			if secure_cookie {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
-			} else {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
-			}
-			logrus.Errorf("error signing token: %v", err)
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Error signing token"})
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 			return
 		}
-
-		_, err = client.Token.Create().SetTokenToUser(entUser).SetExpireAt(expiresAt).SetToken(tokenString).Save(c)
-		if err != nil {
-			if secure_cookie {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
-			} else {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
+		if len(methods) > 0 {
+			if err = issueMFAChallenge(c, challenges, entUser); err != nil {
+				clearAuthCookie(c)
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
 			}
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Error updating token"})
+			c.JSON(http.StatusAccepted, MFAChallengeModel{MFARequired: true, Methods: methods})
 			return
 		}
 
-		if secure_cookie {
-			c.SetCookie("auth-cookie", tokenString, cookie_timeout*60, "/", hostname, true, true)
-		} else {
-			c.SetCookie("auth-cookie", tokenString, cookie_timeout*60, "/", hostname, false, false)
-		}
-
-		// Successful sign-in
-		err = client.Action.Create().
-			SetIPAddress(clientIp).
-			SetType(action.TypeSIGN_IN).
-			SetMessage(fmt.Sprintf("user \"%s\" has signed in successfully", username)).
-			SetActionToUser(entUser).
-			Exec(c)
-		if err != nil {
-			logrus.Warnf("failed to create SIGN_IN action: %v", err)
+		if err = issueSession(c, client, entUser, "local"); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
 		}
+		api.RecordLoginSuccess(c, limiter, ratelimit.Username(username))
 
 		entUser.Password = ""
 		c.JSON(200, UserEntToModel(entUser))
@@ -221,24 +188,11 @@ func Logout(client *ent.Client) gin.HandlerFunc {
 		// Initialize a new instance of `Claims`
 		claims := &api.CompsoleJWTClaims{}
 
-		jwtKey, exists := os.LookupEnv("JWT_SECRET")
-		if !exists {
-			if secure_cookie {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, true, true)
-			} else {
-				c.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
-			}
-			c.AbortWithStatus(http.StatusUnauthorized)
-			return
-		}
-
 		// Parse the JWT string and store the result in `claims`.
-		// Note that we are passing the key in this method as well. This method will return an error
+		// The signing key is picked from the `kid` header. This method will return an error
 		// if the token is invalid (if it has expired according to the expiry time we set on sign in),
 		// or if the signature does not match
-		tkn, err := jwt.ParseWithClaims(tknStr, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtKey), nil
-		})
+		tkn, err := signing.ParseWithClaims(tknStr, claims)
 
 		if err != nil {
 			if secure_cookie {
@@ -246,7 +200,7 @@ func Logout(client *ent.Client) gin.HandlerFunc {
 			} else {
 				c.SetCookie("auth-cookie", "", 0, "/", hostname, false, false)
 			}
-			if err == jwt.ErrSignatureInvalid {
+			if errors.Is(err, jwt.ErrTokenSignatureInvalid) {
 				c.AbortWithStatus(http.StatusUnauthorized)
 				return
 			}
@@ -274,12 +228,9 @@ func Logout(client *ent.Client) gin.HandlerFunc {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err})
 			return
 		}
-		clientIpValues, exists := c.Request.Header["X-Forwarded-For"]
-		clientIp := ""
-		if exists {
-			clientIp = clientIpValues[0]
-		} else {
-			clientIp = c.RemoteIP()
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
 		}
 		err = client.Action.Create().
 			SetIPAddress(clientIp).
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/compsole/mfa"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// mfaChallengeTimeout is how long a user has to enter their second factor after entering their password
+const mfaChallengeTimeout = 5 * time.Minute
+
+// MFAChallengeModel model info
+//
+//	@Description	Returned when the password was correct but a second factor is required
+type MFAChallengeModel struct {
+	MFARequired bool     `json:"mfa_required" example:"true"`     // Always true
+	Methods     []string `json:"methods" example:"totp,webauthn"` // "totp" codes are POSTed to /api/auth/mfa/login, "webauthn" uses /api/auth/webauthn/login/begin
+}
+
+// MFALoginVals model info
+//
+//	@Description	Used as an input to the mfa login
+type MFALoginVals struct {
+	Code string `form:"code" json:"code" binding:"required" example:"123456"` // A TOTP code or a recovery code
+}
+
+// mfaMethods returns the second factors the user has set up
+func mfaMethods(c *gin.Context, entUser *ent.User) ([]string, error) {
+	methods := []string{}
+	if entUser.TotpEnabled {
+		methods = append(methods, "totp")
+	}
+	hasPasskey, err := entUser.QueryUserToWebauthnCredentials().Exist(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query passkeys: %v", err)
+	}
+	if hasPasskey {
+		methods = append(methods, "webauthn")
+	}
+	return methods, nil
+}
+
+// issueMFAChallenge stores proof that the user entered the right password, so the second factor can be checked before
+// the `auth-cookie` is issued. The proof is kept server side and the `mfa-cookie` only holds its id.
+func issueMFAChallenge(c *gin.Context, challenges *challenge.Store, entUser *ent.User) error {
+	challengeId, err := challenges.Create(c, challenge.KindMFA, entUser.ID, mfaChallengeTimeout)
+	if err != nil {
+		logrus.Errorf("failed to create mfa challenge: %v", err)
+		return fmt.Errorf("failed to create mfa challenge")
+	}
+	setCookie(c, "mfa-cookie", challengeId, int(mfaChallengeTimeout.Seconds()))
+	return nil
+}
+
+// mfaChallengeUser returns the user who passed the first factor of the login from the `mfa-cookie`. Set consume when
+// checking the second factor, so each challenge only gets one attempt.
+func mfaChallengeUser(c *gin.Context, client *ent.Client, challenges *challenge.Store, consume bool) (*ent.User, error) {
+	challengeId, err := c.Cookie("mfa-cookie")
+	if err != nil || challengeId == "" {
+		return nil, fmt.Errorf("login has expired, please try again")
+	}
+	var userUuid uuid.UUID
+	var found bool
+	if consume {
+		found, err = challenges.Consume(c, challenge.KindMFA, challengeId, &userUuid)
+	} else {
+		found, err = challenges.Peek(c, challenge.KindMFA, challengeId, &userUuid)
+	}
+	if err != nil {
+		logrus.Errorf("failed to get mfa challenge: %v", err)
+		return nil, fmt.Errorf("failed to get mfa challenge")
+	}
+	if !found {
+		return nil, fmt.Errorf("login has expired, please try again")
+	}
+	return client.User.Query().Where(user.IDEQ(userUuid)).WithUserToTeam().Only(c)
+}
+
+// MFALogin godoc
+//
+//	@Summary		Complete a login with a second factor
+//	@Schemes		http https
+//	@Description	Completes a local login which returned `mfa_required` using a TOTP code or a recovery code
+//	@Tags			Auth API
+//	@Accept			json,mpfd
+//	@Param			login	body	auth.MFALoginVals	true	"The second factor"
+//	@Produce		json
+//	@Success		200	{object}	auth.UserModel
+//	@Header			200	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Failure		429	{object}	api.APIError
+//	@Router			/api/auth/mfa/login [post]
+func MFALogin(client *ent.Client, limiter *ratelimit.Limiter, challenges *challenge.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var loginVals MFALoginVals
+		if err := c.ShouldBind(&loginVals); err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err})
+			return
+		}
+		// Each challenge only gets one attempt, a wrong code sends the user back to the password step
+		entUser, err := mfaChallengeUser(c, client, challenges, true)
+		setCookie(c, "mfa-cookie", "", 0)
+		if err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
+		}
+		limitSubjects := []ratelimit.Subject{ratelimit.Username(entUser.Username), ratelimit.IP(clientIp)}
+		if loginThrottled(c, client, limiter, entUser.Username, limitSubjects...) {
+			return
+		}
+
+		usedRecoveryCode, err := mfa.Verify(c, client, entUser, loginVals.Code)
+		if err != nil {
+			actionErr := client.Action.Create().
+				SetIPAddress(clientIp).
+				SetType(action.TypeFAILED_MFA).
+				SetMessage(fmt.Sprintf("invalid mfa code for user \"%s\": %v", entUser.Username, err)).
+				SetActionToUser(entUser).
+				Exec(c)
+			if actionErr != nil {
+				logrus.Warnf("failed to create FAILED_MFA action: %v", actionErr)
+			}
+			api.RecordLoginFailure(c, client, limiter, limitSubjects...)
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid code"})
+			return
+		}
+
+		method := "local and totp"
+		if usedRecoveryCode {
+			method = fmt.Sprintf("local and a recovery code (%d remaining)", len(entUser.TotpRecoveryCodes))
+		}
+		if err = issueSession(c, client, entUser, method); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		api.RecordLoginSuccess(c, limiter, ratelimit.Username(entUser.Username))
+
+		entUser.Password = ""
+		c.JSON(200, UserEntToModel(entUser))
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+func TestMFALoginChallengeIsSingleUse(t *testing.T) {
+	ctx, client := newTestClient(t)
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	challenges := challenge.New(rdb)
+
+	entUser, err := client.User.Create().
+		SetUsername("alice").
+		SetPassword("hash").
+		SetRole(user.RoleUSER).
+		SetProvider(user.ProviderLOCAL).
+		SetTotpSecret("JBSWY3DPEHPK3PXP").
+		SetTotpEnabled(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	challengeId, err := challenges.Create(ctx, challenge.KindMFA, entUser.ID, mfaChallengeTimeout)
+	if err != nil {
+		t.Fatalf("failed to create challenge: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.POST("/mfa/login", api.UnauthenticatedMiddleware(), api.AnonymousMiddleware(), MFALogin(client, ratelimit.New(rdb), challenges))
+
+	// Replaying the same `mfa-cookie` must not give another guess at the code
+	for i, wantError := range []string{"Invalid code", "login has expired"} {
+		req := httptest.NewRequest(http.MethodPost, "/mfa/login", strings.NewReader(`{"code":"000000"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "mfa-cookie", Value: challengeId})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized || !strings.Contains(w.Body.String(), wantError) {
+			t.Errorf("attempt %d: got %d %s, want 401 %q", i+1, w.Code, w.Body.String(), wantError)
+		}
+	}
+}
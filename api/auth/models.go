@@ -20,11 +20,12 @@ type UserLoginVals struct {
 //	@Description	Used for User login
 type UserModel struct {
 	// Fields
-	ID        uuid.UUID `json:"id" example:"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"` // Compsole ID
-	Username  string    `json:"username" example:"compsole"`                       // [REQUIRED] The username for the user.
-	FirstName string    `json:"first_name" example:"Default"`                      // [OPTIONAL] The display first name for the user.
-	LastName  string    `json:"last_name" example:"User"`                          // [OPTIONAL] The display last name for the user.
-	Role      user.Role `json:"role" example:"USER"`                               // [REQUIRED] The role of the user. Admins have full access.
+	ID                 uuid.UUID `json:"id" example:"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"` // Compsole ID
+	Username           string    `json:"username" example:"compsole"`                       // [REQUIRED] The username for the user.
+	FirstName          string    `json:"first_name" example:"Default"`                      // [OPTIONAL] The display first name for the user.
+	LastName           string    `json:"last_name" example:"User"`                          // [OPTIONAL] The display last name for the user.
+	Role               user.Role `json:"role" example:"USER"`                               // [REQUIRED] The role of the user. Admins have full access.
+	MustChangePassword bool      `json:"must_change_password" example:"false"`              // [OPTIONAL] Whether the user must change their password before doing anything else.
 	// Edges
 	UserToTeam *rest.TeamEdge `json:"user_to_team"`
 }
@@ -32,11 +33,12 @@ type UserModel struct {
 // UserEntToModel converts the result of a User ENT query into a UserModel for API responses
 func UserEntToModel(entUser *ent.User) UserModel {
 	userModel := UserModel{
-		ID:        entUser.ID,
-		Username:  entUser.Username,
-		FirstName: entUser.FirstName,
-		LastName:  entUser.LastName,
-		Role:      entUser.Role,
+		ID:                 entUser.ID,
+		Username:           entUser.Username,
+		FirstName:          entUser.FirstName,
+		LastName:           entUser.LastName,
+		Role:               entUser.Role,
+		MustChangePassword: entUser.MustChangePassword,
 	}
 	if entUser.Edges.UserToTeam != nil {
 		userModel.UserToTeam = &rest.TeamEdge{
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+)
+
+// oauthCookieTimeout is how long (in seconds) a user has to complete a login with an external provider
+const oauthCookieTimeout = 10 * 60
+
+// externalURL returns the url a browser should use to reach path on this server. Callback urls are registered with
+// external providers so they must be absolute.
+func externalURL(path string) string {
+	scheme := "http"
+	if envValue, exists := os.LookupEnv("HTTPS_ENABLED"); exists && envValue == "true" {
+		scheme = "https"
+	}
+	hostname, ok := os.LookupEnv("GRAPHQL_HOSTNAME")
+	if !ok {
+		hostname = "localhost"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, hostname, path)
+}
+
+// loginRedirectURL is where the browser is sent after logging in with an external provider. Set with LOGIN_REDIRECT_URL.
+func loginRedirectURL() string {
+	if envValue, exists := os.LookupEnv("LOGIN_REDIRECT_URL"); exists && envValue != "" {
+		return envValue
+	}
+	return "/"
+}
+
+// startOAuth redirects the browser to the provider's authorization endpoint. The state and PKCE verifier are kept in
+// short-lived cookies until the provider redirects back to the callback.
+func startOAuth(c *gin.Context, config *oauth2.Config, opts ...oauth2.AuthCodeOption) error {
+	state, err := utils.NewToken()
+	if err != nil {
+		return err
+	}
+	verifier := oauth2.GenerateVerifier()
+	setCookie(c, "oauth-state", state, oauthCookieTimeout)
+	setCookie(c, "oauth-verifier", verifier, oauthCookieTimeout)
+	opts = append(opts, oauth2.S256ChallengeOption(verifier))
+	c.Redirect(302, config.AuthCodeURL(state, opts...))
+	return nil
+}
+
+// finishOAuth validates the state the provider redirected back with and exchanges the authorization code for a token
+func finishOAuth(c *gin.Context, config *oauth2.Config) (*oauth2.Token, error) {
+	state, stateErr := c.Cookie("oauth-state")
+	verifier, verifierErr := c.Cookie("oauth-verifier")
+	// The state and verifier are single use
+	setCookie(c, "oauth-state", "", 0)
+	setCookie(c, "oauth-verifier", "", 0)
+
+	if errorCode := c.Query("error"); errorCode != "" {
+		return nil, fmt.Errorf("login was denied by the provider: %s %s", errorCode, c.Query("error_description"))
+	}
+	if stateErr != nil || verifierErr != nil || state == "" {
+		return nil, fmt.Errorf("login has expired, please try again")
+	}
+	if c.Query("state") != state {
+		return nil, fmt.Errorf("login state does not match")
+	}
+	code := c.Query("code")
+	if code == "" {
+		return nil, fmt.Errorf("provider did not return an authorization code")
+	}
+	oauthToken, err := config.Exchange(c.Request.Context(), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %v", err)
+	}
+	return oauthToken, nil
+}
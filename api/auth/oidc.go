@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// oidcDiscoveryTimeout is how long to wait for an issuer's discovery document
+const oidcDiscoveryTimeout = 15 * time.Second
+
+// OIDCIssuer is an OpenID Connect identity provider users can login with (eg. Keycloak or Entra ID)
+type OIDCIssuer struct {
+	// Name is the url-safe identifier for the issuer used in its login and callback urls
+	Name string `json:"name"`
+	// DisplayName is shown on the sign in page
+	DisplayName string `json:"display_name"`
+	// Issuer is the issuer url, the discovery document is loaded from <issuer>/.well-known/openid-configuration
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	// CallbackURL is optional and defaults to /api/auth/oidc/<name>/callback on this server
+	CallbackURL string `json:"callback_url,omitempty"`
+	// Scopes are optional and default to openid, profile and email
+	Scopes []string `json:"scopes,omitempty"`
+	// UsernameClaim is optional and defaults to preferred_username. It only names new users, users are matched on
+	// the issuer and their sub claim. New users whose username is taken get a number appended (eg. alice-2).
+	UsernameClaim string `json:"username_claim,omitempty"`
+	// FirstNameClaim and LastNameClaim are optional and default to given_name and family_name
+	FirstNameClaim string `json:"first_name_claim,omitempty"`
+	LastNameClaim  string `json:"last_name_claim,omitempty"`
+	// GroupsClaim is optional and defaults to groups. Nested claims can be selected with dots (eg. realm_access.roles).
+	GroupsClaim string `json:"groups_claim,omitempty"`
+	// Mappings assign roles and teams to users based on the values of the groups claim
+	Mappings []GroupMapping `json:"mappings,omitempty"`
+
+	mu           sync.Mutex
+	provider     *oidc.Provider
+	oauth2Config *oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+}
+
+// LoadOIDCIssuers reads the list of OIDC issuers from the JSON file at OIDC_CONFIG. Returns no issuers if OIDC
+// logins are not configured.
+func LoadOIDCIssuers() ([]*OIDCIssuer, error) {
+	configPath, exists := os.LookupEnv("OIDC_CONFIG")
+	if !exists || configPath == "" {
+		return nil, nil
+	}
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oidc config: %v", err)
+	}
+	var issuers []*OIDCIssuer
+	if err := json.Unmarshal(configBytes, &issuers); err != nil {
+		return nil, fmt.Errorf("failed to parse oidc config: %v", err)
+	}
+	names := map[string]bool{}
+	for _, issuer := range issuers {
+		if issuer.Name == "" || issuer.Issuer == "" || issuer.ClientID == "" {
+			return nil, fmt.Errorf("oidc issuers must set name, issuer and client_id")
+		}
+		if names[issuer.Name] {
+			return nil, fmt.Errorf("oidc issuer name \"%s\" is used more than once", issuer.Name)
+		}
+		names[issuer.Name] = true
+		if issuer.DisplayName == "" {
+			issuer.DisplayName = issuer.Name
+		}
+		if issuer.CallbackURL == "" {
+			issuer.CallbackURL = externalURL(fmt.Sprintf("/api/auth/oidc/%s/callback", issuer.Name))
+		}
+		if len(issuer.Scopes) == 0 {
+			issuer.Scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+		}
+		if issuer.UsernameClaim == "" {
+			issuer.UsernameClaim = "preferred_username"
+		}
+		if issuer.FirstNameClaim == "" {
+			issuer.FirstNameClaim = "given_name"
+		}
+		if issuer.LastNameClaim == "" {
+			issuer.LastNameClaim = "family_name"
+		}
+		if issuer.GroupsClaim == "" {
+			issuer.GroupsClaim = "groups"
+		}
+		for _, mapping := range issuer.Mappings {
+			if err := mapping.validate(); err != nil {
+				return nil, fmt.Errorf("invalid mapping for oidc issuer \"%s\": %v", issuer.Name, err)
+			}
+		}
+	}
+	return issuers, nil
+}
+
+// discover loads the issuer's discovery document the first time it is needed, so an unavailable identity provider
+// doesn't stop the server from starting
+func (i *OIDCIssuer) discover() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.provider != nil {
+		return nil
+	}
+	// Discovery isn't tied to a request since the provider is shared by every login
+	ctx, cancel := context.WithTimeout(context.Background(), oidcDiscoveryTimeout)
+	defer cancel()
+	provider, err := oidc.NewProvider(ctx, i.Issuer)
+	if err != nil {
+		return fmt.Errorf("failed to discover oidc issuer \"%s\": %v", i.Issuer, err)
+	}
+	i.provider = provider
+	i.oauth2Config = &oauth2.Config{
+		ClientID:     i.ClientID,
+		ClientSecret: i.ClientSecret,
+		RedirectURL:  i.CallbackURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       i.Scopes,
+	}
+	i.verifier = provider.Verifier(&oidc.Config{ClientID: i.ClientID})
+	return nil
+}
+
+// claimValue looks up a claim, following dots into nested objects
+func claimValue(claims map[string]interface{}, name string) interface{} {
+	var value interface{} = claims
+	for _, part := range strings.Split(name, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[part]
+	}
+	return value
+}
+
+// claimString returns a claim if it is a string
+func claimString(claims map[string]interface{}, name string) string {
+	value, _ := claimValue(claims, name).(string)
+	return value
+}
+
+// claimStrings returns a claim as a list of strings. Single string claims are treated as a list of one.
+func claimStrings(claims map[string]interface{}, name string) []string {
+	switch value := claimValue(claims, name).(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// OIDCLogin godoc
+//
+//	@Summary		Login with an OpenID Connect issuer
+//	@Schemes		http https
+//	@Description	Redirects to the OpenID Connect issuer to login
+//	@Tags			Auth API
+//	@Param			issuer	path	string	true	"The name of the issuer"
+//	@Success		302
+//	@Failure		502	{object}	api.APIError
+//	@Router			/api/auth/oidc/{issuer}/login [get]
+func OIDCLogin(issuer *OIDCIssuer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := issuer.discover(); err != nil {
+			logrus.Errorf("%v", err)
+			c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "login provider is unavailable"})
+			return
+		}
+		nonce, err := utils.NewToken()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		setCookie(c, "oauth-nonce", nonce, oauthCookieTimeout)
+		if err := startOAuth(c, issuer.oauth2Config, oidc.Nonce(nonce)); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+	}
+}
+
+// OIDCCallback godoc
+//
+//	@Summary		OpenID Connect login callback
+//	@Schemes		http https
+//	@Description	Completes an OpenID Connect login, creating the user if they have never logged in before
+//	@Tags			Auth API
+//	@Param			issuer	path	string	true	"The name of the issuer"
+//	@Param			code	query	string	true	"The authorization code from the issuer"
+//	@Param			state	query	string	true	"The state passed to the issuer"
+//	@Success		302
+//	@Header			302	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Router			/api/auth/oidc/{issuer}/callback [get]
+func OIDCCallback(client *ent.Client, sessionManager *sessions.Manager, issuer *OIDCIssuer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		nonce, nonceErr := c.Cookie("oauth-nonce")
+		setCookie(c, "oauth-nonce", "", 0)
+
+		if err := issuer.discover(); err != nil {
+			logrus.Errorf("%v", err)
+			failedSignIn(c, client, nil, fmt.Sprintf("failed %s sign in: %v", issuer.Name, err), fmt.Errorf("login provider is unavailable"))
+			return
+		}
+		oauthToken, err := finishOAuth(c, issuer.oauth2Config)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed %s sign in: %v", issuer.Name, err), err)
+			return
+		}
+
+		claims, err := issuer.verifiedClaims(c, oauthToken, nonce, nonceErr)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed %s sign in: %v", issuer.Name, err), err)
+			return
+		}
+
+		username := claimString(claims, issuer.UsernameClaim)
+		entUser, err := provisionUser(c, client, sessionManager, user.ProviderOIDC, &ExternalUser{
+			Issuer:    issuer.Issuer,
+			Subject:   claimString(claims, "sub"),
+			Username:  username,
+			FirstName: claimString(claims, issuer.FirstNameClaim),
+			LastName:  claimString(claims, issuer.LastNameClaim),
+			Groups:    claimStrings(claims, issuer.GroupsClaim),
+		}, issuer.Mappings)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed %s sign in for \"%s\": %v", issuer.Name, username, err), err)
+			return
+		}
+
+		if err = issueSession(c, client, entUser, issuer.Name); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Redirect(http.StatusFound, loginRedirectURL())
+	}
+}
+
+// verifiedClaims verifies the ID token returned with the oauth token and returns its claims. Claims from the userinfo
+// endpoint are added if they aren't in the ID token, since some issuers leave groups out of ID tokens.
+func (i *OIDCIssuer) verifiedClaims(c *gin.Context, oauthToken *oauth2.Token, nonce string, nonceErr error) (map[string]interface{}, error) {
+	rawIdToken, ok := oauthToken.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("issuer did not return an id token")
+	}
+	idToken, err := i.verifier.Verify(c.Request.Context(), rawIdToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id token: %v", err)
+	}
+	if nonceErr != nil || nonce == "" || idToken.Nonce != nonce {
+		return nil, fmt.Errorf("id token nonce does not match")
+	}
+	if idToken.Subject == "" {
+		return nil, fmt.Errorf("id token does not have a subject")
+	}
+	claims := map[string]interface{}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse id token claims: %v", err)
+	}
+
+	if i.provider.UserInfoEndpoint() != "" {
+		userInfo, err := i.provider.UserInfo(c.Request.Context(), oauth2.StaticTokenSource(oauthToken))
+		if err != nil {
+			logrus.Warnf("failed to get userinfo from oidc issuer \"%s\": %v", i.Name, err)
+			return claims, nil
+		}
+		if userInfo.Subject != idToken.Subject {
+			return nil, fmt.Errorf("userinfo subject does not match id token")
+		}
+		userInfoClaims := map[string]interface{}{}
+		if err := userInfo.Claims(&userInfoClaims); err != nil {
+			logrus.Warnf("failed to parse userinfo claims from oidc issuer \"%s\": %v", i.Name, err)
+			return claims, nil
+		}
+		for claim, value := range userInfoClaims {
+			if _, exists := claims[claim]; !exists {
+				claims[claim] = value
+			}
+		}
+	}
+	return claims, nil
+}
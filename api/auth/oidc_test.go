@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockOIDCIssuer is an OpenID Connect issuer which signs in as the claims matching each authorization code
+type mockOIDCIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	users  map[string]jwt.MapClaims
+}
+
+// newMockOIDCIssuer serves discovery, keys and a token endpoint which returns a signed ID token for the client
+func newMockOIDCIssuer(t *testing.T, clientId string, users map[string]jwt.MapClaims) *mockOIDCIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	issuer := &mockOIDCIssuer{key: key, users: users}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                issuer.server.URL,
+			"authorization_endpoint":                issuer.server.URL + "/authorize",
+			"token_endpoint":                        issuer.server.URL + "/token",
+			"jwks_uri":                              issuer.server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		userClaims, ok := issuer.users[r.Form.Get("code")]
+		if !ok {
+			http.Error(w, "invalid code", http.StatusBadRequest)
+			return
+		}
+		claims := jwt.MapClaims{
+			"iss":   issuer.server.URL,
+			"aud":   clientId,
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": "test-nonce",
+		}
+		for claim, value := range userClaims {
+			claims[claim] = value
+		}
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		idToken.Header["kid"] = "test"
+		rawIdToken, err := idToken.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"id_token":     rawIdToken,
+		})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func TestOIDCCallback(t *testing.T) {
+	ctx, client := newTestClient(t)
+	// A local admin already has the username both issuers report
+	localAdmin, err := client.User.Create().
+		SetUsername("admin").
+		SetPassword("hash").
+		SetRole(user.RoleADMIN).
+		SetProvider(user.ProviderLOCAL).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create local admin: %v", err)
+	}
+
+	users := map[string]jwt.MapClaims{
+		"admin":   {"sub": "1", "preferred_username": "admin"},
+		"renamed": {"sub": "1", "preferred_username": "root"},
+		"no-sub":  {"preferred_username": "nobody"},
+	}
+	issuerA := newMockOIDCIssuer(t, "compsole", users)
+	issuerB := newMockOIDCIssuer(t, "compsole", users)
+	routers := map[string]*gin.Engine{}
+	for name, mock := range map[string]*mockOIDCIssuer{"a": issuerA, "b": issuerB} {
+		routers[name] = newCallbackRouter(OIDCCallback(client, newTestSessions(t, client), &OIDCIssuer{
+			Name:          name,
+			Issuer:        mock.server.URL,
+			ClientID:      "compsole",
+			UsernameClaim: "preferred_username",
+		}))
+	}
+	issuerUrls := map[string]string{"a": issuerA.server.URL, "b": issuerB.server.URL}
+
+	tests := []struct {
+		name         string
+		issuer       string
+		code         string
+		wantStatus   int
+		wantUsername string
+	}{
+		{"username taken by a local user", "a", "admin", http.StatusFound, "admin-2"},
+		{"same username and sub at another issuer gets a new user", "b", "admin", http.StatusFound, "admin-3"},
+		{"renamed user keeps their account", "a", "renamed", http.StatusFound, "admin-2"},
+		{"id token without a subject is rejected", "a", "no-sub", http.StatusUnauthorized, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			routers[tt.issuer].ServeHTTP(w, callbackRequest(tt.code, &http.Cookie{Name: "oauth-nonce", Value: "test-nonce"}))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantUsername == "" {
+				return
+			}
+			entUser, err := client.User.Query().Where(
+				user.ProviderEQ(user.ProviderOIDC),
+				user.ExternalIssuerEQ(issuerUrls[tt.issuer]),
+				user.ExternalSubjectEQ("1"),
+			).Only(ctx)
+			if err != nil {
+				t.Fatalf("failed to query user: %v", err)
+			}
+			if entUser.Username != tt.wantUsername {
+				t.Errorf("got username %q, want %q", entUser.Username, tt.wantUsername)
+			}
+		})
+	}
+
+	localAdmin, err = client.User.Get(ctx, localAdmin.ID)
+	if err != nil {
+		t.Fatalf("failed to query local admin: %v", err)
+	}
+	if localAdmin.Provider != user.ProviderLOCAL || localAdmin.ExternalSubject != "" {
+		t.Errorf("local admin was linked to an oidc account")
+	}
+	userCount, err := client.User.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if userCount != 3 {
+		t.Errorf("got %d users, want 3", userCount)
+	}
+}
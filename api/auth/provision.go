@@ -0,0 +1,352 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/token"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// GroupMapping assigns a Compsole role and/or team to members of an external group (eg. a GitLab group path or a
+// value of an OIDC groups claim)
+type GroupMapping struct {
+	// Group is matched case-insensitively against the groups reported by the login provider
+	Group string `json:"group"`
+	// Role is optional. When any mapping sets a role, users' roles are synced on every login.
+	Role user.Role `json:"role,omitempty"`
+	// Competition and TeamNumber are optional. When any mapping sets a team, users' teams are synced on every login.
+	Competition string `json:"competition,omitempty"`
+	TeamNumber  *int   `json:"team_number,omitempty"`
+}
+
+// LoadGroupMappings reads a JSON list of group mappings from a file. An empty path returns no mappings.
+func LoadGroupMappings(path string) ([]GroupMapping, error) {
+	if path == "" {
+		return nil, nil
+	}
+	mappingBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group mappings: %v", err)
+	}
+	var mappings []GroupMapping
+	if err := json.Unmarshal(mappingBytes, &mappings); err != nil {
+		return nil, fmt.Errorf("failed to parse group mappings: %v", err)
+	}
+	for _, mapping := range mappings {
+		if err := mapping.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return mappings, nil
+}
+
+func (mapping GroupMapping) validate() error {
+	if mapping.Group == "" {
+		return fmt.Errorf("group mapping is missing a group")
+	}
+	if mapping.Role != "" {
+		if err := user.RoleValidator(mapping.Role); err != nil {
+			return fmt.Errorf("invalid role for group \"%s\": %v", mapping.Group, err)
+		}
+	}
+	if (mapping.Competition == "") != (mapping.TeamNumber == nil) {
+		return fmt.Errorf("group \"%s\" must set both competition and team_number to map a team", mapping.Group)
+	}
+	return nil
+}
+
+// maxUsernameSuffix limits how many numbered usernames are tried when an external user's username is taken
+const maxUsernameSuffix = 100
+
+// ExternalUser is a user as reported by an external login provider
+type ExternalUser struct {
+	// Issuer and Subject identify the external account (eg. the OIDC issuer url and sub claim). When Subject is set,
+	// users are matched on them and Username is only used to name new users, since providers let users rename
+	// themselves and several issuers can have users with the same username.
+	Issuer    string
+	Subject   string
+	Username  string
+	FirstName string
+	LastName  string
+	Groups    []string
+}
+
+// splitName splits a full display name into first and last names
+func splitName(name string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(name), " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// provisionUser finds or creates the user for an external login and syncs their role and team from the group mappings
+func provisionUser(ctx context.Context, client *ent.Client, sessionManager *sessions.Manager, provider user.Provider, externalUser *ExternalUser, mappings []GroupMapping) (*ent.User, error) {
+	username := strings.ToLower(externalUser.Username) // Always lowercase username
+	if username == "" {
+		return nil, fmt.Errorf("login provider did not return a username")
+	}
+
+	externalAccount := user.And(
+		user.UsernameEQ(username),
+		user.ProviderEQ(provider),
+	)
+	if externalUser.Subject != "" {
+		externalAccount = user.And(
+			user.ProviderEQ(provider),
+			user.ExternalIssuerEQ(externalUser.Issuer),
+			user.ExternalSubjectEQ(externalUser.Subject),
+		)
+	}
+	entUser, err := client.User.Query().Where(externalAccount).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query user: %v", err)
+	}
+	if entUser == nil && externalUser.Subject != "" {
+		// Users created before the provider sent a subject were matched on username, so link them on their next login
+		entUser, err = client.User.Query().Where(
+			user.UsernameEQ(username),
+			user.ProviderEQ(provider),
+			user.Or(user.ExternalSubjectIsNil(), user.ExternalSubjectEQ("")),
+		).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query user: %v", err)
+		}
+		if entUser != nil {
+			entUser, err = entUser.Update().
+				SetNillableExternalIssuer(nillable(externalUser.Issuer)).
+				SetExternalSubject(externalUser.Subject).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update user: %v", err)
+			}
+		}
+	}
+	if entUser == nil {
+		if externalUser.Subject != "" {
+			// The username is only a display value, so a taken username gets a number instead of being shared
+			username, err = availableUsername(ctx, client, username)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// Don't let an external account take over an account from a different provider
+			exists, err := client.User.Query().Where(user.UsernameEQ(username)).Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query user: %v", err)
+			}
+			if exists {
+				return nil, fmt.Errorf("username \"%s\" is already in use by another account", username)
+			}
+		}
+		// External users never log in with a password, so give them one nobody knows
+		randomPassword, err := utils.NewToken()
+		if err != nil {
+			return nil, err
+		}
+		hashedPassword, err := utils.HashPassword(randomPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %v", err)
+		}
+		entUser, err = client.User.Create().
+			SetUsername(username).
+			SetPassword(hashedPassword).
+			SetFirstName(externalUser.FirstName).
+			SetLastName(externalUser.LastName).
+			SetRole(user.RoleUSER).
+			SetProvider(provider).
+			SetNillableExternalIssuer(nillable(externalUser.Issuer)).
+			SetNillableExternalSubject(nillable(externalUser.Subject)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %v", err)
+		}
+	}
+
+	previousRole := entUser.Role
+	userUpdate := entUser.Update()
+	if externalUser.FirstName != "" || externalUser.LastName != "" {
+		userUpdate.SetFirstName(externalUser.FirstName).SetLastName(externalUser.LastName)
+	}
+	teams, err := applyGroupMappings(ctx, client, entUser, userUpdate, externalUser.Groups, mappings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := userUpdate.Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update user: %v", err)
+	}
+	if teams != nil {
+		if err = syncTeamMemberships(ctx, client, entUser, teams); err != nil {
+			return nil, err
+		}
+	}
+	entUser, err = client.User.Query().Where(user.IDEQ(entUser.ID)).WithUserToTeam().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %v", err)
+	}
+	if err = utils.EnsureTeamMembership(ctx, client, entUser, entUser.Edges.UserToTeam); err != nil {
+		return nil, err
+	}
+	// Sessions from earlier logins were authorized for the old role, so the user has to sign in again everywhere
+	if entUser.Role != previousRole {
+		revoked, err := sessionManager.Revoke(ctx, token.HasTokenToUserWith(user.IDEQ(entUser.ID)))
+		if err != nil {
+			return nil, err
+		}
+		if revoked > 0 {
+			err = client.Action.Create().
+				SetType(action.TypeREVOKE_SESSION).
+				SetMessage(fmt.Sprintf("revoked %d sessions for user %s (role changed by group mappings)", revoked, entUser.Username)).
+				SetActionToUser(entUser).
+				Exec(ctx)
+			if err != nil {
+				logrus.Warnf("failed to log REVOKE_SESSION: %v", err)
+			}
+		}
+	}
+	return entUser, nil
+}
+
+// availableUsername returns the username, or the username with the lowest number appended (eg. "alice-2") if it is
+// already taken
+func availableUsername(ctx context.Context, client *ent.Client, username string) (string, error) {
+	candidate := username
+	for suffix := 2; suffix <= maxUsernameSuffix; suffix++ {
+		exists, err := client.User.Query().Where(user.UsernameEQ(candidate)).Exist(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to query user: %v", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", username, suffix)
+	}
+	return "", fmt.Errorf("username \"%s\" is already in use by too many accounts", username)
+}
+
+// nillable returns nil for empty strings, so optional fields are left unset
+func nillable(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// mappedTeams are the teams managed by the group mappings. Members of a mapped group are put on its team and taken
+// off of it once they leave the group. Memberships of other teams are left to admins.
+type mappedTeams struct {
+	// all is every team named by a mapping
+	all []uuid.UUID
+	// member is the mapped teams of the user's groups, in the order of the mappings
+	member []*ent.Team
+}
+
+// applyGroupMappings sets the user's role and team from the mappings which match their groups. The most privileged
+// matching role wins. The user's active team is kept if their groups still map to it and is otherwise the first
+// matching team. Returns nil when no mapping sets a team.
+func applyGroupMappings(ctx context.Context, client *ent.Client, entUser *ent.User, userUpdate *ent.UserUpdateOne, groups []string, mappings []GroupMapping) (*mappedTeams, error) {
+	role := user.RoleUSER
+	syncRole := false
+	var teamPredicates []predicate.Team
+	teams := &mappedTeams{}
+	for _, mapping := range mappings {
+		var mappedTeam predicate.Team
+		if mapping.Role != "" {
+			syncRole = true
+		}
+		if mapping.TeamNumber != nil {
+			mappedTeam = team.And(
+				team.TeamNumberEQ(*mapping.TeamNumber),
+				team.HasTeamToCompetitionWith(competition.NameEQ(mapping.Competition)),
+			)
+			teamPredicates = append(teamPredicates, mappedTeam)
+		}
+		if !memberOf(groups, mapping.Group) {
+			continue
+		}
+		if mapping.Role != "" && permissions.MorePrivileged(mapping.Role, role) {
+			role = mapping.Role
+		}
+		if mappedTeam != nil {
+			entTeam, err := client.Team.Query().Where(mappedTeam).Only(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find team %d in competition \"%s\" for group \"%s\": %v", *mapping.TeamNumber, mapping.Competition, mapping.Group, err)
+			}
+			teams.member = append(teams.member, entTeam)
+		}
+	}
+	if syncRole {
+		userUpdate.SetRole(role)
+	}
+	if len(teamPredicates) == 0 {
+		return nil, nil
+	}
+	var err error
+	teams.all, err = client.Team.Query().Where(team.Or(teamPredicates...)).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mapped teams: %v", err)
+	}
+	if len(teams.member) == 0 {
+		userUpdate.ClearUserToTeam()
+		return teams, nil
+	}
+	activeTeamId, err := entUser.QueryUserToTeam().OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query team from user: %v", err)
+	}
+	for _, entTeam := range teams.member {
+		if entTeam.ID == activeTeamId {
+			return teams, nil
+		}
+	}
+	userUpdate.SetUserToTeam(teams.member[0])
+	return teams, nil
+}
+
+// syncTeamMemberships makes the user a member of the mapped teams of their groups and takes them off of the other
+// mapped teams
+func syncTeamMemberships(ctx context.Context, client *ent.Client, entUser *ent.User, teams *mappedTeams) error {
+	unmappedTeams := []predicate.Team{team.IDIn(teams.all...)}
+	for _, entTeam := range teams.member {
+		unmappedTeams = append(unmappedTeams, team.IDNEQ(entTeam.ID))
+	}
+	_, err := client.TeamMembership.Delete().
+		Where(
+			teammembership.HasTeamMembershipToUserWith(user.IDEQ(entUser.ID)),
+			teammembership.HasTeamMembershipToTeamWith(unmappedTeams...),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete team memberships: %v", err)
+	}
+	for _, entTeam := range teams.member {
+		if err = utils.EnsureTeamMembership(ctx, client, entUser, entTeam); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func memberOf(groups []string, group string) bool {
+	for _, g := range groups {
+		if strings.EqualFold(g, group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/token"
+	"github.com/BradHacker/compsole/ent/user"
+)
+
+func TestProvisionUserSyncsTeamMemberships(t *testing.T) {
+	ctx, client := newTestClient(t)
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	entCompetition := client.Competition.Create().SetName("competition").SetCompetitionToProvider(entProvider).SaveX(ctx)
+	client.Team.Create().SetTeamNumber(1).SetTeamToCompetition(entCompetition).ExecX(ctx)
+	client.Team.Create().SetTeamNumber(2).SetTeamToCompetition(entCompetition).ExecX(ctx)
+	manualTeam := client.Team.Create().SetTeamNumber(3).SetTeamToCompetition(entCompetition).SaveX(ctx)
+	one, two := 1, 2
+	mappings := []GroupMapping{
+		{Group: "team-one", Competition: "competition", TeamNumber: &one},
+		{Group: "team-two", Competition: "competition", TeamNumber: &two},
+	}
+
+	tests := []struct {
+		name           string
+		groups         []string
+		wantActiveTeam int
+		wantTeams      []int
+	}{
+		{"first login", []string{"team-one"}, 1, []int{1, 3}},
+		{"joined a second group", []string{"team-one", "team-two"}, 1, []int{1, 2, 3}},
+		{"left the active team's group", []string{"team-two"}, 2, []int{2, 3}},
+		{"left every group", nil, 0, []int{3}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			externalUser := &ExternalUser{Issuer: "https://idp.example.com", Subject: "1", Username: "alice", Groups: tt.groups}
+			entUser, err := provisionUser(ctx, client, newTestSessions(t, client), user.ProviderOIDC, externalUser, mappings)
+			if err != nil {
+				t.Fatalf("failed to provision user: %v", err)
+			}
+			// Admins can add users to teams which aren't mapped, which logins leave alone
+			if i == 0 {
+				client.TeamMembership.Create().SetTeamMembershipToUser(entUser).SetTeamMembershipToTeam(manualTeam).ExecX(ctx)
+			}
+			activeTeam := 0
+			if entUser.Edges.UserToTeam != nil {
+				activeTeam = entUser.Edges.UserToTeam.TeamNumber
+			}
+			if activeTeam != tt.wantActiveTeam {
+				t.Errorf("got active team %d, want %d", activeTeam, tt.wantActiveTeam)
+			}
+			teamNumbers, err := entUser.QueryUserToTeamMemberships().
+				QueryTeamMembershipToTeam().
+				Order(ent.Asc(team.FieldTeamNumber)).
+				Select(team.FieldTeamNumber).
+				Ints(ctx)
+			if err != nil {
+				t.Fatalf("failed to query team memberships: %v", err)
+			}
+			if fmt.Sprint(teamNumbers) != fmt.Sprint(tt.wantTeams) {
+				t.Errorf("got member of teams %v, want %v", teamNumbers, tt.wantTeams)
+			}
+		})
+	}
+}
+
+func TestProvisionUserRevokesSessionsOnRoleChange(t *testing.T) {
+	ctx, client := newTestClient(t)
+	sessionManager := newTestSessions(t, client)
+	mappings := []GroupMapping{{Group: "admins", Role: user.RoleADMIN}}
+
+	tests := []struct {
+		name               string
+		groups             []string
+		wantRole           user.Role
+		wantEarlierSession bool
+	}{
+		{"first login", []string{"admins"}, user.RoleADMIN, false},
+		{"same role", []string{"admins"}, user.RoleADMIN, true},
+		{"removed from the admin group", nil, user.RoleUSER, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			externalUser := &ExternalUser{Issuer: "https://idp.example.com", Subject: "1", Username: "alice", Groups: tt.groups}
+			entUser, err := provisionUser(ctx, client, sessionManager, user.ProviderOIDC, externalUser, mappings)
+			if err != nil {
+				t.Fatalf("failed to provision user: %v", err)
+			}
+			if entUser.Role != tt.wantRole {
+				t.Errorf("got role %s, want %s", entUser.Role, tt.wantRole)
+			}
+			earlierSession := client.Token.Query().Where(token.TokenEQ("earlier-login")).ExistX(ctx)
+			if earlierSession != tt.wantEarlierSession {
+				t.Errorf("got earlier session %v, want %v", earlierSession, tt.wantEarlierSession)
+			}
+			// The session which would be issued for this login
+			client.Token.Delete().Where(token.TokenEQ("earlier-login")).ExecX(ctx)
+			client.Token.Create().SetTokenToUser(entUser).SetToken("earlier-login").SetExpireAt(time.Now().Add(time.Hour).Unix()).ExecX(ctx)
+		})
+	}
+}
+
+func TestProvisionUserLinksAccountsWithoutSubject(t *testing.T) {
+	ctx, client := newTestClient(t)
+	sessionManager := newTestSessions(t, client)
+	legacyUser := client.User.Create().SetUsername("alice").SetPassword("hash").SetRole(user.RoleUSER).SetProvider(user.ProviderSAML).SaveX(ctx)
+	client.User.Create().SetUsername("bob").SetPassword("hash").SetRole(user.RoleUSER).SetProvider(user.ProviderLOCAL).ExecX(ctx)
+
+	externalUser := &ExternalUser{Issuer: "https://idp.example.com", Subject: "alice-id", Username: "alice"}
+	entUser, err := provisionUser(ctx, client, sessionManager, user.ProviderSAML, externalUser, nil)
+	if err != nil {
+		t.Fatalf("failed to provision user: %v", err)
+	}
+	if entUser.ID != legacyUser.ID {
+		t.Fatalf("got new user %s, want the existing account linked", entUser.Username)
+	}
+	if entUser.ExternalIssuer != externalUser.Issuer || entUser.ExternalSubject != externalUser.Subject {
+		t.Errorf("got issuer %q and subject %q, want the external account's", entUser.ExternalIssuer, entUser.ExternalSubject)
+	}
+
+	// Once linked, the account follows the subject, not the username
+	externalUser = &ExternalUser{Issuer: "https://idp.example.com", Subject: "alice-id", Username: "alice.renamed"}
+	if entUser, err = provisionUser(ctx, client, sessionManager, user.ProviderSAML, externalUser, nil); err != nil {
+		t.Fatalf("failed to provision user: %v", err)
+	}
+	if entUser.ID != legacyUser.ID {
+		t.Errorf("got new user %s after a rename, want the linked account", entUser.Username)
+	}
+
+	// Accounts from other providers are never linked
+	externalUser = &ExternalUser{Issuer: "https://idp.example.com", Subject: "bob-id", Username: "bob"}
+	if entUser, err = provisionUser(ctx, client, sessionManager, user.ProviderSAML, externalUser, nil); err != nil {
+		t.Fatalf("failed to provision user: %v", err)
+	}
+	if entUser.Username == "bob" || entUser.Provider != user.ProviderSAML {
+		t.Errorf("got user %s from provider %s, want a new saml user", entUser.Username, entUser.Provider)
+	}
+}
@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/crewjam/saml"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// samlMetadataTimeout is how long to wait for the IdP's metadata
+const samlMetadataTimeout = 15 * time.Second
+
+// SAMLConfig is the SAML 2.0 identity provider users can login with
+type SAMLConfig struct {
+	// DisplayName is shown on the sign in page
+	DisplayName string
+	// IDPMetadata is the url or file path of the IdP's metadata
+	IDPMetadata string
+	// UsernameAttribute is optional, the assertion's NameID is used if it is empty
+	UsernameAttribute  string
+	FirstNameAttribute string
+	LastNameAttribute  string
+	GroupsAttribute    string
+	Mappings           []GroupMapping
+	ServiceProvider    *saml.ServiceProvider
+
+	mu sync.Mutex
+}
+
+// LoadSAMLConfig loads the SAML service provider from the environment. Returns nil if SAML logins are not configured.
+func LoadSAMLConfig() (*SAMLConfig, error) {
+	idpMetadata, exists := os.LookupEnv("SAML_IDP_METADATA")
+	if !exists || idpMetadata == "" {
+		return nil, nil
+	}
+	keyPair, err := tls.LoadX509KeyPair(os.Getenv("SAML_CERT"), os.Getenv("SAML_KEY"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load SAML_CERT and SAML_KEY: %v", err)
+	}
+	key, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("SAML_KEY must be an RSA private key")
+	}
+	certificate, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SAML_CERT: %v", err)
+	}
+	metadataUrl, _ := url.Parse(externalURL("/api/auth/saml/metadata"))
+	acsUrl, _ := url.Parse(externalURL("/api/auth/saml/acs"))
+	serviceProvider := &saml.ServiceProvider{
+		EntityID:          os.Getenv("SAML_ENTITY_ID"),
+		Key:               key,
+		Certificate:       certificate,
+		MetadataURL:       *metadataUrl,
+		AcsURL:            *acsUrl,
+		AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		SignatureMethod:   "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+	}
+
+	config := &SAMLConfig{
+		DisplayName:        "SAML",
+		IDPMetadata:        idpMetadata,
+		UsernameAttribute:  os.Getenv("SAML_USERNAME_ATTRIBUTE"),
+		FirstNameAttribute: "givenName",
+		LastNameAttribute:  "sn",
+		GroupsAttribute:    "groups",
+		ServiceProvider:    serviceProvider,
+	}
+	if envValue, exists := os.LookupEnv("SAML_DISPLAY_NAME"); exists && envValue != "" {
+		config.DisplayName = envValue
+	}
+	if envValue, exists := os.LookupEnv("SAML_FIRST_NAME_ATTRIBUTE"); exists && envValue != "" {
+		config.FirstNameAttribute = envValue
+	}
+	if envValue, exists := os.LookupEnv("SAML_LAST_NAME_ATTRIBUTE"); exists && envValue != "" {
+		config.LastNameAttribute = envValue
+	}
+	if envValue, exists := os.LookupEnv("SAML_GROUPS_ATTRIBUTE"); exists && envValue != "" {
+		config.GroupsAttribute = envValue
+	}
+	config.Mappings, err = LoadGroupMappings(os.Getenv("SAML_GROUP_MAPPINGS"))
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadIDPMetadata loads the IdP's metadata the first time it is needed, so an unavailable IdP doesn't stop the server
+// from starting
+func (config *SAMLConfig) loadIDPMetadata() error {
+	config.mu.Lock()
+	defer config.mu.Unlock()
+	if config.ServiceProvider.IDPMetadata != nil {
+		return nil
+	}
+	var metadataBytes []byte
+	var err error
+	if strings.HasPrefix(config.IDPMetadata, "http://") || strings.HasPrefix(config.IDPMetadata, "https://") {
+		ctx, cancel := context.WithTimeout(context.Background(), samlMetadataTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.IDPMetadata, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create idp metadata request: %v", err)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to fetch idp metadata: %v", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to fetch idp metadata: status %d", res.StatusCode)
+		}
+		metadataBytes, err = io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read idp metadata: %v", err)
+		}
+	} else {
+		metadataBytes, err = os.ReadFile(config.IDPMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to read idp metadata: %v", err)
+		}
+	}
+	var idpMetadata saml.EntityDescriptor
+	if err := xml.Unmarshal(metadataBytes, &idpMetadata); err != nil {
+		return fmt.Errorf("failed to parse idp metadata: %v", err)
+	}
+	if len(idpMetadata.IDPSSODescriptors) == 0 {
+		return fmt.Errorf("idp metadata has no IDPSSODescriptor")
+	}
+	config.ServiceProvider.IDPMetadata = &idpMetadata
+	return nil
+}
+
+// setSAMLRequestCookie stores the id of the pending authentication request. The IdP POSTs back to the ACS from its own
+// site, so the cookie must be SameSite=None for the browser to send it (which requires HTTPS).
+func setSAMLRequestCookie(c *gin.Context, requestId string, maxAge int) {
+	if envValue, exists := os.LookupEnv("HTTPS_ENABLED"); exists && envValue == "true" {
+		c.SetSameSite(http.SameSiteNoneMode)
+	}
+	setCookie(c, "saml-request", requestId, maxAge)
+	c.SetSameSite(http.SameSiteDefaultMode)
+}
+
+// SAMLMetadata godoc
+//
+//	@Summary		SAML service provider metadata
+//	@Schemes		http https
+//	@Description	The metadata to register Compsole as a service provider with the IdP
+//	@Tags			Auth API
+//	@Produce		xml
+//	@Success		200
+//	@Router			/api/auth/saml/metadata [get]
+func SAMLMetadata(config *SAMLConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		metadataBytes, err := xml.MarshalIndent(config.ServiceProvider.Metadata(), "", "  ")
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Data(http.StatusOK, "application/samlmetadata+xml", metadataBytes)
+	}
+}
+
+// SAMLLogin godoc
+//
+//	@Summary		Login with SAML
+//	@Schemes		http https
+//	@Description	Redirects to the SAML IdP to login
+//	@Tags			Auth API
+//	@Success		302
+//	@Failure		502	{object}	api.APIError
+//	@Router			/api/auth/saml/login [get]
+func SAMLLogin(config *SAMLConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := config.loadIDPMetadata(); err != nil {
+			logrus.Errorf("%v", err)
+			c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "login provider is unavailable"})
+			return
+		}
+		authnRequest, err := config.ServiceProvider.MakeAuthenticationRequest(
+			config.ServiceProvider.GetSSOBindingLocation(saml.HTTPRedirectBinding),
+			saml.HTTPRedirectBinding,
+			saml.HTTPPostBinding,
+		)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		redirectUrl, err := authnRequest.Redirect("", config.ServiceProvider)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		setSAMLRequestCookie(c, authnRequest.ID, oauthCookieTimeout)
+		c.Redirect(http.StatusFound, redirectUrl.String())
+	}
+}
+
+// SAMLACS godoc
+//
+//	@Summary		SAML assertion consumer service
+//	@Schemes		http https
+//	@Description	Validates the signed assertion from the IdP, creating the user if they have never logged in before
+//	@Tags			Auth API
+//	@Accept			x-www-form-urlencoded
+//	@Param			SAMLResponse	formData	string	true	"The response from the IdP"
+//	@Success		302
+//	@Header			302	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Router			/api/auth/saml/acs [post]
+func SAMLACS(client *ent.Client, sessionManager *sessions.Manager, config *SAMLConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId, err := c.Cookie("saml-request")
+		// The request id is single use
+		setSAMLRequestCookie(c, "", 0)
+		if err != nil || requestId == "" {
+			failedSignIn(c, client, nil, "failed saml sign in: missing authentication request", fmt.Errorf("login has expired, please try again"))
+			return
+		}
+		if err := config.loadIDPMetadata(); err != nil {
+			logrus.Errorf("%v", err)
+			failedSignIn(c, client, nil, fmt.Sprintf("failed saml sign in: %v", err), fmt.Errorf("login provider is unavailable"))
+			return
+		}
+		if err := c.Request.ParseForm(); err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed saml sign in: %v", err), fmt.Errorf("invalid saml response"))
+			return
+		}
+
+		assertion, err := config.ServiceProvider.ParseResponse(c.Request, []string{requestId})
+		if err != nil {
+			// The details of why a response is invalid are kept out of the error returned to the client
+			var invalidResponseErr *saml.InvalidResponseError
+			if errors.As(err, &invalidResponseErr) {
+				err = invalidResponseErr.PrivateErr
+			}
+			failedSignIn(c, client, nil, fmt.Sprintf("failed saml sign in: %v", err), fmt.Errorf("invalid saml response"))
+			return
+		}
+
+		attributes := samlAttributes(assertion)
+		username := ""
+		if config.UsernameAttribute != "" {
+			if values := attributes[config.UsernameAttribute]; len(values) > 0 {
+				username = values[0]
+			}
+		} else if assertion.Subject != nil && assertion.Subject.NameID != nil {
+			username = assertion.Subject.NameID.Value
+		}
+		externalUser := &ExternalUser{
+			Issuer:   config.ServiceProvider.IDPMetadata.EntityID,
+			Subject:  samlSubject(assertion),
+			Username: username,
+			Groups:   attributes[config.GroupsAttribute],
+		}
+		if values := attributes[config.FirstNameAttribute]; len(values) > 0 {
+			externalUser.FirstName = values[0]
+		}
+		if values := attributes[config.LastNameAttribute]; len(values) > 0 {
+			externalUser.LastName = values[0]
+		}
+		entUser, err := provisionUser(c, client, sessionManager, user.ProviderSAML, externalUser, config.Mappings)
+		if err != nil {
+			failedSignIn(c, client, nil, fmt.Sprintf("failed saml sign in for \"%s\": %v", username, err), err)
+			return
+		}
+
+		if err = issueSession(c, client, entUser, "saml"); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Redirect(http.StatusFound, loginRedirectURL())
+	}
+}
+
+// samlAttributes collects the values of the assertion's attributes by both their name and friendly name
+func samlAttributes(assertion *saml.Assertion) map[string][]string {
+	attributes := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := make([]string, 0, len(attribute.Values))
+			for _, value := range attribute.Values {
+				values = append(values, value.Value)
+			}
+			attributes[attribute.Name] = append(attributes[attribute.Name], values...)
+			if attribute.FriendlyName != "" && attribute.FriendlyName != attribute.Name {
+				attributes[attribute.FriendlyName] = append(attributes[attribute.FriendlyName], values...)
+			}
+		}
+	}
+	return attributes
+}
+
+// samlSubject returns the assertion's NameID if it's persistent, the only format which stays the same for a user across
+// logins. Users whose IdP sends another format (eg. transient ids or emails) are matched by username.
+func samlSubject(assertion *saml.Assertion) string {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil {
+		return ""
+	}
+	if saml.NameIDFormat(assertion.Subject.NameID.Format) != saml.PersistentNameIDFormat {
+		return ""
+	}
+	return assertion.Subject.NameID.Value
+}
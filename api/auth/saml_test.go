@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/crewjam/saml"
+)
+
+func TestSAMLSubject(t *testing.T) {
+	tests := []struct {
+		name      string
+		assertion *saml.Assertion
+		want      string
+	}{
+		{"persistent", &saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Format: string(saml.PersistentNameIDFormat), Value: "alice-id"}}}, "alice-id"},
+		{"transient", &saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Format: string(saml.TransientNameIDFormat), Value: "_1234"}}}, ""},
+		{"email", &saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Format: string(saml.EmailAddressNameIDFormat), Value: "alice@example.com"}}}, ""},
+		{"no format", &saml.Assertion{Subject: &saml.Subject{NameID: &saml.NameID{Value: "alice"}}}, ""},
+		{"no name id", &saml.Assertion{Subject: &saml.Subject{}}, ""},
+		{"no subject", &saml.Assertion{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := samlSubject(tt.assertion); got != tt.want {
+				t.Errorf("got subject %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/allowlist"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/schedule"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// setCookie sets a cookie on the compsole hostname, secured when HTTPS_ENABLED is set
+func setCookie(c *gin.Context, name string, value string, maxAge int) {
+	hostname, ok := os.LookupEnv("GRAPHQL_HOSTNAME")
+	if !ok {
+		hostname = "localhost"
+	}
+	if envValue, exists := os.LookupEnv("HTTPS_ENABLED"); exists && envValue == "true" {
+		c.SetCookie(name, value, maxAge, "/", hostname, true, true)
+	} else {
+		c.SetCookie(name, value, maxAge, "/", hostname, false, false)
+	}
+}
+
+// clearAuthCookie removes any existing session cookie from the client
+func clearAuthCookie(c *gin.Context) {
+	setCookie(c, "auth-cookie", "", 0)
+}
+
+// issueSession signs a new session token for the user, stores it as a Token, sets the `auth-cookie` and logs the
+// sign in. Every login method creates sessions this way so the rest of the middleware doesn't care how a user
+// authenticated.
+func issueSession(c *gin.Context, client *ent.Client, entUser *ent.User, method string) error {
+	clientIp, err := api.ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+
+	// Competitors can only sign in while their competition is open
+	canSignIn, err := schedule.CanSignIn(c, entUser)
+	if err != nil {
+		logrus.Errorf("failed to check competition schedule: %v", err)
+		return fmt.Errorf("failed to check competition schedule")
+	}
+	if !canSignIn {
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeFAILED_SIGN_IN).
+			SetMessage(fmt.Sprintf("user \"%s\" tried to sign in while their competition is closed", entUser.Username)).
+			SetActionToUser(entUser).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
+		}
+		return fmt.Errorf("your competition is not open right now")
+	}
+
+	// Team accounts can only sign in from their team's and competition's networks
+	allowed, err := allowlist.Check(c, client, entUser, clientIp, "sign in")
+	if err != nil {
+		logrus.Errorf("failed to check ip allowlist: %v", err)
+		return fmt.Errorf("failed to check ip allowlist")
+	}
+	if !allowed {
+		return fmt.Errorf("you can't sign in from this network")
+	}
+
+	cookieTimeout := 60
+	if envValue, exists := os.LookupEnv("COOKIE_TIMEOUT"); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil {
+			cookieTimeout = atoiValue
+		}
+	}
+
+	issuedAt := time.Now()
+	expiresAt := issuedAt.Add(time.Minute * time.Duration(cookieTimeout))
+	claims := &api.CompsoleJWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   entUser.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	tokenString, err := signing.Sign(claims)
+	if err != nil {
+		logrus.Errorf("error signing token: %v", err)
+		return fmt.Errorf("error signing token")
+	}
+
+	_, err = client.Token.Create().
+		SetTokenToUser(entUser).
+		SetExpireAt(expiresAt.Unix()).
+		SetToken(tokenString).
+		SetIPAddress(clientIp).
+		SetUserAgent(c.Request.UserAgent()).
+		SetMethod(method).
+		Save(c)
+	if err != nil {
+		return fmt.Errorf("error updating token")
+	}
+	setCookie(c, "auth-cookie", tokenString, cookieTimeout*60)
+
+	err = client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeSIGN_IN).
+		SetMessage(fmt.Sprintf("user \"%s\" has signed in successfully with %s", entUser.Username, method)).
+		SetActionToUser(entUser).
+		Exec(c)
+	if err != nil {
+		logrus.Warnf("failed to create SIGN_IN action: %v", err)
+	}
+	return nil
+}
+
+// failedSignIn logs a FAILED_SIGN_IN action and rejects the login
+func failedSignIn(c *gin.Context, client *ent.Client, entUser *ent.User, message string, err error) {
+	clientIp, ipErr := api.ForContextIp(c)
+	if ipErr != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", ipErr)
+	}
+	actionCreate := client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeFAILED_SIGN_IN).
+		SetMessage(message)
+	if entUser != nil {
+		actionCreate.SetActionToUser(entUser)
+	}
+	if actionErr := actionCreate.Exec(c); actionErr != nil {
+		logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", actionErr)
+	}
+	clearAuthCookie(c)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+}
+
+// loginThrottled rejects the login with 429 if any of the subjects must wait before trying to login again
+func loginThrottled(c *gin.Context, client *ent.Client, limiter *ratelimit.Limiter, username string, subjects ...ratelimit.Subject) bool {
+	retryAfter := api.CheckLoginRateLimit(c, limiter, subjects...)
+	if retryAfter <= 0 {
+		return false
+	}
+	clientIp, err := api.ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+	err = client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeFAILED_SIGN_IN).
+		SetMessage(fmt.Sprintf("login for user \"%s\" was throttled for %s", username, retryAfter.Round(time.Second))).
+		Exec(c)
+	if err != nil {
+		logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
+	}
+	clearAuthCookie(c)
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": api.TooManyLoginsError(retryAfter).Error()})
+	return true
+}
@@ -0,0 +1,498 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// webauthnSessionTimeout is how long a user has to complete a WebAuthn ceremony
+const webauthnSessionTimeout = 5 * time.Minute
+
+const (
+	// webauthnRegister sessions add a passkey to the signed in user
+	webauthnRegister = "register"
+	// webauthnPasswordless sessions login with a discoverable passkey alone
+	webauthnPasswordless = "passwordless"
+	// webauthnSecondFactor sessions complete a local login which returned `mfa_required`
+	webauthnSecondFactor = "mfa"
+)
+
+// LoadWebAuthnConfig loads the relying party from the environment. The relying party ID defaults to GRAPHQL_HOSTNAME
+// and the allowed origins default to CORS_ALLOWED_ORIGINS plus this server.
+func LoadWebAuthnConfig() (*webauthn.WebAuthn, error) {
+	rpId, exists := os.LookupEnv("WEBAUTHN_RP_ID")
+	if !exists || rpId == "" {
+		rpId = strings.Split(os.Getenv("GRAPHQL_HOSTNAME"), ":")[0]
+		if rpId == "" {
+			rpId = "localhost"
+		}
+	}
+	rpName, exists := os.LookupEnv("WEBAUTHN_RP_NAME")
+	if !exists || rpName == "" {
+		rpName = "Compsole"
+	}
+	var rpOrigins []string
+	if envValue, exists := os.LookupEnv("WEBAUTHN_RP_ORIGINS"); exists && envValue != "" {
+		rpOrigins = strings.Split(envValue, ",")
+	} else {
+		rpOrigins = []string{"http://localhost", "http://localhost:3000"}
+		if envValue, exists := os.LookupEnv("CORS_ALLOWED_ORIGINS"); exists {
+			rpOrigins = strings.Split(envValue, ",")
+		}
+		rpOrigins = append(rpOrigins, strings.TrimSuffix(externalURL("/"), "/"))
+	}
+	for _, origin := range rpOrigins {
+		if _, err := url.Parse(origin); err != nil {
+			return nil, fmt.Errorf("failed to parse webauthn origin \"%s\": %v", origin, err)
+		}
+	}
+	return webauthn.New(&webauthn.Config{
+		RPID:          rpId,
+		RPDisplayName: rpName,
+		RPOrigins:     rpOrigins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationPreferred,
+		},
+	})
+}
+
+// webauthnUser adapts a user and their passkeys to the webauthn library
+type webauthnUser struct {
+	entUser     *ent.User
+	credentials []*ent.WebauthnCredential
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return u.entUser.ID[:]
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.entUser.Username
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	displayName := strings.TrimSpace(u.entUser.FirstName + " " + u.entUser.LastName)
+	if displayName == "" {
+		return u.entUser.Username
+	}
+	return displayName
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.credentials))
+	for i, entCredential := range u.credentials {
+		transports := make([]protocol.AuthenticatorTransport, len(entCredential.Transports))
+		for j, transport := range entCredential.Transports {
+			transports[j] = protocol.AuthenticatorTransport(transport)
+		}
+		credentials[i] = webauthn.Credential{
+			ID:              entCredential.CredentialID,
+			PublicKey:       entCredential.PublicKey,
+			AttestationType: entCredential.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: entCredential.BackupEligible,
+				BackupState:    entCredential.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    entCredential.Aaguid,
+				SignCount: entCredential.SignCount,
+			},
+		}
+	}
+	return credentials
+}
+
+// credential returns the stored passkey matching the credential ID the authenticator used
+func (u *webauthnUser) credential(credentialId []byte) *ent.WebauthnCredential {
+	for _, entCredential := range u.credentials {
+		if string(entCredential.CredentialID) == string(credentialId) {
+			return entCredential
+		}
+	}
+	return nil
+}
+
+// loadWebauthnUser loads the user's passkeys. Only local accounts can use passkeys, external providers are responsible
+// for their own authentication.
+func loadWebauthnUser(c *gin.Context, entUser *ent.User) (*webauthnUser, error) {
+	if entUser.Provider != user.ProviderLOCAL {
+		return nil, fmt.Errorf("passkeys are only available for local accounts")
+	}
+	entCredentials, err := entUser.QueryUserToWebauthnCredentials().All(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query passkeys: %v", err)
+	}
+	return &webauthnUser{entUser: entUser, credentials: entCredentials}, nil
+}
+
+// webauthnCeremony is a WebAuthn ceremony in progress and what it was started for
+type webauthnCeremony struct {
+	Purpose string               `json:"purpose"`
+	Session webauthn.SessionData `json:"session"`
+}
+
+// saveWebauthnSession keeps the ceremony's challenge server side until it is finished. The `webauthn-session` cookie
+// only holds its id.
+func saveWebauthnSession(c *gin.Context, challenges *challenge.Store, purpose string, session *webauthn.SessionData) error {
+	ceremonyId, err := challenges.Create(c, challenge.KindWebAuthn, &webauthnCeremony{Purpose: purpose, Session: *session}, webauthnSessionTimeout)
+	if err != nil {
+		logrus.Errorf("failed to save webauthn session: %v", err)
+		return fmt.Errorf("failed to save passkey ceremony")
+	}
+	setCookie(c, "webauthn-session", ceremonyId, int(webauthnSessionTimeout.Seconds()))
+	return nil
+}
+
+// loadWebauthnSession returns the ceremony in progress and what it was started for. The session is consumed, so each
+// ceremony can only be finished once.
+func loadWebauthnSession(c *gin.Context, challenges *challenge.Store) (string, *webauthn.SessionData, error) {
+	ceremonyId, err := c.Cookie("webauthn-session")
+	setCookie(c, "webauthn-session", "", 0)
+	if err != nil || ceremonyId == "" {
+		return "", nil, fmt.Errorf("passkey ceremony has expired, please try again")
+	}
+	ceremony := &webauthnCeremony{}
+	found, err := challenges.Consume(c, challenge.KindWebAuthn, ceremonyId, ceremony)
+	if err != nil {
+		logrus.Errorf("failed to load webauthn session: %v", err)
+		return "", nil, fmt.Errorf("failed to load passkey ceremony")
+	}
+	if !found {
+		return "", nil, fmt.Errorf("passkey ceremony has expired, please try again")
+	}
+	return ceremony.Purpose, &ceremony.Session, nil
+}
+
+// useWebauthnCredential records the state the authenticator reported after a successful assertion
+func useWebauthnCredential(c *gin.Context, waUser *webauthnUser, credential *webauthn.Credential) error {
+	if credential.Authenticator.CloneWarning {
+		return fmt.Errorf("signature counter went backwards, the authenticator may have been cloned")
+	}
+	entCredential := waUser.credential(credential.ID)
+	if entCredential == nil {
+		return fmt.Errorf("passkey not found")
+	}
+	return entCredential.Update().
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupState(credential.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Exec(c)
+}
+
+// WebAuthnRegisterBegin godoc
+//
+//	@Summary		Start registering a passkey
+//	@Schemes		http https
+//	@Description	Returns the options to pass to `navigator.credentials.create()` for the signed in user
+//	@Tags			Auth API
+//	@Produce		json
+//	@Success		200	{object}	protocol.CredentialCreation
+//	@Header			200	{string}	Cookie	"`webauthn-session` identifies the registration challenge"
+//	@Failure		400	{object}	api.APIError
+//	@Router			/api/auth/webauthn/register/begin [post]
+func WebAuthnRegisterBegin(client *ent.Client, wa *webauthn.WebAuthn, challenges *challenge.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, err := api.ForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get user from context", err)
+			return
+		}
+		waUser, err := loadWebauthnUser(c, authUser)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadRequest, "failed to start passkey registration", err)
+			return
+		}
+		exclusions := make([]protocol.CredentialDescriptor, len(waUser.credentials))
+		for i, credential := range waUser.WebAuthnCredentials() {
+			exclusions[i] = credential.Descriptor()
+		}
+		creation, session, err := wa.BeginRegistration(waUser, webauthn.WithExclusions(exclusions))
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to start passkey registration", err)
+			return
+		}
+		if err = saveWebauthnSession(c, challenges, webauthnRegister, session); err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to start passkey registration", err)
+			return
+		}
+		c.JSON(200, creation)
+	}
+}
+
+// WebAuthnRegisterFinish godoc
+//
+//	@Summary		Finish registering a passkey
+//	@Schemes		http https
+//	@Description	Verifies the response from `navigator.credentials.create()` and saves the passkey
+//	@Tags			Auth API
+//	@Accept			json
+//	@Param			name	query	string	false	"The display name of the passkey"
+//	@Produce		json
+//	@Success		201	{object}	auth.WebAuthnCredentialModel
+//	@Failure		400	{object}	api.APIError
+//	@Router			/api/auth/webauthn/register/finish [post]
+func WebAuthnRegisterFinish(client *ent.Client, wa *webauthn.WebAuthn, challenges *challenge.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authUser, err := api.ForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get user from context", err)
+			return
+		}
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
+		}
+		purpose, session, err := loadWebauthnSession(c, challenges)
+		if err == nil && purpose != webauthnRegister {
+			err = fmt.Errorf("passkey ceremony was not started for registration")
+		}
+		if err != nil {
+			api.ReturnError(c, http.StatusBadRequest, "failed to register passkey", err)
+			return
+		}
+		waUser, err := loadWebauthnUser(c, authUser)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadRequest, "failed to register passkey", err)
+			return
+		}
+		credential, err := wa.FinishRegistration(waUser, *session, c.Request)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadRequest, "failed to register passkey", err)
+			return
+		}
+		transports := make([]string, len(credential.Transport))
+		for i, transport := range credential.Transport {
+			transports[i] = string(transport)
+		}
+		name := strings.TrimSpace(c.Query("name"))
+		if name == "" {
+			name = "Passkey"
+		}
+		entCredential, err := client.WebauthnCredential.Create().
+			SetName(name).
+			SetCredentialID(credential.ID).
+			SetPublicKey(credential.PublicKey).
+			SetAttestationType(credential.AttestationType).
+			SetAaguid(credential.Authenticator.AAGUID).
+			SetSignCount(credential.Authenticator.SignCount).
+			SetTransports(transports).
+			SetBackupEligible(credential.Flags.BackupEligible).
+			SetBackupState(credential.Flags.BackupState).
+			SetWebauthnCredentialToUser(authUser).
+			Save(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to save passkey", err)
+			return
+		}
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeMFA_ENROLL).
+			SetMessage(fmt.Sprintf("registered passkey \"%s\" for user %s", entCredential.Name, authUser.Username)).
+			SetActionToUser(authUser).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to create MFA_ENROLL action: %v", err)
+		}
+		c.JSON(http.StatusCreated, WebAuthnCredentialModel{
+			ID:   entCredential.ID.String(),
+			Name: entCredential.Name,
+		})
+	}
+}
+
+// WebAuthnCredentialModel model info
+//
+//	@Description	A newly registered passkey
+type WebAuthnCredentialModel struct {
+	ID   string `json:"id" example:"2d4e8f3a-51b4-4a06-9ad5-7cc7b6cb9a2f"` // The ID of the passkey
+	Name string `json:"name" example:"YubiKey"`                            // The display name of the passkey
+}
+
+// WebAuthnLoginBegin godoc
+//
+//	@Summary		Start a passkey login
+//	@Schemes		http https
+//	@Description	Returns the options to pass to `navigator.credentials.get()`. If a local login returned `mfa_required`,
+//	@Description	the passkey is used as the second factor. Otherwise any discoverable passkey can login without a password.
+//	@Tags			Auth API
+//	@Produce		json
+//	@Success		200	{object}	protocol.CredentialAssertion
+//	@Header			200	{string}	Cookie	"`webauthn-session` identifies the login challenge"
+//	@Failure		401	{object}	api.APIError
+//	@Router			/api/auth/webauthn/login/begin [post]
+func WebAuthnLoginBegin(client *ent.Client, wa *webauthn.WebAuthn, challenges *challenge.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var assertion *protocol.CredentialAssertion
+		var session *webauthn.SessionData
+		purpose := webauthnPasswordless
+
+		if entUser, err := mfaChallengeUser(c, client, challenges, false); err == nil {
+			waUser, err := loadWebauthnUser(c, entUser)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			if len(waUser.credentials) == 0 {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "No passkeys are registered for this account"})
+				return
+			}
+			purpose = webauthnSecondFactor
+			assertion, session, err = wa.BeginLogin(waUser)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+		} else {
+			// Without a password the passkey must also verify the user (eg. with a PIN or biometric)
+			assertion, session, err = wa.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		if err := saveWebauthnSession(c, challenges, purpose, session); err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(200, assertion)
+	}
+}
+
+// WebAuthnLoginFinish godoc
+//
+//	@Summary		Finish a passkey login
+//	@Schemes		http https
+//	@Description	Verifies the response from `navigator.credentials.get()` and signs the user in
+//	@Tags			Auth API
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	auth.UserModel
+//	@Header			200	{string}	Cookie	"`auth-cookie` contains the session token"
+//	@Failure		401	{object}	api.APIError
+//	@Router			/api/auth/webauthn/login/finish [post]
+func WebAuthnLoginFinish(client *ent.Client, wa *webauthn.WebAuthn, challenges *challenge.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		purpose, session, err := loadWebauthnSession(c, challenges)
+		if err == nil && purpose != webauthnPasswordless && purpose != webauthnSecondFactor {
+			err = fmt.Errorf("passkey ceremony was not started for login")
+		}
+		if err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if purpose == webauthnSecondFactor {
+			webAuthnSecondFactor(c, client, wa, challenges, session)
+			return
+		}
+
+		var waUser *webauthnUser
+		credential, err := wa.FinishDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+			userUuid, err := uuid.FromBytes(userHandle)
+			if err != nil {
+				return nil, fmt.Errorf("invalid user handle")
+			}
+			entUser, err := client.User.Query().
+				Where(
+					user.IDEQ(userUuid),
+					user.ProviderEQ(user.ProviderLOCAL),
+				).
+				WithUserToTeam().
+				Only(c)
+			if err != nil {
+				return nil, fmt.Errorf("user not found")
+			}
+			waUser, err = loadWebauthnUser(c, entUser)
+			if err != nil {
+				return nil, err
+			}
+			return waUser, nil
+		}, *session, c.Request)
+		if err == nil {
+			err = useWebauthnCredential(c, waUser, credential)
+		}
+		if err != nil {
+			var entUser *ent.User
+			if waUser != nil {
+				entUser = waUser.entUser
+			}
+			failedSignIn(c, client, entUser, fmt.Sprintf("failed passkey sign in: %v", err), fmt.Errorf("Invalid passkey"))
+			return
+		}
+
+		if err = issueSession(c, client, waUser.entUser, fmt.Sprintf("passkey \"%s\"", waUser.credential(credential.ID).Name)); err != nil {
+			clearAuthCookie(c)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		waUser.entUser.Password = ""
+		c.JSON(200, UserEntToModel(waUser.entUser))
+	}
+}
+
+// webAuthnSecondFactor completes a local login with a passkey
+func webAuthnSecondFactor(c *gin.Context, client *ent.Client, wa *webauthn.WebAuthn, challenges *challenge.Store, session *webauthn.SessionData) {
+	// Each challenge only gets one attempt, a failure sends the user back to the password step
+	entUser, err := mfaChallengeUser(c, client, challenges, true)
+	setCookie(c, "mfa-cookie", "", 0)
+	if err != nil {
+		clearAuthCookie(c)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	waUser, err := loadWebauthnUser(c, entUser)
+	if err == nil {
+		var credential *webauthn.Credential
+		credential, err = wa.FinishLogin(waUser, *session, c.Request)
+		if err == nil {
+			err = useWebauthnCredential(c, waUser, credential)
+		}
+		if err == nil {
+			err = issueSession(c, client, entUser, fmt.Sprintf("local and passkey \"%s\"", waUser.credential(credential.ID).Name))
+			if err != nil {
+				clearAuthCookie(c)
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			entUser.Password = ""
+			c.JSON(200, UserEntToModel(entUser))
+			return
+		}
+	}
+
+	clientIp, ipErr := api.ForContextIp(c)
+	if ipErr != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", ipErr)
+	}
+	actionErr := client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeFAILED_MFA).
+		SetMessage(fmt.Sprintf("invalid passkey for user \"%s\": %v", entUser.Username, err)).
+		SetActionToUser(entUser).
+		Exec(c)
+	if actionErr != nil {
+		logrus.Warnf("failed to create FAILED_MFA action: %v", actionErr)
+	}
+	clearAuthCookie(c)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid passkey"})
+}
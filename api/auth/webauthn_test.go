@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+func TestWebAuthnSessionIsSingleUse(t *testing.T) {
+	_, client := newTestClient(t)
+	challenges := challenge.New(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+	wa, err := LoadWebAuthnConfig()
+	if err != nil {
+		t.Fatalf("failed to load webauthn config: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(api.UnauthenticatedMiddleware(), api.AnonymousMiddleware())
+	router.POST("/webauthn/login/begin", WebAuthnLoginBegin(client, wa, challenges))
+	router.POST("/webauthn/login/finish", WebAuthnLoginFinish(client, wa, challenges))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webauthn/login/begin", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to begin login: %d %s", w.Code, w.Body.String())
+	}
+	var sessionCookie *http.Cookie
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == "webauthn-session" {
+			sessionCookie = cookie
+		}
+	}
+	if sessionCookie == nil || sessionCookie.Value == "" {
+		t.Fatalf("begin didn't set the webauthn-session cookie")
+	}
+
+	// Replaying the same `webauthn-session` must not reuse the ceremony's challenge
+	for i, wantError := range []string{"Invalid passkey", "passkey ceremony has expired"} {
+		req := httptest.NewRequest(http.MethodPost, "/webauthn/login/finish", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "webauthn-session", Value: sessionCookie.Value})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized || !strings.Contains(w.Body.String(), wantError) {
+			t.Errorf("attempt %d: got %d %s, want 401 %q", i+1, w.Code, w.Body.String(), wantError)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/allowlist"
+	"github.com/BradHacker/compsole/compsole/mfa"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/schedule"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// RegisterConsoleEndpoints registers the consoles which are proxied through Compsole instead of being handed to the browser
+func RegisterConsoleEndpoints(client *ent.Client, rdb *redis.Client, providerMap *providers.ProviderMap, limiter *ratelimit.Limiter, r *gin.RouterGroup) {
+	r.GET("/serial/:id", SerialConsole(client, rdb, providerMap))
+	r.GET("/ssh/:id", GuacamoleConsole(client, rdb, vmcredential.ProtocolSSH))
+	r.GET("/rdp/:id", GuacamoleConsole(client, rdb, vmcredential.ProtocolRDP))
+	// Share links don't require an account
+	r.GET("/share/:token", api.AnonymousMiddleware(), SharedConsoleInfo(client))
+	r.POST("/share/:token/ticket", api.AnonymousMiddleware(), SharedConsoleTicket(client, limiter))
+	r.GET("/share/:token/view", api.AnonymousMiddleware(), SharedConsole(client))
+}
+
+// CloseStaleConsoleSessions ends any proxied console sessions left open by a previous run of the server so they
+// don't count against console limits
+func CloseStaleConsoleSessions(ctx context.Context, client *ent.Client) error {
+	_, err := client.ConsoleSession.Update().
+		Where(consolesession.EndedAtIsNil()).
+		SetEndedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// upgrader is shared by all of the proxied consoles. Origins are restricted to CORS_ALLOWED_ORIGINS
+// since the session cookie is sent along with the websocket handshake.
+var upgrader = websocket.Upgrader{
+	HandshakeTimeout: 30 * time.Second,
+	ReadBufferSize:   4096,
+	WriteBufferSize:  4096,
+	CheckOrigin:      checkOrigin,
+}
+
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	allowedOrigins := []string{"http://localhost", "http://localhost:3000"}
+	if envValue, exists := os.LookupEnv("CORS_ALLOWED_ORIGINS"); exists {
+		allowedOrigins = strings.Split(envValue, ",")
+	}
+	for _, allowedOrigin := range allowedOrigins {
+		if strings.EqualFold(strings.TrimSpace(allowedOrigin), origin) {
+			return true
+		}
+	}
+	// Same-origin requests are always allowed
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// authorizeConsole performs the same access checks as the Console query for the vm object in the `id` path parameter
+func authorizeConsole(c *gin.Context, client *ent.Client) (*ent.User, *ent.VmObject, int, error) {
+	entUser, err := api.ForContext(c.Request.Context())
+	if err != nil {
+		return nil, nil, http.StatusUnauthorized, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	if _, err := api.ForContextImpersonator(c.Request.Context()); err == nil {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("consoles can't be opened while impersonating a user")
+	}
+	if entUser.MustChangePassword {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("password must be changed before continuing")
+	}
+	enrollmentRequired, err := mfa.EnrollmentRequired(c, entUser)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, err
+	}
+	if enrollmentRequired {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("multi-factor authentication must be set up before continuing")
+	}
+	clientIp, err := api.ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+	allowed, err := allowlist.Check(c, client, entUser, clientIp, "open a console")
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, fmt.Errorf("failed to check ip allowlist: %v", err)
+	}
+	if !allowed {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("consoles can't be opened from this network")
+	}
+	vmObjectUuid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, nil, http.StatusUnprocessableEntity, fmt.Errorf("failed to parse vm object uuid: %v", err)
+	}
+	entVmObject, err := client.VmObject.Get(c, vmObjectUuid)
+	if ent.IsNotFound(err) {
+		return nil, nil, http.StatusNotFound, fmt.Errorf("vm object not found")
+	}
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	// Check if user has access to VM
+	canAccessVm, err := utils.UserCanAccessVM(c, entVmObject, entUser, permissions.VmConsole)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, fmt.Errorf("failed to check access to vm: %v", err)
+	}
+	if !canAccessVm {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("user does not have permission to access this vm")
+	}
+	lockedOut, err := utils.UserIsLockedOut(c, entVmObject, entUser, permissions.VmConsole)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("VM is currently locked out")
+	}
+	canUseVm, err := schedule.CanUseVmObject(viewer.SystemContext(c), entUser, entVmObject)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, fmt.Errorf("failed to check competition schedule: %v", err)
+	}
+	if !canUseVm {
+		return nil, nil, http.StatusForbidden, fmt.Errorf("competition is not open right now")
+	}
+	// Checked again when the session is created, this rejects the request before connecting to the console
+	if err := utils.CheckConsoleLimits(c, entVmObject, entUser); err != nil {
+		if _, ok := err.(*utils.ConsoleLimitError); ok {
+			return nil, nil, http.StatusTooManyRequests, err
+		}
+		return nil, nil, http.StatusInternalServerError, err
+	}
+	return entUser, entVmObject, http.StatusOK, nil
+}
+
+// openConsoleSession saves the session of a console whose websocket has been opened, closing the websocket if the
+// session can't be created
+func openConsoleSession(c *gin.Context, conn *websocket.Conn, rdb *redis.Client, entUser *ent.User, entVmObject *ent.VmObject, consoleSessionCreate *ent.ConsoleSessionCreate) (*ent.ConsoleSession, bool) {
+	entConsoleSession, err := utils.OpenConsoleSession(c, rdb, entVmObject, entUser, consoleSessionCreate)
+	if _, ok := err.(*utils.ConsoleLimitError); ok {
+		// Another session was opened since the limits were checked by authorizeConsole
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "console limit reached"), time.Now().Add(time.Second))
+		return nil, false
+	}
+	if err != nil {
+		logrus.Errorf("failed to create console session: %v", err)
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "failed to create console session"), time.Now().Add(time.Second))
+		return nil, false
+	}
+	return entConsoleSession, true
+}
@@ -0,0 +1,262 @@
+package console
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/guacamole"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// maxGuacamoleMessageSize is the point at which buffered guacd output is flushed to the browser
+const maxGuacamoleMessageSize = 8192
+
+// GuacamoleConsole godoc
+//
+//	@Summary		Open an SSH or RDP console for a VM Object
+//	@Schemes		ws wss
+//	@Description	Upgrades to a websocket which is bridged to guacd using the VM's admin-managed credentials. Compatible with the guacamole-common-js WebSocketTunnel.
+//	@Tags			Console API
+//	@Param			id		path	string	true	"The id of the vm object"	format(uuid)	example(xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+//	@Param			width	query	int		false	"The width of the display in pixels"	default(1024)
+//	@Param			height	query	int		false	"The height of the display in pixels"	default(768)
+//	@Param			dpi		query	int		false	"The resolution of the display"	default(96)
+//	@Success		101
+//	@Failure		401	{object}	api.APIError
+//	@Failure		403	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		422	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/console/ssh/{id} [get]
+//	@Router			/console/rdp/{id} [get]
+func GuacamoleConsole(client *ent.Client, rdb *redis.Client, protocol vmcredential.Protocol) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entUser, entVmObject, status, err := authorizeConsole(c, client)
+		if err != nil {
+			api.ReturnError(c, status, "unable to open console", err)
+			return
+		}
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("unable to get ip from context: %v", err)
+		}
+
+		entVmCredential, err := entVmObject.QueryVmObjectToVmCredentials().Where(vmcredential.ProtocolEQ(protocol)).Only(c)
+		if ent.IsNotFound(err) {
+			api.ReturnError(c, http.StatusNotFound, "unable to open console", fmt.Errorf("no %s credentials have been configured for this vm", protocol))
+			return
+		}
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query vm credentials", err)
+			return
+		}
+		if len(entVmObject.IPAddresses) == 0 {
+			api.ReturnError(c, http.StatusUnprocessableEntity, "unable to open console", fmt.Errorf("vm has no ip addresses"))
+			return
+		}
+		config := guacamoleConfig(c, entVmObject.IPAddresses[0], entVmCredential)
+
+		guacdAddress := "localhost:4822"
+		if envValue, exists := os.LookupEnv("GUACD_ADDRESS"); exists {
+			guacdAddress = envValue
+		}
+		stream, err := guacamole.Dial(c, guacdAddress, config)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadGateway, "failed to connect to guacd", err)
+			return
+		}
+		defer stream.Close()
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, http.Header{
+			"Sec-Websocket-Protocol": []string{"guacamole"},
+		})
+		if err != nil {
+			// Upgrade has already written an error response
+			logrus.Warnf("failed to upgrade guacamole console connection: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		consoleType := utils.GuacamoleSSHConsole
+		if protocol == vmcredential.ProtocolRDP {
+			consoleType = utils.GuacamoleRDPConsole
+		}
+		entConsoleSession, ok := openConsoleSession(c, conn, rdb, entUser, entVmObject, client.ConsoleSession.Create().
+			SetConsoleType(string(consoleType)).
+			SetIPAddress(clientIp))
+		if !ok {
+			return
+		}
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeCONSOLE_ACCESS).
+			SetMessage(fmt.Sprintf("opened %s console for vm %s", strings.ToLower(string(protocol)), entVmObject.Name)).
+			SetActionToUser(entUser).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
+		}
+
+		live := liveSessions.open(entVmObject.ID, consoleType, stream.ConnectionID)
+		bridgeGuacamole(conn, stream, false)
+		liveSessions.close(live)
+
+		err = entConsoleSession.Update().SetEndedAt(time.Now()).Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to end console session: %v", err)
+		}
+	}
+}
+
+// guacamoleConfig builds the guacd connection for the vm credential using the display size requested by the client
+func guacamoleConfig(c *gin.Context, hostname string, entVmCredential *ent.VmCredential) *guacamole.Config {
+	queryInt := func(key string, defaultValue int) int {
+		if value, err := strconv.Atoi(c.Query(key)); err == nil && value > 0 {
+			return value
+		}
+		return defaultValue
+	}
+	parameters := map[string]string{
+		"hostname": hostname,
+		"username": entVmCredential.Username,
+		"password": entVmCredential.Password,
+	}
+	if entVmCredential.Port > 0 {
+		parameters["port"] = strconv.Itoa(entVmCredential.Port)
+	}
+	switch entVmCredential.Protocol {
+	case vmcredential.ProtocolSSH:
+		parameters["private-key"] = entVmCredential.PrivateKey
+	case vmcredential.ProtocolRDP:
+		parameters["domain"] = entVmCredential.Domain
+		parameters["security"] = "any"
+		if entVmCredential.IgnoreCert {
+			parameters["ignore-cert"] = "true"
+		}
+	}
+	return &guacamole.Config{
+		Protocol:       strings.ToLower(string(entVmCredential.Protocol)),
+		Parameters:     parameters,
+		Width:          queryInt("width", 1024),
+		Height:         queryInt("height", 768),
+		DPI:            queryInt("dpi", 96),
+		Timezone:       c.Query("timezone"),
+		AudioMimetypes: c.QueryArray("audio"),
+		VideoMimetypes: c.QueryArray("video"),
+		ImageMimetypes: []string{"image/png", "image/jpeg", "image/webp"},
+	}
+}
+
+// readOnlyOpcodes are the only instructions forwarded to guacd from read-only viewers. Everything else
+// (keyboard, mouse, clipboard, file transfers, etc.) is dropped.
+var readOnlyOpcodes = map[string]bool{
+	"sync":       true,
+	"nop":        true,
+	"ack":        true,
+	"disconnect": true,
+}
+
+// filterClientInstructions splits the instructions sent by the browser into the ones forwarded to guacd and the
+// internal pings the tunnel answers itself. Other internal instructions are dropped, as are the instructions
+// read-only viewers can't send (see readOnlyOpcodes).
+func filterClientInstructions(instructions []*guacamole.Instruction, readOnly bool) ([]*guacamole.Instruction, []*guacamole.Instruction) {
+	forward := make([]*guacamole.Instruction, 0, len(instructions))
+	pings := make([]*guacamole.Instruction, 0)
+	for _, instruction := range instructions {
+		if instruction.Opcode == guacamole.InternalOpcode {
+			if len(instruction.Args) > 0 && instruction.Args[0] == "ping" {
+				pings = append(pings, instruction)
+			}
+			continue
+		}
+		if readOnly && !readOnlyOpcodes[instruction.Opcode] {
+			continue
+		}
+		forward = append(forward, instruction)
+	}
+	return forward, pings
+}
+
+// bridgeGuacamole forwards instructions between the browser's websocket tunnel and guacd until either side disconnects
+func bridgeGuacamole(conn *websocket.Conn, stream *guacamole.Stream, readOnly bool) {
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() { once.Do(func() { close(done) }) }
+	var writeMu sync.Mutex
+	writeText := func(data string) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return conn.WriteMessage(websocket.TextMessage, []byte(data))
+	}
+
+	// The tunnel's uuid must be the first thing the client receives
+	if err := writeText(guacamole.NewInstruction(guacamole.InternalOpcode, stream.ConnectionID).String()); err != nil {
+		return
+	}
+
+	// Client -> guacd
+	go func() {
+		defer stop()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			instructions, err := guacamole.ParseInstructions(string(data))
+			if err != nil {
+				logrus.Warnf("dropping malformed guacamole message: %v", err)
+				continue
+			}
+			forward, pings := filterClientInstructions(instructions, readOnly)
+			for _, ping := range pings {
+				if err := writeText(ping.String()); err != nil {
+					return
+				}
+			}
+			if len(forward) == 0 {
+				continue
+			}
+			if err := stream.WriteInstructions(forward...); err != nil {
+				return
+			}
+		}
+	}()
+	// guacd -> Client
+	go func() {
+		defer stop()
+		var message strings.Builder
+		for {
+			instruction, err := stream.ReadInstruction()
+			if err != nil {
+				return
+			}
+			message.WriteString(instruction.String())
+			// Only complete instructions may be sent, batch them until guacd has nothing else buffered
+			if stream.Buffered() > 0 && message.Len() < maxGuacamoleMessageSize {
+				continue
+			}
+			if err := writeText(message.String()); err != nil {
+				return
+			}
+			message.Reset()
+		}
+	}()
+
+	<-done
+	deadline := time.Now().Add(time.Second)
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+	stream.WriteInstructions(guacamole.NewInstruction("disconnect"))
+}
@@ -0,0 +1,66 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/BradHacker/compsole/compsole/guacamole"
+)
+
+func TestFilterClientInstructions(t *testing.T) {
+	tests := []struct {
+		name                string
+		instruction         *guacamole.Instruction
+		wantForwarded       bool
+		wantForwardReadOnly bool
+		wantPing            bool
+	}{
+		{"sync", guacamole.NewInstruction("sync", "1700000000"), true, true, false},
+		{"nop", guacamole.NewInstruction("nop"), true, true, false},
+		{"ack", guacamole.NewInstruction("ack", "1", "OK", "0"), true, true, false},
+		{"disconnect", guacamole.NewInstruction("disconnect"), true, true, false},
+		{"key", guacamole.NewInstruction("key", "65", "1"), true, false, false},
+		{"mouse", guacamole.NewInstruction("mouse", "100", "200", "1"), true, false, false},
+		{"size", guacamole.NewInstruction("size", "1024", "768"), true, false, false},
+		{"clipboard", guacamole.NewInstruction("clipboard", "1", "text/plain"), true, false, false},
+		{"file upload", guacamole.NewInstruction("file", "1", "text/plain", "notes.txt"), true, false, false},
+		{"blob", guacamole.NewInstruction("blob", "1", "aGVsbG8="), true, false, false},
+		{"end", guacamole.NewInstruction("end", "1"), true, false, false},
+		{"internal ping", guacamole.NewInstruction(guacamole.InternalOpcode, "ping", "1700000000"), false, false, true},
+		{"other internal instruction", guacamole.NewInstruction(guacamole.InternalOpcode, "uuid"), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, readOnly := range []bool{false, true} {
+				wantForwarded := tt.wantForwarded
+				if readOnly {
+					wantForwarded = tt.wantForwardReadOnly
+				}
+				forward, pings := filterClientInstructions([]*guacamole.Instruction{tt.instruction}, readOnly)
+				if forwarded := len(forward) == 1; forwarded != wantForwarded {
+					t.Errorf("read only %v: got forwarded %v, want %v", readOnly, forwarded, wantForwarded)
+				}
+				if ping := len(pings) == 1; ping != tt.wantPing {
+					t.Errorf("read only %v: got ping %v, want %v", readOnly, ping, tt.wantPing)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterClientInstructionsKeepsOrder(t *testing.T) {
+	instructions, err := guacamole.ParseInstructions("4.sync,1.1;3.key,2.65,1.1;0.,4.ping,1.2;3.nop;4.sync,1.3;")
+	if err != nil {
+		t.Fatalf("failed to parse instructions: %v", err)
+	}
+	forward, pings := filterClientInstructions(instructions, true)
+	got := ""
+	for _, instruction := range forward {
+		got += instruction.String()
+	}
+	if want := "4.sync,1.1;3.nop;4.sync,1.3;"; got != want {
+		t.Errorf("got forwarded %q, want %q", got, want)
+	}
+	if len(pings) != 1 || pings[0].String() != "0.,4.ping,1.2;" {
+		t.Errorf("got pings %v, want the one ping", pings)
+	}
+}
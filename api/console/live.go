@@ -0,0 +1,111 @@
+package console
+
+import (
+	"sync"
+
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/google/uuid"
+)
+
+// viewerBufferSize is the number of output frames buffered per viewer before frames are dropped
+const viewerBufferSize = 256
+
+// liveSession is a proxied console which is currently open on this server. Share links attach to these
+// as read-only viewers instead of opening a new connection to the VM.
+type liveSession struct {
+	vmObjectId  uuid.UUID
+	consoleType utils.ConsoleType
+	// guacdConnectionId is set for guacamole consoles so viewers can join the connection through guacd
+	guacdConnectionId string
+
+	mu      sync.Mutex
+	viewers map[chan []byte]struct{}
+}
+
+// subscribe returns a channel which receives the session's output until unsubscribe is called
+func (s *liveSession) subscribe() chan []byte {
+	ch := make(chan []byte, viewerBufferSize)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.viewers[ch] = struct{}{}
+	return ch
+}
+
+func (s *liveSession) unsubscribe(ch chan []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.viewers[ch]; ok {
+		delete(s.viewers, ch)
+		close(ch)
+	}
+}
+
+// publish sends the output to every viewer. Viewers which have fallen behind miss the frame rather than
+// slowing down the session.
+func (s *liveSession) publish(data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.viewers {
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// close disconnects all of the viewers
+func (s *liveSession) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.viewers {
+		delete(s.viewers, ch)
+		close(ch)
+	}
+}
+
+// liveSessionRegistry tracks the proxied consoles open on this server
+type liveSessionRegistry struct {
+	mu       sync.Mutex
+	sessions []*liveSession
+}
+
+var liveSessions = &liveSessionRegistry{}
+
+// open registers a new live session
+func (r *liveSessionRegistry) open(vmObjectId uuid.UUID, consoleType utils.ConsoleType, guacdConnectionId string) *liveSession {
+	s := &liveSession{
+		vmObjectId:        vmObjectId,
+		consoleType:       consoleType,
+		guacdConnectionId: guacdConnectionId,
+		viewers:           make(map[chan []byte]struct{}),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions = append(r.sessions, s)
+	return s
+}
+
+// close removes the live session and disconnects its viewers
+func (r *liveSessionRegistry) close(s *liveSession) {
+	r.mu.Lock()
+	for i, session := range r.sessions {
+		if session == s {
+			r.sessions = append(r.sessions[:i], r.sessions[i+1:]...)
+			break
+		}
+	}
+	r.mu.Unlock()
+	s.close()
+}
+
+// latest returns the most recently opened live session of the console type on the vm object
+func (r *liveSessionRegistry) latest(vmObjectId uuid.UUID, consoleType utils.ConsoleType) *liveSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.sessions) - 1; i >= 0; i-- {
+		if r.sessions[i].vmObjectId == vmObjectId && r.sessions[i].consoleType == consoleType {
+			return r.sessions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pingInterval          = 30 * time.Second
+	transcriptFlushPeriod = 10 * time.Second
+)
+
+// SerialConsole godoc
+//
+//	@Summary		Open a serial console for a VM Object
+//	@Schemes		ws wss
+//	@Description	Upgrades to a websocket which is bridged to the provider's serial console. Frames sent by the client are forwarded as keyboard input and console output is returned as binary frames, compatible with the xterm.js attach addon.
+//	@Tags			Console API
+//	@Param			id	path	string	true	"The id of the vm object"	format(uuid)	example(xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+//	@Success		101
+//	@Failure		401	{object}	api.APIError
+//	@Failure		403	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/console/serial/{id} [get]
+func SerialConsole(client *ent.Client, rdb *redis.Client, providerMap *providers.ProviderMap) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entUser, entVmObject, status, err := authorizeConsole(c, client)
+		if err != nil {
+			api.ReturnError(c, status, "unable to open serial console", err)
+			return
+		}
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("unable to get ip from context: %v", err)
+		}
+
+		provider, err := providerMap.ForVmObject(c, entVmObject)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to load provider", err)
+			return
+		}
+		consoleUrl, err := provider.GetConsoleUrl(c, entVmObject, utils.SerialConsole)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadGateway, "failed to get serial console from provider", err)
+			return
+		}
+		upstream, err := dialSerial(c, consoleUrl)
+		if err != nil {
+			api.ReturnError(c, http.StatusBadGateway, "failed to connect to provider serial console", err)
+			return
+		}
+		defer upstream.Close()
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// Upgrade has already written an error response
+			logrus.Warnf("failed to upgrade serial console connection: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		entConsoleSession, ok := openConsoleSession(c, conn, rdb, entUser, entVmObject, client.ConsoleSession.Create().
+			SetConsoleType(string(utils.SerialConsole)).
+			SetIPAddress(clientIp))
+		if !ok {
+			return
+		}
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeCONSOLE_ACCESS).
+			SetMessage(fmt.Sprintf("opened serial console for vm %s", entVmObject.Name)).
+			SetActionToUser(entUser).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
+		}
+
+		live := liveSessions.open(entVmObject.ID, utils.SerialConsole, "")
+		recorder := newTranscriptRecorder(client, entConsoleSession)
+		bridgeSerial(conn, upstream, recorder, live)
+		recorder.Close()
+		liveSessions.close(live)
+	}
+}
+
+// dialSerial connects to the provider's serial console websocket
+func dialSerial(ctx context.Context, consoleUrl string) (*websocket.Conn, error) {
+	u, err := url.Parse(consoleUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse console url: %v", err)
+	}
+	// Providers (eg. Nova's serial proxy) validate the origin against their own host
+	origin := url.URL{Scheme: "http", Host: u.Host}
+	if u.Scheme == "wss" {
+		origin.Scheme = "https"
+	}
+	dialer := websocket.Dialer{
+		HandshakeTimeout: 30 * time.Second,
+		Subprotocols:     []string{"binary"},
+	}
+	conn, _, err := dialer.DialContext(ctx, consoleUrl, http.Header{
+		"Origin": []string{origin.String()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// bridgeSerial copies keyboard input from the client to the provider and console output from the
+// provider back to the client (and any share link viewers) until either side disconnects
+func bridgeSerial(conn *websocket.Conn, upstream *websocket.Conn, recorder *transcriptRecorder, live *liveSession) {
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() { once.Do(func() { close(done) }) }
+
+	// Client -> Provider
+	go func() {
+		defer stop()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := upstream.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				return
+			}
+		}
+	}()
+	// Provider -> Client
+	go func() {
+		defer stop()
+		for {
+			_, data, err := upstream.ReadMessage()
+			if err != nil {
+				return
+			}
+			recorder.Write(data)
+			live.publish(data)
+			if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Keep the connection alive through idle proxies
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingInterval)); err != nil {
+				stop()
+			}
+		case <-done:
+			deadline := time.Now().Add(time.Second)
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+			upstream.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+			return
+		}
+	}
+}
+
+// transcriptRecorder buffers console output and periodically saves it to the console session
+type transcriptRecorder struct {
+	client         *ent.Client
+	consoleSession *ent.ConsoleSession
+	limit          int
+
+	mu         sync.Mutex
+	transcript strings.Builder
+	dirty      bool
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newTranscriptRecorder(client *ent.Client, consoleSession *ent.ConsoleSession) *transcriptRecorder {
+	// Limit is in kilobytes
+	transcriptLimit := 1024
+	if envValue, exists := os.LookupEnv("TRANSCRIPT_LIMIT"); exists {
+		if atioValue, err := strconv.Atoi(envValue); err == nil {
+			transcriptLimit = atioValue
+		}
+	}
+	r := &transcriptRecorder{
+		client:         client,
+		consoleSession: consoleSession,
+		limit:          transcriptLimit * 1024,
+		done:           make(chan struct{}),
+	}
+	r.wg.Add(1)
+	go r.flushLoop()
+	return r
+}
+
+// Write appends console output to the transcript, dropping anything past the transcript limit
+func (r *transcriptRecorder) Write(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	remaining := r.limit - r.transcript.Len()
+	if remaining <= 0 {
+		return
+	}
+	if len(data) > remaining {
+		data = data[:remaining]
+	}
+	r.transcript.Write(data)
+	r.dirty = true
+}
+
+func (r *transcriptRecorder) flushLoop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(transcriptFlushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.flush(false)
+		case <-r.done:
+			r.flush(true)
+			return
+		}
+	}
+}
+
+func (r *transcriptRecorder) flush(closed bool) {
+	r.mu.Lock()
+	dirty := r.dirty
+	transcript := r.transcript.String()
+	r.dirty = false
+	r.mu.Unlock()
+
+	if !dirty && !closed {
+		return
+	}
+	// Postgres text columns must be valid UTF-8 without NUL bytes
+	transcript = strings.ReplaceAll(strings.ToValidUTF8(transcript, "�"), "\x00", "")
+	consoleSessionUpdate := r.client.ConsoleSession.UpdateOne(r.consoleSession).SetTranscript(transcript)
+	if closed {
+		consoleSessionUpdate = consoleSessionUpdate.SetEndedAt(time.Now())
+	}
+	if err := consoleSessionUpdate.Exec(context.Background()); err != nil {
+		logrus.Warnf("failed to save console session transcript: %v", err)
+	}
+}
+
+// Close saves the final transcript and marks the console session as ended
+func (r *transcriptRecorder) Close() {
+	close(r.done)
+	r.wg.Wait()
+}
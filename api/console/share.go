@@ -0,0 +1,331 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/guacamole"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// shareTicketLifetime is how long a viewer has to open the console after entering the share link's password
+	shareTicketLifetime = time.Minute
+	// shareCheckPeriod is how often open viewers check their share link hasn't been revoked
+	shareCheckPeriod = 15 * time.Second
+)
+
+type ShareInfo struct {
+	VmObjectName     string    `json:"vm_object_name" example:"team01.dc"`        // The name of the shared vm object
+	ConsoleType      string    `json:"console_type" example:"GUAC_RDP"`           // The type of console being shared
+	PasswordRequired bool      `json:"password_required" example:"false"`         // Whether a ticket is required to view the console
+	ExpiresAt        time.Time `json:"expires_at" example:"2023-02-09T12:00:00Z"` // When the share link stops working
+}
+
+type ShareTicketRequest struct {
+	Password string `json:"password" binding:"required" example:"password123"` // [REQUIRED] The share link's password
+}
+
+type ShareTicketResult struct {
+	Ticket string `json:"ticket" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // Pass as the ticket query parameter when opening the console
+}
+
+// shareTicketClaims proves the viewer entered the share link's password
+type shareTicketClaims struct {
+	ShareID string
+	jwt.RegisteredClaims
+}
+
+// lookupShare returns the share link for the `token` path parameter if it is still usable
+func lookupShare(c *gin.Context, client *ent.Client) (*ent.ConsoleShare, int, error) {
+	entConsoleShare, err := client.ConsoleShare.Query().
+		Where(consoleshare.TokenHashEQ(utils.HashToken(c.Param("token")))).
+		WithConsoleShareToVmObject().
+		Only(c)
+	if ent.IsNotFound(err) {
+		return nil, http.StatusNotFound, fmt.Errorf("share link not found")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to query share link: %v", err)
+	}
+	if entConsoleShare.Revoked {
+		return nil, http.StatusGone, fmt.Errorf("share link has been revoked")
+	}
+	if time.Now().After(entConsoleShare.ExpiresAt) {
+		return nil, http.StatusGone, fmt.Errorf("share link has expired")
+	}
+	return entConsoleShare, http.StatusOK, nil
+}
+
+// SharedConsoleInfo godoc
+//
+//	@Summary		Get the details of a console share link
+//	@Schemes		http https
+//	@Description	Get the details needed to display a shared console
+//	@Tags			Console API
+//	@Param			token	path	string	true	"The share link token"
+//	@Produce		json
+//	@Success		200	{object}	console.ShareInfo
+//	@Failure		404	{object}	api.APIError
+//	@Failure		410	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/console/share/{token} [get]
+func SharedConsoleInfo(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entConsoleShare, status, err := lookupShare(c, client)
+		if err != nil {
+			api.ReturnError(c, status, "invalid share link", err)
+			return
+		}
+		c.JSON(http.StatusOK, ShareInfo{
+			VmObjectName:     entConsoleShare.Edges.ConsoleShareToVmObject.Name,
+			ConsoleType:      entConsoleShare.ConsoleType,
+			PasswordRequired: entConsoleShare.Password != "",
+			ExpiresAt:        entConsoleShare.ExpiresAt,
+		})
+	}
+}
+
+// SharedConsoleTicket godoc
+//
+//	@Summary		Unlock a password protected console share link
+//	@Schemes		http https
+//	@Description	Exchanges the share link's password for a short-lived ticket used to open the console
+//	@Tags			Console API
+//	@Param			token		path	string						true	"The share link token"
+//	@Param			password	body	console.ShareTicketRequest	true	"The share link's password"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	console.ShareTicketResult
+//	@Failure		401	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		410	{object}	api.APIError
+//	@Failure		422	{object}	api.APIError
+//	@Failure		429	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/console/share/{token}/ticket [post]
+func SharedConsoleTicket(client *ent.Client, limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entConsoleShare, status, err := lookupShare(c, client)
+		if err != nil {
+			api.ReturnError(c, status, "invalid share link", err)
+			return
+		}
+		var ticketRequest ShareTicketRequest
+		if err := c.ShouldBindJSON(&ticketRequest); err != nil {
+			api.ReturnError(c, http.StatusUnprocessableEntity, "invalid ticket request", err)
+			return
+		}
+		if entConsoleShare.Password != "" {
+			clientIp, err := api.ForContextIp(c)
+			if err != nil {
+				logrus.Warnf("failed to get IP from gin context: %v", err)
+			}
+			// Share passwords are guessed the same way as account passwords, so they are throttled the same way
+			limitSubjects := []ratelimit.Subject{ratelimit.ShareLink(entConsoleShare.ID.String()), ratelimit.IP(clientIp)}
+			if retryAfter := api.CheckLoginRateLimit(c, limiter, limitSubjects...); retryAfter > 0 {
+				api.ReturnError(c, http.StatusTooManyRequests, "too many failed attempts", api.TooManyLoginsError(retryAfter))
+				return
+			}
+			if err := utils.CheckPassword(ticketRequest.Password, entConsoleShare.Password); err != nil {
+				api.RecordLoginFailure(c, client, limiter, limitSubjects...)
+				api.ReturnError(c, http.StatusUnauthorized, "invalid password", fmt.Errorf("invalid password"))
+				return
+			}
+			api.RecordLoginSuccess(c, limiter, ratelimit.ShareLink(entConsoleShare.ID.String()))
+		}
+		ticket, err := signing.Sign(shareTicketClaims{
+			ShareID: entConsoleShare.ID.String(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(shareTicketLifetime)),
+			},
+		})
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to sign ticket", err)
+			return
+		}
+		c.JSON(http.StatusOK, ShareTicketResult{
+			Ticket: ticket,
+		})
+	}
+}
+
+// validShareTicket checks the ticket was issued for the share link and hasn't expired
+func validShareTicket(ticket string, entConsoleShare *ent.ConsoleShare) bool {
+	if ticket == "" {
+		return false
+	}
+	claims := &shareTicketClaims{}
+	tkn, err := signing.ParseWithClaims(ticket, claims)
+	if err != nil || !tkn.Valid {
+		return false
+	}
+	return claims.ShareID == entConsoleShare.ID.String()
+}
+
+// SharedConsole godoc
+//
+//	@Summary		View a shared console
+//	@Schemes		ws wss
+//	@Description	Upgrades to a websocket which mirrors the console currently open on the shared VM. All input from the viewer is dropped. Serial consoles send binary frames and guacamole consoles use the guacamole-common-js WebSocketTunnel protocol.
+//	@Tags			Console API
+//	@Param			token	path	string	true	"The share link token"
+//	@Param			ticket	query	string	false	"The ticket from unlocking the share link (required for password protected links)"
+//	@Success		101
+//	@Failure		401	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		409	{object}	api.APIError
+//	@Failure		410	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/console/share/{token}/view [get]
+func SharedConsole(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entConsoleShare, status, err := lookupShare(c, client)
+		if err != nil {
+			api.ReturnError(c, status, "invalid share link", err)
+			return
+		}
+		if entConsoleShare.Password != "" && !validShareTicket(c.Query("ticket"), entConsoleShare) {
+			api.ReturnError(c, http.StatusUnauthorized, "invalid share link ticket", fmt.Errorf("a valid ticket is required to view this console"))
+			return
+		}
+		clientIp, err := api.ForContextIp(c)
+		if err != nil {
+			logrus.Warnf("unable to get ip from context: %v", err)
+		}
+		entVmObject := entConsoleShare.Edges.ConsoleShareToVmObject
+		consoleType := utils.ConsoleType(entConsoleShare.ConsoleType)
+		live := liveSessions.latest(entVmObject.ID, consoleType)
+		if live == nil {
+			api.ReturnError(c, http.StatusConflict, "console is not in use", fmt.Errorf("nobody is currently using this console"))
+			return
+		}
+
+		var stream *guacamole.Stream
+		responseHeader := http.Header{}
+		if consoleType != utils.SerialConsole {
+			guacdAddress := "localhost:4822"
+			if envValue, exists := os.LookupEnv("GUACD_ADDRESS"); exists {
+				guacdAddress = envValue
+			}
+			// Join the live connection instead of opening a new one to the VM
+			stream, err = guacamole.Dial(c, guacdAddress, &guacamole.Config{
+				Protocol: live.guacdConnectionId,
+				Parameters: map[string]string{
+					"read-only": "true",
+				},
+				Width:          1024,
+				Height:         768,
+				DPI:            96,
+				ImageMimetypes: []string{"image/png", "image/jpeg", "image/webp"},
+			})
+			if err != nil {
+				api.ReturnError(c, http.StatusBadGateway, "failed to connect to guacd", err)
+				return
+			}
+			defer stream.Close()
+			responseHeader.Set("Sec-Websocket-Protocol", "guacamole")
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, responseHeader)
+		if err != nil {
+			// Upgrade has already written an error response
+			logrus.Warnf("failed to upgrade shared console connection: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeCONSOLE_ACCESS).
+			SetMessage(fmt.Sprintf("viewed %s console for vm %s with share link %s", consoleType, entVmObject.Name, entConsoleShare.ID)).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
+		}
+
+		stopWatchdog := shareWatchdog(client, entConsoleShare, conn)
+		defer stopWatchdog()
+		if stream != nil {
+			bridgeGuacamole(conn, stream, true)
+			return
+		}
+		watchSerial(conn, live)
+	}
+}
+
+// shareWatchdog disconnects the viewer once the share link is revoked or expires. The returned func stops the watchdog.
+func shareWatchdog(client *ent.Client, entConsoleShare *ent.ConsoleShare, conn *websocket.Conn) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(shareCheckPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				usable, err := client.ConsoleShare.Query().Where(
+					consoleshare.IDEQ(entConsoleShare.ID),
+					consoleshare.RevokedEQ(false),
+					consoleshare.ExpiresAtGT(time.Now()),
+				).Exist(context.Background())
+				if err != nil {
+					logrus.Warnf("failed to check share link %s: %v", entConsoleShare.ID, err)
+					continue
+				}
+				if !usable {
+					conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "share link is no longer valid"), time.Now().Add(time.Second))
+					conn.Close()
+					return
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// watchSerial mirrors the live serial console to a read-only viewer until either side disconnects
+func watchSerial(conn *websocket.Conn, live *liveSession) {
+	output := live.subscribe()
+	defer live.unsubscribe(output)
+
+	// Input from viewers is read (to handle control frames) and dropped
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case data, ok := <-output:
+			if !ok {
+				conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "console closed"), time.Now().Add(time.Second))
+				return
+			}
+			if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/allowlist"
+	"github.com/BradHacker/compsole/compsole/schedule"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, so they can be told apart from session and service tokens
+const PersonalAccessTokenPrefix = "cpat_"
+
+var personalAccessTokenCtxKey = &contextKey{"personal_access_token"}
+
+// NewPersonalAccessToken generates a personal access token. Returns the token, its hash and the prefix to display.
+func NewPersonalAccessToken() (string, string, string, error) {
+	tokenStr, err := utils.NewToken()
+	if err != nil {
+		return "", "", "", err
+	}
+	tokenStr = PersonalAccessTokenPrefix + tokenStr
+	return tokenStr, utils.HashToken(tokenStr), tokenStr[:len(PersonalAccessTokenPrefix)+4], nil
+}
+
+// PersonalAccessTokenMiddleware authenticates requests with an `Authorization: Bearer` personal access token. Requests
+// already authenticated by Middleware are left alone. MUST run after Middleware.
+func PersonalAccessTokenMiddleware(client *ent.Client) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if _, err := ForContext(ctx.Request.Context()); err == nil {
+			ctx.Next()
+			return
+		}
+
+		authorization := ctx.GetHeader("Authorization")
+		if !strings.HasPrefix(authorization, "Bearer "+PersonalAccessTokenPrefix) {
+			ctx.Next()
+			return
+		}
+		tokenStr := strings.TrimPrefix(authorization, "Bearer ")
+
+		entPersonalAccessToken, err := client.PersonalAccessToken.Query().
+			Where(
+				personalaccesstoken.TokenHashEQ(utils.HashToken(tokenStr)),
+				personalaccesstoken.Or(
+					personalaccesstoken.ExpiresAtIsNil(),
+					personalaccesstoken.ExpiresAtGT(time.Now()),
+				),
+			).
+			WithPersonalAccessTokenToUser(func(uq *ent.UserQuery) {
+				uq.WithUserToCustomRole()
+			}).
+			Only(viewer.SystemContext(ctx))
+		if ent.IsNotFound(err) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired personal access token"})
+			return
+		} else if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+
+		clientIp, err := ForContextIp(ctx)
+		if err != nil {
+			logrus.Warnf("failed to get IP from gin context: %v", err)
+		}
+		// Tokens are held to the same networks as sessions
+		allowed, err := allowlist.Check(viewer.SystemContext(ctx), client, entPersonalAccessToken.Edges.PersonalAccessTokenToUser, clientIp, "use a personal access token")
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+		if !allowed {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "you can't use compsole from this network"})
+			return
+		}
+		// ...and to the competition schedule
+		canSignIn, err := schedule.CanSignIn(viewer.SystemContext(ctx), entPersonalAccessToken.Edges.PersonalAccessTokenToUser)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err})
+			return
+		}
+		if !canSignIn {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "your competition is not open right now"})
+			return
+		}
+		recordPersonalAccessTokenUse(ctx, entPersonalAccessToken, clientIp)
+
+		// put it in context
+		c := context.WithValue(ctx.Request.Context(), userCtxKey, entPersonalAccessToken.Edges.PersonalAccessTokenToUser)
+		c = context.WithValue(c, personalAccessTokenCtxKey, entPersonalAccessToken)
+		c = viewer.NewContext(c, &viewer.Viewer{User: entPersonalAccessToken.Edges.PersonalAccessTokenToUser})
+		ctx.Request = ctx.Request.WithContext(c)
+
+		ctx.Next()
+	}
+}
+
+// recordPersonalAccessTokenUse updates when and where the token was last used. Only writes once per
+// sessionActivityInterval unless the IP changes.
+func recordPersonalAccessTokenUse(ctx context.Context, entPersonalAccessToken *ent.PersonalAccessToken, clientIp string) {
+	if entPersonalAccessToken.LastUsedAt != nil && time.Since(*entPersonalAccessToken.LastUsedAt) < sessionActivityInterval && entPersonalAccessToken.LastUsedIP == clientIp {
+		return
+	}
+	err := entPersonalAccessToken.Update().
+		SetLastUsedAt(time.Now()).
+		SetLastUsedIP(clientIp).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to update personal access token last used time: %v", err)
+	}
+}
+
+// ForContextPersonalAccessToken finds the personal access token the request was authenticated with. REQUIRES
+// PersonalAccessTokenMiddleware to have run.
+func ForContextPersonalAccessToken(ctx context.Context) (*ent.PersonalAccessToken, error) {
+	raw, ok := ctx.Value(personalAccessTokenCtxKey).(*ent.PersonalAccessToken)
+	if ok {
+		return raw, nil
+	}
+	return nil, errors.New("unable to get personal access token from context")
+}
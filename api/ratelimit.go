@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckLoginRateLimit returns how long the client must wait before trying to login as the subjects again, and sets the
+// Retry-After header if they must wait. Logins are allowed if redis is unavailable.
+func CheckLoginRateLimit(c *gin.Context, limiter *ratelimit.Limiter, subjects ...ratelimit.Subject) time.Duration {
+	retryAfter, err := limiter.Check(c, subjects...)
+	if err != nil {
+		logrus.Warnf("failed to check login rate limit: %v", err)
+		return 0
+	}
+	if retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second).Seconds())))
+	}
+	return retryAfter
+}
+
+// RecordLoginFailure counts a failed login against the subjects and logs any lockouts it causes
+func RecordLoginFailure(c *gin.Context, client *ent.Client, limiter *ratelimit.Limiter, subjects ...ratelimit.Subject) {
+	lockedOut, err := limiter.Fail(c, subjects...)
+	if err != nil {
+		logrus.Warnf("failed to record login failure: %v", err)
+	}
+	if len(lockedOut) == 0 {
+		return
+	}
+	clientIp, err := ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+	for _, subject := range lockedOut {
+		err = client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeACCOUNT_LOCKED).
+			SetMessage(fmt.Sprintf("%s \"%s\" has been locked out for %s after too many failed logins", subject.Type, subject.Identifier, limiter.LockoutDuration)).
+			Exec(c)
+		if err != nil {
+			logrus.Warnf("failed to create ACCOUNT_LOCKED action: %v", err)
+		}
+	}
+}
+
+// RecordLoginSuccess clears the failed logins counted against the subjects
+func RecordLoginSuccess(c *gin.Context, limiter *ratelimit.Limiter, subjects ...ratelimit.Subject) {
+	if err := limiter.Succeed(c, subjects...); err != nil {
+		logrus.Warnf("failed to clear login failures: %v", err)
+	}
+}
+
+// TooManyLoginsError is returned to clients which must wait before trying to login again
+func TooManyLoginsError(retryAfter time.Duration) error {
+	return fmt.Errorf("too many failed login attempts, try again in %s", retryAfter.Round(time.Second))
+}
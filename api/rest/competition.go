@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -27,12 +28,18 @@ import (
 //	@Router			/rest/competition [get]
 func ListCompetitions(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		queryField := c.Query("field")
 		if queryField == "" {
 			queryField = "name"
 		}
 
-		entCompetitionQuery := client.Competition.Query().WithCompetitionToTeams().WithCompetitionToProvider()
+		entCompetitionQuery := client.Competition.Query().Where(scope.readableCompetitions()).WithCompetitionToTeams().WithCompetitionToProvider()
 
 		queryText := c.Query("q")
 		if queryText != "" {
@@ -75,6 +82,12 @@ func ListCompetitions(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/competition/{id} [get]
 func GetCompetition(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		competitionID := c.Param("id")
 		competitionUuid, err := uuid.Parse(competitionID)
 		if err != nil {
@@ -85,6 +98,7 @@ func GetCompetition(client *ent.Client) gin.HandlerFunc {
 		entCompetition, err := client.Competition.Query().
 			Where(
 				competition.IDEQ(competitionUuid),
+				scope.readableCompetitions(),
 			).
 			WithCompetitionToTeams().
 			WithCompetitionToProvider().
@@ -119,6 +133,17 @@ func GetCompetition(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/competition [post]
 func CreateCompetition(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
+		if scope != nil {
+			api.ReturnError(c, http.StatusForbidden, "service accounts limited to competitions or teams can't create competitions", fmt.Errorf("restricted service account"))
+			return
+		}
+
 		var newCompetition CompetitionInput
 		if err := c.ShouldBind(&newCompetition); err != nil {
 			api.ReturnError(c, http.StatusUnprocessableEntity, "failed to bind to competition data", err)
@@ -180,6 +205,12 @@ func CreateCompetition(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/competition/{id} [put]
 func UpdateCompetition(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		competitionID := c.Param("id")
 		competitionUuid, err := uuid.Parse(competitionID)
 		if err != nil {
@@ -190,6 +221,7 @@ func UpdateCompetition(client *ent.Client) gin.HandlerFunc {
 		entCompetition, err := client.Competition.Query().
 			Where(
 				competition.IDEQ(competitionUuid),
+				scope.writableCompetitions(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "competition not found", err)
@@ -224,6 +256,19 @@ func UpdateCompetition(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		if scope != nil {
+			// Providers hold the infrastructure credentials, so restricted service accounts can't move competitions between them
+			currentProviderId, err := entCompetition.QueryCompetitionToProvider().OnlyID(c)
+			if err != nil {
+				api.ReturnError(c, http.StatusInternalServerError, "failed to query for competition provider", err)
+				return
+			}
+			if currentProviderId != entProvider.ID {
+				api.ReturnError(c, http.StatusForbidden, "service accounts limited to competitions or teams can't change the provider of a competition", fmt.Errorf("restricted service account"))
+				return
+			}
+		}
+
 		entUpdatedCompetition, err := entCompetition.Update().
 			SetName(updatedCompetition.Name).
 			SetCompetitionToProvider(entProvider).
@@ -260,6 +305,12 @@ func UpdateCompetition(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/competition/{id} [delete]
 func DeleteCompetition(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		competitionID := c.Param("id")
 		competitionUuid, err := uuid.Parse(competitionID)
 		if err != nil {
@@ -267,6 +318,16 @@ func DeleteCompetition(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		exists, err := client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.writableCompetitions()).Exist(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for competition", err)
+			return
+		}
+		if !exists {
+			api.ReturnError(c, http.StatusNotFound, "competition not found", fmt.Errorf("competition not found"))
+			return
+		}
+
 		err = client.Competition.DeleteOneID(competitionUuid).Exec(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "competition not found", err)
@@ -8,12 +8,15 @@ import (
 	"time"
 
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/utils"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -31,7 +34,21 @@ type ServiceLoginResult struct {
 	ExpiresAt int64  `json:"token_expires_at"`
 }
 
-func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServiceAccount *ent.ServiceAccount, existingRefreshToken *string) {
+// checkServiceAccountSecret compares the secret with the service account's current secret and, during the overlap
+// period after a rotation, its previous secret. Returns whether the secret is valid and whether it was the previous one.
+func checkServiceAccountSecret(entServiceAccount *ent.ServiceAccount, apiSecret uuid.UUID) (bool, bool) {
+	if utils.CheckToken(apiSecret.String(), entServiceAccount.APISecretHash) {
+		return true, false
+	}
+	if entServiceAccount.PreviousAPISecretExpiresAt == nil || time.Now().After(*entServiceAccount.PreviousAPISecretExpiresAt) {
+		return false, false
+	}
+	return utils.CheckToken(apiSecret.String(), entServiceAccount.PreviousAPISecretHash), true
+}
+
+// generateAndReturnServiceToken starts a session for the service account. secretGeneration is the generation of the
+// secret the session was started with (see api.ServiceTokenUsable).
+func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServiceAccount *ent.ServiceAccount, secretGeneration int, existingRefreshToken *string) {
 	hostname, ok := os.LookupEnv("GRAPHQL_HOSTNAME")
 	if !ok {
 		hostname = "localhost"
@@ -55,26 +72,18 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 		}
 	}
 
-	jwtKey, exists := os.LookupEnv("JWT_SECRET")
-	if !exists {
-		// Kill the request if we don't have a valid JWT_SECRET
-		logrus.Error("env variable JWT_SECRET not set")
-		api.ReturnError(c, http.StatusInternalServerError, "check logs for details", fmt.Errorf("check logs for details"))
-		return
-	}
-
 	issuedAt := time.Now()
 
-	tokenExpiresAt := issuedAt.Add(time.Minute * time.Duration(sessionTimeout)).Unix()
+	tokenExpiresAt := issuedAt.Add(time.Minute * time.Duration(sessionTimeout))
 	tokenClaims := &api.CompsoleJWTClaims{
-		ApiKey:   entServiceAccount.APIKey.String(),
-		IssuedAt: issuedAt.Unix(),
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: tokenExpiresAt,
+		ApiKey: entServiceAccount.APIKey.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   entServiceAccount.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(tokenExpiresAt),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
-	tokenString, err := token.SignedString([]byte(jwtKey))
+	tokenString, err := signing.Sign(tokenClaims)
 	if err != nil {
 		api.ReturnError(c, http.StatusUnauthorized, "failed to sign api token", err)
 		return
@@ -83,16 +92,16 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 	refreshTokenString := ""
 	if existingRefreshToken == nil {
 		// We don't already have a refresh token, generate one
-		refreshExpiresAt := issuedAt.Add(time.Hour * time.Duration(refreshWindow)).Unix()
+		refreshExpiresAt := issuedAt.Add(time.Hour * time.Duration(refreshWindow))
 		refreshTokenClaims := &api.CompsoleJWTClaims{
-			ApiKey:   entServiceAccount.APIKey.String(),
-			IssuedAt: issuedAt.Unix(),
-			StandardClaims: jwt.StandardClaims{
-				ExpiresAt: refreshExpiresAt,
+			ApiKey: entServiceAccount.APIKey.String(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   entServiceAccount.ID.String(),
+				IssuedAt:  jwt.NewNumericDate(issuedAt),
+				ExpiresAt: jwt.NewNumericDate(refreshExpiresAt),
 			},
 		}
-		refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshTokenClaims)
-		refreshTokenString, err = refreshToken.SignedString([]byte(jwtKey))
+		refreshTokenString, err = signing.Sign(refreshTokenClaims)
 		if err != nil {
 			api.ReturnError(c, http.StatusUnauthorized, "failed to sign api refresh token", err)
 			return
@@ -105,6 +114,7 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 	_, err = client.ServiceToken.Create().
 		SetTokenToServiceAccount(entServiceAccount).
 		SetIssuedAt(issuedAt.Unix()).
+		SetSecretGeneration(secretGeneration).
 		SetToken(tokenString).
 		SetRefreshToken(refreshTokenString).
 		Save(c)
@@ -118,6 +128,7 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 	if err != nil {
 		logrus.Warnf("failed to get IP from gin context: %v", err)
 	}
+	api.RecordServiceAccountUse(c, entServiceAccount, clientIp)
 
 	// Successful sign-in
 	err = client.Action.Create().
@@ -138,7 +149,7 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 
 	c.JSON(http.StatusOK, ServiceLoginResult{
 		Token:     tokenString,
-		ExpiresAt: tokenExpiresAt,
+		ExpiresAt: tokenExpiresAt.Unix(),
 	})
 }
 
@@ -153,11 +164,12 @@ func generateAndReturnServiceToken(c *gin.Context, client *ent.Client, entServic
 //	@Produce		json
 //	@Success		200	{object}	ServiceLoginResult
 //	@Failure		401	{object}	api.APIError
+//	@Failure		429	{object}	api.APIError
 //	@Failure		500	{object}	api.APIError
 //	@Router			/rest/token [post]
 //
 // ServiceLogin handles login of service accounts and packs the session into context
-func ServiceLogin(client *ent.Client) gin.HandlerFunc {
+func ServiceLogin(client *ent.Client, limiter *ratelimit.Limiter) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var loginVals ServiceLoginVals
 
@@ -177,20 +189,41 @@ func ServiceLogin(client *ent.Client) gin.HandlerFunc {
 			api.ReturnError(c, http.StatusUnauthorized, "failed to parse api_key", err)
 			return
 		}
+
+		limitSubjects := []ratelimit.Subject{ratelimit.APIKey(apiKey.String()), ratelimit.IP(clientIp)}
+		if retryAfter := api.CheckLoginRateLimit(c, limiter, limitSubjects...); retryAfter > 0 {
+			err = client.Action.Create().
+				SetIPAddress(clientIp).
+				SetType(action.TypeFAILED_SIGN_IN).
+				SetMessage(fmt.Sprintf("service account login for api_key \"%s\" was throttled for %s", apiKey, retryAfter.Round(time.Second))).
+				Exec(c)
+			if err != nil {
+				logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
+			}
+			api.ReturnError(c, http.StatusTooManyRequests, "too many failed login attempts", api.TooManyLoginsError(retryAfter))
+			return
+		}
+
 		apiSecret, err := uuid.Parse(loginVals.ApiSecret)
 		if err != nil {
+			api.RecordLoginFailure(c, client, limiter, limitSubjects...)
 			api.ReturnError(c, http.StatusUnauthorized, "failed to parse api_secret", err)
 			return
 		}
 
 		entServiceAccount, err := client.ServiceAccount.Query().Where(
-			serviceaccount.And(
-				serviceaccount.APIKeyEQ(apiKey),
-				serviceaccount.APISecretEQ(apiSecret),
-				serviceaccount.ActiveEQ(true), // only active accounts may authenticate
-			),
+			serviceaccount.APIKeyEQ(apiKey),
+			api.UsableServiceAccount(), // only active, unexpired accounts may authenticate
 		).Only(c)
-		if ent.IsNotFound(err) {
+		if err != nil && !ent.IsNotFound(err) {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query service account", err)
+			return
+		}
+		validSecret, previousSecret := false, false
+		if err == nil {
+			validSecret, previousSecret = checkServiceAccountSecret(entServiceAccount, apiSecret)
+		}
+		if !validSecret {
 			err = client.Action.Create().
 				SetIPAddress(clientIp).
 				SetType(action.TypeFAILED_SIGN_IN).
@@ -199,13 +232,18 @@ func ServiceLogin(client *ent.Client) gin.HandlerFunc {
 			if err != nil {
 				logrus.Warnf("failed to create FAILED_SIGN_IN action: %v", err)
 			}
-		}
-		if err != nil {
-			api.ReturnError(c, http.StatusUnauthorized, "api_key or api_secret is invalid", err)
+			api.RecordLoginFailure(c, client, limiter, limitSubjects...)
+			api.ReturnError(c, http.StatusUnauthorized, "api_key or api_secret is invalid", fmt.Errorf("api_key or api_secret is invalid"))
 			return
 		}
+		secretGeneration := entServiceAccount.SecretGeneration
+		if previousSecret {
+			logrus.Warnf("service account \"%s\" signed in with its previous secret, which stops working at %s", entServiceAccount.DisplayName, entServiceAccount.PreviousAPISecretExpiresAt.Format(time.RFC3339))
+			secretGeneration--
+		}
+		api.RecordLoginSuccess(c, limiter, ratelimit.APIKey(apiKey.String()))
 
-		generateAndReturnServiceToken(c, client, entServiceAccount, nil)
+		generateAndReturnServiceToken(c, client, entServiceAccount, secretGeneration, nil)
 	}
 }
 
@@ -231,17 +269,7 @@ func ServiceTokenRefresh(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		jwtKey, exists := os.LookupEnv("JWT_SECRET")
-		if !exists {
-			// Kill the request if we don't have a valid JWT_SECRET
-			logrus.Error("env variable JWT_SECRET not set")
-			api.ReturnError(c, http.StatusInternalServerError, "check logs for details", fmt.Errorf("check logs for details"))
-			return
-		}
-
-		refreshToken, err := jwt.ParseWithClaims(refreshTokenString, &api.CompsoleJWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtKey), nil
-		})
+		refreshToken, err := signing.ParseWithClaims(refreshTokenString, &api.CompsoleJWTClaims{})
 		if err != nil {
 			api.ReturnError(c, http.StatusUnauthorized, "invalid or expired token", err)
 			return
@@ -269,7 +297,7 @@ func ServiceTokenRefresh(client *ent.Client) gin.HandlerFunc {
 		entServiceToken, err := client.ServiceToken.Query().Where(
 			servicetoken.HasTokenToServiceAccountWith(
 				serviceaccount.APIKeyEQ(apiKeyUUID),
-				serviceaccount.ActiveEQ(true),
+				api.UsableServiceAccount(),
 			),
 			servicetoken.RefreshTokenEQ(refreshTokenString),
 		).Only(c)
@@ -293,7 +321,15 @@ func ServiceTokenRefresh(client *ent.Client) gin.HandlerFunc {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to query service account from service token", err)
 			return
 		}
+		// Refreshing doesn't carry a session past the end of its secret's overlap
+		if !api.ServiceTokenUsable(entServiceToken, entServiceAccount) {
+			if err = client.ServiceToken.DeleteOne(entServiceToken).Exec(c); err != nil {
+				logrus.Warnf("failed to delete service token from a rotated secret: %v", err)
+			}
+			api.ReturnError(c, http.StatusUnauthorized, "the secret this session was started with has been rotated", fmt.Errorf("service account secret was rotated"))
+			return
+		}
 
-		generateAndReturnServiceToken(c, client, entServiceAccount, &refreshTokenString)
+		generateAndReturnServiceToken(c, client, entServiceAccount, entServiceToken.SecretGeneration, &refreshTokenString)
 	}
 }
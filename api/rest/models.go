@@ -108,8 +108,10 @@ type UserInput struct {
 	Username   string  `json:"username" form:"username" binding:"required" example:"compsole"`
 	FirstName  string  `json:"first_name" form:"first_name" binding:"required" example:"John"`
 	LastName   string  `json:"last_name" form:"last_name" binding:"required" example:"Doe"`
-	Role       string  `json:"role" form:"role" binding:"required" example:"USER" enums:"USER,ADMIN"`
+	Role       string  `json:"role" form:"role" binding:"required" example:"USER" enums:"USER,ADMIN,WHITE_TEAM,BLACK_TEAM,RED_TEAM"`
 	UserToTeam *string `json:"user_to_team,omitempty" form:"user_to_team" example:"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"`
+	// Makes the user a competition-scoped admin of these competitions. Leave out to keep the existing competitions on update.
+	UserToAdminCompetitions *[]string `json:"user_to_admin_competitions,omitempty" form:"user_to_admin_competitions"`
 }
 
 // UserModel model info
@@ -123,7 +125,8 @@ type UserModel struct {
 	LastName  string    `json:"last_name" example:"User"`                          // [OPTIONAL] The display last name for the user.
 	Role      user.Role `json:"role" example:"USER"`                               // [REQUIRED] The role of the user. Admins have full access.
 	// Edges
-	UserToTeam *TeamEdge `json:"user_to_team"`
+	UserToTeam              *TeamEdge         `json:"user_to_team"`
+	UserToAdminCompetitions []CompetitionEdge `json:"user_to_admin_competitions"` // The competitions the user can manage as a competition-scoped admin.
 }
 
 // UserEdge model info
@@ -148,6 +151,13 @@ type VmObjectInput struct {
 	VmObjectToTeam string   `json:"vm_object_to_team" form:"vm_object_to_team" binding:"required" example:"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"`
 }
 
+// RebootInput model info
+//
+//	@Description	Used as an input model for rebooting VmObjects
+type RebootInput struct {
+	RebootType string `json:"reboot_type" form:"reboot_type" binding:"required,oneof=SOFT HARD" example:"SOFT" enums:"SOFT,HARD"`
+}
+
 // VmObjectInput model info
 //
 //	@Description	Used as an input model for updating VM Object identifiers
@@ -280,6 +290,13 @@ func UserEntToModel(entUser *ent.User) UserModel {
 			Name:       entUser.Edges.UserToTeam.Name,
 		}
 	}
+	userModel.UserToAdminCompetitions = make([]CompetitionEdge, len(entUser.Edges.UserToAdminCompetitions))
+	for i, entCompetition := range entUser.Edges.UserToAdminCompetitions {
+		userModel.UserToAdminCompetitions[i] = CompetitionEdge{
+			ID:   entCompetition.ID,
+			Name: entCompetition.Name,
+		}
+	}
 	return userModel
 }
 
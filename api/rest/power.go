@@ -0,0 +1,167 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/consolecache"
+	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// powerAction runs a power change against the provider of the vm object in the `id` param and logs it as the
+// service account making the request
+func powerAction(c *gin.Context, client *ent.Client, providerMap *providers.ProviderMap, consoleCache *consolecache.ConsoleCache, actionType action.Type, verb string, run func(context.Context, providers.CompsoleProvider, *ent.VmObject) error) {
+	scope, err := restrictionForContext(c)
+	if err != nil {
+		api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+		return
+	}
+	entServiceAccount, err := api.ForContextServiceAccount(c.Request.Context())
+	if err != nil {
+		api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+		return
+	}
+
+	vmObjectID := c.Param("id")
+	vmObjectUuid, err := uuid.Parse(vmObjectID)
+	if err != nil {
+		api.ReturnError(c, http.StatusUnprocessableEntity, "failed to parse vm object uuid", err)
+		return
+	}
+
+	entVmObject, err := client.VmObject.Query().
+		Where(
+			vmobject.IDEQ(vmObjectUuid),
+			scope.vmObjects(),
+		).Only(c)
+	if ent.IsNotFound(err) {
+		api.ReturnError(c, http.StatusNotFound, "vm object not found", err)
+		return
+	}
+	if err != nil {
+		api.ReturnError(c, http.StatusInternalServerError, "failed to query for vm object", err)
+		return
+	}
+
+	entProvider, err := entVmObject.QueryVmObjectToTeam().QueryTeamToCompetition().QueryCompetitionToProvider().Only(c)
+	if err != nil {
+		api.ReturnError(c, http.StatusInternalServerError, "failed to query provider from vm object", err)
+		return
+	}
+	provider, err := providerMap.Get(entProvider.ID)
+	if err != nil {
+		api.ReturnError(c, http.StatusInternalServerError, "failed to load provider", err)
+		return
+	}
+
+	clientIp, err := api.ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+	err = client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(actionType).
+		SetMessage(fmt.Sprintf("service account \"%s\" %s vm %s", entServiceAccount.DisplayName, verb, entVmObject.Name)).
+		SetActionToServiceAccount(entServiceAccount).
+		Exec(c)
+	if err != nil {
+		logrus.Warnf("failed to log %s: %v", actionType, err)
+	}
+
+	err = run(c, provider, entVmObject)
+	if err != nil {
+		api.ReturnError(c, http.StatusInternalServerError, fmt.Sprintf("failed to %s vm", actionType), err)
+		return
+	}
+	// Consoles issued before the power change are no longer valid
+	if err := consoleCache.Invalidate(c, entVmObject.ID); err != nil {
+		logrus.Warnf("failed to invalidate cached consoles after %s: %v", actionType, err)
+	}
+
+	c.Status(http.StatusNoContent)
+	c.Next()
+}
+
+// PowerOnVMObject godoc
+//
+//	@Security		ServiceAuth
+//	@Summary		Power on a VM Object
+//	@Schemes		http https
+//	@Description	Power on a VM Object. Requires the `vm:power` scope.
+//	@Tags			Service API
+//	@Param			id	path	string	true	"The id of the vm object"	format(uuid)	example(xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+//	@Produce		json
+//	@Success		204
+//	@Failure		403	{object}	api.APIError
+//	@Failure		422	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/rest/vm-object/{id}/power-on [post]
+func PowerOnVMObject(client *ent.Client, providerMap *providers.ProviderMap, consoleCache *consolecache.ConsoleCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		powerAction(c, client, providerMap, consoleCache, action.TypePOWER_ON, "powered on", func(ctx context.Context, provider providers.CompsoleProvider, entVmObject *ent.VmObject) error {
+			return provider.PowerOnVM(ctx, entVmObject)
+		})
+	}
+}
+
+// PowerOffVMObject godoc
+//
+//	@Security		ServiceAuth
+//	@Summary		Power off a VM Object
+//	@Schemes		http https
+//	@Description	Power off a VM Object. Requires the `vm:power` scope.
+//	@Tags			Service API
+//	@Param			id	path	string	true	"The id of the vm object"	format(uuid)	example(xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+//	@Produce		json
+//	@Success		204
+//	@Failure		403	{object}	api.APIError
+//	@Failure		422	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/rest/vm-object/{id}/power-off [post]
+func PowerOffVMObject(client *ent.Client, providerMap *providers.ProviderMap, consoleCache *consolecache.ConsoleCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		powerAction(c, client, providerMap, consoleCache, action.TypePOWER_OFF, "powered off", func(ctx context.Context, provider providers.CompsoleProvider, entVmObject *ent.VmObject) error {
+			return provider.PowerOffVM(ctx, entVmObject)
+		})
+	}
+}
+
+// RebootVMObject godoc
+//
+//	@Security		ServiceAuth
+//	@Summary		Reboot a VM Object
+//	@Schemes		http https
+//	@Description	Reboot a VM Object. Requires the `vm:power` scope.
+//	@Tags			Service API
+//	@Param			id		path	string				true	"The id of the vm object"	format(uuid)	example(xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+//	@Param			reboot	body	rest.RebootInput	true	"The type of reboot"
+//	@Produce		json
+//	@Success		204
+//	@Failure		403	{object}	api.APIError
+//	@Failure		422	{object}	api.APIError
+//	@Failure		404	{object}	api.APIError
+//	@Failure		500	{object}	api.APIError
+//	@Router			/rest/vm-object/{id}/reboot [post]
+func RebootVMObject(client *ent.Client, providerMap *providers.ProviderMap, consoleCache *consolecache.ConsoleCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var rebootInput RebootInput
+		if err := c.ShouldBind(&rebootInput); err != nil {
+			api.ReturnError(c, http.StatusUnprocessableEntity, "failed to bind to reboot data", err)
+			return
+		}
+		powerAction(c, client, providerMap, consoleCache, action.TypeREBOOT, "rebooted", func(ctx context.Context, provider providers.CompsoleProvider, entVmObject *ent.VmObject) error {
+			return provider.RestartVM(ctx, entVmObject, utils.RebootType(rebootInput.RebootType))
+		})
+	}
+}
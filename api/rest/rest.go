@@ -2,45 +2,52 @@ package rest
 
 import (
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/consolecache"
+	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/sessions"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRESTEndpoints(client *ent.Client, r *gin.RouterGroup) {
+func RegisterRESTEndpoints(client *ent.Client, limiter *ratelimit.Limiter, providerMap *providers.ProviderMap, consoleCache *consolecache.ConsoleCache, sessionManager *sessions.Manager, r *gin.RouterGroup) {
 	// Login
-	r.POST("/token", ServiceLogin(client))
-	r.POST("/token/refresh", ServiceTokenRefresh(client))
+	r.POST("/token", api.AnonymousMiddleware(), ServiceLogin(client, limiter))
+	r.POST("/token/refresh", api.AnonymousMiddleware(), ServiceTokenRefresh(client))
 
 	r.Use(api.ServiceMiddleware(client))
 	// VM Objects
-	r.GET("/vm-object", ListVmObjects(client))
-	r.POST("/vm-object", CreateVMObject(client))
-	r.GET("/vm-object/:id", GetVMObject(client))
-	r.PUT("/vm-object/:id", UpdateVMObject(client))
-	r.PUT("/vm-object/:id/identifier", UpdateVMObjectIdentifier(client))
-	r.DELETE("/vm-object/:id", DeleteVMObject(client))
+	r.GET("/vm-object", api.RequireScope(api.ScopeVmRead), ListVmObjects(client))
+	r.POST("/vm-object", api.RequireScope(api.ScopeVmWrite), CreateVMObject(client))
+	r.GET("/vm-object/:id", api.RequireScope(api.ScopeVmRead), GetVMObject(client))
+	r.PUT("/vm-object/:id", api.RequireScope(api.ScopeVmWrite), UpdateVMObject(client))
+	r.PUT("/vm-object/:id/identifier", api.RequireScope(api.ScopeVmWrite), UpdateVMObjectIdentifier(client))
+	r.DELETE("/vm-object/:id", api.RequireScope(api.ScopeVmWrite), DeleteVMObject(client))
+	r.POST("/vm-object/:id/power-on", api.RequireScope(api.ScopeVmPower), PowerOnVMObject(client, providerMap, consoleCache))
+	r.POST("/vm-object/:id/power-off", api.RequireScope(api.ScopeVmPower), PowerOffVMObject(client, providerMap, consoleCache))
+	r.POST("/vm-object/:id/reboot", api.RequireScope(api.ScopeVmPower), RebootVMObject(client, providerMap, consoleCache))
 	// Competitions
-	r.GET("/competition", ListCompetitions(client))
-	r.POST("/competition", CreateCompetition(client))
-	r.GET("/competition/:id", GetCompetition(client))
-	r.PUT("/competition/:id", UpdateCompetition(client))
-	r.DELETE("/competition/:id", DeleteCompetition(client))
+	r.GET("/competition", api.RequireScope(api.ScopeCompetitionRead), ListCompetitions(client))
+	r.POST("/competition", api.RequireScope(api.ScopeCompetitionWrite), CreateCompetition(client))
+	r.GET("/competition/:id", api.RequireScope(api.ScopeCompetitionRead), GetCompetition(client))
+	r.PUT("/competition/:id", api.RequireScope(api.ScopeCompetitionWrite), UpdateCompetition(client))
+	r.DELETE("/competition/:id", api.RequireScope(api.ScopeCompetitionWrite), DeleteCompetition(client))
 	// Providers
-	r.GET("/provider", ListProviders(client))
-	r.POST("/provider", CreateProvider(client))
-	r.GET("/provider/:id", GetProvider(client))
-	r.PUT("/provider/:id", UpdateProvider(client))
-	r.DELETE("/provider/:id", DeleteProvider(client))
+	r.GET("/provider", api.RequireScope(api.ScopeProviderRead), ListProviders(client))
+	r.POST("/provider", api.RequireScope(api.ScopeProviderWrite), CreateProvider(client))
+	r.GET("/provider/:id", api.RequireScope(api.ScopeProviderRead), GetProvider(client))
+	r.PUT("/provider/:id", api.RequireScope(api.ScopeProviderWrite), UpdateProvider(client))
+	r.DELETE("/provider/:id", api.RequireScope(api.ScopeProviderWrite), DeleteProvider(client))
 	// Teams
-	r.GET("/team", ListTeams(client))
-	r.POST("/team", CreateTeam(client))
-	r.GET("/team/:id", GetTeam(client))
-	r.PUT("/team/:id", UpdateTeam(client))
-	r.DELETE("/team/:id", DeleteTeam(client))
+	r.GET("/team", api.RequireScope(api.ScopeTeamRead), ListTeams(client))
+	r.POST("/team", api.RequireScope(api.ScopeTeamWrite), CreateTeam(client))
+	r.GET("/team/:id", api.RequireScope(api.ScopeTeamRead), GetTeam(client))
+	r.PUT("/team/:id", api.RequireScope(api.ScopeTeamWrite), UpdateTeam(client))
+	r.DELETE("/team/:id", api.RequireScope(api.ScopeTeamWrite), DeleteTeam(client))
 	// Users
-	r.GET("/user", ListUsers(client))
-	r.POST("/user", CreateUser(client))
-	r.GET("/user/:id", GetUser(client))
-	r.PUT("/user/:id", UpdateUser(client))
-	r.DELETE("/user/:id", DeleteUser(client))
+	r.GET("/user", api.RequireScope(api.ScopeUserRead), ListUsers(client))
+	r.POST("/user", api.RequireScope(api.ScopeUserWrite), CreateUser(client))
+	r.GET("/user/:id", api.RequireScope(api.ScopeUserRead), GetUser(client))
+	r.PUT("/user/:id", api.RequireScope(api.ScopeUserWrite), UpdateUser(client, sessionManager))
+	r.DELETE("/user/:id", api.RequireScope(api.ScopeUserWrite), DeleteUser(client))
 }
@@ -0,0 +1,124 @@
+package rest
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// restriction limits a service account to the competitions and teams it was assigned. Objects outside of the
+// restriction are treated as if they don't exist. A nil restriction allows everything.
+type restriction struct {
+	competitionIds []uuid.UUID
+	teamIds        []uuid.UUID
+}
+
+// restrictionForContext returns the restriction of the service account making the request
+func restrictionForContext(c *gin.Context) (*restriction, error) {
+	entServiceAccount, err := api.ForContextServiceAccount(c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	if !viewer.FromContext(c.Request.Context()).IsRestricted() {
+		return nil, nil
+	}
+	r := &restriction{}
+	for _, entCompetition := range entServiceAccount.Edges.ServiceAccountToCompetitions {
+		r.competitionIds = append(r.competitionIds, entCompetition.ID)
+	}
+	for _, entTeam := range entServiceAccount.Edges.ServiceAccountToTeams {
+		r.teamIds = append(r.teamIds, entTeam.ID)
+	}
+	return r, nil
+}
+
+// allowAll is a predicate which doesn't filter anything
+func allowAll(*sql.Selector) {}
+
+// readableCompetitions matches the assigned competitions and the competitions of the assigned teams
+func (r *restriction) readableCompetitions() predicate.Competition {
+	if r == nil {
+		return allowAll
+	}
+	return competition.Or(
+		competition.IDIn(r.competitionIds...),
+		competition.HasCompetitionToTeamsWith(team.IDIn(r.teamIds...)),
+	)
+}
+
+// writableCompetitions only matches the assigned competitions, being assigned a team doesn't allow changing its
+// competition
+func (r *restriction) writableCompetitions() predicate.Competition {
+	if r == nil {
+		return allowAll
+	}
+	return competition.IDIn(r.competitionIds...)
+}
+
+// teams matches the assigned teams and every team in the assigned competitions
+func (r *restriction) teams() predicate.Team {
+	if r == nil {
+		return allowAll
+	}
+	return team.Or(
+		team.IDIn(r.teamIds...),
+		team.HasTeamToCompetitionWith(competition.IDIn(r.competitionIds...)),
+	)
+}
+
+// vmObjects matches the vm objects of the teams the service account can access
+func (r *restriction) vmObjects() predicate.VmObject {
+	if r == nil {
+		return allowAll
+	}
+	return vmobject.HasVmObjectToTeamWith(r.teams())
+}
+
+// users matches the members of the teams the service account can access. Users without a team are only visible to
+// unrestricted service accounts.
+func (r *restriction) users() predicate.User {
+	if r == nil {
+		return allowAll
+	}
+	return user.HasUserToTeamMembershipsWith(teammembership.HasTeamMembershipToTeamWith(r.teams()))
+}
+
+// allowsTeam returns whether an object can be assigned to the team (nil means no team)
+func (r *restriction) allowsTeam(entTeam *ent.Team) bool {
+	return r == nil || entTeam != nil
+}
+
+// allowsUser returns whether the existing user can be managed. Competition admins, users with other roles and members
+// of teams outside of the restriction could only have been set up by an unrestricted service account or an admin.
+func (r *restriction) allowsUser(ctx context.Context, entUser *ent.User) (bool, error) {
+	if r == nil {
+		return true, nil
+	}
+	if entUser.Role != user.RoleUSER {
+		return false, nil
+	}
+	// The competitions and teams outside of the restriction have to be visible to these checks
+	ctx = viewer.SystemContext(ctx)
+	administersCompetitions, err := entUser.QueryUserToAdminCompetitions().Exist(ctx)
+	if err != nil || administersCompetitions {
+		return false, err
+	}
+	memberOutside, err := entUser.QueryUserToTeamMemberships().
+		Where(teammembership.HasTeamMembershipToTeamWith(team.Not(r.teams()))).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !memberOutside, nil
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -28,12 +29,18 @@ import (
 //	@Router			/rest/team [get]
 func ListTeams(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		queryField := c.Query("field")
 		if queryField == "" {
 			queryField = "name"
 		}
 
-		entTeamQuery := client.Team.Query().WithTeamToCompetition().WithTeamToVmObjects().WithTeamToUsers()
+		entTeamQuery := client.Team.Query().Where(scope.teams()).WithTeamToCompetition().WithTeamToVmObjects().WithTeamToUsers()
 
 		queryText := c.Query("q")
 		if queryText != "" {
@@ -83,6 +90,12 @@ func ListTeams(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/team/{id} [get]
 func GetTeam(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		teamID := c.Param("id")
 		teamUuid, err := uuid.Parse(teamID)
 		if err != nil {
@@ -93,6 +106,7 @@ func GetTeam(client *ent.Client) gin.HandlerFunc {
 		entTeam, err := client.Team.Query().
 			Where(
 				team.IDEQ(teamUuid),
+				scope.teams(),
 			).
 			WithTeamToCompetition().
 			WithTeamToVmObjects().
@@ -128,6 +142,12 @@ func GetTeam(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/team [post]
 func CreateTeam(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		var newTeam TeamInput
 		if err := c.ShouldBind(&newTeam); err != nil {
 			api.ReturnError(c, http.StatusUnprocessableEntity, "failed to bind to team data", err)
@@ -142,6 +162,7 @@ func CreateTeam(client *ent.Client) gin.HandlerFunc {
 		entCompetition, err := client.Competition.Query().
 			Where(
 				competition.IDEQ(competitionUuid),
+				scope.writableCompetitions(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "competition not found", err)
@@ -190,6 +211,12 @@ func CreateTeam(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/team/{id} [put]
 func UpdateTeam(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		teamID := c.Param("id")
 		teamUuid, err := uuid.Parse(teamID)
 		if err != nil {
@@ -200,6 +227,7 @@ func UpdateTeam(client *ent.Client) gin.HandlerFunc {
 		entTeam, err := client.Team.Query().
 			Where(
 				team.IDEQ(teamUuid),
+				scope.teams(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -224,6 +252,7 @@ func UpdateTeam(client *ent.Client) gin.HandlerFunc {
 		entCompetition, err := client.Competition.Query().
 			Where(
 				competition.IDEQ(competitionUuid),
+				scope.writableCompetitions(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "competition not found", err)
@@ -271,6 +300,12 @@ func UpdateTeam(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/team/{id} [delete]
 func DeleteTeam(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		teamID := c.Param("id")
 		teamUuid, err := uuid.Parse(teamID)
 		if err != nil {
@@ -278,6 +313,16 @@ func DeleteTeam(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		exists, err := client.Team.Query().Where(team.IDEQ(teamUuid), scope.teams()).Exist(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for team", err)
+			return
+		}
+		if !exists {
+			api.ReturnError(c, http.StatusNotFound, "team not found", fmt.Errorf("team not found"))
+			return
+		}
+
 		err = client.Team.DeleteOneID(teamUuid).Exec(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -6,11 +6,17 @@ import (
 	"strings"
 
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/compsole/viewer"
 	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 )
 
 // ListUsers godoc
@@ -28,12 +34,18 @@ import (
 //	@Router			/rest/user [get]
 func ListUsers(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		queryField := c.Query("field")
 		if queryField == "" {
 			queryField = "username"
 		}
 
-		entUserQuery := client.User.Query().WithUserToTeam()
+		entUserQuery := client.User.Query().Where(scope.users()).WithUserToTeam().WithUserToAdminCompetitions()
 
 		queryText := c.Query("q")
 		if queryText != "" {
@@ -80,6 +92,12 @@ func ListUsers(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/user/{id} [get]
 func GetUser(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		userID := c.Param("id")
 		userUuid, err := uuid.Parse(userID)
 		if err != nil {
@@ -89,8 +107,10 @@ func GetUser(client *ent.Client) gin.HandlerFunc {
 		entUser, err := client.User.Query().
 			Where(
 				user.IDEQ(userUuid),
+				scope.users(),
 			).
 			WithUserToTeam().
+			WithUserToAdminCompetitions().
 			Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "user not found", err)
@@ -122,6 +142,12 @@ func GetUser(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/user [post]
 func CreateUser(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		var newUser UserInput
 		if err := c.ShouldBind(&newUser); err != nil {
 			api.ReturnError(c, http.StatusUnprocessableEntity, "failed to bind to user data", err)
@@ -138,6 +164,7 @@ func CreateUser(client *ent.Client) gin.HandlerFunc {
 			entTeam, err = client.Team.Query().
 				Where(
 					team.IDEQ(teamUuid),
+					scope.teams(),
 				).Only(c)
 			if ent.IsNotFound(err) {
 				api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -148,6 +175,19 @@ func CreateUser(client *ent.Client) gin.HandlerFunc {
 				return
 			}
 		}
+		if !scope.allowsTeam(entTeam) || (scope != nil && (user.Role(newUser.Role) != user.RoleUSER || newUser.UserToAdminCompetitions != nil)) {
+			api.ReturnError(c, http.StatusForbidden, "service accounts limited to competitions or teams can only manage users on their teams", fmt.Errorf("restricted service account"))
+			return
+		}
+
+		var adminCompetitionUuids []uuid.UUID
+		if newUser.UserToAdminCompetitions != nil {
+			adminCompetitionUuids, err = parseCompetitionUuids(*newUser.UserToAdminCompetitions)
+			if err != nil {
+				api.ReturnError(c, http.StatusUnprocessableEntity, "failed to parse competition uuid", err)
+				return
+			}
+		}
 
 		entUser, err := client.User.Create().
 			SetUsername(newUser.Username).
@@ -155,13 +195,18 @@ func CreateUser(client *ent.Client) gin.HandlerFunc {
 			SetLastName(newUser.LastName).
 			SetRole(user.Role(newUser.Role)).
 			SetUserToTeam(entTeam).
+			AddUserToAdminCompetitionIDs(adminCompetitionUuids...).
 			Save(c)
 		if err != nil {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to create user", err)
 			return
 		}
+		if err = utils.EnsureTeamMembership(c, client, entUser, entTeam); err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to add user to team", err)
+			return
+		}
 
-		entUser, err = client.User.Query().Where(user.IDEQ(entUser.ID)).WithUserToTeam().Only(c)
+		entUser, err = client.User.Query().Where(user.IDEQ(entUser.ID)).WithUserToTeam().WithUserToAdminCompetitions().Only(c)
 		if err != nil {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to query new user", err)
 			return
@@ -187,8 +232,14 @@ func CreateUser(client *ent.Client) gin.HandlerFunc {
 //	@Failure		404	{object}	api.APIError
 //	@Failure		500	{object}	api.APIError
 //	@Router			/rest/user/{id} [put]
-func UpdateUser(client *ent.Client) gin.HandlerFunc {
+func UpdateUser(client *ent.Client, sessionManager *sessions.Manager) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		userID := c.Param("id")
 		userUuid, err := uuid.Parse(userID)
 		if err != nil {
@@ -198,7 +249,10 @@ func UpdateUser(client *ent.Client) gin.HandlerFunc {
 		entUser, err := client.User.Query().
 			Where(
 				user.IDEQ(userUuid),
-			).Only(c)
+				scope.users(),
+			).
+			WithUserToTeam().
+			Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "user not found", err)
 			return
@@ -224,6 +278,7 @@ func UpdateUser(client *ent.Client) gin.HandlerFunc {
 			entTeam, err = client.Team.Query().
 				Where(
 					team.IDEQ(teamUuid),
+					scope.teams(),
 				).Only(c)
 			if ent.IsNotFound(err) {
 				api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -234,20 +289,59 @@ func UpdateUser(client *ent.Client) gin.HandlerFunc {
 				return
 			}
 		}
+		allowsUser, err := scope.allowsUser(c, entUser)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for user competitions", err)
+			return
+		}
+		if !allowsUser || !scope.allowsTeam(entTeam) || (scope != nil && (user.Role(updatedUser.Role) != user.RoleUSER || updatedUser.UserToAdminCompetitions != nil)) {
+			api.ReturnError(c, http.StatusForbidden, "service accounts limited to competitions or teams can only manage users on their teams", fmt.Errorf("restricted service account"))
+			return
+		}
 
-		entUpdatedUser, err := entUser.Update().
+		roleChanged := entUser.Role != user.Role(updatedUser.Role)
+		entUserUpdate := entUser.Update().
 			SetUsername(updatedUser.Username).
 			SetFirstName(updatedUser.FirstName).
 			SetLastName(updatedUser.LastName).
-			SetRole(user.Role(updatedUser.Role)).
-			SetUserToTeam(entTeam).
-			Save(c)
+			SetRole(user.Role(updatedUser.Role))
+		if entTeam != nil {
+			entUserUpdate = entUserUpdate.SetUserToTeam(entTeam)
+		} else {
+			entUserUpdate = entUserUpdate.ClearUserToTeam()
+		}
+		if updatedUser.UserToAdminCompetitions != nil {
+			adminCompetitionUuids, err := parseCompetitionUuids(*updatedUser.UserToAdminCompetitions)
+			if err != nil {
+				api.ReturnError(c, http.StatusUnprocessableEntity, "failed to parse competition uuid", err)
+				return
+			}
+			currentUuids, err := entUser.QueryUserToAdminCompetitions().IDs(c)
+			if err != nil {
+				api.ReturnError(c, http.StatusInternalServerError, "failed to query admin competitions from user", err)
+				return
+			}
+			roleChanged = roleChanged || !sameUuids(currentUuids, adminCompetitionUuids)
+			entUserUpdate = entUserUpdate.ClearUserToAdminCompetitions().AddUserToAdminCompetitionIDs(adminCompetitionUuids...)
+		}
+		entUpdatedUser, err := entUserUpdate.Save(c)
 		if err != nil {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to update user", err)
 			return
 		}
+		if err = utils.ReplaceActiveTeamMembership(c, client, entUpdatedUser, entUser.Edges.UserToTeam, entTeam); err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to move user to team", err)
+			return
+		}
+		// Sessions were authorized for the old role, make the user sign in again
+		if roleChanged {
+			if err = revokeUserSessions(c, client, sessionManager, entUpdatedUser, "role changed"); err != nil {
+				api.ReturnError(c, http.StatusInternalServerError, "user was updated but failed to revoke their sessions", err)
+				return
+			}
+		}
 
-		entUpdatedUser, err = client.User.Query().Where(user.IDEQ(entUpdatedUser.ID)).WithUserToTeam().Only(c)
+		entUpdatedUser, err = client.User.Query().Where(user.IDEQ(entUpdatedUser.ID)).WithUserToTeam().WithUserToAdminCompetitions().Only(c)
 		if err != nil {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to query new user", err)
 			return
@@ -274,6 +368,12 @@ func UpdateUser(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/user/{id} [delete]
 func DeleteUser(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		userID := c.Param("id")
 		userUuid, err := uuid.Parse(userID)
 		if err != nil {
@@ -281,13 +381,32 @@ func DeleteUser(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		entUser, err := client.User.Query().Where(user.IDEQ(userUuid), scope.users()).Only(c)
+		if ent.IsNotFound(err) {
+			api.ReturnError(c, http.StatusNotFound, "user not found", err)
+			return
+		}
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for user", err)
+			return
+		}
+		allowsUser, err := scope.allowsUser(c, entUser)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for user competitions", err)
+			return
+		}
+		if !allowsUser {
+			api.ReturnError(c, http.StatusForbidden, "service accounts limited to competitions or teams can only manage users on their teams", fmt.Errorf("restricted service account"))
+			return
+		}
+
 		// Must maintain at least one admin user in the database (count all admin users who's ID's don't match the one we're deleting)
 		if userCount, err := client.User.Query().Where(
 			user.And(
 				user.IDNEQ(userUuid),
 				user.RoleEQ(user.RoleADMIN),
 			),
-		).Count(c); err != nil {
+		).Count(viewer.SystemContext(c)); err != nil {
 			api.ReturnError(c, http.StatusInternalServerError, "failed to count users", err)
 			return
 		} else if userCount <= 0 {
@@ -309,3 +428,56 @@ func DeleteUser(client *ent.Client) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// parseCompetitionUuids parses the competition ids of a UserInput
+// sameUuids returns whether a and b contain the same ids, in any order
+func sameUuids(a []uuid.UUID, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[uuid.UUID]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// revokeUserSessions signs the user out of every session and logs why
+func revokeUserSessions(c *gin.Context, client *ent.Client, sessionManager *sessions.Manager, entUser *ent.User, reason string) error {
+	revoked, err := sessionManager.Revoke(c, token.HasTokenToUserWith(user.IDEQ(entUser.ID)))
+	if err != nil || revoked == 0 {
+		return err
+	}
+	clientIp, err := api.ForContextIp(c)
+	if err != nil {
+		logrus.Warnf("failed to get IP from gin context: %v", err)
+	}
+	actionCreate := client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeREVOKE_SESSION).
+		SetMessage(fmt.Sprintf("revoked %d sessions for user %s (%s)", revoked, entUser.Username, reason))
+	if entServiceAccount, err := api.ForContextServiceAccount(c.Request.Context()); err == nil {
+		actionCreate.SetActionToServiceAccount(entServiceAccount)
+	}
+	if err = actionCreate.Exec(c); err != nil {
+		logrus.Warnf("failed to log REVOKE_SESSION: %v", err)
+	}
+	return nil
+}
+
+func parseCompetitionUuids(competitionIds []string) ([]uuid.UUID, error) {
+	competitionUuids := make([]uuid.UUID, len(competitionIds))
+	for i, competitionId := range competitionIds {
+		competitionUuid, err := uuid.Parse(competitionId)
+		if err != nil {
+			return nil, err
+		}
+		competitionUuids[i] = competitionUuid
+	}
+	return competitionUuids, nil
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -27,12 +28,18 @@ import (
 //	@Router			/rest/vm-object [get]
 func ListVmObjects(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		queryField := c.Query("field")
 		if queryField == "" {
 			queryField = "name"
 		}
 
-		entVmObjectQuery := client.VmObject.Query().WithVmObjectToTeam()
+		entVmObjectQuery := client.VmObject.Query().Where(scope.vmObjects()).WithVmObjectToTeam()
 
 		queryText := c.Query("q")
 		if queryText != "" {
@@ -77,6 +84,12 @@ func ListVmObjects(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/vm-object/{id} [get]
 func GetVMObject(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		vmObjectID := c.Param("id")
 		vmObjectUuid, err := uuid.Parse(vmObjectID)
 		if err != nil {
@@ -87,6 +100,7 @@ func GetVMObject(client *ent.Client) gin.HandlerFunc {
 		entVmObject, err := client.VmObject.Query().
 			Where(
 				vmobject.IDEQ(vmObjectUuid),
+				scope.vmObjects(),
 			).
 			WithVmObjectToTeam().
 			Only(c)
@@ -120,6 +134,12 @@ func GetVMObject(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/vm-object [post]
 func CreateVMObject(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		var newVmObject VmObjectInput
 		if err := c.ShouldBind(&newVmObject); err != nil {
 			api.ReturnError(c, http.StatusUnprocessableEntity, "failed to bind to vm_object data", err)
@@ -134,6 +154,7 @@ func CreateVMObject(client *ent.Client) gin.HandlerFunc {
 		entTeam, err := client.Team.Query().
 			Where(
 				team.IDEQ(teamUuid),
+				scope.teams(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -183,6 +204,12 @@ func CreateVMObject(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/vm-object/{id} [put]
 func UpdateVMObject(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		vmObjectID := c.Param("id")
 		vmObjectUuid, err := uuid.Parse(vmObjectID)
 		if err != nil {
@@ -193,6 +220,7 @@ func UpdateVMObject(client *ent.Client) gin.HandlerFunc {
 		entVmObject, err := client.VmObject.Query().
 			Where(
 				vmobject.IDEQ(vmObjectUuid),
+				scope.vmObjects(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "vm object not found", err)
@@ -217,6 +245,7 @@ func UpdateVMObject(client *ent.Client) gin.HandlerFunc {
 		entTeam, err := client.Team.Query().
 			Where(
 				team.IDEQ(teamUuid),
+				scope.teams(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "team not found", err)
@@ -266,6 +295,12 @@ func UpdateVMObject(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/vm-object/{id}/identifier [put]
 func UpdateVMObjectIdentifier(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		vmObjectID := c.Param("id")
 		vmObjectUuid, err := uuid.Parse(vmObjectID)
 		if err != nil {
@@ -276,6 +311,7 @@ func UpdateVMObjectIdentifier(client *ent.Client) gin.HandlerFunc {
 		entVmObject, err := client.VmObject.Query().
 			Where(
 				vmobject.IDEQ(vmObjectUuid),
+				scope.vmObjects(),
 			).Only(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "vm object not found", err)
@@ -327,6 +363,12 @@ func UpdateVMObjectIdentifier(client *ent.Client) gin.HandlerFunc {
 //	@Router			/rest/vm-object/{id} [delete]
 func DeleteVMObject(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		scope, err := restrictionForContext(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+
 		vmObjectID := c.Param("id")
 		vmObjectUuid, err := uuid.Parse(vmObjectID)
 		if err != nil {
@@ -334,6 +376,16 @@ func DeleteVMObject(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		exists, err := client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid), scope.vmObjects()).Exist(c)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to query for vm object", err)
+			return
+		}
+		if !exists {
+			api.ReturnError(c, http.StatusNotFound, "vm object not found", fmt.Errorf("vm object not found"))
+			return
+		}
+
 		err = client.VmObject.DeleteOneID(vmObjectUuid).Exec(c)
 		if ent.IsNotFound(err) {
 			api.ReturnError(c, http.StatusNotFound, "vm object not found", err)
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// Scope is a permission granted to a service account
+type Scope string
+
+const (
+	ScopeVmRead           Scope = "vm:read"
+	ScopeVmWrite          Scope = "vm:write"
+	ScopeVmPower          Scope = "vm:power"
+	ScopeCompetitionRead  Scope = "competition:read"
+	ScopeCompetitionWrite Scope = "competition:write"
+	ScopeTeamRead         Scope = "team:read"
+	ScopeTeamWrite        Scope = "team:write"
+	ScopeUserRead         Scope = "user:read"
+	ScopeUserWrite        Scope = "user:write"
+	// Providers hold the credentials for the infrastructure, so they can only be used by service accounts which aren't
+	// limited to specific competitions or teams
+	ScopeProviderRead  Scope = "provider:read"
+	ScopeProviderWrite Scope = "provider:write"
+)
+
+// AllScopes is every scope, in the order they are displayed
+var AllScopes = []Scope{
+	ScopeVmRead,
+	ScopeVmWrite,
+	ScopeVmPower,
+	ScopeCompetitionRead,
+	ScopeCompetitionWrite,
+	ScopeTeamRead,
+	ScopeTeamWrite,
+	ScopeUserRead,
+	ScopeUserWrite,
+	ScopeProviderRead,
+	ScopeProviderWrite,
+}
+
+// AllScopeStrings returns AllScopes as strings, for storing on a service account
+func AllScopeStrings() []string {
+	scopes := make([]string, len(AllScopes))
+	for i, scope := range AllScopes {
+		scopes[i] = string(scope)
+	}
+	return scopes
+}
+
+// ForContextServiceAccount finds the service account from the context. REQUIRES ServiceMiddleware to have run.
+func ForContextServiceAccount(ctx context.Context) (*ent.ServiceAccount, error) {
+	raw, ok := ctx.Value(userCtxKey).(*ent.ServiceAccount)
+	if ok {
+		return raw, nil
+	}
+	return nil, errors.New("unable to get service account from context")
+}
+
+// HasScope returns whether the service account was granted the scope
+func HasScope(entServiceAccount *ent.ServiceAccount, scope Scope) bool {
+	for _, granted := range entServiceAccount.Scopes {
+		if granted == string(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireScope rejects requests from service accounts which weren't granted the scope. REQUIRES ServiceMiddleware to
+// have run.
+func RequireScope(scope Scope) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		entServiceAccount, err := ForContextServiceAccount(ctx.Request.Context())
+		if err != nil {
+			ReturnError(ctx, http.StatusUnauthorized, "failed to get service account from context", err)
+			return
+		}
+		if !HasScope(entServiceAccount, scope) {
+			ReturnError(ctx, http.StatusForbidden, fmt.Sprintf("service account is missing the \"%s\" scope", scope), fmt.Errorf("missing scope"))
+			return
+		}
+		if (scope == ScopeProviderRead || scope == ScopeProviderWrite) && viewer.FromContext(ctx.Request.Context()).IsRestricted() {
+			ReturnError(ctx, http.StatusForbidden, "service accounts limited to competitions or teams can't access providers", fmt.Errorf("restricted service account"))
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,97 @@
+package allowlist
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Validate returns an error for the first network which isn't in CIDR notation (eg. "10.0.1.0/24")
+func Validate(cidrs []string) error {
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("\"%s\" is not a valid CIDR: %v", cidr, err)
+		}
+	}
+	return nil
+}
+
+// Contains returns whether the IP is in any of the networks. An empty allowlist contains every IP.
+func Contains(cidrs []string, ip string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	parsedIp := net.ParseIP(ip)
+	if parsedIp == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(parsedIp) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExempt returns whether the user can connect to the competition from anywhere. Users who administer the
+// competition (see permissions.AdministersCompetition) aren't held to its allowlists.
+func IsExempt(ctx context.Context, entUser *ent.User, competitionId uuid.UUID) (bool, error) {
+	isExempt, err := permissions.AdministersCompetition(ctx, entUser, competitionId)
+	if err != nil {
+		return false, fmt.Errorf("failed to check permission: %v", err)
+	}
+	return isExempt, nil
+}
+
+// TeamAllows returns whether the IP is in both the team's allowlist and its competition's allowlist. REQUIRES the
+// TeamToCompetition edge to be loaded.
+func TeamAllows(entTeam *ent.Team, ip string) bool {
+	return Contains(entTeam.AllowedCidrs, ip) && Contains(entTeam.Edges.TeamToCompetition.AllowedCidrs, ip)
+}
+
+// IsAllowed returns whether the user can connect from the IP. The IP has to be allowed by the user's active team (see
+// TeamAllows). Users exempt from the team's competition (see IsExempt) and users without an active team can connect
+// from anywhere.
+func IsAllowed(ctx context.Context, entUser *ent.User, ip string) (bool, error) {
+	entTeam, err := entUser.QueryUserToTeam().WithTeamToCompetition().Only(ctx)
+	if ent.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to query active team: %v", err)
+	}
+	isExempt, err := IsExempt(ctx, entUser, entTeam.Edges.TeamToCompetition.ID)
+	if err != nil || isExempt {
+		return isExempt, err
+	}
+	return TeamAllows(entTeam, ip), nil
+}
+
+// Check returns whether the user can connect from the IP (see IsAllowed) and logs an IP_NOT_ALLOWED action when they
+// can't. attempt describes what the user was trying to do (eg. "sign in").
+func Check(ctx context.Context, client *ent.Client, entUser *ent.User, ip string, attempt string) (bool, error) {
+	allowed, err := IsAllowed(ctx, entUser, ip)
+	if err != nil || allowed {
+		return allowed, err
+	}
+	err = client.Action.Create().
+		SetIPAddress(ip).
+		SetType(action.TypeIP_NOT_ALLOWED).
+		SetMessage(fmt.Sprintf("user \"%s\" tried to %s from %s, which isn't allowed for their team", entUser.Username, attempt, ip)).
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log IP_NOT_ALLOWED: %v", err)
+	}
+	return false, nil
+}
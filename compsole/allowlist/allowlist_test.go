@@ -0,0 +1,91 @@
+package allowlist
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name  string
+		cidrs []string
+		ip    string
+		want  bool
+	}{
+		{"empty allowlist contains every ip", nil, "203.0.113.7", true},
+		{"empty allowlist contains invalid ips", []string{}, "not an ip", true},
+		{"ipv4 in the network", []string{"10.0.1.0/24"}, "10.0.1.25", true},
+		{"ipv4 outside the network", []string{"10.0.1.0/24"}, "10.0.2.25", false},
+		{"ipv4 in the second network", []string{"10.0.1.0/24", "192.168.0.0/16"}, "192.168.4.1", true},
+		{"single address network", []string{"10.0.1.5/32"}, "10.0.1.5", true},
+		{"ipv6 in the network", []string{"2001:db8::/32"}, "2001:db8::1", true},
+		{"ipv6 outside the network", []string{"2001:db8::/32"}, "2001:db9::1", false},
+		{"ipv4 mapped ipv6 address", []string{"10.0.1.0/24"}, "::ffff:10.0.1.25", true},
+		{"invalid ip", []string{"10.0.1.0/24"}, "10.0.1", false},
+		{"missing ip", []string{"10.0.1.0/24"}, "", false},
+		{"invalid cidr is skipped", []string{"10.0.1.0/33", "10.0.2.0/24"}, "10.0.2.25", true},
+		{"only invalid cidrs contain nothing", []string{"10.0.1.0"}, "10.0.1.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Contains(tt.cidrs, tt.ip); got != tt.want {
+				t.Errorf("Contains(%v, %q) = %v, want %v", tt.cidrs, tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllowed(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	entCompetition := client.Competition.Create().SetName("competition").SetAllowedCidrs([]string{"10.0.0.0/16"}).SetCompetitionToProvider(entProvider).SaveX(ctx)
+	otherCompetition := client.Competition.Create().SetName("other").SetCompetitionToProvider(entProvider).SaveX(ctx)
+	entTeam := client.Team.Create().SetTeamNumber(1).SetAllowedCidrs([]string{"10.0.1.0/24"}).SetTeamToCompetition(entCompetition).SaveX(ctx)
+	newUser := func(username string, role user.Role, entTeam *ent.Team, adminCompetition *ent.Competition) *ent.User {
+		userCreate := client.User.Create().SetUsername(username).SetPassword("hash").SetRole(role).SetProvider(user.ProviderLOCAL)
+		if entTeam != nil {
+			userCreate.SetUserToTeam(entTeam)
+		}
+		if adminCompetition != nil {
+			userCreate.AddUserToAdminCompetitions(adminCompetition)
+		}
+		return userCreate.SaveX(ctx)
+	}
+	competitor := newUser("competitor", user.RoleUSER, entTeam, nil)
+
+	tests := []struct {
+		name    string
+		entUser *ent.User
+		ip      string
+		want    bool
+	}{
+		{"competitor on the team's network", competitor, "10.0.1.25", true},
+		{"competitor on the competition's network but not the team's", competitor, "10.0.2.25", false},
+		{"competitor outside the networks", competitor, "203.0.113.7", false},
+		{"user without a team", newUser("no team", user.RoleUSER, nil, nil), "203.0.113.7", true},
+		{"staff outside the networks", newUser("white", user.RoleWHITE_TEAM, entTeam, nil), "203.0.113.7", true},
+		{"admin of the team's competition outside the networks", newUser("admin", user.RoleUSER, entTeam, entCompetition), "203.0.113.7", true},
+		{"admin of another competition outside the networks", newUser("other admin", user.RoleUSER, entTeam, otherCompetition), "203.0.113.7", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllowed(ctx, tt.entUser, tt.ip)
+			if err != nil {
+				t.Fatalf("failed to check allowlist: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
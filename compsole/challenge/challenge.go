@@ -0,0 +1,91 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/go-redis/redis/v8"
+)
+
+// Kind separates the challenges of each login step, so an id issued for one step can't be used for another
+type Kind string
+
+const (
+	// KindMFA proves the user entered the right password and still needs to enter their second factor
+	KindMFA Kind = "mfa"
+	// KindWebAuthn holds a WebAuthn ceremony between its begin and finish requests
+	KindWebAuthn Kind = "webauthn"
+)
+
+// Store keeps login challenges in redis until they are used. Browsers only hold a random id for the challenge, so a
+// challenge can't be replayed once Consume has deleted it.
+type Store struct {
+	rdb *redis.Client
+}
+
+func New(rdb *redis.Client) *Store {
+	return &Store{
+		rdb: rdb,
+	}
+}
+
+// key is derived from the hash of the id, so the ids can't be read back out of redis
+func key(kind Kind, id string) string {
+	return fmt.Sprintf("login_challenge:%s:%s", kind, utils.HashToken(id))
+}
+
+// Create stores the value as JSON and returns the id of the new challenge. The challenge expires after ttl.
+func (s *Store) Create(ctx context.Context, kind Kind, value interface{}, ttl time.Duration) (string, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal challenge: %v", err)
+	}
+	id, err := utils.NewToken()
+	if err != nil {
+		return "", err
+	}
+	if err := s.rdb.Set(ctx, key(kind, id), valueBytes, ttl).Err(); err != nil {
+		return "", fmt.Errorf("failed to store challenge: %v", err)
+	}
+	return id, nil
+}
+
+// Peek decodes the challenge into value without using it up. Returns false if the challenge doesn't exist or has
+// expired.
+func (s *Store) Peek(ctx context.Context, kind Kind, id string, value interface{}) (bool, error) {
+	valueBytes, err := s.rdb.Get(ctx, key(kind, id)).Bytes()
+	return decode(valueBytes, err, value)
+}
+
+// Consume deletes the challenge and decodes it into value. The get and delete run in one transaction, so only one
+// request can consume each challenge. Returns false if the challenge doesn't exist, has expired or was already consumed.
+func (s *Store) Consume(ctx context.Context, kind Kind, id string, value interface{}) (bool, error) {
+	// GETDEL would do this in one command but needs redis 6.2
+	var get *redis.StringCmd
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key(kind, id))
+		pipe.Del(ctx, key(kind, id))
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return false, fmt.Errorf("failed to consume challenge: %v", err)
+	}
+	valueBytes, err := get.Bytes()
+	return decode(valueBytes, err, value)
+}
+
+func decode(valueBytes []byte, err error, value interface{}) (bool, error) {
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get challenge: %v", err)
+	}
+	if err := json.Unmarshal(valueBytes, value); err != nil {
+		return false, fmt.Errorf("failed to unmarshal challenge: %v", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,70 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestStore(t *testing.T) (*Store, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return New(redis.NewClient(&redis.Options{Addr: mr.Addr()})), mr
+}
+
+func TestConsume(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		kind      Kind
+		peekFirst bool
+		consumed  bool
+		expired   bool
+		wantFound bool
+	}{
+		{"unused challenge", KindMFA, false, false, false, true},
+		{"peek doesn't use up the challenge", KindMFA, true, false, false, true},
+		{"challenge can only be consumed once", KindMFA, false, true, false, false},
+		{"expired challenge", KindMFA, false, false, true, false},
+		{"challenge for a different step", KindWebAuthn, false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, mr := newTestStore(t)
+			id, err := store.Create(ctx, KindMFA, "alice", time.Minute)
+			if err != nil {
+				t.Fatalf("failed to create challenge: %v", err)
+			}
+			var value string
+			if tt.peekFirst {
+				if found, err := store.Peek(ctx, KindMFA, id, &value); err != nil || !found {
+					t.Fatalf("failed to peek challenge: %v", err)
+				}
+			}
+			if tt.consumed {
+				if found, err := store.Consume(ctx, KindMFA, id, &value); err != nil || !found {
+					t.Fatalf("failed to consume challenge: %v", err)
+				}
+			}
+			if tt.expired {
+				mr.FastForward(2 * time.Minute)
+			}
+
+			value = ""
+			found, err := store.Consume(ctx, tt.kind, id, &value)
+			if err != nil {
+				t.Fatalf("failed to consume challenge: %v", err)
+			}
+			if found != tt.wantFound {
+				t.Fatalf("got found %v, want %v", found, tt.wantFound)
+			}
+			if found && value != "alice" {
+				t.Errorf("got value %q, want %q", value, "alice")
+			}
+		})
+	}
+}
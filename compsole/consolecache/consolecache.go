@@ -0,0 +1,87 @@
+package consolecache
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// expiryMargin is subtracted from the provider's url lifetime so a cached url is never handed out moments before it expires
+const expiryMargin = 30 * time.Second
+
+var (
+	hits   = expvar.NewInt("console_url_cache_hits")
+	misses = expvar.NewInt("console_url_cache_misses")
+)
+
+// ConsoleCache stores issued console urls in redis per user, vm object and console type
+type ConsoleCache struct {
+	rdb *redis.Client
+}
+
+func New(rdb *redis.Client) *ConsoleCache {
+	return &ConsoleCache{
+		rdb: rdb,
+	}
+}
+
+func urlKey(vmObjectId uuid.UUID, userId uuid.UUID, consoleType utils.ConsoleType) string {
+	return fmt.Sprintf("console_url:%s:%s:%s", vmObjectId, userId, consoleType)
+}
+
+// vmKey is a set of all the url keys cached for a vm object so they can be invalidated together
+func vmKey(vmObjectId uuid.UUID) string {
+	return fmt.Sprintf("console_url_keys:%s", vmObjectId)
+}
+
+// Get returns the cached console url if one hasn't expired
+func (cc *ConsoleCache) Get(ctx context.Context, vmObjectId uuid.UUID, userId uuid.UUID, consoleType utils.ConsoleType) (string, bool, error) {
+	consoleUrl, err := cc.rdb.Get(ctx, urlKey(vmObjectId, userId, consoleType)).Result()
+	if err == redis.Nil {
+		misses.Add(1)
+		return "", false, nil
+	}
+	if err != nil {
+		misses.Add(1)
+		return "", false, fmt.Errorf("failed to get cached console url: %v", err)
+	}
+	hits.Add(1)
+	return consoleUrl, true, nil
+}
+
+// Set caches the console url for the lifetime of the provider's console token
+func (cc *ConsoleCache) Set(ctx context.Context, vmObjectId uuid.UUID, userId uuid.UUID, consoleType utils.ConsoleType, consoleUrl string, lifetime time.Duration) error {
+	ttl := lifetime - expiryMargin
+	if ttl <= 0 {
+		return nil
+	}
+	key := urlKey(vmObjectId, userId, consoleType)
+	_, err := cc.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, consoleUrl, ttl)
+		pipe.SAdd(ctx, vmKey(vmObjectId), key)
+		pipe.Expire(ctx, vmKey(vmObjectId), ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cache console url: %v", err)
+	}
+	return nil
+}
+
+// Invalidate removes every cached console url for the vm object
+func (cc *ConsoleCache) Invalidate(ctx context.Context, vmObjectId uuid.UUID) error {
+	keys, err := cc.rdb.SMembers(ctx, vmKey(vmObjectId)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get cached console urls: %v", err)
+	}
+	keys = append(keys, vmKey(vmObjectId))
+	if err := cc.rdb.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate cached console urls: %v", err)
+	}
+	return nil
+}
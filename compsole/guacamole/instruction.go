@@ -0,0 +1,120 @@
+package guacamole
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// InternalOpcode is the opcode reserved for messages between the tunnel and the client which are never sent to guacd
+const InternalOpcode = ""
+
+// maxElementLength prevents a malformed length prefix from allocating unbounded memory
+const maxElementLength = 8 * 1024 * 1024
+
+// Instruction is a single Guacamole protocol instruction (eg. "4.size,4.1024,3.768;")
+type Instruction struct {
+	Opcode string
+	Args   []string
+}
+
+// NewInstruction creates an instruction with the given opcode and arguments
+func NewInstruction(opcode string, args ...string) *Instruction {
+	return &Instruction{
+		Opcode: opcode,
+		Args:   args,
+	}
+}
+
+// String encodes the instruction into its wire format. Element lengths are counted in Unicode code points.
+func (i *Instruction) String() string {
+	var sb strings.Builder
+	writeElement(&sb, i.Opcode)
+	for _, arg := range i.Args {
+		sb.WriteByte(',')
+		writeElement(&sb, arg)
+	}
+	sb.WriteByte(';')
+	return sb.String()
+}
+
+func writeElement(sb *strings.Builder, value string) {
+	sb.WriteString(strconv.Itoa(utf8.RuneCountInString(value)))
+	sb.WriteByte('.')
+	sb.WriteString(value)
+}
+
+// ReadInstruction reads the next full instruction from r
+func ReadInstruction(r io.RuneReader) (*Instruction, error) {
+	elements := make([]string, 0, 4)
+	for {
+		element, terminator, err := readElement(r)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+		if terminator == ';' {
+			break
+		}
+	}
+	return NewInstruction(elements[0], elements[1:]...), nil
+}
+
+// ParseInstructions parses every instruction contained in data
+func ParseInstructions(data string) ([]*Instruction, error) {
+	reader := strings.NewReader(data)
+	instructions := make([]*Instruction, 0, 1)
+	for reader.Len() > 0 {
+		instruction, err := ReadInstruction(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse instruction: %v", err)
+		}
+		instructions = append(instructions, instruction)
+	}
+	return instructions, nil
+}
+
+// readElement reads a single "LENGTH.VALUE" element and the terminator which follows it
+func readElement(r io.RuneReader) (string, rune, error) {
+	length := 0
+	for {
+		c, _, err := r.ReadRune()
+		if err != nil {
+			return "", 0, err
+		}
+		if c == '.' {
+			break
+		}
+		if c < '0' || c > '9' {
+			return "", 0, fmt.Errorf("unexpected character %q in element length", c)
+		}
+		length = length*10 + int(c-'0')
+		if length > maxElementLength {
+			return "", 0, fmt.Errorf("element length exceeds %d", maxElementLength)
+		}
+	}
+	var sb strings.Builder
+	for i := 0; i < length; i++ {
+		c, _, err := r.ReadRune()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", 0, err
+		}
+		sb.WriteRune(c)
+	}
+	terminator, _, err := r.ReadRune()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", 0, err
+	}
+	if terminator != ',' && terminator != ';' {
+		return "", 0, fmt.Errorf("unexpected terminator %q", terminator)
+	}
+	return sb.String(), terminator, nil
+}
@@ -0,0 +1,108 @@
+package guacamole
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestInstructionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		instruction *Instruction
+		want        string
+	}{
+		{"no args", NewInstruction("nop"), "3.nop;"},
+		{"args", NewInstruction("size", "1024", "768"), "4.size,4.1024,3.768;"},
+		{"empty arg", NewInstruction("audio", ""), "5.audio,0.;"},
+		{"internal opcode", NewInstruction(InternalOpcode, "ping", "1700000000"), "0.,4.ping,10.1700000000;"},
+		{"lengths count code points", NewInstruction("clipboard", "héllo", "日本"), "9.clipboard,5.héllo,2.日本;"},
+		{"separators in values", NewInstruction("key", "1.a,2;", "1"), "3.key,6.1.a,2;,1.1;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.instruction.String()
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			parsed, err := ParseInstructions(got)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", got, err)
+			}
+			if len(parsed) != 1 {
+				t.Fatalf("got %d instructions, want 1", len(parsed))
+			}
+			if parsed[0].Opcode != tt.instruction.Opcode || fmt.Sprintf("%q", parsed[0].Args) != fmt.Sprintf("%q", tt.instruction.Args) {
+				t.Errorf("got opcode %q and args %q, want %q and %q", parsed[0].Opcode, parsed[0].Args, tt.instruction.Opcode, tt.instruction.Args)
+			}
+		})
+	}
+}
+
+func TestParseInstructions(t *testing.T) {
+	instructions, err := ParseInstructions("4.sync,8.12345678;3.nop;5.mouse,3.100,3.200,1.1;")
+	if err != nil {
+		t.Fatalf("failed to parse instructions: %v", err)
+	}
+	got := make([]string, len(instructions))
+	for i, instruction := range instructions {
+		got[i] = instruction.String()
+	}
+	want := []string{"4.sync,8.12345678;", "3.nop;", "5.mouse,3.100,3.200,1.1;"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseInstructionsMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"letter in length", "4.size,x.1024;", nil},
+		{"negative length", "-1.a;", nil},
+		{"missing length separator", "4size;", nil},
+		{"length longer than the value", "10.size;", io.ErrUnexpectedEOF},
+		{"length shorter than the value", "2.size;", nil},
+		{"missing terminator", "4.size", io.ErrUnexpectedEOF},
+		{"unexpected terminator", "4.size:", nil},
+		{"truncated instruction", "4.size,4.1024,", io.EOF},
+		{"truncated length", "4", io.EOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadInstruction(strings.NewReader(tt.data))
+			if err == nil {
+				t.Fatalf("parsed malformed instruction %q", tt.data)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if _, err := ParseInstructions(tt.data); err == nil {
+				t.Errorf("parsed malformed message %q", tt.data)
+			}
+		})
+	}
+}
+
+func TestReadInstructionElementCap(t *testing.T) {
+	value := strings.Repeat("a", maxElementLength)
+	instruction, err := ReadInstruction(strings.NewReader(NewInstruction("blob", "0", value).String()))
+	if err != nil {
+		t.Fatalf("failed to read an element of the max length: %v", err)
+	}
+	if len(instruction.Args) != 2 || len(instruction.Args[1]) != maxElementLength {
+		t.Errorf("got %d args, want the blob's stream and data", len(instruction.Args))
+	}
+
+	// The length is rejected before any of the value is read
+	for _, length := range []string{fmt.Sprint(maxElementLength + 1), "99999999999999999999"} {
+		_, err = ReadInstruction(strings.NewReader(fmt.Sprintf("4.blob,1.0,%s.", length)))
+		if err == nil || !strings.Contains(err.Error(), "exceeds") {
+			t.Errorf("got error %v for length %s, want the element cap", err, length)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package guacamole
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ProtocolVersion is the Guacamole protocol version Compsole speaks to guacd
+const ProtocolVersion = "VERSION_1_5_0"
+
+const handshakeTimeout = 15 * time.Second
+
+// Config describes the remote desktop connection guacd should establish
+type Config struct {
+	// Protocol is the guacd client plugin to use (eg. "ssh" or "rdp")
+	Protocol string
+	// Parameters are the connection parameters (eg. "hostname" or "password"). Any parameters guacd requests which are not set are sent empty.
+	Parameters map[string]string
+	Width      int
+	Height     int
+	DPI        int
+	// Timezone is optional and should be an IANA timezone (eg. "America/New_York")
+	Timezone       string
+	AudioMimetypes []string
+	VideoMimetypes []string
+	ImageMimetypes []string
+}
+
+// Stream is an established connection to guacd
+type Stream struct {
+	// ConnectionID is the id guacd assigned to this connection during the handshake
+	ConnectionID string
+
+	conn    net.Conn
+	reader  *bufio.Reader
+	writeMu sync.Mutex
+}
+
+// Dial connects to guacd at address and performs the handshake for config
+func Dial(ctx context.Context, address string, config *Config) (*Stream, error) {
+	dialer := net.Dialer{Timeout: handshakeTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to guacd: %v", err)
+	}
+	stream := &Stream{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := stream.handshake(config); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return stream, nil
+}
+
+func (s *Stream) handshake(config *Config) error {
+	if err := s.WriteInstructions(NewInstruction("select", config.Protocol)); err != nil {
+		return fmt.Errorf("failed to send select: %v", err)
+	}
+	args, err := s.expect("args")
+	if err != nil {
+		return err
+	}
+
+	handshake := []*Instruction{
+		NewInstruction("size", strconv.Itoa(config.Width), strconv.Itoa(config.Height), strconv.Itoa(config.DPI)),
+		NewInstruction("audio", config.AudioMimetypes...),
+		NewInstruction("video", config.VideoMimetypes...),
+		NewInstruction("image", config.ImageMimetypes...),
+	}
+	if config.Timezone != "" {
+		handshake = append(handshake, NewInstruction("timezone", config.Timezone))
+	}
+	// Connect arguments must be in the order guacd requested them
+	connectArgs := make([]string, len(args.Args))
+	for i, argName := range args.Args {
+		if strings.HasPrefix(argName, "VERSION_") {
+			connectArgs[i] = ProtocolVersion
+			continue
+		}
+		connectArgs[i] = config.Parameters[argName]
+	}
+	handshake = append(handshake, NewInstruction("connect", connectArgs...))
+	if err := s.WriteInstructions(handshake...); err != nil {
+		return fmt.Errorf("failed to send handshake: %v", err)
+	}
+
+	ready, err := s.expect("ready")
+	if err != nil {
+		return err
+	}
+	if len(ready.Args) > 0 {
+		s.ConnectionID = ready.Args[0]
+	}
+	return nil
+}
+
+// expect reads the next instruction and ensures it has the given opcode
+func (s *Stream) expect(opcode string) (*Instruction, error) {
+	instruction, err := s.ReadInstruction()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read \"%s\" from guacd: %v", opcode, err)
+	}
+	if instruction.Opcode == "error" {
+		return nil, fmt.Errorf("guacd returned an error: %s", strings.Join(instruction.Args, ": "))
+	}
+	if instruction.Opcode != opcode {
+		return nil, fmt.Errorf("expected \"%s\" from guacd but got \"%s\"", opcode, instruction.Opcode)
+	}
+	return instruction, nil
+}
+
+// ReadInstruction reads the next instruction sent by guacd
+func (s *Stream) ReadInstruction() (*Instruction, error) {
+	return ReadInstruction(s.reader)
+}
+
+// Buffered returns the number of bytes which can be read without blocking on the connection
+func (s *Stream) Buffered() int {
+	return s.reader.Buffered()
+}
+
+// WriteInstructions sends the instructions to guacd. It is safe to call from multiple goroutines.
+func (s *Stream) WriteInstructions(instructions ...*Instruction) error {
+	var sb strings.Builder
+	for _, instruction := range instructions {
+		sb.WriteString(instruction.String())
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_, err := s.conn.Write([]byte(sb.String()))
+	return err
+}
+
+// Close closes the connection to guacd
+func (s *Stream) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,228 @@
+package mfa
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image/png"
+	"os"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// period is the number of seconds each TOTP code is valid for
+	period = 30
+	// skew is the number of periods before and after the current one which are also accepted, to allow for clock drift
+	skew = 1
+	// recoveryCodeCount is the number of recovery codes generated when enrolling
+	recoveryCodeCount = 10
+)
+
+// Enrollment is a newly generated TOTP secret which hasn't been confirmed yet
+type Enrollment struct {
+	Secret string
+	// ProvisioningURI is the otpauth:// uri authenticator apps import
+	ProvisioningURI string
+	// QRCode is a PNG data url of the provisioning uri
+	QRCode string
+}
+
+// Required returns whether users with the role must use multi-factor authentication. Set with MFA_REQUIRED_ROLES
+// (a comma separated list of roles, eg. "ADMIN").
+func Required(entUser *ent.User) bool {
+	// External providers are responsible for their own multi-factor authentication
+	if entUser.Provider != user.ProviderLOCAL {
+		return false
+	}
+	for _, role := range strings.Split(os.Getenv("MFA_REQUIRED_ROLES"), ",") {
+		if strings.EqualFold(strings.TrimSpace(role), string(entUser.Role)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Enabled returns whether the user has a second factor (TOTP or a passkey) set up
+func Enabled(ctx context.Context, entUser *ent.User) (bool, error) {
+	if entUser.TotpEnabled {
+		return true, nil
+	}
+	hasPasskey, err := entUser.QueryUserToWebauthnCredentials().Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query passkeys: %v", err)
+	}
+	return hasPasskey, nil
+}
+
+// EnrollmentRequired returns whether the user must set up TOTP or a passkey before they can do anything else
+func EnrollmentRequired(ctx context.Context, entUser *ent.User) (bool, error) {
+	if !Required(entUser) {
+		return false, nil
+	}
+	enabled, err := Enabled(ctx, entUser)
+	if err != nil {
+		return false, err
+	}
+	return !enabled, nil
+}
+
+// NewEnrollment generates a new TOTP secret for the user
+func NewEnrollment(entUser *ent.User) (*Enrollment, error) {
+	issuer := "Compsole"
+	if envValue, exists := os.LookupEnv("MFA_ISSUER"); exists && envValue != "" {
+		issuer = envValue
+	}
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: entUser.Username,
+		Period:      period,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate totp secret: %v", err)
+	}
+	image, err := key.Image(256, 256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate qr code: %v", err)
+	}
+	var qrCode bytes.Buffer
+	if err := png.Encode(&qrCode, image); err != nil {
+		return nil, fmt.Errorf("failed to encode qr code: %v", err)
+	}
+	return &Enrollment{
+		Secret:          key.Secret(),
+		ProvisioningURI: key.URL(),
+		QRCode:          "data:image/png;base64," + base64.StdEncoding.EncodeToString(qrCode.Bytes()),
+	}, nil
+}
+
+// matchCounter returns the time step the code is valid for, or -1 if it isn't valid
+func matchCounter(secret string, code string, now time.Time) int64 {
+	counter := now.Unix() / period
+	for offset := int64(-skew); offset <= skew; offset++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix((counter+offset)*period, 0), totp.ValidateOpts{
+			Period:    period,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return -1
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter + offset
+		}
+	}
+	return -1
+}
+
+// ValidateCode checks a TOTP code against the user's secret. Each code can only be used once.
+func ValidateCode(ctx context.Context, client *ent.Client, entUser *ent.User, code string) error {
+	if entUser.TotpSecret == "" {
+		return fmt.Errorf("totp has not been set up")
+	}
+	counter := matchCounter(entUser.TotpSecret, strings.TrimSpace(code), time.Now())
+	if counter < 0 {
+		return fmt.Errorf("invalid totp code")
+	}
+	// Only accept the code if no code from this time step (or later) has been used. The update is conditional so
+	// concurrent requests with the same code can't both succeed.
+	updated, err := client.User.Update().
+		Where(
+			user.IDEQ(entUser.ID),
+			user.TotpLastCounterLT(counter),
+		).
+		SetTotpLastCounter(counter).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update totp counter: %v", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("totp code has already been used")
+	}
+	entUser.TotpLastCounter = counter
+	return nil
+}
+
+// NewRecoveryCodes generates a set of single use recovery codes and their hashes. Only the hashes should be stored.
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %v", err)
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes[i] = fmt.Sprintf("%s-%s-%s-%s", code[0:4], code[4:8], code[8:12], code[12:16])
+		hashes[i] = utils.HashToken(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// UseRecoveryCode consumes one of the user's recovery codes
+func UseRecoveryCode(ctx context.Context, client *ent.Client, entUser *ent.User, code string) error {
+	hash := utils.HashToken(strings.ToLower(strings.TrimSpace(code)))
+	remaining := make([]string, 0, len(entUser.TotpRecoveryCodes))
+	found := false
+	for _, recoveryCodeHash := range entUser.TotpRecoveryCodes {
+		if !found && subtle.ConstantTimeCompare([]byte(recoveryCodeHash), []byte(hash)) == 1 {
+			found = true
+			continue
+		}
+		remaining = append(remaining, recoveryCodeHash)
+	}
+	if !found {
+		return fmt.Errorf("invalid recovery code")
+	}
+	// Only remove the code if the recovery codes haven't changed since the user was loaded. The update is conditional
+	// so concurrent requests with the same code can't both succeed.
+	updated, err := client.User.Update().
+		Where(
+			user.IDEQ(entUser.ID),
+			recoveryCodesEQ(entUser.TotpRecoveryCodes),
+		).
+		SetTotpRecoveryCodes(remaining).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update recovery codes: %v", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("recovery code has already been used")
+	}
+	entUser.TotpRecoveryCodes = remaining
+	return nil
+}
+
+// recoveryCodesEQ matches users whose stored recovery codes are exactly the given hashes. ent doesn't generate
+// predicates for JSON fields, so the column is compared with the same encoding ent stores it with.
+func recoveryCodesEQ(hashes []string) predicate.User {
+	encoded, _ := json.Marshal(hashes)
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(user.FieldTotpRecoveryCodes), encoded))
+	})
+}
+
+// Verify accepts either a TOTP code or a recovery code. Returns true if a recovery code was used.
+func Verify(ctx context.Context, client *ent.Client, entUser *ent.User, code string) (bool, error) {
+	// TOTP codes are always 6 digits, anything else is treated as a recovery code
+	trimmed := strings.TrimSpace(code)
+	if len(trimmed) == 6 && strings.Trim(trimmed, "0123456789") == "" {
+		return false, ValidateCode(ctx, client, entUser, trimmed)
+	}
+	return true, UseRecoveryCode(ctx, client, entUser, trimmed)
+}
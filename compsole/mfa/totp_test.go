@@ -0,0 +1,64 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestUseRecoveryCode(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	codes, hashes, err := NewRecoveryCodes()
+	if err != nil {
+		t.Fatalf("failed to generate recovery codes: %v", err)
+	}
+	entUser, err := client.User.Create().
+		SetUsername("alice").
+		SetPassword("hash").
+		SetRole(user.RoleUSER).
+		SetProvider(user.ProviderLOCAL).
+		SetTotpRecoveryCodes(hashes).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	// Two requests which loaded the user before either used a code
+	firstRequest := client.User.GetX(ctx, entUser.ID)
+	secondRequest := client.User.GetX(ctx, entUser.ID)
+
+	tests := []struct {
+		name          string
+		loaded        func() *ent.User
+		code          string
+		wantErr       bool
+		wantRemaining int
+	}{
+		{"invalid code", func() *ent.User { return firstRequest }, "aaaa-bbbb-cccc-dddd", true, recoveryCodeCount},
+		{"valid code", func() *ent.User { return firstRequest }, codes[0], false, recoveryCodeCount - 1},
+		{"code can't be reused", func() *ent.User { return client.User.GetX(ctx, entUser.ID) }, codes[0], true, recoveryCodeCount - 1},
+		{"concurrent use of the same code", func() *ent.User { return secondRequest }, codes[0], true, recoveryCodeCount - 1},
+		{"codes are case insensitive", func() *ent.User { return client.User.GetX(ctx, entUser.ID) }, " " + strings.ToUpper(codes[1]) + " ", false, recoveryCodeCount - 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UseRecoveryCode(ctx, client, tt.loaded(), tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			remaining := client.User.GetX(ctx, entUser.ID).TotpRecoveryCodes
+			if len(remaining) != tt.wantRemaining {
+				t.Errorf("got %d remaining codes, want %d", len(remaining), tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+)
+
+// Permission is something a user is allowed to do. Users get the permissions of their built-in role plus the
+// permissions of their custom role, if they have one.
+type Permission string
+
+const (
+	CompetitionRead  Permission = "competition:read"
+	CompetitionWrite Permission = "competition:write"
+	TeamRead         Permission = "team:read"
+	TeamWrite        Permission = "team:write"
+	UserRead         Permission = "user:read"
+	UserWrite        Permission = "user:write"
+	// UserImpersonate allows viewing Compsole as another user (or team) to troubleshoot what they see
+	UserImpersonate Permission = "user:impersonate"
+	// VmRead, VmConsole and VmPower apply to every VM. Users can always view, open consoles on and power their own
+	// team's VMs without them.
+	VmRead    Permission = "vm:read"
+	VmWrite   Permission = "vm:write"
+	VmConsole Permission = "vm:console"
+	VmPower   Permission = "vm:power"
+	VmLockout Permission = "vm:lockout"
+	// RedTeamConsole allows opening consoles on the VMs marked for red team access, regardless of team
+	RedTeamConsole      Permission = "red_team:console"
+	ProviderRead        Permission = "provider:read"
+	ProviderWrite       Permission = "provider:write"
+	ServiceAccountRead  Permission = "service_account:read"
+	ServiceAccountWrite Permission = "service_account:write"
+	LogsRead            Permission = "logs:read"
+	RoleWrite           Permission = "role:write"
+)
+
+// All is every permission, in the order they are displayed
+var All = []Permission{
+	CompetitionRead,
+	CompetitionWrite,
+	TeamRead,
+	TeamWrite,
+	UserRead,
+	UserWrite,
+	UserImpersonate,
+	VmRead,
+	VmWrite,
+	VmConsole,
+	VmPower,
+	VmLockout,
+	RedTeamConsole,
+	ProviderRead,
+	ProviderWrite,
+	ServiceAccountRead,
+	ServiceAccountWrite,
+	LogsRead,
+	RoleWrite,
+}
+
+// roles are the permissions of each built-in role
+var roles = map[user.Role][]Permission{
+	user.RoleADMIN: All,
+	// Standard users only have access to their own team
+	user.RoleUSER: {},
+	user.RoleWHITE_TEAM: {
+		CompetitionRead,
+		TeamRead,
+		VmRead,
+		VmConsole,
+	},
+	user.RoleBLACK_TEAM: {
+		CompetitionRead,
+		TeamRead,
+		VmRead,
+		VmConsole,
+		VmPower,
+		VmLockout,
+	},
+	user.RoleRED_TEAM: {
+		RedTeamConsole,
+	},
+}
+
+// Precedence is the built-in roles from most to least privileged
+var Precedence = []user.Role{
+	user.RoleADMIN,
+	user.RoleBLACK_TEAM,
+	user.RoleWHITE_TEAM,
+	user.RoleRED_TEAM,
+	user.RoleUSER,
+}
+
+// MorePrivileged returns whether role a comes before role b in Precedence
+func MorePrivileged(a user.Role, b user.Role) bool {
+	for _, role := range Precedence {
+		if role == a {
+			return a != b
+		}
+		if role == b {
+			return false
+		}
+	}
+	return false
+}
+
+// ForRole returns the permissions of a built-in role
+func ForRole(role user.Role) []Permission {
+	return roles[role]
+}
+
+// Valid returns whether the permission exists
+func Valid(permission Permission) bool {
+	for _, p := range All {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// ForUser returns the permissions of the user's built-in role and custom role. Uses the UserToCustomRole edge if it
+// was loaded, otherwise queries it.
+func ForUser(ctx context.Context, entUser *ent.User) ([]Permission, error) {
+	entCustomRole, err := entUser.Edges.UserToCustomRoleOrErr()
+	if _, notLoaded := err.(*ent.NotLoadedError); notLoaded {
+		entCustomRole, err = entUser.QueryUserToCustomRole().Only(ctx)
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query custom role from user: %v", err)
+	}
+	granted := make(map[Permission]bool)
+	for _, permission := range ForRole(entUser.Role) {
+		granted[permission] = true
+	}
+	if entCustomRole != nil {
+		for _, permission := range entCustomRole.Permissions {
+			granted[Permission(permission)] = true
+		}
+	}
+	// Return them in display order
+	userPermissions := make([]Permission, 0, len(granted))
+	for _, permission := range All {
+		if granted[permission] {
+			userPermissions = append(userPermissions, permission)
+		}
+	}
+	return userPermissions, nil
+}
+
+// Has returns whether the user has the permission
+func Has(ctx context.Context, entUser *ent.User, permission Permission) (bool, error) {
+	userPermissions, err := ForUser(ctx, entUser)
+	if err != nil {
+		return false, err
+	}
+	return contains(userPermissions, permission), nil
+}
+
+// Missing returns the permissions which the user doesn't have. Used to stop users from granting permissions they
+// don't have themselves.
+func Missing(ctx context.Context, entUser *ent.User, requested []Permission) ([]Permission, error) {
+	userPermissions, err := ForUser(ctx, entUser)
+	if err != nil {
+		return nil, err
+	}
+	missing := []Permission{}
+	for _, permission := range requested {
+		if !contains(userPermissions, permission) {
+			missing = append(missing, permission)
+		}
+	}
+	return missing, nil
+}
+
+// Strings converts the permissions to strings, for storing on a custom role
+func Strings(permissions []Permission) []string {
+	strs := make([]string, len(permissions))
+	for i, permission := range permissions {
+		strs[i] = string(permission)
+	}
+	return strs
+}
+
+func contains(permissions []Permission, permission Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
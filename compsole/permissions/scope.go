@@ -0,0 +1,169 @@
+package permissions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// CompetitionScoped is the permissions competition admins have within the competitions they administer
+var CompetitionScoped = []Permission{
+	CompetitionRead,
+	CompetitionWrite,
+	TeamRead,
+	TeamWrite,
+	UserRead,
+	UserWrite,
+	VmRead,
+	VmWrite,
+	VmConsole,
+	VmPower,
+	VmLockout,
+}
+
+// ErrNotPermitted is returned by ScopeFor when the user doesn't have the permission anywhere
+var ErrNotPermitted = errors.New("user doesn't have the permission")
+
+// Scope limits a competition admin to the competitions they administer. Objects outside of the scope are treated as
+// if they don't exist. A nil Scope allows everything.
+type Scope struct {
+	competitionIds []uuid.UUID
+}
+
+// ScopeFor returns where the user has the permission. The scope is nil when the user has the permission everywhere,
+// limited to the competitions they administer when it is competition-scoped, and ErrNotPermitted otherwise.
+func ScopeFor(ctx context.Context, entUser *ent.User, permission Permission) (*Scope, error) {
+	hasPermission, err := Has(ctx, entUser, permission)
+	if err != nil {
+		return nil, err
+	}
+	if hasPermission {
+		return nil, nil
+	}
+	if !contains(CompetitionScoped, permission) {
+		return nil, ErrNotPermitted
+	}
+	competitionIds, err := entUser.QueryUserToAdminCompetitions().IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query admin competitions from user: %v", err)
+	}
+	if len(competitionIds) == 0 {
+		return nil, ErrNotPermitted
+	}
+	return &Scope{competitionIds: competitionIds}, nil
+}
+
+// HasAnywhere returns whether the user has the permission, either everywhere or within the competitions they
+// administer
+func HasAnywhere(ctx context.Context, entUser *ent.User, permission Permission) (bool, error) {
+	_, err := ScopeFor(ctx, entUser, permission)
+	if errors.Is(err, ErrNotPermitted) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// HasInCompetition returns whether the user has the permission within the competition
+func HasInCompetition(ctx context.Context, entUser *ent.User, permission Permission, competitionId uuid.UUID) (bool, error) {
+	scope, err := ScopeFor(ctx, entUser, permission)
+	if errors.Is(err, ErrNotPermitted) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return scope.AllowsCompetition(competitionId), nil
+}
+
+// AdministersCompetition returns whether the user manages the competition, either as staff with the
+// "competition:read" permission everywhere or as one of its admins. The competition rules staff aren't held to (eg.
+// schedules and allowlists) use this, so administering one competition doesn't exempt a user from another's rules.
+func AdministersCompetition(ctx context.Context, entUser *ent.User, competitionId uuid.UUID) (bool, error) {
+	return HasInCompetition(ctx, entUser, CompetitionRead, competitionId)
+}
+
+// allowAll is a predicate which doesn't filter anything
+func allowAll(*sql.Selector) {}
+
+// IsGlobal returns whether the scope allows everything
+func (s *Scope) IsGlobal() bool {
+	return s == nil
+}
+
+// AllowsCompetition returns whether the competition is in the scope
+func (s *Scope) AllowsCompetition(competitionId uuid.UUID) bool {
+	if s == nil {
+		return true
+	}
+	for _, id := range s.competitionIds {
+		if id == competitionId {
+			return true
+		}
+	}
+	return false
+}
+
+// Competitions matches the administered competitions
+func (s *Scope) Competitions() predicate.Competition {
+	if s == nil {
+		return allowAll
+	}
+	return competition.IDIn(s.competitionIds...)
+}
+
+// Teams matches every team in the administered competitions
+func (s *Scope) Teams() predicate.Team {
+	if s == nil {
+		return allowAll
+	}
+	return team.HasTeamToCompetitionWith(s.Competitions())
+}
+
+// VmObjects matches the vm objects of the teams in the administered competitions
+func (s *Scope) VmObjects() predicate.VmObject {
+	if s == nil {
+		return allowAll
+	}
+	return vmobject.HasVmObjectToTeamWith(s.Teams())
+}
+
+// Users matches the members of the teams in the administered competitions. Users without a team are only visible
+// outside of a scope.
+func (s *Scope) Users() predicate.User {
+	if s == nil {
+		return allowAll
+	}
+	return user.HasUserToTeamMembershipsWith(teammembership.HasTeamMembershipToTeamWith(s.Teams()))
+}
+
+// MemberOutside returns whether the user is a member of teams outside of the scope, in which case nobody limited to
+// the scope can manage them
+func (s *Scope) MemberOutside(ctx context.Context, entUser *ent.User) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	// The teams outside of the scope are hidden from the user by the privacy policies
+	return entUser.QueryUserToTeamMemberships().
+		Where(teammembership.HasTeamMembershipToTeamWith(team.Not(s.Teams()))).
+		Exist(viewer.SystemContext(ctx))
+}
+
+// AdministersOutside returns whether the user is an admin of competitions outside of the scope, in which case
+// nobody limited to the scope can manage them
+func (s *Scope) AdministersOutside(ctx context.Context, entUser *ent.User) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	return entUser.QueryUserToAdminCompetitions().Where(competition.IDNotIn(s.competitionIds...)).Exist(viewer.SystemContext(ctx))
+}
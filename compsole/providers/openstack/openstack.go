@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/BradHacker/compsole/compsole/utils"
 	"github.com/BradHacker/compsole/ent"
@@ -37,6 +38,8 @@ type OpenstackConfig struct {
 	RegionName       string `json:"region_name"`
 	DomainName       string `json:"domain_name"`
 	DomainId         string `json:"domain_id"`
+	// ConsoleTokenTTL must match Nova's [consoleauth] token_ttl (in seconds)
+	ConsoleTokenTTL int `json:"console_token_ttl,omitempty"`
 }
 
 const (
@@ -159,6 +162,14 @@ func (provider CompsoleProviderOpenstack) GetConsoleUrl(ctx context.Context, vmO
 	return finalURL, nil
 }
 
+func (provider CompsoleProviderOpenstack) ConsoleUrlLifetime() time.Duration {
+	if provider.config.ConsoleTokenTTL > 0 {
+		return time.Duration(provider.config.ConsoleTokenTTL) * time.Second
+	}
+	// Nova's default token_ttl
+	return 10 * time.Minute
+}
+
 func (provider CompsoleProviderOpenstack) GetPowerState(ctx context.Context, vmObject *ent.VmObject) (utils.PowerState, error) {
 	var serverResult servers.Server
 	err := servers.Get(ctx, provider.computeClient, vmObject.Identifier).ExtractInto(&serverResult)
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/BradHacker/compsole/compsole/providers/openstack"
 	"github.com/BradHacker/compsole/compsole/utils"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 )
 
 type CompsoleProvider interface {
@@ -18,6 +20,8 @@ type CompsoleProvider interface {
 	Author() string
 	Version() string
 	GetConsoleUrl(ctx context.Context, vmObject *ent.VmObject, consoleType utils.ConsoleType) (string, error)
+	// ConsoleUrlLifetime is how long a url returned by GetConsoleUrl remains valid
+	ConsoleUrlLifetime() time.Duration
 	GetPowerState(ctx context.Context, vmObject *ent.VmObject) (utils.PowerState, error)
 	ListVMs(ctx context.Context) ([]*ent.VmObject, error)
 	RestartVM(ctx context.Context, vmObject *ent.VmObject, rebootType utils.RebootType) error
@@ -45,6 +49,25 @@ func ValidateConfig(providerType string, config string) error {
 	}
 }
 
+// LoadProviders generates every provider stored in the database and stores them in a new ProviderMap
+func LoadProviders(ctx context.Context, client *ent.Client) (*ProviderMap, error) {
+	compsoleProviders := &ProviderMap{}
+	entProviders, err := client.Provider.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query providers: %v", err)
+	}
+	for _, entProvider := range entProviders {
+		// Generate the provider
+		provider, err := NewProvider(ctx, entProvider.Type, entProvider.Config)
+		if err != nil {
+			logrus.Errorf("failed to create provider from config: %v", err)
+		} else {
+			compsoleProviders.Set(entProvider.ID, provider)
+		}
+	}
+	return compsoleProviders, nil
+}
+
 type ProviderMap struct {
 	sync.Map
 }
@@ -64,3 +87,12 @@ func (pm *ProviderMap) Get(id uuid.UUID) (CompsoleProvider, error) {
 func (pm *ProviderMap) Set(id uuid.UUID, p CompsoleProvider) {
 	pm.Store(id, p)
 }
+
+// ForVmObject gets the loaded provider for the competition the vm object belongs to
+func (pm *ProviderMap) ForVmObject(ctx context.Context, vmObject *ent.VmObject) (CompsoleProvider, error) {
+	entProvider, err := vmObject.QueryVmObjectToTeam().QueryTeamToCompetition().QueryCompetitionToProvider().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provider from vm object: %v", err)
+	}
+	return pm.Get(entProvider.ID)
+}
@@ -0,0 +1,238 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// SubjectType is what failed logins are counted against
+type SubjectType string
+
+const (
+	SubjectUsername  SubjectType = "USERNAME"
+	SubjectIP        SubjectType = "IP"
+	SubjectAPIKey    SubjectType = "API_KEY"
+	SubjectShareLink SubjectType = "SHARE_LINK"
+)
+
+// lockoutsKey is a sorted set of every active lockout, scored by when it expires
+const lockoutsKey = "login_lockouts"
+
+// Subject is a single username, IP address, API key or console share link
+type Subject struct {
+	Type       SubjectType
+	Identifier string
+}
+
+func Username(username string) Subject {
+	return Subject{Type: SubjectUsername, Identifier: strings.ToLower(username)}
+}
+
+func IP(ip string) Subject {
+	return Subject{Type: SubjectIP, Identifier: ip}
+}
+
+func APIKey(apiKey string) Subject {
+	return Subject{Type: SubjectAPIKey, Identifier: apiKey}
+}
+
+// ShareLink counts wrong passwords for a console share link, identified by its id (never its token)
+func ShareLink(shareId string) Subject {
+	return Subject{Type: SubjectShareLink, Identifier: shareId}
+}
+
+func (s Subject) String() string {
+	return fmt.Sprintf("%s:%s", s.Type, s.Identifier)
+}
+
+func (s Subject) failuresKey() string {
+	return fmt.Sprintf("login_failures:%s", s)
+}
+
+func (s Subject) backoffKey() string {
+	return fmt.Sprintf("login_backoff:%s", s)
+}
+
+func (s Subject) lockoutKey() string {
+	return fmt.Sprintf("login_lockout:%s", s)
+}
+
+// Lockout is a subject which has failed to login too many times
+type Lockout struct {
+	Subject
+	Failures    int
+	LockedUntil time.Time
+}
+
+// Limiter throttles failed logins in redis. Each failure for a username, API key or share link doubles the time before the next
+// attempt is allowed, and too many failures within the window locks the subject out. IP addresses are only locked out
+// (with a higher threshold) since whole teams often share one address.
+type Limiter struct {
+	rdb *redis.Client
+	// Threshold is the number of failures within Window before a username, API key or share link is locked out
+	Threshold int
+	// IPThreshold is the number of failures within Window before an IP address is locked out
+	IPThreshold     int
+	Window          time.Duration
+	LockoutDuration time.Duration
+	BackoffBase     time.Duration
+	BackoffMax      time.Duration
+}
+
+func envInt(name string, defaultValue int) int {
+	if envValue, exists := os.LookupEnv(name); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil && atoiValue > 0 {
+			return atoiValue
+		}
+	}
+	return defaultValue
+}
+
+// New creates a limiter configured from the environment (see .env.example)
+func New(rdb *redis.Client) *Limiter {
+	return &Limiter{
+		rdb:             rdb,
+		Threshold:       envInt("LOGIN_LOCKOUT_THRESHOLD", 5),
+		IPThreshold:     envInt("LOGIN_IP_LOCKOUT_THRESHOLD", 50),
+		Window:          time.Duration(envInt("LOGIN_FAILURE_WINDOW", 15)) * time.Minute,
+		LockoutDuration: time.Duration(envInt("LOGIN_LOCKOUT_DURATION", 15)) * time.Minute,
+		BackoffBase:     time.Second,
+		BackoffMax:      time.Duration(envInt("LOGIN_BACKOFF_MAX", 60)) * time.Second,
+	}
+}
+
+func (l *Limiter) threshold(s Subject) int {
+	if s.Type == SubjectIP {
+		return l.IPThreshold
+	}
+	return l.Threshold
+}
+
+// Check returns how long to wait before any of the subjects may attempt to login again. Zero means the attempt is
+// allowed.
+func (l *Limiter) Check(ctx context.Context, subjects ...Subject) (time.Duration, error) {
+	pipe := l.rdb.Pipeline()
+	ttls := make([]*redis.DurationCmd, 0, len(subjects)*2)
+	for _, s := range subjects {
+		ttls = append(ttls, pipe.PTTL(ctx, s.lockoutKey()), pipe.PTTL(ctx, s.backoffKey()))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, fmt.Errorf("failed to check login rate limit: %v", err)
+	}
+	var retryAfter time.Duration
+	for _, ttl := range ttls {
+		// Missing keys have a negative ttl
+		if ttl.Val() > retryAfter {
+			retryAfter = ttl.Val()
+		}
+	}
+	return retryAfter, nil
+}
+
+// Fail records a failed login for each subject. Returns the subjects which have just been locked out.
+func (l *Limiter) Fail(ctx context.Context, subjects ...Subject) ([]Subject, error) {
+	lockedOut := []Subject{}
+	for _, s := range subjects {
+		failures, err := l.rdb.Incr(ctx, s.failuresKey()).Result()
+		if err != nil {
+			return lockedOut, fmt.Errorf("failed to count login failure: %v", err)
+		}
+		if failures == 1 {
+			if err := l.rdb.Expire(ctx, s.failuresKey(), l.Window).Err(); err != nil {
+				return lockedOut, fmt.Errorf("failed to count login failure: %v", err)
+			}
+		}
+		if int(failures) >= l.threshold(s) {
+			lockedUntil := time.Now().Add(l.LockoutDuration)
+			_, err := l.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, s.lockoutKey(), failures, l.LockoutDuration)
+				pipe.ZAdd(ctx, lockoutsKey, &redis.Z{Score: float64(lockedUntil.Unix()), Member: s.String()})
+				// The count starts over once the lockout ends
+				pipe.Del(ctx, s.failuresKey(), s.backoffKey())
+				return nil
+			})
+			if err != nil {
+				return lockedOut, fmt.Errorf("failed to lock out %s: %v", s, err)
+			}
+			lockedOut = append(lockedOut, s)
+			continue
+		}
+		if s.Type == SubjectIP {
+			continue
+		}
+		backoff := time.Duration(float64(l.BackoffBase) * math.Pow(2, float64(failures-1)))
+		if backoff > l.BackoffMax {
+			backoff = l.BackoffMax
+		}
+		if err := l.rdb.Set(ctx, s.backoffKey(), failures, backoff).Err(); err != nil {
+			return lockedOut, fmt.Errorf("failed to set login backoff: %v", err)
+		}
+	}
+	return lockedOut, nil
+}
+
+// Succeed clears the failures of the subjects after a successful login. IP addresses are not passed here so one valid
+// account can't be used to reset the count for an address which is spraying passwords.
+func (l *Limiter) Succeed(ctx context.Context, subjects ...Subject) error {
+	keys := make([]string, 0, len(subjects)*2)
+	for _, s := range subjects {
+		keys = append(keys, s.failuresKey(), s.backoffKey())
+	}
+	if err := l.rdb.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to clear login failures: %v", err)
+	}
+	return nil
+}
+
+// Lockouts lists every subject which is currently locked out
+func (l *Limiter) Lockouts(ctx context.Context) ([]Lockout, error) {
+	err := l.rdb.ZRemRangeByScore(ctx, lockoutsKey, "-inf", strconv.FormatInt(time.Now().Unix(), 10)).Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear expired lockouts: %v", err)
+	}
+	members, err := l.rdb.ZRangeWithScores(ctx, lockoutsKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lockouts: %v", err)
+	}
+	lockouts := make([]Lockout, 0, len(members))
+	for _, member := range members {
+		subjectType, identifier, found := strings.Cut(member.Member.(string), ":")
+		if !found {
+			continue
+		}
+		s := Subject{Type: SubjectType(subjectType), Identifier: identifier}
+		failures, err := l.rdb.Get(ctx, s.lockoutKey()).Int()
+		if err == redis.Nil {
+			// Unlocked or expired since the sorted set was cleaned up
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get lockout for %s: %v", s, err)
+		}
+		lockouts = append(lockouts, Lockout{
+			Subject:     s,
+			Failures:    failures,
+			LockedUntil: time.Unix(int64(member.Score), 0),
+		})
+	}
+	return lockouts, nil
+}
+
+// Unlock removes the lockout and any failures recorded for the subject
+func (l *Limiter) Unlock(ctx context.Context, s Subject) (bool, error) {
+	removed, err := l.rdb.ZRem(ctx, lockoutsKey, s.String()).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock %s: %v", s, err)
+	}
+	deleted, err := l.rdb.Del(ctx, s.lockoutKey(), s.failuresKey(), s.backoffKey()).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock %s: %v", s, err)
+	}
+	return removed > 0 || deleted > 0, nil
+}
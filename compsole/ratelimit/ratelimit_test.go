@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestLimiter(t *testing.T) (*Limiter, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return &Limiter{
+		rdb:             redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		Threshold:       5,
+		IPThreshold:     3,
+		Window:          15 * time.Minute,
+		LockoutDuration: 10 * time.Minute,
+		BackoffBase:     time.Second,
+		BackoffMax:      4 * time.Second,
+	}, mr
+}
+
+func TestFail(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		subject        Subject
+		failures       int
+		wantRetryAfter time.Duration
+		wantLockedOut  bool
+	}{
+		{"no failures", Username("alice"), 0, 0, false},
+		{"first failure backs off", Username("alice"), 1, time.Second, false},
+		{"backoff doubles", Username("alice"), 3, 4 * time.Second, false},
+		{"backoff is capped", Username("alice"), 4, 4 * time.Second, false},
+		{"threshold locks out", Username("alice"), 5, 10 * time.Minute, true},
+		{"api keys back off", APIKey("key"), 2, 2 * time.Second, false},
+		{"share links back off", ShareLink("share"), 2, 2 * time.Second, false},
+		{"ip addresses don't back off", IP("10.0.0.1"), 2, 0, false},
+		{"ip threshold locks out", IP("10.0.0.1"), 3, 10 * time.Minute, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, _ := newTestLimiter(t)
+			var lockedOut []Subject
+			for i := 0; i < tt.failures; i++ {
+				newLockouts, err := limiter.Fail(ctx, tt.subject)
+				if err != nil {
+					t.Fatalf("failed to record failure: %v", err)
+				}
+				lockedOut = append(lockedOut, newLockouts...)
+			}
+			retryAfter, err := limiter.Check(ctx, tt.subject)
+			if err != nil {
+				t.Fatalf("failed to check: %v", err)
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("got retry after %v, want %v", retryAfter, tt.wantRetryAfter)
+			}
+			if (len(lockedOut) > 0) != tt.wantLockedOut {
+				t.Errorf("got locked out %v, want %v", lockedOut, tt.wantLockedOut)
+			}
+			lockouts, err := limiter.Lockouts(ctx)
+			if err != nil {
+				t.Fatalf("failed to list lockouts: %v", err)
+			}
+			if (len(lockouts) == 1 && lockouts[0].Subject == tt.subject) != tt.wantLockedOut {
+				t.Errorf("got lockouts %v, want locked out %v", lockouts, tt.wantLockedOut)
+			}
+		})
+	}
+}
+
+func TestCheckUsesLongestWait(t *testing.T) {
+	ctx := context.Background()
+	limiter, _ := newTestLimiter(t)
+	for i := 0; i < 3; i++ {
+		if _, err := limiter.Fail(ctx, Username("alice"), IP("10.0.0.1")); err != nil {
+			t.Fatalf("failed to record failure: %v", err)
+		}
+	}
+	// The IP is locked out even though alice is only backing off, and other usernames from the IP are blocked too
+	for _, subjects := range [][]Subject{{Username("alice"), IP("10.0.0.1")}, {Username("bob"), IP("10.0.0.1")}} {
+		retryAfter, err := limiter.Check(ctx, subjects...)
+		if err != nil {
+			t.Fatalf("failed to check: %v", err)
+		}
+		if retryAfter != 10*time.Minute {
+			t.Errorf("%v: got retry after %v, want %v", subjects, retryAfter, 10*time.Minute)
+		}
+	}
+}
+
+func TestSucceedAndUnlock(t *testing.T) {
+	ctx := context.Background()
+	limiter, mr := newTestLimiter(t)
+
+	for i := 0; i < 4; i++ {
+		if _, err := limiter.Fail(ctx, Username("alice")); err != nil {
+			t.Fatalf("failed to record failure: %v", err)
+		}
+	}
+	if err := limiter.Succeed(ctx, Username("alice")); err != nil {
+		t.Fatalf("failed to record success: %v", err)
+	}
+	if retryAfter, _ := limiter.Check(ctx, Username("alice")); retryAfter != 0 {
+		t.Errorf("got retry after %v after a successful login, want 0", retryAfter)
+	}
+	// The count starts over, so one more failure doesn't lock alice out
+	if lockedOut, _ := limiter.Fail(ctx, Username("alice")); len(lockedOut) != 0 {
+		t.Errorf("got locked out after the count was cleared")
+	}
+
+	for i := 0; i < 5; i++ {
+		limiter.Fail(ctx, Username("bob"))
+	}
+	unlocked, err := limiter.Unlock(ctx, Username("bob"))
+	if err != nil || !unlocked {
+		t.Fatalf("failed to unlock: %v", err)
+	}
+	if retryAfter, _ := limiter.Check(ctx, Username("bob")); retryAfter != 0 {
+		t.Errorf("got retry after %v after unlocking, want 0", retryAfter)
+	}
+	if unlocked, _ := limiter.Unlock(ctx, Username("bob")); unlocked {
+		t.Errorf("unlocked bob twice")
+	}
+
+	// Lockouts expire on their own
+	for i := 0; i < 5; i++ {
+		limiter.Fail(ctx, Username("carol"))
+	}
+	mr.FastForward(11 * time.Minute)
+	if retryAfter, _ := limiter.Check(ctx, Username("carol")); retryAfter != 0 {
+		t.Errorf("got retry after %v once the lockout expired, want 0", retryAfter)
+	}
+}
@@ -0,0 +1,178 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/provider"
+	"github.com/BradHacker/compsole/ent/serviceaccount"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// The filters below match what a viewer can see or change when they don't have the permission for every object.
+// They only use predicates, since querying through the client from inside a policy would evaluate the policies
+// again.
+
+// administeredCompetitions matches the competitions the user is a competition-scoped admin of
+func administeredCompetitions(v *viewer.Viewer) predicate.Competition {
+	return competition.HasCompetitionToAdminsWith(user.IDEQ(v.User.ID))
+}
+
+// memberTeams matches every team the user is a member of
+func memberTeams(v *viewer.Viewer) predicate.Team {
+	return team.HasTeamToMembershipsWith(teammembership.HasTeamMembershipToUserWith(user.IDEQ(v.User.ID)))
+}
+
+// assignedCompetitionIds returns the competitions a restricted service account was assigned
+func assignedCompetitionIds(v *viewer.Viewer) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, entCompetition := range v.ServiceAccount.Edges.ServiceAccountToCompetitions {
+		ids = append(ids, entCompetition.ID)
+	}
+	return ids
+}
+
+// assignedTeamIds returns the teams a restricted service account was assigned
+func assignedTeamIds(v *viewer.Viewer) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, entTeam := range v.ServiceAccount.Edges.ServiceAccountToTeams {
+		ids = append(ids, entTeam.ID)
+	}
+	return ids
+}
+
+// hasRedTeamConsole returns whether the viewer can see the VMs marked for red team access (and their teams and
+// competitions)
+func hasRedTeamConsole(ctx context.Context, v *viewer.Viewer) (bool, error) {
+	if v.User == nil {
+		return false, nil
+	}
+	return permissions.Has(ctx, v.User, permissions.RedTeamConsole)
+}
+
+// readableCompetitions matches the administered competitions and the competitions of every team the viewer can see
+func readableCompetitions(ctx context.Context, v *viewer.Viewer) (predicate.Competition, error) {
+	teams, err := readableTeams(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if v.User == nil {
+		return competition.Or(
+			competition.IDIn(assignedCompetitionIds(v)...),
+			competition.HasCompetitionToTeamsWith(teams),
+		), nil
+	}
+	return competition.Or(
+		administeredCompetitions(v),
+		competition.HasCompetitionToTeamsWith(teams),
+	), nil
+}
+
+// writableCompetitions matches the administered competitions. Being on (or assigned) a team doesn't allow changing
+// its competition.
+func writableCompetitions(v *viewer.Viewer) predicate.Competition {
+	if v.User == nil {
+		return competition.IDIn(assignedCompetitionIds(v)...)
+	}
+	return administeredCompetitions(v)
+}
+
+// readableTeams matches the teams in the administered competitions, the teams the user is a member of and the teams
+// with VMs marked for red team access
+func readableTeams(ctx context.Context, v *viewer.Viewer) (predicate.Team, error) {
+	if v.User == nil {
+		return writableTeams(v), nil
+	}
+	teams := []predicate.Team{
+		writableTeams(v),
+		memberTeams(v),
+	}
+	redTeam, err := hasRedTeamConsole(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if redTeam {
+		teams = append(teams, team.HasTeamToVmObjectsWith(vmobject.RedTeamAccessEQ(true)))
+	}
+	return team.Or(teams...), nil
+}
+
+// writableTeams matches every team in the administered competitions. Service accounts can also change the teams
+// they were assigned.
+func writableTeams(v *viewer.Viewer) predicate.Team {
+	if v.User == nil {
+		return team.Or(
+			team.IDIn(assignedTeamIds(v)...),
+			team.HasTeamToCompetitionWith(competition.IDIn(assignedCompetitionIds(v)...)),
+		)
+	}
+	return team.HasTeamToCompetitionWith(administeredCompetitions(v))
+}
+
+// readableVmObjects matches the VMs of the administered competitions, the VMs of the user's active team and the VMs
+// marked for red team access. Users have to switch teams to see their other teams' VMs.
+func readableVmObjects(ctx context.Context, v *viewer.Viewer) (predicate.VmObject, error) {
+	if v.User == nil {
+		return writableVmObjects(v), nil
+	}
+	vmObjects := []predicate.VmObject{
+		writableVmObjects(v),
+		vmobject.HasVmObjectToTeamWith(team.HasTeamToUsersWith(user.IDEQ(v.User.ID))),
+	}
+	redTeam, err := hasRedTeamConsole(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if redTeam {
+		vmObjects = append(vmObjects, vmobject.RedTeamAccessEQ(true))
+	}
+	return vmobject.Or(vmObjects...), nil
+}
+
+// writableVmObjects matches the VMs of the writable teams
+func writableVmObjects(v *viewer.Viewer) predicate.VmObject {
+	return vmobject.HasVmObjectToTeamWith(writableTeams(v))
+}
+
+// readableUsers matches the user themself and the members of the writable teams
+func readableUsers(v *viewer.Viewer) predicate.User {
+	if v.User == nil {
+		return writableUsers(v)
+	}
+	return user.Or(
+		user.IDEQ(v.User.ID),
+		writableUsers(v),
+	)
+}
+
+// writableUsers matches the members of the writable teams. Users without a team can only be changed by viewers with
+// the permission for every user.
+func writableUsers(v *viewer.Viewer) predicate.User {
+	return user.HasUserToTeamMembershipsWith(teammembership.HasTeamMembershipToTeamWith(writableTeams(v)))
+}
+
+// readableProviders matches the providers of the readable competitions, which are needed to power and open
+// consoles on their VMs
+func readableProviders(ctx context.Context, v *viewer.Viewer) (predicate.Provider, error) {
+	competitions, err := readableCompetitions(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return provider.HasProviderToCompetitionsWith(competitions), nil
+}
+
+// readableActions matches the actions the viewer performed
+func readableActions(v *viewer.Viewer) predicate.Action {
+	if v.User == nil {
+		return action.HasActionToServiceAccountWith(serviceaccount.IDEQ(v.ServiceAccount.ID))
+	}
+	return action.HasActionToUserWith(user.IDEQ(v.User.ID))
+}
@@ -0,0 +1,324 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/privacy"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// DenyIfNoViewer denies queries and mutations made without a viewer. Work Compsole does on its own behalf has to use
+// viewer.SystemContext.
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("viewer is missing from context")
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfPermission allows users with any of the permissions for every object and service accounts which aren't
+// limited to specific competitions or teams. Service account scopes are checked by the REST API.
+func AllowIfPermission(required ...permissions.Permission) privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		v := viewer.FromContext(ctx)
+		if v.ServiceAccount != nil {
+			if v.IsRestricted() {
+				return privacy.Skip
+			}
+			return privacy.Allow
+		}
+		for _, permission := range required {
+			hasPermission, err := permissions.Has(ctx, v.User, permission)
+			if err != nil {
+				return privacy.Denyf("failed to check permission: %v", err)
+			}
+			if hasPermission {
+				return privacy.Allow
+			}
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterCompetitionQuery limits competition queries to the readable competitions
+func FilterCompetitionQuery() privacy.QueryRule {
+	return privacy.CompetitionQueryRuleFunc(func(ctx context.Context, q *ent.CompetitionQuery) error {
+		competitions, err := readableCompetitions(ctx, viewer.FromContext(ctx))
+		if err != nil {
+			return privacy.Denyf("failed to filter competitions: %v", err)
+		}
+		q.Where(competitions)
+		return privacy.Skip
+	})
+}
+
+// FilterCompetitionMutation limits competition changes to the writable competitions. Competitions can only be
+// created and deleted by viewers with the permission for every competition.
+func FilterCompetitionMutation() privacy.MutationRule {
+	return privacy.CompetitionMutationRuleFunc(func(ctx context.Context, m *ent.CompetitionMutation) error {
+		if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+			return privacy.Denyf("competitions can only be created and deleted with the permission for every competition")
+		}
+		m.Where(writableCompetitions(viewer.FromContext(ctx)))
+		return privacy.Skip
+	})
+}
+
+// FilterTeamQuery limits team queries to the readable teams
+func FilterTeamQuery() privacy.QueryRule {
+	return privacy.TeamQueryRuleFunc(func(ctx context.Context, q *ent.TeamQuery) error {
+		teams, err := readableTeams(ctx, viewer.FromContext(ctx))
+		if err != nil {
+			return privacy.Denyf("failed to filter teams: %v", err)
+		}
+		q.Where(teams)
+		return privacy.Skip
+	})
+}
+
+// FilterTeamMutation limits team changes to the writable competitions
+func FilterTeamMutation() privacy.MutationRule {
+	return privacy.TeamMutationRuleFunc(func(ctx context.Context, m *ent.TeamMutation) error {
+		v := viewer.FromContext(ctx)
+		if competitionId, exists := m.TeamToCompetitionID(); exists {
+			writable, err := m.Client().Competition.Query().
+				Where(competition.IDEQ(competitionId), writableCompetitions(v)).
+				Exist(ctx)
+			if err != nil {
+				return privacy.Denyf("failed to query competition: %v", err)
+			}
+			if !writable {
+				return privacy.Denyf("competition %s can't be changed", competitionId)
+			}
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if _, exists := m.TeamToCompetitionID(); !exists {
+				return privacy.Denyf("teams must be created in a competition")
+			}
+			return privacy.Skip
+		}
+		m.Where(writableTeams(v))
+		return privacy.Skip
+	})
+}
+
+// FilterTeamMembershipQuery limits team membership queries to the memberships of the readable teams
+func FilterTeamMembershipQuery() privacy.QueryRule {
+	return privacy.TeamMembershipQueryRuleFunc(func(ctx context.Context, q *ent.TeamMembershipQuery) error {
+		teams, err := readableTeams(ctx, viewer.FromContext(ctx))
+		if err != nil {
+			return privacy.Denyf("failed to filter team memberships: %v", err)
+		}
+		q.Where(teammembership.HasTeamMembershipToTeamWith(teams))
+		return privacy.Skip
+	})
+}
+
+// FilterTeamMembershipMutation limits team membership changes to the memberships of the writable teams. New
+// memberships have to be on a writable team.
+func FilterTeamMembershipMutation() privacy.MutationRule {
+	return privacy.TeamMembershipMutationRuleFunc(func(ctx context.Context, m *ent.TeamMembershipMutation) error {
+		v := viewer.FromContext(ctx)
+		if teamId, exists := m.TeamMembershipToTeamID(); exists {
+			if err := checkWritableTeam(ctx, m.Client(), v, teamId); err != nil {
+				return err
+			}
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if _, exists := m.TeamMembershipToTeamID(); !exists {
+				return privacy.Denyf("team memberships must be created on a team")
+			}
+			return privacy.Skip
+		}
+		m.Where(teammembership.HasTeamMembershipToTeamWith(writableTeams(v)))
+		return privacy.Skip
+	})
+}
+
+// FilterVmObjectQuery limits vm object queries to the readable VMs
+func FilterVmObjectQuery() privacy.QueryRule {
+	return privacy.VmObjectQueryRuleFunc(func(ctx context.Context, q *ent.VmObjectQuery) error {
+		vmObjects, err := readableVmObjects(ctx, viewer.FromContext(ctx))
+		if err != nil {
+			return privacy.Denyf("failed to filter vm objects: %v", err)
+		}
+		q.Where(vmObjects)
+		return privacy.Skip
+	})
+}
+
+// AllowVmObjectLockout allows users with the "vm:lockout" permission for every VM to lock and unlock VMs without
+// being able to change anything else about them
+func AllowVmObjectLockout() privacy.MutationRule {
+	return privacy.VmObjectMutationRuleFunc(func(ctx context.Context, m *ent.VmObjectMutation) error {
+		v := viewer.FromContext(ctx)
+		if v.User == nil || !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+		fields := m.Fields()
+		if len(fields) != 1 || fields[0] != vmobject.FieldLocked || len(m.ClearedFields()) > 0 || len(m.AddedEdges()) > 0 || len(m.RemovedEdges()) > 0 || len(m.ClearedEdges()) > 0 {
+			return privacy.Skip
+		}
+		hasPermission, err := permissions.Has(ctx, v.User, permissions.VmLockout)
+		if err != nil {
+			return privacy.Denyf("failed to check permission: %v", err)
+		}
+		if hasPermission {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterVmObjectMutation limits vm object changes to the writable teams
+func FilterVmObjectMutation() privacy.MutationRule {
+	return privacy.VmObjectMutationRuleFunc(func(ctx context.Context, m *ent.VmObjectMutation) error {
+		v := viewer.FromContext(ctx)
+		if teamId, exists := m.VmObjectToTeamID(); exists {
+			if err := checkWritableTeam(ctx, m.Client(), v, teamId); err != nil {
+				return err
+			}
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if _, exists := m.VmObjectToTeamID(); !exists {
+				return privacy.Denyf("vm objects must be created on a team")
+			}
+			return privacy.Skip
+		}
+		m.Where(writableVmObjects(v))
+		return privacy.Skip
+	})
+}
+
+// FilterUserQuery limits user queries to the readable users
+func FilterUserQuery() privacy.QueryRule {
+	return privacy.UserQueryRuleFunc(func(ctx context.Context, q *ent.UserQuery) error {
+		q.Where(readableUsers(viewer.FromContext(ctx)))
+		return privacy.Skip
+	})
+}
+
+// selfUpdateFields are the fields users can set on their own account. Everything else (eg. their role or login
+// provider) needs the "user:write" permission.
+var selfUpdateFields = map[string]bool{
+	user.FieldFirstName: true,
+	user.FieldLastName:  true,
+	user.FieldPassword:  true,
+	// Only clearing it is allowed, which happens when the password is changed
+	user.FieldMustChangePassword: true,
+	// Users enroll and disable their own authenticator
+	user.FieldTotpSecret:        true,
+	user.FieldTotpEnabled:       true,
+	user.FieldTotpLastCounter:   true,
+	user.FieldTotpRecoveryCodes: true,
+}
+
+// AllowUserSelfUpdate allows users to change the fields in selfUpdateFields on their own account and to switch their
+// active team to a team they are a member of
+func AllowUserSelfUpdate() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		v := viewer.FromContext(ctx)
+		if v.User == nil || !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+		if id, exists := m.ID(); m.Op().Is(ent.OpUpdateOne) && (!exists || id != v.User.ID) {
+			return privacy.Skip
+		}
+		for _, fields := range [][]string{m.Fields(), m.ClearedFields()} {
+			for _, field := range fields {
+				if !selfUpdateFields[field] {
+					return privacy.Skip
+				}
+			}
+		}
+		if mustChangePassword, exists := m.MustChangePassword(); exists && mustChangePassword {
+			return privacy.Skip
+		}
+		if len(m.RemovedEdges()) > 0 || len(m.ClearedEdges()) > 0 {
+			return privacy.Skip
+		}
+		for _, edge := range m.AddedEdges() {
+			if edge != user.EdgeUserToTeam {
+				return privacy.Skip
+			}
+		}
+		if teamId, exists := m.UserToTeamID(); exists {
+			m.Where(user.HasUserToTeamMembershipsWith(teammembership.HasTeamMembershipToTeamWith(team.IDEQ(teamId))))
+		}
+		// Bulk updates (eg. the conditional TOTP counter update) are limited to the user's own account
+		m.Where(user.IDEQ(v.User.ID))
+		return privacy.Allow
+	})
+}
+
+// FilterUserMutation limits user changes to the members of the writable teams. New users have to be put on a
+// writable team. Only viewers who can change every user can change the competitions a user administers.
+func FilterUserMutation() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		v := viewer.FromContext(ctx)
+		// This can't be left to the writableUsers predicate since ent adds the edge before it checks the predicate,
+		// which would let users make themselves admins of a competition they are on
+		for _, edges := range [][]string{m.AddedEdges(), m.RemovedEdges(), m.ClearedEdges()} {
+			for _, edge := range edges {
+				if edge == user.EdgeUserToAdminCompetitions {
+					return privacy.Denyf("only users with the %q permission can change competition admins", permissions.UserWrite)
+				}
+			}
+		}
+		if teamId, exists := m.UserToTeamID(); exists {
+			if err := checkWritableTeam(ctx, m.Client(), v, teamId); err != nil {
+				return err
+			}
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if _, exists := m.UserToTeamID(); !exists {
+				return privacy.Denyf("users must be created on a team")
+			}
+			return privacy.Skip
+		}
+		m.Where(writableUsers(v))
+		return privacy.Skip
+	})
+}
+
+// FilterProviderQuery limits provider queries to the providers of the readable competitions
+func FilterProviderQuery() privacy.QueryRule {
+	return privacy.ProviderQueryRuleFunc(func(ctx context.Context, q *ent.ProviderQuery) error {
+		providers, err := readableProviders(ctx, viewer.FromContext(ctx))
+		if err != nil {
+			return privacy.Denyf("failed to filter providers: %v", err)
+		}
+		q.Where(providers)
+		return privacy.Skip
+	})
+}
+
+// FilterActionQuery limits action queries to the viewer's own actions
+func FilterActionQuery() privacy.QueryRule {
+	return privacy.ActionQueryRuleFunc(func(ctx context.Context, q *ent.ActionQuery) error {
+		q.Where(readableActions(viewer.FromContext(ctx)))
+		return privacy.Skip
+	})
+}
+
+// checkWritableTeam returns a Deny decision if the team can't be changed by the viewer, and nil otherwise
+func checkWritableTeam(ctx context.Context, client *ent.Client, v *viewer.Viewer, teamId uuid.UUID) error {
+	writable, err := client.Team.Query().Where(team.IDEQ(teamId), writableTeams(v)).Exist(ctx)
+	if err != nil {
+		return privacy.Denyf("failed to query team: %v", err)
+	}
+	if !writable {
+		return privacy.Denyf("team %s can't be changed", teamId)
+	}
+	return nil
+}
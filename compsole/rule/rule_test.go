@@ -0,0 +1,327 @@
+package rule_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/serviceaccount"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// fixture is two competitions. Alice is on team A1 and (inactive) B1, Bob is on A2 and Carol is on B1. Dana
+// administers competition A, Rita is red team and Root is an admin. The VM on B1 is marked for red team access.
+type fixture struct {
+	client *ent.Client
+	teams  map[string]*ent.Team
+	users  map[string]*ent.User
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+	f := &fixture{client: client, teams: map[string]*ent.Team{}, users: map[string]*ent.User{}}
+
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	for _, name := range []string{"A", "B"} {
+		entCompetition := client.Competition.Create().SetName(name).SetCompetitionToProvider(entProvider).SaveX(ctx)
+		for teamNumber := 1; teamNumber <= 2; teamNumber++ {
+			if name == "B" && teamNumber == 2 {
+				break
+			}
+			teamName := fmt.Sprintf("%s%d", name, teamNumber)
+			f.teams[teamName] = client.Team.Create().SetTeamNumber(teamNumber).SetName(teamName).SetTeamToCompetition(entCompetition).SaveX(ctx)
+			client.VmObject.Create().
+				SetName("vm" + teamName).
+				SetIdentifier(teamName).
+				SetRedTeamAccess(teamName == "B1").
+				SetVmObjectToTeam(f.teams[teamName]).
+				ExecX(ctx)
+		}
+	}
+	for _, u := range []struct {
+		username string
+		role     user.Role
+		teams    []string
+	}{
+		{"alice", user.RoleUSER, []string{"A1", "B1"}},
+		{"bob", user.RoleUSER, []string{"A2"}},
+		{"carol", user.RoleUSER, []string{"B1"}},
+		{"dana", user.RoleUSER, nil},
+		{"rita", user.RoleRED_TEAM, nil},
+		{"root", user.RoleADMIN, nil},
+	} {
+		userCreate := client.User.Create().
+			SetUsername(u.username).
+			SetPassword("hash").
+			SetFirstName(u.username).
+			SetRole(u.role).
+			SetProvider(user.ProviderLOCAL)
+		if len(u.teams) > 0 {
+			userCreate.SetUserToTeam(f.teams[u.teams[0]])
+		}
+		f.users[u.username] = userCreate.SaveX(ctx)
+		for _, teamName := range u.teams {
+			client.TeamMembership.Create().SetTeamMembershipToUser(f.users[u.username]).SetTeamMembershipToTeam(f.teams[teamName]).ExecX(ctx)
+		}
+	}
+	f.teams["A1"].QueryTeamToCompetition().OnlyX(ctx).Update().AddCompetitionToAdmins(f.users["dana"]).ExecX(ctx)
+	return f
+}
+
+// userContext is a request made by the user, loaded the way api.Middleware loads it
+func (f *fixture) userContext(t *testing.T, username string) context.Context {
+	t.Helper()
+	entUser := f.client.User.Query().
+		Where(user.IDEQ(f.users[username].ID)).
+		WithUserToCustomRole().
+		OnlyX(viewer.SystemContext(context.Background()))
+	return viewer.NewContext(context.Background(), &viewer.Viewer{User: entUser})
+}
+
+// serviceAccountContext is a request made by a service account limited to the teams, loaded the way
+// api.ServiceMiddleware loads it
+func (f *fixture) serviceAccountContext(t *testing.T, teamNames ...string) context.Context {
+	t.Helper()
+	ctx := viewer.SystemContext(context.Background())
+	serviceAccountCreate := f.client.ServiceAccount.Create().
+		SetDisplayName(fmt.Sprintf("service account %v", teamNames)).
+		SetAPIKey(uuid.New()).
+		SetActive(true)
+	for _, teamName := range teamNames {
+		serviceAccountCreate.AddServiceAccountToTeams(f.teams[teamName])
+	}
+	entServiceAccount := f.client.ServiceAccount.Query().
+		Where(serviceaccount.IDEQ(serviceAccountCreate.SaveX(ctx).ID)).
+		WithServiceAccountToCompetitions().
+		WithServiceAccountToTeams().
+		OnlyX(ctx)
+	return viewer.NewContext(context.Background(), &viewer.Viewer{ServiceAccount: entServiceAccount})
+}
+
+func sorted(names []string) []string {
+	sort.Strings(names)
+	return names
+}
+
+func TestFilters(t *testing.T) {
+	f := newFixture(t)
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		wantCompetitions []string
+		wantTeams        []string
+		wantVmObjects    []string
+		wantUsers        []string
+	}{
+		{"member sees their teams and only their active team's VMs", f.userContext(t, "alice"), []string{"A", "B"}, []string{"A1", "B1"}, []string{"vmA1"}, []string{"alice"}},
+		{"member of one team", f.userContext(t, "bob"), []string{"A"}, []string{"A2"}, []string{"vmA2"}, []string{"bob"}},
+		{"competition admin sees their competition", f.userContext(t, "dana"), []string{"A"}, []string{"A1", "A2"}, []string{"vmA1", "vmA2"}, []string{"alice", "bob", "dana"}},
+		{"red team sees red team VMs", f.userContext(t, "rita"), []string{"B"}, []string{"B1"}, []string{"vmB1"}, []string{"rita"}},
+		{"admin sees everything", f.userContext(t, "root"), []string{"A", "B"}, []string{"A1", "A2", "B1"}, []string{"vmA1", "vmA2", "vmB1"}, []string{"alice", "bob", "carol", "dana", "rita", "root"}},
+		{"restricted service account sees its teams", f.serviceAccountContext(t, "B1"), []string{"B"}, []string{"B1"}, []string{"vmB1"}, []string{"alice", "carol"}},
+		{"unrestricted service account sees everything", f.serviceAccountContext(t), []string{"A", "B"}, []string{"A1", "A2", "B1"}, []string{"vmA1", "vmA2", "vmB1"}, []string{"alice", "bob", "carol", "dana", "rita", "root"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, check := range []struct {
+				entity string
+				query  func(ctx context.Context) ([]string, error)
+				want   []string
+			}{
+				{"competitions", f.client.Competition.Query().Select("name").Strings, tt.wantCompetitions},
+				{"teams", f.client.Team.Query().Select("name").Strings, tt.wantTeams},
+				{"vm objects", f.client.VmObject.Query().Select("name").Strings, tt.wantVmObjects},
+				{"users", f.client.User.Query().Select("username").Strings, tt.wantUsers},
+			} {
+				got, err := check.query(tt.ctx)
+				if err != nil {
+					t.Fatalf("failed to query %s: %v", check.entity, err)
+				}
+				if fmt.Sprint(sorted(got)) != fmt.Sprint(sorted(check.want)) {
+					t.Errorf("got %s %v, want %v", check.entity, sorted(got), sorted(check.want))
+				}
+			}
+		})
+	}
+}
+
+func TestAllowUserSelfUpdate(t *testing.T) {
+	f := newFixture(t)
+	alice := f.userContext(t, "alice")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		update  func(ctx context.Context) error
+		wantErr bool
+	}{
+		{"change own name", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetFirstName("Alice").SetLastName("Smith").Exec(ctx)
+		}, false},
+		{"change own password", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetPassword("new hash").SetMustChangePassword(false).Exec(ctx)
+		}, false},
+		{"switch to a team they are a member of", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetUserToTeam(f.teams["B1"]).Exec(ctx)
+		}, false},
+		{"switch to a team they aren't a member of", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetUserToTeam(f.teams["A2"]).Exec(ctx)
+		}, true},
+		{"change own role", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetRole(user.RoleADMIN).Exec(ctx)
+		}, true},
+		{"change own provider", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetProvider(user.ProviderOIDC).Exec(ctx)
+		}, true},
+		{"force own password change", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().SetMustChangePassword(true).Exec(ctx)
+		}, true},
+		{"administer a competition", alice, func(ctx context.Context) error {
+			return f.users["alice"].Update().AddUserToAdminCompetitionIDs(f.teams["A1"].QueryTeamToCompetition().OnlyIDX(viewer.SystemContext(ctx))).Exec(ctx)
+		}, true},
+		{"change another user", alice, func(ctx context.Context) error {
+			return f.users["bob"].Update().SetFirstName("Robert").Exec(ctx)
+		}, true},
+		{"bulk update another user", alice, func(ctx context.Context) error {
+			updated, err := f.client.User.Update().Where(user.IDEQ(f.users["bob"].ID)).SetFirstName("Robert").Save(ctx)
+			if err == nil && updated == 0 {
+				return fmt.Errorf("no users were updated")
+			}
+			return err
+		}, true},
+		{"competition admin changes a competitor", f.userContext(t, "dana"), func(ctx context.Context) error {
+			return f.users["bob"].Update().SetFirstName("Robert").Exec(ctx)
+		}, false},
+		{"competition admin changes a user outside their competition", f.userContext(t, "dana"), func(ctx context.Context) error {
+			return f.users["carol"].Update().SetFirstName("Caroline").Exec(ctx)
+		}, true},
+		{"competition admin makes a competitor an admin", f.userContext(t, "dana"), func(ctx context.Context) error {
+			return f.users["bob"].Update().AddUserToAdminCompetitionIDs(f.teams["A2"].QueryTeamToCompetition().OnlyIDX(viewer.SystemContext(ctx))).Exec(ctx)
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.update(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Nothing alice wasn't allowed to change was changed
+	ctx := viewer.SystemContext(context.Background())
+	entAlice := f.client.User.GetX(ctx, f.users["alice"].ID)
+	if entAlice.Role != user.RoleUSER || entAlice.Provider != user.ProviderLOCAL || entAlice.MustChangePassword || entAlice.QueryUserToAdminCompetitions().ExistX(ctx) {
+		t.Errorf("alice changed a field she isn't allowed to: %+v", entAlice)
+	}
+	if activeTeam := entAlice.QueryUserToTeam().OnlyX(ctx); activeTeam.ID != f.teams["B1"].ID {
+		t.Errorf("got active team %s, want B1", activeTeam.Name)
+	}
+	if entBob := f.client.User.GetX(ctx, f.users["bob"].ID); entBob.FirstName != "Robert" {
+		t.Errorf("got bob's name %q, want the competition admin's change", entBob.FirstName)
+	}
+}
+
+func TestFilterTeamMembershipMutation(t *testing.T) {
+	f := newFixture(t)
+	addMembership := func(username string, teamName string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			return f.client.TeamMembership.Create().
+				SetTeamMembershipToUser(f.users[username]).
+				SetTeamMembershipToTeam(f.teams[teamName]).
+				Exec(ctx)
+		}
+	}
+	// deleteMembership fails if the membership wasn't deleted, since the filters hide memberships instead of failing
+	deleteMembership := func(username string, teamName string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			deleted, err := f.client.TeamMembership.Delete().
+				Where(
+					teammembership.HasTeamMembershipToUserWith(user.IDEQ(f.users[username].ID)),
+					teammembership.HasTeamMembershipToTeamWith(team.IDEQ(f.teams[teamName].ID)),
+				).
+				Exec(ctx)
+			if err == nil && deleted == 0 {
+				return fmt.Errorf("no team memberships were deleted")
+			}
+			return err
+		}
+	}
+
+	serviceAccount := f.serviceAccountContext(t, "B1")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		change  func(ctx context.Context) error
+		wantErr bool
+	}{
+		{"no viewer", context.Background(), addMembership("bob", "A1"), true},
+		{"competitor joins another team", f.userContext(t, "alice"), addMembership("alice", "A2"), true},
+		{"competitor leaves a team", f.userContext(t, "alice"), deleteMembership("alice", "B1"), true},
+		{"competitor promotes themselves", f.userContext(t, "bob"), func(ctx context.Context) error {
+			updated, err := f.client.TeamMembership.Update().
+				Where(teammembership.HasTeamMembershipToUserWith(user.IDEQ(f.users["bob"].ID))).
+				SetRole(teammembership.RoleCAPTAIN).
+				Save(ctx)
+			if err == nil && updated == 0 {
+				return fmt.Errorf("no team memberships were updated")
+			}
+			return err
+		}, true},
+		{"competition admin adds a user to their team", f.userContext(t, "dana"), addMembership("bob", "A1"), false},
+		{"competition admin adds a user to a team outside their competition", f.userContext(t, "dana"), addMembership("bob", "B1"), true},
+		{"competition admin removes a user from their team", f.userContext(t, "dana"), deleteMembership("bob", "A1"), false},
+		{"competition admin removes a user from a team outside their competition", f.userContext(t, "dana"), deleteMembership("alice", "B1"), true},
+		{"restricted service account adds a user to its team", serviceAccount, addMembership("bob", "B1"), false},
+		{"restricted service account adds a user to another team", serviceAccount, addMembership("carol", "A1"), true},
+		{"admin adds a user to any team", f.userContext(t, "root"), addMembership("carol", "A2"), false},
+		{"system removes a user from any team", viewer.SystemContext(context.Background()), deleteMembership("carol", "A2"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.change(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The denied changes didn't go through
+	ctx := viewer.SystemContext(context.Background())
+	for _, membership := range []struct {
+		username string
+		teamName string
+		want     bool
+	}{
+		{"alice", "A2", false},
+		{"alice", "B1", true},
+		{"bob", "B1", true},
+		{"carol", "A1", false},
+	} {
+		exists := f.client.TeamMembership.Query().
+			Where(
+				teammembership.HasTeamMembershipToUserWith(user.IDEQ(f.users[membership.username].ID)),
+				teammembership.HasTeamMembershipToTeamWith(team.IDEQ(f.teams[membership.teamName].ID)),
+			).
+			ExistX(ctx)
+		if exists != membership.want {
+			t.Errorf("got %s member of %s %v, want %v", membership.username, membership.teamName, exists, membership.want)
+		}
+	}
+	if f.client.TeamMembership.Query().Where(teammembership.RoleEQ(teammembership.RoleCAPTAIN)).ExistX(ctx) {
+		t.Errorf("competitor promoted themselves to captain")
+	}
+}
@@ -0,0 +1,213 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+)
+
+// HasSchedule returns whether the competition has a start, an end or any breaks. REQUIRES the CompetitionToBreaks
+// edge to be loaded.
+func HasSchedule(entCompetition *ent.Competition) bool {
+	return entCompetition.StartsAt != nil || entCompetition.EndsAt != nil || len(entCompetition.Edges.CompetitionToBreaks) > 0
+}
+
+// IsOpen returns whether competitors can access the competition at time t: after it starts, before it ends and
+// outside of its breaks. Competitions without a schedule are always open. REQUIRES the CompetitionToBreaks edge to be
+// loaded.
+func IsOpen(entCompetition *ent.Competition, t time.Time) bool {
+	if entCompetition.StartsAt != nil && t.Before(*entCompetition.StartsAt) {
+		return false
+	}
+	if entCompetition.EndsAt != nil && !t.Before(*entCompetition.EndsAt) {
+		return false
+	}
+	for _, entBreak := range entCompetition.Edges.CompetitionToBreaks {
+		if !t.Before(entBreak.StartsAt) && t.Before(entBreak.EndsAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanSignIn returns whether the user can sign in right now. Users can only sign in while at least one of their
+// competitions (the competitions of their teams and the competitions they administer) is open or is administered by
+// them (see permissions.AdministersCompetition).
+func CanSignIn(ctx context.Context, entUser *ent.User) (bool, error) {
+	entCompetitions, err := entUser.QueryUserToTeamMemberships().
+		QueryTeamMembershipToTeam().
+		QueryTeamToCompetition().
+		WithCompetitionToBreaks().
+		All(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query competitions: %v", err)
+	}
+	entAdminCompetitions, err := entUser.QueryUserToAdminCompetitions().WithCompetitionToBreaks().All(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query administered competitions: %v", err)
+	}
+	entCompetitions = append(entCompetitions, entAdminCompetitions...)
+	// Users without a competition can't access any VMs, so there's nothing to keep them out of
+	if len(entCompetitions) == 0 {
+		return true, nil
+	}
+	now := time.Now()
+	for _, entCompetition := range entCompetitions {
+		if IsOpen(entCompetition, now) {
+			return true, nil
+		}
+		administers, err := permissions.AdministersCompetition(ctx, entUser, entCompetition.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to check permission: %v", err)
+		}
+		if administers {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CanUseVmObject returns whether the user can use the VM right now. Competitors can only use the VMs of open
+// competitions, even if the scheduler hasn't locked them yet or an admin unlocked them. Users who administer the
+// competition (see permissions.AdministersCompetition) can always use them.
+func CanUseVmObject(ctx context.Context, entUser *ent.User, entVmObject *ent.VmObject) (bool, error) {
+	entCompetition, err := entVmObject.QueryVmObjectToTeam().
+		QueryTeamToCompetition().
+		WithCompetitionToBreaks().
+		Only(ctx)
+	// VMs without a team aren't part of any competition
+	if ent.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to query competition from vm object: %v", err)
+	}
+	administers, err := permissions.AdministersCompetition(ctx, entUser, entCompetition.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check permission: %v", err)
+	}
+	if administers {
+		return true, nil
+	}
+	return IsOpen(entCompetition, time.Now()), nil
+}
+
+// Scheduler locks and unlocks the VMs of competitions with a schedule as they open and close
+type Scheduler struct {
+	client *ent.Client
+	rdb    *redis.Client
+}
+
+func New(client *ent.Client, rdb *redis.Client) *Scheduler {
+	return &Scheduler{
+		client: client,
+		rdb:    rdb,
+	}
+}
+
+// Run applies the competition schedules every SCHEDULE_INTERVAL seconds (default 15). Blocks until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	interval := 15
+	if envValue, exists := os.LookupEnv("SCHEDULE_INTERVAL"); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil && atoiValue > 0 {
+			interval = atoiValue
+		}
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := s.Apply(ctx, time.Now()); err != nil {
+			logrus.Warnf("failed to apply competition schedules: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Apply locks the VMs of every scheduled competition which is closed at time t and unlocks the VMs of every
+// scheduled competition which is open, unless the scheduler already did so
+func (s *Scheduler) Apply(ctx context.Context, t time.Time) error {
+	entCompetitions, err := s.client.Competition.Query().
+		Where(
+			competition.Or(
+				competition.StartsAtNotNil(),
+				competition.EndsAtNotNil(),
+				competition.HasCompetitionToBreaks(),
+			),
+		).
+		WithCompetitionToBreaks().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query scheduled competitions: %v", err)
+	}
+	for _, entCompetition := range entCompetitions {
+		locked := !IsOpen(entCompetition, t)
+		if entCompetition.ScheduleLocked != nil && *entCompetition.ScheduleLocked == locked {
+			continue
+		}
+		if err := s.transition(ctx, entCompetition, locked); err != nil {
+			logrus.Warnf("failed to apply schedule for competition \"%s\": %v", entCompetition.Name, err)
+		}
+	}
+	return nil
+}
+
+// transition sets the lockout of every VM in the competition and publishes the lockout events
+func (s *Scheduler) transition(ctx context.Context, entCompetition *ent.Competition, locked bool) error {
+	// Claim the transition first, so only one server applies it when several are running
+	claimed, err := s.client.Competition.Update().
+		Where(
+			competition.IDEQ(entCompetition.ID),
+			competition.Or(
+				competition.ScheduleLockedIsNil(),
+				competition.ScheduleLockedNEQ(locked),
+			),
+		).
+		SetScheduleLocked(locked).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update competition: %v", err)
+	}
+	if claimed == 0 {
+		return nil
+	}
+	competitionVmObjects := vmobject.HasVmObjectToTeamWith(
+		team.HasTeamToCompetitionWith(
+			competition.IDEQ(entCompetition.ID),
+		),
+	)
+	err = s.client.VmObject.Update().Where(competitionVmObjects).SetLocked(locked).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set competition vm objects lock state: %v", err)
+	}
+	updatedVmUuids, err := s.client.VmObject.Query().Where(competitionVmObjects).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query updated vm objects: %v", err)
+	}
+	for _, vmUuid := range updatedVmUuids {
+		s.rdb.Publish(ctx, "lockout", vmUuid.String())
+	}
+	err = s.client.Action.Create().
+		SetType(action.TypeUPDATE_LOCKOUT).
+		SetMessage(fmt.Sprintf("schedule set lockout to %t for competition %s", locked, entCompetition.Name)).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+	}
+	logrus.Infof("Schedule set lockout to %t for competition \"%s\"", locked, entCompetition.Name)
+	return nil
+}
@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCanUseVmObject(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	ended := time.Now().Add(-time.Hour)
+	closedCompetition := client.Competition.Create().SetName("closed").SetEndsAt(ended).SetCompetitionToProvider(entProvider).SaveX(ctx)
+	openCompetition := client.Competition.Create().SetName("open").SetCompetitionToProvider(entProvider).SaveX(ctx)
+	newVmObject := func(entCompetition *ent.Competition) *ent.VmObject {
+		vmObjectCreate := client.VmObject.Create().SetName("vm").SetIdentifier("vm")
+		if entCompetition != nil {
+			entTeam := client.Team.Create().SetTeamNumber(1).SetTeamToCompetition(entCompetition).SaveX(ctx)
+			vmObjectCreate.SetVmObjectToTeam(entTeam)
+		}
+		return vmObjectCreate.SaveX(ctx)
+	}
+	newUser := func(username string, role user.Role) *ent.User {
+		return client.User.Create().SetUsername(username).SetPassword("hash").SetRole(role).SetProvider(user.ProviderLOCAL).SaveX(ctx)
+	}
+	competitor := newUser("competitor", user.RoleUSER)
+	competitionAdmin := newUser("admin", user.RoleUSER)
+	closedCompetition.Update().AddCompetitionToAdmins(competitionAdmin).ExecX(ctx)
+	otherAdmin := newUser("other admin", user.RoleUSER)
+	openCompetition.Update().AddCompetitionToAdmins(otherAdmin).ExecX(ctx)
+
+	tests := []struct {
+		name        string
+		entUser     *ent.User
+		entVmObject *ent.VmObject
+		want        bool
+	}{
+		{"competitor in an open competition", competitor, newVmObject(openCompetition), true},
+		{"competitor in a closed competition", competitor, newVmObject(closedCompetition), false},
+		{"vm without a competition", competitor, newVmObject(nil), true},
+		{"staff in a closed competition", newUser("white", user.RoleWHITE_TEAM), newVmObject(closedCompetition), true},
+		{"red team in a closed competition", newUser("red", user.RoleRED_TEAM), newVmObject(closedCompetition), false},
+		{"competition admin in their closed competition", competitionAdmin, newVmObject(closedCompetition), true},
+		{"admin of another competition in a closed competition", otherAdmin, newVmObject(closedCompetition), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanUseVmObject(ctx, tt.entUser, tt.entVmObject)
+			if err != nil {
+				t.Fatalf("failed to check schedule: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanSignIn(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	ended := time.Now().Add(-time.Hour)
+	newCompetition := func(name string, closed bool) *ent.Competition {
+		competitionCreate := client.Competition.Create().SetName(name).SetCompetitionToProvider(entProvider)
+		if closed {
+			competitionCreate.SetEndsAt(ended)
+		}
+		return competitionCreate.SaveX(ctx)
+	}
+	closedCompetition := newCompetition("closed", true)
+	otherClosedCompetition := newCompetition("other closed", true)
+	openCompetition := newCompetition("open", false)
+	newUser := func(username string, role user.Role, competitions []*ent.Competition, adminCompetitions []*ent.Competition) *ent.User {
+		entUser := client.User.Create().SetUsername(username).SetPassword("hash").SetRole(role).SetProvider(user.ProviderLOCAL).SaveX(ctx)
+		for i, entCompetition := range competitions {
+			entTeam := client.Team.Create().SetTeamNumber(i + 1).SetTeamToCompetition(entCompetition).SaveX(ctx)
+			client.TeamMembership.Create().SetTeamMembershipToUser(entUser).SetTeamMembershipToTeam(entTeam).ExecX(ctx)
+		}
+		for _, entCompetition := range adminCompetitions {
+			entCompetition.Update().AddCompetitionToAdmins(entUser).ExecX(ctx)
+		}
+		return entUser
+	}
+
+	tests := []struct {
+		name    string
+		entUser *ent.User
+		want    bool
+	}{
+		{"competitor in an open competition", newUser("open", user.RoleUSER, []*ent.Competition{openCompetition}, nil), true},
+		{"competitor in a closed competition", newUser("closed", user.RoleUSER, []*ent.Competition{closedCompetition}, nil), false},
+		{"competitor in a closed and an open competition", newUser("both", user.RoleUSER, []*ent.Competition{closedCompetition, openCompetition}, nil), true},
+		{"user without a competition", newUser("none", user.RoleUSER, nil, nil), true},
+		{"staff on a team in a closed competition", newUser("white", user.RoleWHITE_TEAM, []*ent.Competition{closedCompetition}, nil), true},
+		{"admin of a closed competition", newUser("admin", user.RoleUSER, nil, []*ent.Competition{closedCompetition}), true},
+		{"admin of a closed competition competing in another", newUser("admin competitor", user.RoleUSER, []*ent.Competition{otherClosedCompetition}, []*ent.Competition{closedCompetition}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanSignIn(ctx, tt.entUser)
+			if err != nil {
+				t.Fatalf("failed to check schedule: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/token"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// revokeChannel is the redis channel revoked session ids are published on, so every server can close the
+// subscriptions opened with them
+const revokeChannel = "revoke_session"
+
+// Manager revokes user sessions and closes any GraphQL subscriptions which were opened with them
+type Manager struct {
+	client *ent.Client
+	rdb    *redis.Client
+
+	mu sync.Mutex
+	// subscriptions are the cancel functions of the open subscription connections on this server, by session id
+	subscriptions map[uuid.UUID]map[*context.CancelFunc]bool
+}
+
+func New(client *ent.Client, rdb *redis.Client) *Manager {
+	return &Manager{
+		client:        client,
+		rdb:           rdb,
+		subscriptions: map[uuid.UUID]map[*context.CancelFunc]bool{},
+	}
+}
+
+// Track returns a context which is cancelled when the session is revoked. Used for long lived connections (eg. GraphQL
+// subscriptions) which are only authenticated when they are opened.
+func (m *Manager) Track(ctx context.Context, sessionId uuid.UUID) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	m.mu.Lock()
+	if m.subscriptions[sessionId] == nil {
+		m.subscriptions[sessionId] = map[*context.CancelFunc]bool{}
+	}
+	m.subscriptions[sessionId][&cancel] = true
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		delete(m.subscriptions[sessionId], &cancel)
+		if len(m.subscriptions[sessionId]) == 0 {
+			delete(m.subscriptions, sessionId)
+		}
+		m.mu.Unlock()
+	}()
+	return ctx
+}
+
+// closeSubscriptions cancels every connection on this server which was opened with the session
+func (m *Manager) closeSubscriptions(sessionId uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for cancel := range m.subscriptions[sessionId] {
+		(*cancel)()
+	}
+}
+
+// Listen closes subscriptions on this server when their session is revoked by any server. Blocks until ctx is done.
+func (m *Manager) Listen(ctx context.Context) {
+	sub := m.rdb.Subscribe(ctx, revokeChannel)
+	defer sub.Close()
+	ch := sub.Channel()
+	for {
+		select {
+		case message, ok := <-ch:
+			if !ok {
+				return
+			}
+			sessionId, err := uuid.Parse(message.Payload)
+			if err != nil {
+				logrus.Warnf("invalid revoked session id \"%s\": %v", message.Payload, err)
+				break
+			}
+			m.closeSubscriptions(sessionId)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Revoke deletes the matching sessions and closes their subscriptions. Returns the number of sessions revoked.
+func (m *Manager) Revoke(ctx context.Context, where ...predicate.Token) (int, error) {
+	sessionIds, err := m.client.Token.Query().Where(where...).IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query sessions: %v", err)
+	}
+	if len(sessionIds) == 0 {
+		return 0, nil
+	}
+	revoked, err := m.client.Token.Delete().Where(token.IDIn(sessionIds...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+	for _, sessionId := range sessionIds {
+		if err := m.rdb.Publish(ctx, revokeChannel, sessionId.String()).Err(); err != nil {
+			// Fall back to at least closing the subscriptions on this server
+			logrus.Warnf("failed to publish revoked session: %v", err)
+			m.closeSubscriptions(sessionId)
+		}
+	}
+	return revoked, nil
+}
+
+// envDuration reads a whole number of units from the env, falling back to defaultValue
+func envDuration(key string, defaultValue int, unit time.Duration) time.Duration {
+	value := defaultValue
+	if envValue, exists := os.LookupEnv(key); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil && atoiValue > 0 {
+			value = atoiValue
+		}
+	}
+	return time.Duration(value) * unit
+}
+
+// PurgeExpired deletes expired user sessions and service account sessions which can no longer be refreshed
+func (m *Manager) PurgeExpired(ctx context.Context) (int, int, error) {
+	now := time.Now()
+	purgedTokens, err := m.client.Token.Delete().Where(token.ExpireAtLT(now.Unix())).Exec(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to delete expired sessions: %v", err)
+	}
+	// Service tokens can be refreshed for REFRESH_WINDOW hours after they are issued
+	serviceTokenLifetime := envDuration("REFRESH_WINDOW", 60, time.Hour)
+	if sessionTimeout := envDuration("COOKIE_TIMEOUT", 60, time.Minute); sessionTimeout > serviceTokenLifetime {
+		serviceTokenLifetime = sessionTimeout
+	}
+	purgedServiceTokens, err := m.client.ServiceToken.Delete().
+		Where(servicetoken.IssuedAtLT(now.Add(-serviceTokenLifetime).Unix())).
+		Exec(ctx)
+	if err != nil {
+		return purgedTokens, 0, fmt.Errorf("failed to delete expired service account sessions: %v", err)
+	}
+	return purgedTokens, purgedServiceTokens, nil
+}
+
+// RunPurge purges expired sessions every SESSION_PURGE_INTERVAL minutes (default 60). Blocks until ctx is done.
+func (m *Manager) RunPurge(ctx context.Context) {
+	ticker := time.NewTicker(envDuration("SESSION_PURGE_INTERVAL", 60, time.Minute))
+	defer ticker.Stop()
+	for {
+		purgedTokens, purgedServiceTokens, err := m.PurgeExpired(ctx)
+		if err != nil {
+			logrus.Warnf("failed to purge expired sessions: %v", err)
+		} else if purgedTokens+purgedServiceTokens > 0 {
+			logrus.Infof("purged %d expired sessions and %d expired service account sessions", purgedTokens, purgedServiceTokens)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
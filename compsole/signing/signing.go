@@ -0,0 +1,373 @@
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/signingkey"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// refreshInterval is how often keys rotated by another server (or the keys command) are picked up
+const refreshInterval = time.Minute
+
+// missingKeyRefreshInterval limits how often an unknown kid causes the keys to be reloaded
+const missingKeyRefreshInterval = 10 * time.Second
+
+// rsaKeyBits is the size of generated RS256 keys
+const rsaKeyBits = 2048
+
+// key is a parsed SigningKey
+type key struct {
+	id         string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+	publicKey  crypto.PublicKey
+	expiresAt  *time.Time
+}
+
+// Every token helper needs the keys, so they are kept here in the same way the JWT_SECRET env var used to be read
+// wherever it was needed. Init MUST be called before any tokens are signed or verified.
+var (
+	mu            sync.RWMutex
+	client        *ent.Client
+	signingKey    *key
+	verifyingKeys = map[string]*key{}
+	lastLoaded    time.Time
+)
+
+// Init loads the signing keys, generating one with the JWT_SIGNING_ALGORITHM (default RS256) if there isn't a usable
+// key yet
+func Init(ctx context.Context, entClient *ent.Client) error {
+	mu.Lock()
+	client = entClient
+	mu.Unlock()
+
+	hasSigningKey, err := entClient.SigningKey.Query().Where(usableSigningKey()).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query signing keys: %v", err)
+	}
+	if !hasSigningKey {
+		algorithm, err := DefaultAlgorithm()
+		if err != nil {
+			return err
+		}
+		entSigningKey, err := Generate(ctx, entClient, algorithm)
+		if err != nil {
+			return err
+		}
+		logrus.Infof("Generated %s signing key %s", entSigningKey.Algorithm, entSigningKey.ID)
+	}
+	return Reload(ctx)
+}
+
+// DefaultAlgorithm returns the algorithm from the JWT_SIGNING_ALGORITHM env var (default RS256)
+func DefaultAlgorithm() (signingkey.Algorithm, error) {
+	algorithm := signingkey.AlgorithmRS256
+	if envValue, exists := os.LookupEnv("JWT_SIGNING_ALGORITHM"); exists && envValue != "" {
+		algorithm = signingkey.Algorithm(envValue)
+	}
+	if err := signingkey.AlgorithmValidator(algorithm); err != nil {
+		return "", fmt.Errorf("JWT_SIGNING_ALGORITHM must be RS256 or EdDSA")
+	}
+	return algorithm, nil
+}
+
+// usableSigningKey matches keys which can sign new tokens
+func usableSigningKey() predicate.SigningKey {
+	return signingkey.And(
+		signingkey.RetiredAtIsNil(),
+		signingkey.Or(
+			signingkey.ExpiresAtIsNil(),
+			signingkey.ExpiresAtGT(time.Now()),
+		),
+	)
+}
+
+// Reload reads the keys from the database. The newest key which isn't retired signs tokens and every key which hasn't
+// expired verifies them.
+func Reload(ctx context.Context) error {
+	mu.RLock()
+	entClient := client
+	mu.RUnlock()
+	if entClient == nil {
+		return fmt.Errorf("signing keys haven't been initialized")
+	}
+
+	entSigningKeys, err := entClient.SigningKey.Query().
+		Where(
+			signingkey.Or(
+				signingkey.ExpiresAtIsNil(),
+				signingkey.ExpiresAtGT(time.Now()),
+			),
+		).
+		Order(ent.Desc(signingkey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query signing keys: %v", err)
+	}
+	var newSigningKey *key
+	newVerifyingKeys := map[string]*key{}
+	for _, entSigningKey := range entSigningKeys {
+		k, err := parseKey(entSigningKey)
+		if err != nil {
+			logrus.Errorf("failed to parse signing key %s: %v", entSigningKey.ID, err)
+			continue
+		}
+		newVerifyingKeys[k.id] = k
+		if newSigningKey == nil && entSigningKey.RetiredAt == nil {
+			newSigningKey = k
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	signingKey = newSigningKey
+	verifyingKeys = newVerifyingKeys
+	lastLoaded = time.Now()
+	return nil
+}
+
+// RunRefresh periodically reloads the keys so rotations made elsewhere are picked up. Blocks until ctx is done.
+func RunRefresh(ctx context.Context) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := Reload(ctx); err != nil {
+				logrus.Warnf("failed to reload signing keys: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sign signs the claims with the current signing key. The key's id is set as the `kid` header.
+func Sign(claims jwt.Claims) (string, error) {
+	mu.RLock()
+	k := signingKey
+	mu.RUnlock()
+	if k == nil {
+		return "", fmt.Errorf("no signing key is available")
+	}
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.id
+	return token.SignedString(k.privateKey)
+}
+
+// ParseWithClaims verifies the token with the key from its `kid` header and decodes it into claims. Tokens without a
+// `kid` were signed before signing keys existed and are verified with the JWT_SECRET env var, if it is still set.
+func ParseWithClaims(tokenString string, claims jwt.Claims, options ...jwt.ParserOption) (*jwt.Token, error) {
+	options = append(options, jwt.WithValidMethods([]string{
+		jwt.SigningMethodRS256.Alg(),
+		jwt.SigningMethodEdDSA.Alg(),
+		jwt.SigningMethodHS256.Alg(),
+		jwt.SigningMethodHS512.Alg(),
+	}))
+	return jwt.ParseWithClaims(tokenString, claims, keyFunc, options...)
+}
+
+// keyFunc finds the key which verifies the token
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		if _, isHMAC := token.Method.(*jwt.SigningMethodHMAC); !isHMAC {
+			return nil, fmt.Errorf("token is missing a kid")
+		}
+		jwtKey, exists := os.LookupEnv("JWT_SECRET")
+		if !exists || jwtKey == "" {
+			return nil, fmt.Errorf("legacy tokens are no longer accepted")
+		}
+		return []byte(jwtKey), nil
+	}
+	k := verifyingKey(kid)
+	if k == nil {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	if k.method.Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return k.publicKey, nil
+}
+
+// verifyingKey returns the key with the id. Keys which are missing might have just been generated by another
+// server, so the keys are reloaded (at most every missingKeyRefreshInterval) before giving up.
+func verifyingKey(kid string) *key {
+	mu.RLock()
+	k, ok := verifyingKeys[kid]
+	canReload := time.Since(lastLoaded) > missingKeyRefreshInterval
+	mu.RUnlock()
+	if !ok && canReload {
+		if err := Reload(context.Background()); err != nil {
+			logrus.Warnf("failed to reload signing keys: %v", err)
+		}
+		mu.RLock()
+		k, ok = verifyingKeys[kid]
+		mu.RUnlock()
+	}
+	if !ok || (k.expiresAt != nil && time.Now().After(*k.expiresAt)) {
+		return nil
+	}
+	return k
+}
+
+// Generate creates a new signing key. It becomes the signing key the next time the keys are reloaded, but any existing
+// keys aren't retired. Use Rotate to replace the signing key.
+func Generate(ctx context.Context, entClient *ent.Client, algorithm signingkey.Algorithm) (*ent.SigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch algorithm {
+	case signingkey.AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case signingkey.AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s key: %v", algorithm, err)
+	}
+	privateKeyDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %v", err)
+	}
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %v", err)
+	}
+	entSigningKey, err := entClient.SigningKey.Create().
+		SetAlgorithm(algorithm).
+		SetPrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDer}))).
+		SetPublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDer}))).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signing key: %v", err)
+	}
+	return entSigningKey, nil
+}
+
+// Rotate generates a new signing key and retires the current ones. Retired keys keep verifying tokens for
+// verifyFor, which should be at least as long as the longest token lifetime. Keys which have expired are deleted.
+func Rotate(ctx context.Context, entClient *ent.Client, algorithm signingkey.Algorithm, verifyFor time.Duration) (*ent.SigningKey, error) {
+	entSigningKey, err := Generate(ctx, entClient, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	retiredAt := time.Now()
+	err = entClient.SigningKey.Update().
+		Where(
+			signingkey.IDNEQ(entSigningKey.ID),
+			signingkey.RetiredAtIsNil(),
+		).
+		SetRetiredAt(retiredAt).
+		SetExpiresAt(retiredAt.Add(verifyFor)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retire signing keys: %v", err)
+	}
+	_, err = entClient.SigningKey.Delete().Where(signingkey.ExpiresAtLT(retiredAt)).Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to delete expired signing keys: %v", err)
+	}
+	return entSigningKey, nil
+}
+
+// parseKey decodes the PEM encoded keys of a SigningKey
+func parseKey(entSigningKey *ent.SigningKey) (*key, error) {
+	privateBlock, _ := pem.Decode([]byte(entSigningKey.PrivateKey))
+	if privateBlock == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key can't sign")
+	}
+	var method jwt.SigningMethod
+	switch entSigningKey.Algorithm {
+	case signingkey.AlgorithmRS256:
+		method = jwt.SigningMethodRS256
+	case signingkey.AlgorithmEdDSA:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", entSigningKey.Algorithm)
+	}
+	return &key{
+		id:         entSigningKey.ID.String(),
+		method:     method,
+		privateKey: signer,
+		publicKey:  signer.Public(),
+		expiresAt:  entSigningKey.ExpiresAt,
+	}, nil
+}
+
+// JSONWebKey is the public half of a signing key, as described in RFC 7517
+type JSONWebKey struct {
+	KeyType   string `json:"kty" example:"RSA"`
+	KeyID     string `json:"kid" example:"5f1b4c2e-0f6a-4d3b-9a57-2a7c1e8d9b10"`
+	Algorithm string `json:"alg" example:"RS256"`
+	Use       string `json:"use" example:"sig"`
+	// RSA keys
+	Modulus  string `json:"n,omitempty"`
+	Exponent string `json:"e,omitempty"`
+	// EdDSA keys
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet is the set of keys which verify tokens
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeySet returns the public keys of every key which verifies tokens
+func KeySet() JSONWebKeySet {
+	mu.RLock()
+	defer mu.RUnlock()
+	keySet := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, k := range verifyingKeys {
+		jwk := JSONWebKey{
+			KeyID:     k.id,
+			Algorithm: k.method.Alg(),
+			Use:       "sig",
+		}
+		switch publicKey := k.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.Modulus = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		default:
+			continue
+		}
+		keySet.Keys = append(keySet.Keys, jwk)
+	}
+	sort.Slice(keySet.Keys, func(i, j int) bool {
+		return keySet.Keys[i].KeyID < keySet.Keys[j].KeyID
+	})
+	return keySet
+}
@@ -0,0 +1,147 @@
+package signing
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/signingkey"
+	"github.com/golang-jwt/jwt/v5"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testSecret = "legacy-secret"
+
+func newTestClaims() *jwt.RegisteredClaims {
+	return &jwt.RegisteredClaims{
+		Subject:   "alice",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+// signLegacy signs a token the way they were signed before signing keys existed
+func signLegacy(t *testing.T, secret string) string {
+	t.Helper()
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, newTestClaims()).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign legacy token: %v", err)
+	}
+	return tokenString
+}
+
+func TestParseWithClaims(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	t.Setenv("JWT_SIGNING_ALGORITHM", "")
+	if err := Init(ctx, client); err != nil {
+		t.Fatalf("failed to init signing keys: %v", err)
+	}
+	mu.RLock()
+	currentKey := signingKey
+	mu.RUnlock()
+
+	signed, err := Sign(newTestClaims())
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(currentKey.publicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	// Signs an HMAC token using the public key as the secret, which a verifier mixing up algorithms would accept
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, newTestClaims())
+	confused.Header["kid"] = currentKey.id
+	confusedString, err := confused.SignedString(publicKeyBytes)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	unknownKid := jwt.NewWithClaims(currentKey.method, newTestClaims())
+	unknownKid.Header["kid"] = "00000000-0000-0000-0000-000000000000"
+	unknownKidString, err := unknownKid.SignedString(currentKey.privateKey)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	noKidString, err := jwt.NewWithClaims(currentKey.method, newTestClaims()).SignedString(currentKey.privateKey)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		jwtSecret string
+		wantValid bool
+	}{
+		{"token with the current kid", signed, "", true},
+		{"legacy token while JWT_SECRET is set", signLegacy(t, testSecret), testSecret, true},
+		{"legacy token after JWT_SECRET is removed", signLegacy(t, testSecret), "", false},
+		{"legacy token signed with another secret", signLegacy(t, "other-secret"), testSecret, false},
+		{"asymmetric token without a kid", noKidString, testSecret, false},
+		{"hmac token naming an asymmetric key", confusedString, testSecret, false},
+		{"token with an unknown kid", unknownKidString, testSecret, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_SECRET", tt.jwtSecret)
+			claims := &jwt.RegisteredClaims{}
+			tkn, err := ParseWithClaims(tt.token, claims)
+			valid := err == nil && tkn.Valid
+			if valid != tt.wantValid {
+				t.Fatalf("got valid %v (%v), want %v", valid, err, tt.wantValid)
+			}
+			if valid && claims.Subject != "alice" {
+				t.Errorf("got subject %q, want %q", claims.Subject, "alice")
+			}
+		})
+	}
+}
+
+func TestParseWithClaimsAfterRotation(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	if err := Init(ctx, client); err != nil {
+		t.Fatalf("failed to init signing keys: %v", err)
+	}
+	signed, err := Sign(newTestClaims())
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	// Retired keys keep verifying tokens until they expire
+	if _, err := Rotate(ctx, client, signingkey.AlgorithmEdDSA, time.Hour); err != nil {
+		t.Fatalf("failed to rotate signing key: %v", err)
+	}
+	if err := Reload(ctx); err != nil {
+		t.Fatalf("failed to reload signing keys: %v", err)
+	}
+	if _, err := ParseWithClaims(signed, &jwt.RegisteredClaims{}); err != nil {
+		t.Errorf("token from a retired key was rejected: %v", err)
+	}
+	rotated, err := Sign(newTestClaims())
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if tkn, _ := jwt.Parse(rotated, nil); tkn == nil || tkn.Method != jwt.SigningMethodEdDSA {
+		t.Errorf("tokens aren't signed with the new key")
+	}
+
+	if _, err := Rotate(ctx, client, signingkey.AlgorithmRS256, -time.Second); err != nil {
+		t.Fatalf("failed to rotate signing key: %v", err)
+	}
+	if err := Reload(ctx); err != nil {
+		t.Fatalf("failed to reload signing keys: %v", err)
+	}
+	// Rotating without a grace period expires the EdDSA key at once, but the first key still has its hour
+	if _, err := ParseWithClaims(rotated, &jwt.RegisteredClaims{}); err == nil {
+		t.Errorf("token from an expired key was accepted")
+	}
+	if _, err := ParseWithClaims(signed, &jwt.RegisteredClaims{}); err != nil {
+		t.Errorf("token from a retired key was rejected: %v", err)
+	}
+}
@@ -2,22 +2,82 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/BradHacker/compsole/compsole/permissions"
 	"github.com/BradHacker/compsole/ent"
-	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/vmobject"
 )
 
-func UserCanAccessVM(ctx context.Context, entVmObject *ent.VmObject, entUser *ent.User) (bool, error) {
-	if entUser.Role != user.RoleADMIN {
-		canAccessVm, err := entUser.QueryUserToTeam().QueryTeamToVmObjects().Where(vmobject.IDEQ(entVmObject.ID)).Exist(ctx)
+// UserCanAccessVM returns whether the user can use the permission (eg. permissions.VmPower) on the vm. Users can
+// always access the VMs of their active team, though observers can only view them, and competition admins can access
+// every VM in their competitions. Users with the "red_team:console" permission can also view and open consoles on
+// VMs marked for red team access.
+func UserCanAccessVM(ctx context.Context, entVmObject *ent.VmObject, entUser *ent.User, permission permissions.Permission) (bool, error) {
+	hasPermission, err := hasPermissionForVM(ctx, entVmObject, entUser, permission)
+	if err != nil {
+		return false, err
+	}
+	if hasPermission {
+		return true, nil
+	}
+	if entVmObject.RedTeamAccess && (permission == permissions.VmRead || permission == permissions.VmConsole) {
+		hasRedTeamConsole, err := permissions.Has(ctx, entUser, permissions.RedTeamConsole)
 		if err != nil {
-			return false, fmt.Errorf("failed to query vm object from user")
+			return false, err
 		}
-		if !canAccessVm {
-			return false, nil
+		if hasRedTeamConsole {
+			return true, nil
 		}
 	}
-	return true, nil
+	// Only the VMs of the active team are accessible, the user has to switch teams to use their other teams' VMs
+	entMembership, err := ActiveTeamMembership(ctx, entUser)
+	if err != nil {
+		return false, err
+	}
+	if entMembership == nil {
+		return false, nil
+	}
+	if entMembership.Role == teammembership.RoleOBSERVER && permission != permissions.VmRead {
+		return false, nil
+	}
+	canAccessVm, err := entMembership.QueryTeamMembershipToTeam().QueryTeamToVmObjects().Where(vmobject.IDEQ(entVmObject.ID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query vm object from team membership: %v", err)
+	}
+	return canAccessVm, nil
+}
+
+// UserIsLockedOut returns whether the vm is locked for the user. Lockouts only apply to users accessing the vm
+// through their team or red team access, not to users with the permission for every VM or the vm's competition.
+func UserIsLockedOut(ctx context.Context, entVmObject *ent.VmObject, entUser *ent.User, permission permissions.Permission) (bool, error) {
+	if !entVmObject.Locked {
+		return false, nil
+	}
+	hasPermission, err := hasPermissionForVM(ctx, entVmObject, entUser, permission)
+	if err != nil {
+		return false, err
+	}
+	return !hasPermission, nil
+}
+
+// hasPermissionForVM returns whether the user has the permission for every VM or as an admin of the vm's competition
+func hasPermissionForVM(ctx context.Context, entVmObject *ent.VmObject, entUser *ent.User, permission permissions.Permission) (bool, error) {
+	scope, err := permissions.ScopeFor(ctx, entUser, permission)
+	if errors.Is(err, permissions.ErrNotPermitted) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if scope.IsGlobal() {
+		return true, nil
+	}
+	administersVm, err := entVmObject.QueryVmObjectToTeam().QueryTeamToCompetition().Where(scope.Competitions()).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query competition from vm object: %v", err)
+	}
+	return administersVm, nil
 }
@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// ConsoleLimits are the max number of simultaneous console sessions. A limit of 0 is unlimited.
+type ConsoleLimits struct {
+	PerVm   int
+	PerUser int
+	PerTeam int
+}
+
+// ConsoleLimitError is returned when opening a console would exceed one of the console limits
+type ConsoleLimitError struct {
+	Scope    string
+	Limit    int
+	Sessions []*ent.ConsoleSession
+}
+
+func (e *ConsoleLimitError) Error() string {
+	holders := make([]string, 0, len(e.Sessions))
+	for _, entConsoleSession := range e.Sessions {
+		username := "unknown user"
+		if entConsoleSession.Edges.ConsoleSessionToUser != nil {
+			username = entConsoleSession.Edges.ConsoleSessionToUser.Username
+		}
+		vmName := "unknown vm"
+		if entConsoleSession.Edges.ConsoleSessionToVmObject != nil {
+			vmName = entConsoleSession.Edges.ConsoleSessionToVmObject.Name
+		}
+		holders = append(holders, fmt.Sprintf("%s on %s (since %s)", username, vmName, entConsoleSession.StartedAt.Format(time.Kitchen)))
+	}
+	return fmt.Sprintf("console limit of %d per %s reached, current sessions are held by: %s", e.Limit, e.Scope, strings.Join(holders, ", "))
+}
+
+// ConsoleSessionLease is how long a console session is considered active when the console url is handed directly
+// to the browser, since Compsole can't tell when those consoles are closed. Set with CONSOLE_SESSION_LEASE (in minutes).
+func ConsoleSessionLease() time.Duration {
+	consoleSessionLease := 10
+	if envValue, exists := os.LookupEnv("CONSOLE_SESSION_LEASE"); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil {
+			consoleSessionLease = atoiValue
+		}
+	}
+	return time.Duration(consoleSessionLease) * time.Minute
+}
+
+// ActiveConsoleSession matches console sessions which are still open or whose lease hasn't expired
+func ActiveConsoleSession() predicate.ConsoleSession {
+	return consolesession.Or(
+		consolesession.EndedAtIsNil(),
+		consolesession.EndedAtGT(time.Now()),
+	)
+}
+
+// ConsoleLimitsForVM returns the console limits of the vm's competition with any overrides set on the vm applied
+func ConsoleLimitsForVM(ctx context.Context, entVmObject *ent.VmObject) (ConsoleLimits, error) {
+	limits := ConsoleLimits{}
+	entCompetition, err := entVmObject.QueryVmObjectToTeam().QueryTeamToCompetition().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return limits, fmt.Errorf("failed to query competition from vm object: %v", err)
+	}
+	if entCompetition != nil {
+		limits.PerVm = entCompetition.ConsoleLimitPerVM
+		limits.PerUser = entCompetition.ConsoleLimitPerUser
+		limits.PerTeam = entCompetition.ConsoleLimitPerTeam
+	}
+	if entVmObject.ConsoleLimitPerVM != nil {
+		limits.PerVm = *entVmObject.ConsoleLimitPerVM
+	}
+	if entVmObject.ConsoleLimitPerUser != nil {
+		limits.PerUser = *entVmObject.ConsoleLimitPerUser
+	}
+	if entVmObject.ConsoleLimitPerTeam != nil {
+		limits.PerTeam = *entVmObject.ConsoleLimitPerTeam
+	}
+	return limits, nil
+}
+
+// CheckConsoleLimits returns a ConsoleLimitError if the user opening a console on the vm would exceed any of its
+// console limits. Users with the "vm:console" permission (eg. admins and white team) are not subject to console limits.
+// The sessions can change once this returns, so use OpenConsoleSession to create the session itself.
+func CheckConsoleLimits(ctx context.Context, entVmObject *ent.VmObject, entUser *ent.User) error {
+	exempt, err := permissions.Has(ctx, entUser, permissions.VmConsole)
+	if err != nil {
+		return err
+	}
+	if exempt {
+		return nil
+	}
+	// The limits count the sessions of every user, including the ones the user can't see
+	ctx = viewer.SystemContext(ctx)
+	limits, err := ConsoleLimitsForVM(ctx, entVmObject)
+	if err != nil {
+		return err
+	}
+	checks := []struct {
+		scope string
+		limit int
+		query func() *ent.ConsoleSessionQuery
+	}{
+		{"vm", limits.PerVm, entVmObject.QueryVmObjectToConsoleSessions},
+		{"user", limits.PerUser, entUser.QueryUserToConsoleSessions},
+		{"team", limits.PerTeam, func() *ent.ConsoleSessionQuery {
+			return entUser.QueryUserToTeam().QueryTeamToUsers().QueryUserToConsoleSessions()
+		}},
+	}
+	for _, check := range checks {
+		if check.limit <= 0 {
+			continue
+		}
+		entConsoleSessions, err := check.query().
+			Where(ActiveConsoleSession()).
+			WithConsoleSessionToUser().
+			WithConsoleSessionToVmObject().
+			Order(ent.Asc(consolesession.FieldStartedAt)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query active console sessions: %v", err)
+		}
+		if len(entConsoleSessions) >= check.limit {
+			return &ConsoleLimitError{
+				Scope:    check.scope,
+				Limit:    check.limit,
+				Sessions: entConsoleSessions,
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	consoleLimitsLockKey = "console_limits_lock"
+	// consoleLimitsLockTTL releases the lock if the server holding it dies, and is how long a request waits for it
+	consoleLimitsLockTTL = 10 * time.Second
+)
+
+// releaseLockScript only deletes the lock if it's still held by the same request, since it could have expired and been
+// taken by another one
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// lockConsoleLimits takes the lock which serializes console limit checks across every Compsole server and returns the
+// function which releases it
+func lockConsoleLimits(ctx context.Context, rdb *redis.Client) (func(), error) {
+	ctx, cancel := context.WithTimeout(ctx, consoleLimitsLockTTL)
+	defer cancel()
+	lockToken := uuid.NewString()
+	for {
+		locked, err := rdb.SetNX(ctx, consoleLimitsLockKey, lockToken, consoleLimitsLockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock console limits: %v", err)
+		}
+		if locked {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for console limits lock: %v", ctx.Err())
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+	return func() {
+		if err := releaseLockScript.Run(context.Background(), rdb, []string{consoleLimitsLockKey}, lockToken).Err(); err != nil {
+			logrus.Warnf("failed to release console limits lock: %v", err)
+		}
+	}, nil
+}
+
+// OpenConsoleSession checks the console limits and saves the console session while holding a lock, so concurrent
+// requests can't all pass the check before any of their sessions exist. Returns a ConsoleLimitError if the session
+// would exceed the limits.
+func OpenConsoleSession(ctx context.Context, rdb *redis.Client, entVmObject *ent.VmObject, entUser *ent.User, consoleSessionCreate *ent.ConsoleSessionCreate) (*ent.ConsoleSession, error) {
+	exempt, err := permissions.Has(ctx, entUser, permissions.VmConsole)
+	if err != nil {
+		return nil, err
+	}
+	if !exempt {
+		unlock, err := lockConsoleLimits(ctx, rdb)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		if err = CheckConsoleLimits(ctx, entVmObject, entUser); err != nil {
+			return nil, err
+		}
+	}
+	entConsoleSession, err := consoleSessionCreate.
+		SetConsoleSessionToUser(entUser).
+		SetConsoleSessionToVmObject(entVmObject).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create console session: %v", err)
+	}
+	return entConsoleSession, nil
+}
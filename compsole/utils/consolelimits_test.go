@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/BradHacker/compsole/ent/hook"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestOpenConsoleSessionEnforcesLimitsConcurrently(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	// sqlite can't write from several connections to a shared in-memory database at once
+	db.SetMaxOpenConns(1)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB("sqlite3", db))))
+	defer client.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	// Slow inserts give every request the chance to count the sessions before the others are saved
+	client.ConsoleSession.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.ConsoleSessionFunc(func(ctx context.Context, m *ent.ConsoleSessionMutation) (ent.Value, error) {
+			time.Sleep(10 * time.Millisecond)
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	entProvider := client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	entCompetition := client.Competition.Create().SetName("competition").SetConsoleLimitPerVM(2).SetCompetitionToProvider(entProvider).SaveX(ctx)
+	entTeam := client.Team.Create().SetTeamNumber(1).SetTeamToCompetition(entCompetition).SaveX(ctx)
+	entVmObject := client.VmObject.Create().SetName("vm").SetIdentifier("vm").SetVmObjectToTeam(entTeam).SaveX(ctx)
+
+	const requests = 10
+	entUsers := make([]*ent.User, requests)
+	for i := range entUsers {
+		entUsers[i] = client.User.Create().SetUsername(fmt.Sprintf("user%d", i)).SetPassword("hash").SetRole(user.RoleUSER).SetProvider(user.ProviderLOCAL).SaveX(ctx)
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, requests)
+	for i, entUser := range entUsers {
+		wg.Add(1)
+		go func(i int, entUser *ent.User) {
+			defer wg.Done()
+			_, errs[i] = OpenConsoleSession(ctx, rdb, entVmObject, entUser, client.ConsoleSession.Create().SetConsoleType(string(SerialConsole)))
+		}(i, entUser)
+	}
+	wg.Wait()
+
+	opened := 0
+	for _, err := range errs {
+		if err == nil {
+			opened++
+		} else if _, ok := err.(*ConsoleLimitError); !ok {
+			t.Errorf("failed to open console session: %v", err)
+		}
+	}
+	if opened != 2 {
+		t.Errorf("opened %d console sessions, want 2", opened)
+	}
+	if count := client.ConsoleSession.Query().CountX(ctx); count != opened {
+		t.Errorf("got %d console sessions saved, want %d", count, opened)
+	}
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+)
+
+// EnsureTeamMembership adds the user to the team as a member if they aren't on it already. Setting a user's active
+// team directly (eg. through the REST API or a group mapping) also makes them a member of it.
+func EnsureTeamMembership(ctx context.Context, client *ent.Client, entUser *ent.User, entTeam *ent.Team) error {
+	if entTeam == nil {
+		return nil
+	}
+	isMember, err := client.TeamMembership.Query().
+		Where(
+			teammembership.HasTeamMembershipToUserWith(user.IDEQ(entUser.ID)),
+			teammembership.HasTeamMembershipToTeamWith(team.IDEQ(entTeam.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query team membership: %v", err)
+	}
+	if isMember {
+		return nil
+	}
+	err = client.TeamMembership.Create().
+		SetTeamMembershipToUser(entUser).
+		SetTeamMembershipToTeam(entTeam).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create team membership: %v", err)
+	}
+	return nil
+}
+
+// ReplaceActiveTeamMembership moves the user's membership from their previous active team to their new one (either can
+// be nil). Changes which only set the active team are moving the user, so they mustn't be able to switch back to the
+// team they were taken off of.
+func ReplaceActiveTeamMembership(ctx context.Context, client *ent.Client, entUser *ent.User, previousTeam *ent.Team, entTeam *ent.Team) error {
+	if previousTeam != nil && (entTeam == nil || previousTeam.ID != entTeam.ID) {
+		_, err := client.TeamMembership.Delete().
+			Where(
+				teammembership.HasTeamMembershipToUserWith(user.IDEQ(entUser.ID)),
+				teammembership.HasTeamMembershipToTeamWith(team.IDEQ(previousTeam.ID)),
+			).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete team membership: %v", err)
+		}
+	}
+	return EnsureTeamMembership(ctx, client, entUser, entTeam)
+}
+
+// ActiveTeamMembership returns the user's membership of their active team, or nil if they don't have an active team
+func ActiveTeamMembership(ctx context.Context, entUser *ent.User) (*ent.TeamMembership, error) {
+	entMembership, err := entUser.QueryUserToTeamMemberships().
+		Where(teammembership.HasTeamMembershipToTeamWith(team.HasTeamToUsersWith(user.IDEQ(entUser.ID)))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query active team membership from user: %v", err)
+	}
+	return entMembership, nil
+}
+
+// BackfillTeamMemberships makes users members of their active team. Users could only be on one team before team
+// memberships were added, so existing users don't have any.
+func BackfillTeamMemberships(ctx context.Context, client *ent.Client) (int, error) {
+	entUsers, err := client.User.Query().
+		Where(user.HasUserToTeam(), user.Not(user.HasUserToTeamMemberships())).
+		WithUserToTeam().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query users without team memberships: %v", err)
+	}
+	for _, entUser := range entUsers {
+		if err = EnsureTeamMembership(ctx, client, entUser, entUser.Edges.UserToTeam); err != nil {
+			return 0, err
+		}
+	}
+	return len(entUsers), nil
+}
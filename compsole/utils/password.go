@@ -30,12 +30,18 @@ func NewPassword() string {
 // By default, it uses Argon2id for hashing. If the environment variable
 // PASSWORD_HASH_ALGO is set to "bcrypt", it will use bcrypt instead.
 func HashPassword(password string) (string, error) {
-	if os.Getenv("PASSWORD_HASH_ALGO") == "bcrypt" {
+	if usesBcrypt() {
 		return hashBcrypt(password)
 	}
 	return hashArgon2(password)
 }
 
+// usesBcrypt returns whether new passwords are hashed with bcrypt, which only supports passwords up to
+// maxBcryptPasswordBytes
+func usesBcrypt() bool {
+	return os.Getenv("PASSWORD_HASH_ALGO") == "bcrypt"
+}
+
 var (
 	argon2Prefix = "$argon2id$"
 	bcryptPrefix = "$2"
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	_ "embed"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxPasswordLength keeps password hashing cheap enough that long passwords can't be used to tie up the server
+const maxPasswordLength = 128
+
+// maxBcryptPasswordBytes is the longest password bcrypt can hash, which is shorter than maxPasswordLength
+const maxBcryptPasswordBytes = 72
+
+// passwordSymbols are the symbols added to generated passwords when the policy requires one
+const passwordSymbols = "!@#$%^&*-_=+?"
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords is the bundled list of common and breached passwords, lowercased
+var commonPasswords = func() map[string]bool {
+	passwords := map[string]bool{}
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = true
+	}
+	return passwords
+}()
+
+// PasswordPolicy is the set of rules new passwords must follow
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// CheckCommon rejects passwords from the bundled list of common and breached passwords
+	CheckCommon bool
+}
+
+// LoadPasswordPolicy loads the password policy from the env. Set with PASSWORD_MIN_LENGTH, PASSWORD_REQUIRE_UPPER,
+// PASSWORD_REQUIRE_LOWER, PASSWORD_REQUIRE_DIGIT, PASSWORD_REQUIRE_SYMBOL and PASSWORD_CHECK_COMMON.
+func LoadPasswordPolicy() PasswordPolicy {
+	policy := PasswordPolicy{
+		MinLength:   12,
+		CheckCommon: true,
+	}
+	if envValue, exists := os.LookupEnv("PASSWORD_MIN_LENGTH"); exists {
+		if atoiValue, err := strconv.Atoi(envValue); err == nil && atoiValue > 0 {
+			policy.MinLength = atoiValue
+		}
+	}
+	if usesBcrypt() && policy.MinLength > maxBcryptPasswordBytes {
+		logrus.Warnf("PASSWORD_MIN_LENGTH is longer than bcrypt supports, using %d instead", maxBcryptPasswordBytes)
+		policy.MinLength = maxBcryptPasswordBytes
+	}
+	for envKey, setting := range map[string]*bool{
+		"PASSWORD_REQUIRE_UPPER":  &policy.RequireUpper,
+		"PASSWORD_REQUIRE_LOWER":  &policy.RequireLower,
+		"PASSWORD_REQUIRE_DIGIT":  &policy.RequireDigit,
+		"PASSWORD_REQUIRE_SYMBOL": &policy.RequireSymbol,
+		"PASSWORD_CHECK_COMMON":   &policy.CheckCommon,
+	} {
+		if envValue, exists := os.LookupEnv(envKey); exists {
+			if boolValue, err := strconv.ParseBool(envValue); err == nil {
+				*setting = boolValue
+			}
+		}
+	}
+	return policy
+}
+
+// isCommonPassword checks the password against the bundled list, including common variations which only add digits
+// or symbols to the end (eg. "Password123!")
+func isCommonPassword(password string) bool {
+	lowered := strings.ToLower(password)
+	if commonPasswords[lowered] {
+		return true
+	}
+	base := strings.TrimRightFunc(lowered, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return base != "" && commonPasswords[base]
+}
+
+// Validate returns an error describing every rule the password breaks
+func (p PasswordPolicy) Validate(password string, username string) error {
+	problems := []string{}
+	length := len([]rune(password))
+	if length < p.MinLength {
+		problems = append(problems, fmt.Sprintf("be at least %d characters long", p.MinLength))
+	}
+	if length > maxPasswordLength {
+		problems = append(problems, fmt.Sprintf("be at most %d characters long", maxPasswordLength))
+	} else if usesBcrypt() && len(password) > maxBcryptPasswordBytes {
+		// Characters outside of ASCII take up more than one byte
+		problems = append(problems, fmt.Sprintf("be at most %d bytes long", maxBcryptPasswordBytes))
+	}
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		problems = append(problems, "contain an uppercase letter")
+	}
+	if p.RequireLower && !hasLower {
+		problems = append(problems, "contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		problems = append(problems, "contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		problems = append(problems, "contain a symbol")
+	}
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		problems = append(problems, "not contain the username")
+	}
+	if p.CheckCommon && isCommonPassword(password) {
+		problems = append(problems, "not be a commonly used password")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("password must %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Generate returns a random password (see NewPassword) which follows the policy
+func (p PasswordPolicy) Generate() string {
+	password := NewPassword()
+	for len(password) < p.MinLength {
+		password += NewPassword()
+	}
+	if usesBcrypt() {
+		limit := maxBcryptPasswordBytes
+		if p.RequireSymbol {
+			limit--
+		}
+		if len(password) > limit {
+			password = password[:limit]
+		}
+	}
+	if p.RequireUpper {
+		password = strings.ToUpper(password[:1]) + password[1:]
+	}
+	if p.RequireSymbol {
+		password += string(passwordSymbols[rand.Intn(len(passwordSymbols))])
+	}
+	return password
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		CheckCommon:   true,
+	}
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		username string
+		// wantProblems are the rules the password breaks, nil if it's valid
+		wantProblems []string
+	}{
+		{"follows every rule", strict, "Correct-Horse7", "alice", nil},
+		{"too short", strict, "Sh0rt!", "alice", []string{"be at least 12 characters long"}},
+		{"length counts characters not bytes", PasswordPolicy{MinLength: 5}, "日本語!", "", []string{"be at least 5 characters long"}},
+		{"missing uppercase", strict, "correct-horse7", "alice", []string{"contain an uppercase letter"}},
+		{"missing lowercase", strict, "CORRECT-HORSE7", "alice", []string{"contain a lowercase letter"}},
+		{"missing digit", strict, "Correct-Horse!", "alice", []string{"contain a digit"}},
+		{"missing symbol", strict, "CorrectHorse77", "alice", []string{"contain a symbol"}},
+		{"space counts as a symbol", strict, "Correct Horse7", "alice", nil},
+		{"contains the username", strict, "Alice-Horse-77", "alice", []string{"not contain the username"}},
+		{"username check is skipped without a username", strict, "Alice-Horse-77", "", nil},
+		{"breaks several rules", strict, "short", "alice", []string{"be at least 12 characters long", "contain an uppercase letter", "contain a digit", "contain a symbol"}},
+		{"common password", PasswordPolicy{CheckCommon: true}, "Password", "", []string{"not be a commonly used password"}},
+		{"common password check can be disabled", PasswordPolicy{}, "password", "", nil},
+		{"at the max length", PasswordPolicy{}, strings.Repeat("a", maxPasswordLength), "", nil},
+		{"over the max length", PasswordPolicy{}, strings.Repeat("a", maxPasswordLength+1), "", []string{"be at most 128 characters long"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(tt.password, tt.username)
+			if tt.wantProblems == nil {
+				if err != nil {
+					t.Errorf("got error %v, want none", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("got no error, want %v", tt.wantProblems)
+			}
+			if want := "password must " + strings.Join(tt.wantProblems, ", "); err.Error() != want {
+				t.Errorf("got error %q, want %q", err, want)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyValidateBcryptLength(t *testing.T) {
+	multiByte := strings.Repeat("é", 40) // 80 bytes
+	tests := []struct {
+		name     string
+		algo     string
+		password string
+		wantErr  string
+	}{
+		{"argon2 allows the max length", "", strings.Repeat("a", maxPasswordLength), ""},
+		{"bcrypt at the byte limit", "bcrypt", strings.Repeat("a", maxBcryptPasswordBytes), ""},
+		{"bcrypt over the byte limit", "bcrypt", strings.Repeat("a", maxBcryptPasswordBytes+1), "password must be at most 72 bytes long"},
+		{"bcrypt counts multi-byte characters as bytes", "bcrypt", multiByte, "password must be at most 72 bytes long"},
+		{"argon2 allows multi-byte characters", "", multiByte, ""},
+		{"bcrypt over the max length", "bcrypt", strings.Repeat("a", maxPasswordLength+1), "password must be at most 128 characters long"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PASSWORD_HASH_ALGO", tt.algo)
+			err := PasswordPolicy{}.Validate(tt.password, "")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v, want none", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The limit is where bcrypt stops being able to hash passwords
+	t.Setenv("PASSWORD_HASH_ALGO", "bcrypt")
+	if _, err := HashPassword(strings.Repeat("a", maxBcryptPasswordBytes)); err != nil {
+		t.Errorf("failed to hash a password at the limit: %v", err)
+	}
+	if _, err := HashPassword(strings.Repeat("a", maxBcryptPasswordBytes+1)); err == nil {
+		t.Errorf("hashed a password over the limit, the limit is out of date")
+	}
+}
+
+func TestIsCommonPassword(t *testing.T) {
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"password", true},
+		{"PASSWORD", true},
+		{"Password123", true},
+		{"Password123!", true},
+		{"password!!", true},
+		{"qwerty2024", true},
+		{"123password", false},
+		{"pass word", false},
+		{"passwordz", false},
+		{"monkeybusiness", false},
+		{"000000", true},
+		{"!!!", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			if got := isCommonPassword(tt.password); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyGenerate(t *testing.T) {
+	policies := []struct {
+		name   string
+		algo   string
+		policy PasswordPolicy
+	}{
+		{"default", "", PasswordPolicy{MinLength: 12, CheckCommon: true}},
+		{"strict", "", PasswordPolicy{MinLength: 20, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true, CheckCommon: true}},
+		{"bcrypt at the byte limit", "bcrypt", PasswordPolicy{MinLength: maxBcryptPasswordBytes, RequireSymbol: true}},
+	}
+	for _, tt := range policies {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PASSWORD_HASH_ALGO", tt.algo)
+			for i := 0; i < 20; i++ {
+				password := tt.policy.Generate()
+				if err := tt.policy.Validate(password, ""); err != nil {
+					t.Fatalf("generated password %q breaks the policy: %v", password, err)
+				}
+			}
+		})
+	}
+}
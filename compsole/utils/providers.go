@@ -12,6 +12,22 @@ type RebootType string
 
 type PowerState string
 
+// These consoles are proxied through Compsole instead of handing the provider's url to the browser
+const (
+	SerialConsole       ConsoleType = "SERIAL"
+	GuacamoleSSHConsole ConsoleType = "GUAC_SSH"
+	GuacamoleRDPConsole ConsoleType = "GUAC_RDP"
+)
+
+// IsProxied returns whether the console is bridged through Compsole
+func (consoleType ConsoleType) IsProxied() bool {
+	switch consoleType {
+	case SerialConsole, GuacamoleSSHConsole, GuacamoleRDPConsole:
+		return true
+	}
+	return false
+}
+
 const (
 	SoftReboot RebootType = "SOFT"
 	HardReboot RebootType = "HARD"
@@ -0,0 +1,46 @@
+package viewer
+
+import (
+	"context"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/privacy"
+)
+
+// Viewer is who is making a request, either a user or a service account. The ent privacy policies use it to filter
+// what the request can query and change. While an admin is impersonating a user, User is the impersonated user and
+// Impersonator is the admin.
+type Viewer struct {
+	User           *ent.User
+	ServiceAccount *ent.ServiceAccount
+	Impersonator   *ent.User
+}
+
+// A private key for context that only this package can access
+type contextKey struct{}
+
+// NewContext returns a copy of parent which carries the viewer
+func NewContext(parent context.Context, v *Viewer) context.Context {
+	return context.WithValue(parent, contextKey{}, v)
+}
+
+// FromContext returns the viewer of the request, or nil if there isn't one
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(contextKey{}).(*Viewer)
+	return v
+}
+
+// SystemContext returns a copy of parent which bypasses the privacy policies. Only use it for work Compsole does on
+// its own behalf (eg. startup tasks and signing users in), never to act on a request's behalf.
+func SystemContext(parent context.Context) context.Context {
+	return privacy.DecisionContext(parent, privacy.Allow)
+}
+
+// IsRestricted returns whether the viewer is a service account limited to specific competitions or teams. REQUIRES
+// the ServiceAccountToCompetitions and ServiceAccountToTeams edges to be loaded (ServiceMiddleware loads them).
+func (v *Viewer) IsRestricted() bool {
+	if v == nil || v.ServiceAccount == nil {
+		return false
+	}
+	return len(v.ServiceAccount.Edges.ServiceAccountToCompetitions) > 0 || len(v.ServiceAccount.Edges.ServiceAccountToTeams) > 0
+}
@@ -30,6 +30,7 @@ type Action struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ActionQuery when eager-loading is set.
 	Edges                                      ActionEdges `json:"edges"`
+	action_action_to_impersonator              *uuid.UUID
 	service_account_service_account_to_actions *uuid.UUID
 	user_user_to_actions                       *uuid.UUID
 }
@@ -40,9 +41,11 @@ type ActionEdges struct {
 	ActionToUser *User `json:"ActionToUser,omitempty"`
 	// ActionToServiceAccount holds the value of the ActionToServiceAccount edge.
 	ActionToServiceAccount *ServiceAccount `json:"ActionToServiceAccount,omitempty"`
+	// ActionToImpersonator holds the value of the ActionToImpersonator edge.
+	ActionToImpersonator *User `json:"ActionToImpersonator,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ActionToUserOrErr returns the ActionToUser value or an error if the edge
@@ -73,6 +76,20 @@ func (e ActionEdges) ActionToServiceAccountOrErr() (*ServiceAccount, error) {
 	return nil, &NotLoadedError{edge: "ActionToServiceAccount"}
 }
 
+// ActionToImpersonatorOrErr returns the ActionToImpersonator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionEdges) ActionToImpersonatorOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.ActionToImpersonator == nil {
+			// The edge ActionToImpersonator was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.ActionToImpersonator, nil
+	}
+	return nil, &NotLoadedError{edge: "ActionToImpersonator"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Action) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -84,9 +101,11 @@ func (*Action) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new(sql.NullTime)
 		case action.FieldID:
 			values[i] = new(uuid.UUID)
-		case action.ForeignKeys[0]: // service_account_service_account_to_actions
+		case action.ForeignKeys[0]: // action_action_to_impersonator
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case action.ForeignKeys[1]: // service_account_service_account_to_actions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case action.ForeignKeys[1]: // user_user_to_actions
+		case action.ForeignKeys[2]: // user_user_to_actions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Action", columns[i])
@@ -134,13 +153,20 @@ func (a *Action) assignValues(columns []string, values []interface{}) error {
 				a.PerformedAt = value.Time
 			}
 		case action.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field action_action_to_impersonator", values[i])
+			} else if value.Valid {
+				a.action_action_to_impersonator = new(uuid.UUID)
+				*a.action_action_to_impersonator = *value.S.(*uuid.UUID)
+			}
+		case action.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field service_account_service_account_to_actions", values[i])
 			} else if value.Valid {
 				a.service_account_service_account_to_actions = new(uuid.UUID)
 				*a.service_account_service_account_to_actions = *value.S.(*uuid.UUID)
 			}
-		case action.ForeignKeys[1]:
+		case action.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_user_to_actions", values[i])
 			} else if value.Valid {
@@ -162,6 +188,11 @@ func (a *Action) QueryActionToServiceAccount() *ServiceAccountQuery {
 	return (&ActionClient{config: a.config}).QueryActionToServiceAccount(a)
 }
 
+// QueryActionToImpersonator queries the "ActionToImpersonator" edge of the Action entity.
+func (a *Action) QueryActionToImpersonator() *UserQuery {
+	return (&ActionClient{config: a.config}).QueryActionToImpersonator(a)
+}
+
 // Update returns a builder for updating this Action.
 // Note that you need to call Action.Unwrap() before calling this method if this Action
 // was returned from a transaction, and the transaction was committed or rolled back.
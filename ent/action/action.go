@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +29,8 @@ const (
 	EdgeActionToUser = "ActionToUser"
 	// EdgeActionToServiceAccount holds the string denoting the actiontoserviceaccount edge name in mutations.
 	EdgeActionToServiceAccount = "ActionToServiceAccount"
+	// EdgeActionToImpersonator holds the string denoting the actiontoimpersonator edge name in mutations.
+	EdgeActionToImpersonator = "ActionToImpersonator"
 	// Table holds the table name of the action in the database.
 	Table = "actions"
 	// ActionToUserTable is the table that holds the ActionToUser relation/edge.
@@ -44,6 +47,13 @@ const (
 	ActionToServiceAccountInverseTable = "service_accounts"
 	// ActionToServiceAccountColumn is the table column denoting the ActionToServiceAccount relation/edge.
 	ActionToServiceAccountColumn = "service_account_service_account_to_actions"
+	// ActionToImpersonatorTable is the table that holds the ActionToImpersonator relation/edge.
+	ActionToImpersonatorTable = "actions"
+	// ActionToImpersonatorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ActionToImpersonatorInverseTable = "users"
+	// ActionToImpersonatorColumn is the table column denoting the ActionToImpersonator relation/edge.
+	ActionToImpersonatorColumn = "action_action_to_impersonator"
 )
 
 // Columns holds all SQL columns for action fields.
@@ -58,6 +68,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "actions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"action_action_to_impersonator",
 	"service_account_service_account_to_actions",
 	"user_user_to_actions",
 }
@@ -77,7 +88,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
 	// DefaultIPAddress holds the default value on creation for the "ip_address" field.
 	DefaultIPAddress string
 	// DefaultPerformedAt holds the default value on creation for the "performed_at" field.
@@ -107,6 +125,17 @@ const (
 	TypeUPDATE_OBJECT        Type = "UPDATE_OBJECT"
 	TypeDELETE_OBJECT        Type = "DELETE_OBJECT"
 	TypeUPDATE_LOCKOUT       Type = "UPDATE_LOCKOUT"
+	TypeMFA_ENROLL           Type = "MFA_ENROLL"
+	TypeMFA_DISABLE          Type = "MFA_DISABLE"
+	TypeFAILED_MFA           Type = "FAILED_MFA"
+	TypeACCOUNT_LOCKED       Type = "ACCOUNT_LOCKED"
+	TypeACCOUNT_UNLOCKED     Type = "ACCOUNT_UNLOCKED"
+	TypeREVOKE_SESSION       Type = "REVOKE_SESSION"
+	TypeROTATE_SECRET        Type = "ROTATE_SECRET"
+	TypeCREATE_ACCESS_TOKEN  Type = "CREATE_ACCESS_TOKEN"
+	TypeREVOKE_ACCESS_TOKEN  Type = "REVOKE_ACCESS_TOKEN"
+	TypeIMPERSONATE          Type = "IMPERSONATE"
+	TypeIP_NOT_ALLOWED       Type = "IP_NOT_ALLOWED"
 )
 
 func (_type Type) String() string {
@@ -116,7 +145,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeSIGN_IN, TypeFAILED_SIGN_IN, TypeSIGN_OUT, TypeAPI_CALL, TypeCONSOLE_ACCESS, TypePOWER_STATE, TypeREBOOT, TypeSHUTDOWN, TypePOWER_ON, TypePOWER_OFF, TypeCHANGE_SELF_PASSWORD, TypeCHANGE_PASSWORD, TypeCREATE_OBJECT, TypeUPDATE_OBJECT, TypeDELETE_OBJECT, TypeUPDATE_LOCKOUT:
+	case TypeSIGN_IN, TypeFAILED_SIGN_IN, TypeSIGN_OUT, TypeAPI_CALL, TypeCONSOLE_ACCESS, TypePOWER_STATE, TypeREBOOT, TypeSHUTDOWN, TypePOWER_ON, TypePOWER_OFF, TypeCHANGE_SELF_PASSWORD, TypeCHANGE_PASSWORD, TypeCREATE_OBJECT, TypeUPDATE_OBJECT, TypeDELETE_OBJECT, TypeUPDATE_LOCKOUT, TypeMFA_ENROLL, TypeMFA_DISABLE, TypeFAILED_MFA, TypeACCOUNT_LOCKED, TypeACCOUNT_UNLOCKED, TypeREVOKE_SESSION, TypeROTATE_SECRET, TypeCREATE_ACCESS_TOKEN, TypeREVOKE_ACCESS_TOKEN, TypeIMPERSONATE, TypeIP_NOT_ALLOWED:
 		return nil
 	default:
 		return fmt.Errorf("action: invalid enum value for type field: %q", _type)
@@ -517,6 +517,34 @@ func HasActionToServiceAccountWith(preds ...predicate.ServiceAccount) predicate.
 	})
 }
 
+// HasActionToImpersonator applies the HasEdge predicate on the "ActionToImpersonator" edge.
+func HasActionToImpersonator() predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ActionToImpersonatorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ActionToImpersonatorTable, ActionToImpersonatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionToImpersonatorWith applies the HasEdge predicate on the "ActionToImpersonator" edge with a given conditions (other predicates).
+func HasActionToImpersonatorWith(preds ...predicate.User) predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ActionToImpersonatorInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ActionToImpersonatorTable, ActionToImpersonatorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Action) predicate.Action {
 	return predicate.Action(func(s *sql.Selector) {
@@ -115,6 +115,25 @@ func (ac *ActionCreate) SetActionToServiceAccount(s *ServiceAccount) *ActionCrea
 	return ac.SetActionToServiceAccountID(s.ID)
 }
 
+// SetActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID.
+func (ac *ActionCreate) SetActionToImpersonatorID(id uuid.UUID) *ActionCreate {
+	ac.mutation.SetActionToImpersonatorID(id)
+	return ac
+}
+
+// SetNillableActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID if the given value is not nil.
+func (ac *ActionCreate) SetNillableActionToImpersonatorID(id *uuid.UUID) *ActionCreate {
+	if id != nil {
+		ac = ac.SetActionToImpersonatorID(*id)
+	}
+	return ac
+}
+
+// SetActionToImpersonator sets the "ActionToImpersonator" edge to the User entity.
+func (ac *ActionCreate) SetActionToImpersonator(u *User) *ActionCreate {
+	return ac.SetActionToImpersonatorID(u.ID)
+}
+
 // Mutation returns the ActionMutation object of the builder.
 func (ac *ActionCreate) Mutation() *ActionMutation {
 	return ac.mutation
@@ -126,7 +145,9 @@ func (ac *ActionCreate) Save(ctx context.Context) (*Action, error) {
 		err  error
 		node *Action
 	)
-	ac.defaults()
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
 	if len(ac.hooks) == 0 {
 		if err = ac.check(); err != nil {
 			return nil, err
@@ -185,19 +206,26 @@ func (ac *ActionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ac *ActionCreate) defaults() {
+func (ac *ActionCreate) defaults() error {
 	if _, ok := ac.mutation.IPAddress(); !ok {
 		v := action.DefaultIPAddress
 		ac.mutation.SetIPAddress(v)
 	}
 	if _, ok := ac.mutation.PerformedAt(); !ok {
+		if action.DefaultPerformedAt == nil {
+			return fmt.Errorf("ent: uninitialized action.DefaultPerformedAt (forgotten import ent/runtime?)")
+		}
 		v := action.DefaultPerformedAt()
 		ac.mutation.SetPerformedAt(v)
 	}
 	if _, ok := ac.mutation.ID(); !ok {
+		if action.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized action.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := action.DefaultID()
 		ac.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -327,6 +355,26 @@ func (ac *ActionCreate) createSpec() (*Action, *sqlgraph.CreateSpec) {
 		_node.service_account_service_account_to_actions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.ActionToImpersonatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   action.ActionToImpersonatorTable,
+			Columns: []string{action.ActionToImpersonatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.action_action_to_impersonator = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
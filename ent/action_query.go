@@ -30,6 +30,7 @@ type ActionQuery struct {
 	// eager-loading edges.
 	withActionToUser           *UserQuery
 	withActionToServiceAccount *ServiceAccountQuery
+	withActionToImpersonator   *UserQuery
 	withFKs                    bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -111,6 +112,28 @@ func (aq *ActionQuery) QueryActionToServiceAccount() *ServiceAccountQuery {
 	return query
 }
 
+// QueryActionToImpersonator chains the current query on the "ActionToImpersonator" edge.
+func (aq *ActionQuery) QueryActionToImpersonator() *UserQuery {
+	query := &UserQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(action.Table, action.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, action.ActionToImpersonatorTable, action.ActionToImpersonatorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Action entity from the query.
 // Returns a *NotFoundError when no Action was found.
 func (aq *ActionQuery) First(ctx context.Context) (*Action, error) {
@@ -294,6 +317,7 @@ func (aq *ActionQuery) Clone() *ActionQuery {
 		predicates:                 append([]predicate.Action{}, aq.predicates...),
 		withActionToUser:           aq.withActionToUser.Clone(),
 		withActionToServiceAccount: aq.withActionToServiceAccount.Clone(),
+		withActionToImpersonator:   aq.withActionToImpersonator.Clone(),
 		// clone intermediate query.
 		sql:    aq.sql.Clone(),
 		path:   aq.path,
@@ -323,6 +347,17 @@ func (aq *ActionQuery) WithActionToServiceAccount(opts ...func(*ServiceAccountQu
 	return aq
 }
 
+// WithActionToImpersonator tells the query-builder to eager-load the nodes that are connected to
+// the "ActionToImpersonator" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ActionQuery) WithActionToImpersonator(opts ...func(*UserQuery)) *ActionQuery {
+	query := &UserQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withActionToImpersonator = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -379,6 +414,12 @@ func (aq *ActionQuery) prepareQuery(ctx context.Context) error {
 		}
 		aq.sql = prev
 	}
+	if action.Policy == nil {
+		return errors.New("ent: uninitialized action.Policy (forgotten import ent/runtime?)")
+	}
+	if err := action.Policy.EvalQuery(ctx, aq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -387,12 +428,13 @@ func (aq *ActionQuery) sqlAll(ctx context.Context) ([]*Action, error) {
 		nodes       = []*Action{}
 		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			aq.withActionToUser != nil,
 			aq.withActionToServiceAccount != nil,
+			aq.withActionToImpersonator != nil,
 		}
 	)
-	if aq.withActionToUser != nil || aq.withActionToServiceAccount != nil {
+	if aq.withActionToUser != nil || aq.withActionToServiceAccount != nil || aq.withActionToImpersonator != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -476,6 +518,35 @@ func (aq *ActionQuery) sqlAll(ctx context.Context) ([]*Action, error) {
 		}
 	}
 
+	if query := aq.withActionToImpersonator; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*Action)
+		for i := range nodes {
+			if nodes[i].action_action_to_impersonator == nil {
+				continue
+			}
+			fk := *nodes[i].action_action_to_impersonator
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "action_action_to_impersonator" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ActionToImpersonator = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -109,6 +109,25 @@ func (au *ActionUpdate) SetActionToServiceAccount(s *ServiceAccount) *ActionUpda
 	return au.SetActionToServiceAccountID(s.ID)
 }
 
+// SetActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID.
+func (au *ActionUpdate) SetActionToImpersonatorID(id uuid.UUID) *ActionUpdate {
+	au.mutation.SetActionToImpersonatorID(id)
+	return au
+}
+
+// SetNillableActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID if the given value is not nil.
+func (au *ActionUpdate) SetNillableActionToImpersonatorID(id *uuid.UUID) *ActionUpdate {
+	if id != nil {
+		au = au.SetActionToImpersonatorID(*id)
+	}
+	return au
+}
+
+// SetActionToImpersonator sets the "ActionToImpersonator" edge to the User entity.
+func (au *ActionUpdate) SetActionToImpersonator(u *User) *ActionUpdate {
+	return au.SetActionToImpersonatorID(u.ID)
+}
+
 // Mutation returns the ActionMutation object of the builder.
 func (au *ActionUpdate) Mutation() *ActionMutation {
 	return au.mutation
@@ -126,6 +145,12 @@ func (au *ActionUpdate) ClearActionToServiceAccount() *ActionUpdate {
 	return au
 }
 
+// ClearActionToImpersonator clears the "ActionToImpersonator" edge to the User entity.
+func (au *ActionUpdate) ClearActionToImpersonator() *ActionUpdate {
+	au.mutation.ClearActionToImpersonator()
+	return au
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ActionUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -312,6 +337,41 @@ func (au *ActionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.ActionToImpersonatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   action.ActionToImpersonatorTable,
+			Columns: []string{action.ActionToImpersonatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ActionToImpersonatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   action.ActionToImpersonatorTable,
+			Columns: []string{action.ActionToImpersonatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{action.Label}
@@ -409,6 +469,25 @@ func (auo *ActionUpdateOne) SetActionToServiceAccount(s *ServiceAccount) *Action
 	return auo.SetActionToServiceAccountID(s.ID)
 }
 
+// SetActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID.
+func (auo *ActionUpdateOne) SetActionToImpersonatorID(id uuid.UUID) *ActionUpdateOne {
+	auo.mutation.SetActionToImpersonatorID(id)
+	return auo
+}
+
+// SetNillableActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by ID if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableActionToImpersonatorID(id *uuid.UUID) *ActionUpdateOne {
+	if id != nil {
+		auo = auo.SetActionToImpersonatorID(*id)
+	}
+	return auo
+}
+
+// SetActionToImpersonator sets the "ActionToImpersonator" edge to the User entity.
+func (auo *ActionUpdateOne) SetActionToImpersonator(u *User) *ActionUpdateOne {
+	return auo.SetActionToImpersonatorID(u.ID)
+}
+
 // Mutation returns the ActionMutation object of the builder.
 func (auo *ActionUpdateOne) Mutation() *ActionMutation {
 	return auo.mutation
@@ -426,6 +505,12 @@ func (auo *ActionUpdateOne) ClearActionToServiceAccount() *ActionUpdateOne {
 	return auo
 }
 
+// ClearActionToImpersonator clears the "ActionToImpersonator" edge to the User entity.
+func (auo *ActionUpdateOne) ClearActionToImpersonator() *ActionUpdateOne {
+	auo.mutation.ClearActionToImpersonator()
+	return auo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (auo *ActionUpdateOne) Select(field string, fields ...string) *ActionUpdateOne {
@@ -636,6 +721,41 @@ func (auo *ActionUpdateOne) sqlSave(ctx context.Context) (_node *Action, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.ActionToImpersonatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   action.ActionToImpersonatorTable,
+			Columns: []string{action.ActionToImpersonatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ActionToImpersonatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   action.ActionToImpersonatorTable,
+			Columns: []string{action.ActionToImpersonatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Action{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
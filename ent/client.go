@@ -12,13 +12,22 @@ import (
 
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -34,20 +43,38 @@ type Client struct {
 	Action *ActionClient
 	// Competition is the client for interacting with the Competition builders.
 	Competition *CompetitionClient
+	// CompetitionBreak is the client for interacting with the CompetitionBreak builders.
+	CompetitionBreak *CompetitionBreakClient
+	// ConsoleSession is the client for interacting with the ConsoleSession builders.
+	ConsoleSession *ConsoleSessionClient
+	// ConsoleShare is the client for interacting with the ConsoleShare builders.
+	ConsoleShare *ConsoleShareClient
+	// CustomRole is the client for interacting with the CustomRole builders.
+	CustomRole *CustomRoleClient
+	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
+	PersonalAccessToken *PersonalAccessTokenClient
 	// Provider is the client for interacting with the Provider builders.
 	Provider *ProviderClient
 	// ServiceAccount is the client for interacting with the ServiceAccount builders.
 	ServiceAccount *ServiceAccountClient
 	// ServiceToken is the client for interacting with the ServiceToken builders.
 	ServiceToken *ServiceTokenClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
+	// TeamMembership is the client for interacting with the TeamMembership builders.
+	TeamMembership *TeamMembershipClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VmCredential is the client for interacting with the VmCredential builders.
+	VmCredential *VmCredentialClient
 	// VmObject is the client for interacting with the VmObject builders.
 	VmObject *VmObjectClient
+	// WebauthnCredential is the client for interacting with the WebauthnCredential builders.
+	WebauthnCredential *WebauthnCredentialClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -63,13 +90,22 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Action = NewActionClient(c.config)
 	c.Competition = NewCompetitionClient(c.config)
+	c.CompetitionBreak = NewCompetitionBreakClient(c.config)
+	c.ConsoleSession = NewConsoleSessionClient(c.config)
+	c.ConsoleShare = NewConsoleShareClient(c.config)
+	c.CustomRole = NewCustomRoleClient(c.config)
+	c.PersonalAccessToken = NewPersonalAccessTokenClient(c.config)
 	c.Provider = NewProviderClient(c.config)
 	c.ServiceAccount = NewServiceAccountClient(c.config)
 	c.ServiceToken = NewServiceTokenClient(c.config)
+	c.SigningKey = NewSigningKeyClient(c.config)
 	c.Team = NewTeamClient(c.config)
+	c.TeamMembership = NewTeamMembershipClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.VmCredential = NewVmCredentialClient(c.config)
 	c.VmObject = NewVmObjectClient(c.config)
+	c.WebauthnCredential = NewWebauthnCredentialClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -101,17 +137,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Action:         NewActionClient(cfg),
-		Competition:    NewCompetitionClient(cfg),
-		Provider:       NewProviderClient(cfg),
-		ServiceAccount: NewServiceAccountClient(cfg),
-		ServiceToken:   NewServiceTokenClient(cfg),
-		Team:           NewTeamClient(cfg),
-		Token:          NewTokenClient(cfg),
-		User:           NewUserClient(cfg),
-		VmObject:       NewVmObjectClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Action:              NewActionClient(cfg),
+		Competition:         NewCompetitionClient(cfg),
+		CompetitionBreak:    NewCompetitionBreakClient(cfg),
+		ConsoleSession:      NewConsoleSessionClient(cfg),
+		ConsoleShare:        NewConsoleShareClient(cfg),
+		CustomRole:          NewCustomRoleClient(cfg),
+		PersonalAccessToken: NewPersonalAccessTokenClient(cfg),
+		Provider:            NewProviderClient(cfg),
+		ServiceAccount:      NewServiceAccountClient(cfg),
+		ServiceToken:        NewServiceTokenClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		Team:                NewTeamClient(cfg),
+		TeamMembership:      NewTeamMembershipClient(cfg),
+		Token:               NewTokenClient(cfg),
+		User:                NewUserClient(cfg),
+		VmCredential:        NewVmCredentialClient(cfg),
+		VmObject:            NewVmObjectClient(cfg),
+		WebauthnCredential:  NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -129,17 +174,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Action:         NewActionClient(cfg),
-		Competition:    NewCompetitionClient(cfg),
-		Provider:       NewProviderClient(cfg),
-		ServiceAccount: NewServiceAccountClient(cfg),
-		ServiceToken:   NewServiceTokenClient(cfg),
-		Team:           NewTeamClient(cfg),
-		Token:          NewTokenClient(cfg),
-		User:           NewUserClient(cfg),
-		VmObject:       NewVmObjectClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Action:              NewActionClient(cfg),
+		Competition:         NewCompetitionClient(cfg),
+		CompetitionBreak:    NewCompetitionBreakClient(cfg),
+		ConsoleSession:      NewConsoleSessionClient(cfg),
+		ConsoleShare:        NewConsoleShareClient(cfg),
+		CustomRole:          NewCustomRoleClient(cfg),
+		PersonalAccessToken: NewPersonalAccessTokenClient(cfg),
+		Provider:            NewProviderClient(cfg),
+		ServiceAccount:      NewServiceAccountClient(cfg),
+		ServiceToken:        NewServiceTokenClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		Team:                NewTeamClient(cfg),
+		TeamMembership:      NewTeamMembershipClient(cfg),
+		Token:               NewTokenClient(cfg),
+		User:                NewUserClient(cfg),
+		VmCredential:        NewVmCredentialClient(cfg),
+		VmObject:            NewVmObjectClient(cfg),
+		WebauthnCredential:  NewWebauthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -170,13 +224,22 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.Action.Use(hooks...)
 	c.Competition.Use(hooks...)
+	c.CompetitionBreak.Use(hooks...)
+	c.ConsoleSession.Use(hooks...)
+	c.ConsoleShare.Use(hooks...)
+	c.CustomRole.Use(hooks...)
+	c.PersonalAccessToken.Use(hooks...)
 	c.Provider.Use(hooks...)
 	c.ServiceAccount.Use(hooks...)
 	c.ServiceToken.Use(hooks...)
+	c.SigningKey.Use(hooks...)
 	c.Team.Use(hooks...)
+	c.TeamMembership.Use(hooks...)
 	c.Token.Use(hooks...)
 	c.User.Use(hooks...)
+	c.VmCredential.Use(hooks...)
 	c.VmObject.Use(hooks...)
+	c.WebauthnCredential.Use(hooks...)
 }
 
 // ActionClient is a client for the Action schema.
@@ -296,9 +359,26 @@ func (c *ActionClient) QueryActionToServiceAccount(a *Action) *ServiceAccountQue
 	return query
 }
 
+// QueryActionToImpersonator queries the ActionToImpersonator edge of a Action.
+func (c *ActionClient) QueryActionToImpersonator(a *Action) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(action.Table, action.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, action.ActionToImpersonatorTable, action.ActionToImpersonatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ActionClient) Hooks() []Hook {
-	return c.hooks.Action
+	hooks := c.hooks.Action
+	return append(hooks[:len(hooks):len(hooks)], action.Hooks[:]...)
 }
 
 // CompetitionClient is a client for the Competition schema.
@@ -418,9 +498,604 @@ func (c *CompetitionClient) QueryCompetitionToProvider(co *Competition) *Provide
 	return query
 }
 
+// QueryCompetitionToAdmins queries the CompetitionToAdmins edge of a Competition.
+func (c *CompetitionClient) QueryCompetitionToAdmins(co *Competition) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competition.Table, competition.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, competition.CompetitionToAdminsTable, competition.CompetitionToAdminsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCompetitionToBreaks queries the CompetitionToBreaks edge of a Competition.
+func (c *CompetitionClient) QueryCompetitionToBreaks(co *Competition) *CompetitionBreakQuery {
+	query := &CompetitionBreakQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competition.Table, competition.FieldID, id),
+			sqlgraph.To(competitionbreak.Table, competitionbreak.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, competition.CompetitionToBreaksTable, competition.CompetitionToBreaksColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CompetitionClient) Hooks() []Hook {
-	return c.hooks.Competition
+	hooks := c.hooks.Competition
+	return append(hooks[:len(hooks):len(hooks)], competition.Hooks[:]...)
+}
+
+// CompetitionBreakClient is a client for the CompetitionBreak schema.
+type CompetitionBreakClient struct {
+	config
+}
+
+// NewCompetitionBreakClient returns a client for the CompetitionBreak from the given config.
+func NewCompetitionBreakClient(c config) *CompetitionBreakClient {
+	return &CompetitionBreakClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `competitionbreak.Hooks(f(g(h())))`.
+func (c *CompetitionBreakClient) Use(hooks ...Hook) {
+	c.hooks.CompetitionBreak = append(c.hooks.CompetitionBreak, hooks...)
+}
+
+// Create returns a create builder for CompetitionBreak.
+func (c *CompetitionBreakClient) Create() *CompetitionBreakCreate {
+	mutation := newCompetitionBreakMutation(c.config, OpCreate)
+	return &CompetitionBreakCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CompetitionBreak entities.
+func (c *CompetitionBreakClient) CreateBulk(builders ...*CompetitionBreakCreate) *CompetitionBreakCreateBulk {
+	return &CompetitionBreakCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CompetitionBreak.
+func (c *CompetitionBreakClient) Update() *CompetitionBreakUpdate {
+	mutation := newCompetitionBreakMutation(c.config, OpUpdate)
+	return &CompetitionBreakUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CompetitionBreakClient) UpdateOne(cb *CompetitionBreak) *CompetitionBreakUpdateOne {
+	mutation := newCompetitionBreakMutation(c.config, OpUpdateOne, withCompetitionBreak(cb))
+	return &CompetitionBreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CompetitionBreakClient) UpdateOneID(id uuid.UUID) *CompetitionBreakUpdateOne {
+	mutation := newCompetitionBreakMutation(c.config, OpUpdateOne, withCompetitionBreakID(id))
+	return &CompetitionBreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CompetitionBreak.
+func (c *CompetitionBreakClient) Delete() *CompetitionBreakDelete {
+	mutation := newCompetitionBreakMutation(c.config, OpDelete)
+	return &CompetitionBreakDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CompetitionBreakClient) DeleteOne(cb *CompetitionBreak) *CompetitionBreakDeleteOne {
+	return c.DeleteOneID(cb.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CompetitionBreakClient) DeleteOneID(id uuid.UUID) *CompetitionBreakDeleteOne {
+	builder := c.Delete().Where(competitionbreak.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CompetitionBreakDeleteOne{builder}
+}
+
+// Query returns a query builder for CompetitionBreak.
+func (c *CompetitionBreakClient) Query() *CompetitionBreakQuery {
+	return &CompetitionBreakQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CompetitionBreak entity by its id.
+func (c *CompetitionBreakClient) Get(ctx context.Context, id uuid.UUID) (*CompetitionBreak, error) {
+	return c.Query().Where(competitionbreak.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CompetitionBreakClient) GetX(ctx context.Context, id uuid.UUID) *CompetitionBreak {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCompetitionBreakToCompetition queries the CompetitionBreakToCompetition edge of a CompetitionBreak.
+func (c *CompetitionBreakClient) QueryCompetitionBreakToCompetition(cb *CompetitionBreak) *CompetitionQuery {
+	query := &CompetitionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competitionbreak.Table, competitionbreak.FieldID, id),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, competitionbreak.CompetitionBreakToCompetitionTable, competitionbreak.CompetitionBreakToCompetitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(cb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CompetitionBreakClient) Hooks() []Hook {
+	return c.hooks.CompetitionBreak
+}
+
+// ConsoleSessionClient is a client for the ConsoleSession schema.
+type ConsoleSessionClient struct {
+	config
+}
+
+// NewConsoleSessionClient returns a client for the ConsoleSession from the given config.
+func NewConsoleSessionClient(c config) *ConsoleSessionClient {
+	return &ConsoleSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consolesession.Hooks(f(g(h())))`.
+func (c *ConsoleSessionClient) Use(hooks ...Hook) {
+	c.hooks.ConsoleSession = append(c.hooks.ConsoleSession, hooks...)
+}
+
+// Create returns a create builder for ConsoleSession.
+func (c *ConsoleSessionClient) Create() *ConsoleSessionCreate {
+	mutation := newConsoleSessionMutation(c.config, OpCreate)
+	return &ConsoleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConsoleSession entities.
+func (c *ConsoleSessionClient) CreateBulk(builders ...*ConsoleSessionCreate) *ConsoleSessionCreateBulk {
+	return &ConsoleSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConsoleSession.
+func (c *ConsoleSessionClient) Update() *ConsoleSessionUpdate {
+	mutation := newConsoleSessionMutation(c.config, OpUpdate)
+	return &ConsoleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsoleSessionClient) UpdateOne(cs *ConsoleSession) *ConsoleSessionUpdateOne {
+	mutation := newConsoleSessionMutation(c.config, OpUpdateOne, withConsoleSession(cs))
+	return &ConsoleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsoleSessionClient) UpdateOneID(id uuid.UUID) *ConsoleSessionUpdateOne {
+	mutation := newConsoleSessionMutation(c.config, OpUpdateOne, withConsoleSessionID(id))
+	return &ConsoleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConsoleSession.
+func (c *ConsoleSessionClient) Delete() *ConsoleSessionDelete {
+	mutation := newConsoleSessionMutation(c.config, OpDelete)
+	return &ConsoleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ConsoleSessionClient) DeleteOne(cs *ConsoleSession) *ConsoleSessionDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ConsoleSessionClient) DeleteOneID(id uuid.UUID) *ConsoleSessionDeleteOne {
+	builder := c.Delete().Where(consolesession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsoleSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ConsoleSession.
+func (c *ConsoleSessionClient) Query() *ConsoleSessionQuery {
+	return &ConsoleSessionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ConsoleSession entity by its id.
+func (c *ConsoleSessionClient) Get(ctx context.Context, id uuid.UUID) (*ConsoleSession, error) {
+	return c.Query().Where(consolesession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsoleSessionClient) GetX(ctx context.Context, id uuid.UUID) *ConsoleSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConsoleSessionToUser queries the ConsoleSessionToUser edge of a ConsoleSession.
+func (c *ConsoleSessionClient) QueryConsoleSessionToUser(cs *ConsoleSession) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consolesession.Table, consolesession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consolesession.ConsoleSessionToUserTable, consolesession.ConsoleSessionToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConsoleSessionToVmObject queries the ConsoleSessionToVmObject edge of a ConsoleSession.
+func (c *ConsoleSessionClient) QueryConsoleSessionToVmObject(cs *ConsoleSession) *VmObjectQuery {
+	query := &VmObjectQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consolesession.Table, consolesession.FieldID, id),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consolesession.ConsoleSessionToVmObjectTable, consolesession.ConsoleSessionToVmObjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConsoleSessionClient) Hooks() []Hook {
+	return c.hooks.ConsoleSession
+}
+
+// ConsoleShareClient is a client for the ConsoleShare schema.
+type ConsoleShareClient struct {
+	config
+}
+
+// NewConsoleShareClient returns a client for the ConsoleShare from the given config.
+func NewConsoleShareClient(c config) *ConsoleShareClient {
+	return &ConsoleShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consoleshare.Hooks(f(g(h())))`.
+func (c *ConsoleShareClient) Use(hooks ...Hook) {
+	c.hooks.ConsoleShare = append(c.hooks.ConsoleShare, hooks...)
+}
+
+// Create returns a create builder for ConsoleShare.
+func (c *ConsoleShareClient) Create() *ConsoleShareCreate {
+	mutation := newConsoleShareMutation(c.config, OpCreate)
+	return &ConsoleShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConsoleShare entities.
+func (c *ConsoleShareClient) CreateBulk(builders ...*ConsoleShareCreate) *ConsoleShareCreateBulk {
+	return &ConsoleShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConsoleShare.
+func (c *ConsoleShareClient) Update() *ConsoleShareUpdate {
+	mutation := newConsoleShareMutation(c.config, OpUpdate)
+	return &ConsoleShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsoleShareClient) UpdateOne(cs *ConsoleShare) *ConsoleShareUpdateOne {
+	mutation := newConsoleShareMutation(c.config, OpUpdateOne, withConsoleShare(cs))
+	return &ConsoleShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsoleShareClient) UpdateOneID(id uuid.UUID) *ConsoleShareUpdateOne {
+	mutation := newConsoleShareMutation(c.config, OpUpdateOne, withConsoleShareID(id))
+	return &ConsoleShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConsoleShare.
+func (c *ConsoleShareClient) Delete() *ConsoleShareDelete {
+	mutation := newConsoleShareMutation(c.config, OpDelete)
+	return &ConsoleShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ConsoleShareClient) DeleteOne(cs *ConsoleShare) *ConsoleShareDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ConsoleShareClient) DeleteOneID(id uuid.UUID) *ConsoleShareDeleteOne {
+	builder := c.Delete().Where(consoleshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsoleShareDeleteOne{builder}
+}
+
+// Query returns a query builder for ConsoleShare.
+func (c *ConsoleShareClient) Query() *ConsoleShareQuery {
+	return &ConsoleShareQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ConsoleShare entity by its id.
+func (c *ConsoleShareClient) Get(ctx context.Context, id uuid.UUID) (*ConsoleShare, error) {
+	return c.Query().Where(consoleshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsoleShareClient) GetX(ctx context.Context, id uuid.UUID) *ConsoleShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConsoleShareToVmObject queries the ConsoleShareToVmObject edge of a ConsoleShare.
+func (c *ConsoleShareClient) QueryConsoleShareToVmObject(cs *ConsoleShare) *VmObjectQuery {
+	query := &VmObjectQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consoleshare.Table, consoleshare.FieldID, id),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consoleshare.ConsoleShareToVmObjectTable, consoleshare.ConsoleShareToVmObjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConsoleShareToUser queries the ConsoleShareToUser edge of a ConsoleShare.
+func (c *ConsoleShareClient) QueryConsoleShareToUser(cs *ConsoleShare) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consoleshare.Table, consoleshare.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consoleshare.ConsoleShareToUserTable, consoleshare.ConsoleShareToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConsoleShareClient) Hooks() []Hook {
+	return c.hooks.ConsoleShare
+}
+
+// CustomRoleClient is a client for the CustomRole schema.
+type CustomRoleClient struct {
+	config
+}
+
+// NewCustomRoleClient returns a client for the CustomRole from the given config.
+func NewCustomRoleClient(c config) *CustomRoleClient {
+	return &CustomRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customrole.Hooks(f(g(h())))`.
+func (c *CustomRoleClient) Use(hooks ...Hook) {
+	c.hooks.CustomRole = append(c.hooks.CustomRole, hooks...)
+}
+
+// Create returns a create builder for CustomRole.
+func (c *CustomRoleClient) Create() *CustomRoleCreate {
+	mutation := newCustomRoleMutation(c.config, OpCreate)
+	return &CustomRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CustomRole entities.
+func (c *CustomRoleClient) CreateBulk(builders ...*CustomRoleCreate) *CustomRoleCreateBulk {
+	return &CustomRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CustomRole.
+func (c *CustomRoleClient) Update() *CustomRoleUpdate {
+	mutation := newCustomRoleMutation(c.config, OpUpdate)
+	return &CustomRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomRoleClient) UpdateOne(cr *CustomRole) *CustomRoleUpdateOne {
+	mutation := newCustomRoleMutation(c.config, OpUpdateOne, withCustomRole(cr))
+	return &CustomRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomRoleClient) UpdateOneID(id uuid.UUID) *CustomRoleUpdateOne {
+	mutation := newCustomRoleMutation(c.config, OpUpdateOne, withCustomRoleID(id))
+	return &CustomRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CustomRole.
+func (c *CustomRoleClient) Delete() *CustomRoleDelete {
+	mutation := newCustomRoleMutation(c.config, OpDelete)
+	return &CustomRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CustomRoleClient) DeleteOne(cr *CustomRole) *CustomRoleDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CustomRoleClient) DeleteOneID(id uuid.UUID) *CustomRoleDeleteOne {
+	builder := c.Delete().Where(customrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for CustomRole.
+func (c *CustomRoleClient) Query() *CustomRoleQuery {
+	return &CustomRoleQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CustomRole entity by its id.
+func (c *CustomRoleClient) Get(ctx context.Context, id uuid.UUID) (*CustomRole, error) {
+	return c.Query().Where(customrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomRoleClient) GetX(ctx context.Context, id uuid.UUID) *CustomRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCustomRoleToUsers queries the CustomRoleToUsers edge of a CustomRole.
+func (c *CustomRoleClient) QueryCustomRoleToUsers(cr *CustomRole) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customrole.Table, customrole.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customrole.CustomRoleToUsersTable, customrole.CustomRoleToUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CustomRoleClient) Hooks() []Hook {
+	return c.hooks.CustomRole
+}
+
+// PersonalAccessTokenClient is a client for the PersonalAccessToken schema.
+type PersonalAccessTokenClient struct {
+	config
+}
+
+// NewPersonalAccessTokenClient returns a client for the PersonalAccessToken from the given config.
+func NewPersonalAccessTokenClient(c config) *PersonalAccessTokenClient {
+	return &PersonalAccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `personalaccesstoken.Hooks(f(g(h())))`.
+func (c *PersonalAccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.PersonalAccessToken = append(c.hooks.PersonalAccessToken, hooks...)
+}
+
+// Create returns a create builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Create() *PersonalAccessTokenCreate {
+	mutation := newPersonalAccessTokenMutation(c.config, OpCreate)
+	return &PersonalAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PersonalAccessToken entities.
+func (c *PersonalAccessTokenClient) CreateBulk(builders ...*PersonalAccessTokenCreate) *PersonalAccessTokenCreateBulk {
+	return &PersonalAccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Update() *PersonalAccessTokenUpdate {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdate)
+	return &PersonalAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PersonalAccessTokenClient) UpdateOne(pat *PersonalAccessToken) *PersonalAccessTokenUpdateOne {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdateOne, withPersonalAccessToken(pat))
+	return &PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PersonalAccessTokenClient) UpdateOneID(id uuid.UUID) *PersonalAccessTokenUpdateOne {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdateOne, withPersonalAccessTokenID(id))
+	return &PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Delete() *PersonalAccessTokenDelete {
+	mutation := newPersonalAccessTokenMutation(c.config, OpDelete)
+	return &PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PersonalAccessTokenClient) DeleteOne(pat *PersonalAccessToken) *PersonalAccessTokenDeleteOne {
+	return c.DeleteOneID(pat.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PersonalAccessTokenClient) DeleteOneID(id uuid.UUID) *PersonalAccessTokenDeleteOne {
+	builder := c.Delete().Where(personalaccesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PersonalAccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Query() *PersonalAccessTokenQuery {
+	return &PersonalAccessTokenQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PersonalAccessToken entity by its id.
+func (c *PersonalAccessTokenClient) Get(ctx context.Context, id uuid.UUID) (*PersonalAccessToken, error) {
+	return c.Query().Where(personalaccesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PersonalAccessTokenClient) GetX(ctx context.Context, id uuid.UUID) *PersonalAccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPersonalAccessTokenToUser queries the PersonalAccessTokenToUser edge of a PersonalAccessToken.
+func (c *PersonalAccessTokenClient) QueryPersonalAccessTokenToUser(pat *PersonalAccessToken) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pat.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(personalaccesstoken.Table, personalaccesstoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, personalaccesstoken.PersonalAccessTokenToUserTable, personalaccesstoken.PersonalAccessTokenToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pat.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PersonalAccessTokenClient) Hooks() []Hook {
+	return c.hooks.PersonalAccessToken
 }
 
 // ProviderClient is a client for the Provider schema.
@@ -526,7 +1201,8 @@ func (c *ProviderClient) QueryProviderToCompetitions(pr *Provider) *CompetitionQ
 
 // Hooks returns the client hooks.
 func (c *ProviderClient) Hooks() []Hook {
-	return c.hooks.Provider
+	hooks := c.hooks.Provider
+	return append(hooks[:len(hooks):len(hooks)], provider.Hooks[:]...)
 }
 
 // ServiceAccountClient is a client for the ServiceAccount schema.
@@ -646,6 +1322,38 @@ func (c *ServiceAccountClient) QueryServiceAccountToActions(sa *ServiceAccount)
 	return query
 }
 
+// QueryServiceAccountToCompetitions queries the ServiceAccountToCompetitions edge of a ServiceAccount.
+func (c *ServiceAccountClient) QueryServiceAccountToCompetitions(sa *ServiceAccount) *CompetitionQuery {
+	query := &CompetitionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, id),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serviceaccount.ServiceAccountToCompetitionsTable, serviceaccount.ServiceAccountToCompetitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryServiceAccountToTeams queries the ServiceAccountToTeams edge of a ServiceAccount.
+func (c *ServiceAccountClient) QueryServiceAccountToTeams(sa *ServiceAccount) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serviceaccount.ServiceAccountToTeamsTable, serviceaccount.ServiceAccountToTeamsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ServiceAccountClient) Hooks() []Hook {
 	return c.hooks.ServiceAccount
@@ -757,6 +1465,96 @@ func (c *ServiceTokenClient) Hooks() []Hook {
 	return c.hooks.ServiceToken
 }
 
+// SigningKeyClient is a client for the SigningKey schema.
+type SigningKeyClient struct {
+	config
+}
+
+// NewSigningKeyClient returns a client for the SigningKey from the given config.
+func NewSigningKeyClient(c config) *SigningKeyClient {
+	return &SigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingkey.Hooks(f(g(h())))`.
+func (c *SigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.SigningKey = append(c.hooks.SigningKey, hooks...)
+}
+
+// Create returns a create builder for SigningKey.
+func (c *SigningKeyClient) Create() *SigningKeyCreate {
+	mutation := newSigningKeyMutation(c.config, OpCreate)
+	return &SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningKey entities.
+func (c *SigningKeyClient) CreateBulk(builders ...*SigningKeyCreate) *SigningKeyCreateBulk {
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SigningKey.
+func (c *SigningKeyClient) Update() *SigningKeyUpdate {
+	mutation := newSigningKeyMutation(c.config, OpUpdate)
+	return &SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SigningKeyClient) UpdateOne(sk *SigningKey) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKey(sk))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SigningKeyClient) UpdateOneID(id uuid.UUID) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKeyID(id))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SigningKey.
+func (c *SigningKeyClient) Delete() *SigningKeyDelete {
+	mutation := newSigningKeyMutation(c.config, OpDelete)
+	return &SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SigningKeyClient) DeleteOne(sk *SigningKey) *SigningKeyDeleteOne {
+	return c.DeleteOneID(sk.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SigningKeyClient) DeleteOneID(id uuid.UUID) *SigningKeyDeleteOne {
+	builder := c.Delete().Where(signingkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SigningKey.
+func (c *SigningKeyClient) Query() *SigningKeyQuery {
+	return &SigningKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SigningKey entity by its id.
+func (c *SigningKeyClient) Get(ctx context.Context, id uuid.UUID) (*SigningKey, error) {
+	return c.Query().Where(signingkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SigningKeyClient) GetX(ctx context.Context, id uuid.UUID) *SigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SigningKeyClient) Hooks() []Hook {
+	return c.hooks.SigningKey
+}
+
 // TeamClient is a client for the Team schema.
 type TeamClient struct {
 	config
@@ -862,37 +1660,177 @@ func (c *TeamClient) QueryTeamToCompetition(t *Team) *CompetitionQuery {
 func (c *TeamClient) QueryTeamToVmObjects(t *Team) *VmObjectQuery {
 	query := &VmObjectQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToVmObjectsTable, team.TeamToVmObjectsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamToUsers queries the TeamToUsers edge of a Team.
+func (c *TeamClient) QueryTeamToUsers(t *Team) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToUsersTable, team.TeamToUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamToMemberships queries the TeamToMemberships edge of a Team.
+func (c *TeamClient) QueryTeamToMemberships(t *Team) *TeamMembershipQuery {
+	query := &TeamMembershipQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(teammembership.Table, teammembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToMembershipsTable, team.TeamToMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	hooks := c.hooks.Team
+	return append(hooks[:len(hooks):len(hooks)], team.Hooks[:]...)
+}
+
+// TeamMembershipClient is a client for the TeamMembership schema.
+type TeamMembershipClient struct {
+	config
+}
+
+// NewTeamMembershipClient returns a client for the TeamMembership from the given config.
+func NewTeamMembershipClient(c config) *TeamMembershipClient {
+	return &TeamMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teammembership.Hooks(f(g(h())))`.
+func (c *TeamMembershipClient) Use(hooks ...Hook) {
+	c.hooks.TeamMembership = append(c.hooks.TeamMembership, hooks...)
+}
+
+// Create returns a create builder for TeamMembership.
+func (c *TeamMembershipClient) Create() *TeamMembershipCreate {
+	mutation := newTeamMembershipMutation(c.config, OpCreate)
+	return &TeamMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamMembership entities.
+func (c *TeamMembershipClient) CreateBulk(builders ...*TeamMembershipCreate) *TeamMembershipCreateBulk {
+	return &TeamMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamMembership.
+func (c *TeamMembershipClient) Update() *TeamMembershipUpdate {
+	mutation := newTeamMembershipMutation(c.config, OpUpdate)
+	return &TeamMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamMembershipClient) UpdateOne(tm *TeamMembership) *TeamMembershipUpdateOne {
+	mutation := newTeamMembershipMutation(c.config, OpUpdateOne, withTeamMembership(tm))
+	return &TeamMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamMembershipClient) UpdateOneID(id uuid.UUID) *TeamMembershipUpdateOne {
+	mutation := newTeamMembershipMutation(c.config, OpUpdateOne, withTeamMembershipID(id))
+	return &TeamMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamMembership.
+func (c *TeamMembershipClient) Delete() *TeamMembershipDelete {
+	mutation := newTeamMembershipMutation(c.config, OpDelete)
+	return &TeamMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TeamMembershipClient) DeleteOne(tm *TeamMembership) *TeamMembershipDeleteOne {
+	return c.DeleteOneID(tm.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TeamMembershipClient) DeleteOneID(id uuid.UUID) *TeamMembershipDeleteOne {
+	builder := c.Delete().Where(teammembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamMembership.
+func (c *TeamMembershipClient) Query() *TeamMembershipQuery {
+	return &TeamMembershipQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TeamMembership entity by its id.
+func (c *TeamMembershipClient) Get(ctx context.Context, id uuid.UUID) (*TeamMembership, error) {
+	return c.Query().Where(teammembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamMembershipClient) GetX(ctx context.Context, id uuid.UUID) *TeamMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeamMembershipToUser queries the TeamMembershipToUser edge of a TeamMembership.
+func (c *TeamMembershipClient) QueryTeamMembershipToUser(tm *TeamMembership) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(vmobject.Table, vmobject.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToVmObjectsTable, team.TeamToVmObjectsColumn),
+			sqlgraph.From(teammembership.Table, teammembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammembership.TeamMembershipToUserTable, teammembership.TeamMembershipToUserColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTeamToUsers queries the TeamToUsers edge of a Team.
-func (c *TeamClient) QueryTeamToUsers(t *Team) *UserQuery {
-	query := &UserQuery{config: c.config}
+// QueryTeamMembershipToTeam queries the TeamMembershipToTeam edge of a TeamMembership.
+func (c *TeamMembershipClient) QueryTeamMembershipToTeam(tm *TeamMembership) *TeamQuery {
+	query := &TeamQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := tm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(team.Table, team.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToUsersTable, team.TeamToUsersColumn),
+			sqlgraph.From(teammembership.Table, teammembership.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammembership.TeamMembershipToTeamTable, teammembership.TeamMembershipToTeamColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TeamClient) Hooks() []Hook {
-	return c.hooks.Team
+func (c *TeamMembershipClient) Hooks() []Hook {
+	hooks := c.hooks.TeamMembership
+	return append(hooks[:len(hooks):len(hooks)], teammembership.Hooks[:]...)
 }
 
 // TokenClient is a client for the Token schema.
@@ -996,6 +1934,22 @@ func (c *TokenClient) QueryTokenToUser(t *Token) *UserQuery {
 	return query
 }
 
+// QueryTokenToImpersonatedUser queries the TokenToImpersonatedUser edge of a Token.
+func (c *TokenClient) QueryTokenToImpersonatedUser(t *Token) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, token.TokenToImpersonatedUserTable, token.TokenToImpersonatedUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TokenClient) Hooks() []Hook {
 	return c.hooks.Token
@@ -1102,6 +2056,54 @@ func (c *UserClient) QueryUserToTeam(u *User) *TeamQuery {
 	return query
 }
 
+// QueryUserToTeamMemberships queries the UserToTeamMemberships edge of a User.
+func (c *UserClient) QueryUserToTeamMemberships(u *User) *TeamMembershipQuery {
+	query := &TeamMembershipQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(teammembership.Table, teammembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToTeamMembershipsTable, user.UserToTeamMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserToCustomRole queries the UserToCustomRole edge of a User.
+func (c *UserClient) QueryUserToCustomRole(u *User) *CustomRoleQuery {
+	query := &CustomRoleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(customrole.Table, customrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.UserToCustomRoleTable, user.UserToCustomRoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserToAdminCompetitions queries the UserToAdminCompetitions edge of a User.
+func (c *UserClient) QueryUserToAdminCompetitions(u *User) *CompetitionQuery {
+	query := &CompetitionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.UserToAdminCompetitionsTable, user.UserToAdminCompetitionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryUserToToken queries the UserToToken edge of a User.
 func (c *UserClient) QueryUserToToken(u *User) *TokenQuery {
 	query := &TokenQuery{config: c.config}
@@ -1134,9 +2136,180 @@ func (c *UserClient) QueryUserToActions(u *User) *ActionQuery {
 	return query
 }
 
+// QueryUserToConsoleSessions queries the UserToConsoleSessions edge of a User.
+func (c *UserClient) QueryUserToConsoleSessions(u *User) *ConsoleSessionQuery {
+	query := &ConsoleSessionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(consolesession.Table, consolesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToConsoleSessionsTable, user.UserToConsoleSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserToConsoleShares queries the UserToConsoleShares edge of a User.
+func (c *UserClient) QueryUserToConsoleShares(u *User) *ConsoleShareQuery {
+	query := &ConsoleShareQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(consoleshare.Table, consoleshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToConsoleSharesTable, user.UserToConsoleSharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserToWebauthnCredentials queries the UserToWebauthnCredentials edge of a User.
+func (c *UserClient) QueryUserToWebauthnCredentials(u *User) *WebauthnCredentialQuery {
+	query := &WebauthnCredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(webauthncredential.Table, webauthncredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToWebauthnCredentialsTable, user.UserToWebauthnCredentialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserToPersonalAccessTokens queries the UserToPersonalAccessTokens edge of a User.
+func (c *UserClient) QueryUserToPersonalAccessTokens(u *User) *PersonalAccessTokenQuery {
+	query := &PersonalAccessTokenQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(personalaccesstoken.Table, personalaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToPersonalAccessTokensTable, user.UserToPersonalAccessTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
+}
+
+// VmCredentialClient is a client for the VmCredential schema.
+type VmCredentialClient struct {
+	config
+}
+
+// NewVmCredentialClient returns a client for the VmCredential from the given config.
+func NewVmCredentialClient(c config) *VmCredentialClient {
+	return &VmCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vmcredential.Hooks(f(g(h())))`.
+func (c *VmCredentialClient) Use(hooks ...Hook) {
+	c.hooks.VmCredential = append(c.hooks.VmCredential, hooks...)
+}
+
+// Create returns a create builder for VmCredential.
+func (c *VmCredentialClient) Create() *VmCredentialCreate {
+	mutation := newVmCredentialMutation(c.config, OpCreate)
+	return &VmCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VmCredential entities.
+func (c *VmCredentialClient) CreateBulk(builders ...*VmCredentialCreate) *VmCredentialCreateBulk {
+	return &VmCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VmCredential.
+func (c *VmCredentialClient) Update() *VmCredentialUpdate {
+	mutation := newVmCredentialMutation(c.config, OpUpdate)
+	return &VmCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VmCredentialClient) UpdateOne(vc *VmCredential) *VmCredentialUpdateOne {
+	mutation := newVmCredentialMutation(c.config, OpUpdateOne, withVmCredential(vc))
+	return &VmCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VmCredentialClient) UpdateOneID(id uuid.UUID) *VmCredentialUpdateOne {
+	mutation := newVmCredentialMutation(c.config, OpUpdateOne, withVmCredentialID(id))
+	return &VmCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VmCredential.
+func (c *VmCredentialClient) Delete() *VmCredentialDelete {
+	mutation := newVmCredentialMutation(c.config, OpDelete)
+	return &VmCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *VmCredentialClient) DeleteOne(vc *VmCredential) *VmCredentialDeleteOne {
+	return c.DeleteOneID(vc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *VmCredentialClient) DeleteOneID(id uuid.UUID) *VmCredentialDeleteOne {
+	builder := c.Delete().Where(vmcredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VmCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for VmCredential.
+func (c *VmCredentialClient) Query() *VmCredentialQuery {
+	return &VmCredentialQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a VmCredential entity by its id.
+func (c *VmCredentialClient) Get(ctx context.Context, id uuid.UUID) (*VmCredential, error) {
+	return c.Query().Where(vmcredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VmCredentialClient) GetX(ctx context.Context, id uuid.UUID) *VmCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVmCredentialToVmObject queries the VmCredentialToVmObject edge of a VmCredential.
+func (c *VmCredentialClient) QueryVmCredentialToVmObject(vc *VmCredential) *VmObjectQuery {
+	query := &VmObjectQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmcredential.Table, vmcredential.FieldID, id),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vmcredential.VmCredentialToVmObjectTable, vmcredential.VmCredentialToVmObjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(vc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VmCredentialClient) Hooks() []Hook {
+	return c.hooks.VmCredential
 }
 
 // VmObjectClient is a client for the VmObject schema.
@@ -1240,7 +2413,162 @@ func (c *VmObjectClient) QueryVmObjectToTeam(vo *VmObject) *TeamQuery {
 	return query
 }
 
+// QueryVmObjectToConsoleSessions queries the VmObjectToConsoleSessions edge of a VmObject.
+func (c *VmObjectClient) QueryVmObjectToConsoleSessions(vo *VmObject) *ConsoleSessionQuery {
+	query := &ConsoleSessionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, id),
+			sqlgraph.To(consolesession.Table, consolesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToConsoleSessionsTable, vmobject.VmObjectToConsoleSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(vo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVmObjectToVmCredentials queries the VmObjectToVmCredentials edge of a VmObject.
+func (c *VmObjectClient) QueryVmObjectToVmCredentials(vo *VmObject) *VmCredentialQuery {
+	query := &VmCredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, id),
+			sqlgraph.To(vmcredential.Table, vmcredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToVmCredentialsTable, vmobject.VmObjectToVmCredentialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(vo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVmObjectToConsoleShares queries the VmObjectToConsoleShares edge of a VmObject.
+func (c *VmObjectClient) QueryVmObjectToConsoleShares(vo *VmObject) *ConsoleShareQuery {
+	query := &ConsoleShareQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := vo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, id),
+			sqlgraph.To(consoleshare.Table, consoleshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToConsoleSharesTable, vmobject.VmObjectToConsoleSharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(vo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VmObjectClient) Hooks() []Hook {
-	return c.hooks.VmObject
+	hooks := c.hooks.VmObject
+	return append(hooks[:len(hooks):len(hooks)], vmobject.Hooks[:]...)
+}
+
+// WebauthnCredentialClient is a client for the WebauthnCredential schema.
+type WebauthnCredentialClient struct {
+	config
+}
+
+// NewWebauthnCredentialClient returns a client for the WebauthnCredential from the given config.
+func NewWebauthnCredentialClient(c config) *WebauthnCredentialClient {
+	return &WebauthnCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebauthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebauthnCredential = append(c.hooks.WebauthnCredential, hooks...)
+}
+
+// Create returns a create builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Create() *WebauthnCredentialCreate {
+	mutation := newWebauthnCredentialMutation(c.config, OpCreate)
+	return &WebauthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebauthnCredential entities.
+func (c *WebauthnCredentialClient) CreateBulk(builders ...*WebauthnCredentialCreate) *WebauthnCredentialCreateBulk {
+	return &WebauthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Update() *WebauthnCredentialUpdate {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdate)
+	return &WebauthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebauthnCredentialClient) UpdateOne(wc *WebauthnCredential) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredential(wc))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebauthnCredentialClient) UpdateOneID(id uuid.UUID) *WebauthnCredentialUpdateOne {
+	mutation := newWebauthnCredentialMutation(c.config, OpUpdateOne, withWebauthnCredentialID(id))
+	return &WebauthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Delete() *WebauthnCredentialDelete {
+	mutation := newWebauthnCredentialMutation(c.config, OpDelete)
+	return &WebauthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebauthnCredentialClient) DeleteOne(wc *WebauthnCredential) *WebauthnCredentialDeleteOne {
+	return c.DeleteOneID(wc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebauthnCredentialClient) DeleteOneID(id uuid.UUID) *WebauthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebauthnCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for WebauthnCredential.
+func (c *WebauthnCredentialClient) Query() *WebauthnCredentialQuery {
+	return &WebauthnCredentialQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebauthnCredential entity by its id.
+func (c *WebauthnCredentialClient) Get(ctx context.Context, id uuid.UUID) (*WebauthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebauthnCredentialClient) GetX(ctx context.Context, id uuid.UUID) *WebauthnCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebauthnCredentialToUser queries the WebauthnCredentialToUser edge of a WebauthnCredential.
+func (c *WebauthnCredentialClient) QueryWebauthnCredentialToUser(wc *WebauthnCredential) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webauthncredential.Table, webauthncredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webauthncredential.WebauthnCredentialToUserTable, webauthncredential.WebauthnCredentialToUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(wc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebauthnCredentialClient) Hooks() []Hook {
+	return c.hooks.WebauthnCredential
 }
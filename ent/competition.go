@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/BradHacker/compsole/ent/competition"
@@ -20,10 +22,32 @@ type Competition struct {
 	// Name holds the value of the "name" field.
 	// [REQUIRED] The unique name (aka. slug) for the competition.
 	Name string `json:"name,omitempty"`
+	// ConsoleLimitPerVM holds the value of the "console_limit_per_vm" field.
+	// [OPTIONAL] (default is 0) The max number of simultaneous console sessions on a single VM. 0 is unlimited.
+	ConsoleLimitPerVM int `json:"console_limit_per_vm,omitempty"`
+	// ConsoleLimitPerUser holds the value of the "console_limit_per_user" field.
+	// [OPTIONAL] (default is 0) The max number of simultaneous console sessions a single user can hold. 0 is unlimited.
+	ConsoleLimitPerUser int `json:"console_limit_per_user,omitempty"`
+	// ConsoleLimitPerTeam holds the value of the "console_limit_per_team" field.
+	// [OPTIONAL] (default is 0) The max number of simultaneous console sessions a team's users can hold. 0 is unlimited.
+	ConsoleLimitPerTeam int `json:"console_limit_per_team,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	// [OPTIONAL] When competitors can start accessing their VMs. The VMs are locked before this time.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	// [OPTIONAL] When competitor access ends. The VMs are locked after this time.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// ScheduleLocked holds the value of the "schedule_locked" field.
+	// [OPTIONAL] The lockout the scheduler last applied to the VMs. VMs are only locked and unlocked when the schedule moves between open and closed, so manual lockouts stick until then.
+	ScheduleLocked *bool `json:"schedule_locked,omitempty"`
+	// AllowedCidrs holds the value of the "allowed_cidrs" field.
+	// [OPTIONAL] The networks (in CIDR notation) the competition's users can connect from. Empty allows any network.
+	AllowedCidrs []string `json:"allowed_cidrs,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CompetitionQuery when eager-loading is set.
-	Edges                               CompetitionEdges `json:"edges"`
-	competition_competition_to_provider *uuid.UUID
+	Edges                                           CompetitionEdges `json:"edges"`
+	competition_competition_to_provider             *uuid.UUID
+	service_account_service_account_to_competitions *uuid.UUID
 }
 
 // CompetitionEdges holds the relations/edges for other nodes in the graph.
@@ -32,9 +56,13 @@ type CompetitionEdges struct {
 	CompetitionToTeams []*Team `json:"CompetitionToTeams,omitempty"`
 	// CompetitionToProvider holds the value of the CompetitionToProvider edge.
 	CompetitionToProvider *Provider `json:"CompetitionToProvider,omitempty"`
+	// CompetitionToAdmins holds the value of the CompetitionToAdmins edge.
+	CompetitionToAdmins []*User `json:"CompetitionToAdmins,omitempty"`
+	// CompetitionToBreaks holds the value of the CompetitionToBreaks edge.
+	CompetitionToBreaks []*CompetitionBreak `json:"CompetitionToBreaks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // CompetitionToTeamsOrErr returns the CompetitionToTeams value or an error if the edge
@@ -60,17 +88,45 @@ func (e CompetitionEdges) CompetitionToProviderOrErr() (*Provider, error) {
 	return nil, &NotLoadedError{edge: "CompetitionToProvider"}
 }
 
+// CompetitionToAdminsOrErr returns the CompetitionToAdmins value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompetitionEdges) CompetitionToAdminsOrErr() ([]*User, error) {
+	if e.loadedTypes[2] {
+		return e.CompetitionToAdmins, nil
+	}
+	return nil, &NotLoadedError{edge: "CompetitionToAdmins"}
+}
+
+// CompetitionToBreaksOrErr returns the CompetitionToBreaks value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompetitionEdges) CompetitionToBreaksOrErr() ([]*CompetitionBreak, error) {
+	if e.loadedTypes[3] {
+		return e.CompetitionToBreaks, nil
+	}
+	return nil, &NotLoadedError{edge: "CompetitionToBreaks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Competition) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case competition.FieldAllowedCidrs:
+			values[i] = new([]byte)
+		case competition.FieldScheduleLocked:
+			values[i] = new(sql.NullBool)
+		case competition.FieldConsoleLimitPerVM, competition.FieldConsoleLimitPerUser, competition.FieldConsoleLimitPerTeam:
+			values[i] = new(sql.NullInt64)
 		case competition.FieldName:
 			values[i] = new(sql.NullString)
+		case competition.FieldStartsAt, competition.FieldEndsAt:
+			values[i] = new(sql.NullTime)
 		case competition.FieldID:
 			values[i] = new(uuid.UUID)
 		case competition.ForeignKeys[0]: // competition_competition_to_provider
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case competition.ForeignKeys[1]: // service_account_service_account_to_competitions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Competition", columns[i])
 		}
@@ -98,6 +154,53 @@ func (c *Competition) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				c.Name = value.String
 			}
+		case competition.FieldConsoleLimitPerVM:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_vm", values[i])
+			} else if value.Valid {
+				c.ConsoleLimitPerVM = int(value.Int64)
+			}
+		case competition.FieldConsoleLimitPerUser:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_user", values[i])
+			} else if value.Valid {
+				c.ConsoleLimitPerUser = int(value.Int64)
+			}
+		case competition.FieldConsoleLimitPerTeam:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_team", values[i])
+			} else if value.Valid {
+				c.ConsoleLimitPerTeam = int(value.Int64)
+			}
+		case competition.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				c.StartsAt = new(time.Time)
+				*c.StartsAt = value.Time
+			}
+		case competition.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				c.EndsAt = new(time.Time)
+				*c.EndsAt = value.Time
+			}
+		case competition.FieldScheduleLocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_locked", values[i])
+			} else if value.Valid {
+				c.ScheduleLocked = new(bool)
+				*c.ScheduleLocked = value.Bool
+			}
+		case competition.FieldAllowedCidrs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_cidrs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.AllowedCidrs); err != nil {
+					return fmt.Errorf("unmarshal field allowed_cidrs: %w", err)
+				}
+			}
 		case competition.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field competition_competition_to_provider", values[i])
@@ -105,6 +208,13 @@ func (c *Competition) assignValues(columns []string, values []interface{}) error
 				c.competition_competition_to_provider = new(uuid.UUID)
 				*c.competition_competition_to_provider = *value.S.(*uuid.UUID)
 			}
+		case competition.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account_service_account_to_competitions", values[i])
+			} else if value.Valid {
+				c.service_account_service_account_to_competitions = new(uuid.UUID)
+				*c.service_account_service_account_to_competitions = *value.S.(*uuid.UUID)
+			}
 		}
 	}
 	return nil
@@ -120,6 +230,16 @@ func (c *Competition) QueryCompetitionToProvider() *ProviderQuery {
 	return (&CompetitionClient{config: c.config}).QueryCompetitionToProvider(c)
 }
 
+// QueryCompetitionToAdmins queries the "CompetitionToAdmins" edge of the Competition entity.
+func (c *Competition) QueryCompetitionToAdmins() *UserQuery {
+	return (&CompetitionClient{config: c.config}).QueryCompetitionToAdmins(c)
+}
+
+// QueryCompetitionToBreaks queries the "CompetitionToBreaks" edge of the Competition entity.
+func (c *Competition) QueryCompetitionToBreaks() *CompetitionBreakQuery {
+	return (&CompetitionClient{config: c.config}).QueryCompetitionToBreaks(c)
+}
+
 // Update returns a builder for updating this Competition.
 // Note that you need to call Competition.Unwrap() before calling this method if this Competition
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -145,6 +265,26 @@ func (c *Competition) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", c.ID))
 	builder.WriteString(", name=")
 	builder.WriteString(c.Name)
+	builder.WriteString(", console_limit_per_vm=")
+	builder.WriteString(fmt.Sprintf("%v", c.ConsoleLimitPerVM))
+	builder.WriteString(", console_limit_per_user=")
+	builder.WriteString(fmt.Sprintf("%v", c.ConsoleLimitPerUser))
+	builder.WriteString(", console_limit_per_team=")
+	builder.WriteString(fmt.Sprintf("%v", c.ConsoleLimitPerTeam))
+	if v := c.StartsAt; v != nil {
+		builder.WriteString(", starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := c.EndsAt; v != nil {
+		builder.WriteString(", ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := c.ScheduleLocked; v != nil {
+		builder.WriteString(", schedule_locked=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", allowed_cidrs=")
+	builder.WriteString(fmt.Sprintf("%v", c.AllowedCidrs))
 	builder.WriteByte(')')
 	return builder.String()
 }
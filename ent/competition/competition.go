@@ -3,6 +3,7 @@
 package competition
 
 import (
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -13,10 +14,28 @@ const (
 	FieldID = "oid"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldConsoleLimitPerVM holds the string denoting the console_limit_per_vm field in the database.
+	FieldConsoleLimitPerVM = "console_limit_per_vm"
+	// FieldConsoleLimitPerUser holds the string denoting the console_limit_per_user field in the database.
+	FieldConsoleLimitPerUser = "console_limit_per_user"
+	// FieldConsoleLimitPerTeam holds the string denoting the console_limit_per_team field in the database.
+	FieldConsoleLimitPerTeam = "console_limit_per_team"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldScheduleLocked holds the string denoting the schedule_locked field in the database.
+	FieldScheduleLocked = "schedule_locked"
+	// FieldAllowedCidrs holds the string denoting the allowed_cidrs field in the database.
+	FieldAllowedCidrs = "allowed_cidrs"
 	// EdgeCompetitionToTeams holds the string denoting the competitiontoteams edge name in mutations.
 	EdgeCompetitionToTeams = "CompetitionToTeams"
 	// EdgeCompetitionToProvider holds the string denoting the competitiontoprovider edge name in mutations.
 	EdgeCompetitionToProvider = "CompetitionToProvider"
+	// EdgeCompetitionToAdmins holds the string denoting the competitiontoadmins edge name in mutations.
+	EdgeCompetitionToAdmins = "CompetitionToAdmins"
+	// EdgeCompetitionToBreaks holds the string denoting the competitiontobreaks edge name in mutations.
+	EdgeCompetitionToBreaks = "CompetitionToBreaks"
 	// Table holds the table name of the competition in the database.
 	Table = "competitions"
 	// CompetitionToTeamsTable is the table that holds the CompetitionToTeams relation/edge.
@@ -33,20 +52,46 @@ const (
 	CompetitionToProviderInverseTable = "providers"
 	// CompetitionToProviderColumn is the table column denoting the CompetitionToProvider relation/edge.
 	CompetitionToProviderColumn = "competition_competition_to_provider"
+	// CompetitionToAdminsTable is the table that holds the CompetitionToAdmins relation/edge. The primary key declared below.
+	CompetitionToAdminsTable = "competition_CompetitionToAdmins"
+	// CompetitionToAdminsInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CompetitionToAdminsInverseTable = "users"
+	// CompetitionToBreaksTable is the table that holds the CompetitionToBreaks relation/edge.
+	CompetitionToBreaksTable = "competition_breaks"
+	// CompetitionToBreaksInverseTable is the table name for the CompetitionBreak entity.
+	// It exists in this package in order to avoid circular dependency with the "competitionbreak" package.
+	CompetitionToBreaksInverseTable = "competition_breaks"
+	// CompetitionToBreaksColumn is the table column denoting the CompetitionToBreaks relation/edge.
+	CompetitionToBreaksColumn = "competition_competition_to_breaks"
 )
 
 // Columns holds all SQL columns for competition fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldConsoleLimitPerVM,
+	FieldConsoleLimitPerUser,
+	FieldConsoleLimitPerTeam,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldScheduleLocked,
+	FieldAllowedCidrs,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "competitions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"competition_competition_to_provider",
+	"service_account_service_account_to_competitions",
 }
 
+var (
+	// CompetitionToAdminsPrimaryKey and CompetitionToAdminsColumn2 are the table columns denoting the
+	// primary key for the CompetitionToAdmins relation (M2M).
+	CompetitionToAdminsPrimaryKey = []string{"competition_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -62,7 +107,26 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultConsoleLimitPerVM holds the default value on creation for the "console_limit_per_vm" field.
+	DefaultConsoleLimitPerVM int
+	// ConsoleLimitPerVMValidator is a validator for the "console_limit_per_vm" field. It is called by the builders before save.
+	ConsoleLimitPerVMValidator func(int) error
+	// DefaultConsoleLimitPerUser holds the default value on creation for the "console_limit_per_user" field.
+	DefaultConsoleLimitPerUser int
+	// ConsoleLimitPerUserValidator is a validator for the "console_limit_per_user" field. It is called by the builders before save.
+	ConsoleLimitPerUserValidator func(int) error
+	// DefaultConsoleLimitPerTeam holds the default value on creation for the "console_limit_per_team" field.
+	DefaultConsoleLimitPerTeam int
+	// ConsoleLimitPerTeamValidator is a validator for the "console_limit_per_team" field. It is called by the builders before save.
+	ConsoleLimitPerTeamValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
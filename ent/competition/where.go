@@ -3,6 +3,8 @@
 package competition
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/BradHacker/compsole/ent/predicate"
@@ -99,6 +101,48 @@ func Name(v string) predicate.Competition {
 	})
 }
 
+// ConsoleLimitPerVM applies equality check predicate on the "console_limit_per_vm" field. It's identical to ConsoleLimitPerVMEQ.
+func ConsoleLimitPerVM(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerUser applies equality check predicate on the "console_limit_per_user" field. It's identical to ConsoleLimitPerUserEQ.
+func ConsoleLimitPerUser(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerTeam applies equality check predicate on the "console_limit_per_team" field. It's identical to ConsoleLimitPerTeamEQ.
+func ConsoleLimitPerTeam(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// ScheduleLocked applies equality check predicate on the "schedule_locked" field. It's identical to ScheduleLockedEQ.
+func ScheduleLocked(v bool) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScheduleLocked), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Competition {
 	return predicate.Competition(func(s *sql.Selector) {
@@ -210,6 +254,456 @@ func NameContainsFold(v string) predicate.Competition {
 	})
 }
 
+// ConsoleLimitPerVMEQ applies the EQ predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMNEQ applies the NEQ predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMNEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMIn applies the In predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerVM), v...))
+	})
+}
+
+// ConsoleLimitPerVMNotIn applies the NotIn predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMNotIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerVM), v...))
+	})
+}
+
+// ConsoleLimitPerVMGT applies the GT predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMGT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMGTE applies the GTE predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMGTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMLT applies the LT predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMLT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMLTE applies the LTE predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMLTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerUserEQ applies the EQ predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserNEQ applies the NEQ predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserNEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserIn applies the In predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerUser), v...))
+	})
+}
+
+// ConsoleLimitPerUserNotIn applies the NotIn predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserNotIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerUser), v...))
+	})
+}
+
+// ConsoleLimitPerUserGT applies the GT predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserGT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserGTE applies the GTE predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserGTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserLT applies the LT predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserLT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserLTE applies the LTE predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserLTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerTeamEQ applies the EQ predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamNEQ applies the NEQ predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamNEQ(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamIn applies the In predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerTeam), v...))
+	})
+}
+
+// ConsoleLimitPerTeamNotIn applies the NotIn predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamNotIn(vs ...int) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerTeam), v...))
+	})
+}
+
+// ConsoleLimitPerTeamGT applies the GT predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamGT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamGTE applies the GTE predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamGTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamLT applies the LT predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamLT(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamLTE applies the LTE predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamLTE(v int) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartsAt)))
+	})
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartsAt)))
+	})
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Competition {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Competition(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndsAt)))
+	})
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndsAt)))
+	})
+}
+
+// ScheduleLockedEQ applies the EQ predicate on the "schedule_locked" field.
+func ScheduleLockedEQ(v bool) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScheduleLocked), v))
+	})
+}
+
+// ScheduleLockedNEQ applies the NEQ predicate on the "schedule_locked" field.
+func ScheduleLockedNEQ(v bool) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScheduleLocked), v))
+	})
+}
+
+// ScheduleLockedIsNil applies the IsNil predicate on the "schedule_locked" field.
+func ScheduleLockedIsNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScheduleLocked)))
+	})
+}
+
+// ScheduleLockedNotNil applies the NotNil predicate on the "schedule_locked" field.
+func ScheduleLockedNotNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScheduleLocked)))
+	})
+}
+
+// AllowedCidrsIsNil applies the IsNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsIsNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAllowedCidrs)))
+	})
+}
+
+// AllowedCidrsNotNil applies the NotNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsNotNil() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAllowedCidrs)))
+	})
+}
+
 // HasCompetitionToTeams applies the HasEdge predicate on the "CompetitionToTeams" edge.
 func HasCompetitionToTeams() predicate.Competition {
 	return predicate.Competition(func(s *sql.Selector) {
@@ -266,6 +760,62 @@ func HasCompetitionToProviderWith(preds ...predicate.Provider) predicate.Competi
 	})
 }
 
+// HasCompetitionToAdmins applies the HasEdge predicate on the "CompetitionToAdmins" edge.
+func HasCompetitionToAdmins() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionToAdminsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, CompetitionToAdminsTable, CompetitionToAdminsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCompetitionToAdminsWith applies the HasEdge predicate on the "CompetitionToAdmins" edge with a given conditions (other predicates).
+func HasCompetitionToAdminsWith(preds ...predicate.User) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionToAdminsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, CompetitionToAdminsTable, CompetitionToAdminsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCompetitionToBreaks applies the HasEdge predicate on the "CompetitionToBreaks" edge.
+func HasCompetitionToBreaks() predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionToBreaksTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CompetitionToBreaksTable, CompetitionToBreaksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCompetitionToBreaksWith applies the HasEdge predicate on the "CompetitionToBreaks" edge with a given conditions (other predicates).
+func HasCompetitionToBreaksWith(preds ...predicate.CompetitionBreak) predicate.Competition {
+	return predicate.Competition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionToBreaksInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CompetitionToBreaksTable, CompetitionToBreaksColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Competition) predicate.Competition {
 	return predicate.Competition(func(s *sql.Selector) {
@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/user"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +31,96 @@ func (cc *CompetitionCreate) SetName(s string) *CompetitionCreate {
 	return cc
 }
 
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (cc *CompetitionCreate) SetConsoleLimitPerVM(i int) *CompetitionCreate {
+	cc.mutation.SetConsoleLimitPerVM(i)
+	return cc
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableConsoleLimitPerVM(i *int) *CompetitionCreate {
+	if i != nil {
+		cc.SetConsoleLimitPerVM(*i)
+	}
+	return cc
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (cc *CompetitionCreate) SetConsoleLimitPerUser(i int) *CompetitionCreate {
+	cc.mutation.SetConsoleLimitPerUser(i)
+	return cc
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableConsoleLimitPerUser(i *int) *CompetitionCreate {
+	if i != nil {
+		cc.SetConsoleLimitPerUser(*i)
+	}
+	return cc
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (cc *CompetitionCreate) SetConsoleLimitPerTeam(i int) *CompetitionCreate {
+	cc.mutation.SetConsoleLimitPerTeam(i)
+	return cc
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableConsoleLimitPerTeam(i *int) *CompetitionCreate {
+	if i != nil {
+		cc.SetConsoleLimitPerTeam(*i)
+	}
+	return cc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cc *CompetitionCreate) SetStartsAt(t time.Time) *CompetitionCreate {
+	cc.mutation.SetStartsAt(t)
+	return cc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableStartsAt(t *time.Time) *CompetitionCreate {
+	if t != nil {
+		cc.SetStartsAt(*t)
+	}
+	return cc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cc *CompetitionCreate) SetEndsAt(t time.Time) *CompetitionCreate {
+	cc.mutation.SetEndsAt(t)
+	return cc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableEndsAt(t *time.Time) *CompetitionCreate {
+	if t != nil {
+		cc.SetEndsAt(*t)
+	}
+	return cc
+}
+
+// SetScheduleLocked sets the "schedule_locked" field.
+func (cc *CompetitionCreate) SetScheduleLocked(b bool) *CompetitionCreate {
+	cc.mutation.SetScheduleLocked(b)
+	return cc
+}
+
+// SetNillableScheduleLocked sets the "schedule_locked" field if the given value is not nil.
+func (cc *CompetitionCreate) SetNillableScheduleLocked(b *bool) *CompetitionCreate {
+	if b != nil {
+		cc.SetScheduleLocked(*b)
+	}
+	return cc
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (cc *CompetitionCreate) SetAllowedCidrs(s []string) *CompetitionCreate {
+	cc.mutation.SetAllowedCidrs(s)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CompetitionCreate) SetID(u uuid.UUID) *CompetitionCreate {
 	cc.mutation.SetID(u)
@@ -68,6 +161,36 @@ func (cc *CompetitionCreate) SetCompetitionToProvider(p *Provider) *CompetitionC
 	return cc.SetCompetitionToProviderID(p.ID)
 }
 
+// AddCompetitionToAdminIDs adds the "CompetitionToAdmins" edge to the User entity by IDs.
+func (cc *CompetitionCreate) AddCompetitionToAdminIDs(ids ...uuid.UUID) *CompetitionCreate {
+	cc.mutation.AddCompetitionToAdminIDs(ids...)
+	return cc
+}
+
+// AddCompetitionToAdmins adds the "CompetitionToAdmins" edges to the User entity.
+func (cc *CompetitionCreate) AddCompetitionToAdmins(u ...*User) *CompetitionCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cc.AddCompetitionToAdminIDs(ids...)
+}
+
+// AddCompetitionToBreakIDs adds the "CompetitionToBreaks" edge to the CompetitionBreak entity by IDs.
+func (cc *CompetitionCreate) AddCompetitionToBreakIDs(ids ...uuid.UUID) *CompetitionCreate {
+	cc.mutation.AddCompetitionToBreakIDs(ids...)
+	return cc
+}
+
+// AddCompetitionToBreaks adds the "CompetitionToBreaks" edges to the CompetitionBreak entity.
+func (cc *CompetitionCreate) AddCompetitionToBreaks(c ...*CompetitionBreak) *CompetitionCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddCompetitionToBreakIDs(ids...)
+}
+
 // Mutation returns the CompetitionMutation object of the builder.
 func (cc *CompetitionCreate) Mutation() *CompetitionMutation {
 	return cc.mutation
@@ -79,7 +202,9 @@ func (cc *CompetitionCreate) Save(ctx context.Context) (*Competition, error) {
 		err  error
 		node *Competition
 	)
-	cc.defaults()
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(cc.hooks) == 0 {
 		if err = cc.check(); err != nil {
 			return nil, err
@@ -138,11 +263,27 @@ func (cc *CompetitionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cc *CompetitionCreate) defaults() {
+func (cc *CompetitionCreate) defaults() error {
+	if _, ok := cc.mutation.ConsoleLimitPerVM(); !ok {
+		v := competition.DefaultConsoleLimitPerVM
+		cc.mutation.SetConsoleLimitPerVM(v)
+	}
+	if _, ok := cc.mutation.ConsoleLimitPerUser(); !ok {
+		v := competition.DefaultConsoleLimitPerUser
+		cc.mutation.SetConsoleLimitPerUser(v)
+	}
+	if _, ok := cc.mutation.ConsoleLimitPerTeam(); !ok {
+		v := competition.DefaultConsoleLimitPerTeam
+		cc.mutation.SetConsoleLimitPerTeam(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
+		if competition.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized competition.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := competition.DefaultID()
 		cc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -150,6 +291,30 @@ func (cc *CompetitionCreate) check() error {
 	if _, ok := cc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Competition.name"`)}
 	}
+	if _, ok := cc.mutation.ConsoleLimitPerVM(); !ok {
+		return &ValidationError{Name: "console_limit_per_vm", err: errors.New(`ent: missing required field "Competition.console_limit_per_vm"`)}
+	}
+	if v, ok := cc.mutation.ConsoleLimitPerVM(); ok {
+		if err := competition.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ConsoleLimitPerUser(); !ok {
+		return &ValidationError{Name: "console_limit_per_user", err: errors.New(`ent: missing required field "Competition.console_limit_per_user"`)}
+	}
+	if v, ok := cc.mutation.ConsoleLimitPerUser(); ok {
+		if err := competition.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_user": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ConsoleLimitPerTeam(); !ok {
+		return &ValidationError{Name: "console_limit_per_team", err: errors.New(`ent: missing required field "Competition.console_limit_per_team"`)}
+	}
+	if v, ok := cc.mutation.ConsoleLimitPerTeam(); ok {
+		if err := competition.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_team": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.CompetitionToProviderID(); !ok {
 		return &ValidationError{Name: "CompetitionToProvider", err: errors.New(`ent: missing required edge "Competition.CompetitionToProvider"`)}
 	}
@@ -197,6 +362,62 @@ func (cc *CompetitionCreate) createSpec() (*Competition, *sqlgraph.CreateSpec) {
 		})
 		_node.Name = value
 	}
+	if value, ok := cc.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerVM,
+		})
+		_node.ConsoleLimitPerVM = value
+	}
+	if value, ok := cc.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerUser,
+		})
+		_node.ConsoleLimitPerUser = value
+	}
+	if value, ok := cc.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerTeam,
+		})
+		_node.ConsoleLimitPerTeam = value
+	}
+	if value, ok := cc.mutation.StartsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldStartsAt,
+		})
+		_node.StartsAt = &value
+	}
+	if value, ok := cc.mutation.EndsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldEndsAt,
+		})
+		_node.EndsAt = &value
+	}
+	if value, ok := cc.mutation.ScheduleLocked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: competition.FieldScheduleLocked,
+		})
+		_node.ScheduleLocked = &value
+	}
+	if value, ok := cc.mutation.AllowedCidrs(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: competition.FieldAllowedCidrs,
+		})
+		_node.AllowedCidrs = value
+	}
 	if nodes := cc.mutation.CompetitionToTeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -236,6 +457,44 @@ func (cc *CompetitionCreate) createSpec() (*Competition, *sqlgraph.CreateSpec) {
 		_node.competition_competition_to_provider = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.CompetitionToAdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.CompetitionToBreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,9 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/user"
 	"github.com/google/uuid"
 )
 
@@ -31,6 +33,8 @@ type CompetitionQuery struct {
 	// eager-loading edges.
 	withCompetitionToTeams    *TeamQuery
 	withCompetitionToProvider *ProviderQuery
+	withCompetitionToAdmins   *UserQuery
+	withCompetitionToBreaks   *CompetitionBreakQuery
 	withFKs                   bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -112,6 +116,50 @@ func (cq *CompetitionQuery) QueryCompetitionToProvider() *ProviderQuery {
 	return query
 }
 
+// QueryCompetitionToAdmins chains the current query on the "CompetitionToAdmins" edge.
+func (cq *CompetitionQuery) QueryCompetitionToAdmins() *UserQuery {
+	query := &UserQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competition.Table, competition.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, competition.CompetitionToAdminsTable, competition.CompetitionToAdminsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCompetitionToBreaks chains the current query on the "CompetitionToBreaks" edge.
+func (cq *CompetitionQuery) QueryCompetitionToBreaks() *CompetitionBreakQuery {
+	query := &CompetitionBreakQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competition.Table, competition.FieldID, selector),
+			sqlgraph.To(competitionbreak.Table, competitionbreak.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, competition.CompetitionToBreaksTable, competition.CompetitionToBreaksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Competition entity from the query.
 // Returns a *NotFoundError when no Competition was found.
 func (cq *CompetitionQuery) First(ctx context.Context) (*Competition, error) {
@@ -295,6 +343,8 @@ func (cq *CompetitionQuery) Clone() *CompetitionQuery {
 		predicates:                append([]predicate.Competition{}, cq.predicates...),
 		withCompetitionToTeams:    cq.withCompetitionToTeams.Clone(),
 		withCompetitionToProvider: cq.withCompetitionToProvider.Clone(),
+		withCompetitionToAdmins:   cq.withCompetitionToAdmins.Clone(),
+		withCompetitionToBreaks:   cq.withCompetitionToBreaks.Clone(),
 		// clone intermediate query.
 		sql:    cq.sql.Clone(),
 		path:   cq.path,
@@ -324,6 +374,28 @@ func (cq *CompetitionQuery) WithCompetitionToProvider(opts ...func(*ProviderQuer
 	return cq
 }
 
+// WithCompetitionToAdmins tells the query-builder to eager-load the nodes that are connected to
+// the "CompetitionToAdmins" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompetitionQuery) WithCompetitionToAdmins(opts ...func(*UserQuery)) *CompetitionQuery {
+	query := &UserQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withCompetitionToAdmins = query
+	return cq
+}
+
+// WithCompetitionToBreaks tells the query-builder to eager-load the nodes that are connected to
+// the "CompetitionToBreaks" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompetitionQuery) WithCompetitionToBreaks(opts ...func(*CompetitionBreakQuery)) *CompetitionQuery {
+	query := &CompetitionBreakQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withCompetitionToBreaks = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -380,6 +452,12 @@ func (cq *CompetitionQuery) prepareQuery(ctx context.Context) error {
 		}
 		cq.sql = prev
 	}
+	if competition.Policy == nil {
+		return errors.New("ent: uninitialized competition.Policy (forgotten import ent/runtime?)")
+	}
+	if err := competition.Policy.EvalQuery(ctx, cq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -388,9 +466,11 @@ func (cq *CompetitionQuery) sqlAll(ctx context.Context) ([]*Competition, error)
 		nodes       = []*Competition{}
 		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			cq.withCompetitionToTeams != nil,
 			cq.withCompetitionToProvider != nil,
+			cq.withCompetitionToAdmins != nil,
+			cq.withCompetitionToBreaks != nil,
 		}
 	)
 	if cq.withCompetitionToProvider != nil {
@@ -477,6 +557,100 @@ func (cq *CompetitionQuery) sqlAll(ctx context.Context) ([]*Competition, error)
 		}
 	}
 
+	if query := cq.withCompetitionToAdmins; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[uuid.UUID]*Competition, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.CompetitionToAdmins = []*User{}
+		}
+		var (
+			edgeids []uuid.UUID
+			edges   = make(map[uuid.UUID][]*Competition)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   competition.CompetitionToAdminsTable,
+				Columns: competition.CompetitionToAdminsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(competition.CompetitionToAdminsPrimaryKey[0], fks...))
+			},
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{new(uuid.UUID), new(uuid.UUID)}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*uuid.UUID)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*uuid.UUID)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := *eout
+				inValue := *ein
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, cq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "CompetitionToAdmins": %w`, err)
+		}
+		query.Where(user.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "CompetitionToAdmins" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.CompetitionToAdmins = append(nodes[i].Edges.CompetitionToAdmins, n)
+			}
+		}
+	}
+
+	if query := cq.withCompetitionToBreaks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Competition)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.CompetitionToBreaks = []*CompetitionBreak{}
+		}
+		query.withFKs = true
+		query.Where(predicate.CompetitionBreak(func(s *sql.Selector) {
+			s.Where(sql.InValues(competition.CompetitionToBreaksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.competition_competition_to_breaks
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "competition_competition_to_breaks" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "competition_competition_to_breaks" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.CompetitionToBreaks = append(node.Edges.CompetitionToBreaks, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -6,14 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/user"
 	"github.com/google/uuid"
 )
 
@@ -36,6 +39,141 @@ func (cu *CompetitionUpdate) SetName(s string) *CompetitionUpdate {
 	return cu
 }
 
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (cu *CompetitionUpdate) SetConsoleLimitPerVM(i int) *CompetitionUpdate {
+	cu.mutation.ResetConsoleLimitPerVM()
+	cu.mutation.SetConsoleLimitPerVM(i)
+	return cu
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableConsoleLimitPerVM(i *int) *CompetitionUpdate {
+	if i != nil {
+		cu.SetConsoleLimitPerVM(*i)
+	}
+	return cu
+}
+
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (cu *CompetitionUpdate) AddConsoleLimitPerVM(i int) *CompetitionUpdate {
+	cu.mutation.AddConsoleLimitPerVM(i)
+	return cu
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (cu *CompetitionUpdate) SetConsoleLimitPerUser(i int) *CompetitionUpdate {
+	cu.mutation.ResetConsoleLimitPerUser()
+	cu.mutation.SetConsoleLimitPerUser(i)
+	return cu
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableConsoleLimitPerUser(i *int) *CompetitionUpdate {
+	if i != nil {
+		cu.SetConsoleLimitPerUser(*i)
+	}
+	return cu
+}
+
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (cu *CompetitionUpdate) AddConsoleLimitPerUser(i int) *CompetitionUpdate {
+	cu.mutation.AddConsoleLimitPerUser(i)
+	return cu
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (cu *CompetitionUpdate) SetConsoleLimitPerTeam(i int) *CompetitionUpdate {
+	cu.mutation.ResetConsoleLimitPerTeam()
+	cu.mutation.SetConsoleLimitPerTeam(i)
+	return cu
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableConsoleLimitPerTeam(i *int) *CompetitionUpdate {
+	if i != nil {
+		cu.SetConsoleLimitPerTeam(*i)
+	}
+	return cu
+}
+
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (cu *CompetitionUpdate) AddConsoleLimitPerTeam(i int) *CompetitionUpdate {
+	cu.mutation.AddConsoleLimitPerTeam(i)
+	return cu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cu *CompetitionUpdate) SetStartsAt(t time.Time) *CompetitionUpdate {
+	cu.mutation.SetStartsAt(t)
+	return cu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableStartsAt(t *time.Time) *CompetitionUpdate {
+	if t != nil {
+		cu.SetStartsAt(*t)
+	}
+	return cu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (cu *CompetitionUpdate) ClearStartsAt() *CompetitionUpdate {
+	cu.mutation.ClearStartsAt()
+	return cu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cu *CompetitionUpdate) SetEndsAt(t time.Time) *CompetitionUpdate {
+	cu.mutation.SetEndsAt(t)
+	return cu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableEndsAt(t *time.Time) *CompetitionUpdate {
+	if t != nil {
+		cu.SetEndsAt(*t)
+	}
+	return cu
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (cu *CompetitionUpdate) ClearEndsAt() *CompetitionUpdate {
+	cu.mutation.ClearEndsAt()
+	return cu
+}
+
+// SetScheduleLocked sets the "schedule_locked" field.
+func (cu *CompetitionUpdate) SetScheduleLocked(b bool) *CompetitionUpdate {
+	cu.mutation.SetScheduleLocked(b)
+	return cu
+}
+
+// SetNillableScheduleLocked sets the "schedule_locked" field if the given value is not nil.
+func (cu *CompetitionUpdate) SetNillableScheduleLocked(b *bool) *CompetitionUpdate {
+	if b != nil {
+		cu.SetScheduleLocked(*b)
+	}
+	return cu
+}
+
+// ClearScheduleLocked clears the value of the "schedule_locked" field.
+func (cu *CompetitionUpdate) ClearScheduleLocked() *CompetitionUpdate {
+	cu.mutation.ClearScheduleLocked()
+	return cu
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (cu *CompetitionUpdate) SetAllowedCidrs(s []string) *CompetitionUpdate {
+	cu.mutation.SetAllowedCidrs(s)
+	return cu
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (cu *CompetitionUpdate) ClearAllowedCidrs() *CompetitionUpdate {
+	cu.mutation.ClearAllowedCidrs()
+	return cu
+}
+
 // AddCompetitionToTeamIDs adds the "CompetitionToTeams" edge to the Team entity by IDs.
 func (cu *CompetitionUpdate) AddCompetitionToTeamIDs(ids ...uuid.UUID) *CompetitionUpdate {
 	cu.mutation.AddCompetitionToTeamIDs(ids...)
@@ -62,6 +200,36 @@ func (cu *CompetitionUpdate) SetCompetitionToProvider(p *Provider) *CompetitionU
 	return cu.SetCompetitionToProviderID(p.ID)
 }
 
+// AddCompetitionToAdminIDs adds the "CompetitionToAdmins" edge to the User entity by IDs.
+func (cu *CompetitionUpdate) AddCompetitionToAdminIDs(ids ...uuid.UUID) *CompetitionUpdate {
+	cu.mutation.AddCompetitionToAdminIDs(ids...)
+	return cu
+}
+
+// AddCompetitionToAdmins adds the "CompetitionToAdmins" edges to the User entity.
+func (cu *CompetitionUpdate) AddCompetitionToAdmins(u ...*User) *CompetitionUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.AddCompetitionToAdminIDs(ids...)
+}
+
+// AddCompetitionToBreakIDs adds the "CompetitionToBreaks" edge to the CompetitionBreak entity by IDs.
+func (cu *CompetitionUpdate) AddCompetitionToBreakIDs(ids ...uuid.UUID) *CompetitionUpdate {
+	cu.mutation.AddCompetitionToBreakIDs(ids...)
+	return cu
+}
+
+// AddCompetitionToBreaks adds the "CompetitionToBreaks" edges to the CompetitionBreak entity.
+func (cu *CompetitionUpdate) AddCompetitionToBreaks(c ...*CompetitionBreak) *CompetitionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddCompetitionToBreakIDs(ids...)
+}
+
 // Mutation returns the CompetitionMutation object of the builder.
 func (cu *CompetitionUpdate) Mutation() *CompetitionMutation {
 	return cu.mutation
@@ -94,6 +262,48 @@ func (cu *CompetitionUpdate) ClearCompetitionToProvider() *CompetitionUpdate {
 	return cu
 }
 
+// ClearCompetitionToAdmins clears all "CompetitionToAdmins" edges to the User entity.
+func (cu *CompetitionUpdate) ClearCompetitionToAdmins() *CompetitionUpdate {
+	cu.mutation.ClearCompetitionToAdmins()
+	return cu
+}
+
+// RemoveCompetitionToAdminIDs removes the "CompetitionToAdmins" edge to User entities by IDs.
+func (cu *CompetitionUpdate) RemoveCompetitionToAdminIDs(ids ...uuid.UUID) *CompetitionUpdate {
+	cu.mutation.RemoveCompetitionToAdminIDs(ids...)
+	return cu
+}
+
+// RemoveCompetitionToAdmins removes "CompetitionToAdmins" edges to User entities.
+func (cu *CompetitionUpdate) RemoveCompetitionToAdmins(u ...*User) *CompetitionUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.RemoveCompetitionToAdminIDs(ids...)
+}
+
+// ClearCompetitionToBreaks clears all "CompetitionToBreaks" edges to the CompetitionBreak entity.
+func (cu *CompetitionUpdate) ClearCompetitionToBreaks() *CompetitionUpdate {
+	cu.mutation.ClearCompetitionToBreaks()
+	return cu
+}
+
+// RemoveCompetitionToBreakIDs removes the "CompetitionToBreaks" edge to CompetitionBreak entities by IDs.
+func (cu *CompetitionUpdate) RemoveCompetitionToBreakIDs(ids ...uuid.UUID) *CompetitionUpdate {
+	cu.mutation.RemoveCompetitionToBreakIDs(ids...)
+	return cu
+}
+
+// RemoveCompetitionToBreaks removes "CompetitionToBreaks" edges to CompetitionBreak entities.
+func (cu *CompetitionUpdate) RemoveCompetitionToBreaks(c ...*CompetitionBreak) *CompetitionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveCompetitionToBreakIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CompetitionUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -156,6 +366,21 @@ func (cu *CompetitionUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *CompetitionUpdate) check() error {
+	if v, ok := cu.mutation.ConsoleLimitPerVM(); ok {
+		if err := competition.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.ConsoleLimitPerUser(); ok {
+		if err := competition.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_user": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.ConsoleLimitPerTeam(); ok {
+		if err := competition.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_team": %w`, err)}
+		}
+	}
 	if _, ok := cu.mutation.CompetitionToProviderID(); cu.mutation.CompetitionToProviderCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Competition.CompetitionToProvider"`)
 	}
@@ -187,6 +412,100 @@ func (cu *CompetitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: competition.FieldName,
 		})
 	}
+	if value, ok := cu.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := cu.mutation.AddedConsoleLimitPerVM(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := cu.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := cu.mutation.AddedConsoleLimitPerUser(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := cu.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := cu.mutation.AddedConsoleLimitPerTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := cu.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldStartsAt,
+		})
+	}
+	if cu.mutation.StartsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: competition.FieldStartsAt,
+		})
+	}
+	if value, ok := cu.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldEndsAt,
+		})
+	}
+	if cu.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: competition.FieldEndsAt,
+		})
+	}
+	if value, ok := cu.mutation.ScheduleLocked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: competition.FieldScheduleLocked,
+		})
+	}
+	if cu.mutation.ScheduleLockedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: competition.FieldScheduleLocked,
+		})
+	}
+	if value, ok := cu.mutation.AllowedCidrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: competition.FieldAllowedCidrs,
+		})
+	}
+	if cu.mutation.AllowedCidrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: competition.FieldAllowedCidrs,
+		})
+	}
 	if cu.mutation.CompetitionToTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -276,6 +595,114 @@ func (cu *CompetitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.CompetitionToAdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedCompetitionToAdminsIDs(); len(nodes) > 0 && !cu.mutation.CompetitionToAdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CompetitionToAdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.CompetitionToBreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedCompetitionToBreaksIDs(); len(nodes) > 0 && !cu.mutation.CompetitionToBreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CompetitionToBreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{competition.Label}
@@ -301,6 +728,141 @@ func (cuo *CompetitionUpdateOne) SetName(s string) *CompetitionUpdateOne {
 	return cuo
 }
 
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (cuo *CompetitionUpdateOne) SetConsoleLimitPerVM(i int) *CompetitionUpdateOne {
+	cuo.mutation.ResetConsoleLimitPerVM()
+	cuo.mutation.SetConsoleLimitPerVM(i)
+	return cuo
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableConsoleLimitPerVM(i *int) *CompetitionUpdateOne {
+	if i != nil {
+		cuo.SetConsoleLimitPerVM(*i)
+	}
+	return cuo
+}
+
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (cuo *CompetitionUpdateOne) AddConsoleLimitPerVM(i int) *CompetitionUpdateOne {
+	cuo.mutation.AddConsoleLimitPerVM(i)
+	return cuo
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (cuo *CompetitionUpdateOne) SetConsoleLimitPerUser(i int) *CompetitionUpdateOne {
+	cuo.mutation.ResetConsoleLimitPerUser()
+	cuo.mutation.SetConsoleLimitPerUser(i)
+	return cuo
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableConsoleLimitPerUser(i *int) *CompetitionUpdateOne {
+	if i != nil {
+		cuo.SetConsoleLimitPerUser(*i)
+	}
+	return cuo
+}
+
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (cuo *CompetitionUpdateOne) AddConsoleLimitPerUser(i int) *CompetitionUpdateOne {
+	cuo.mutation.AddConsoleLimitPerUser(i)
+	return cuo
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (cuo *CompetitionUpdateOne) SetConsoleLimitPerTeam(i int) *CompetitionUpdateOne {
+	cuo.mutation.ResetConsoleLimitPerTeam()
+	cuo.mutation.SetConsoleLimitPerTeam(i)
+	return cuo
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableConsoleLimitPerTeam(i *int) *CompetitionUpdateOne {
+	if i != nil {
+		cuo.SetConsoleLimitPerTeam(*i)
+	}
+	return cuo
+}
+
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (cuo *CompetitionUpdateOne) AddConsoleLimitPerTeam(i int) *CompetitionUpdateOne {
+	cuo.mutation.AddConsoleLimitPerTeam(i)
+	return cuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cuo *CompetitionUpdateOne) SetStartsAt(t time.Time) *CompetitionUpdateOne {
+	cuo.mutation.SetStartsAt(t)
+	return cuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableStartsAt(t *time.Time) *CompetitionUpdateOne {
+	if t != nil {
+		cuo.SetStartsAt(*t)
+	}
+	return cuo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (cuo *CompetitionUpdateOne) ClearStartsAt() *CompetitionUpdateOne {
+	cuo.mutation.ClearStartsAt()
+	return cuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cuo *CompetitionUpdateOne) SetEndsAt(t time.Time) *CompetitionUpdateOne {
+	cuo.mutation.SetEndsAt(t)
+	return cuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableEndsAt(t *time.Time) *CompetitionUpdateOne {
+	if t != nil {
+		cuo.SetEndsAt(*t)
+	}
+	return cuo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (cuo *CompetitionUpdateOne) ClearEndsAt() *CompetitionUpdateOne {
+	cuo.mutation.ClearEndsAt()
+	return cuo
+}
+
+// SetScheduleLocked sets the "schedule_locked" field.
+func (cuo *CompetitionUpdateOne) SetScheduleLocked(b bool) *CompetitionUpdateOne {
+	cuo.mutation.SetScheduleLocked(b)
+	return cuo
+}
+
+// SetNillableScheduleLocked sets the "schedule_locked" field if the given value is not nil.
+func (cuo *CompetitionUpdateOne) SetNillableScheduleLocked(b *bool) *CompetitionUpdateOne {
+	if b != nil {
+		cuo.SetScheduleLocked(*b)
+	}
+	return cuo
+}
+
+// ClearScheduleLocked clears the value of the "schedule_locked" field.
+func (cuo *CompetitionUpdateOne) ClearScheduleLocked() *CompetitionUpdateOne {
+	cuo.mutation.ClearScheduleLocked()
+	return cuo
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (cuo *CompetitionUpdateOne) SetAllowedCidrs(s []string) *CompetitionUpdateOne {
+	cuo.mutation.SetAllowedCidrs(s)
+	return cuo
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (cuo *CompetitionUpdateOne) ClearAllowedCidrs() *CompetitionUpdateOne {
+	cuo.mutation.ClearAllowedCidrs()
+	return cuo
+}
+
 // AddCompetitionToTeamIDs adds the "CompetitionToTeams" edge to the Team entity by IDs.
 func (cuo *CompetitionUpdateOne) AddCompetitionToTeamIDs(ids ...uuid.UUID) *CompetitionUpdateOne {
 	cuo.mutation.AddCompetitionToTeamIDs(ids...)
@@ -327,6 +889,36 @@ func (cuo *CompetitionUpdateOne) SetCompetitionToProvider(p *Provider) *Competit
 	return cuo.SetCompetitionToProviderID(p.ID)
 }
 
+// AddCompetitionToAdminIDs adds the "CompetitionToAdmins" edge to the User entity by IDs.
+func (cuo *CompetitionUpdateOne) AddCompetitionToAdminIDs(ids ...uuid.UUID) *CompetitionUpdateOne {
+	cuo.mutation.AddCompetitionToAdminIDs(ids...)
+	return cuo
+}
+
+// AddCompetitionToAdmins adds the "CompetitionToAdmins" edges to the User entity.
+func (cuo *CompetitionUpdateOne) AddCompetitionToAdmins(u ...*User) *CompetitionUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.AddCompetitionToAdminIDs(ids...)
+}
+
+// AddCompetitionToBreakIDs adds the "CompetitionToBreaks" edge to the CompetitionBreak entity by IDs.
+func (cuo *CompetitionUpdateOne) AddCompetitionToBreakIDs(ids ...uuid.UUID) *CompetitionUpdateOne {
+	cuo.mutation.AddCompetitionToBreakIDs(ids...)
+	return cuo
+}
+
+// AddCompetitionToBreaks adds the "CompetitionToBreaks" edges to the CompetitionBreak entity.
+func (cuo *CompetitionUpdateOne) AddCompetitionToBreaks(c ...*CompetitionBreak) *CompetitionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddCompetitionToBreakIDs(ids...)
+}
+
 // Mutation returns the CompetitionMutation object of the builder.
 func (cuo *CompetitionUpdateOne) Mutation() *CompetitionMutation {
 	return cuo.mutation
@@ -359,6 +951,48 @@ func (cuo *CompetitionUpdateOne) ClearCompetitionToProvider() *CompetitionUpdate
 	return cuo
 }
 
+// ClearCompetitionToAdmins clears all "CompetitionToAdmins" edges to the User entity.
+func (cuo *CompetitionUpdateOne) ClearCompetitionToAdmins() *CompetitionUpdateOne {
+	cuo.mutation.ClearCompetitionToAdmins()
+	return cuo
+}
+
+// RemoveCompetitionToAdminIDs removes the "CompetitionToAdmins" edge to User entities by IDs.
+func (cuo *CompetitionUpdateOne) RemoveCompetitionToAdminIDs(ids ...uuid.UUID) *CompetitionUpdateOne {
+	cuo.mutation.RemoveCompetitionToAdminIDs(ids...)
+	return cuo
+}
+
+// RemoveCompetitionToAdmins removes "CompetitionToAdmins" edges to User entities.
+func (cuo *CompetitionUpdateOne) RemoveCompetitionToAdmins(u ...*User) *CompetitionUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.RemoveCompetitionToAdminIDs(ids...)
+}
+
+// ClearCompetitionToBreaks clears all "CompetitionToBreaks" edges to the CompetitionBreak entity.
+func (cuo *CompetitionUpdateOne) ClearCompetitionToBreaks() *CompetitionUpdateOne {
+	cuo.mutation.ClearCompetitionToBreaks()
+	return cuo
+}
+
+// RemoveCompetitionToBreakIDs removes the "CompetitionToBreaks" edge to CompetitionBreak entities by IDs.
+func (cuo *CompetitionUpdateOne) RemoveCompetitionToBreakIDs(ids ...uuid.UUID) *CompetitionUpdateOne {
+	cuo.mutation.RemoveCompetitionToBreakIDs(ids...)
+	return cuo
+}
+
+// RemoveCompetitionToBreaks removes "CompetitionToBreaks" edges to CompetitionBreak entities.
+func (cuo *CompetitionUpdateOne) RemoveCompetitionToBreaks(c ...*CompetitionBreak) *CompetitionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveCompetitionToBreakIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (cuo *CompetitionUpdateOne) Select(field string, fields ...string) *CompetitionUpdateOne {
@@ -428,6 +1062,21 @@ func (cuo *CompetitionUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CompetitionUpdateOne) check() error {
+	if v, ok := cuo.mutation.ConsoleLimitPerVM(); ok {
+		if err := competition.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.ConsoleLimitPerUser(); ok {
+		if err := competition.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_user": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.ConsoleLimitPerTeam(); ok {
+		if err := competition.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "Competition.console_limit_per_team": %w`, err)}
+		}
+	}
 	if _, ok := cuo.mutation.CompetitionToProviderID(); cuo.mutation.CompetitionToProviderCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Competition.CompetitionToProvider"`)
 	}
@@ -476,6 +1125,100 @@ func (cuo *CompetitionUpdateOne) sqlSave(ctx context.Context) (_node *Competitio
 			Column: competition.FieldName,
 		})
 	}
+	if value, ok := cuo.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := cuo.mutation.AddedConsoleLimitPerVM(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := cuo.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := cuo.mutation.AddedConsoleLimitPerUser(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := cuo.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := cuo.mutation.AddedConsoleLimitPerTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: competition.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := cuo.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldStartsAt,
+		})
+	}
+	if cuo.mutation.StartsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: competition.FieldStartsAt,
+		})
+	}
+	if value, ok := cuo.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competition.FieldEndsAt,
+		})
+	}
+	if cuo.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: competition.FieldEndsAt,
+		})
+	}
+	if value, ok := cuo.mutation.ScheduleLocked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: competition.FieldScheduleLocked,
+		})
+	}
+	if cuo.mutation.ScheduleLockedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: competition.FieldScheduleLocked,
+		})
+	}
+	if value, ok := cuo.mutation.AllowedCidrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: competition.FieldAllowedCidrs,
+		})
+	}
+	if cuo.mutation.AllowedCidrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: competition.FieldAllowedCidrs,
+		})
+	}
 	if cuo.mutation.CompetitionToTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -565,6 +1308,114 @@ func (cuo *CompetitionUpdateOne) sqlSave(ctx context.Context) (_node *Competitio
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.CompetitionToAdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedCompetitionToAdminsIDs(); len(nodes) > 0 && !cuo.mutation.CompetitionToAdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CompetitionToAdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   competition.CompetitionToAdminsTable,
+			Columns: competition.CompetitionToAdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.CompetitionToBreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedCompetitionToBreaksIDs(); len(nodes) > 0 && !cuo.mutation.CompetitionToBreaksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CompetitionToBreaksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   competition.CompetitionToBreaksTable,
+			Columns: []string{competition.CompetitionToBreaksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competitionbreak.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Competition{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,168 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/google/uuid"
+)
+
+// CompetitionBreak is the model entity for the CompetitionBreak schema.
+type CompetitionBreak struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	// [OPTIONAL] What the break is for (eg. "Lunch").
+	Name string `json:"name,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	// [REQUIRED] When competitors are locked out of their VMs.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	// [REQUIRED] When competitors can access their VMs again.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CompetitionBreakQuery when eager-loading is set.
+	Edges                             CompetitionBreakEdges `json:"edges"`
+	competition_competition_to_breaks *uuid.UUID
+}
+
+// CompetitionBreakEdges holds the relations/edges for other nodes in the graph.
+type CompetitionBreakEdges struct {
+	// CompetitionBreakToCompetition holds the value of the CompetitionBreakToCompetition edge.
+	CompetitionBreakToCompetition *Competition `json:"CompetitionBreakToCompetition,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CompetitionBreakToCompetitionOrErr returns the CompetitionBreakToCompetition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CompetitionBreakEdges) CompetitionBreakToCompetitionOrErr() (*Competition, error) {
+	if e.loadedTypes[0] {
+		if e.CompetitionBreakToCompetition == nil {
+			// The edge CompetitionBreakToCompetition was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: competition.Label}
+		}
+		return e.CompetitionBreakToCompetition, nil
+	}
+	return nil, &NotLoadedError{edge: "CompetitionBreakToCompetition"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CompetitionBreak) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case competitionbreak.FieldName:
+			values[i] = new(sql.NullString)
+		case competitionbreak.FieldStartsAt, competitionbreak.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case competitionbreak.FieldID:
+			values[i] = new(uuid.UUID)
+		case competitionbreak.ForeignKeys[0]: // competition_competition_to_breaks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CompetitionBreak", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CompetitionBreak fields.
+func (cb *CompetitionBreak) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case competitionbreak.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cb.ID = *value
+			}
+		case competitionbreak.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cb.Name = value.String
+			}
+		case competitionbreak.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				cb.StartsAt = value.Time
+			}
+		case competitionbreak.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				cb.EndsAt = value.Time
+			}
+		case competitionbreak.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field competition_competition_to_breaks", values[i])
+			} else if value.Valid {
+				cb.competition_competition_to_breaks = new(uuid.UUID)
+				*cb.competition_competition_to_breaks = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCompetitionBreakToCompetition queries the "CompetitionBreakToCompetition" edge of the CompetitionBreak entity.
+func (cb *CompetitionBreak) QueryCompetitionBreakToCompetition() *CompetitionQuery {
+	return (&CompetitionBreakClient{config: cb.config}).QueryCompetitionBreakToCompetition(cb)
+}
+
+// Update returns a builder for updating this CompetitionBreak.
+// Note that you need to call CompetitionBreak.Unwrap() before calling this method if this CompetitionBreak
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cb *CompetitionBreak) Update() *CompetitionBreakUpdateOne {
+	return (&CompetitionBreakClient{config: cb.config}).UpdateOne(cb)
+}
+
+// Unwrap unwraps the CompetitionBreak entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cb *CompetitionBreak) Unwrap() *CompetitionBreak {
+	tx, ok := cb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CompetitionBreak is not a transactional entity")
+	}
+	cb.config.driver = tx.drv
+	return cb
+}
+
+// String implements the fmt.Stringer.
+func (cb *CompetitionBreak) String() string {
+	var builder strings.Builder
+	builder.WriteString("CompetitionBreak(")
+	builder.WriteString(fmt.Sprintf("id=%v", cb.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(cb.Name)
+	builder.WriteString(", starts_at=")
+	builder.WriteString(cb.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ends_at=")
+	builder.WriteString(cb.EndsAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CompetitionBreaks is a parsable slice of CompetitionBreak.
+type CompetitionBreaks []*CompetitionBreak
+
+func (cb CompetitionBreaks) config(cfg config) {
+	for _i := range cb {
+		cb[_i].config = cfg
+	}
+}
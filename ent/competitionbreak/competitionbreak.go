@@ -0,0 +1,67 @@
+// Code generated by entc, DO NOT EDIT.
+
+package competitionbreak
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the competitionbreak type in the database.
+	Label = "competition_break"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// EdgeCompetitionBreakToCompetition holds the string denoting the competitionbreaktocompetition edge name in mutations.
+	EdgeCompetitionBreakToCompetition = "CompetitionBreakToCompetition"
+	// Table holds the table name of the competitionbreak in the database.
+	Table = "competition_breaks"
+	// CompetitionBreakToCompetitionTable is the table that holds the CompetitionBreakToCompetition relation/edge.
+	CompetitionBreakToCompetitionTable = "competition_breaks"
+	// CompetitionBreakToCompetitionInverseTable is the table name for the Competition entity.
+	// It exists in this package in order to avoid circular dependency with the "competition" package.
+	CompetitionBreakToCompetitionInverseTable = "competitions"
+	// CompetitionBreakToCompetitionColumn is the table column denoting the CompetitionBreakToCompetition relation/edge.
+	CompetitionBreakToCompetitionColumn = "competition_competition_to_breaks"
+)
+
+// Columns holds all SQL columns for competitionbreak fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldStartsAt,
+	FieldEndsAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "competition_breaks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"competition_competition_to_breaks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
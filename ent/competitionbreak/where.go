@@ -0,0 +1,439 @@
+// Code generated by entc, DO NOT EDIT.
+
+package competitionbreak
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.CompetitionBreak {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// HasCompetitionBreakToCompetition applies the HasEdge predicate on the "CompetitionBreakToCompetition" edge.
+func HasCompetitionBreakToCompetition() predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionBreakToCompetitionTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CompetitionBreakToCompetitionTable, CompetitionBreakToCompetitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCompetitionBreakToCompetitionWith applies the HasEdge predicate on the "CompetitionBreakToCompetition" edge with a given conditions (other predicates).
+func HasCompetitionBreakToCompetitionWith(preds ...predicate.Competition) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CompetitionBreakToCompetitionInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CompetitionBreakToCompetitionTable, CompetitionBreakToCompetitionColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CompetitionBreak) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CompetitionBreak) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CompetitionBreak) predicate.CompetitionBreak {
+	return predicate.CompetitionBreak(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,332 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/google/uuid"
+)
+
+// CompetitionBreakCreate is the builder for creating a CompetitionBreak entity.
+type CompetitionBreakCreate struct {
+	config
+	mutation *CompetitionBreakMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cbc *CompetitionBreakCreate) SetName(s string) *CompetitionBreakCreate {
+	cbc.mutation.SetName(s)
+	return cbc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cbc *CompetitionBreakCreate) SetNillableName(s *string) *CompetitionBreakCreate {
+	if s != nil {
+		cbc.SetName(*s)
+	}
+	return cbc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cbc *CompetitionBreakCreate) SetStartsAt(t time.Time) *CompetitionBreakCreate {
+	cbc.mutation.SetStartsAt(t)
+	return cbc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cbc *CompetitionBreakCreate) SetEndsAt(t time.Time) *CompetitionBreakCreate {
+	cbc.mutation.SetEndsAt(t)
+	return cbc
+}
+
+// SetID sets the "id" field.
+func (cbc *CompetitionBreakCreate) SetID(u uuid.UUID) *CompetitionBreakCreate {
+	cbc.mutation.SetID(u)
+	return cbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cbc *CompetitionBreakCreate) SetNillableID(u *uuid.UUID) *CompetitionBreakCreate {
+	if u != nil {
+		cbc.SetID(*u)
+	}
+	return cbc
+}
+
+// SetCompetitionBreakToCompetitionID sets the "CompetitionBreakToCompetition" edge to the Competition entity by ID.
+func (cbc *CompetitionBreakCreate) SetCompetitionBreakToCompetitionID(id uuid.UUID) *CompetitionBreakCreate {
+	cbc.mutation.SetCompetitionBreakToCompetitionID(id)
+	return cbc
+}
+
+// SetCompetitionBreakToCompetition sets the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (cbc *CompetitionBreakCreate) SetCompetitionBreakToCompetition(c *Competition) *CompetitionBreakCreate {
+	return cbc.SetCompetitionBreakToCompetitionID(c.ID)
+}
+
+// Mutation returns the CompetitionBreakMutation object of the builder.
+func (cbc *CompetitionBreakCreate) Mutation() *CompetitionBreakMutation {
+	return cbc.mutation
+}
+
+// Save creates the CompetitionBreak in the database.
+func (cbc *CompetitionBreakCreate) Save(ctx context.Context) (*CompetitionBreak, error) {
+	var (
+		err  error
+		node *CompetitionBreak
+	)
+	cbc.defaults()
+	if len(cbc.hooks) == 0 {
+		if err = cbc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cbc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CompetitionBreakMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cbc.check(); err != nil {
+				return nil, err
+			}
+			cbc.mutation = mutation
+			if node, err = cbc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cbc.hooks) - 1; i >= 0; i-- {
+			if cbc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cbc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cbc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cbc *CompetitionBreakCreate) SaveX(ctx context.Context) *CompetitionBreak {
+	v, err := cbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cbc *CompetitionBreakCreate) Exec(ctx context.Context) error {
+	_, err := cbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbc *CompetitionBreakCreate) ExecX(ctx context.Context) {
+	if err := cbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cbc *CompetitionBreakCreate) defaults() {
+	if _, ok := cbc.mutation.Name(); !ok {
+		v := competitionbreak.DefaultName
+		cbc.mutation.SetName(v)
+	}
+	if _, ok := cbc.mutation.ID(); !ok {
+		v := competitionbreak.DefaultID()
+		cbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cbc *CompetitionBreakCreate) check() error {
+	if _, ok := cbc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CompetitionBreak.name"`)}
+	}
+	if _, ok := cbc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "CompetitionBreak.starts_at"`)}
+	}
+	if _, ok := cbc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "CompetitionBreak.ends_at"`)}
+	}
+	if _, ok := cbc.mutation.CompetitionBreakToCompetitionID(); !ok {
+		return &ValidationError{Name: "CompetitionBreakToCompetition", err: errors.New(`ent: missing required edge "CompetitionBreak.CompetitionBreakToCompetition"`)}
+	}
+	return nil
+}
+
+func (cbc *CompetitionBreakCreate) sqlSave(ctx context.Context) (*CompetitionBreak, error) {
+	_node, _spec := cbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (cbc *CompetitionBreakCreate) createSpec() (*CompetitionBreak, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CompetitionBreak{config: cbc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: competitionbreak.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: competitionbreak.FieldID,
+			},
+		}
+	)
+	if id, ok := cbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cbc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: competitionbreak.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := cbc.mutation.StartsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldStartsAt,
+		})
+		_node.StartsAt = value
+	}
+	if value, ok := cbc.mutation.EndsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldEndsAt,
+		})
+		_node.EndsAt = value
+	}
+	if nodes := cbc.mutation.CompetitionBreakToCompetitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   competitionbreak.CompetitionBreakToCompetitionTable,
+			Columns: []string{competitionbreak.CompetitionBreakToCompetitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.competition_competition_to_breaks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CompetitionBreakCreateBulk is the builder for creating many CompetitionBreak entities in bulk.
+type CompetitionBreakCreateBulk struct {
+	config
+	builders []*CompetitionBreakCreate
+}
+
+// Save creates the CompetitionBreak entities in the database.
+func (cbcb *CompetitionBreakCreateBulk) Save(ctx context.Context) ([]*CompetitionBreak, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cbcb.builders))
+	nodes := make([]*CompetitionBreak, len(cbcb.builders))
+	mutators := make([]Mutator, len(cbcb.builders))
+	for i := range cbcb.builders {
+		func(i int, root context.Context) {
+			builder := cbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CompetitionBreakMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbcb *CompetitionBreakCreateBulk) SaveX(ctx context.Context) []*CompetitionBreak {
+	v, err := cbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cbcb *CompetitionBreakCreateBulk) Exec(ctx context.Context) error {
+	_, err := cbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbcb *CompetitionBreakCreateBulk) ExecX(ctx context.Context) {
+	if err := cbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/predicate"
+)
+
+// CompetitionBreakDelete is the builder for deleting a CompetitionBreak entity.
+type CompetitionBreakDelete struct {
+	config
+	hooks    []Hook
+	mutation *CompetitionBreakMutation
+}
+
+// Where appends a list predicates to the CompetitionBreakDelete builder.
+func (cbd *CompetitionBreakDelete) Where(ps ...predicate.CompetitionBreak) *CompetitionBreakDelete {
+	cbd.mutation.Where(ps...)
+	return cbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cbd *CompetitionBreakDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cbd.hooks) == 0 {
+		affected, err = cbd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CompetitionBreakMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cbd.mutation = mutation
+			affected, err = cbd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cbd.hooks) - 1; i >= 0; i-- {
+			if cbd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cbd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cbd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbd *CompetitionBreakDelete) ExecX(ctx context.Context) int {
+	n, err := cbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cbd *CompetitionBreakDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: competitionbreak.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: competitionbreak.FieldID,
+			},
+		},
+	}
+	if ps := cbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, cbd.driver, _spec)
+}
+
+// CompetitionBreakDeleteOne is the builder for deleting a single CompetitionBreak entity.
+type CompetitionBreakDeleteOne struct {
+	cbd *CompetitionBreakDelete
+}
+
+// Exec executes the deletion query.
+func (cbdo *CompetitionBreakDeleteOne) Exec(ctx context.Context) error {
+	n, err := cbdo.cbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{competitionbreak.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbdo *CompetitionBreakDeleteOne) ExecX(ctx context.Context) {
+	cbdo.cbd.ExecX(ctx)
+}
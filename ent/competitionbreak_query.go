@@ -0,0 +1,999 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// CompetitionBreakQuery is the builder for querying CompetitionBreak entities.
+type CompetitionBreakQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CompetitionBreak
+	// eager-loading edges.
+	withCompetitionBreakToCompetition *CompetitionQuery
+	withFKs                           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CompetitionBreakQuery builder.
+func (cbq *CompetitionBreakQuery) Where(ps ...predicate.CompetitionBreak) *CompetitionBreakQuery {
+	cbq.predicates = append(cbq.predicates, ps...)
+	return cbq
+}
+
+// Limit adds a limit step to the query.
+func (cbq *CompetitionBreakQuery) Limit(limit int) *CompetitionBreakQuery {
+	cbq.limit = &limit
+	return cbq
+}
+
+// Offset adds an offset step to the query.
+func (cbq *CompetitionBreakQuery) Offset(offset int) *CompetitionBreakQuery {
+	cbq.offset = &offset
+	return cbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cbq *CompetitionBreakQuery) Unique(unique bool) *CompetitionBreakQuery {
+	cbq.unique = &unique
+	return cbq
+}
+
+// Order adds an order step to the query.
+func (cbq *CompetitionBreakQuery) Order(o ...OrderFunc) *CompetitionBreakQuery {
+	cbq.order = append(cbq.order, o...)
+	return cbq
+}
+
+// QueryCompetitionBreakToCompetition chains the current query on the "CompetitionBreakToCompetition" edge.
+func (cbq *CompetitionBreakQuery) QueryCompetitionBreakToCompetition() *CompetitionQuery {
+	query := &CompetitionQuery{config: cbq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(competitionbreak.Table, competitionbreak.FieldID, selector),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, competitionbreak.CompetitionBreakToCompetitionTable, competitionbreak.CompetitionBreakToCompetitionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CompetitionBreak entity from the query.
+// Returns a *NotFoundError when no CompetitionBreak was found.
+func (cbq *CompetitionBreakQuery) First(ctx context.Context) (*CompetitionBreak, error) {
+	nodes, err := cbq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{competitionbreak.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) FirstX(ctx context.Context) *CompetitionBreak {
+	node, err := cbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CompetitionBreak ID from the query.
+// Returns a *NotFoundError when no CompetitionBreak ID was found.
+func (cbq *CompetitionBreakQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cbq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{competitionbreak.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CompetitionBreak entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CompetitionBreak entity is found.
+// Returns a *NotFoundError when no CompetitionBreak entities are found.
+func (cbq *CompetitionBreakQuery) Only(ctx context.Context) (*CompetitionBreak, error) {
+	nodes, err := cbq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{competitionbreak.Label}
+	default:
+		return nil, &NotSingularError{competitionbreak.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) OnlyX(ctx context.Context) *CompetitionBreak {
+	node, err := cbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CompetitionBreak ID in the query.
+// Returns a *NotSingularError when more than one CompetitionBreak ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cbq *CompetitionBreakQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cbq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = &NotSingularError{competitionbreak.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CompetitionBreaks.
+func (cbq *CompetitionBreakQuery) All(ctx context.Context) ([]*CompetitionBreak, error) {
+	if err := cbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cbq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) AllX(ctx context.Context) []*CompetitionBreak {
+	nodes, err := cbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CompetitionBreak IDs.
+func (cbq *CompetitionBreakQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := cbq.Select(competitionbreak.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cbq *CompetitionBreakQuery) Count(ctx context.Context) (int, error) {
+	if err := cbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cbq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) CountX(ctx context.Context) int {
+	count, err := cbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cbq *CompetitionBreakQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cbq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cbq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cbq *CompetitionBreakQuery) ExistX(ctx context.Context) bool {
+	exist, err := cbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CompetitionBreakQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cbq *CompetitionBreakQuery) Clone() *CompetitionBreakQuery {
+	if cbq == nil {
+		return nil
+	}
+	return &CompetitionBreakQuery{
+		config:                            cbq.config,
+		limit:                             cbq.limit,
+		offset:                            cbq.offset,
+		order:                             append([]OrderFunc{}, cbq.order...),
+		predicates:                        append([]predicate.CompetitionBreak{}, cbq.predicates...),
+		withCompetitionBreakToCompetition: cbq.withCompetitionBreakToCompetition.Clone(),
+		// clone intermediate query.
+		sql:    cbq.sql.Clone(),
+		path:   cbq.path,
+		unique: cbq.unique,
+	}
+}
+
+// WithCompetitionBreakToCompetition tells the query-builder to eager-load the nodes that are connected to
+// the "CompetitionBreakToCompetition" edge. The optional arguments are used to configure the query builder of the edge.
+func (cbq *CompetitionBreakQuery) WithCompetitionBreakToCompetition(opts ...func(*CompetitionQuery)) *CompetitionBreakQuery {
+	query := &CompetitionQuery{config: cbq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cbq.withCompetitionBreakToCompetition = query
+	return cbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CompetitionBreak.Query().
+//		GroupBy(competitionbreak.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cbq *CompetitionBreakQuery) GroupBy(field string, fields ...string) *CompetitionBreakGroupBy {
+	group := &CompetitionBreakGroupBy{config: cbq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cbq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.CompetitionBreak.Query().
+//		Select(competitionbreak.FieldName).
+//		Scan(ctx, &v)
+func (cbq *CompetitionBreakQuery) Select(fields ...string) *CompetitionBreakSelect {
+	cbq.fields = append(cbq.fields, fields...)
+	return &CompetitionBreakSelect{CompetitionBreakQuery: cbq}
+}
+
+func (cbq *CompetitionBreakQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cbq.fields {
+		if !competitionbreak.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cbq.path != nil {
+		prev, err := cbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cbq.sql = prev
+	}
+	return nil
+}
+
+func (cbq *CompetitionBreakQuery) sqlAll(ctx context.Context) ([]*CompetitionBreak, error) {
+	var (
+		nodes       = []*CompetitionBreak{}
+		withFKs     = cbq.withFKs
+		_spec       = cbq.querySpec()
+		loadedTypes = [1]bool{
+			cbq.withCompetitionBreakToCompetition != nil,
+		}
+	)
+	if cbq.withCompetitionBreakToCompetition != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, competitionbreak.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CompetitionBreak{config: cbq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cbq.withCompetitionBreakToCompetition; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*CompetitionBreak)
+		for i := range nodes {
+			if nodes[i].competition_competition_to_breaks == nil {
+				continue
+			}
+			fk := *nodes[i].competition_competition_to_breaks
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(competition.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "competition_competition_to_breaks" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.CompetitionBreakToCompetition = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cbq *CompetitionBreakQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cbq.querySpec()
+	_spec.Node.Columns = cbq.fields
+	if len(cbq.fields) > 0 {
+		_spec.Unique = cbq.unique != nil && *cbq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cbq.driver, _spec)
+}
+
+func (cbq *CompetitionBreakQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cbq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cbq *CompetitionBreakQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   competitionbreak.Table,
+			Columns: competitionbreak.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: competitionbreak.FieldID,
+			},
+		},
+		From:   cbq.sql,
+		Unique: true,
+	}
+	if unique := cbq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cbq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, competitionbreak.FieldID)
+		for i := range fields {
+			if fields[i] != competitionbreak.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cbq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cbq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cbq *CompetitionBreakQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cbq.driver.Dialect())
+	t1 := builder.Table(competitionbreak.Table)
+	columns := cbq.fields
+	if len(columns) == 0 {
+		columns = competitionbreak.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cbq.sql != nil {
+		selector = cbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cbq.unique != nil && *cbq.unique {
+		selector.Distinct()
+	}
+	for _, p := range cbq.predicates {
+		p(selector)
+	}
+	for _, p := range cbq.order {
+		p(selector)
+	}
+	if offset := cbq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cbq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CompetitionBreakGroupBy is the group-by builder for CompetitionBreak entities.
+type CompetitionBreakGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cbgb *CompetitionBreakGroupBy) Aggregate(fns ...AggregateFunc) *CompetitionBreakGroupBy {
+	cbgb.fns = append(cbgb.fns, fns...)
+	return cbgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cbgb *CompetitionBreakGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cbgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cbgb.sql = query
+	return cbgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cbgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cbgb.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cbgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cbgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cbgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) StringX(ctx context.Context) string {
+	v, err := cbgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cbgb.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cbgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cbgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cbgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) IntX(ctx context.Context) int {
+	v, err := cbgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cbgb.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cbgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cbgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cbgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cbgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cbgb.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cbgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cbgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cbgb *CompetitionBreakGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cbgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cbgb *CompetitionBreakGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cbgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cbgb *CompetitionBreakGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cbgb.fields {
+		if !competitionbreak.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cbgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cbgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cbgb *CompetitionBreakGroupBy) sqlQuery() *sql.Selector {
+	selector := cbgb.sql.Select()
+	aggregation := make([]string, 0, len(cbgb.fns))
+	for _, fn := range cbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cbgb.fields)+len(cbgb.fns))
+		for _, f := range cbgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cbgb.fields...)...)
+}
+
+// CompetitionBreakSelect is the builder for selecting fields of CompetitionBreak entities.
+type CompetitionBreakSelect struct {
+	*CompetitionBreakQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cbs *CompetitionBreakSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cbs.sql = cbs.CompetitionBreakQuery.sqlQuery(ctx)
+	return cbs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cbs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cbs.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cbs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) StringsX(ctx context.Context) []string {
+	v, err := cbs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cbs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) StringX(ctx context.Context) string {
+	v, err := cbs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cbs.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cbs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) IntsX(ctx context.Context) []int {
+	v, err := cbs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cbs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) IntX(ctx context.Context) int {
+	v, err := cbs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cbs.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cbs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cbs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cbs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) Float64X(ctx context.Context) float64 {
+	v, err := cbs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cbs.fields) > 1 {
+		return nil, errors.New("ent: CompetitionBreakSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cbs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cbs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cbs *CompetitionBreakSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cbs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{competitionbreak.Label}
+	default:
+		err = fmt.Errorf("ent: CompetitionBreakSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cbs *CompetitionBreakSelect) BoolX(ctx context.Context) bool {
+	v, err := cbs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cbs *CompetitionBreakSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cbs.sql.Query()
+	if err := cbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,468 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// CompetitionBreakUpdate is the builder for updating CompetitionBreak entities.
+type CompetitionBreakUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CompetitionBreakMutation
+}
+
+// Where appends a list predicates to the CompetitionBreakUpdate builder.
+func (cbu *CompetitionBreakUpdate) Where(ps ...predicate.CompetitionBreak) *CompetitionBreakUpdate {
+	cbu.mutation.Where(ps...)
+	return cbu
+}
+
+// SetName sets the "name" field.
+func (cbu *CompetitionBreakUpdate) SetName(s string) *CompetitionBreakUpdate {
+	cbu.mutation.SetName(s)
+	return cbu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cbu *CompetitionBreakUpdate) SetNillableName(s *string) *CompetitionBreakUpdate {
+	if s != nil {
+		cbu.SetName(*s)
+	}
+	return cbu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cbu *CompetitionBreakUpdate) SetStartsAt(t time.Time) *CompetitionBreakUpdate {
+	cbu.mutation.SetStartsAt(t)
+	return cbu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cbu *CompetitionBreakUpdate) SetEndsAt(t time.Time) *CompetitionBreakUpdate {
+	cbu.mutation.SetEndsAt(t)
+	return cbu
+}
+
+// SetCompetitionBreakToCompetitionID sets the "CompetitionBreakToCompetition" edge to the Competition entity by ID.
+func (cbu *CompetitionBreakUpdate) SetCompetitionBreakToCompetitionID(id uuid.UUID) *CompetitionBreakUpdate {
+	cbu.mutation.SetCompetitionBreakToCompetitionID(id)
+	return cbu
+}
+
+// SetCompetitionBreakToCompetition sets the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (cbu *CompetitionBreakUpdate) SetCompetitionBreakToCompetition(c *Competition) *CompetitionBreakUpdate {
+	return cbu.SetCompetitionBreakToCompetitionID(c.ID)
+}
+
+// Mutation returns the CompetitionBreakMutation object of the builder.
+func (cbu *CompetitionBreakUpdate) Mutation() *CompetitionBreakMutation {
+	return cbu.mutation
+}
+
+// ClearCompetitionBreakToCompetition clears the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (cbu *CompetitionBreakUpdate) ClearCompetitionBreakToCompetition() *CompetitionBreakUpdate {
+	cbu.mutation.ClearCompetitionBreakToCompetition()
+	return cbu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cbu *CompetitionBreakUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cbu.hooks) == 0 {
+		if err = cbu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cbu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CompetitionBreakMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cbu.check(); err != nil {
+				return 0, err
+			}
+			cbu.mutation = mutation
+			affected, err = cbu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cbu.hooks) - 1; i >= 0; i-- {
+			if cbu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cbu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cbu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbu *CompetitionBreakUpdate) SaveX(ctx context.Context) int {
+	affected, err := cbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cbu *CompetitionBreakUpdate) Exec(ctx context.Context) error {
+	_, err := cbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbu *CompetitionBreakUpdate) ExecX(ctx context.Context) {
+	if err := cbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cbu *CompetitionBreakUpdate) check() error {
+	if _, ok := cbu.mutation.CompetitionBreakToCompetitionID(); cbu.mutation.CompetitionBreakToCompetitionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CompetitionBreak.CompetitionBreakToCompetition"`)
+	}
+	return nil
+}
+
+func (cbu *CompetitionBreakUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   competitionbreak.Table,
+			Columns: competitionbreak.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: competitionbreak.FieldID,
+			},
+		},
+	}
+	if ps := cbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cbu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: competitionbreak.FieldName,
+		})
+	}
+	if value, ok := cbu.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldStartsAt,
+		})
+	}
+	if value, ok := cbu.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldEndsAt,
+		})
+	}
+	if cbu.mutation.CompetitionBreakToCompetitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   competitionbreak.CompetitionBreakToCompetitionTable,
+			Columns: []string{competitionbreak.CompetitionBreakToCompetitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbu.mutation.CompetitionBreakToCompetitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   competitionbreak.CompetitionBreakToCompetitionTable,
+			Columns: []string{competitionbreak.CompetitionBreakToCompetitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{competitionbreak.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CompetitionBreakUpdateOne is the builder for updating a single CompetitionBreak entity.
+type CompetitionBreakUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CompetitionBreakMutation
+}
+
+// SetName sets the "name" field.
+func (cbuo *CompetitionBreakUpdateOne) SetName(s string) *CompetitionBreakUpdateOne {
+	cbuo.mutation.SetName(s)
+	return cbuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cbuo *CompetitionBreakUpdateOne) SetNillableName(s *string) *CompetitionBreakUpdateOne {
+	if s != nil {
+		cbuo.SetName(*s)
+	}
+	return cbuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cbuo *CompetitionBreakUpdateOne) SetStartsAt(t time.Time) *CompetitionBreakUpdateOne {
+	cbuo.mutation.SetStartsAt(t)
+	return cbuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (cbuo *CompetitionBreakUpdateOne) SetEndsAt(t time.Time) *CompetitionBreakUpdateOne {
+	cbuo.mutation.SetEndsAt(t)
+	return cbuo
+}
+
+// SetCompetitionBreakToCompetitionID sets the "CompetitionBreakToCompetition" edge to the Competition entity by ID.
+func (cbuo *CompetitionBreakUpdateOne) SetCompetitionBreakToCompetitionID(id uuid.UUID) *CompetitionBreakUpdateOne {
+	cbuo.mutation.SetCompetitionBreakToCompetitionID(id)
+	return cbuo
+}
+
+// SetCompetitionBreakToCompetition sets the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (cbuo *CompetitionBreakUpdateOne) SetCompetitionBreakToCompetition(c *Competition) *CompetitionBreakUpdateOne {
+	return cbuo.SetCompetitionBreakToCompetitionID(c.ID)
+}
+
+// Mutation returns the CompetitionBreakMutation object of the builder.
+func (cbuo *CompetitionBreakUpdateOne) Mutation() *CompetitionBreakMutation {
+	return cbuo.mutation
+}
+
+// ClearCompetitionBreakToCompetition clears the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (cbuo *CompetitionBreakUpdateOne) ClearCompetitionBreakToCompetition() *CompetitionBreakUpdateOne {
+	cbuo.mutation.ClearCompetitionBreakToCompetition()
+	return cbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cbuo *CompetitionBreakUpdateOne) Select(field string, fields ...string) *CompetitionBreakUpdateOne {
+	cbuo.fields = append([]string{field}, fields...)
+	return cbuo
+}
+
+// Save executes the query and returns the updated CompetitionBreak entity.
+func (cbuo *CompetitionBreakUpdateOne) Save(ctx context.Context) (*CompetitionBreak, error) {
+	var (
+		err  error
+		node *CompetitionBreak
+	)
+	if len(cbuo.hooks) == 0 {
+		if err = cbuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cbuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CompetitionBreakMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cbuo.check(); err != nil {
+				return nil, err
+			}
+			cbuo.mutation = mutation
+			node, err = cbuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cbuo.hooks) - 1; i >= 0; i-- {
+			if cbuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cbuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cbuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbuo *CompetitionBreakUpdateOne) SaveX(ctx context.Context) *CompetitionBreak {
+	node, err := cbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cbuo *CompetitionBreakUpdateOne) Exec(ctx context.Context) error {
+	_, err := cbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbuo *CompetitionBreakUpdateOne) ExecX(ctx context.Context) {
+	if err := cbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cbuo *CompetitionBreakUpdateOne) check() error {
+	if _, ok := cbuo.mutation.CompetitionBreakToCompetitionID(); cbuo.mutation.CompetitionBreakToCompetitionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CompetitionBreak.CompetitionBreakToCompetition"`)
+	}
+	return nil
+}
+
+func (cbuo *CompetitionBreakUpdateOne) sqlSave(ctx context.Context) (_node *CompetitionBreak, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   competitionbreak.Table,
+			Columns: competitionbreak.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: competitionbreak.FieldID,
+			},
+		},
+	}
+	id, ok := cbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CompetitionBreak.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, competitionbreak.FieldID)
+		for _, f := range fields {
+			if !competitionbreak.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != competitionbreak.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cbuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: competitionbreak.FieldName,
+		})
+	}
+	if value, ok := cbuo.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldStartsAt,
+		})
+	}
+	if value, ok := cbuo.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: competitionbreak.FieldEndsAt,
+		})
+	}
+	if cbuo.mutation.CompetitionBreakToCompetitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   competitionbreak.CompetitionBreakToCompetitionTable,
+			Columns: []string{competitionbreak.CompetitionBreakToCompetitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbuo.mutation.CompetitionBreakToCompetitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   competitionbreak.CompetitionBreakToCompetitionTable,
+			Columns: []string{competitionbreak.CompetitionBreakToCompetitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CompetitionBreak{config: cbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{competitionbreak.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
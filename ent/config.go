@@ -24,15 +24,24 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Action         []ent.Hook
-	Competition    []ent.Hook
-	Provider       []ent.Hook
-	ServiceAccount []ent.Hook
-	ServiceToken   []ent.Hook
-	Team           []ent.Hook
-	Token          []ent.Hook
-	User           []ent.Hook
-	VmObject       []ent.Hook
+	Action              []ent.Hook
+	Competition         []ent.Hook
+	CompetitionBreak    []ent.Hook
+	ConsoleSession      []ent.Hook
+	ConsoleShare        []ent.Hook
+	CustomRole          []ent.Hook
+	PersonalAccessToken []ent.Hook
+	Provider            []ent.Hook
+	ServiceAccount      []ent.Hook
+	ServiceToken        []ent.Hook
+	SigningKey          []ent.Hook
+	Team                []ent.Hook
+	TeamMembership      []ent.Hook
+	Token               []ent.Hook
+	User                []ent.Hook
+	VmCredential        []ent.Hook
+	VmObject            []ent.Hook
+	WebauthnCredential  []ent.Hook
 }
 
 // Options applies the options on the config object.
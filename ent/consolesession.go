@@ -0,0 +1,225 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleSession is the model entity for the ConsoleSession schema.
+type ConsoleSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ConsoleType holds the value of the "console_type" field.
+	// [REQUIRED] The type of console this session was opened with.
+	ConsoleType string `json:"console_type,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	// [OPTIONAL] The IP address of the client which opened the session.
+	IPAddress string `json:"ip_address,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	// [REQUIRED] The time the session was opened.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	// [OPTIONAL] The time the session was closed. Empty while the session is active.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Transcript holds the value of the "transcript" field.
+	// [OPTIONAL] The output of the console for proxied text consoles.
+	Transcript string `json:"transcript,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConsoleSessionQuery when eager-loading is set.
+	Edges                                   ConsoleSessionEdges `json:"edges"`
+	user_user_to_console_sessions           *uuid.UUID
+	vm_object_vm_object_to_console_sessions *uuid.UUID
+}
+
+// ConsoleSessionEdges holds the relations/edges for other nodes in the graph.
+type ConsoleSessionEdges struct {
+	// ConsoleSessionToUser holds the value of the ConsoleSessionToUser edge.
+	ConsoleSessionToUser *User `json:"ConsoleSessionToUser,omitempty"`
+	// ConsoleSessionToVmObject holds the value of the ConsoleSessionToVmObject edge.
+	ConsoleSessionToVmObject *VmObject `json:"ConsoleSessionToVmObject,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConsoleSessionToUserOrErr returns the ConsoleSessionToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConsoleSessionEdges) ConsoleSessionToUserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.ConsoleSessionToUser == nil {
+			// The edge ConsoleSessionToUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.ConsoleSessionToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "ConsoleSessionToUser"}
+}
+
+// ConsoleSessionToVmObjectOrErr returns the ConsoleSessionToVmObject value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConsoleSessionEdges) ConsoleSessionToVmObjectOrErr() (*VmObject, error) {
+	if e.loadedTypes[1] {
+		if e.ConsoleSessionToVmObject == nil {
+			// The edge ConsoleSessionToVmObject was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: vmobject.Label}
+		}
+		return e.ConsoleSessionToVmObject, nil
+	}
+	return nil, &NotLoadedError{edge: "ConsoleSessionToVmObject"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsoleSession) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consolesession.FieldConsoleType, consolesession.FieldIPAddress, consolesession.FieldTranscript:
+			values[i] = new(sql.NullString)
+		case consolesession.FieldStartedAt, consolesession.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case consolesession.FieldID:
+			values[i] = new(uuid.UUID)
+		case consolesession.ForeignKeys[0]: // user_user_to_console_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case consolesession.ForeignKeys[1]: // vm_object_vm_object_to_console_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ConsoleSession", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsoleSession fields.
+func (cs *ConsoleSession) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consolesession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case consolesession.FieldConsoleType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field console_type", values[i])
+			} else if value.Valid {
+				cs.ConsoleType = value.String
+			}
+		case consolesession.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				cs.IPAddress = value.String
+			}
+		case consolesession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				cs.StartedAt = value.Time
+			}
+		case consolesession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				cs.EndedAt = new(time.Time)
+				*cs.EndedAt = value.Time
+			}
+		case consolesession.FieldTranscript:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transcript", values[i])
+			} else if value.Valid {
+				cs.Transcript = value.String
+			}
+		case consolesession.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_user_to_console_sessions", values[i])
+			} else if value.Valid {
+				cs.user_user_to_console_sessions = new(uuid.UUID)
+				*cs.user_user_to_console_sessions = *value.S.(*uuid.UUID)
+			}
+		case consolesession.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vm_object_vm_object_to_console_sessions", values[i])
+			} else if value.Valid {
+				cs.vm_object_vm_object_to_console_sessions = new(uuid.UUID)
+				*cs.vm_object_vm_object_to_console_sessions = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryConsoleSessionToUser queries the "ConsoleSessionToUser" edge of the ConsoleSession entity.
+func (cs *ConsoleSession) QueryConsoleSessionToUser() *UserQuery {
+	return (&ConsoleSessionClient{config: cs.config}).QueryConsoleSessionToUser(cs)
+}
+
+// QueryConsoleSessionToVmObject queries the "ConsoleSessionToVmObject" edge of the ConsoleSession entity.
+func (cs *ConsoleSession) QueryConsoleSessionToVmObject() *VmObjectQuery {
+	return (&ConsoleSessionClient{config: cs.config}).QueryConsoleSessionToVmObject(cs)
+}
+
+// Update returns a builder for updating this ConsoleSession.
+// Note that you need to call ConsoleSession.Unwrap() before calling this method if this ConsoleSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ConsoleSession) Update() *ConsoleSessionUpdateOne {
+	return (&ConsoleSessionClient{config: cs.config}).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ConsoleSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ConsoleSession) Unwrap() *ConsoleSession {
+	tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsoleSession is not a transactional entity")
+	}
+	cs.config.driver = tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ConsoleSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsoleSession(")
+	builder.WriteString(fmt.Sprintf("id=%v", cs.ID))
+	builder.WriteString(", console_type=")
+	builder.WriteString(cs.ConsoleType)
+	builder.WriteString(", ip_address=")
+	builder.WriteString(cs.IPAddress)
+	builder.WriteString(", started_at=")
+	builder.WriteString(cs.StartedAt.Format(time.ANSIC))
+	if v := cs.EndedAt; v != nil {
+		builder.WriteString(", ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", transcript=")
+	builder.WriteString(cs.Transcript)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsoleSessions is a parsable slice of ConsoleSession.
+type ConsoleSessions []*ConsoleSession
+
+func (cs ConsoleSessions) config(cfg config) {
+	for _i := range cs {
+		cs[_i].config = cfg
+	}
+}
@@ -0,0 +1,89 @@
+// Code generated by entc, DO NOT EDIT.
+
+package consolesession
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the consolesession type in the database.
+	Label = "console_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldConsoleType holds the string denoting the console_type field in the database.
+	FieldConsoleType = "console_type"
+	// FieldIPAddress holds the string denoting the ip_address field in the database.
+	FieldIPAddress = "ip_address"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldTranscript holds the string denoting the transcript field in the database.
+	FieldTranscript = "transcript"
+	// EdgeConsoleSessionToUser holds the string denoting the consolesessiontouser edge name in mutations.
+	EdgeConsoleSessionToUser = "ConsoleSessionToUser"
+	// EdgeConsoleSessionToVmObject holds the string denoting the consolesessiontovmobject edge name in mutations.
+	EdgeConsoleSessionToVmObject = "ConsoleSessionToVmObject"
+	// Table holds the table name of the consolesession in the database.
+	Table = "console_sessions"
+	// ConsoleSessionToUserTable is the table that holds the ConsoleSessionToUser relation/edge.
+	ConsoleSessionToUserTable = "console_sessions"
+	// ConsoleSessionToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ConsoleSessionToUserInverseTable = "users"
+	// ConsoleSessionToUserColumn is the table column denoting the ConsoleSessionToUser relation/edge.
+	ConsoleSessionToUserColumn = "user_user_to_console_sessions"
+	// ConsoleSessionToVmObjectTable is the table that holds the ConsoleSessionToVmObject relation/edge.
+	ConsoleSessionToVmObjectTable = "console_sessions"
+	// ConsoleSessionToVmObjectInverseTable is the table name for the VmObject entity.
+	// It exists in this package in order to avoid circular dependency with the "vmobject" package.
+	ConsoleSessionToVmObjectInverseTable = "vm_objects"
+	// ConsoleSessionToVmObjectColumn is the table column denoting the ConsoleSessionToVmObject relation/edge.
+	ConsoleSessionToVmObjectColumn = "vm_object_vm_object_to_console_sessions"
+)
+
+// Columns holds all SQL columns for consolesession fields.
+var Columns = []string{
+	FieldID,
+	FieldConsoleType,
+	FieldIPAddress,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldTranscript,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "console_sessions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_user_to_console_sessions",
+	"vm_object_vm_object_to_console_sessions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIPAddress holds the default value on creation for the "ip_address" field.
+	DefaultIPAddress string
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultTranscript holds the default value on creation for the "transcript" field.
+	DefaultTranscript string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
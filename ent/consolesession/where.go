@@ -0,0 +1,717 @@
+// Code generated by entc, DO NOT EDIT.
+
+package consolesession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ConsoleType applies equality check predicate on the "console_type" field. It's identical to ConsoleTypeEQ.
+func ConsoleType(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIPAddress), v))
+	})
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// Transcript applies equality check predicate on the "transcript" field. It's identical to TranscriptEQ.
+func Transcript(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTranscript), v))
+	})
+}
+
+// ConsoleTypeEQ applies the EQ predicate on the "console_type" field.
+func ConsoleTypeEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeNEQ applies the NEQ predicate on the "console_type" field.
+func ConsoleTypeNEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeIn applies the In predicate on the "console_type" field.
+func ConsoleTypeIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleType), v...))
+	})
+}
+
+// ConsoleTypeNotIn applies the NotIn predicate on the "console_type" field.
+func ConsoleTypeNotIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleType), v...))
+	})
+}
+
+// ConsoleTypeGT applies the GT predicate on the "console_type" field.
+func ConsoleTypeGT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeGTE applies the GTE predicate on the "console_type" field.
+func ConsoleTypeGTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeLT applies the LT predicate on the "console_type" field.
+func ConsoleTypeLT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeLTE applies the LTE predicate on the "console_type" field.
+func ConsoleTypeLTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeContains applies the Contains predicate on the "console_type" field.
+func ConsoleTypeContains(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeHasPrefix applies the HasPrefix predicate on the "console_type" field.
+func ConsoleTypeHasPrefix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeHasSuffix applies the HasSuffix predicate on the "console_type" field.
+func ConsoleTypeHasSuffix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeEqualFold applies the EqualFold predicate on the "console_type" field.
+func ConsoleTypeEqualFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeContainsFold applies the ContainsFold predicate on the "console_type" field.
+func ConsoleTypeContainsFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldConsoleType), v))
+	})
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIPAddress), v...))
+	})
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIPAddress), v...))
+	})
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIPAddress), v))
+	})
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIPAddress), v))
+	})
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartedAt), v...))
+	})
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartedAt), v))
+	})
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartedAt), v))
+	})
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndedAt), v...))
+	})
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndedAt), v))
+	})
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndedAt)))
+	})
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndedAt)))
+	})
+}
+
+// TranscriptEQ applies the EQ predicate on the "transcript" field.
+func TranscriptEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptNEQ applies the NEQ predicate on the "transcript" field.
+func TranscriptNEQ(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptIn applies the In predicate on the "transcript" field.
+func TranscriptIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTranscript), v...))
+	})
+}
+
+// TranscriptNotIn applies the NotIn predicate on the "transcript" field.
+func TranscriptNotIn(vs ...string) predicate.ConsoleSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTranscript), v...))
+	})
+}
+
+// TranscriptGT applies the GT predicate on the "transcript" field.
+func TranscriptGT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptGTE applies the GTE predicate on the "transcript" field.
+func TranscriptGTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptLT applies the LT predicate on the "transcript" field.
+func TranscriptLT(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptLTE applies the LTE predicate on the "transcript" field.
+func TranscriptLTE(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptContains applies the Contains predicate on the "transcript" field.
+func TranscriptContains(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptHasPrefix applies the HasPrefix predicate on the "transcript" field.
+func TranscriptHasPrefix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptHasSuffix applies the HasSuffix predicate on the "transcript" field.
+func TranscriptHasSuffix(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptEqualFold applies the EqualFold predicate on the "transcript" field.
+func TranscriptEqualFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTranscript), v))
+	})
+}
+
+// TranscriptContainsFold applies the ContainsFold predicate on the "transcript" field.
+func TranscriptContainsFold(v string) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTranscript), v))
+	})
+}
+
+// HasConsoleSessionToUser applies the HasEdge predicate on the "ConsoleSessionToUser" edge.
+func HasConsoleSessionToUser() predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleSessionToUserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleSessionToUserTable, ConsoleSessionToUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsoleSessionToUserWith applies the HasEdge predicate on the "ConsoleSessionToUser" edge with a given conditions (other predicates).
+func HasConsoleSessionToUserWith(preds ...predicate.User) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleSessionToUserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleSessionToUserTable, ConsoleSessionToUserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConsoleSessionToVmObject applies the HasEdge predicate on the "ConsoleSessionToVmObject" edge.
+func HasConsoleSessionToVmObject() predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleSessionToVmObjectTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleSessionToVmObjectTable, ConsoleSessionToVmObjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsoleSessionToVmObjectWith applies the HasEdge predicate on the "ConsoleSessionToVmObject" edge with a given conditions (other predicates).
+func HasConsoleSessionToVmObjectWith(preds ...predicate.VmObject) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleSessionToVmObjectInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleSessionToVmObjectTable, ConsoleSessionToVmObjectColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsoleSession) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsoleSession) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsoleSession) predicate.ConsoleSession {
+	return predicate.ConsoleSession(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
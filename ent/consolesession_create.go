@@ -0,0 +1,440 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleSessionCreate is the builder for creating a ConsoleSession entity.
+type ConsoleSessionCreate struct {
+	config
+	mutation *ConsoleSessionMutation
+	hooks    []Hook
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csc *ConsoleSessionCreate) SetConsoleType(s string) *ConsoleSessionCreate {
+	csc.mutation.SetConsoleType(s)
+	return csc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (csc *ConsoleSessionCreate) SetIPAddress(s string) *ConsoleSessionCreate {
+	csc.mutation.SetIPAddress(s)
+	return csc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableIPAddress(s *string) *ConsoleSessionCreate {
+	if s != nil {
+		csc.SetIPAddress(*s)
+	}
+	return csc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csc *ConsoleSessionCreate) SetStartedAt(t time.Time) *ConsoleSessionCreate {
+	csc.mutation.SetStartedAt(t)
+	return csc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableStartedAt(t *time.Time) *ConsoleSessionCreate {
+	if t != nil {
+		csc.SetStartedAt(*t)
+	}
+	return csc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csc *ConsoleSessionCreate) SetEndedAt(t time.Time) *ConsoleSessionCreate {
+	csc.mutation.SetEndedAt(t)
+	return csc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableEndedAt(t *time.Time) *ConsoleSessionCreate {
+	if t != nil {
+		csc.SetEndedAt(*t)
+	}
+	return csc
+}
+
+// SetTranscript sets the "transcript" field.
+func (csc *ConsoleSessionCreate) SetTranscript(s string) *ConsoleSessionCreate {
+	csc.mutation.SetTranscript(s)
+	return csc
+}
+
+// SetNillableTranscript sets the "transcript" field if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableTranscript(s *string) *ConsoleSessionCreate {
+	if s != nil {
+		csc.SetTranscript(*s)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ConsoleSessionCreate) SetID(u uuid.UUID) *ConsoleSessionCreate {
+	csc.mutation.SetID(u)
+	return csc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableID(u *uuid.UUID) *ConsoleSessionCreate {
+	if u != nil {
+		csc.SetID(*u)
+	}
+	return csc
+}
+
+// SetConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID.
+func (csc *ConsoleSessionCreate) SetConsoleSessionToUserID(id uuid.UUID) *ConsoleSessionCreate {
+	csc.mutation.SetConsoleSessionToUserID(id)
+	return csc
+}
+
+// SetNillableConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableConsoleSessionToUserID(id *uuid.UUID) *ConsoleSessionCreate {
+	if id != nil {
+		csc = csc.SetConsoleSessionToUserID(*id)
+	}
+	return csc
+}
+
+// SetConsoleSessionToUser sets the "ConsoleSessionToUser" edge to the User entity.
+func (csc *ConsoleSessionCreate) SetConsoleSessionToUser(u *User) *ConsoleSessionCreate {
+	return csc.SetConsoleSessionToUserID(u.ID)
+}
+
+// SetConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID.
+func (csc *ConsoleSessionCreate) SetConsoleSessionToVmObjectID(id uuid.UUID) *ConsoleSessionCreate {
+	csc.mutation.SetConsoleSessionToVmObjectID(id)
+	return csc
+}
+
+// SetNillableConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID if the given value is not nil.
+func (csc *ConsoleSessionCreate) SetNillableConsoleSessionToVmObjectID(id *uuid.UUID) *ConsoleSessionCreate {
+	if id != nil {
+		csc = csc.SetConsoleSessionToVmObjectID(*id)
+	}
+	return csc
+}
+
+// SetConsoleSessionToVmObject sets the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (csc *ConsoleSessionCreate) SetConsoleSessionToVmObject(v *VmObject) *ConsoleSessionCreate {
+	return csc.SetConsoleSessionToVmObjectID(v.ID)
+}
+
+// Mutation returns the ConsoleSessionMutation object of the builder.
+func (csc *ConsoleSessionCreate) Mutation() *ConsoleSessionMutation {
+	return csc.mutation
+}
+
+// Save creates the ConsoleSession in the database.
+func (csc *ConsoleSessionCreate) Save(ctx context.Context) (*ConsoleSession, error) {
+	var (
+		err  error
+		node *ConsoleSession
+	)
+	csc.defaults()
+	if len(csc.hooks) == 0 {
+		if err = csc.check(); err != nil {
+			return nil, err
+		}
+		node, err = csc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = csc.check(); err != nil {
+				return nil, err
+			}
+			csc.mutation = mutation
+			if node, err = csc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csc.hooks) - 1; i >= 0; i-- {
+			if csc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ConsoleSessionCreate) SaveX(ctx context.Context) *ConsoleSession {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ConsoleSessionCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ConsoleSessionCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ConsoleSessionCreate) defaults() {
+	if _, ok := csc.mutation.IPAddress(); !ok {
+		v := consolesession.DefaultIPAddress
+		csc.mutation.SetIPAddress(v)
+	}
+	if _, ok := csc.mutation.StartedAt(); !ok {
+		v := consolesession.DefaultStartedAt()
+		csc.mutation.SetStartedAt(v)
+	}
+	if _, ok := csc.mutation.Transcript(); !ok {
+		v := consolesession.DefaultTranscript
+		csc.mutation.SetTranscript(v)
+	}
+	if _, ok := csc.mutation.ID(); !ok {
+		v := consolesession.DefaultID()
+		csc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ConsoleSessionCreate) check() error {
+	if _, ok := csc.mutation.ConsoleType(); !ok {
+		return &ValidationError{Name: "console_type", err: errors.New(`ent: missing required field "ConsoleSession.console_type"`)}
+	}
+	if _, ok := csc.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "ConsoleSession.ip_address"`)}
+	}
+	if _, ok := csc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "ConsoleSession.started_at"`)}
+	}
+	if _, ok := csc.mutation.Transcript(); !ok {
+		return &ValidationError{Name: "transcript", err: errors.New(`ent: missing required field "ConsoleSession.transcript"`)}
+	}
+	return nil
+}
+
+func (csc *ConsoleSessionCreate) sqlSave(ctx context.Context) (*ConsoleSession, error) {
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (csc *ConsoleSessionCreate) createSpec() (*ConsoleSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsoleSession{config: csc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: consolesession.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consolesession.FieldID,
+			},
+		}
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.ConsoleType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldConsoleType,
+		})
+		_node.ConsoleType = value
+	}
+	if value, ok := csc.mutation.IPAddress(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldIPAddress,
+		})
+		_node.IPAddress = value
+	}
+	if value, ok := csc.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := csc.mutation.EndedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldEndedAt,
+		})
+		_node.EndedAt = &value
+	}
+	if value, ok := csc.mutation.Transcript(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldTranscript,
+		})
+		_node.Transcript = value
+	}
+	if nodes := csc.mutation.ConsoleSessionToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToUserTable,
+			Columns: []string{consolesession.ConsoleSessionToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_user_to_console_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := csc.mutation.ConsoleSessionToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToVmObjectTable,
+			Columns: []string{consolesession.ConsoleSessionToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vm_object_vm_object_to_console_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConsoleSessionCreateBulk is the builder for creating many ConsoleSession entities in bulk.
+type ConsoleSessionCreateBulk struct {
+	config
+	builders []*ConsoleSessionCreate
+}
+
+// Save creates the ConsoleSession entities in the database.
+func (cscb *ConsoleSessionCreateBulk) Save(ctx context.Context) ([]*ConsoleSession, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ConsoleSession, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsoleSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ConsoleSessionCreateBulk) SaveX(ctx context.Context) []*ConsoleSession {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ConsoleSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ConsoleSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1065 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleSessionQuery is the builder for querying ConsoleSession entities.
+type ConsoleSessionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ConsoleSession
+	// eager-loading edges.
+	withConsoleSessionToUser     *UserQuery
+	withConsoleSessionToVmObject *VmObjectQuery
+	withFKs                      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsoleSessionQuery builder.
+func (csq *ConsoleSessionQuery) Where(ps ...predicate.ConsoleSession) *ConsoleSessionQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit adds a limit step to the query.
+func (csq *ConsoleSessionQuery) Limit(limit int) *ConsoleSessionQuery {
+	csq.limit = &limit
+	return csq
+}
+
+// Offset adds an offset step to the query.
+func (csq *ConsoleSessionQuery) Offset(offset int) *ConsoleSessionQuery {
+	csq.offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ConsoleSessionQuery) Unique(unique bool) *ConsoleSessionQuery {
+	csq.unique = &unique
+	return csq
+}
+
+// Order adds an order step to the query.
+func (csq *ConsoleSessionQuery) Order(o ...OrderFunc) *ConsoleSessionQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryConsoleSessionToUser chains the current query on the "ConsoleSessionToUser" edge.
+func (csq *ConsoleSessionQuery) QueryConsoleSessionToUser() *UserQuery {
+	query := &UserQuery{config: csq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consolesession.Table, consolesession.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consolesession.ConsoleSessionToUserTable, consolesession.ConsoleSessionToUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConsoleSessionToVmObject chains the current query on the "ConsoleSessionToVmObject" edge.
+func (csq *ConsoleSessionQuery) QueryConsoleSessionToVmObject() *VmObjectQuery {
+	query := &VmObjectQuery{config: csq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consolesession.Table, consolesession.FieldID, selector),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consolesession.ConsoleSessionToVmObjectTable, consolesession.ConsoleSessionToVmObjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ConsoleSession entity from the query.
+// Returns a *NotFoundError when no ConsoleSession was found.
+func (csq *ConsoleSessionQuery) First(ctx context.Context) (*ConsoleSession, error) {
+	nodes, err := csq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consolesession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) FirstX(ctx context.Context) *ConsoleSession {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsoleSession ID from the query.
+// Returns a *NotFoundError when no ConsoleSession ID was found.
+func (csq *ConsoleSessionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consolesession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsoleSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsoleSession entity is found.
+// Returns a *NotFoundError when no ConsoleSession entities are found.
+func (csq *ConsoleSessionQuery) Only(ctx context.Context) (*ConsoleSession, error) {
+	nodes, err := csq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consolesession.Label}
+	default:
+		return nil, &NotSingularError{consolesession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) OnlyX(ctx context.Context) *ConsoleSession {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsoleSession ID in the query.
+// Returns a *NotSingularError when more than one ConsoleSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ConsoleSessionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = &NotSingularError{consolesession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsoleSessions.
+func (csq *ConsoleSessionQuery) All(ctx context.Context) ([]*ConsoleSession, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return csq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) AllX(ctx context.Context) []*ConsoleSession {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsoleSession IDs.
+func (csq *ConsoleSessionQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := csq.Select(consolesession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ConsoleSessionQuery) Count(ctx context.Context) (int, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return csq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ConsoleSessionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return csq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ConsoleSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsoleSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ConsoleSessionQuery) Clone() *ConsoleSessionQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ConsoleSessionQuery{
+		config:                       csq.config,
+		limit:                        csq.limit,
+		offset:                       csq.offset,
+		order:                        append([]OrderFunc{}, csq.order...),
+		predicates:                   append([]predicate.ConsoleSession{}, csq.predicates...),
+		withConsoleSessionToUser:     csq.withConsoleSessionToUser.Clone(),
+		withConsoleSessionToVmObject: csq.withConsoleSessionToVmObject.Clone(),
+		// clone intermediate query.
+		sql:    csq.sql.Clone(),
+		path:   csq.path,
+		unique: csq.unique,
+	}
+}
+
+// WithConsoleSessionToUser tells the query-builder to eager-load the nodes that are connected to
+// the "ConsoleSessionToUser" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ConsoleSessionQuery) WithConsoleSessionToUser(opts ...func(*UserQuery)) *ConsoleSessionQuery {
+	query := &UserQuery{config: csq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withConsoleSessionToUser = query
+	return csq
+}
+
+// WithConsoleSessionToVmObject tells the query-builder to eager-load the nodes that are connected to
+// the "ConsoleSessionToVmObject" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ConsoleSessionQuery) WithConsoleSessionToVmObject(opts ...func(*VmObjectQuery)) *ConsoleSessionQuery {
+	query := &VmObjectQuery{config: csq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withConsoleSessionToVmObject = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ConsoleType string `json:"console_type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsoleSession.Query().
+//		GroupBy(consolesession.FieldConsoleType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *ConsoleSessionQuery) GroupBy(field string, fields ...string) *ConsoleSessionGroupBy {
+	group := &ConsoleSessionGroupBy{config: csq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return csq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ConsoleType string `json:"console_type,omitempty"`
+//	}
+//
+//	client.ConsoleSession.Query().
+//		Select(consolesession.FieldConsoleType).
+//		Scan(ctx, &v)
+func (csq *ConsoleSessionQuery) Select(fields ...string) *ConsoleSessionSelect {
+	csq.fields = append(csq.fields, fields...)
+	return &ConsoleSessionSelect{ConsoleSessionQuery: csq}
+}
+
+func (csq *ConsoleSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range csq.fields {
+		if !consolesession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ConsoleSessionQuery) sqlAll(ctx context.Context) ([]*ConsoleSession, error) {
+	var (
+		nodes       = []*ConsoleSession{}
+		withFKs     = csq.withFKs
+		_spec       = csq.querySpec()
+		loadedTypes = [2]bool{
+			csq.withConsoleSessionToUser != nil,
+			csq.withConsoleSessionToVmObject != nil,
+		}
+	)
+	if csq.withConsoleSessionToUser != nil || csq.withConsoleSessionToVmObject != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, consolesession.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ConsoleSession{config: csq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := csq.withConsoleSessionToUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*ConsoleSession)
+		for i := range nodes {
+			if nodes[i].user_user_to_console_sessions == nil {
+				continue
+			}
+			fk := *nodes[i].user_user_to_console_sessions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_console_sessions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ConsoleSessionToUser = n
+			}
+		}
+	}
+
+	if query := csq.withConsoleSessionToVmObject; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*ConsoleSession)
+		for i := range nodes {
+			if nodes[i].vm_object_vm_object_to_console_sessions == nil {
+				continue
+			}
+			fk := *nodes[i].vm_object_vm_object_to_console_sessions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(vmobject.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_console_sessions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ConsoleSessionToVmObject = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (csq *ConsoleSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.fields
+	if len(csq.fields) > 0 {
+		_spec.Unique = csq.unique != nil && *csq.unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ConsoleSessionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := csq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (csq *ConsoleSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consolesession.Table,
+			Columns: consolesession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consolesession.FieldID,
+			},
+		},
+		From:   csq.sql,
+		Unique: true,
+	}
+	if unique := csq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := csq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consolesession.FieldID)
+		for i := range fields {
+			if fields[i] != consolesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ConsoleSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(consolesession.Table)
+	columns := csq.fields
+	if len(columns) == 0 {
+		columns = consolesession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.unique != nil && *csq.unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConsoleSessionGroupBy is the group-by builder for ConsoleSession entities.
+type ConsoleSessionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ConsoleSessionGroupBy) Aggregate(fns ...AggregateFunc) *ConsoleSessionGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (csgb *ConsoleSessionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := csgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	csgb.sql = query
+	return csgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := csgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := csgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = csgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) StringX(ctx context.Context) string {
+	v, err := csgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := csgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = csgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) IntX(ctx context.Context) int {
+	v, err := csgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := csgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = csgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := csgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := csgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleSessionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = csgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (csgb *ConsoleSessionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := csgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (csgb *ConsoleSessionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range csgb.fields {
+		if !consolesession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := csgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (csgb *ConsoleSessionGroupBy) sqlQuery() *sql.Selector {
+	selector := csgb.sql.Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(csgb.fields)+len(csgb.fns))
+		for _, f := range csgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(csgb.fields...)...)
+}
+
+// ConsoleSessionSelect is the builder for selecting fields of ConsoleSession entities.
+type ConsoleSessionSelect struct {
+	*ConsoleSessionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ConsoleSessionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	css.sql = css.ConsoleSessionQuery.sqlQuery(ctx)
+	return css.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (css *ConsoleSessionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := css.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (css *ConsoleSessionSelect) StringsX(ctx context.Context) []string {
+	v, err := css.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = css.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (css *ConsoleSessionSelect) StringX(ctx context.Context) string {
+	v, err := css.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (css *ConsoleSessionSelect) IntsX(ctx context.Context) []int {
+	v, err := css.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = css.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (css *ConsoleSessionSelect) IntX(ctx context.Context) int {
+	v, err := css.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (css *ConsoleSessionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := css.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = css.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (css *ConsoleSessionSelect) Float64X(ctx context.Context) float64 {
+	v, err := css.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleSessionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (css *ConsoleSessionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := css.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (css *ConsoleSessionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = css.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consolesession.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleSessionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (css *ConsoleSessionSelect) BoolX(ctx context.Context) bool {
+	v, err := css.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (css *ConsoleSessionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := css.sql.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,701 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleSessionUpdate is the builder for updating ConsoleSession entities.
+type ConsoleSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsoleSessionMutation
+}
+
+// Where appends a list predicates to the ConsoleSessionUpdate builder.
+func (csu *ConsoleSessionUpdate) Where(ps ...predicate.ConsoleSession) *ConsoleSessionUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csu *ConsoleSessionUpdate) SetConsoleType(s string) *ConsoleSessionUpdate {
+	csu.mutation.SetConsoleType(s)
+	return csu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (csu *ConsoleSessionUpdate) SetIPAddress(s string) *ConsoleSessionUpdate {
+	csu.mutation.SetIPAddress(s)
+	return csu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableIPAddress(s *string) *ConsoleSessionUpdate {
+	if s != nil {
+		csu.SetIPAddress(*s)
+	}
+	return csu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csu *ConsoleSessionUpdate) SetStartedAt(t time.Time) *ConsoleSessionUpdate {
+	csu.mutation.SetStartedAt(t)
+	return csu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableStartedAt(t *time.Time) *ConsoleSessionUpdate {
+	if t != nil {
+		csu.SetStartedAt(*t)
+	}
+	return csu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csu *ConsoleSessionUpdate) SetEndedAt(t time.Time) *ConsoleSessionUpdate {
+	csu.mutation.SetEndedAt(t)
+	return csu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableEndedAt(t *time.Time) *ConsoleSessionUpdate {
+	if t != nil {
+		csu.SetEndedAt(*t)
+	}
+	return csu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csu *ConsoleSessionUpdate) ClearEndedAt() *ConsoleSessionUpdate {
+	csu.mutation.ClearEndedAt()
+	return csu
+}
+
+// SetTranscript sets the "transcript" field.
+func (csu *ConsoleSessionUpdate) SetTranscript(s string) *ConsoleSessionUpdate {
+	csu.mutation.SetTranscript(s)
+	return csu
+}
+
+// SetNillableTranscript sets the "transcript" field if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableTranscript(s *string) *ConsoleSessionUpdate {
+	if s != nil {
+		csu.SetTranscript(*s)
+	}
+	return csu
+}
+
+// SetConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID.
+func (csu *ConsoleSessionUpdate) SetConsoleSessionToUserID(id uuid.UUID) *ConsoleSessionUpdate {
+	csu.mutation.SetConsoleSessionToUserID(id)
+	return csu
+}
+
+// SetNillableConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableConsoleSessionToUserID(id *uuid.UUID) *ConsoleSessionUpdate {
+	if id != nil {
+		csu = csu.SetConsoleSessionToUserID(*id)
+	}
+	return csu
+}
+
+// SetConsoleSessionToUser sets the "ConsoleSessionToUser" edge to the User entity.
+func (csu *ConsoleSessionUpdate) SetConsoleSessionToUser(u *User) *ConsoleSessionUpdate {
+	return csu.SetConsoleSessionToUserID(u.ID)
+}
+
+// SetConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID.
+func (csu *ConsoleSessionUpdate) SetConsoleSessionToVmObjectID(id uuid.UUID) *ConsoleSessionUpdate {
+	csu.mutation.SetConsoleSessionToVmObjectID(id)
+	return csu
+}
+
+// SetNillableConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID if the given value is not nil.
+func (csu *ConsoleSessionUpdate) SetNillableConsoleSessionToVmObjectID(id *uuid.UUID) *ConsoleSessionUpdate {
+	if id != nil {
+		csu = csu.SetConsoleSessionToVmObjectID(*id)
+	}
+	return csu
+}
+
+// SetConsoleSessionToVmObject sets the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (csu *ConsoleSessionUpdate) SetConsoleSessionToVmObject(v *VmObject) *ConsoleSessionUpdate {
+	return csu.SetConsoleSessionToVmObjectID(v.ID)
+}
+
+// Mutation returns the ConsoleSessionMutation object of the builder.
+func (csu *ConsoleSessionUpdate) Mutation() *ConsoleSessionMutation {
+	return csu.mutation
+}
+
+// ClearConsoleSessionToUser clears the "ConsoleSessionToUser" edge to the User entity.
+func (csu *ConsoleSessionUpdate) ClearConsoleSessionToUser() *ConsoleSessionUpdate {
+	csu.mutation.ClearConsoleSessionToUser()
+	return csu
+}
+
+// ClearConsoleSessionToVmObject clears the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (csu *ConsoleSessionUpdate) ClearConsoleSessionToVmObject() *ConsoleSessionUpdate {
+	csu.mutation.ClearConsoleSessionToVmObject()
+	return csu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ConsoleSessionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(csu.hooks) == 0 {
+		affected, err = csu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			csu.mutation = mutation
+			affected, err = csu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(csu.hooks) - 1; i >= 0; i-- {
+			if csu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ConsoleSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ConsoleSessionUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ConsoleSessionUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csu *ConsoleSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consolesession.Table,
+			Columns: consolesession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consolesession.FieldID,
+			},
+		},
+	}
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.ConsoleType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldConsoleType,
+		})
+	}
+	if value, ok := csu.mutation.IPAddress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldIPAddress,
+		})
+	}
+	if value, ok := csu.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldStartedAt,
+		})
+	}
+	if value, ok := csu.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldEndedAt,
+		})
+	}
+	if csu.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: consolesession.FieldEndedAt,
+		})
+	}
+	if value, ok := csu.mutation.Transcript(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldTranscript,
+		})
+	}
+	if csu.mutation.ConsoleSessionToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToUserTable,
+			Columns: []string{consolesession.ConsoleSessionToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ConsoleSessionToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToUserTable,
+			Columns: []string{consolesession.ConsoleSessionToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csu.mutation.ConsoleSessionToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToVmObjectTable,
+			Columns: []string{consolesession.ConsoleSessionToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ConsoleSessionToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToVmObjectTable,
+			Columns: []string{consolesession.ConsoleSessionToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consolesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ConsoleSessionUpdateOne is the builder for updating a single ConsoleSession entity.
+type ConsoleSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsoleSessionMutation
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csuo *ConsoleSessionUpdateOne) SetConsoleType(s string) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetConsoleType(s)
+	return csuo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (csuo *ConsoleSessionUpdateOne) SetIPAddress(s string) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetIPAddress(s)
+	return csuo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableIPAddress(s *string) *ConsoleSessionUpdateOne {
+	if s != nil {
+		csuo.SetIPAddress(*s)
+	}
+	return csuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (csuo *ConsoleSessionUpdateOne) SetStartedAt(t time.Time) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetStartedAt(t)
+	return csuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableStartedAt(t *time.Time) *ConsoleSessionUpdateOne {
+	if t != nil {
+		csuo.SetStartedAt(*t)
+	}
+	return csuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (csuo *ConsoleSessionUpdateOne) SetEndedAt(t time.Time) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetEndedAt(t)
+	return csuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableEndedAt(t *time.Time) *ConsoleSessionUpdateOne {
+	if t != nil {
+		csuo.SetEndedAt(*t)
+	}
+	return csuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (csuo *ConsoleSessionUpdateOne) ClearEndedAt() *ConsoleSessionUpdateOne {
+	csuo.mutation.ClearEndedAt()
+	return csuo
+}
+
+// SetTranscript sets the "transcript" field.
+func (csuo *ConsoleSessionUpdateOne) SetTranscript(s string) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetTranscript(s)
+	return csuo
+}
+
+// SetNillableTranscript sets the "transcript" field if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableTranscript(s *string) *ConsoleSessionUpdateOne {
+	if s != nil {
+		csuo.SetTranscript(*s)
+	}
+	return csuo
+}
+
+// SetConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID.
+func (csuo *ConsoleSessionUpdateOne) SetConsoleSessionToUserID(id uuid.UUID) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetConsoleSessionToUserID(id)
+	return csuo
+}
+
+// SetNillableConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by ID if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableConsoleSessionToUserID(id *uuid.UUID) *ConsoleSessionUpdateOne {
+	if id != nil {
+		csuo = csuo.SetConsoleSessionToUserID(*id)
+	}
+	return csuo
+}
+
+// SetConsoleSessionToUser sets the "ConsoleSessionToUser" edge to the User entity.
+func (csuo *ConsoleSessionUpdateOne) SetConsoleSessionToUser(u *User) *ConsoleSessionUpdateOne {
+	return csuo.SetConsoleSessionToUserID(u.ID)
+}
+
+// SetConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID.
+func (csuo *ConsoleSessionUpdateOne) SetConsoleSessionToVmObjectID(id uuid.UUID) *ConsoleSessionUpdateOne {
+	csuo.mutation.SetConsoleSessionToVmObjectID(id)
+	return csuo
+}
+
+// SetNillableConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by ID if the given value is not nil.
+func (csuo *ConsoleSessionUpdateOne) SetNillableConsoleSessionToVmObjectID(id *uuid.UUID) *ConsoleSessionUpdateOne {
+	if id != nil {
+		csuo = csuo.SetConsoleSessionToVmObjectID(*id)
+	}
+	return csuo
+}
+
+// SetConsoleSessionToVmObject sets the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (csuo *ConsoleSessionUpdateOne) SetConsoleSessionToVmObject(v *VmObject) *ConsoleSessionUpdateOne {
+	return csuo.SetConsoleSessionToVmObjectID(v.ID)
+}
+
+// Mutation returns the ConsoleSessionMutation object of the builder.
+func (csuo *ConsoleSessionUpdateOne) Mutation() *ConsoleSessionMutation {
+	return csuo.mutation
+}
+
+// ClearConsoleSessionToUser clears the "ConsoleSessionToUser" edge to the User entity.
+func (csuo *ConsoleSessionUpdateOne) ClearConsoleSessionToUser() *ConsoleSessionUpdateOne {
+	csuo.mutation.ClearConsoleSessionToUser()
+	return csuo
+}
+
+// ClearConsoleSessionToVmObject clears the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (csuo *ConsoleSessionUpdateOne) ClearConsoleSessionToVmObject() *ConsoleSessionUpdateOne {
+	csuo.mutation.ClearConsoleSessionToVmObject()
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ConsoleSessionUpdateOne) Select(field string, fields ...string) *ConsoleSessionUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ConsoleSession entity.
+func (csuo *ConsoleSessionUpdateOne) Save(ctx context.Context) (*ConsoleSession, error) {
+	var (
+		err  error
+		node *ConsoleSession
+	)
+	if len(csuo.hooks) == 0 {
+		node, err = csuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			csuo.mutation = mutation
+			node, err = csuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csuo.hooks) - 1; i >= 0; i-- {
+			if csuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ConsoleSessionUpdateOne) SaveX(ctx context.Context) *ConsoleSession {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ConsoleSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ConsoleSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csuo *ConsoleSessionUpdateOne) sqlSave(ctx context.Context) (_node *ConsoleSession, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consolesession.Table,
+			Columns: consolesession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consolesession.FieldID,
+			},
+		},
+	}
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsoleSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consolesession.FieldID)
+		for _, f := range fields {
+			if !consolesession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consolesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.ConsoleType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldConsoleType,
+		})
+	}
+	if value, ok := csuo.mutation.IPAddress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldIPAddress,
+		})
+	}
+	if value, ok := csuo.mutation.StartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldStartedAt,
+		})
+	}
+	if value, ok := csuo.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consolesession.FieldEndedAt,
+		})
+	}
+	if csuo.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: consolesession.FieldEndedAt,
+		})
+	}
+	if value, ok := csuo.mutation.Transcript(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consolesession.FieldTranscript,
+		})
+	}
+	if csuo.mutation.ConsoleSessionToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToUserTable,
+			Columns: []string{consolesession.ConsoleSessionToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ConsoleSessionToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToUserTable,
+			Columns: []string{consolesession.ConsoleSessionToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csuo.mutation.ConsoleSessionToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToVmObjectTable,
+			Columns: []string{consolesession.ConsoleSessionToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ConsoleSessionToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consolesession.ConsoleSessionToVmObjectTable,
+			Columns: []string{consolesession.ConsoleSessionToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConsoleSession{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consolesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
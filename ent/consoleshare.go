@@ -0,0 +1,233 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleShare is the model entity for the ConsoleShare schema.
+type ConsoleShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	// [REQUIRED] The SHA-256 hash of the share link token.
+	TokenHash string `json:"-"`
+	// ConsoleType holds the value of the "console_type" field.
+	// [REQUIRED] The type of console being shared. Only consoles proxied through Compsole can be shared.
+	ConsoleType string `json:"console_type,omitempty"`
+	// Password holds the value of the "password" field.
+	// [OPTIONAL] The hashed password required to view the console. Empty if no password is required.
+	Password string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	// [REQUIRED] (default is now) When the share link was created.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	// [REQUIRED] When the share link stops working.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	// [REQUIRED] (default is false) Revoked share links can no longer be used.
+	Revoked bool `json:"revoked,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConsoleShareQuery when eager-loading is set.
+	Edges                                 ConsoleShareEdges `json:"edges"`
+	user_user_to_console_shares           *uuid.UUID
+	vm_object_vm_object_to_console_shares *uuid.UUID
+}
+
+// ConsoleShareEdges holds the relations/edges for other nodes in the graph.
+type ConsoleShareEdges struct {
+	// ConsoleShareToVmObject holds the value of the ConsoleShareToVmObject edge.
+	ConsoleShareToVmObject *VmObject `json:"ConsoleShareToVmObject,omitempty"`
+	// ConsoleShareToUser holds the value of the ConsoleShareToUser edge.
+	ConsoleShareToUser *User `json:"ConsoleShareToUser,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConsoleShareToVmObjectOrErr returns the ConsoleShareToVmObject value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConsoleShareEdges) ConsoleShareToVmObjectOrErr() (*VmObject, error) {
+	if e.loadedTypes[0] {
+		if e.ConsoleShareToVmObject == nil {
+			// The edge ConsoleShareToVmObject was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: vmobject.Label}
+		}
+		return e.ConsoleShareToVmObject, nil
+	}
+	return nil, &NotLoadedError{edge: "ConsoleShareToVmObject"}
+}
+
+// ConsoleShareToUserOrErr returns the ConsoleShareToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConsoleShareEdges) ConsoleShareToUserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.ConsoleShareToUser == nil {
+			// The edge ConsoleShareToUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.ConsoleShareToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "ConsoleShareToUser"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsoleShare) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consoleshare.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case consoleshare.FieldTokenHash, consoleshare.FieldConsoleType, consoleshare.FieldPassword:
+			values[i] = new(sql.NullString)
+		case consoleshare.FieldCreatedAt, consoleshare.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case consoleshare.FieldID:
+			values[i] = new(uuid.UUID)
+		case consoleshare.ForeignKeys[0]: // user_user_to_console_shares
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case consoleshare.ForeignKeys[1]: // vm_object_vm_object_to_console_shares
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ConsoleShare", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsoleShare fields.
+func (cs *ConsoleShare) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consoleshare.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case consoleshare.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				cs.TokenHash = value.String
+			}
+		case consoleshare.FieldConsoleType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field console_type", values[i])
+			} else if value.Valid {
+				cs.ConsoleType = value.String
+			}
+		case consoleshare.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				cs.Password = value.String
+			}
+		case consoleshare.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		case consoleshare.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				cs.ExpiresAt = value.Time
+			}
+		case consoleshare.FieldRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				cs.Revoked = value.Bool
+			}
+		case consoleshare.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_user_to_console_shares", values[i])
+			} else if value.Valid {
+				cs.user_user_to_console_shares = new(uuid.UUID)
+				*cs.user_user_to_console_shares = *value.S.(*uuid.UUID)
+			}
+		case consoleshare.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vm_object_vm_object_to_console_shares", values[i])
+			} else if value.Valid {
+				cs.vm_object_vm_object_to_console_shares = new(uuid.UUID)
+				*cs.vm_object_vm_object_to_console_shares = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryConsoleShareToVmObject queries the "ConsoleShareToVmObject" edge of the ConsoleShare entity.
+func (cs *ConsoleShare) QueryConsoleShareToVmObject() *VmObjectQuery {
+	return (&ConsoleShareClient{config: cs.config}).QueryConsoleShareToVmObject(cs)
+}
+
+// QueryConsoleShareToUser queries the "ConsoleShareToUser" edge of the ConsoleShare entity.
+func (cs *ConsoleShare) QueryConsoleShareToUser() *UserQuery {
+	return (&ConsoleShareClient{config: cs.config}).QueryConsoleShareToUser(cs)
+}
+
+// Update returns a builder for updating this ConsoleShare.
+// Note that you need to call ConsoleShare.Unwrap() before calling this method if this ConsoleShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ConsoleShare) Update() *ConsoleShareUpdateOne {
+	return (&ConsoleShareClient{config: cs.config}).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ConsoleShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ConsoleShare) Unwrap() *ConsoleShare {
+	tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsoleShare is not a transactional entity")
+	}
+	cs.config.driver = tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ConsoleShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsoleShare(")
+	builder.WriteString(fmt.Sprintf("id=%v", cs.ID))
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", console_type=")
+	builder.WriteString(cs.ConsoleType)
+	builder.WriteString(", password=<sensitive>")
+	builder.WriteString(", created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(cs.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", revoked=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Revoked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsoleShares is a parsable slice of ConsoleShare.
+type ConsoleShares []*ConsoleShare
+
+func (cs ConsoleShares) config(cfg config) {
+	for _i := range cs {
+		cs[_i].config = cfg
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by entc, DO NOT EDIT.
+
+package consoleshare
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the consoleshare type in the database.
+	Label = "console_share"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldConsoleType holds the string denoting the console_type field in the database.
+	FieldConsoleType = "console_type"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRevoked holds the string denoting the revoked field in the database.
+	FieldRevoked = "revoked"
+	// EdgeConsoleShareToVmObject holds the string denoting the consolesharetovmobject edge name in mutations.
+	EdgeConsoleShareToVmObject = "ConsoleShareToVmObject"
+	// EdgeConsoleShareToUser holds the string denoting the consolesharetouser edge name in mutations.
+	EdgeConsoleShareToUser = "ConsoleShareToUser"
+	// Table holds the table name of the consoleshare in the database.
+	Table = "console_shares"
+	// ConsoleShareToVmObjectTable is the table that holds the ConsoleShareToVmObject relation/edge.
+	ConsoleShareToVmObjectTable = "console_shares"
+	// ConsoleShareToVmObjectInverseTable is the table name for the VmObject entity.
+	// It exists in this package in order to avoid circular dependency with the "vmobject" package.
+	ConsoleShareToVmObjectInverseTable = "vm_objects"
+	// ConsoleShareToVmObjectColumn is the table column denoting the ConsoleShareToVmObject relation/edge.
+	ConsoleShareToVmObjectColumn = "vm_object_vm_object_to_console_shares"
+	// ConsoleShareToUserTable is the table that holds the ConsoleShareToUser relation/edge.
+	ConsoleShareToUserTable = "console_shares"
+	// ConsoleShareToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ConsoleShareToUserInverseTable = "users"
+	// ConsoleShareToUserColumn is the table column denoting the ConsoleShareToUser relation/edge.
+	ConsoleShareToUserColumn = "user_user_to_console_shares"
+)
+
+// Columns holds all SQL columns for consoleshare fields.
+var Columns = []string{
+	FieldID,
+	FieldTokenHash,
+	FieldConsoleType,
+	FieldPassword,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldRevoked,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "console_shares"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_user_to_console_shares",
+	"vm_object_vm_object_to_console_shares",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPassword holds the default value on creation for the "password" field.
+	DefaultPassword string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultRevoked holds the default value on creation for the "revoked" field.
+	DefaultRevoked bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
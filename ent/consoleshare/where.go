@@ -0,0 +1,724 @@
+// Code generated by entc, DO NOT EDIT.
+
+package consoleshare
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// ConsoleType applies equality check predicate on the "console_type" field. It's identical to ConsoleTypeEQ.
+func ConsoleType(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPassword), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// Revoked applies equality check predicate on the "revoked" field. It's identical to RevokedEQ.
+func Revoked(v bool) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevoked), v))
+	})
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// ConsoleTypeEQ applies the EQ predicate on the "console_type" field.
+func ConsoleTypeEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeNEQ applies the NEQ predicate on the "console_type" field.
+func ConsoleTypeNEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeIn applies the In predicate on the "console_type" field.
+func ConsoleTypeIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleType), v...))
+	})
+}
+
+// ConsoleTypeNotIn applies the NotIn predicate on the "console_type" field.
+func ConsoleTypeNotIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleType), v...))
+	})
+}
+
+// ConsoleTypeGT applies the GT predicate on the "console_type" field.
+func ConsoleTypeGT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeGTE applies the GTE predicate on the "console_type" field.
+func ConsoleTypeGTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeLT applies the LT predicate on the "console_type" field.
+func ConsoleTypeLT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeLTE applies the LTE predicate on the "console_type" field.
+func ConsoleTypeLTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeContains applies the Contains predicate on the "console_type" field.
+func ConsoleTypeContains(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeHasPrefix applies the HasPrefix predicate on the "console_type" field.
+func ConsoleTypeHasPrefix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeHasSuffix applies the HasSuffix predicate on the "console_type" field.
+func ConsoleTypeHasSuffix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeEqualFold applies the EqualFold predicate on the "console_type" field.
+func ConsoleTypeEqualFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldConsoleType), v))
+	})
+}
+
+// ConsoleTypeContainsFold applies the ContainsFold predicate on the "console_type" field.
+func ConsoleTypeContainsFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldConsoleType), v))
+	})
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPassword), v...))
+	})
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPassword), v...))
+	})
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPassword), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ConsoleShare {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// RevokedEQ applies the EQ predicate on the "revoked" field.
+func RevokedEQ(v bool) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedNEQ applies the NEQ predicate on the "revoked" field.
+func RevokedNEQ(v bool) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevoked), v))
+	})
+}
+
+// HasConsoleShareToVmObject applies the HasEdge predicate on the "ConsoleShareToVmObject" edge.
+func HasConsoleShareToVmObject() predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleShareToVmObjectTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleShareToVmObjectTable, ConsoleShareToVmObjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsoleShareToVmObjectWith applies the HasEdge predicate on the "ConsoleShareToVmObject" edge with a given conditions (other predicates).
+func HasConsoleShareToVmObjectWith(preds ...predicate.VmObject) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleShareToVmObjectInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleShareToVmObjectTable, ConsoleShareToVmObjectColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConsoleShareToUser applies the HasEdge predicate on the "ConsoleShareToUser" edge.
+func HasConsoleShareToUser() predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleShareToUserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleShareToUserTable, ConsoleShareToUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsoleShareToUserWith applies the HasEdge predicate on the "ConsoleShareToUser" edge with a given conditions (other predicates).
+func HasConsoleShareToUserWith(preds ...predicate.User) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ConsoleShareToUserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConsoleShareToUserTable, ConsoleShareToUserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsoleShare) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsoleShare) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsoleShare) predicate.ConsoleShare {
+	return predicate.ConsoleShare(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
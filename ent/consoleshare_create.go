@@ -0,0 +1,447 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleShareCreate is the builder for creating a ConsoleShare entity.
+type ConsoleShareCreate struct {
+	config
+	mutation *ConsoleShareMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (csc *ConsoleShareCreate) SetTokenHash(s string) *ConsoleShareCreate {
+	csc.mutation.SetTokenHash(s)
+	return csc
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csc *ConsoleShareCreate) SetConsoleType(s string) *ConsoleShareCreate {
+	csc.mutation.SetConsoleType(s)
+	return csc
+}
+
+// SetPassword sets the "password" field.
+func (csc *ConsoleShareCreate) SetPassword(s string) *ConsoleShareCreate {
+	csc.mutation.SetPassword(s)
+	return csc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (csc *ConsoleShareCreate) SetNillablePassword(s *string) *ConsoleShareCreate {
+	if s != nil {
+		csc.SetPassword(*s)
+	}
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *ConsoleShareCreate) SetCreatedAt(t time.Time) *ConsoleShareCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *ConsoleShareCreate) SetNillableCreatedAt(t *time.Time) *ConsoleShareCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csc *ConsoleShareCreate) SetExpiresAt(t time.Time) *ConsoleShareCreate {
+	csc.mutation.SetExpiresAt(t)
+	return csc
+}
+
+// SetRevoked sets the "revoked" field.
+func (csc *ConsoleShareCreate) SetRevoked(b bool) *ConsoleShareCreate {
+	csc.mutation.SetRevoked(b)
+	return csc
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (csc *ConsoleShareCreate) SetNillableRevoked(b *bool) *ConsoleShareCreate {
+	if b != nil {
+		csc.SetRevoked(*b)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ConsoleShareCreate) SetID(u uuid.UUID) *ConsoleShareCreate {
+	csc.mutation.SetID(u)
+	return csc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (csc *ConsoleShareCreate) SetNillableID(u *uuid.UUID) *ConsoleShareCreate {
+	if u != nil {
+		csc.SetID(*u)
+	}
+	return csc
+}
+
+// SetConsoleShareToVmObjectID sets the "ConsoleShareToVmObject" edge to the VmObject entity by ID.
+func (csc *ConsoleShareCreate) SetConsoleShareToVmObjectID(id uuid.UUID) *ConsoleShareCreate {
+	csc.mutation.SetConsoleShareToVmObjectID(id)
+	return csc
+}
+
+// SetConsoleShareToVmObject sets the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (csc *ConsoleShareCreate) SetConsoleShareToVmObject(v *VmObject) *ConsoleShareCreate {
+	return csc.SetConsoleShareToVmObjectID(v.ID)
+}
+
+// SetConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID.
+func (csc *ConsoleShareCreate) SetConsoleShareToUserID(id uuid.UUID) *ConsoleShareCreate {
+	csc.mutation.SetConsoleShareToUserID(id)
+	return csc
+}
+
+// SetNillableConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID if the given value is not nil.
+func (csc *ConsoleShareCreate) SetNillableConsoleShareToUserID(id *uuid.UUID) *ConsoleShareCreate {
+	if id != nil {
+		csc = csc.SetConsoleShareToUserID(*id)
+	}
+	return csc
+}
+
+// SetConsoleShareToUser sets the "ConsoleShareToUser" edge to the User entity.
+func (csc *ConsoleShareCreate) SetConsoleShareToUser(u *User) *ConsoleShareCreate {
+	return csc.SetConsoleShareToUserID(u.ID)
+}
+
+// Mutation returns the ConsoleShareMutation object of the builder.
+func (csc *ConsoleShareCreate) Mutation() *ConsoleShareMutation {
+	return csc.mutation
+}
+
+// Save creates the ConsoleShare in the database.
+func (csc *ConsoleShareCreate) Save(ctx context.Context) (*ConsoleShare, error) {
+	var (
+		err  error
+		node *ConsoleShare
+	)
+	csc.defaults()
+	if len(csc.hooks) == 0 {
+		if err = csc.check(); err != nil {
+			return nil, err
+		}
+		node, err = csc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = csc.check(); err != nil {
+				return nil, err
+			}
+			csc.mutation = mutation
+			if node, err = csc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csc.hooks) - 1; i >= 0; i-- {
+			if csc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ConsoleShareCreate) SaveX(ctx context.Context) *ConsoleShare {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ConsoleShareCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ConsoleShareCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ConsoleShareCreate) defaults() {
+	if _, ok := csc.mutation.Password(); !ok {
+		v := consoleshare.DefaultPassword
+		csc.mutation.SetPassword(v)
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := consoleshare.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := csc.mutation.Revoked(); !ok {
+		v := consoleshare.DefaultRevoked
+		csc.mutation.SetRevoked(v)
+	}
+	if _, ok := csc.mutation.ID(); !ok {
+		v := consoleshare.DefaultID()
+		csc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ConsoleShareCreate) check() error {
+	if _, ok := csc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ConsoleShare.token_hash"`)}
+	}
+	if _, ok := csc.mutation.ConsoleType(); !ok {
+		return &ValidationError{Name: "console_type", err: errors.New(`ent: missing required field "ConsoleShare.console_type"`)}
+	}
+	if _, ok := csc.mutation.Password(); !ok {
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "ConsoleShare.password"`)}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConsoleShare.created_at"`)}
+	}
+	if _, ok := csc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ConsoleShare.expires_at"`)}
+	}
+	if _, ok := csc.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "ConsoleShare.revoked"`)}
+	}
+	if _, ok := csc.mutation.ConsoleShareToVmObjectID(); !ok {
+		return &ValidationError{Name: "ConsoleShareToVmObject", err: errors.New(`ent: missing required edge "ConsoleShare.ConsoleShareToVmObject"`)}
+	}
+	return nil
+}
+
+func (csc *ConsoleShareCreate) sqlSave(ctx context.Context) (*ConsoleShare, error) {
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (csc *ConsoleShareCreate) createSpec() (*ConsoleShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsoleShare{config: csc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: consoleshare.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consoleshare.FieldID,
+			},
+		}
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := csc.mutation.ConsoleType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldConsoleType,
+		})
+		_node.ConsoleType = value
+	}
+	if value, ok := csc.mutation.Password(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldPassword,
+		})
+		_node.Password = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := csc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := csc.mutation.Revoked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: consoleshare.FieldRevoked,
+		})
+		_node.Revoked = value
+	}
+	if nodes := csc.mutation.ConsoleShareToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToVmObjectTable,
+			Columns: []string{consoleshare.ConsoleShareToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vm_object_vm_object_to_console_shares = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := csc.mutation.ConsoleShareToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToUserTable,
+			Columns: []string{consoleshare.ConsoleShareToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_user_to_console_shares = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConsoleShareCreateBulk is the builder for creating many ConsoleShare entities in bulk.
+type ConsoleShareCreateBulk struct {
+	config
+	builders []*ConsoleShareCreate
+}
+
+// Save creates the ConsoleShare entities in the database.
+func (cscb *ConsoleShareCreateBulk) Save(ctx context.Context) ([]*ConsoleShare, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ConsoleShare, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsoleShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ConsoleShareCreateBulk) SaveX(ctx context.Context) []*ConsoleShare {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ConsoleShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ConsoleShareCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1065 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleShareQuery is the builder for querying ConsoleShare entities.
+type ConsoleShareQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ConsoleShare
+	// eager-loading edges.
+	withConsoleShareToVmObject *VmObjectQuery
+	withConsoleShareToUser     *UserQuery
+	withFKs                    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsoleShareQuery builder.
+func (csq *ConsoleShareQuery) Where(ps ...predicate.ConsoleShare) *ConsoleShareQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit adds a limit step to the query.
+func (csq *ConsoleShareQuery) Limit(limit int) *ConsoleShareQuery {
+	csq.limit = &limit
+	return csq
+}
+
+// Offset adds an offset step to the query.
+func (csq *ConsoleShareQuery) Offset(offset int) *ConsoleShareQuery {
+	csq.offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ConsoleShareQuery) Unique(unique bool) *ConsoleShareQuery {
+	csq.unique = &unique
+	return csq
+}
+
+// Order adds an order step to the query.
+func (csq *ConsoleShareQuery) Order(o ...OrderFunc) *ConsoleShareQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryConsoleShareToVmObject chains the current query on the "ConsoleShareToVmObject" edge.
+func (csq *ConsoleShareQuery) QueryConsoleShareToVmObject() *VmObjectQuery {
+	query := &VmObjectQuery{config: csq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consoleshare.Table, consoleshare.FieldID, selector),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consoleshare.ConsoleShareToVmObjectTable, consoleshare.ConsoleShareToVmObjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConsoleShareToUser chains the current query on the "ConsoleShareToUser" edge.
+func (csq *ConsoleShareQuery) QueryConsoleShareToUser() *UserQuery {
+	query := &UserQuery{config: csq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consoleshare.Table, consoleshare.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consoleshare.ConsoleShareToUserTable, consoleshare.ConsoleShareToUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ConsoleShare entity from the query.
+// Returns a *NotFoundError when no ConsoleShare was found.
+func (csq *ConsoleShareQuery) First(ctx context.Context) (*ConsoleShare, error) {
+	nodes, err := csq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consoleshare.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ConsoleShareQuery) FirstX(ctx context.Context) *ConsoleShare {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsoleShare ID from the query.
+// Returns a *NotFoundError when no ConsoleShare ID was found.
+func (csq *ConsoleShareQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consoleshare.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ConsoleShareQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsoleShare entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsoleShare entity is found.
+// Returns a *NotFoundError when no ConsoleShare entities are found.
+func (csq *ConsoleShareQuery) Only(ctx context.Context) (*ConsoleShare, error) {
+	nodes, err := csq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consoleshare.Label}
+	default:
+		return nil, &NotSingularError{consoleshare.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ConsoleShareQuery) OnlyX(ctx context.Context) *ConsoleShare {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsoleShare ID in the query.
+// Returns a *NotSingularError when more than one ConsoleShare ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ConsoleShareQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = &NotSingularError{consoleshare.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ConsoleShareQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsoleShares.
+func (csq *ConsoleShareQuery) All(ctx context.Context) ([]*ConsoleShare, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return csq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ConsoleShareQuery) AllX(ctx context.Context) []*ConsoleShare {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsoleShare IDs.
+func (csq *ConsoleShareQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := csq.Select(consoleshare.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ConsoleShareQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ConsoleShareQuery) Count(ctx context.Context) (int, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return csq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ConsoleShareQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ConsoleShareQuery) Exist(ctx context.Context) (bool, error) {
+	if err := csq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return csq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ConsoleShareQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsoleShareQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ConsoleShareQuery) Clone() *ConsoleShareQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ConsoleShareQuery{
+		config:                     csq.config,
+		limit:                      csq.limit,
+		offset:                     csq.offset,
+		order:                      append([]OrderFunc{}, csq.order...),
+		predicates:                 append([]predicate.ConsoleShare{}, csq.predicates...),
+		withConsoleShareToVmObject: csq.withConsoleShareToVmObject.Clone(),
+		withConsoleShareToUser:     csq.withConsoleShareToUser.Clone(),
+		// clone intermediate query.
+		sql:    csq.sql.Clone(),
+		path:   csq.path,
+		unique: csq.unique,
+	}
+}
+
+// WithConsoleShareToVmObject tells the query-builder to eager-load the nodes that are connected to
+// the "ConsoleShareToVmObject" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ConsoleShareQuery) WithConsoleShareToVmObject(opts ...func(*VmObjectQuery)) *ConsoleShareQuery {
+	query := &VmObjectQuery{config: csq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withConsoleShareToVmObject = query
+	return csq
+}
+
+// WithConsoleShareToUser tells the query-builder to eager-load the nodes that are connected to
+// the "ConsoleShareToUser" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ConsoleShareQuery) WithConsoleShareToUser(opts ...func(*UserQuery)) *ConsoleShareQuery {
+	query := &UserQuery{config: csq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withConsoleShareToUser = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash string `json:"token_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsoleShare.Query().
+//		GroupBy(consoleshare.FieldTokenHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *ConsoleShareQuery) GroupBy(field string, fields ...string) *ConsoleShareGroupBy {
+	group := &ConsoleShareGroupBy{config: csq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return csq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash string `json:"token_hash,omitempty"`
+//	}
+//
+//	client.ConsoleShare.Query().
+//		Select(consoleshare.FieldTokenHash).
+//		Scan(ctx, &v)
+func (csq *ConsoleShareQuery) Select(fields ...string) *ConsoleShareSelect {
+	csq.fields = append(csq.fields, fields...)
+	return &ConsoleShareSelect{ConsoleShareQuery: csq}
+}
+
+func (csq *ConsoleShareQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range csq.fields {
+		if !consoleshare.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ConsoleShareQuery) sqlAll(ctx context.Context) ([]*ConsoleShare, error) {
+	var (
+		nodes       = []*ConsoleShare{}
+		withFKs     = csq.withFKs
+		_spec       = csq.querySpec()
+		loadedTypes = [2]bool{
+			csq.withConsoleShareToVmObject != nil,
+			csq.withConsoleShareToUser != nil,
+		}
+	)
+	if csq.withConsoleShareToVmObject != nil || csq.withConsoleShareToUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, consoleshare.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ConsoleShare{config: csq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := csq.withConsoleShareToVmObject; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*ConsoleShare)
+		for i := range nodes {
+			if nodes[i].vm_object_vm_object_to_console_shares == nil {
+				continue
+			}
+			fk := *nodes[i].vm_object_vm_object_to_console_shares
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(vmobject.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_console_shares" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ConsoleShareToVmObject = n
+			}
+		}
+	}
+
+	if query := csq.withConsoleShareToUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*ConsoleShare)
+		for i := range nodes {
+			if nodes[i].user_user_to_console_shares == nil {
+				continue
+			}
+			fk := *nodes[i].user_user_to_console_shares
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_console_shares" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ConsoleShareToUser = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (csq *ConsoleShareQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.fields
+	if len(csq.fields) > 0 {
+		_spec.Unique = csq.unique != nil && *csq.unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ConsoleShareQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := csq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (csq *ConsoleShareQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consoleshare.Table,
+			Columns: consoleshare.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consoleshare.FieldID,
+			},
+		},
+		From:   csq.sql,
+		Unique: true,
+	}
+	if unique := csq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := csq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consoleshare.FieldID)
+		for i := range fields {
+			if fields[i] != consoleshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ConsoleShareQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(consoleshare.Table)
+	columns := csq.fields
+	if len(columns) == 0 {
+		columns = consoleshare.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.unique != nil && *csq.unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConsoleShareGroupBy is the group-by builder for ConsoleShare entities.
+type ConsoleShareGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ConsoleShareGroupBy) Aggregate(fns ...AggregateFunc) *ConsoleShareGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (csgb *ConsoleShareGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := csgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	csgb.sql = query
+	return csgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := csgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) StringsX(ctx context.Context) []string {
+	v, err := csgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = csgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) StringX(ctx context.Context) string {
+	v, err := csgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) IntsX(ctx context.Context) []int {
+	v, err := csgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = csgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) IntX(ctx context.Context) int {
+	v, err := csgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := csgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = csgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := csgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(csgb.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := csgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := csgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (csgb *ConsoleShareGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = csgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (csgb *ConsoleShareGroupBy) BoolX(ctx context.Context) bool {
+	v, err := csgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (csgb *ConsoleShareGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range csgb.fields {
+		if !consoleshare.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := csgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (csgb *ConsoleShareGroupBy) sqlQuery() *sql.Selector {
+	selector := csgb.sql.Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(csgb.fields)+len(csgb.fns))
+		for _, f := range csgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(csgb.fields...)...)
+}
+
+// ConsoleShareSelect is the builder for selecting fields of ConsoleShare entities.
+type ConsoleShareSelect struct {
+	*ConsoleShareQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ConsoleShareSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	css.sql = css.ConsoleShareQuery.sqlQuery(ctx)
+	return css.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (css *ConsoleShareSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := css.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (css *ConsoleShareSelect) StringsX(ctx context.Context) []string {
+	v, err := css.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = css.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (css *ConsoleShareSelect) StringX(ctx context.Context) string {
+	v, err := css.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (css *ConsoleShareSelect) IntsX(ctx context.Context) []int {
+	v, err := css.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = css.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (css *ConsoleShareSelect) IntX(ctx context.Context) int {
+	v, err := css.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (css *ConsoleShareSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := css.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = css.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (css *ConsoleShareSelect) Float64X(ctx context.Context) float64 {
+	v, err := css.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(css.fields) > 1 {
+		return nil, errors.New("ent: ConsoleShareSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := css.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (css *ConsoleShareSelect) BoolsX(ctx context.Context) []bool {
+	v, err := css.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (css *ConsoleShareSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = css.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{consoleshare.Label}
+	default:
+		err = fmt.Errorf("ent: ConsoleShareSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (css *ConsoleShareSelect) BoolX(ctx context.Context) bool {
+	v, err := css.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (css *ConsoleShareSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := css.sql.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,699 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// ConsoleShareUpdate is the builder for updating ConsoleShare entities.
+type ConsoleShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsoleShareMutation
+}
+
+// Where appends a list predicates to the ConsoleShareUpdate builder.
+func (csu *ConsoleShareUpdate) Where(ps ...predicate.ConsoleShare) *ConsoleShareUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (csu *ConsoleShareUpdate) SetTokenHash(s string) *ConsoleShareUpdate {
+	csu.mutation.SetTokenHash(s)
+	return csu
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csu *ConsoleShareUpdate) SetConsoleType(s string) *ConsoleShareUpdate {
+	csu.mutation.SetConsoleType(s)
+	return csu
+}
+
+// SetPassword sets the "password" field.
+func (csu *ConsoleShareUpdate) SetPassword(s string) *ConsoleShareUpdate {
+	csu.mutation.SetPassword(s)
+	return csu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (csu *ConsoleShareUpdate) SetNillablePassword(s *string) *ConsoleShareUpdate {
+	if s != nil {
+		csu.SetPassword(*s)
+	}
+	return csu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csu *ConsoleShareUpdate) SetCreatedAt(t time.Time) *ConsoleShareUpdate {
+	csu.mutation.SetCreatedAt(t)
+	return csu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csu *ConsoleShareUpdate) SetNillableCreatedAt(t *time.Time) *ConsoleShareUpdate {
+	if t != nil {
+		csu.SetCreatedAt(*t)
+	}
+	return csu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csu *ConsoleShareUpdate) SetExpiresAt(t time.Time) *ConsoleShareUpdate {
+	csu.mutation.SetExpiresAt(t)
+	return csu
+}
+
+// SetRevoked sets the "revoked" field.
+func (csu *ConsoleShareUpdate) SetRevoked(b bool) *ConsoleShareUpdate {
+	csu.mutation.SetRevoked(b)
+	return csu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (csu *ConsoleShareUpdate) SetNillableRevoked(b *bool) *ConsoleShareUpdate {
+	if b != nil {
+		csu.SetRevoked(*b)
+	}
+	return csu
+}
+
+// SetConsoleShareToVmObjectID sets the "ConsoleShareToVmObject" edge to the VmObject entity by ID.
+func (csu *ConsoleShareUpdate) SetConsoleShareToVmObjectID(id uuid.UUID) *ConsoleShareUpdate {
+	csu.mutation.SetConsoleShareToVmObjectID(id)
+	return csu
+}
+
+// SetConsoleShareToVmObject sets the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (csu *ConsoleShareUpdate) SetConsoleShareToVmObject(v *VmObject) *ConsoleShareUpdate {
+	return csu.SetConsoleShareToVmObjectID(v.ID)
+}
+
+// SetConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID.
+func (csu *ConsoleShareUpdate) SetConsoleShareToUserID(id uuid.UUID) *ConsoleShareUpdate {
+	csu.mutation.SetConsoleShareToUserID(id)
+	return csu
+}
+
+// SetNillableConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID if the given value is not nil.
+func (csu *ConsoleShareUpdate) SetNillableConsoleShareToUserID(id *uuid.UUID) *ConsoleShareUpdate {
+	if id != nil {
+		csu = csu.SetConsoleShareToUserID(*id)
+	}
+	return csu
+}
+
+// SetConsoleShareToUser sets the "ConsoleShareToUser" edge to the User entity.
+func (csu *ConsoleShareUpdate) SetConsoleShareToUser(u *User) *ConsoleShareUpdate {
+	return csu.SetConsoleShareToUserID(u.ID)
+}
+
+// Mutation returns the ConsoleShareMutation object of the builder.
+func (csu *ConsoleShareUpdate) Mutation() *ConsoleShareMutation {
+	return csu.mutation
+}
+
+// ClearConsoleShareToVmObject clears the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (csu *ConsoleShareUpdate) ClearConsoleShareToVmObject() *ConsoleShareUpdate {
+	csu.mutation.ClearConsoleShareToVmObject()
+	return csu
+}
+
+// ClearConsoleShareToUser clears the "ConsoleShareToUser" edge to the User entity.
+func (csu *ConsoleShareUpdate) ClearConsoleShareToUser() *ConsoleShareUpdate {
+	csu.mutation.ClearConsoleShareToUser()
+	return csu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ConsoleShareUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(csu.hooks) == 0 {
+		if err = csu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = csu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = csu.check(); err != nil {
+				return 0, err
+			}
+			csu.mutation = mutation
+			affected, err = csu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(csu.hooks) - 1; i >= 0; i-- {
+			if csu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ConsoleShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ConsoleShareUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ConsoleShareUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *ConsoleShareUpdate) check() error {
+	if _, ok := csu.mutation.ConsoleShareToVmObjectID(); csu.mutation.ConsoleShareToVmObjectCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ConsoleShare.ConsoleShareToVmObject"`)
+	}
+	return nil
+}
+
+func (csu *ConsoleShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consoleshare.Table,
+			Columns: consoleshare.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consoleshare.FieldID,
+			},
+		},
+	}
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldTokenHash,
+		})
+	}
+	if value, ok := csu.mutation.ConsoleType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldConsoleType,
+		})
+	}
+	if value, ok := csu.mutation.Password(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldPassword,
+		})
+	}
+	if value, ok := csu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldCreatedAt,
+		})
+	}
+	if value, ok := csu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldExpiresAt,
+		})
+	}
+	if value, ok := csu.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: consoleshare.FieldRevoked,
+		})
+	}
+	if csu.mutation.ConsoleShareToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToVmObjectTable,
+			Columns: []string{consoleshare.ConsoleShareToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ConsoleShareToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToVmObjectTable,
+			Columns: []string{consoleshare.ConsoleShareToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csu.mutation.ConsoleShareToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToUserTable,
+			Columns: []string{consoleshare.ConsoleShareToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ConsoleShareToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToUserTable,
+			Columns: []string{consoleshare.ConsoleShareToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consoleshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ConsoleShareUpdateOne is the builder for updating a single ConsoleShare entity.
+type ConsoleShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsoleShareMutation
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (csuo *ConsoleShareUpdateOne) SetTokenHash(s string) *ConsoleShareUpdateOne {
+	csuo.mutation.SetTokenHash(s)
+	return csuo
+}
+
+// SetConsoleType sets the "console_type" field.
+func (csuo *ConsoleShareUpdateOne) SetConsoleType(s string) *ConsoleShareUpdateOne {
+	csuo.mutation.SetConsoleType(s)
+	return csuo
+}
+
+// SetPassword sets the "password" field.
+func (csuo *ConsoleShareUpdateOne) SetPassword(s string) *ConsoleShareUpdateOne {
+	csuo.mutation.SetPassword(s)
+	return csuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (csuo *ConsoleShareUpdateOne) SetNillablePassword(s *string) *ConsoleShareUpdateOne {
+	if s != nil {
+		csuo.SetPassword(*s)
+	}
+	return csuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csuo *ConsoleShareUpdateOne) SetCreatedAt(t time.Time) *ConsoleShareUpdateOne {
+	csuo.mutation.SetCreatedAt(t)
+	return csuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csuo *ConsoleShareUpdateOne) SetNillableCreatedAt(t *time.Time) *ConsoleShareUpdateOne {
+	if t != nil {
+		csuo.SetCreatedAt(*t)
+	}
+	return csuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csuo *ConsoleShareUpdateOne) SetExpiresAt(t time.Time) *ConsoleShareUpdateOne {
+	csuo.mutation.SetExpiresAt(t)
+	return csuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (csuo *ConsoleShareUpdateOne) SetRevoked(b bool) *ConsoleShareUpdateOne {
+	csuo.mutation.SetRevoked(b)
+	return csuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (csuo *ConsoleShareUpdateOne) SetNillableRevoked(b *bool) *ConsoleShareUpdateOne {
+	if b != nil {
+		csuo.SetRevoked(*b)
+	}
+	return csuo
+}
+
+// SetConsoleShareToVmObjectID sets the "ConsoleShareToVmObject" edge to the VmObject entity by ID.
+func (csuo *ConsoleShareUpdateOne) SetConsoleShareToVmObjectID(id uuid.UUID) *ConsoleShareUpdateOne {
+	csuo.mutation.SetConsoleShareToVmObjectID(id)
+	return csuo
+}
+
+// SetConsoleShareToVmObject sets the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (csuo *ConsoleShareUpdateOne) SetConsoleShareToVmObject(v *VmObject) *ConsoleShareUpdateOne {
+	return csuo.SetConsoleShareToVmObjectID(v.ID)
+}
+
+// SetConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID.
+func (csuo *ConsoleShareUpdateOne) SetConsoleShareToUserID(id uuid.UUID) *ConsoleShareUpdateOne {
+	csuo.mutation.SetConsoleShareToUserID(id)
+	return csuo
+}
+
+// SetNillableConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by ID if the given value is not nil.
+func (csuo *ConsoleShareUpdateOne) SetNillableConsoleShareToUserID(id *uuid.UUID) *ConsoleShareUpdateOne {
+	if id != nil {
+		csuo = csuo.SetConsoleShareToUserID(*id)
+	}
+	return csuo
+}
+
+// SetConsoleShareToUser sets the "ConsoleShareToUser" edge to the User entity.
+func (csuo *ConsoleShareUpdateOne) SetConsoleShareToUser(u *User) *ConsoleShareUpdateOne {
+	return csuo.SetConsoleShareToUserID(u.ID)
+}
+
+// Mutation returns the ConsoleShareMutation object of the builder.
+func (csuo *ConsoleShareUpdateOne) Mutation() *ConsoleShareMutation {
+	return csuo.mutation
+}
+
+// ClearConsoleShareToVmObject clears the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (csuo *ConsoleShareUpdateOne) ClearConsoleShareToVmObject() *ConsoleShareUpdateOne {
+	csuo.mutation.ClearConsoleShareToVmObject()
+	return csuo
+}
+
+// ClearConsoleShareToUser clears the "ConsoleShareToUser" edge to the User entity.
+func (csuo *ConsoleShareUpdateOne) ClearConsoleShareToUser() *ConsoleShareUpdateOne {
+	csuo.mutation.ClearConsoleShareToUser()
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ConsoleShareUpdateOne) Select(field string, fields ...string) *ConsoleShareUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ConsoleShare entity.
+func (csuo *ConsoleShareUpdateOne) Save(ctx context.Context) (*ConsoleShare, error) {
+	var (
+		err  error
+		node *ConsoleShare
+	)
+	if len(csuo.hooks) == 0 {
+		if err = csuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = csuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConsoleShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = csuo.check(); err != nil {
+				return nil, err
+			}
+			csuo.mutation = mutation
+			node, err = csuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(csuo.hooks) - 1; i >= 0; i-- {
+			if csuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = csuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, csuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ConsoleShareUpdateOne) SaveX(ctx context.Context) *ConsoleShare {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ConsoleShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ConsoleShareUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *ConsoleShareUpdateOne) check() error {
+	if _, ok := csuo.mutation.ConsoleShareToVmObjectID(); csuo.mutation.ConsoleShareToVmObjectCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ConsoleShare.ConsoleShareToVmObject"`)
+	}
+	return nil
+}
+
+func (csuo *ConsoleShareUpdateOne) sqlSave(ctx context.Context) (_node *ConsoleShare, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   consoleshare.Table,
+			Columns: consoleshare.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: consoleshare.FieldID,
+			},
+		},
+	}
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsoleShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consoleshare.FieldID)
+		for _, f := range fields {
+			if !consoleshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consoleshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldTokenHash,
+		})
+	}
+	if value, ok := csuo.mutation.ConsoleType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldConsoleType,
+		})
+	}
+	if value, ok := csuo.mutation.Password(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: consoleshare.FieldPassword,
+		})
+	}
+	if value, ok := csuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldCreatedAt,
+		})
+	}
+	if value, ok := csuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: consoleshare.FieldExpiresAt,
+		})
+	}
+	if value, ok := csuo.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: consoleshare.FieldRevoked,
+		})
+	}
+	if csuo.mutation.ConsoleShareToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToVmObjectTable,
+			Columns: []string{consoleshare.ConsoleShareToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ConsoleShareToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToVmObjectTable,
+			Columns: []string{consoleshare.ConsoleShareToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if csuo.mutation.ConsoleShareToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToUserTable,
+			Columns: []string{consoleshare.ConsoleShareToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ConsoleShareToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consoleshare.ConsoleShareToUserTable,
+			Columns: []string{consoleshare.ConsoleShareToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConsoleShare{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consoleshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
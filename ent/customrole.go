@@ -0,0 +1,154 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/google/uuid"
+)
+
+// CustomRole is the model entity for the CustomRole schema.
+type CustomRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	// [REQUIRED] The display name for the role.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	// [OPTIONAL] What the role is for.
+	Description string `json:"description,omitempty"`
+	// Permissions holds the value of the "permissions" field.
+	// [OPTIONAL] The permissions granted to users with this role (eg. "vm:power"), on top of the permissions of their built-in role.
+	Permissions []string `json:"permissions,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomRoleQuery when eager-loading is set.
+	Edges CustomRoleEdges `json:"edges"`
+}
+
+// CustomRoleEdges holds the relations/edges for other nodes in the graph.
+type CustomRoleEdges struct {
+	// CustomRoleToUsers holds the value of the CustomRoleToUsers edge.
+	CustomRoleToUsers []*User `json:"CustomRoleToUsers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CustomRoleToUsersOrErr returns the CustomRoleToUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomRoleEdges) CustomRoleToUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.CustomRoleToUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "CustomRoleToUsers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomRole) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customrole.FieldPermissions:
+			values[i] = new([]byte)
+		case customrole.FieldName, customrole.FieldDescription:
+			values[i] = new(sql.NullString)
+		case customrole.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CustomRole", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomRole fields.
+func (cr *CustomRole) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customrole.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cr.ID = *value
+			}
+		case customrole.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cr.Name = value.String
+			}
+		case customrole.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				cr.Description = value.String
+			}
+		case customrole.FieldPermissions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cr.Permissions); err != nil {
+					return fmt.Errorf("unmarshal field permissions: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCustomRoleToUsers queries the "CustomRoleToUsers" edge of the CustomRole entity.
+func (cr *CustomRole) QueryCustomRoleToUsers() *UserQuery {
+	return (&CustomRoleClient{config: cr.config}).QueryCustomRoleToUsers(cr)
+}
+
+// Update returns a builder for updating this CustomRole.
+// Note that you need to call CustomRole.Unwrap() before calling this method if this CustomRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CustomRole) Update() *CustomRoleUpdateOne {
+	return (&CustomRoleClient{config: cr.config}).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CustomRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CustomRole) Unwrap() *CustomRole {
+	tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomRole is not a transactional entity")
+	}
+	cr.config.driver = tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CustomRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomRole(")
+	builder.WriteString(fmt.Sprintf("id=%v", cr.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(cr.Name)
+	builder.WriteString(", description=")
+	builder.WriteString(cr.Description)
+	builder.WriteString(", permissions=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Permissions))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomRoles is a parsable slice of CustomRole.
+type CustomRoles []*CustomRole
+
+func (cr CustomRoles) config(cfg config) {
+	for _i := range cr {
+		cr[_i].config = cfg
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by entc, DO NOT EDIT.
+
+package customrole
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the customrole type in the database.
+	Label = "custom_role"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldPermissions holds the string denoting the permissions field in the database.
+	FieldPermissions = "permissions"
+	// EdgeCustomRoleToUsers holds the string denoting the customroletousers edge name in mutations.
+	EdgeCustomRoleToUsers = "CustomRoleToUsers"
+	// Table holds the table name of the customrole in the database.
+	Table = "custom_roles"
+	// CustomRoleToUsersTable is the table that holds the CustomRoleToUsers relation/edge.
+	CustomRoleToUsersTable = "users"
+	// CustomRoleToUsersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CustomRoleToUsersInverseTable = "users"
+	// CustomRoleToUsersColumn is the table column denoting the CustomRoleToUsers relation/edge.
+	CustomRoleToUsersColumn = "custom_role_custom_role_to_users"
+)
+
+// Columns holds all SQL columns for customrole fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldPermissions,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
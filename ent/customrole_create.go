@@ -0,0 +1,328 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// CustomRoleCreate is the builder for creating a CustomRole entity.
+type CustomRoleCreate struct {
+	config
+	mutation *CustomRoleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (crc *CustomRoleCreate) SetName(s string) *CustomRoleCreate {
+	crc.mutation.SetName(s)
+	return crc
+}
+
+// SetDescription sets the "description" field.
+func (crc *CustomRoleCreate) SetDescription(s string) *CustomRoleCreate {
+	crc.mutation.SetDescription(s)
+	return crc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (crc *CustomRoleCreate) SetNillableDescription(s *string) *CustomRoleCreate {
+	if s != nil {
+		crc.SetDescription(*s)
+	}
+	return crc
+}
+
+// SetPermissions sets the "permissions" field.
+func (crc *CustomRoleCreate) SetPermissions(s []string) *CustomRoleCreate {
+	crc.mutation.SetPermissions(s)
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *CustomRoleCreate) SetID(u uuid.UUID) *CustomRoleCreate {
+	crc.mutation.SetID(u)
+	return crc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (crc *CustomRoleCreate) SetNillableID(u *uuid.UUID) *CustomRoleCreate {
+	if u != nil {
+		crc.SetID(*u)
+	}
+	return crc
+}
+
+// AddCustomRoleToUserIDs adds the "CustomRoleToUsers" edge to the User entity by IDs.
+func (crc *CustomRoleCreate) AddCustomRoleToUserIDs(ids ...uuid.UUID) *CustomRoleCreate {
+	crc.mutation.AddCustomRoleToUserIDs(ids...)
+	return crc
+}
+
+// AddCustomRoleToUsers adds the "CustomRoleToUsers" edges to the User entity.
+func (crc *CustomRoleCreate) AddCustomRoleToUsers(u ...*User) *CustomRoleCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return crc.AddCustomRoleToUserIDs(ids...)
+}
+
+// Mutation returns the CustomRoleMutation object of the builder.
+func (crc *CustomRoleCreate) Mutation() *CustomRoleMutation {
+	return crc.mutation
+}
+
+// Save creates the CustomRole in the database.
+func (crc *CustomRoleCreate) Save(ctx context.Context) (*CustomRole, error) {
+	var (
+		err  error
+		node *CustomRole
+	)
+	crc.defaults()
+	if len(crc.hooks) == 0 {
+		if err = crc.check(); err != nil {
+			return nil, err
+		}
+		node, err = crc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomRoleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = crc.check(); err != nil {
+				return nil, err
+			}
+			crc.mutation = mutation
+			if node, err = crc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(crc.hooks) - 1; i >= 0; i-- {
+			if crc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = crc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, crc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CustomRoleCreate) SaveX(ctx context.Context) *CustomRole {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CustomRoleCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CustomRoleCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CustomRoleCreate) defaults() {
+	if _, ok := crc.mutation.Description(); !ok {
+		v := customrole.DefaultDescription
+		crc.mutation.SetDescription(v)
+	}
+	if _, ok := crc.mutation.ID(); !ok {
+		v := customrole.DefaultID()
+		crc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CustomRoleCreate) check() error {
+	if _, ok := crc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CustomRole.name"`)}
+	}
+	if _, ok := crc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "CustomRole.description"`)}
+	}
+	return nil
+}
+
+func (crc *CustomRoleCreate) sqlSave(ctx context.Context) (*CustomRole, error) {
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (crc *CustomRoleCreate) createSpec() (*CustomRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomRole{config: crc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: customrole.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: customrole.FieldID,
+			},
+		}
+	)
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := crc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := crc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := crc.mutation.Permissions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customrole.FieldPermissions,
+		})
+		_node.Permissions = value
+	}
+	if nodes := crc.mutation.CustomRoleToUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomRoleCreateBulk is the builder for creating many CustomRole entities in bulk.
+type CustomRoleCreateBulk struct {
+	config
+	builders []*CustomRoleCreate
+}
+
+// Save creates the CustomRole entities in the database.
+func (crcb *CustomRoleCreateBulk) Save(ctx context.Context) ([]*CustomRole, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CustomRole, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CustomRoleCreateBulk) SaveX(ctx context.Context) []*CustomRole {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CustomRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CustomRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
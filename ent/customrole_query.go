@@ -0,0 +1,992 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// CustomRoleQuery is the builder for querying CustomRole entities.
+type CustomRoleQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CustomRole
+	// eager-loading edges.
+	withCustomRoleToUsers *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomRoleQuery builder.
+func (crq *CustomRoleQuery) Where(ps ...predicate.CustomRole) *CustomRoleQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit adds a limit step to the query.
+func (crq *CustomRoleQuery) Limit(limit int) *CustomRoleQuery {
+	crq.limit = &limit
+	return crq
+}
+
+// Offset adds an offset step to the query.
+func (crq *CustomRoleQuery) Offset(offset int) *CustomRoleQuery {
+	crq.offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *CustomRoleQuery) Unique(unique bool) *CustomRoleQuery {
+	crq.unique = &unique
+	return crq
+}
+
+// Order adds an order step to the query.
+func (crq *CustomRoleQuery) Order(o ...OrderFunc) *CustomRoleQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// QueryCustomRoleToUsers chains the current query on the "CustomRoleToUsers" edge.
+func (crq *CustomRoleQuery) QueryCustomRoleToUsers() *UserQuery {
+	query := &UserQuery{config: crq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customrole.Table, customrole.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customrole.CustomRoleToUsersTable, customrole.CustomRoleToUsersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CustomRole entity from the query.
+// Returns a *NotFoundError when no CustomRole was found.
+func (crq *CustomRoleQuery) First(ctx context.Context) (*CustomRole, error) {
+	nodes, err := crq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customrole.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CustomRoleQuery) FirstX(ctx context.Context) *CustomRole {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomRole ID from the query.
+// Returns a *NotFoundError when no CustomRole ID was found.
+func (crq *CustomRoleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customrole.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *CustomRoleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomRole entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomRole entity is found.
+// Returns a *NotFoundError when no CustomRole entities are found.
+func (crq *CustomRoleQuery) Only(ctx context.Context) (*CustomRole, error) {
+	nodes, err := crq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customrole.Label}
+	default:
+		return nil, &NotSingularError{customrole.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CustomRoleQuery) OnlyX(ctx context.Context) *CustomRole {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomRole ID in the query.
+// Returns a *NotSingularError when more than one CustomRole ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *CustomRoleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = &NotSingularError{customrole.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CustomRoleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomRoles.
+func (crq *CustomRoleQuery) All(ctx context.Context) ([]*CustomRole, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return crq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CustomRoleQuery) AllX(ctx context.Context) []*CustomRole {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomRole IDs.
+func (crq *CustomRoleQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := crq.Select(customrole.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CustomRoleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CustomRoleQuery) Count(ctx context.Context) (int, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return crq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CustomRoleQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CustomRoleQuery) Exist(ctx context.Context) (bool, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return crq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CustomRoleQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomRoleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CustomRoleQuery) Clone() *CustomRoleQuery {
+	if crq == nil {
+		return nil
+	}
+	return &CustomRoleQuery{
+		config:                crq.config,
+		limit:                 crq.limit,
+		offset:                crq.offset,
+		order:                 append([]OrderFunc{}, crq.order...),
+		predicates:            append([]predicate.CustomRole{}, crq.predicates...),
+		withCustomRoleToUsers: crq.withCustomRoleToUsers.Clone(),
+		// clone intermediate query.
+		sql:    crq.sql.Clone(),
+		path:   crq.path,
+		unique: crq.unique,
+	}
+}
+
+// WithCustomRoleToUsers tells the query-builder to eager-load the nodes that are connected to
+// the "CustomRoleToUsers" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *CustomRoleQuery) WithCustomRoleToUsers(opts ...func(*UserQuery)) *CustomRoleQuery {
+	query := &UserQuery{config: crq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withCustomRoleToUsers = query
+	return crq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomRole.Query().
+//		GroupBy(customrole.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (crq *CustomRoleQuery) GroupBy(field string, fields ...string) *CustomRoleGroupBy {
+	group := &CustomRoleGroupBy{config: crq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return crq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.CustomRole.Query().
+//		Select(customrole.FieldName).
+//		Scan(ctx, &v)
+func (crq *CustomRoleQuery) Select(fields ...string) *CustomRoleSelect {
+	crq.fields = append(crq.fields, fields...)
+	return &CustomRoleSelect{CustomRoleQuery: crq}
+}
+
+func (crq *CustomRoleQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range crq.fields {
+		if !customrole.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	return nil
+}
+
+func (crq *CustomRoleQuery) sqlAll(ctx context.Context) ([]*CustomRole, error) {
+	var (
+		nodes       = []*CustomRole{}
+		_spec       = crq.querySpec()
+		loadedTypes = [1]bool{
+			crq.withCustomRoleToUsers != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CustomRole{config: crq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := crq.withCustomRoleToUsers; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*CustomRole)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.CustomRoleToUsers = []*User{}
+		}
+		query.withFKs = true
+		query.Where(predicate.User(func(s *sql.Selector) {
+			s.Where(sql.InValues(customrole.CustomRoleToUsersColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.custom_role_custom_role_to_users
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "custom_role_custom_role_to_users" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "custom_role_custom_role_to_users" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.CustomRoleToUsers = append(node.Edges.CustomRoleToUsers, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (crq *CustomRoleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	_spec.Node.Columns = crq.fields
+	if len(crq.fields) > 0 {
+		_spec.Unique = crq.unique != nil && *crq.unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CustomRoleQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := crq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (crq *CustomRoleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customrole.Table,
+			Columns: customrole.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: customrole.FieldID,
+			},
+		},
+		From:   crq.sql,
+		Unique: true,
+	}
+	if unique := crq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := crq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customrole.FieldID)
+		for i := range fields {
+			if fields[i] != customrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CustomRoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(customrole.Table)
+	columns := crq.fields
+	if len(columns) == 0 {
+		columns = customrole.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.unique != nil && *crq.unique {
+		selector.Distinct()
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomRoleGroupBy is the group-by builder for CustomRole entities.
+type CustomRoleGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CustomRoleGroupBy) Aggregate(fns ...AggregateFunc) *CustomRoleGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (crgb *CustomRoleGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := crgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	crgb.sql = query
+	return crgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := crgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) StringsX(ctx context.Context) []string {
+	v, err := crgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = crgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) StringX(ctx context.Context) string {
+	v, err := crgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) IntsX(ctx context.Context) []int {
+	v, err := crgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = crgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) IntX(ctx context.Context) int {
+	v, err := crgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := crgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = crgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := crgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := crgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (crgb *CustomRoleGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = crgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (crgb *CustomRoleGroupBy) BoolX(ctx context.Context) bool {
+	v, err := crgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (crgb *CustomRoleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range crgb.fields {
+		if !customrole.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := crgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (crgb *CustomRoleGroupBy) sqlQuery() *sql.Selector {
+	selector := crgb.sql.Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(crgb.fields)+len(crgb.fns))
+		for _, f := range crgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(crgb.fields...)...)
+}
+
+// CustomRoleSelect is the builder for selecting fields of CustomRole entities.
+type CustomRoleSelect struct {
+	*CustomRoleQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *CustomRoleSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	crs.sql = crs.CustomRoleQuery.sqlQuery(ctx)
+	return crs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (crs *CustomRoleSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := crs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (crs *CustomRoleSelect) StringsX(ctx context.Context) []string {
+	v, err := crs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = crs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (crs *CustomRoleSelect) StringX(ctx context.Context) string {
+	v, err := crs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (crs *CustomRoleSelect) IntsX(ctx context.Context) []int {
+	v, err := crs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = crs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (crs *CustomRoleSelect) IntX(ctx context.Context) int {
+	v, err := crs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (crs *CustomRoleSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := crs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = crs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (crs *CustomRoleSelect) Float64X(ctx context.Context) float64 {
+	v, err := crs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CustomRoleSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (crs *CustomRoleSelect) BoolsX(ctx context.Context) []bool {
+	v, err := crs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (crs *CustomRoleSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = crs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customrole.Label}
+	default:
+		err = fmt.Errorf("ent: CustomRoleSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (crs *CustomRoleSelect) BoolX(ctx context.Context) bool {
+	v, err := crs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (crs *CustomRoleSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := crs.sql.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
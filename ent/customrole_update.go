@@ -0,0 +1,539 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// CustomRoleUpdate is the builder for updating CustomRole entities.
+type CustomRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomRoleMutation
+}
+
+// Where appends a list predicates to the CustomRoleUpdate builder.
+func (cru *CustomRoleUpdate) Where(ps ...predicate.CustomRole) *CustomRoleUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetName sets the "name" field.
+func (cru *CustomRoleUpdate) SetName(s string) *CustomRoleUpdate {
+	cru.mutation.SetName(s)
+	return cru
+}
+
+// SetDescription sets the "description" field.
+func (cru *CustomRoleUpdate) SetDescription(s string) *CustomRoleUpdate {
+	cru.mutation.SetDescription(s)
+	return cru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cru *CustomRoleUpdate) SetNillableDescription(s *string) *CustomRoleUpdate {
+	if s != nil {
+		cru.SetDescription(*s)
+	}
+	return cru
+}
+
+// SetPermissions sets the "permissions" field.
+func (cru *CustomRoleUpdate) SetPermissions(s []string) *CustomRoleUpdate {
+	cru.mutation.SetPermissions(s)
+	return cru
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (cru *CustomRoleUpdate) ClearPermissions() *CustomRoleUpdate {
+	cru.mutation.ClearPermissions()
+	return cru
+}
+
+// AddCustomRoleToUserIDs adds the "CustomRoleToUsers" edge to the User entity by IDs.
+func (cru *CustomRoleUpdate) AddCustomRoleToUserIDs(ids ...uuid.UUID) *CustomRoleUpdate {
+	cru.mutation.AddCustomRoleToUserIDs(ids...)
+	return cru
+}
+
+// AddCustomRoleToUsers adds the "CustomRoleToUsers" edges to the User entity.
+func (cru *CustomRoleUpdate) AddCustomRoleToUsers(u ...*User) *CustomRoleUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cru.AddCustomRoleToUserIDs(ids...)
+}
+
+// Mutation returns the CustomRoleMutation object of the builder.
+func (cru *CustomRoleUpdate) Mutation() *CustomRoleMutation {
+	return cru.mutation
+}
+
+// ClearCustomRoleToUsers clears all "CustomRoleToUsers" edges to the User entity.
+func (cru *CustomRoleUpdate) ClearCustomRoleToUsers() *CustomRoleUpdate {
+	cru.mutation.ClearCustomRoleToUsers()
+	return cru
+}
+
+// RemoveCustomRoleToUserIDs removes the "CustomRoleToUsers" edge to User entities by IDs.
+func (cru *CustomRoleUpdate) RemoveCustomRoleToUserIDs(ids ...uuid.UUID) *CustomRoleUpdate {
+	cru.mutation.RemoveCustomRoleToUserIDs(ids...)
+	return cru
+}
+
+// RemoveCustomRoleToUsers removes "CustomRoleToUsers" edges to User entities.
+func (cru *CustomRoleUpdate) RemoveCustomRoleToUsers(u ...*User) *CustomRoleUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cru.RemoveCustomRoleToUserIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CustomRoleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cru.hooks) == 0 {
+		affected, err = cru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomRoleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cru.mutation = mutation
+			affected, err = cru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cru.hooks) - 1; i >= 0; i-- {
+			if cru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CustomRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CustomRoleUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CustomRoleUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cru *CustomRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customrole.Table,
+			Columns: customrole.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: customrole.FieldID,
+			},
+		},
+	}
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldName,
+		})
+	}
+	if value, ok := cru.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldDescription,
+		})
+	}
+	if value, ok := cru.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customrole.FieldPermissions,
+		})
+	}
+	if cru.mutation.PermissionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customrole.FieldPermissions,
+		})
+	}
+	if cru.mutation.CustomRoleToUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.RemovedCustomRoleToUsersIDs(); len(nodes) > 0 && !cru.mutation.CustomRoleToUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.CustomRoleToUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CustomRoleUpdateOne is the builder for updating a single CustomRole entity.
+type CustomRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomRoleMutation
+}
+
+// SetName sets the "name" field.
+func (cruo *CustomRoleUpdateOne) SetName(s string) *CustomRoleUpdateOne {
+	cruo.mutation.SetName(s)
+	return cruo
+}
+
+// SetDescription sets the "description" field.
+func (cruo *CustomRoleUpdateOne) SetDescription(s string) *CustomRoleUpdateOne {
+	cruo.mutation.SetDescription(s)
+	return cruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cruo *CustomRoleUpdateOne) SetNillableDescription(s *string) *CustomRoleUpdateOne {
+	if s != nil {
+		cruo.SetDescription(*s)
+	}
+	return cruo
+}
+
+// SetPermissions sets the "permissions" field.
+func (cruo *CustomRoleUpdateOne) SetPermissions(s []string) *CustomRoleUpdateOne {
+	cruo.mutation.SetPermissions(s)
+	return cruo
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (cruo *CustomRoleUpdateOne) ClearPermissions() *CustomRoleUpdateOne {
+	cruo.mutation.ClearPermissions()
+	return cruo
+}
+
+// AddCustomRoleToUserIDs adds the "CustomRoleToUsers" edge to the User entity by IDs.
+func (cruo *CustomRoleUpdateOne) AddCustomRoleToUserIDs(ids ...uuid.UUID) *CustomRoleUpdateOne {
+	cruo.mutation.AddCustomRoleToUserIDs(ids...)
+	return cruo
+}
+
+// AddCustomRoleToUsers adds the "CustomRoleToUsers" edges to the User entity.
+func (cruo *CustomRoleUpdateOne) AddCustomRoleToUsers(u ...*User) *CustomRoleUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cruo.AddCustomRoleToUserIDs(ids...)
+}
+
+// Mutation returns the CustomRoleMutation object of the builder.
+func (cruo *CustomRoleUpdateOne) Mutation() *CustomRoleMutation {
+	return cruo.mutation
+}
+
+// ClearCustomRoleToUsers clears all "CustomRoleToUsers" edges to the User entity.
+func (cruo *CustomRoleUpdateOne) ClearCustomRoleToUsers() *CustomRoleUpdateOne {
+	cruo.mutation.ClearCustomRoleToUsers()
+	return cruo
+}
+
+// RemoveCustomRoleToUserIDs removes the "CustomRoleToUsers" edge to User entities by IDs.
+func (cruo *CustomRoleUpdateOne) RemoveCustomRoleToUserIDs(ids ...uuid.UUID) *CustomRoleUpdateOne {
+	cruo.mutation.RemoveCustomRoleToUserIDs(ids...)
+	return cruo
+}
+
+// RemoveCustomRoleToUsers removes "CustomRoleToUsers" edges to User entities.
+func (cruo *CustomRoleUpdateOne) RemoveCustomRoleToUsers(u ...*User) *CustomRoleUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cruo.RemoveCustomRoleToUserIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CustomRoleUpdateOne) Select(field string, fields ...string) *CustomRoleUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CustomRole entity.
+func (cruo *CustomRoleUpdateOne) Save(ctx context.Context) (*CustomRole, error) {
+	var (
+		err  error
+		node *CustomRole
+	)
+	if len(cruo.hooks) == 0 {
+		node, err = cruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomRoleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cruo.mutation = mutation
+			node, err = cruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cruo.hooks) - 1; i >= 0; i-- {
+			if cruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CustomRoleUpdateOne) SaveX(ctx context.Context) *CustomRole {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CustomRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CustomRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cruo *CustomRoleUpdateOne) sqlSave(ctx context.Context) (_node *CustomRole, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customrole.Table,
+			Columns: customrole.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: customrole.FieldID,
+			},
+		},
+	}
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customrole.FieldID)
+		for _, f := range fields {
+			if !customrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldName,
+		})
+	}
+	if value, ok := cruo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customrole.FieldDescription,
+		})
+	}
+	if value, ok := cruo.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customrole.FieldPermissions,
+		})
+	}
+	if cruo.mutation.PermissionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customrole.FieldPermissions,
+		})
+	}
+	if cruo.mutation.CustomRoleToUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.RemovedCustomRoleToUsersIDs(); len(nodes) > 0 && !cruo.mutation.CustomRoleToUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.CustomRoleToUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customrole.CustomRoleToUsersTable,
+			Columns: []string{customrole.CustomRoleToUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CustomRole{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -10,13 +10,22 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -37,15 +46,24 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		action.Table:         action.ValidColumn,
-		competition.Table:    competition.ValidColumn,
-		provider.Table:       provider.ValidColumn,
-		serviceaccount.Table: serviceaccount.ValidColumn,
-		servicetoken.Table:   servicetoken.ValidColumn,
-		team.Table:           team.ValidColumn,
-		token.Table:          token.ValidColumn,
-		user.Table:           user.ValidColumn,
-		vmobject.Table:       vmobject.ValidColumn,
+		action.Table:              action.ValidColumn,
+		competition.Table:         competition.ValidColumn,
+		competitionbreak.Table:    competitionbreak.ValidColumn,
+		consolesession.Table:      consolesession.ValidColumn,
+		consoleshare.Table:        consoleshare.ValidColumn,
+		customrole.Table:          customrole.ValidColumn,
+		personalaccesstoken.Table: personalaccesstoken.ValidColumn,
+		provider.Table:            provider.ValidColumn,
+		serviceaccount.Table:      serviceaccount.ValidColumn,
+		servicetoken.Table:        servicetoken.ValidColumn,
+		signingkey.Table:          signingkey.ValidColumn,
+		team.Table:                team.ValidColumn,
+		teammembership.Table:      teammembership.ValidColumn,
+		token.Table:               token.ValidColumn,
+		user.Table:                user.ValidColumn,
+		vmcredential.Table:        vmcredential.ValidColumn,
+		vmobject.Table:            vmobject.ValidColumn,
+		webauthncredential.Table:  webauthncredential.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
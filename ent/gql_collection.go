@@ -32,6 +32,66 @@ func (c *CompetitionQuery) collectField(ctx *graphql.OperationContext, field gra
 	return c
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cb *CompetitionBreakQuery) CollectFields(ctx context.Context, satisfies ...string) *CompetitionBreakQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		cb = cb.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return cb
+}
+
+func (cb *CompetitionBreakQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *CompetitionBreakQuery {
+	return cb
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cs *ConsoleSessionQuery) CollectFields(ctx context.Context, satisfies ...string) *ConsoleSessionQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		cs = cs.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return cs
+}
+
+func (cs *ConsoleSessionQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *ConsoleSessionQuery {
+	return cs
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cs *ConsoleShareQuery) CollectFields(ctx context.Context, satisfies ...string) *ConsoleShareQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		cs = cs.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return cs
+}
+
+func (cs *ConsoleShareQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *ConsoleShareQuery {
+	return cs
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cr *CustomRoleQuery) CollectFields(ctx context.Context, satisfies ...string) *CustomRoleQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		cr = cr.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return cr
+}
+
+func (cr *CustomRoleQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *CustomRoleQuery {
+	return cr
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pat *PersonalAccessTokenQuery) CollectFields(ctx context.Context, satisfies ...string) *PersonalAccessTokenQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		pat = pat.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return pat
+}
+
+func (pat *PersonalAccessTokenQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *PersonalAccessTokenQuery {
+	return pat
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (pr *ProviderQuery) CollectFields(ctx context.Context, satisfies ...string) *ProviderQuery {
 	if fc := graphql.GetFieldContext(ctx); fc != nil {
@@ -68,6 +128,18 @@ func (st *ServiceTokenQuery) collectField(ctx *graphql.OperationContext, field g
 	return st
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (sk *SigningKeyQuery) CollectFields(ctx context.Context, satisfies ...string) *SigningKeyQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		sk = sk.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return sk
+}
+
+func (sk *SigningKeyQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *SigningKeyQuery {
+	return sk
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (t *TeamQuery) CollectFields(ctx context.Context, satisfies ...string) *TeamQuery {
 	if fc := graphql.GetFieldContext(ctx); fc != nil {
@@ -80,6 +152,18 @@ func (t *TeamQuery) collectField(ctx *graphql.OperationContext, field graphql.Co
 	return t
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (tm *TeamMembershipQuery) CollectFields(ctx context.Context, satisfies ...string) *TeamMembershipQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		tm = tm.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return tm
+}
+
+func (tm *TeamMembershipQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *TeamMembershipQuery {
+	return tm
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (t *TokenQuery) CollectFields(ctx context.Context, satisfies ...string) *TokenQuery {
 	if fc := graphql.GetFieldContext(ctx); fc != nil {
@@ -104,6 +188,18 @@ func (u *UserQuery) collectField(ctx *graphql.OperationContext, field graphql.Co
 	return u
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (vc *VmCredentialQuery) CollectFields(ctx context.Context, satisfies ...string) *VmCredentialQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		vc = vc.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return vc
+}
+
+func (vc *VmCredentialQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *VmCredentialQuery {
+	return vc
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (vo *VmObjectQuery) CollectFields(ctx context.Context, satisfies ...string) *VmObjectQuery {
 	if fc := graphql.GetFieldContext(ctx); fc != nil {
@@ -115,3 +211,15 @@ func (vo *VmObjectQuery) CollectFields(ctx context.Context, satisfies ...string)
 func (vo *VmObjectQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *VmObjectQuery {
 	return vo
 }
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (wc *WebauthnCredentialQuery) CollectFields(ctx context.Context, satisfies ...string) *WebauthnCredentialQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		wc = wc.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return wc
+}
+
+func (wc *WebauthnCredentialQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *WebauthnCredentialQuery {
+	return wc
+}
@@ -20,6 +20,14 @@ func (a *Action) ActionToServiceAccount(ctx context.Context) (*ServiceAccount, e
 	return result, MaskNotFound(err)
 }
 
+func (a *Action) ActionToImpersonator(ctx context.Context) (*User, error) {
+	result, err := a.Edges.ActionToImpersonatorOrErr()
+	if IsNotLoaded(err) {
+		result, err = a.QueryActionToImpersonator().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (c *Competition) CompetitionToTeams(ctx context.Context) ([]*Team, error) {
 	result, err := c.Edges.CompetitionToTeamsOrErr()
 	if IsNotLoaded(err) {
@@ -36,6 +44,78 @@ func (c *Competition) CompetitionToProvider(ctx context.Context) (*Provider, err
 	return result, err
 }
 
+func (c *Competition) CompetitionToAdmins(ctx context.Context) ([]*User, error) {
+	result, err := c.Edges.CompetitionToAdminsOrErr()
+	if IsNotLoaded(err) {
+		result, err = c.QueryCompetitionToAdmins().All(ctx)
+	}
+	return result, err
+}
+
+func (c *Competition) CompetitionToBreaks(ctx context.Context) ([]*CompetitionBreak, error) {
+	result, err := c.Edges.CompetitionToBreaksOrErr()
+	if IsNotLoaded(err) {
+		result, err = c.QueryCompetitionToBreaks().All(ctx)
+	}
+	return result, err
+}
+
+func (cb *CompetitionBreak) CompetitionBreakToCompetition(ctx context.Context) (*Competition, error) {
+	result, err := cb.Edges.CompetitionBreakToCompetitionOrErr()
+	if IsNotLoaded(err) {
+		result, err = cb.QueryCompetitionBreakToCompetition().Only(ctx)
+	}
+	return result, err
+}
+
+func (cs *ConsoleSession) ConsoleSessionToUser(ctx context.Context) (*User, error) {
+	result, err := cs.Edges.ConsoleSessionToUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = cs.QueryConsoleSessionToUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (cs *ConsoleSession) ConsoleSessionToVmObject(ctx context.Context) (*VmObject, error) {
+	result, err := cs.Edges.ConsoleSessionToVmObjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = cs.QueryConsoleSessionToVmObject().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (cs *ConsoleShare) ConsoleShareToVmObject(ctx context.Context) (*VmObject, error) {
+	result, err := cs.Edges.ConsoleShareToVmObjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = cs.QueryConsoleShareToVmObject().Only(ctx)
+	}
+	return result, err
+}
+
+func (cs *ConsoleShare) ConsoleShareToUser(ctx context.Context) (*User, error) {
+	result, err := cs.Edges.ConsoleShareToUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = cs.QueryConsoleShareToUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (cr *CustomRole) CustomRoleToUsers(ctx context.Context) ([]*User, error) {
+	result, err := cr.Edges.CustomRoleToUsersOrErr()
+	if IsNotLoaded(err) {
+		result, err = cr.QueryCustomRoleToUsers().All(ctx)
+	}
+	return result, err
+}
+
+func (pat *PersonalAccessToken) PersonalAccessTokenToUser(ctx context.Context) (*User, error) {
+	result, err := pat.Edges.PersonalAccessTokenToUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = pat.QueryPersonalAccessTokenToUser().Only(ctx)
+	}
+	return result, err
+}
+
 func (pr *Provider) ProviderToCompetitions(ctx context.Context) ([]*Competition, error) {
 	result, err := pr.Edges.ProviderToCompetitionsOrErr()
 	if IsNotLoaded(err) {
@@ -60,6 +140,22 @@ func (sa *ServiceAccount) ServiceAccountToActions(ctx context.Context) ([]*Actio
 	return result, err
 }
 
+func (sa *ServiceAccount) ServiceAccountToCompetitions(ctx context.Context) ([]*Competition, error) {
+	result, err := sa.Edges.ServiceAccountToCompetitionsOrErr()
+	if IsNotLoaded(err) {
+		result, err = sa.QueryServiceAccountToCompetitions().All(ctx)
+	}
+	return result, err
+}
+
+func (sa *ServiceAccount) ServiceAccountToTeams(ctx context.Context) ([]*Team, error) {
+	result, err := sa.Edges.ServiceAccountToTeamsOrErr()
+	if IsNotLoaded(err) {
+		result, err = sa.QueryServiceAccountToTeams().All(ctx)
+	}
+	return result, err
+}
+
 func (st *ServiceToken) TokenToServiceAccount(ctx context.Context) (*ServiceAccount, error) {
 	result, err := st.Edges.TokenToServiceAccountOrErr()
 	if IsNotLoaded(err) {
@@ -92,6 +188,30 @@ func (t *Team) TeamToUsers(ctx context.Context) ([]*User, error) {
 	return result, err
 }
 
+func (t *Team) TeamToMemberships(ctx context.Context) ([]*TeamMembership, error) {
+	result, err := t.Edges.TeamToMembershipsOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryTeamToMemberships().All(ctx)
+	}
+	return result, err
+}
+
+func (tm *TeamMembership) TeamMembershipToUser(ctx context.Context) (*User, error) {
+	result, err := tm.Edges.TeamMembershipToUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = tm.QueryTeamMembershipToUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (tm *TeamMembership) TeamMembershipToTeam(ctx context.Context) (*Team, error) {
+	result, err := tm.Edges.TeamMembershipToTeamOrErr()
+	if IsNotLoaded(err) {
+		result, err = tm.QueryTeamMembershipToTeam().Only(ctx)
+	}
+	return result, err
+}
+
 func (t *Token) TokenToUser(ctx context.Context) (*User, error) {
 	result, err := t.Edges.TokenToUserOrErr()
 	if IsNotLoaded(err) {
@@ -100,6 +220,14 @@ func (t *Token) TokenToUser(ctx context.Context) (*User, error) {
 	return result, err
 }
 
+func (t *Token) TokenToImpersonatedUser(ctx context.Context) (*User, error) {
+	result, err := t.Edges.TokenToImpersonatedUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryTokenToImpersonatedUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (u *User) UserToTeam(ctx context.Context) (*Team, error) {
 	result, err := u.Edges.UserToTeamOrErr()
 	if IsNotLoaded(err) {
@@ -108,6 +236,30 @@ func (u *User) UserToTeam(ctx context.Context) (*Team, error) {
 	return result, MaskNotFound(err)
 }
 
+func (u *User) UserToTeamMemberships(ctx context.Context) ([]*TeamMembership, error) {
+	result, err := u.Edges.UserToTeamMembershipsOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToTeamMemberships().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) UserToCustomRole(ctx context.Context) (*CustomRole, error) {
+	result, err := u.Edges.UserToCustomRoleOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToCustomRole().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (u *User) UserToAdminCompetitions(ctx context.Context) ([]*Competition, error) {
+	result, err := u.Edges.UserToAdminCompetitionsOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToAdminCompetitions().All(ctx)
+	}
+	return result, err
+}
+
 func (u *User) UserToToken(ctx context.Context) ([]*Token, error) {
 	result, err := u.Edges.UserToTokenOrErr()
 	if IsNotLoaded(err) {
@@ -124,6 +276,46 @@ func (u *User) UserToActions(ctx context.Context) ([]*Action, error) {
 	return result, err
 }
 
+func (u *User) UserToConsoleSessions(ctx context.Context) ([]*ConsoleSession, error) {
+	result, err := u.Edges.UserToConsoleSessionsOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToConsoleSessions().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) UserToConsoleShares(ctx context.Context) ([]*ConsoleShare, error) {
+	result, err := u.Edges.UserToConsoleSharesOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToConsoleShares().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) UserToWebauthnCredentials(ctx context.Context) ([]*WebauthnCredential, error) {
+	result, err := u.Edges.UserToWebauthnCredentialsOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToWebauthnCredentials().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) UserToPersonalAccessTokens(ctx context.Context) ([]*PersonalAccessToken, error) {
+	result, err := u.Edges.UserToPersonalAccessTokensOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryUserToPersonalAccessTokens().All(ctx)
+	}
+	return result, err
+}
+
+func (vc *VmCredential) VmCredentialToVmObject(ctx context.Context) (*VmObject, error) {
+	result, err := vc.Edges.VmCredentialToVmObjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = vc.QueryVmCredentialToVmObject().Only(ctx)
+	}
+	return result, err
+}
+
 func (vo *VmObject) VmObjectToTeam(ctx context.Context) (*Team, error) {
 	result, err := vo.Edges.VmObjectToTeamOrErr()
 	if IsNotLoaded(err) {
@@ -131,3 +323,35 @@ func (vo *VmObject) VmObjectToTeam(ctx context.Context) (*Team, error) {
 	}
 	return result, MaskNotFound(err)
 }
+
+func (vo *VmObject) VmObjectToConsoleSessions(ctx context.Context) ([]*ConsoleSession, error) {
+	result, err := vo.Edges.VmObjectToConsoleSessionsOrErr()
+	if IsNotLoaded(err) {
+		result, err = vo.QueryVmObjectToConsoleSessions().All(ctx)
+	}
+	return result, err
+}
+
+func (vo *VmObject) VmObjectToVmCredentials(ctx context.Context) ([]*VmCredential, error) {
+	result, err := vo.Edges.VmObjectToVmCredentialsOrErr()
+	if IsNotLoaded(err) {
+		result, err = vo.QueryVmObjectToVmCredentials().All(ctx)
+	}
+	return result, err
+}
+
+func (vo *VmObject) VmObjectToConsoleShares(ctx context.Context) ([]*ConsoleShare, error) {
+	result, err := vo.Edges.VmObjectToConsoleSharesOrErr()
+	if IsNotLoaded(err) {
+		result, err = vo.QueryVmObjectToConsoleShares().All(ctx)
+	}
+	return result, err
+}
+
+func (wc *WebauthnCredential) WebauthnCredentialToUser(ctx context.Context) (*User, error) {
+	result, err := wc.Edges.WebauthnCredentialToUserOrErr()
+	if IsNotLoaded(err) {
+		result, err = wc.QueryWebauthnCredentialToUser().Only(ctx)
+	}
+	return result, err
+}
@@ -11,13 +11,22 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 )
@@ -54,7 +63,7 @@ func (a *Action) Node(ctx context.Context) (node *Node, err error) {
 		ID:     a.ID,
 		Type:   "Action",
 		Fields: make([]*Field, 4),
-		Edges:  make([]*Edge, 2),
+		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(a.IPAddress); err != nil {
@@ -109,6 +118,16 @@ func (a *Action) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[2] = &Edge{
+		Type: "User",
+		Name: "ActionToImpersonator",
+	}
+	err = a.QueryActionToImpersonator().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
@@ -116,8 +135,8 @@ func (c *Competition) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     c.ID,
 		Type:   "Competition",
-		Fields: make([]*Field, 1),
-		Edges:  make([]*Edge, 2),
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 4),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(c.Name); err != nil {
@@ -128,6 +147,62 @@ func (c *Competition) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "name",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(c.ConsoleLimitPerVM); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "int",
+		Name:  "console_limit_per_vm",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.ConsoleLimitPerUser); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "console_limit_per_user",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.ConsoleLimitPerTeam); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "console_limit_per_team",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.StartsAt); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "time.Time",
+		Name:  "starts_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.EndsAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "ends_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.ScheduleLocked); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "bool",
+		Name:  "schedule_locked",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "[]string",
+		Name:  "allowed_cidrs",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Team",
 		Name: "CompetitionToTeams",
@@ -148,18 +223,38 @@ func (c *Competition) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[2] = &Edge{
+		Type: "User",
+		Name: "CompetitionToAdmins",
+	}
+	err = c.QueryCompetitionToAdmins().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[3] = &Edge{
+		Type: "CompetitionBreak",
+		Name: "CompetitionToBreaks",
+	}
+	err = c.QueryCompetitionToBreaks().
+		Select(competitionbreak.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
-func (pr *Provider) Node(ctx context.Context) (node *Node, err error) {
+func (cb *CompetitionBreak) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     pr.ID,
-		Type:   "Provider",
+		ID:     cb.ID,
+		Type:   "CompetitionBreak",
 		Fields: make([]*Field, 3),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(pr.Name); err != nil {
+	if buf, err = json.Marshal(cb.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
@@ -167,27 +262,27 @@ func (pr *Provider) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(pr.Type); err != nil {
+	if buf, err = json.Marshal(cb.StartsAt); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
-		Type:  "string",
-		Name:  "type",
+		Type:  "time.Time",
+		Name:  "starts_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(pr.Config); err != nil {
+	if buf, err = json.Marshal(cb.EndsAt); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "string",
-		Name:  "config",
+		Type:  "time.Time",
+		Name:  "ends_at",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
 		Type: "Competition",
-		Name: "ProviderToCompetitions",
+		Name: "CompetitionBreakToCompetition",
 	}
-	err = pr.QueryProviderToCompetitions().
+	err = cb.QueryCompetitionBreakToCompetition().
 		Select(competition.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
@@ -196,62 +291,70 @@ func (pr *Provider) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
-func (sa *ServiceAccount) Node(ctx context.Context) (node *Node, err error) {
+func (cs *ConsoleSession) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     sa.ID,
-		Type:   "ServiceAccount",
-		Fields: make([]*Field, 4),
+		ID:     cs.ID,
+		Type:   "ConsoleSession",
+		Fields: make([]*Field, 5),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(sa.DisplayName); err != nil {
+	if buf, err = json.Marshal(cs.ConsoleType); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
 		Type:  "string",
-		Name:  "display_name",
+		Name:  "console_type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(sa.APIKey); err != nil {
+	if buf, err = json.Marshal(cs.IPAddress); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
-		Type:  "uuid.UUID",
-		Name:  "api_key",
+		Type:  "string",
+		Name:  "ip_address",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(sa.APISecret); err != nil {
+	if buf, err = json.Marshal(cs.StartedAt); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "uuid.UUID",
-		Name:  "api_secret",
+		Type:  "time.Time",
+		Name:  "started_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(sa.Active); err != nil {
+	if buf, err = json.Marshal(cs.EndedAt); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "bool",
-		Name:  "active",
+		Type:  "time.Time",
+		Name:  "ended_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cs.Transcript); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "transcript",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "ServiceToken",
-		Name: "ServiceAccountToToken",
+		Type: "User",
+		Name: "ConsoleSessionToUser",
 	}
-	err = sa.QueryServiceAccountToToken().
-		Select(servicetoken.FieldID).
+	err = cs.QueryConsoleSessionToUser().
+		Select(user.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[1] = &Edge{
-		Type: "Action",
-		Name: "ServiceAccountToActions",
+		Type: "VmObject",
+		Name: "ConsoleSessionToVmObject",
 	}
-	err = sa.QueryServiceAccountToActions().
-		Select(action.FieldID).
+	err = cs.QueryConsoleSessionToVmObject().
+		Select(vmobject.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
 		return nil, err
@@ -259,138 +362,1005 @@ func (sa *ServiceAccount) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
-func (st *ServiceToken) Node(ctx context.Context) (node *Node, err error) {
+func (cs *ConsoleShare) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     st.ID,
-		Type:   "ServiceToken",
-		Fields: make([]*Field, 3),
-		Edges:  make([]*Edge, 1),
+		ID:     cs.ID,
+		Type:   "ConsoleShare",
+		Fields: make([]*Field, 6),
+		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(st.Token); err != nil {
+	if buf, err = json.Marshal(cs.TokenHash); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
 		Type:  "string",
-		Name:  "token",
+		Name:  "token_hash",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(st.RefreshToken); err != nil {
+	if buf, err = json.Marshal(cs.ConsoleType); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
 		Type:  "string",
-		Name:  "refresh_token",
+		Name:  "console_type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(st.IssuedAt); err != nil {
+	if buf, err = json.Marshal(cs.Password); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "int64",
-		Name:  "issued_at",
+		Type:  "string",
+		Name:  "password",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cs.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cs.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cs.Revoked); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "bool",
+		Name:  "revoked",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "ServiceAccount",
-		Name: "TokenToServiceAccount",
+		Type: "VmObject",
+		Name: "ConsoleShareToVmObject",
 	}
-	err = st.QueryTokenToServiceAccount().
-		Select(serviceaccount.FieldID).
+	err = cs.QueryConsoleShareToVmObject().
+		Select(vmobject.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[1] = &Edge{
+		Type: "User",
+		Name: "ConsoleShareToUser",
+	}
+	err = cs.QueryConsoleShareToUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
-func (t *Team) Node(ctx context.Context) (node *Node, err error) {
+func (cr *CustomRole) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     t.ID,
-		Type:   "Team",
-		Fields: make([]*Field, 2),
-		Edges:  make([]*Edge, 3),
+		ID:     cr.ID,
+		Type:   "CustomRole",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(t.TeamNumber); err != nil {
+	if buf, err = json.Marshal(cr.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
-		Type:  "int",
-		Name:  "team_number",
+		Type:  "string",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(t.Name); err != nil {
+	if buf, err = json.Marshal(cr.Description); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
 		Type:  "string",
-		Name:  "name",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cr.Permissions); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "[]string",
+		Name:  "permissions",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "Competition",
-		Name: "TeamToCompetition",
+		Type: "User",
+		Name: "CustomRoleToUsers",
 	}
-	err = t.QueryTeamToCompetition().
-		Select(competition.FieldID).
+	err = cr.QueryCustomRoleToUsers().
+		Select(user.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
-	node.Edges[1] = &Edge{
-		Type: "VmObject",
-		Name: "TeamToVmObjects",
+	return node, nil
+}
+
+func (pat *PersonalAccessToken) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     pat.ID,
+		Type:   "PersonalAccessToken",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 1),
 	}
-	err = t.QueryTeamToVmObjects().
-		Select(vmobject.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
+	var buf []byte
+	if buf, err = json.Marshal(pat.Name); err != nil {
 		return nil, err
 	}
-	node.Edges[2] = &Edge{
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.TokenHash); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "token_hash",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.Prefix); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "prefix",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.Scope); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "personalaccesstoken.Scope",
+		Name:  "scope",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pat.LastUsedIP); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "last_used_ip",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
 		Type: "User",
-		Name: "TeamToUsers",
+		Name: "PersonalAccessTokenToUser",
 	}
-	err = t.QueryTeamToUsers().
+	err = pat.QueryPersonalAccessTokenToUser().
 		Select(user.FieldID).
-		Scan(ctx, &node.Edges[2].IDs)
+		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	return node, nil
 }
 
-func (t *Token) Node(ctx context.Context) (node *Node, err error) {
+func (pr *Provider) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     t.ID,
-		Type:   "Token",
-		Fields: make([]*Field, 2),
-		Edges:  make([]*Edge, 1),
+		ID:     pr.ID,
+		Type:   "Provider",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(pr.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pr.Type); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(pr.Config); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "config",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Competition",
+		Name: "ProviderToCompetitions",
+	}
+	err = pr.QueryProviderToCompetitions().
+		Select(competition.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (sa *ServiceAccount) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     sa.ID,
+		Type:   "ServiceAccount",
+		Fields: make([]*Field, 12),
+		Edges:  make([]*Edge, 4),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(sa.DisplayName); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "display_name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.APIKey); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "api_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.APISecret); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "api_secret",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.APISecretHash); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "api_secret_hash",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.PreviousAPISecretHash); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "previous_api_secret_hash",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.PreviousAPISecretExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "previous_api_secret_expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.SecretGeneration); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "secret_generation",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.Active); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "bool",
+		Name:  "active",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.LastUsedIP); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "string",
+		Name:  "last_used_ip",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sa.Scopes); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "[]string",
+		Name:  "scopes",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "ServiceToken",
+		Name: "ServiceAccountToToken",
+	}
+	err = sa.QueryServiceAccountToToken().
+		Select(servicetoken.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "Action",
+		Name: "ServiceAccountToActions",
+	}
+	err = sa.QueryServiceAccountToActions().
+		Select(action.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "Competition",
+		Name: "ServiceAccountToCompetitions",
+	}
+	err = sa.QueryServiceAccountToCompetitions().
+		Select(competition.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[3] = &Edge{
+		Type: "Team",
+		Name: "ServiceAccountToTeams",
+	}
+	err = sa.QueryServiceAccountToTeams().
+		Select(team.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (st *ServiceToken) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     st.ID,
+		Type:   "ServiceToken",
+		Fields: make([]*Field, 4),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(st.Token); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "token",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(st.RefreshToken); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "refresh_token",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(st.IssuedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int64",
+		Name:  "issued_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(st.SecretGeneration); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "secret_generation",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "ServiceAccount",
+		Name: "TokenToServiceAccount",
+	}
+	err = st.QueryTokenToServiceAccount().
+		Select(serviceaccount.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (sk *SigningKey) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     sk.ID,
+		Type:   "SigningKey",
+		Fields: make([]*Field, 6),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(sk.Algorithm); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "signingkey.Algorithm",
+		Name:  "algorithm",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sk.PrivateKey); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "private_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sk.PublicKey); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "public_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sk.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sk.RetiredAt); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "time.Time",
+		Name:  "retired_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(sk.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
+func (t *Team) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     t.ID,
+		Type:   "Team",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 4),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(t.TeamNumber); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "int",
+		Name:  "team_number",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "[]string",
+		Name:  "allowed_cidrs",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Competition",
+		Name: "TeamToCompetition",
+	}
+	err = t.QueryTeamToCompetition().
+		Select(competition.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "VmObject",
+		Name: "TeamToVmObjects",
+	}
+	err = t.QueryTeamToVmObjects().
+		Select(vmobject.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "User",
+		Name: "TeamToUsers",
+	}
+	err = t.QueryTeamToUsers().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[3] = &Edge{
+		Type: "TeamMembership",
+		Name: "TeamToMemberships",
+	}
+	err = t.QueryTeamToMemberships().
+		Select(teammembership.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (tm *TeamMembership) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     tm.ID,
+		Type:   "TeamMembership",
+		Fields: make([]*Field, 1),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(tm.Role); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "teammembership.Role",
+		Name:  "role",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "TeamMembershipToUser",
+	}
+	err = tm.QueryTeamMembershipToUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "Team",
+		Name: "TeamMembershipToTeam",
+	}
+	err = tm.QueryTeamMembershipToTeam().
+		Select(team.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (t *Token) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     t.ID,
+		Type:   "Token",
+		Fields: make([]*Field, 7),
+		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(t.Token); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "token",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.ExpireAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "int64",
+		Name:  "expire_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.IPAddress); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "ip_address",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.UserAgent); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "string",
+		Name:  "user_agent",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Method); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "method",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "TokenToUser",
+	}
+	err = t.QueryTokenToUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "User",
+		Name: "TokenToImpersonatedUser",
+	}
+	err = t.QueryTokenToImpersonatedUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (u *User) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     u.ID,
+		Type:   "User",
+		Fields: make([]*Field, 13),
+		Edges:  make([]*Edge, 10),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(u.Username); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "string",
+		Name:  "username",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.Password); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "password",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.FirstName); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "first_name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.LastName); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "last_name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.Role); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "user.Role",
+		Name:  "role",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.Provider); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "user.Provider",
+		Name:  "provider",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.ExternalIssuer); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "external_issuer",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.ExternalSubject); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "external_subject",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.TotpSecret); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "string",
+		Name:  "totp_secret",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.TotpEnabled); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "bool",
+		Name:  "totp_enabled",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.TotpLastCounter); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "int64",
+		Name:  "totp_last_counter",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.TotpRecoveryCodes); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "[]string",
+		Name:  "totp_recovery_codes",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.MustChangePassword); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "bool",
+		Name:  "must_change_password",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Team",
+		Name: "UserToTeam",
+	}
+	err = u.QueryUserToTeam().
+		Select(team.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "TeamMembership",
+		Name: "UserToTeamMemberships",
+	}
+	err = u.QueryUserToTeamMemberships().
+		Select(teammembership.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "CustomRole",
+		Name: "UserToCustomRole",
+	}
+	err = u.QueryUserToCustomRole().
+		Select(customrole.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[3] = &Edge{
+		Type: "Competition",
+		Name: "UserToAdminCompetitions",
+	}
+	err = u.QueryUserToAdminCompetitions().
+		Select(competition.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[4] = &Edge{
+		Type: "Token",
+		Name: "UserToToken",
+	}
+	err = u.QueryUserToToken().
+		Select(token.FieldID).
+		Scan(ctx, &node.Edges[4].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[5] = &Edge{
+		Type: "Action",
+		Name: "UserToActions",
+	}
+	err = u.QueryUserToActions().
+		Select(action.FieldID).
+		Scan(ctx, &node.Edges[5].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[6] = &Edge{
+		Type: "ConsoleSession",
+		Name: "UserToConsoleSessions",
+	}
+	err = u.QueryUserToConsoleSessions().
+		Select(consolesession.FieldID).
+		Scan(ctx, &node.Edges[6].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[7] = &Edge{
+		Type: "ConsoleShare",
+		Name: "UserToConsoleShares",
+	}
+	err = u.QueryUserToConsoleShares().
+		Select(consoleshare.FieldID).
+		Scan(ctx, &node.Edges[7].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[8] = &Edge{
+		Type: "WebauthnCredential",
+		Name: "UserToWebauthnCredentials",
+	}
+	err = u.QueryUserToWebauthnCredentials().
+		Select(webauthncredential.FieldID).
+		Scan(ctx, &node.Edges[8].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[9] = &Edge{
+		Type: "PersonalAccessToken",
+		Name: "UserToPersonalAccessTokens",
+	}
+	err = u.QueryUserToPersonalAccessTokens().
+		Select(personalaccesstoken.FieldID).
+		Scan(ctx, &node.Edges[9].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (vc *VmCredential) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     vc.ID,
+		Type:   "VmCredential",
+		Fields: make([]*Field, 7),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(vc.Protocol); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "vmcredential.Protocol",
+		Name:  "protocol",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vc.Port); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "int",
+		Name:  "port",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vc.Username); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "username",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vc.Password); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "password",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vc.PrivateKey); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "private_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vc.Domain); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
 		Type:  "string",
-		Name:  "token",
+		Name:  "domain",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(t.ExpireAt); err != nil {
+	if buf, err = json.Marshal(vc.IgnoreCert); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
-		Type:  "int64",
-		Name:  "expire_at",
+	node.Fields[6] = &Field{
+		Type:  "bool",
+		Name:  "ignore_cert",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "TokenToUser",
+		Type: "VmObject",
+		Name: "VmCredentialToVmObject",
 	}
-	err = t.QueryTokenToUser().
-		Select(user.FieldID).
+	err = vc.QueryVmCredentialToVmObject().
+		Select(vmobject.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
@@ -398,104 +1368,130 @@ func (t *Token) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
-func (u *User) Node(ctx context.Context) (node *Node, err error) {
+func (vo *VmObject) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     u.ID,
-		Type:   "User",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 3),
+		ID:     vo.ID,
+		Type:   "VmObject",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 4),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(u.Username); err != nil {
+	if buf, err = json.Marshal(vo.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
 		Type:  "string",
-		Name:  "username",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.Password); err != nil {
+	if buf, err = json.Marshal(vo.Identifier); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
 		Type:  "string",
-		Name:  "password",
+		Name:  "identifier",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.FirstName); err != nil {
+	if buf, err = json.Marshal(vo.IPAddresses); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "string",
-		Name:  "first_name",
+		Type:  "[]string",
+		Name:  "ip_addresses",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.LastName); err != nil {
+	if buf, err = json.Marshal(vo.Locked); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "string",
-		Name:  "last_name",
+		Type:  "bool",
+		Name:  "locked",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.Role); err != nil {
+	if buf, err = json.Marshal(vo.RedTeamAccess); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "user.Role",
-		Name:  "role",
+		Type:  "bool",
+		Name:  "red_team_access",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.Provider); err != nil {
+	if buf, err = json.Marshal(vo.ConsoleLimitPerVM); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "user.Provider",
-		Name:  "provider",
+		Type:  "int",
+		Name:  "console_limit_per_vm",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vo.ConsoleLimitPerUser); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "console_limit_per_user",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vo.ConsoleLimitPerTeam); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "int",
+		Name:  "console_limit_per_team",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
 		Type: "Team",
-		Name: "UserToTeam",
+		Name: "VmObjectToTeam",
 	}
-	err = u.QueryUserToTeam().
+	err = vo.QueryVmObjectToTeam().
 		Select(team.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[1] = &Edge{
-		Type: "Token",
-		Name: "UserToToken",
+		Type: "ConsoleSession",
+		Name: "VmObjectToConsoleSessions",
 	}
-	err = u.QueryUserToToken().
-		Select(token.FieldID).
+	err = vo.QueryVmObjectToConsoleSessions().
+		Select(consolesession.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[2] = &Edge{
-		Type: "Action",
-		Name: "UserToActions",
+		Type: "VmCredential",
+		Name: "VmObjectToVmCredentials",
 	}
-	err = u.QueryUserToActions().
-		Select(action.FieldID).
+	err = vo.QueryVmObjectToVmCredentials().
+		Select(vmcredential.FieldID).
 		Scan(ctx, &node.Edges[2].IDs)
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[3] = &Edge{
+		Type: "ConsoleShare",
+		Name: "VmObjectToConsoleShares",
+	}
+	err = vo.QueryVmObjectToConsoleShares().
+		Select(consoleshare.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
-func (vo *VmObject) Node(ctx context.Context) (node *Node, err error) {
+func (wc *WebauthnCredential) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
-		ID:     vo.ID,
-		Type:   "VmObject",
-		Fields: make([]*Field, 4),
+		ID:     wc.ID,
+		Type:   "WebauthnCredential",
+		Fields: make([]*Field, 11),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
-	if buf, err = json.Marshal(vo.Name); err != nil {
+	if buf, err = json.Marshal(wc.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[0] = &Field{
@@ -503,36 +1499,92 @@ func (vo *VmObject) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(vo.Identifier); err != nil {
+	if buf, err = json.Marshal(wc.CredentialID); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
-		Type:  "string",
-		Name:  "identifier",
+		Type:  "[]byte",
+		Name:  "credential_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(vo.IPAddresses); err != nil {
+	if buf, err = json.Marshal(wc.PublicKey); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "[]string",
-		Name:  "ip_addresses",
+		Type:  "[]byte",
+		Name:  "public_key",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(vo.Locked); err != nil {
+	if buf, err = json.Marshal(wc.AttestationType); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "attestation_type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.Aaguid); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "[]byte",
+		Name:  "aaguid",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.SignCount); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "uint32",
+		Name:  "sign_count",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.Transports); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "[]string",
+		Name:  "transports",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.BackupEligible); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
 		Type:  "bool",
-		Name:  "locked",
+		Name:  "backup_eligible",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.BackupState); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "bool",
+		Name:  "backup_state",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(wc.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "Team",
-		Name: "VmObjectToTeam",
+		Type: "User",
+		Name: "WebauthnCredentialToUser",
 	}
-	err = vo.QueryVmObjectToTeam().
-		Select(team.FieldID).
+	err = wc.QueryWebauthnCredentialToUser().
+		Select(user.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
@@ -624,6 +1676,51 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case competitionbreak.Table:
+		n, err := c.CompetitionBreak.Query().
+			Where(competitionbreak.ID(id)).
+			CollectFields(ctx, "CompetitionBreak").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case consolesession.Table:
+		n, err := c.ConsoleSession.Query().
+			Where(consolesession.ID(id)).
+			CollectFields(ctx, "ConsoleSession").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case consoleshare.Table:
+		n, err := c.ConsoleShare.Query().
+			Where(consoleshare.ID(id)).
+			CollectFields(ctx, "ConsoleShare").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case customrole.Table:
+		n, err := c.CustomRole.Query().
+			Where(customrole.ID(id)).
+			CollectFields(ctx, "CustomRole").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case personalaccesstoken.Table:
+		n, err := c.PersonalAccessToken.Query().
+			Where(personalaccesstoken.ID(id)).
+			CollectFields(ctx, "PersonalAccessToken").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case provider.Table:
 		n, err := c.Provider.Query().
 			Where(provider.ID(id)).
@@ -651,6 +1748,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case signingkey.Table:
+		n, err := c.SigningKey.Query().
+			Where(signingkey.ID(id)).
+			CollectFields(ctx, "SigningKey").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case team.Table:
 		n, err := c.Team.Query().
 			Where(team.ID(id)).
@@ -660,6 +1766,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case teammembership.Table:
+		n, err := c.TeamMembership.Query().
+			Where(teammembership.ID(id)).
+			CollectFields(ctx, "TeamMembership").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case token.Table:
 		n, err := c.Token.Query().
 			Where(token.ID(id)).
@@ -678,6 +1793,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case vmcredential.Table:
+		n, err := c.VmCredential.Query().
+			Where(vmcredential.ID(id)).
+			CollectFields(ctx, "VmCredential").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case vmobject.Table:
 		n, err := c.VmObject.Query().
 			Where(vmobject.ID(id)).
@@ -687,6 +1811,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case webauthncredential.Table:
+		n, err := c.WebauthnCredential.Query().
+			Where(webauthncredential.ID(id)).
+			CollectFields(ctx, "WebauthnCredential").
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -786,6 +1919,71 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case competitionbreak.Table:
+		nodes, err := c.CompetitionBreak.Query().
+			Where(competitionbreak.IDIn(ids...)).
+			CollectFields(ctx, "CompetitionBreak").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case consolesession.Table:
+		nodes, err := c.ConsoleSession.Query().
+			Where(consolesession.IDIn(ids...)).
+			CollectFields(ctx, "ConsoleSession").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case consoleshare.Table:
+		nodes, err := c.ConsoleShare.Query().
+			Where(consoleshare.IDIn(ids...)).
+			CollectFields(ctx, "ConsoleShare").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case customrole.Table:
+		nodes, err := c.CustomRole.Query().
+			Where(customrole.IDIn(ids...)).
+			CollectFields(ctx, "CustomRole").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case personalaccesstoken.Table:
+		nodes, err := c.PersonalAccessToken.Query().
+			Where(personalaccesstoken.IDIn(ids...)).
+			CollectFields(ctx, "PersonalAccessToken").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case provider.Table:
 		nodes, err := c.Provider.Query().
 			Where(provider.IDIn(ids...)).
@@ -825,6 +2023,19 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case signingkey.Table:
+		nodes, err := c.SigningKey.Query().
+			Where(signingkey.IDIn(ids...)).
+			CollectFields(ctx, "SigningKey").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case team.Table:
 		nodes, err := c.Team.Query().
 			Where(team.IDIn(ids...)).
@@ -838,6 +2049,19 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case teammembership.Table:
+		nodes, err := c.TeamMembership.Query().
+			Where(teammembership.IDIn(ids...)).
+			CollectFields(ctx, "TeamMembership").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case token.Table:
 		nodes, err := c.Token.Query().
 			Where(token.IDIn(ids...)).
@@ -864,6 +2088,19 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case vmcredential.Table:
+		nodes, err := c.VmCredential.Query().
+			Where(vmcredential.IDIn(ids...)).
+			CollectFields(ctx, "VmCredential").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case vmobject.Table:
 		nodes, err := c.VmObject.Query().
 			Where(vmobject.IDIn(ids...)).
@@ -877,6 +2114,19 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case webauthncredential.Table:
+		nodes, err := c.WebauthnCredential.Query().
+			Where(webauthncredential.IDIn(ids...)).
+			CollectFields(ctx, "WebauthnCredential").
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -16,13 +16,22 @@ import (
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vmihailenco/msgpack/v5"
@@ -695,124 +704,124 @@ func (c *Competition) ToEdge(order *CompetitionOrder) *CompetitionEdge {
 	}
 }
 
-// ProviderEdge is the edge representation of Provider.
-type ProviderEdge struct {
-	Node   *Provider `json:"node"`
-	Cursor Cursor    `json:"cursor"`
+// CompetitionBreakEdge is the edge representation of CompetitionBreak.
+type CompetitionBreakEdge struct {
+	Node   *CompetitionBreak `json:"node"`
+	Cursor Cursor            `json:"cursor"`
 }
 
-// ProviderConnection is the connection containing edges to Provider.
-type ProviderConnection struct {
-	Edges      []*ProviderEdge `json:"edges"`
-	PageInfo   PageInfo        `json:"pageInfo"`
-	TotalCount int             `json:"totalCount"`
+// CompetitionBreakConnection is the connection containing edges to CompetitionBreak.
+type CompetitionBreakConnection struct {
+	Edges      []*CompetitionBreakEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
 }
 
-// ProviderPaginateOption enables pagination customization.
-type ProviderPaginateOption func(*providerPager) error
+// CompetitionBreakPaginateOption enables pagination customization.
+type CompetitionBreakPaginateOption func(*competitionBreakPager) error
 
-// WithProviderOrder configures pagination ordering.
-func WithProviderOrder(order *ProviderOrder) ProviderPaginateOption {
+// WithCompetitionBreakOrder configures pagination ordering.
+func WithCompetitionBreakOrder(order *CompetitionBreakOrder) CompetitionBreakPaginateOption {
 	if order == nil {
-		order = DefaultProviderOrder
+		order = DefaultCompetitionBreakOrder
 	}
 	o := *order
-	return func(pager *providerPager) error {
+	return func(pager *competitionBreakPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProviderOrder.Field
+			o.Field = DefaultCompetitionBreakOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProviderFilter configures pagination filter.
-func WithProviderFilter(filter func(*ProviderQuery) (*ProviderQuery, error)) ProviderPaginateOption {
-	return func(pager *providerPager) error {
+// WithCompetitionBreakFilter configures pagination filter.
+func WithCompetitionBreakFilter(filter func(*CompetitionBreakQuery) (*CompetitionBreakQuery, error)) CompetitionBreakPaginateOption {
+	return func(pager *competitionBreakPager) error {
 		if filter == nil {
-			return errors.New("ProviderQuery filter cannot be nil")
+			return errors.New("CompetitionBreakQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type providerPager struct {
-	order  *ProviderOrder
-	filter func(*ProviderQuery) (*ProviderQuery, error)
+type competitionBreakPager struct {
+	order  *CompetitionBreakOrder
+	filter func(*CompetitionBreakQuery) (*CompetitionBreakQuery, error)
 }
 
-func newProviderPager(opts []ProviderPaginateOption) (*providerPager, error) {
-	pager := &providerPager{}
+func newCompetitionBreakPager(opts []CompetitionBreakPaginateOption) (*competitionBreakPager, error) {
+	pager := &competitionBreakPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProviderOrder
+		pager.order = DefaultCompetitionBreakOrder
 	}
 	return pager, nil
 }
 
-func (p *providerPager) applyFilter(query *ProviderQuery) (*ProviderQuery, error) {
+func (p *competitionBreakPager) applyFilter(query *CompetitionBreakQuery) (*CompetitionBreakQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *providerPager) toCursor(pr *Provider) Cursor {
-	return p.order.Field.toCursor(pr)
+func (p *competitionBreakPager) toCursor(cb *CompetitionBreak) Cursor {
+	return p.order.Field.toCursor(cb)
 }
 
-func (p *providerPager) applyCursors(query *ProviderQuery, after, before *Cursor) *ProviderQuery {
+func (p *competitionBreakPager) applyCursors(query *CompetitionBreakQuery, after, before *Cursor) *CompetitionBreakQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultProviderOrder.Field.field,
+		p.order.Field.field, DefaultCompetitionBreakOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *providerPager) applyOrder(query *ProviderQuery, reverse bool) *ProviderQuery {
+func (p *competitionBreakPager) applyOrder(query *CompetitionBreakQuery, reverse bool) *CompetitionBreakQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultProviderOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultProviderOrder.Field.field))
+	if p.order.Field != DefaultCompetitionBreakOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultCompetitionBreakOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Provider.
-func (pr *ProviderQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CompetitionBreak.
+func (cb *CompetitionBreakQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProviderPaginateOption,
-) (*ProviderConnection, error) {
+	before *Cursor, last *int, opts ...CompetitionBreakPaginateOption,
+) (*CompetitionBreakConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProviderPager(opts)
+	pager, err := newCompetitionBreakPager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if pr, err = pager.applyFilter(pr); err != nil {
+	if cb, err = pager.applyFilter(cb); err != nil {
 		return nil, err
 	}
 
-	conn := &ProviderConnection{Edges: []*ProviderEdge{}}
+	conn := &CompetitionBreakConnection{Edges: []*CompetitionBreakEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := pr.Count(ctx)
+			count, err := cb.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -824,15 +833,15 @@ func (pr *ProviderQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := pr.Clone().Count(ctx)
+		count, err := cb.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	pr = pager.applyCursors(pr, after, before)
-	pr = pager.applyOrder(pr, last != nil)
+	cb = pager.applyCursors(cb, after, before)
+	cb = pager.applyOrder(cb, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -840,14 +849,14 @@ func (pr *ProviderQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		pr = pr.Limit(limit)
+		cb = cb.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		pr = pr.collectField(graphql.GetOperationContext(ctx), *field)
+		cb = cb.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := pr.All(ctx)
+	nodes, err := cb.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -858,22 +867,22 @@ func (pr *ProviderQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *Provider
+	var nodeAt func(int) *CompetitionBreak
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Provider {
+		nodeAt = func(i int) *CompetitionBreak {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Provider {
+		nodeAt = func(i int) *CompetitionBreak {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*ProviderEdge, len(nodes))
+	conn.Edges = make([]*CompetitionBreakEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &ProviderEdge{
+		conn.Edges[i] = &CompetitionBreakEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -888,158 +897,158 @@ func (pr *ProviderQuery) Paginate(
 	return conn, nil
 }
 
-// ProviderOrderField defines the ordering field of Provider.
-type ProviderOrderField struct {
+// CompetitionBreakOrderField defines the ordering field of CompetitionBreak.
+type CompetitionBreakOrderField struct {
 	field    string
-	toCursor func(*Provider) Cursor
+	toCursor func(*CompetitionBreak) Cursor
 }
 
-// ProviderOrder defines the ordering of Provider.
-type ProviderOrder struct {
-	Direction OrderDirection      `json:"direction"`
-	Field     *ProviderOrderField `json:"field"`
+// CompetitionBreakOrder defines the ordering of CompetitionBreak.
+type CompetitionBreakOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *CompetitionBreakOrderField `json:"field"`
 }
 
-// DefaultProviderOrder is the default ordering of Provider.
-var DefaultProviderOrder = &ProviderOrder{
+// DefaultCompetitionBreakOrder is the default ordering of CompetitionBreak.
+var DefaultCompetitionBreakOrder = &CompetitionBreakOrder{
 	Direction: OrderDirectionAsc,
-	Field: &ProviderOrderField{
-		field: provider.FieldID,
-		toCursor: func(pr *Provider) Cursor {
-			return Cursor{ID: pr.ID}
+	Field: &CompetitionBreakOrderField{
+		field: competitionbreak.FieldID,
+		toCursor: func(cb *CompetitionBreak) Cursor {
+			return Cursor{ID: cb.ID}
 		},
 	},
 }
 
-// ToEdge converts Provider into ProviderEdge.
-func (pr *Provider) ToEdge(order *ProviderOrder) *ProviderEdge {
+// ToEdge converts CompetitionBreak into CompetitionBreakEdge.
+func (cb *CompetitionBreak) ToEdge(order *CompetitionBreakOrder) *CompetitionBreakEdge {
 	if order == nil {
-		order = DefaultProviderOrder
+		order = DefaultCompetitionBreakOrder
 	}
-	return &ProviderEdge{
-		Node:   pr,
-		Cursor: order.Field.toCursor(pr),
+	return &CompetitionBreakEdge{
+		Node:   cb,
+		Cursor: order.Field.toCursor(cb),
 	}
 }
 
-// ServiceAccountEdge is the edge representation of ServiceAccount.
-type ServiceAccountEdge struct {
-	Node   *ServiceAccount `json:"node"`
+// ConsoleSessionEdge is the edge representation of ConsoleSession.
+type ConsoleSessionEdge struct {
+	Node   *ConsoleSession `json:"node"`
 	Cursor Cursor          `json:"cursor"`
 }
 
-// ServiceAccountConnection is the connection containing edges to ServiceAccount.
-type ServiceAccountConnection struct {
-	Edges      []*ServiceAccountEdge `json:"edges"`
+// ConsoleSessionConnection is the connection containing edges to ConsoleSession.
+type ConsoleSessionConnection struct {
+	Edges      []*ConsoleSessionEdge `json:"edges"`
 	PageInfo   PageInfo              `json:"pageInfo"`
 	TotalCount int                   `json:"totalCount"`
 }
 
-// ServiceAccountPaginateOption enables pagination customization.
-type ServiceAccountPaginateOption func(*serviceAccountPager) error
+// ConsoleSessionPaginateOption enables pagination customization.
+type ConsoleSessionPaginateOption func(*consoleSessionPager) error
 
-// WithServiceAccountOrder configures pagination ordering.
-func WithServiceAccountOrder(order *ServiceAccountOrder) ServiceAccountPaginateOption {
+// WithConsoleSessionOrder configures pagination ordering.
+func WithConsoleSessionOrder(order *ConsoleSessionOrder) ConsoleSessionPaginateOption {
 	if order == nil {
-		order = DefaultServiceAccountOrder
+		order = DefaultConsoleSessionOrder
 	}
 	o := *order
-	return func(pager *serviceAccountPager) error {
+	return func(pager *consoleSessionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultServiceAccountOrder.Field
+			o.Field = DefaultConsoleSessionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithServiceAccountFilter configures pagination filter.
-func WithServiceAccountFilter(filter func(*ServiceAccountQuery) (*ServiceAccountQuery, error)) ServiceAccountPaginateOption {
-	return func(pager *serviceAccountPager) error {
+// WithConsoleSessionFilter configures pagination filter.
+func WithConsoleSessionFilter(filter func(*ConsoleSessionQuery) (*ConsoleSessionQuery, error)) ConsoleSessionPaginateOption {
+	return func(pager *consoleSessionPager) error {
 		if filter == nil {
-			return errors.New("ServiceAccountQuery filter cannot be nil")
+			return errors.New("ConsoleSessionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type serviceAccountPager struct {
-	order  *ServiceAccountOrder
-	filter func(*ServiceAccountQuery) (*ServiceAccountQuery, error)
+type consoleSessionPager struct {
+	order  *ConsoleSessionOrder
+	filter func(*ConsoleSessionQuery) (*ConsoleSessionQuery, error)
 }
 
-func newServiceAccountPager(opts []ServiceAccountPaginateOption) (*serviceAccountPager, error) {
-	pager := &serviceAccountPager{}
+func newConsoleSessionPager(opts []ConsoleSessionPaginateOption) (*consoleSessionPager, error) {
+	pager := &consoleSessionPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultServiceAccountOrder
+		pager.order = DefaultConsoleSessionOrder
 	}
 	return pager, nil
 }
 
-func (p *serviceAccountPager) applyFilter(query *ServiceAccountQuery) (*ServiceAccountQuery, error) {
+func (p *consoleSessionPager) applyFilter(query *ConsoleSessionQuery) (*ConsoleSessionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *serviceAccountPager) toCursor(sa *ServiceAccount) Cursor {
-	return p.order.Field.toCursor(sa)
+func (p *consoleSessionPager) toCursor(cs *ConsoleSession) Cursor {
+	return p.order.Field.toCursor(cs)
 }
 
-func (p *serviceAccountPager) applyCursors(query *ServiceAccountQuery, after, before *Cursor) *ServiceAccountQuery {
+func (p *consoleSessionPager) applyCursors(query *ConsoleSessionQuery, after, before *Cursor) *ConsoleSessionQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultServiceAccountOrder.Field.field,
+		p.order.Field.field, DefaultConsoleSessionOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *serviceAccountPager) applyOrder(query *ServiceAccountQuery, reverse bool) *ServiceAccountQuery {
+func (p *consoleSessionPager) applyOrder(query *ConsoleSessionQuery, reverse bool) *ConsoleSessionQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultServiceAccountOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultServiceAccountOrder.Field.field))
+	if p.order.Field != DefaultConsoleSessionOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultConsoleSessionOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ServiceAccount.
-func (sa *ServiceAccountQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ConsoleSession.
+func (cs *ConsoleSessionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ServiceAccountPaginateOption,
-) (*ServiceAccountConnection, error) {
+	before *Cursor, last *int, opts ...ConsoleSessionPaginateOption,
+) (*ConsoleSessionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newServiceAccountPager(opts)
+	pager, err := newConsoleSessionPager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if sa, err = pager.applyFilter(sa); err != nil {
+	if cs, err = pager.applyFilter(cs); err != nil {
 		return nil, err
 	}
 
-	conn := &ServiceAccountConnection{Edges: []*ServiceAccountEdge{}}
+	conn := &ConsoleSessionConnection{Edges: []*ConsoleSessionEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := sa.Count(ctx)
+			count, err := cs.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1051,15 +1060,15 @@ func (sa *ServiceAccountQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := sa.Clone().Count(ctx)
+		count, err := cs.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	sa = pager.applyCursors(sa, after, before)
-	sa = pager.applyOrder(sa, last != nil)
+	cs = pager.applyCursors(cs, after, before)
+	cs = pager.applyOrder(cs, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -1067,14 +1076,14 @@ func (sa *ServiceAccountQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		sa = sa.Limit(limit)
+		cs = cs.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		sa = sa.collectField(graphql.GetOperationContext(ctx), *field)
+		cs = cs.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := sa.All(ctx)
+	nodes, err := cs.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -1085,22 +1094,22 @@ func (sa *ServiceAccountQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *ServiceAccount
+	var nodeAt func(int) *ConsoleSession
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ServiceAccount {
+		nodeAt = func(i int) *ConsoleSession {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ServiceAccount {
+		nodeAt = func(i int) *ConsoleSession {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*ServiceAccountEdge, len(nodes))
+	conn.Edges = make([]*ConsoleSessionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &ServiceAccountEdge{
+		conn.Edges[i] = &ConsoleSessionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1115,158 +1124,158 @@ func (sa *ServiceAccountQuery) Paginate(
 	return conn, nil
 }
 
-// ServiceAccountOrderField defines the ordering field of ServiceAccount.
-type ServiceAccountOrderField struct {
+// ConsoleSessionOrderField defines the ordering field of ConsoleSession.
+type ConsoleSessionOrderField struct {
 	field    string
-	toCursor func(*ServiceAccount) Cursor
+	toCursor func(*ConsoleSession) Cursor
 }
 
-// ServiceAccountOrder defines the ordering of ServiceAccount.
-type ServiceAccountOrder struct {
+// ConsoleSessionOrder defines the ordering of ConsoleSession.
+type ConsoleSessionOrder struct {
 	Direction OrderDirection            `json:"direction"`
-	Field     *ServiceAccountOrderField `json:"field"`
+	Field     *ConsoleSessionOrderField `json:"field"`
 }
 
-// DefaultServiceAccountOrder is the default ordering of ServiceAccount.
-var DefaultServiceAccountOrder = &ServiceAccountOrder{
+// DefaultConsoleSessionOrder is the default ordering of ConsoleSession.
+var DefaultConsoleSessionOrder = &ConsoleSessionOrder{
 	Direction: OrderDirectionAsc,
-	Field: &ServiceAccountOrderField{
-		field: serviceaccount.FieldID,
-		toCursor: func(sa *ServiceAccount) Cursor {
-			return Cursor{ID: sa.ID}
+	Field: &ConsoleSessionOrderField{
+		field: consolesession.FieldID,
+		toCursor: func(cs *ConsoleSession) Cursor {
+			return Cursor{ID: cs.ID}
 		},
 	},
 }
 
-// ToEdge converts ServiceAccount into ServiceAccountEdge.
-func (sa *ServiceAccount) ToEdge(order *ServiceAccountOrder) *ServiceAccountEdge {
+// ToEdge converts ConsoleSession into ConsoleSessionEdge.
+func (cs *ConsoleSession) ToEdge(order *ConsoleSessionOrder) *ConsoleSessionEdge {
 	if order == nil {
-		order = DefaultServiceAccountOrder
+		order = DefaultConsoleSessionOrder
 	}
-	return &ServiceAccountEdge{
-		Node:   sa,
-		Cursor: order.Field.toCursor(sa),
+	return &ConsoleSessionEdge{
+		Node:   cs,
+		Cursor: order.Field.toCursor(cs),
 	}
 }
 
-// ServiceTokenEdge is the edge representation of ServiceToken.
-type ServiceTokenEdge struct {
-	Node   *ServiceToken `json:"node"`
+// ConsoleShareEdge is the edge representation of ConsoleShare.
+type ConsoleShareEdge struct {
+	Node   *ConsoleShare `json:"node"`
 	Cursor Cursor        `json:"cursor"`
 }
 
-// ServiceTokenConnection is the connection containing edges to ServiceToken.
-type ServiceTokenConnection struct {
-	Edges      []*ServiceTokenEdge `json:"edges"`
+// ConsoleShareConnection is the connection containing edges to ConsoleShare.
+type ConsoleShareConnection struct {
+	Edges      []*ConsoleShareEdge `json:"edges"`
 	PageInfo   PageInfo            `json:"pageInfo"`
 	TotalCount int                 `json:"totalCount"`
 }
 
-// ServiceTokenPaginateOption enables pagination customization.
-type ServiceTokenPaginateOption func(*serviceTokenPager) error
+// ConsoleSharePaginateOption enables pagination customization.
+type ConsoleSharePaginateOption func(*consoleSharePager) error
 
-// WithServiceTokenOrder configures pagination ordering.
-func WithServiceTokenOrder(order *ServiceTokenOrder) ServiceTokenPaginateOption {
+// WithConsoleShareOrder configures pagination ordering.
+func WithConsoleShareOrder(order *ConsoleShareOrder) ConsoleSharePaginateOption {
 	if order == nil {
-		order = DefaultServiceTokenOrder
+		order = DefaultConsoleShareOrder
 	}
 	o := *order
-	return func(pager *serviceTokenPager) error {
+	return func(pager *consoleSharePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultServiceTokenOrder.Field
+			o.Field = DefaultConsoleShareOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithServiceTokenFilter configures pagination filter.
-func WithServiceTokenFilter(filter func(*ServiceTokenQuery) (*ServiceTokenQuery, error)) ServiceTokenPaginateOption {
-	return func(pager *serviceTokenPager) error {
+// WithConsoleShareFilter configures pagination filter.
+func WithConsoleShareFilter(filter func(*ConsoleShareQuery) (*ConsoleShareQuery, error)) ConsoleSharePaginateOption {
+	return func(pager *consoleSharePager) error {
 		if filter == nil {
-			return errors.New("ServiceTokenQuery filter cannot be nil")
+			return errors.New("ConsoleShareQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type serviceTokenPager struct {
-	order  *ServiceTokenOrder
-	filter func(*ServiceTokenQuery) (*ServiceTokenQuery, error)
+type consoleSharePager struct {
+	order  *ConsoleShareOrder
+	filter func(*ConsoleShareQuery) (*ConsoleShareQuery, error)
 }
 
-func newServiceTokenPager(opts []ServiceTokenPaginateOption) (*serviceTokenPager, error) {
-	pager := &serviceTokenPager{}
+func newConsoleSharePager(opts []ConsoleSharePaginateOption) (*consoleSharePager, error) {
+	pager := &consoleSharePager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultServiceTokenOrder
+		pager.order = DefaultConsoleShareOrder
 	}
 	return pager, nil
 }
 
-func (p *serviceTokenPager) applyFilter(query *ServiceTokenQuery) (*ServiceTokenQuery, error) {
+func (p *consoleSharePager) applyFilter(query *ConsoleShareQuery) (*ConsoleShareQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *serviceTokenPager) toCursor(st *ServiceToken) Cursor {
-	return p.order.Field.toCursor(st)
+func (p *consoleSharePager) toCursor(cs *ConsoleShare) Cursor {
+	return p.order.Field.toCursor(cs)
 }
 
-func (p *serviceTokenPager) applyCursors(query *ServiceTokenQuery, after, before *Cursor) *ServiceTokenQuery {
+func (p *consoleSharePager) applyCursors(query *ConsoleShareQuery, after, before *Cursor) *ConsoleShareQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultServiceTokenOrder.Field.field,
+		p.order.Field.field, DefaultConsoleShareOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *serviceTokenPager) applyOrder(query *ServiceTokenQuery, reverse bool) *ServiceTokenQuery {
+func (p *consoleSharePager) applyOrder(query *ConsoleShareQuery, reverse bool) *ConsoleShareQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultServiceTokenOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultServiceTokenOrder.Field.field))
+	if p.order.Field != DefaultConsoleShareOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultConsoleShareOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ServiceToken.
-func (st *ServiceTokenQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ConsoleShare.
+func (cs *ConsoleShareQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ServiceTokenPaginateOption,
-) (*ServiceTokenConnection, error) {
+	before *Cursor, last *int, opts ...ConsoleSharePaginateOption,
+) (*ConsoleShareConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newServiceTokenPager(opts)
+	pager, err := newConsoleSharePager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if st, err = pager.applyFilter(st); err != nil {
+	if cs, err = pager.applyFilter(cs); err != nil {
 		return nil, err
 	}
 
-	conn := &ServiceTokenConnection{Edges: []*ServiceTokenEdge{}}
+	conn := &ConsoleShareConnection{Edges: []*ConsoleShareEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := st.Count(ctx)
+			count, err := cs.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1278,15 +1287,15 @@ func (st *ServiceTokenQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := st.Clone().Count(ctx)
+		count, err := cs.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	st = pager.applyCursors(st, after, before)
-	st = pager.applyOrder(st, last != nil)
+	cs = pager.applyCursors(cs, after, before)
+	cs = pager.applyOrder(cs, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -1294,14 +1303,14 @@ func (st *ServiceTokenQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		st = st.Limit(limit)
+		cs = cs.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		st = st.collectField(graphql.GetOperationContext(ctx), *field)
+		cs = cs.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := st.All(ctx)
+	nodes, err := cs.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -1312,22 +1321,22 @@ func (st *ServiceTokenQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *ServiceToken
+	var nodeAt func(int) *ConsoleShare
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ServiceToken {
+		nodeAt = func(i int) *ConsoleShare {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ServiceToken {
+		nodeAt = func(i int) *ConsoleShare {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*ServiceTokenEdge, len(nodes))
+	conn.Edges = make([]*ConsoleShareEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &ServiceTokenEdge{
+		conn.Edges[i] = &ConsoleShareEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1342,158 +1351,158 @@ func (st *ServiceTokenQuery) Paginate(
 	return conn, nil
 }
 
-// ServiceTokenOrderField defines the ordering field of ServiceToken.
-type ServiceTokenOrderField struct {
+// ConsoleShareOrderField defines the ordering field of ConsoleShare.
+type ConsoleShareOrderField struct {
 	field    string
-	toCursor func(*ServiceToken) Cursor
+	toCursor func(*ConsoleShare) Cursor
 }
 
-// ServiceTokenOrder defines the ordering of ServiceToken.
-type ServiceTokenOrder struct {
+// ConsoleShareOrder defines the ordering of ConsoleShare.
+type ConsoleShareOrder struct {
 	Direction OrderDirection          `json:"direction"`
-	Field     *ServiceTokenOrderField `json:"field"`
+	Field     *ConsoleShareOrderField `json:"field"`
 }
 
-// DefaultServiceTokenOrder is the default ordering of ServiceToken.
-var DefaultServiceTokenOrder = &ServiceTokenOrder{
+// DefaultConsoleShareOrder is the default ordering of ConsoleShare.
+var DefaultConsoleShareOrder = &ConsoleShareOrder{
 	Direction: OrderDirectionAsc,
-	Field: &ServiceTokenOrderField{
-		field: servicetoken.FieldID,
-		toCursor: func(st *ServiceToken) Cursor {
-			return Cursor{ID: st.ID}
+	Field: &ConsoleShareOrderField{
+		field: consoleshare.FieldID,
+		toCursor: func(cs *ConsoleShare) Cursor {
+			return Cursor{ID: cs.ID}
 		},
 	},
 }
 
-// ToEdge converts ServiceToken into ServiceTokenEdge.
-func (st *ServiceToken) ToEdge(order *ServiceTokenOrder) *ServiceTokenEdge {
+// ToEdge converts ConsoleShare into ConsoleShareEdge.
+func (cs *ConsoleShare) ToEdge(order *ConsoleShareOrder) *ConsoleShareEdge {
 	if order == nil {
-		order = DefaultServiceTokenOrder
+		order = DefaultConsoleShareOrder
 	}
-	return &ServiceTokenEdge{
-		Node:   st,
-		Cursor: order.Field.toCursor(st),
+	return &ConsoleShareEdge{
+		Node:   cs,
+		Cursor: order.Field.toCursor(cs),
 	}
 }
 
-// TeamEdge is the edge representation of Team.
-type TeamEdge struct {
-	Node   *Team  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// CustomRoleEdge is the edge representation of CustomRole.
+type CustomRoleEdge struct {
+	Node   *CustomRole `json:"node"`
+	Cursor Cursor      `json:"cursor"`
 }
 
-// TeamConnection is the connection containing edges to Team.
-type TeamConnection struct {
-	Edges      []*TeamEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// CustomRoleConnection is the connection containing edges to CustomRole.
+type CustomRoleConnection struct {
+	Edges      []*CustomRoleEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
 }
 
-// TeamPaginateOption enables pagination customization.
-type TeamPaginateOption func(*teamPager) error
+// CustomRolePaginateOption enables pagination customization.
+type CustomRolePaginateOption func(*customRolePager) error
 
-// WithTeamOrder configures pagination ordering.
-func WithTeamOrder(order *TeamOrder) TeamPaginateOption {
+// WithCustomRoleOrder configures pagination ordering.
+func WithCustomRoleOrder(order *CustomRoleOrder) CustomRolePaginateOption {
 	if order == nil {
-		order = DefaultTeamOrder
+		order = DefaultCustomRoleOrder
 	}
 	o := *order
-	return func(pager *teamPager) error {
+	return func(pager *customRolePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultTeamOrder.Field
+			o.Field = DefaultCustomRoleOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithTeamFilter configures pagination filter.
-func WithTeamFilter(filter func(*TeamQuery) (*TeamQuery, error)) TeamPaginateOption {
-	return func(pager *teamPager) error {
+// WithCustomRoleFilter configures pagination filter.
+func WithCustomRoleFilter(filter func(*CustomRoleQuery) (*CustomRoleQuery, error)) CustomRolePaginateOption {
+	return func(pager *customRolePager) error {
 		if filter == nil {
-			return errors.New("TeamQuery filter cannot be nil")
+			return errors.New("CustomRoleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type teamPager struct {
-	order  *TeamOrder
-	filter func(*TeamQuery) (*TeamQuery, error)
+type customRolePager struct {
+	order  *CustomRoleOrder
+	filter func(*CustomRoleQuery) (*CustomRoleQuery, error)
 }
 
-func newTeamPager(opts []TeamPaginateOption) (*teamPager, error) {
-	pager := &teamPager{}
+func newCustomRolePager(opts []CustomRolePaginateOption) (*customRolePager, error) {
+	pager := &customRolePager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultTeamOrder
+		pager.order = DefaultCustomRoleOrder
 	}
 	return pager, nil
 }
 
-func (p *teamPager) applyFilter(query *TeamQuery) (*TeamQuery, error) {
+func (p *customRolePager) applyFilter(query *CustomRoleQuery) (*CustomRoleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *teamPager) toCursor(t *Team) Cursor {
-	return p.order.Field.toCursor(t)
+func (p *customRolePager) toCursor(cr *CustomRole) Cursor {
+	return p.order.Field.toCursor(cr)
 }
 
-func (p *teamPager) applyCursors(query *TeamQuery, after, before *Cursor) *TeamQuery {
+func (p *customRolePager) applyCursors(query *CustomRoleQuery, after, before *Cursor) *CustomRoleQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultTeamOrder.Field.field,
+		p.order.Field.field, DefaultCustomRoleOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *teamPager) applyOrder(query *TeamQuery, reverse bool) *TeamQuery {
+func (p *customRolePager) applyOrder(query *CustomRoleQuery, reverse bool) *CustomRoleQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultTeamOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultTeamOrder.Field.field))
+	if p.order.Field != DefaultCustomRoleOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultCustomRoleOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Team.
-func (t *TeamQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CustomRole.
+func (cr *CustomRoleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...TeamPaginateOption,
-) (*TeamConnection, error) {
+	before *Cursor, last *int, opts ...CustomRolePaginateOption,
+) (*CustomRoleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newTeamPager(opts)
+	pager, err := newCustomRolePager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if t, err = pager.applyFilter(t); err != nil {
+	if cr, err = pager.applyFilter(cr); err != nil {
 		return nil, err
 	}
 
-	conn := &TeamConnection{Edges: []*TeamEdge{}}
+	conn := &CustomRoleConnection{Edges: []*CustomRoleEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := t.Count(ctx)
+			count, err := cr.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1505,15 +1514,15 @@ func (t *TeamQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := t.Clone().Count(ctx)
+		count, err := cr.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	t = pager.applyCursors(t, after, before)
-	t = pager.applyOrder(t, last != nil)
+	cr = pager.applyCursors(cr, after, before)
+	cr = pager.applyOrder(cr, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -1521,14 +1530,1376 @@ func (t *TeamQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		t = t.Limit(limit)
+		cr = cr.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		t = t.collectField(graphql.GetOperationContext(ctx), *field)
+		cr = cr.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := t.All(ctx)
+	nodes, err := cr.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *CustomRole
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *CustomRole {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *CustomRole {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*CustomRoleEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &CustomRoleEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// CustomRoleOrderField defines the ordering field of CustomRole.
+type CustomRoleOrderField struct {
+	field    string
+	toCursor func(*CustomRole) Cursor
+}
+
+// CustomRoleOrder defines the ordering of CustomRole.
+type CustomRoleOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *CustomRoleOrderField `json:"field"`
+}
+
+// DefaultCustomRoleOrder is the default ordering of CustomRole.
+var DefaultCustomRoleOrder = &CustomRoleOrder{
+	Direction: OrderDirectionAsc,
+	Field: &CustomRoleOrderField{
+		field: customrole.FieldID,
+		toCursor: func(cr *CustomRole) Cursor {
+			return Cursor{ID: cr.ID}
+		},
+	},
+}
+
+// ToEdge converts CustomRole into CustomRoleEdge.
+func (cr *CustomRole) ToEdge(order *CustomRoleOrder) *CustomRoleEdge {
+	if order == nil {
+		order = DefaultCustomRoleOrder
+	}
+	return &CustomRoleEdge{
+		Node:   cr,
+		Cursor: order.Field.toCursor(cr),
+	}
+}
+
+// PersonalAccessTokenEdge is the edge representation of PersonalAccessToken.
+type PersonalAccessTokenEdge struct {
+	Node   *PersonalAccessToken `json:"node"`
+	Cursor Cursor               `json:"cursor"`
+}
+
+// PersonalAccessTokenConnection is the connection containing edges to PersonalAccessToken.
+type PersonalAccessTokenConnection struct {
+	Edges      []*PersonalAccessTokenEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
+}
+
+// PersonalAccessTokenPaginateOption enables pagination customization.
+type PersonalAccessTokenPaginateOption func(*personalAccessTokenPager) error
+
+// WithPersonalAccessTokenOrder configures pagination ordering.
+func WithPersonalAccessTokenOrder(order *PersonalAccessTokenOrder) PersonalAccessTokenPaginateOption {
+	if order == nil {
+		order = DefaultPersonalAccessTokenOrder
+	}
+	o := *order
+	return func(pager *personalAccessTokenPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPersonalAccessTokenOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPersonalAccessTokenFilter configures pagination filter.
+func WithPersonalAccessTokenFilter(filter func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)) PersonalAccessTokenPaginateOption {
+	return func(pager *personalAccessTokenPager) error {
+		if filter == nil {
+			return errors.New("PersonalAccessTokenQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type personalAccessTokenPager struct {
+	order  *PersonalAccessTokenOrder
+	filter func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)
+}
+
+func newPersonalAccessTokenPager(opts []PersonalAccessTokenPaginateOption) (*personalAccessTokenPager, error) {
+	pager := &personalAccessTokenPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPersonalAccessTokenOrder
+	}
+	return pager, nil
+}
+
+func (p *personalAccessTokenPager) applyFilter(query *PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *personalAccessTokenPager) toCursor(pat *PersonalAccessToken) Cursor {
+	return p.order.Field.toCursor(pat)
+}
+
+func (p *personalAccessTokenPager) applyCursors(query *PersonalAccessTokenQuery, after, before *Cursor) *PersonalAccessTokenQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultPersonalAccessTokenOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *personalAccessTokenPager) applyOrder(query *PersonalAccessTokenQuery, reverse bool) *PersonalAccessTokenQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultPersonalAccessTokenOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultPersonalAccessTokenOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to PersonalAccessToken.
+func (pat *PersonalAccessTokenQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PersonalAccessTokenPaginateOption,
+) (*PersonalAccessTokenConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPersonalAccessTokenPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if pat, err = pager.applyFilter(pat); err != nil {
+		return nil, err
+	}
+
+	conn := &PersonalAccessTokenConnection{Edges: []*PersonalAccessTokenEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := pat.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := pat.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	pat = pager.applyCursors(pat, after, before)
+	pat = pager.applyOrder(pat, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		pat = pat.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		pat = pat.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := pat.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *PersonalAccessToken
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *PersonalAccessToken {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *PersonalAccessToken {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*PersonalAccessTokenEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &PersonalAccessTokenEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// PersonalAccessTokenOrderField defines the ordering field of PersonalAccessToken.
+type PersonalAccessTokenOrderField struct {
+	field    string
+	toCursor func(*PersonalAccessToken) Cursor
+}
+
+// PersonalAccessTokenOrder defines the ordering of PersonalAccessToken.
+type PersonalAccessTokenOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *PersonalAccessTokenOrderField `json:"field"`
+}
+
+// DefaultPersonalAccessTokenOrder is the default ordering of PersonalAccessToken.
+var DefaultPersonalAccessTokenOrder = &PersonalAccessTokenOrder{
+	Direction: OrderDirectionAsc,
+	Field: &PersonalAccessTokenOrderField{
+		field: personalaccesstoken.FieldID,
+		toCursor: func(pat *PersonalAccessToken) Cursor {
+			return Cursor{ID: pat.ID}
+		},
+	},
+}
+
+// ToEdge converts PersonalAccessToken into PersonalAccessTokenEdge.
+func (pat *PersonalAccessToken) ToEdge(order *PersonalAccessTokenOrder) *PersonalAccessTokenEdge {
+	if order == nil {
+		order = DefaultPersonalAccessTokenOrder
+	}
+	return &PersonalAccessTokenEdge{
+		Node:   pat,
+		Cursor: order.Field.toCursor(pat),
+	}
+}
+
+// ProviderEdge is the edge representation of Provider.
+type ProviderEdge struct {
+	Node   *Provider `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// ProviderConnection is the connection containing edges to Provider.
+type ProviderConnection struct {
+	Edges      []*ProviderEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+// ProviderPaginateOption enables pagination customization.
+type ProviderPaginateOption func(*providerPager) error
+
+// WithProviderOrder configures pagination ordering.
+func WithProviderOrder(order *ProviderOrder) ProviderPaginateOption {
+	if order == nil {
+		order = DefaultProviderOrder
+	}
+	o := *order
+	return func(pager *providerPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProviderOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProviderFilter configures pagination filter.
+func WithProviderFilter(filter func(*ProviderQuery) (*ProviderQuery, error)) ProviderPaginateOption {
+	return func(pager *providerPager) error {
+		if filter == nil {
+			return errors.New("ProviderQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type providerPager struct {
+	order  *ProviderOrder
+	filter func(*ProviderQuery) (*ProviderQuery, error)
+}
+
+func newProviderPager(opts []ProviderPaginateOption) (*providerPager, error) {
+	pager := &providerPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProviderOrder
+	}
+	return pager, nil
+}
+
+func (p *providerPager) applyFilter(query *ProviderQuery) (*ProviderQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *providerPager) toCursor(pr *Provider) Cursor {
+	return p.order.Field.toCursor(pr)
+}
+
+func (p *providerPager) applyCursors(query *ProviderQuery, after, before *Cursor) *ProviderQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultProviderOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *providerPager) applyOrder(query *ProviderQuery, reverse bool) *ProviderQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultProviderOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultProviderOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Provider.
+func (pr *ProviderQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProviderPaginateOption,
+) (*ProviderConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProviderPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr, err = pager.applyFilter(pr); err != nil {
+		return nil, err
+	}
+
+	conn := &ProviderConnection{Edges: []*ProviderEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := pr.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := pr.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	pr = pager.applyCursors(pr, after, before)
+	pr = pager.applyOrder(pr, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		pr = pr.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		pr = pr.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := pr.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *Provider
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Provider {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Provider {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*ProviderEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &ProviderEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// ProviderOrderField defines the ordering field of Provider.
+type ProviderOrderField struct {
+	field    string
+	toCursor func(*Provider) Cursor
+}
+
+// ProviderOrder defines the ordering of Provider.
+type ProviderOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *ProviderOrderField `json:"field"`
+}
+
+// DefaultProviderOrder is the default ordering of Provider.
+var DefaultProviderOrder = &ProviderOrder{
+	Direction: OrderDirectionAsc,
+	Field: &ProviderOrderField{
+		field: provider.FieldID,
+		toCursor: func(pr *Provider) Cursor {
+			return Cursor{ID: pr.ID}
+		},
+	},
+}
+
+// ToEdge converts Provider into ProviderEdge.
+func (pr *Provider) ToEdge(order *ProviderOrder) *ProviderEdge {
+	if order == nil {
+		order = DefaultProviderOrder
+	}
+	return &ProviderEdge{
+		Node:   pr,
+		Cursor: order.Field.toCursor(pr),
+	}
+}
+
+// ServiceAccountEdge is the edge representation of ServiceAccount.
+type ServiceAccountEdge struct {
+	Node   *ServiceAccount `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// ServiceAccountConnection is the connection containing edges to ServiceAccount.
+type ServiceAccountConnection struct {
+	Edges      []*ServiceAccountEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+// ServiceAccountPaginateOption enables pagination customization.
+type ServiceAccountPaginateOption func(*serviceAccountPager) error
+
+// WithServiceAccountOrder configures pagination ordering.
+func WithServiceAccountOrder(order *ServiceAccountOrder) ServiceAccountPaginateOption {
+	if order == nil {
+		order = DefaultServiceAccountOrder
+	}
+	o := *order
+	return func(pager *serviceAccountPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultServiceAccountOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithServiceAccountFilter configures pagination filter.
+func WithServiceAccountFilter(filter func(*ServiceAccountQuery) (*ServiceAccountQuery, error)) ServiceAccountPaginateOption {
+	return func(pager *serviceAccountPager) error {
+		if filter == nil {
+			return errors.New("ServiceAccountQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type serviceAccountPager struct {
+	order  *ServiceAccountOrder
+	filter func(*ServiceAccountQuery) (*ServiceAccountQuery, error)
+}
+
+func newServiceAccountPager(opts []ServiceAccountPaginateOption) (*serviceAccountPager, error) {
+	pager := &serviceAccountPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultServiceAccountOrder
+	}
+	return pager, nil
+}
+
+func (p *serviceAccountPager) applyFilter(query *ServiceAccountQuery) (*ServiceAccountQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *serviceAccountPager) toCursor(sa *ServiceAccount) Cursor {
+	return p.order.Field.toCursor(sa)
+}
+
+func (p *serviceAccountPager) applyCursors(query *ServiceAccountQuery, after, before *Cursor) *ServiceAccountQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultServiceAccountOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *serviceAccountPager) applyOrder(query *ServiceAccountQuery, reverse bool) *ServiceAccountQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultServiceAccountOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultServiceAccountOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ServiceAccount.
+func (sa *ServiceAccountQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ServiceAccountPaginateOption,
+) (*ServiceAccountConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newServiceAccountPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if sa, err = pager.applyFilter(sa); err != nil {
+		return nil, err
+	}
+
+	conn := &ServiceAccountConnection{Edges: []*ServiceAccountEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := sa.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := sa.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	sa = pager.applyCursors(sa, after, before)
+	sa = pager.applyOrder(sa, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		sa = sa.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		sa = sa.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := sa.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *ServiceAccount
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ServiceAccount {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ServiceAccount {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*ServiceAccountEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &ServiceAccountEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// ServiceAccountOrderField defines the ordering field of ServiceAccount.
+type ServiceAccountOrderField struct {
+	field    string
+	toCursor func(*ServiceAccount) Cursor
+}
+
+// ServiceAccountOrder defines the ordering of ServiceAccount.
+type ServiceAccountOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *ServiceAccountOrderField `json:"field"`
+}
+
+// DefaultServiceAccountOrder is the default ordering of ServiceAccount.
+var DefaultServiceAccountOrder = &ServiceAccountOrder{
+	Direction: OrderDirectionAsc,
+	Field: &ServiceAccountOrderField{
+		field: serviceaccount.FieldID,
+		toCursor: func(sa *ServiceAccount) Cursor {
+			return Cursor{ID: sa.ID}
+		},
+	},
+}
+
+// ToEdge converts ServiceAccount into ServiceAccountEdge.
+func (sa *ServiceAccount) ToEdge(order *ServiceAccountOrder) *ServiceAccountEdge {
+	if order == nil {
+		order = DefaultServiceAccountOrder
+	}
+	return &ServiceAccountEdge{
+		Node:   sa,
+		Cursor: order.Field.toCursor(sa),
+	}
+}
+
+// ServiceTokenEdge is the edge representation of ServiceToken.
+type ServiceTokenEdge struct {
+	Node   *ServiceToken `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// ServiceTokenConnection is the connection containing edges to ServiceToken.
+type ServiceTokenConnection struct {
+	Edges      []*ServiceTokenEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+// ServiceTokenPaginateOption enables pagination customization.
+type ServiceTokenPaginateOption func(*serviceTokenPager) error
+
+// WithServiceTokenOrder configures pagination ordering.
+func WithServiceTokenOrder(order *ServiceTokenOrder) ServiceTokenPaginateOption {
+	if order == nil {
+		order = DefaultServiceTokenOrder
+	}
+	o := *order
+	return func(pager *serviceTokenPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultServiceTokenOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithServiceTokenFilter configures pagination filter.
+func WithServiceTokenFilter(filter func(*ServiceTokenQuery) (*ServiceTokenQuery, error)) ServiceTokenPaginateOption {
+	return func(pager *serviceTokenPager) error {
+		if filter == nil {
+			return errors.New("ServiceTokenQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type serviceTokenPager struct {
+	order  *ServiceTokenOrder
+	filter func(*ServiceTokenQuery) (*ServiceTokenQuery, error)
+}
+
+func newServiceTokenPager(opts []ServiceTokenPaginateOption) (*serviceTokenPager, error) {
+	pager := &serviceTokenPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultServiceTokenOrder
+	}
+	return pager, nil
+}
+
+func (p *serviceTokenPager) applyFilter(query *ServiceTokenQuery) (*ServiceTokenQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *serviceTokenPager) toCursor(st *ServiceToken) Cursor {
+	return p.order.Field.toCursor(st)
+}
+
+func (p *serviceTokenPager) applyCursors(query *ServiceTokenQuery, after, before *Cursor) *ServiceTokenQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultServiceTokenOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *serviceTokenPager) applyOrder(query *ServiceTokenQuery, reverse bool) *ServiceTokenQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultServiceTokenOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultServiceTokenOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ServiceToken.
+func (st *ServiceTokenQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ServiceTokenPaginateOption,
+) (*ServiceTokenConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newServiceTokenPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if st, err = pager.applyFilter(st); err != nil {
+		return nil, err
+	}
+
+	conn := &ServiceTokenConnection{Edges: []*ServiceTokenEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := st.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := st.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	st = pager.applyCursors(st, after, before)
+	st = pager.applyOrder(st, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		st = st.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		st = st.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := st.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *ServiceToken
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ServiceToken {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ServiceToken {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*ServiceTokenEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &ServiceTokenEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// ServiceTokenOrderField defines the ordering field of ServiceToken.
+type ServiceTokenOrderField struct {
+	field    string
+	toCursor func(*ServiceToken) Cursor
+}
+
+// ServiceTokenOrder defines the ordering of ServiceToken.
+type ServiceTokenOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *ServiceTokenOrderField `json:"field"`
+}
+
+// DefaultServiceTokenOrder is the default ordering of ServiceToken.
+var DefaultServiceTokenOrder = &ServiceTokenOrder{
+	Direction: OrderDirectionAsc,
+	Field: &ServiceTokenOrderField{
+		field: servicetoken.FieldID,
+		toCursor: func(st *ServiceToken) Cursor {
+			return Cursor{ID: st.ID}
+		},
+	},
+}
+
+// ToEdge converts ServiceToken into ServiceTokenEdge.
+func (st *ServiceToken) ToEdge(order *ServiceTokenOrder) *ServiceTokenEdge {
+	if order == nil {
+		order = DefaultServiceTokenOrder
+	}
+	return &ServiceTokenEdge{
+		Node:   st,
+		Cursor: order.Field.toCursor(st),
+	}
+}
+
+// SigningKeyEdge is the edge representation of SigningKey.
+type SigningKeyEdge struct {
+	Node   *SigningKey `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// SigningKeyConnection is the connection containing edges to SigningKey.
+type SigningKeyConnection struct {
+	Edges      []*SigningKeyEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// SigningKeyPaginateOption enables pagination customization.
+type SigningKeyPaginateOption func(*signingKeyPager) error
+
+// WithSigningKeyOrder configures pagination ordering.
+func WithSigningKeyOrder(order *SigningKeyOrder) SigningKeyPaginateOption {
+	if order == nil {
+		order = DefaultSigningKeyOrder
+	}
+	o := *order
+	return func(pager *signingKeyPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSigningKeyOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSigningKeyFilter configures pagination filter.
+func WithSigningKeyFilter(filter func(*SigningKeyQuery) (*SigningKeyQuery, error)) SigningKeyPaginateOption {
+	return func(pager *signingKeyPager) error {
+		if filter == nil {
+			return errors.New("SigningKeyQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type signingKeyPager struct {
+	order  *SigningKeyOrder
+	filter func(*SigningKeyQuery) (*SigningKeyQuery, error)
+}
+
+func newSigningKeyPager(opts []SigningKeyPaginateOption) (*signingKeyPager, error) {
+	pager := &signingKeyPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSigningKeyOrder
+	}
+	return pager, nil
+}
+
+func (p *signingKeyPager) applyFilter(query *SigningKeyQuery) (*SigningKeyQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *signingKeyPager) toCursor(sk *SigningKey) Cursor {
+	return p.order.Field.toCursor(sk)
+}
+
+func (p *signingKeyPager) applyCursors(query *SigningKeyQuery, after, before *Cursor) *SigningKeyQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultSigningKeyOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *signingKeyPager) applyOrder(query *SigningKeyQuery, reverse bool) *SigningKeyQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultSigningKeyOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultSigningKeyOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SigningKey.
+func (sk *SigningKeyQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SigningKeyPaginateOption,
+) (*SigningKeyConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSigningKeyPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if sk, err = pager.applyFilter(sk); err != nil {
+		return nil, err
+	}
+
+	conn := &SigningKeyConnection{Edges: []*SigningKeyEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := sk.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := sk.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	sk = pager.applyCursors(sk, after, before)
+	sk = pager.applyOrder(sk, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		sk = sk.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		sk = sk.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := sk.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *SigningKey
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SigningKey {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SigningKey {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*SigningKeyEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &SigningKeyEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// SigningKeyOrderField defines the ordering field of SigningKey.
+type SigningKeyOrderField struct {
+	field    string
+	toCursor func(*SigningKey) Cursor
+}
+
+// SigningKeyOrder defines the ordering of SigningKey.
+type SigningKeyOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *SigningKeyOrderField `json:"field"`
+}
+
+// DefaultSigningKeyOrder is the default ordering of SigningKey.
+var DefaultSigningKeyOrder = &SigningKeyOrder{
+	Direction: OrderDirectionAsc,
+	Field: &SigningKeyOrderField{
+		field: signingkey.FieldID,
+		toCursor: func(sk *SigningKey) Cursor {
+			return Cursor{ID: sk.ID}
+		},
+	},
+}
+
+// ToEdge converts SigningKey into SigningKeyEdge.
+func (sk *SigningKey) ToEdge(order *SigningKeyOrder) *SigningKeyEdge {
+	if order == nil {
+		order = DefaultSigningKeyOrder
+	}
+	return &SigningKeyEdge{
+		Node:   sk,
+		Cursor: order.Field.toCursor(sk),
+	}
+}
+
+// TeamEdge is the edge representation of Team.
+type TeamEdge struct {
+	Node   *Team  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// TeamConnection is the connection containing edges to Team.
+type TeamConnection struct {
+	Edges      []*TeamEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+// TeamPaginateOption enables pagination customization.
+type TeamPaginateOption func(*teamPager) error
+
+// WithTeamOrder configures pagination ordering.
+func WithTeamOrder(order *TeamOrder) TeamPaginateOption {
+	if order == nil {
+		order = DefaultTeamOrder
+	}
+	o := *order
+	return func(pager *teamPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultTeamOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithTeamFilter configures pagination filter.
+func WithTeamFilter(filter func(*TeamQuery) (*TeamQuery, error)) TeamPaginateOption {
+	return func(pager *teamPager) error {
+		if filter == nil {
+			return errors.New("TeamQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type teamPager struct {
+	order  *TeamOrder
+	filter func(*TeamQuery) (*TeamQuery, error)
+}
+
+func newTeamPager(opts []TeamPaginateOption) (*teamPager, error) {
+	pager := &teamPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultTeamOrder
+	}
+	return pager, nil
+}
+
+func (p *teamPager) applyFilter(query *TeamQuery) (*TeamQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *teamPager) toCursor(t *Team) Cursor {
+	return p.order.Field.toCursor(t)
+}
+
+func (p *teamPager) applyCursors(query *TeamQuery, after, before *Cursor) *TeamQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultTeamOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *teamPager) applyOrder(query *TeamQuery, reverse bool) *TeamQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultTeamOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultTeamOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Team.
+func (t *TeamQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...TeamPaginateOption,
+) (*TeamConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTeamPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if t, err = pager.applyFilter(t); err != nil {
+		return nil, err
+	}
+
+	conn := &TeamConnection{Edges: []*TeamEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := t.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := t.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	t = pager.applyCursors(t, after, before)
+	t = pager.applyOrder(t, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		t = t.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		t = t.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := t.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -1542,19 +2913,700 @@ func (t *TeamQuery) Paginate(
 	var nodeAt func(int) *Team
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Team {
+		nodeAt = func(i int) *Team {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Team {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*TeamEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &TeamEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// TeamOrderField defines the ordering field of Team.
+type TeamOrderField struct {
+	field    string
+	toCursor func(*Team) Cursor
+}
+
+// TeamOrder defines the ordering of Team.
+type TeamOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *TeamOrderField `json:"field"`
+}
+
+// DefaultTeamOrder is the default ordering of Team.
+var DefaultTeamOrder = &TeamOrder{
+	Direction: OrderDirectionAsc,
+	Field: &TeamOrderField{
+		field: team.FieldID,
+		toCursor: func(t *Team) Cursor {
+			return Cursor{ID: t.ID}
+		},
+	},
+}
+
+// ToEdge converts Team into TeamEdge.
+func (t *Team) ToEdge(order *TeamOrder) *TeamEdge {
+	if order == nil {
+		order = DefaultTeamOrder
+	}
+	return &TeamEdge{
+		Node:   t,
+		Cursor: order.Field.toCursor(t),
+	}
+}
+
+// TeamMembershipEdge is the edge representation of TeamMembership.
+type TeamMembershipEdge struct {
+	Node   *TeamMembership `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// TeamMembershipConnection is the connection containing edges to TeamMembership.
+type TeamMembershipConnection struct {
+	Edges      []*TeamMembershipEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+// TeamMembershipPaginateOption enables pagination customization.
+type TeamMembershipPaginateOption func(*teamMembershipPager) error
+
+// WithTeamMembershipOrder configures pagination ordering.
+func WithTeamMembershipOrder(order *TeamMembershipOrder) TeamMembershipPaginateOption {
+	if order == nil {
+		order = DefaultTeamMembershipOrder
+	}
+	o := *order
+	return func(pager *teamMembershipPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultTeamMembershipOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithTeamMembershipFilter configures pagination filter.
+func WithTeamMembershipFilter(filter func(*TeamMembershipQuery) (*TeamMembershipQuery, error)) TeamMembershipPaginateOption {
+	return func(pager *teamMembershipPager) error {
+		if filter == nil {
+			return errors.New("TeamMembershipQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type teamMembershipPager struct {
+	order  *TeamMembershipOrder
+	filter func(*TeamMembershipQuery) (*TeamMembershipQuery, error)
+}
+
+func newTeamMembershipPager(opts []TeamMembershipPaginateOption) (*teamMembershipPager, error) {
+	pager := &teamMembershipPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultTeamMembershipOrder
+	}
+	return pager, nil
+}
+
+func (p *teamMembershipPager) applyFilter(query *TeamMembershipQuery) (*TeamMembershipQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *teamMembershipPager) toCursor(tm *TeamMembership) Cursor {
+	return p.order.Field.toCursor(tm)
+}
+
+func (p *teamMembershipPager) applyCursors(query *TeamMembershipQuery, after, before *Cursor) *TeamMembershipQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultTeamMembershipOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *teamMembershipPager) applyOrder(query *TeamMembershipQuery, reverse bool) *TeamMembershipQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultTeamMembershipOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultTeamMembershipOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to TeamMembership.
+func (tm *TeamMembershipQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...TeamMembershipPaginateOption,
+) (*TeamMembershipConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTeamMembershipPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if tm, err = pager.applyFilter(tm); err != nil {
+		return nil, err
+	}
+
+	conn := &TeamMembershipConnection{Edges: []*TeamMembershipEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := tm.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := tm.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	tm = pager.applyCursors(tm, after, before)
+	tm = pager.applyOrder(tm, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		tm = tm.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		tm = tm.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := tm.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *TeamMembership
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *TeamMembership {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *TeamMembership {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*TeamMembershipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &TeamMembershipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// TeamMembershipOrderField defines the ordering field of TeamMembership.
+type TeamMembershipOrderField struct {
+	field    string
+	toCursor func(*TeamMembership) Cursor
+}
+
+// TeamMembershipOrder defines the ordering of TeamMembership.
+type TeamMembershipOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *TeamMembershipOrderField `json:"field"`
+}
+
+// DefaultTeamMembershipOrder is the default ordering of TeamMembership.
+var DefaultTeamMembershipOrder = &TeamMembershipOrder{
+	Direction: OrderDirectionAsc,
+	Field: &TeamMembershipOrderField{
+		field: teammembership.FieldID,
+		toCursor: func(tm *TeamMembership) Cursor {
+			return Cursor{ID: tm.ID}
+		},
+	},
+}
+
+// ToEdge converts TeamMembership into TeamMembershipEdge.
+func (tm *TeamMembership) ToEdge(order *TeamMembershipOrder) *TeamMembershipEdge {
+	if order == nil {
+		order = DefaultTeamMembershipOrder
+	}
+	return &TeamMembershipEdge{
+		Node:   tm,
+		Cursor: order.Field.toCursor(tm),
+	}
+}
+
+// TokenEdge is the edge representation of Token.
+type TokenEdge struct {
+	Node   *Token `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// TokenConnection is the connection containing edges to Token.
+type TokenConnection struct {
+	Edges      []*TokenEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+// TokenPaginateOption enables pagination customization.
+type TokenPaginateOption func(*tokenPager) error
+
+// WithTokenOrder configures pagination ordering.
+func WithTokenOrder(order *TokenOrder) TokenPaginateOption {
+	if order == nil {
+		order = DefaultTokenOrder
+	}
+	o := *order
+	return func(pager *tokenPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultTokenOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithTokenFilter configures pagination filter.
+func WithTokenFilter(filter func(*TokenQuery) (*TokenQuery, error)) TokenPaginateOption {
+	return func(pager *tokenPager) error {
+		if filter == nil {
+			return errors.New("TokenQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type tokenPager struct {
+	order  *TokenOrder
+	filter func(*TokenQuery) (*TokenQuery, error)
+}
+
+func newTokenPager(opts []TokenPaginateOption) (*tokenPager, error) {
+	pager := &tokenPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultTokenOrder
+	}
+	return pager, nil
+}
+
+func (p *tokenPager) applyFilter(query *TokenQuery) (*TokenQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *tokenPager) toCursor(t *Token) Cursor {
+	return p.order.Field.toCursor(t)
+}
+
+func (p *tokenPager) applyCursors(query *TokenQuery, after, before *Cursor) *TokenQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultTokenOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *tokenPager) applyOrder(query *TokenQuery, reverse bool) *TokenQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultTokenOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultTokenOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Token.
+func (t *TokenQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...TokenPaginateOption,
+) (*TokenConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTokenPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if t, err = pager.applyFilter(t); err != nil {
+		return nil, err
+	}
+
+	conn := &TokenConnection{Edges: []*TokenEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := t.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := t.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	t = pager.applyCursors(t, after, before)
+	t = pager.applyOrder(t, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		t = t.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		t = t.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := t.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *Token
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Token {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Token {
+			return nodes[i]
+		}
+	}
+
+	conn.Edges = make([]*TokenEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		conn.Edges[i] = &TokenEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+
+	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(nodes)
+	}
+
+	return conn, nil
+}
+
+// TokenOrderField defines the ordering field of Token.
+type TokenOrderField struct {
+	field    string
+	toCursor func(*Token) Cursor
+}
+
+// TokenOrder defines the ordering of Token.
+type TokenOrder struct {
+	Direction OrderDirection   `json:"direction"`
+	Field     *TokenOrderField `json:"field"`
+}
+
+// DefaultTokenOrder is the default ordering of Token.
+var DefaultTokenOrder = &TokenOrder{
+	Direction: OrderDirectionAsc,
+	Field: &TokenOrderField{
+		field: token.FieldID,
+		toCursor: func(t *Token) Cursor {
+			return Cursor{ID: t.ID}
+		},
+	},
+}
+
+// ToEdge converts Token into TokenEdge.
+func (t *Token) ToEdge(order *TokenOrder) *TokenEdge {
+	if order == nil {
+		order = DefaultTokenOrder
+	}
+	return &TokenEdge{
+		Node:   t,
+		Cursor: order.Field.toCursor(t),
+	}
+}
+
+// UserEdge is the edge representation of User.
+type UserEdge struct {
+	Node   *User  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// UserConnection is the connection containing edges to User.
+type UserConnection struct {
+	Edges      []*UserEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+// UserPaginateOption enables pagination customization.
+type UserPaginateOption func(*userPager) error
+
+// WithUserOrder configures pagination ordering.
+func WithUserOrder(order *UserOrder) UserPaginateOption {
+	if order == nil {
+		order = DefaultUserOrder
+	}
+	o := *order
+	return func(pager *userPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultUserOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithUserFilter configures pagination filter.
+func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
+	return func(pager *userPager) error {
+		if filter == nil {
+			return errors.New("UserQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type userPager struct {
+	order  *UserOrder
+	filter func(*UserQuery) (*UserQuery, error)
+}
+
+func newUserPager(opts []UserPaginateOption) (*userPager, error) {
+	pager := &userPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultUserOrder
+	}
+	return pager, nil
+}
+
+func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *userPager) toCursor(u *User) Cursor {
+	return p.order.Field.toCursor(u)
+}
+
+func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) *UserQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultUserOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *userPager) applyOrder(query *UserQuery, reverse bool) *UserQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultUserOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.field))
+	}
+	return query
+}
+
+// Paginate executes the query and returns a relay based cursor connection to User.
+func (u *UserQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...UserPaginateOption,
+) (*UserConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newUserPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if u, err = pager.applyFilter(u); err != nil {
+		return nil, err
+	}
+
+	conn := &UserConnection{Edges: []*UserEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) ||
+			hasCollectedField(ctx, pageInfoField) {
+			count, err := u.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount = count
+			conn.PageInfo.HasNextPage = first != nil && count > 0
+			conn.PageInfo.HasPreviousPage = last != nil && count > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := u.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	u = pager.applyCursors(u, after, before)
+	u = pager.applyOrder(u, last != nil)
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	if limit > 0 {
+		u = u.Limit(limit)
+	}
+
+	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
+		u = u.collectField(graphql.GetOperationContext(ctx), *field)
+	}
+
+	nodes, err := u.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+
+	if len(nodes) == limit {
+		conn.PageInfo.HasNextPage = first != nil
+		conn.PageInfo.HasPreviousPage = last != nil
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	var nodeAt func(int) *User
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *User {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Team {
+		nodeAt = func(i int) *User {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*TeamEdge, len(nodes))
+	conn.Edges = make([]*UserEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &TeamEdge{
+		conn.Edges[i] = &UserEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1569,158 +3621,158 @@ func (t *TeamQuery) Paginate(
 	return conn, nil
 }
 
-// TeamOrderField defines the ordering field of Team.
-type TeamOrderField struct {
+// UserOrderField defines the ordering field of User.
+type UserOrderField struct {
 	field    string
-	toCursor func(*Team) Cursor
+	toCursor func(*User) Cursor
 }
 
-// TeamOrder defines the ordering of Team.
-type TeamOrder struct {
+// UserOrder defines the ordering of User.
+type UserOrder struct {
 	Direction OrderDirection  `json:"direction"`
-	Field     *TeamOrderField `json:"field"`
+	Field     *UserOrderField `json:"field"`
 }
 
-// DefaultTeamOrder is the default ordering of Team.
-var DefaultTeamOrder = &TeamOrder{
+// DefaultUserOrder is the default ordering of User.
+var DefaultUserOrder = &UserOrder{
 	Direction: OrderDirectionAsc,
-	Field: &TeamOrderField{
-		field: team.FieldID,
-		toCursor: func(t *Team) Cursor {
-			return Cursor{ID: t.ID}
+	Field: &UserOrderField{
+		field: user.FieldID,
+		toCursor: func(u *User) Cursor {
+			return Cursor{ID: u.ID}
 		},
 	},
 }
 
-// ToEdge converts Team into TeamEdge.
-func (t *Team) ToEdge(order *TeamOrder) *TeamEdge {
+// ToEdge converts User into UserEdge.
+func (u *User) ToEdge(order *UserOrder) *UserEdge {
 	if order == nil {
-		order = DefaultTeamOrder
+		order = DefaultUserOrder
 	}
-	return &TeamEdge{
-		Node:   t,
-		Cursor: order.Field.toCursor(t),
+	return &UserEdge{
+		Node:   u,
+		Cursor: order.Field.toCursor(u),
 	}
 }
 
-// TokenEdge is the edge representation of Token.
-type TokenEdge struct {
-	Node   *Token `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// VmCredentialEdge is the edge representation of VmCredential.
+type VmCredentialEdge struct {
+	Node   *VmCredential `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// TokenConnection is the connection containing edges to Token.
-type TokenConnection struct {
-	Edges      []*TokenEdge `json:"edges"`
-	PageInfo   PageInfo     `json:"pageInfo"`
-	TotalCount int          `json:"totalCount"`
+// VmCredentialConnection is the connection containing edges to VmCredential.
+type VmCredentialConnection struct {
+	Edges      []*VmCredentialEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-// TokenPaginateOption enables pagination customization.
-type TokenPaginateOption func(*tokenPager) error
+// VmCredentialPaginateOption enables pagination customization.
+type VmCredentialPaginateOption func(*vmCredentialPager) error
 
-// WithTokenOrder configures pagination ordering.
-func WithTokenOrder(order *TokenOrder) TokenPaginateOption {
+// WithVmCredentialOrder configures pagination ordering.
+func WithVmCredentialOrder(order *VmCredentialOrder) VmCredentialPaginateOption {
 	if order == nil {
-		order = DefaultTokenOrder
+		order = DefaultVmCredentialOrder
 	}
 	o := *order
-	return func(pager *tokenPager) error {
+	return func(pager *vmCredentialPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultTokenOrder.Field
+			o.Field = DefaultVmCredentialOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithTokenFilter configures pagination filter.
-func WithTokenFilter(filter func(*TokenQuery) (*TokenQuery, error)) TokenPaginateOption {
-	return func(pager *tokenPager) error {
+// WithVmCredentialFilter configures pagination filter.
+func WithVmCredentialFilter(filter func(*VmCredentialQuery) (*VmCredentialQuery, error)) VmCredentialPaginateOption {
+	return func(pager *vmCredentialPager) error {
 		if filter == nil {
-			return errors.New("TokenQuery filter cannot be nil")
+			return errors.New("VmCredentialQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type tokenPager struct {
-	order  *TokenOrder
-	filter func(*TokenQuery) (*TokenQuery, error)
+type vmCredentialPager struct {
+	order  *VmCredentialOrder
+	filter func(*VmCredentialQuery) (*VmCredentialQuery, error)
 }
 
-func newTokenPager(opts []TokenPaginateOption) (*tokenPager, error) {
-	pager := &tokenPager{}
+func newVmCredentialPager(opts []VmCredentialPaginateOption) (*vmCredentialPager, error) {
+	pager := &vmCredentialPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultTokenOrder
+		pager.order = DefaultVmCredentialOrder
 	}
 	return pager, nil
 }
 
-func (p *tokenPager) applyFilter(query *TokenQuery) (*TokenQuery, error) {
+func (p *vmCredentialPager) applyFilter(query *VmCredentialQuery) (*VmCredentialQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *tokenPager) toCursor(t *Token) Cursor {
-	return p.order.Field.toCursor(t)
+func (p *vmCredentialPager) toCursor(vc *VmCredential) Cursor {
+	return p.order.Field.toCursor(vc)
 }
 
-func (p *tokenPager) applyCursors(query *TokenQuery, after, before *Cursor) *TokenQuery {
+func (p *vmCredentialPager) applyCursors(query *VmCredentialQuery, after, before *Cursor) *VmCredentialQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultTokenOrder.Field.field,
+		p.order.Field.field, DefaultVmCredentialOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *tokenPager) applyOrder(query *TokenQuery, reverse bool) *TokenQuery {
+func (p *vmCredentialPager) applyOrder(query *VmCredentialQuery, reverse bool) *VmCredentialQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultTokenOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultTokenOrder.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultVmCredentialOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultVmCredentialOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Token.
-func (t *TokenQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to VmCredential.
+func (vc *VmCredentialQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...TokenPaginateOption,
-) (*TokenConnection, error) {
+	before *Cursor, last *int, opts ...VmCredentialPaginateOption,
+) (*VmCredentialConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newTokenPager(opts)
+	pager, err := newVmCredentialPager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if t, err = pager.applyFilter(t); err != nil {
+	if vc, err = pager.applyFilter(vc); err != nil {
 		return nil, err
 	}
 
-	conn := &TokenConnection{Edges: []*TokenEdge{}}
+	conn := &VmCredentialConnection{Edges: []*VmCredentialEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := t.Count(ctx)
+			count, err := vc.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1732,15 +3784,15 @@ func (t *TokenQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := t.Clone().Count(ctx)
+		count, err := vc.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	t = pager.applyCursors(t, after, before)
-	t = pager.applyOrder(t, last != nil)
+	vc = pager.applyCursors(vc, after, before)
+	vc = pager.applyOrder(vc, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -1748,14 +3800,14 @@ func (t *TokenQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		t = t.Limit(limit)
+		vc = vc.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		t = t.collectField(graphql.GetOperationContext(ctx), *field)
+		vc = vc.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := t.All(ctx)
+	nodes, err := vc.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -1766,22 +3818,22 @@ func (t *TokenQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *Token
+	var nodeAt func(int) *VmCredential
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Token {
+		nodeAt = func(i int) *VmCredential {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Token {
+		nodeAt = func(i int) *VmCredential {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*TokenEdge, len(nodes))
+	conn.Edges = make([]*VmCredentialEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &TokenEdge{
+		conn.Edges[i] = &VmCredentialEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1796,158 +3848,158 @@ func (t *TokenQuery) Paginate(
 	return conn, nil
 }
 
-// TokenOrderField defines the ordering field of Token.
-type TokenOrderField struct {
+// VmCredentialOrderField defines the ordering field of VmCredential.
+type VmCredentialOrderField struct {
 	field    string
-	toCursor func(*Token) Cursor
+	toCursor func(*VmCredential) Cursor
 }
 
-// TokenOrder defines the ordering of Token.
-type TokenOrder struct {
-	Direction OrderDirection   `json:"direction"`
-	Field     *TokenOrderField `json:"field"`
+// VmCredentialOrder defines the ordering of VmCredential.
+type VmCredentialOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *VmCredentialOrderField `json:"field"`
 }
 
-// DefaultTokenOrder is the default ordering of Token.
-var DefaultTokenOrder = &TokenOrder{
+// DefaultVmCredentialOrder is the default ordering of VmCredential.
+var DefaultVmCredentialOrder = &VmCredentialOrder{
 	Direction: OrderDirectionAsc,
-	Field: &TokenOrderField{
-		field: token.FieldID,
-		toCursor: func(t *Token) Cursor {
-			return Cursor{ID: t.ID}
+	Field: &VmCredentialOrderField{
+		field: vmcredential.FieldID,
+		toCursor: func(vc *VmCredential) Cursor {
+			return Cursor{ID: vc.ID}
 		},
 	},
 }
 
-// ToEdge converts Token into TokenEdge.
-func (t *Token) ToEdge(order *TokenOrder) *TokenEdge {
+// ToEdge converts VmCredential into VmCredentialEdge.
+func (vc *VmCredential) ToEdge(order *VmCredentialOrder) *VmCredentialEdge {
 	if order == nil {
-		order = DefaultTokenOrder
+		order = DefaultVmCredentialOrder
 	}
-	return &TokenEdge{
-		Node:   t,
-		Cursor: order.Field.toCursor(t),
+	return &VmCredentialEdge{
+		Node:   vc,
+		Cursor: order.Field.toCursor(vc),
 	}
 }
 
-// UserEdge is the edge representation of User.
-type UserEdge struct {
-	Node   *User  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// VmObjectEdge is the edge representation of VmObject.
+type VmObjectEdge struct {
+	Node   *VmObject `json:"node"`
+	Cursor Cursor    `json:"cursor"`
 }
 
-// UserConnection is the connection containing edges to User.
-type UserConnection struct {
-	Edges      []*UserEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// VmObjectConnection is the connection containing edges to VmObject.
+type VmObjectConnection struct {
+	Edges      []*VmObjectEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
 }
 
-// UserPaginateOption enables pagination customization.
-type UserPaginateOption func(*userPager) error
+// VmObjectPaginateOption enables pagination customization.
+type VmObjectPaginateOption func(*vmObjectPager) error
 
-// WithUserOrder configures pagination ordering.
-func WithUserOrder(order *UserOrder) UserPaginateOption {
+// WithVmObjectOrder configures pagination ordering.
+func WithVmObjectOrder(order *VmObjectOrder) VmObjectPaginateOption {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultVmObjectOrder
 	}
 	o := *order
-	return func(pager *userPager) error {
+	return func(pager *vmObjectPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserOrder.Field
+			o.Field = DefaultVmObjectOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserFilter configures pagination filter.
-func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
-	return func(pager *userPager) error {
+// WithVmObjectFilter configures pagination filter.
+func WithVmObjectFilter(filter func(*VmObjectQuery) (*VmObjectQuery, error)) VmObjectPaginateOption {
+	return func(pager *vmObjectPager) error {
 		if filter == nil {
-			return errors.New("UserQuery filter cannot be nil")
+			return errors.New("VmObjectQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userPager struct {
-	order  *UserOrder
-	filter func(*UserQuery) (*UserQuery, error)
+type vmObjectPager struct {
+	order  *VmObjectOrder
+	filter func(*VmObjectQuery) (*VmObjectQuery, error)
 }
 
-func newUserPager(opts []UserPaginateOption) (*userPager, error) {
-	pager := &userPager{}
+func newVmObjectPager(opts []VmObjectPaginateOption) (*vmObjectPager, error) {
+	pager := &vmObjectPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserOrder
+		pager.order = DefaultVmObjectOrder
 	}
 	return pager, nil
 }
 
-func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+func (p *vmObjectPager) applyFilter(query *VmObjectQuery) (*VmObjectQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userPager) toCursor(u *User) Cursor {
-	return p.order.Field.toCursor(u)
+func (p *vmObjectPager) toCursor(vo *VmObject) Cursor {
+	return p.order.Field.toCursor(vo)
 }
 
-func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) *UserQuery {
+func (p *vmObjectPager) applyCursors(query *VmObjectQuery, after, before *Cursor) *VmObjectQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultUserOrder.Field.field,
+		p.order.Field.field, DefaultVmObjectOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *userPager) applyOrder(query *UserQuery, reverse bool) *UserQuery {
+func (p *vmObjectPager) applyOrder(query *VmObjectQuery, reverse bool) *VmObjectQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultUserOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.field))
+	if p.order.Field != DefaultVmObjectOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultVmObjectOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to User.
-func (u *UserQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to VmObject.
+func (vo *VmObjectQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserPaginateOption,
-) (*UserConnection, error) {
+	before *Cursor, last *int, opts ...VmObjectPaginateOption,
+) (*VmObjectConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserPager(opts)
+	pager, err := newVmObjectPager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if u, err = pager.applyFilter(u); err != nil {
+	if vo, err = pager.applyFilter(vo); err != nil {
 		return nil, err
 	}
 
-	conn := &UserConnection{Edges: []*UserEdge{}}
+	conn := &VmObjectConnection{Edges: []*VmObjectEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := u.Count(ctx)
+			count, err := vo.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1959,15 +4011,15 @@ func (u *UserQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := u.Clone().Count(ctx)
+		count, err := vo.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	u = pager.applyCursors(u, after, before)
-	u = pager.applyOrder(u, last != nil)
+	vo = pager.applyCursors(vo, after, before)
+	vo = pager.applyOrder(vo, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -1975,14 +4027,14 @@ func (u *UserQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		u = u.Limit(limit)
+		vo = vo.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		u = u.collectField(graphql.GetOperationContext(ctx), *field)
+		vo = vo.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := u.All(ctx)
+	nodes, err := vo.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -1993,22 +4045,22 @@ func (u *UserQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *User
+	var nodeAt func(int) *VmObject
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *VmObject {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *VmObject {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*UserEdge, len(nodes))
+	conn.Edges = make([]*VmObjectEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &UserEdge{
+		conn.Edges[i] = &VmObjectEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2023,158 +4075,158 @@ func (u *UserQuery) Paginate(
 	return conn, nil
 }
 
-// UserOrderField defines the ordering field of User.
-type UserOrderField struct {
+// VmObjectOrderField defines the ordering field of VmObject.
+type VmObjectOrderField struct {
 	field    string
-	toCursor func(*User) Cursor
+	toCursor func(*VmObject) Cursor
 }
 
-// UserOrder defines the ordering of User.
-type UserOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *UserOrderField `json:"field"`
+// VmObjectOrder defines the ordering of VmObject.
+type VmObjectOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *VmObjectOrderField `json:"field"`
 }
 
-// DefaultUserOrder is the default ordering of User.
-var DefaultUserOrder = &UserOrder{
+// DefaultVmObjectOrder is the default ordering of VmObject.
+var DefaultVmObjectOrder = &VmObjectOrder{
 	Direction: OrderDirectionAsc,
-	Field: &UserOrderField{
-		field: user.FieldID,
-		toCursor: func(u *User) Cursor {
-			return Cursor{ID: u.ID}
+	Field: &VmObjectOrderField{
+		field: vmobject.FieldID,
+		toCursor: func(vo *VmObject) Cursor {
+			return Cursor{ID: vo.ID}
 		},
 	},
 }
 
-// ToEdge converts User into UserEdge.
-func (u *User) ToEdge(order *UserOrder) *UserEdge {
+// ToEdge converts VmObject into VmObjectEdge.
+func (vo *VmObject) ToEdge(order *VmObjectOrder) *VmObjectEdge {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultVmObjectOrder
 	}
-	return &UserEdge{
-		Node:   u,
-		Cursor: order.Field.toCursor(u),
+	return &VmObjectEdge{
+		Node:   vo,
+		Cursor: order.Field.toCursor(vo),
 	}
 }
 
-// VmObjectEdge is the edge representation of VmObject.
-type VmObjectEdge struct {
-	Node   *VmObject `json:"node"`
-	Cursor Cursor    `json:"cursor"`
+// WebauthnCredentialEdge is the edge representation of WebauthnCredential.
+type WebauthnCredentialEdge struct {
+	Node   *WebauthnCredential `json:"node"`
+	Cursor Cursor              `json:"cursor"`
 }
 
-// VmObjectConnection is the connection containing edges to VmObject.
-type VmObjectConnection struct {
-	Edges      []*VmObjectEdge `json:"edges"`
-	PageInfo   PageInfo        `json:"pageInfo"`
-	TotalCount int             `json:"totalCount"`
+// WebauthnCredentialConnection is the connection containing edges to WebauthnCredential.
+type WebauthnCredentialConnection struct {
+	Edges      []*WebauthnCredentialEdge `json:"edges"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	TotalCount int                       `json:"totalCount"`
 }
 
-// VmObjectPaginateOption enables pagination customization.
-type VmObjectPaginateOption func(*vmObjectPager) error
+// WebauthnCredentialPaginateOption enables pagination customization.
+type WebauthnCredentialPaginateOption func(*webauthnCredentialPager) error
 
-// WithVmObjectOrder configures pagination ordering.
-func WithVmObjectOrder(order *VmObjectOrder) VmObjectPaginateOption {
+// WithWebauthnCredentialOrder configures pagination ordering.
+func WithWebauthnCredentialOrder(order *WebauthnCredentialOrder) WebauthnCredentialPaginateOption {
 	if order == nil {
-		order = DefaultVmObjectOrder
+		order = DefaultWebauthnCredentialOrder
 	}
 	o := *order
-	return func(pager *vmObjectPager) error {
+	return func(pager *webauthnCredentialPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultVmObjectOrder.Field
+			o.Field = DefaultWebauthnCredentialOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithVmObjectFilter configures pagination filter.
-func WithVmObjectFilter(filter func(*VmObjectQuery) (*VmObjectQuery, error)) VmObjectPaginateOption {
-	return func(pager *vmObjectPager) error {
+// WithWebauthnCredentialFilter configures pagination filter.
+func WithWebauthnCredentialFilter(filter func(*WebauthnCredentialQuery) (*WebauthnCredentialQuery, error)) WebauthnCredentialPaginateOption {
+	return func(pager *webauthnCredentialPager) error {
 		if filter == nil {
-			return errors.New("VmObjectQuery filter cannot be nil")
+			return errors.New("WebauthnCredentialQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type vmObjectPager struct {
-	order  *VmObjectOrder
-	filter func(*VmObjectQuery) (*VmObjectQuery, error)
+type webauthnCredentialPager struct {
+	order  *WebauthnCredentialOrder
+	filter func(*WebauthnCredentialQuery) (*WebauthnCredentialQuery, error)
 }
 
-func newVmObjectPager(opts []VmObjectPaginateOption) (*vmObjectPager, error) {
-	pager := &vmObjectPager{}
+func newWebauthnCredentialPager(opts []WebauthnCredentialPaginateOption) (*webauthnCredentialPager, error) {
+	pager := &webauthnCredentialPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultVmObjectOrder
+		pager.order = DefaultWebauthnCredentialOrder
 	}
 	return pager, nil
 }
 
-func (p *vmObjectPager) applyFilter(query *VmObjectQuery) (*VmObjectQuery, error) {
+func (p *webauthnCredentialPager) applyFilter(query *WebauthnCredentialQuery) (*WebauthnCredentialQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *vmObjectPager) toCursor(vo *VmObject) Cursor {
-	return p.order.Field.toCursor(vo)
+func (p *webauthnCredentialPager) toCursor(wc *WebauthnCredential) Cursor {
+	return p.order.Field.toCursor(wc)
 }
 
-func (p *vmObjectPager) applyCursors(query *VmObjectQuery, after, before *Cursor) *VmObjectQuery {
+func (p *webauthnCredentialPager) applyCursors(query *WebauthnCredentialQuery, after, before *Cursor) *WebauthnCredentialQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultVmObjectOrder.Field.field,
+		p.order.Field.field, DefaultWebauthnCredentialOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *vmObjectPager) applyOrder(query *VmObjectQuery, reverse bool) *VmObjectQuery {
+func (p *webauthnCredentialPager) applyOrder(query *WebauthnCredentialQuery, reverse bool) *WebauthnCredentialQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultVmObjectOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultVmObjectOrder.Field.field))
+	if p.order.Field != DefaultWebauthnCredentialOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultWebauthnCredentialOrder.Field.field))
 	}
 	return query
 }
 
-// Paginate executes the query and returns a relay based cursor connection to VmObject.
-func (vo *VmObjectQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to WebauthnCredential.
+func (wc *WebauthnCredentialQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...VmObjectPaginateOption,
-) (*VmObjectConnection, error) {
+	before *Cursor, last *int, opts ...WebauthnCredentialPaginateOption,
+) (*WebauthnCredentialConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newVmObjectPager(opts)
+	pager, err := newWebauthnCredentialPager(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if vo, err = pager.applyFilter(vo); err != nil {
+	if wc, err = pager.applyFilter(wc); err != nil {
 		return nil, err
 	}
 
-	conn := &VmObjectConnection{Edges: []*VmObjectEdge{}}
+	conn := &WebauthnCredentialConnection{Edges: []*WebauthnCredentialEdge{}}
 	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
 		if hasCollectedField(ctx, totalCountField) ||
 			hasCollectedField(ctx, pageInfoField) {
-			count, err := vo.Count(ctx)
+			count, err := wc.Count(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -2186,15 +4238,15 @@ func (vo *VmObjectQuery) Paginate(
 	}
 
 	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
-		count, err := vo.Clone().Count(ctx)
+		count, err := wc.Clone().Count(ctx)
 		if err != nil {
 			return nil, err
 		}
 		conn.TotalCount = count
 	}
 
-	vo = pager.applyCursors(vo, after, before)
-	vo = pager.applyOrder(vo, last != nil)
+	wc = pager.applyCursors(wc, after, before)
+	wc = pager.applyOrder(wc, last != nil)
 	var limit int
 	if first != nil {
 		limit = *first + 1
@@ -2202,14 +4254,14 @@ func (vo *VmObjectQuery) Paginate(
 		limit = *last + 1
 	}
 	if limit > 0 {
-		vo = vo.Limit(limit)
+		wc = wc.Limit(limit)
 	}
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
-		vo = vo.collectField(graphql.GetOperationContext(ctx), *field)
+		wc = wc.collectField(graphql.GetOperationContext(ctx), *field)
 	}
 
-	nodes, err := vo.All(ctx)
+	nodes, err := wc.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
@@ -2220,22 +4272,22 @@ func (vo *VmObjectQuery) Paginate(
 		nodes = nodes[:len(nodes)-1]
 	}
 
-	var nodeAt func(int) *VmObject
+	var nodeAt func(int) *WebauthnCredential
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *VmObject {
+		nodeAt = func(i int) *WebauthnCredential {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *VmObject {
+		nodeAt = func(i int) *WebauthnCredential {
 			return nodes[i]
 		}
 	}
 
-	conn.Edges = make([]*VmObjectEdge, len(nodes))
+	conn.Edges = make([]*WebauthnCredentialEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &VmObjectEdge{
+		conn.Edges[i] = &WebauthnCredentialEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2250,36 +4302,36 @@ func (vo *VmObjectQuery) Paginate(
 	return conn, nil
 }
 
-// VmObjectOrderField defines the ordering field of VmObject.
-type VmObjectOrderField struct {
+// WebauthnCredentialOrderField defines the ordering field of WebauthnCredential.
+type WebauthnCredentialOrderField struct {
 	field    string
-	toCursor func(*VmObject) Cursor
+	toCursor func(*WebauthnCredential) Cursor
 }
 
-// VmObjectOrder defines the ordering of VmObject.
-type VmObjectOrder struct {
-	Direction OrderDirection      `json:"direction"`
-	Field     *VmObjectOrderField `json:"field"`
+// WebauthnCredentialOrder defines the ordering of WebauthnCredential.
+type WebauthnCredentialOrder struct {
+	Direction OrderDirection                `json:"direction"`
+	Field     *WebauthnCredentialOrderField `json:"field"`
 }
 
-// DefaultVmObjectOrder is the default ordering of VmObject.
-var DefaultVmObjectOrder = &VmObjectOrder{
+// DefaultWebauthnCredentialOrder is the default ordering of WebauthnCredential.
+var DefaultWebauthnCredentialOrder = &WebauthnCredentialOrder{
 	Direction: OrderDirectionAsc,
-	Field: &VmObjectOrderField{
-		field: vmobject.FieldID,
-		toCursor: func(vo *VmObject) Cursor {
-			return Cursor{ID: vo.ID}
+	Field: &WebauthnCredentialOrderField{
+		field: webauthncredential.FieldID,
+		toCursor: func(wc *WebauthnCredential) Cursor {
+			return Cursor{ID: wc.ID}
 		},
 	},
 }
 
-// ToEdge converts VmObject into VmObjectEdge.
-func (vo *VmObject) ToEdge(order *VmObjectOrder) *VmObjectEdge {
+// ToEdge converts WebauthnCredential into WebauthnCredentialEdge.
+func (wc *WebauthnCredential) ToEdge(order *WebauthnCredentialOrder) *WebauthnCredentialEdge {
 	if order == nil {
-		order = DefaultVmObjectOrder
+		order = DefaultWebauthnCredentialOrder
 	}
-	return &VmObjectEdge{
-		Node:   vo,
-		Cursor: order.Field.toCursor(vo),
+	return &WebauthnCredentialEdge{
+		Node:   wc,
+		Cursor: order.Field.toCursor(wc),
 	}
 }
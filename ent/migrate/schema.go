@@ -12,9 +12,10 @@ var (
 	ActionsColumns = []*schema.Column{
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "ip_address", Type: field.TypeString, Default: ""},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"SIGN_IN", "FAILED_SIGN_IN", "SIGN_OUT", "API_CALL", "CONSOLE_ACCESS", "POWER_STATE", "REBOOT", "SHUTDOWN", "POWER_ON", "POWER_OFF", "CHANGE_SELF_PASSWORD", "CHANGE_PASSWORD", "CREATE_OBJECT", "UPDATE_OBJECT", "DELETE_OBJECT", "UPDATE_LOCKOUT"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"SIGN_IN", "FAILED_SIGN_IN", "SIGN_OUT", "API_CALL", "CONSOLE_ACCESS", "POWER_STATE", "REBOOT", "SHUTDOWN", "POWER_ON", "POWER_OFF", "CHANGE_SELF_PASSWORD", "CHANGE_PASSWORD", "CREATE_OBJECT", "UPDATE_OBJECT", "DELETE_OBJECT", "UPDATE_LOCKOUT", "MFA_ENROLL", "MFA_DISABLE", "FAILED_MFA", "ACCOUNT_LOCKED", "ACCOUNT_UNLOCKED", "REVOKE_SESSION", "ROTATE_SECRET", "CREATE_ACCESS_TOKEN", "REVOKE_ACCESS_TOKEN", "IMPERSONATE", "IP_NOT_ALLOWED"}},
 		{Name: "message", Type: field.TypeString},
 		{Name: "performed_at", Type: field.TypeTime},
+		{Name: "action_action_to_impersonator", Type: field.TypeUUID, Nullable: true},
 		{Name: "service_account_service_account_to_actions", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_user_to_actions", Type: field.TypeUUID, Nullable: true},
 	}
@@ -25,14 +26,20 @@ var (
 		PrimaryKey: []*schema.Column{ActionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "actions_service_accounts_ServiceAccountToActions",
+				Symbol:     "actions_users_ActionToImpersonator",
 				Columns:    []*schema.Column{ActionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "actions_service_accounts_ServiceAccountToActions",
+				Columns:    []*schema.Column{ActionsColumns[6]},
 				RefColumns: []*schema.Column{ServiceAccountsColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "actions_users_UserToActions",
-				Columns:    []*schema.Column{ActionsColumns[6]},
+				Columns:    []*schema.Column{ActionsColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -42,7 +49,15 @@ var (
 	CompetitionsColumns = []*schema.Column{
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "console_limit_per_vm", Type: field.TypeInt, Default: 0},
+		{Name: "console_limit_per_user", Type: field.TypeInt, Default: 0},
+		{Name: "console_limit_per_team", Type: field.TypeInt, Default: 0},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "schedule_locked", Type: field.TypeBool, Nullable: true},
+		{Name: "allowed_cidrs", Type: field.TypeJSON, Nullable: true},
 		{Name: "competition_competition_to_provider", Type: field.TypeUUID},
+		{Name: "service_account_service_account_to_competitions", Type: field.TypeUUID, Nullable: true},
 	}
 	// CompetitionsTable holds the schema information for the "competitions" table.
 	CompetitionsTable = &schema.Table{
@@ -52,10 +67,141 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "competitions_providers_CompetitionToProvider",
-				Columns:    []*schema.Column{CompetitionsColumns[2]},
+				Columns:    []*schema.Column{CompetitionsColumns[9]},
 				RefColumns: []*schema.Column{ProvidersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "competitions_service_accounts_ServiceAccountToCompetitions",
+				Columns:    []*schema.Column{CompetitionsColumns[10]},
+				RefColumns: []*schema.Column{ServiceAccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CompetitionBreaksColumns holds the columns for the "competition_breaks" table.
+	CompetitionBreaksColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "competition_competition_to_breaks", Type: field.TypeUUID},
+	}
+	// CompetitionBreaksTable holds the schema information for the "competition_breaks" table.
+	CompetitionBreaksTable = &schema.Table{
+		Name:       "competition_breaks",
+		Columns:    CompetitionBreaksColumns,
+		PrimaryKey: []*schema.Column{CompetitionBreaksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "competition_breaks_competitions_CompetitionToBreaks",
+				Columns:    []*schema.Column{CompetitionBreaksColumns[4]},
+				RefColumns: []*schema.Column{CompetitionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ConsoleSessionsColumns holds the columns for the "console_sessions" table.
+	ConsoleSessionsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "console_type", Type: field.TypeString},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "transcript", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "user_user_to_console_sessions", Type: field.TypeUUID, Nullable: true},
+		{Name: "vm_object_vm_object_to_console_sessions", Type: field.TypeUUID, Nullable: true},
+	}
+	// ConsoleSessionsTable holds the schema information for the "console_sessions" table.
+	ConsoleSessionsTable = &schema.Table{
+		Name:       "console_sessions",
+		Columns:    ConsoleSessionsColumns,
+		PrimaryKey: []*schema.Column{ConsoleSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "console_sessions_users_UserToConsoleSessions",
+				Columns:    []*schema.Column{ConsoleSessionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "console_sessions_vm_objects_VmObjectToConsoleSessions",
+				Columns:    []*schema.Column{ConsoleSessionsColumns[7]},
+				RefColumns: []*schema.Column{VMObjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ConsoleSharesColumns holds the columns for the "console_shares" table.
+	ConsoleSharesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "console_type", Type: field.TypeString},
+		{Name: "password", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+		{Name: "user_user_to_console_shares", Type: field.TypeUUID, Nullable: true},
+		{Name: "vm_object_vm_object_to_console_shares", Type: field.TypeUUID},
+	}
+	// ConsoleSharesTable holds the schema information for the "console_shares" table.
+	ConsoleSharesTable = &schema.Table{
+		Name:       "console_shares",
+		Columns:    ConsoleSharesColumns,
+		PrimaryKey: []*schema.Column{ConsoleSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "console_shares_users_UserToConsoleShares",
+				Columns:    []*schema.Column{ConsoleSharesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "console_shares_vm_objects_VmObjectToConsoleShares",
+				Columns:    []*schema.Column{ConsoleSharesColumns[8]},
+				RefColumns: []*schema.Column{VMObjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CustomRolesColumns holds the columns for the "custom_roles" table.
+	CustomRolesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+	}
+	// CustomRolesTable holds the schema information for the "custom_roles" table.
+	CustomRolesTable = &schema.Table{
+		Name:       "custom_roles",
+		Columns:    CustomRolesColumns,
+		PrimaryKey: []*schema.Column{CustomRolesColumns[0]},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"READ_ONLY", "FULL"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_user_to_personal_access_tokens", Type: field.TypeUUID},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_UserToPersonalAccessTokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// ProvidersColumns holds the columns for the "providers" table.
@@ -76,8 +222,16 @@ var (
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "api_key", Type: field.TypeUUID},
-		{Name: "api_secret", Type: field.TypeUUID},
+		{Name: "api_secret", Type: field.TypeUUID, Nullable: true},
+		{Name: "api_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_secret_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "secret_generation", Type: field.TypeInt, Default: 0},
 		{Name: "active", Type: field.TypeBool},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
 	}
 	// ServiceAccountsTable holds the schema information for the "service_accounts" table.
 	ServiceAccountsTable = &schema.Table{
@@ -91,6 +245,7 @@ var (
 		{Name: "token", Type: field.TypeString},
 		{Name: "refresh_token", Type: field.TypeString},
 		{Name: "issued_at", Type: field.TypeInt64},
+		{Name: "secret_generation", Type: field.TypeInt, Default: 0},
 		{Name: "service_account_service_account_to_token", Type: field.TypeUUID},
 	}
 	// ServiceTokensTable holds the schema information for the "service_tokens" table.
@@ -101,18 +256,36 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "service_tokens_service_accounts_ServiceAccountToToken",
-				Columns:    []*schema.Column{ServiceTokensColumns[4]},
+				Columns:    []*schema.Column{ServiceTokensColumns[5]},
 				RefColumns: []*schema.Column{ServiceAccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "algorithm", Type: field.TypeEnum, Enums: []string{"RS256", "EdDSA"}},
+		{Name: "private_key", Type: field.TypeString},
+		{Name: "public_key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+	}
 	// TeamsColumns holds the columns for the "teams" table.
 	TeamsColumns = []*schema.Column{
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "team_number", Type: field.TypeInt},
 		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "allowed_cidrs", Type: field.TypeJSON, Nullable: true},
 		{Name: "competition_competition_to_teams", Type: field.TypeUUID},
+		{Name: "service_account_service_account_to_teams", Type: field.TypeUUID, Nullable: true},
 	}
 	// TeamsTable holds the schema information for the "teams" table.
 	TeamsTable = &schema.Table{
@@ -122,10 +295,50 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "teams_competitions_CompetitionToTeams",
-				Columns:    []*schema.Column{TeamsColumns[3]},
+				Columns:    []*schema.Column{TeamsColumns[4]},
 				RefColumns: []*schema.Column{CompetitionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "teams_service_accounts_ServiceAccountToTeams",
+				Columns:    []*schema.Column{TeamsColumns[5]},
+				RefColumns: []*schema.Column{ServiceAccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TeamMembershipsColumns holds the columns for the "team_memberships" table.
+	TeamMembershipsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"MEMBER", "CAPTAIN", "OBSERVER"}, Default: "MEMBER"},
+		{Name: "team_team_to_memberships", Type: field.TypeUUID},
+		{Name: "user_user_to_team_memberships", Type: field.TypeUUID},
+	}
+	// TeamMembershipsTable holds the schema information for the "team_memberships" table.
+	TeamMembershipsTable = &schema.Table{
+		Name:       "team_memberships",
+		Columns:    TeamMembershipsColumns,
+		PrimaryKey: []*schema.Column{TeamMembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_memberships_teams_TeamToMemberships",
+				Columns:    []*schema.Column{TeamMembershipsColumns[2]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_memberships_users_UserToTeamMemberships",
+				Columns:    []*schema.Column{TeamMembershipsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teammembership_user_user_to_team_memberships_team_team_to_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{TeamMembershipsColumns[3], TeamMembershipsColumns[2]},
+			},
 		},
 	}
 	// TokensColumns holds the columns for the "tokens" table.
@@ -133,6 +346,12 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "token", Type: field.TypeString},
 		{Name: "expire_at", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "method", Type: field.TypeString, Nullable: true},
+		{Name: "token_token_to_impersonated_user", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_user_to_token", Type: field.TypeUUID},
 	}
 	// TokensTable holds the schema information for the "tokens" table.
@@ -141,9 +360,15 @@ var (
 		Columns:    TokensColumns,
 		PrimaryKey: []*schema.Column{TokensColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tokens_users_TokenToImpersonatedUser",
+				Columns:    []*schema.Column{TokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "tokens_users_UserToToken",
-				Columns:    []*schema.Column{TokensColumns[3]},
+				Columns:    []*schema.Column{TokensColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -156,8 +381,16 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "first_name", Type: field.TypeString, Default: ""},
 		{Name: "last_name", Type: field.TypeString, Default: ""},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"USER", "ADMIN"}},
-		{Name: "provider", Type: field.TypeEnum, Enums: []string{"LOCAL", "GITLAB"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"USER", "ADMIN", "WHITE_TEAM", "BLACK_TEAM", "RED_TEAM"}},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"LOCAL", "GITLAB", "OIDC", "LDAP", "SAML"}},
+		{Name: "external_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "external_subject", Type: field.TypeString, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_counter", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "must_change_password", Type: field.TypeBool, Default: false},
+		{Name: "custom_role_custom_role_to_users", Type: field.TypeUUID, Nullable: true},
 		{Name: "team_team_to_users", Type: field.TypeUUID, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -166,13 +399,52 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_custom_roles_CustomRoleToUsers",
+				Columns:    []*schema.Column{UsersColumns[14]},
+				RefColumns: []*schema.Column{CustomRolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "users_teams_TeamToUsers",
-				Columns:    []*schema.Column{UsersColumns[7]},
+				Columns:    []*schema.Column{UsersColumns[15]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_external_issuer_external_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[7], UsersColumns[8]},
+			},
+		},
+	}
+	// VMCredentialsColumns holds the columns for the "vm_credentials" table.
+	VMCredentialsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "protocol", Type: field.TypeEnum, Enums: []string{"SSH", "RDP"}},
+		{Name: "port", Type: field.TypeInt, Default: 0},
+		{Name: "username", Type: field.TypeString, Default: ""},
+		{Name: "password", Type: field.TypeString, Default: ""},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "domain", Type: field.TypeString, Default: ""},
+		{Name: "ignore_cert", Type: field.TypeBool, Default: true},
+		{Name: "vm_object_vm_object_to_vm_credentials", Type: field.TypeUUID},
+	}
+	// VMCredentialsTable holds the schema information for the "vm_credentials" table.
+	VMCredentialsTable = &schema.Table{
+		Name:       "vm_credentials",
+		Columns:    VMCredentialsColumns,
+		PrimaryKey: []*schema.Column{VMCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vm_credentials_vm_objects_VmObjectToVmCredentials",
+				Columns:    []*schema.Column{VMCredentialsColumns[8]},
+				RefColumns: []*schema.Column{VMObjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// VMObjectsColumns holds the columns for the "vm_objects" table.
 	VMObjectsColumns = []*schema.Column{
@@ -181,6 +453,10 @@ var (
 		{Name: "identifier", Type: field.TypeString},
 		{Name: "ip_addresses", Type: field.TypeJSON, Nullable: true},
 		{Name: "locked", Type: field.TypeBool, Default: false},
+		{Name: "red_team_access", Type: field.TypeBool, Default: false},
+		{Name: "console_limit_per_vm", Type: field.TypeInt, Nullable: true},
+		{Name: "console_limit_per_user", Type: field.TypeInt, Nullable: true},
+		{Name: "console_limit_per_team", Type: field.TypeInt, Nullable: true},
 		{Name: "team_team_to_vm_objects", Type: field.TypeUUID, Nullable: true},
 	}
 	// VMObjectsTable holds the schema information for the "vm_objects" table.
@@ -191,33 +467,115 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vm_objects_teams_TeamToVmObjects",
-				Columns:    []*schema.Column{VMObjectsColumns[5]},
+				Columns:    []*schema.Column{VMObjectsColumns[9]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// WebauthnCredentialsColumns holds the columns for the "webauthn_credentials" table.
+	WebauthnCredentialsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Default: "Passkey"},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Default: ""},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_user_to_webauthn_credentials", Type: field.TypeUUID},
+	}
+	// WebauthnCredentialsTable holds the schema information for the "webauthn_credentials" table.
+	WebauthnCredentialsTable = &schema.Table{
+		Name:       "webauthn_credentials",
+		Columns:    WebauthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{WebauthnCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webauthn_credentials_users_UserToWebauthnCredentials",
+				Columns:    []*schema.Column{WebauthnCredentialsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CompetitionCompetitionToAdminsColumns holds the columns for the "competition_CompetitionToAdmins" table.
+	CompetitionCompetitionToAdminsColumns = []*schema.Column{
+		{Name: "competition_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CompetitionCompetitionToAdminsTable holds the schema information for the "competition_CompetitionToAdmins" table.
+	CompetitionCompetitionToAdminsTable = &schema.Table{
+		Name:       "competition_CompetitionToAdmins",
+		Columns:    CompetitionCompetitionToAdminsColumns,
+		PrimaryKey: []*schema.Column{CompetitionCompetitionToAdminsColumns[0], CompetitionCompetitionToAdminsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "competition_CompetitionToAdmins_competition_id",
+				Columns:    []*schema.Column{CompetitionCompetitionToAdminsColumns[0]},
+				RefColumns: []*schema.Column{CompetitionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "competition_CompetitionToAdmins_user_id",
+				Columns:    []*schema.Column{CompetitionCompetitionToAdminsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActionsTable,
 		CompetitionsTable,
+		CompetitionBreaksTable,
+		ConsoleSessionsTable,
+		ConsoleSharesTable,
+		CustomRolesTable,
+		PersonalAccessTokensTable,
 		ProvidersTable,
 		ServiceAccountsTable,
 		ServiceTokensTable,
+		SigningKeysTable,
 		TeamsTable,
+		TeamMembershipsTable,
 		TokensTable,
 		UsersTable,
+		VMCredentialsTable,
 		VMObjectsTable,
+		WebauthnCredentialsTable,
+		CompetitionCompetitionToAdminsTable,
 	}
 )
 
 func init() {
-	ActionsTable.ForeignKeys[0].RefTable = ServiceAccountsTable
-	ActionsTable.ForeignKeys[1].RefTable = UsersTable
+	ActionsTable.ForeignKeys[0].RefTable = UsersTable
+	ActionsTable.ForeignKeys[1].RefTable = ServiceAccountsTable
+	ActionsTable.ForeignKeys[2].RefTable = UsersTable
 	CompetitionsTable.ForeignKeys[0].RefTable = ProvidersTable
+	CompetitionsTable.ForeignKeys[1].RefTable = ServiceAccountsTable
+	CompetitionBreaksTable.ForeignKeys[0].RefTable = CompetitionsTable
+	ConsoleSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ConsoleSessionsTable.ForeignKeys[1].RefTable = VMObjectsTable
+	ConsoleSharesTable.ForeignKeys[0].RefTable = UsersTable
+	ConsoleSharesTable.ForeignKeys[1].RefTable = VMObjectsTable
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	ServiceTokensTable.ForeignKeys[0].RefTable = ServiceAccountsTable
 	TeamsTable.ForeignKeys[0].RefTable = CompetitionsTable
+	TeamsTable.ForeignKeys[1].RefTable = ServiceAccountsTable
+	TeamMembershipsTable.ForeignKeys[0].RefTable = TeamsTable
+	TeamMembershipsTable.ForeignKeys[1].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = UsersTable
-	UsersTable.ForeignKeys[0].RefTable = TeamsTable
+	TokensTable.ForeignKeys[1].RefTable = UsersTable
+	UsersTable.ForeignKeys[0].RefTable = CustomRolesTable
+	UsersTable.ForeignKeys[1].RefTable = TeamsTable
+	VMCredentialsTable.ForeignKeys[0].RefTable = VMObjectsTable
 	VMObjectsTable.ForeignKeys[0].RefTable = TeamsTable
+	WebauthnCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	CompetitionCompetitionToAdminsTable.ForeignKeys[0].RefTable = CompetitionsTable
+	CompetitionCompetitionToAdminsTable.ForeignKeys[1].RefTable = UsersTable
 }
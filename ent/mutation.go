@@ -11,14 +11,23 @@ import (
 
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -33,15 +42,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAction         = "Action"
-	TypeCompetition    = "Competition"
-	TypeProvider       = "Provider"
-	TypeServiceAccount = "ServiceAccount"
-	TypeServiceToken   = "ServiceToken"
-	TypeTeam           = "Team"
-	TypeToken          = "Token"
-	TypeUser           = "User"
-	TypeVmObject       = "VmObject"
+	TypeAction              = "Action"
+	TypeCompetition         = "Competition"
+	TypeCompetitionBreak    = "CompetitionBreak"
+	TypeConsoleSession      = "ConsoleSession"
+	TypeConsoleShare        = "ConsoleShare"
+	TypeCustomRole          = "CustomRole"
+	TypePersonalAccessToken = "PersonalAccessToken"
+	TypeProvider            = "Provider"
+	TypeServiceAccount      = "ServiceAccount"
+	TypeServiceToken        = "ServiceToken"
+	TypeSigningKey          = "SigningKey"
+	TypeTeam                = "Team"
+	TypeTeamMembership      = "TeamMembership"
+	TypeToken               = "Token"
+	TypeUser                = "User"
+	TypeVmCredential        = "VmCredential"
+	TypeVmObject            = "VmObject"
+	TypeWebauthnCredential  = "WebauthnCredential"
 )
 
 // ActionMutation represents an operation that mutates the Action nodes in the graph.
@@ -59,6 +77,8 @@ type ActionMutation struct {
 	cleared_ActionToUser           bool
 	_ActionToServiceAccount        *uuid.UUID
 	cleared_ActionToServiceAccount bool
+	_ActionToImpersonator          *uuid.UUID
+	cleared_ActionToImpersonator   bool
 	done                           bool
 	oldValue                       func(context.Context) (*Action, error)
 	predicates                     []predicate.Action
@@ -390,6 +410,45 @@ func (m *ActionMutation) ResetActionToServiceAccount() {
 	m.cleared_ActionToServiceAccount = false
 }
 
+// SetActionToImpersonatorID sets the "ActionToImpersonator" edge to the User entity by id.
+func (m *ActionMutation) SetActionToImpersonatorID(id uuid.UUID) {
+	m._ActionToImpersonator = &id
+}
+
+// ClearActionToImpersonator clears the "ActionToImpersonator" edge to the User entity.
+func (m *ActionMutation) ClearActionToImpersonator() {
+	m.cleared_ActionToImpersonator = true
+}
+
+// ActionToImpersonatorCleared reports if the "ActionToImpersonator" edge to the User entity was cleared.
+func (m *ActionMutation) ActionToImpersonatorCleared() bool {
+	return m.cleared_ActionToImpersonator
+}
+
+// ActionToImpersonatorID returns the "ActionToImpersonator" edge ID in the mutation.
+func (m *ActionMutation) ActionToImpersonatorID() (id uuid.UUID, exists bool) {
+	if m._ActionToImpersonator != nil {
+		return *m._ActionToImpersonator, true
+	}
+	return
+}
+
+// ActionToImpersonatorIDs returns the "ActionToImpersonator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActionToImpersonatorID instead. It exists only for internal usage by the builders.
+func (m *ActionMutation) ActionToImpersonatorIDs() (ids []uuid.UUID) {
+	if id := m._ActionToImpersonator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActionToImpersonator resets all changes to the "ActionToImpersonator" edge.
+func (m *ActionMutation) ResetActionToImpersonator() {
+	m._ActionToImpersonator = nil
+	m.cleared_ActionToImpersonator = false
+}
+
 // Where appends a list predicates to the ActionMutation builder.
 func (m *ActionMutation) Where(ps ...predicate.Action) {
 	m.predicates = append(m.predicates, ps...)
@@ -559,13 +618,16 @@ func (m *ActionMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ActionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m._ActionToUser != nil {
 		edges = append(edges, action.EdgeActionToUser)
 	}
 	if m._ActionToServiceAccount != nil {
 		edges = append(edges, action.EdgeActionToServiceAccount)
 	}
+	if m._ActionToImpersonator != nil {
+		edges = append(edges, action.EdgeActionToImpersonator)
+	}
 	return edges
 }
 
@@ -581,13 +643,17 @@ func (m *ActionMutation) AddedIDs(name string) []ent.Value {
 		if id := m._ActionToServiceAccount; id != nil {
 			return []ent.Value{*id}
 		}
+	case action.EdgeActionToImpersonator:
+		if id := m._ActionToImpersonator; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ActionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	return edges
 }
 
@@ -601,13 +667,16 @@ func (m *ActionMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ActionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.cleared_ActionToUser {
 		edges = append(edges, action.EdgeActionToUser)
 	}
 	if m.cleared_ActionToServiceAccount {
 		edges = append(edges, action.EdgeActionToServiceAccount)
 	}
+	if m.cleared_ActionToImpersonator {
+		edges = append(edges, action.EdgeActionToImpersonator)
+	}
 	return edges
 }
 
@@ -619,6 +688,8 @@ func (m *ActionMutation) EdgeCleared(name string) bool {
 		return m.cleared_ActionToUser
 	case action.EdgeActionToServiceAccount:
 		return m.cleared_ActionToServiceAccount
+	case action.EdgeActionToImpersonator:
+		return m.cleared_ActionToImpersonator
 	}
 	return false
 }
@@ -633,6 +704,9 @@ func (m *ActionMutation) ClearEdge(name string) error {
 	case action.EdgeActionToServiceAccount:
 		m.ClearActionToServiceAccount()
 		return nil
+	case action.EdgeActionToImpersonator:
+		m.ClearActionToImpersonator()
+		return nil
 	}
 	return fmt.Errorf("unknown Action unique edge %s", name)
 }
@@ -647,6 +721,9 @@ func (m *ActionMutation) ResetEdge(name string) error {
 	case action.EdgeActionToServiceAccount:
 		m.ResetActionToServiceAccount()
 		return nil
+	case action.EdgeActionToImpersonator:
+		m.ResetActionToImpersonator()
+		return nil
 	}
 	return fmt.Errorf("unknown Action edge %s", name)
 }
@@ -658,12 +735,28 @@ type CompetitionMutation struct {
 	typ                           string
 	id                            *uuid.UUID
 	name                          *string
+	console_limit_per_vm          *int
+	addconsole_limit_per_vm       *int
+	console_limit_per_user        *int
+	addconsole_limit_per_user     *int
+	console_limit_per_team        *int
+	addconsole_limit_per_team     *int
+	starts_at                     *time.Time
+	ends_at                       *time.Time
+	schedule_locked               *bool
+	allowed_cidrs                 *[]string
 	clearedFields                 map[string]struct{}
 	_CompetitionToTeams           map[uuid.UUID]struct{}
 	removed_CompetitionToTeams    map[uuid.UUID]struct{}
 	cleared_CompetitionToTeams    bool
 	_CompetitionToProvider        *uuid.UUID
 	cleared_CompetitionToProvider bool
+	_CompetitionToAdmins          map[uuid.UUID]struct{}
+	removed_CompetitionToAdmins   map[uuid.UUID]struct{}
+	cleared_CompetitionToAdmins   bool
+	_CompetitionToBreaks          map[uuid.UUID]struct{}
+	removed_CompetitionToBreaks   map[uuid.UUID]struct{}
+	cleared_CompetitionToBreaks   bool
 	done                          bool
 	oldValue                      func(context.Context) (*Competition, error)
 	predicates                    []predicate.Competition
@@ -809,6 +902,370 @@ func (m *CompetitionMutation) ResetName() {
 	m.name = nil
 }
 
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (m *CompetitionMutation) SetConsoleLimitPerVM(i int) {
+	m.console_limit_per_vm = &i
+	m.addconsole_limit_per_vm = nil
+}
+
+// ConsoleLimitPerVM returns the value of the "console_limit_per_vm" field in the mutation.
+func (m *CompetitionMutation) ConsoleLimitPerVM() (r int, exists bool) {
+	v := m.console_limit_per_vm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsoleLimitPerVM returns the old "console_limit_per_vm" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldConsoleLimitPerVM(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsoleLimitPerVM is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsoleLimitPerVM requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerVM: %w", err)
+	}
+	return oldValue.ConsoleLimitPerVM, nil
+}
+
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (m *CompetitionMutation) AddConsoleLimitPerVM(i int) {
+	if m.addconsole_limit_per_vm != nil {
+		*m.addconsole_limit_per_vm += i
+	} else {
+		m.addconsole_limit_per_vm = &i
+	}
+}
+
+// AddedConsoleLimitPerVM returns the value that was added to the "console_limit_per_vm" field in this mutation.
+func (m *CompetitionMutation) AddedConsoleLimitPerVM() (r int, exists bool) {
+	v := m.addconsole_limit_per_vm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsoleLimitPerVM resets all changes to the "console_limit_per_vm" field.
+func (m *CompetitionMutation) ResetConsoleLimitPerVM() {
+	m.console_limit_per_vm = nil
+	m.addconsole_limit_per_vm = nil
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (m *CompetitionMutation) SetConsoleLimitPerUser(i int) {
+	m.console_limit_per_user = &i
+	m.addconsole_limit_per_user = nil
+}
+
+// ConsoleLimitPerUser returns the value of the "console_limit_per_user" field in the mutation.
+func (m *CompetitionMutation) ConsoleLimitPerUser() (r int, exists bool) {
+	v := m.console_limit_per_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsoleLimitPerUser returns the old "console_limit_per_user" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldConsoleLimitPerUser(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsoleLimitPerUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsoleLimitPerUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerUser: %w", err)
+	}
+	return oldValue.ConsoleLimitPerUser, nil
+}
+
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (m *CompetitionMutation) AddConsoleLimitPerUser(i int) {
+	if m.addconsole_limit_per_user != nil {
+		*m.addconsole_limit_per_user += i
+	} else {
+		m.addconsole_limit_per_user = &i
+	}
+}
+
+// AddedConsoleLimitPerUser returns the value that was added to the "console_limit_per_user" field in this mutation.
+func (m *CompetitionMutation) AddedConsoleLimitPerUser() (r int, exists bool) {
+	v := m.addconsole_limit_per_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsoleLimitPerUser resets all changes to the "console_limit_per_user" field.
+func (m *CompetitionMutation) ResetConsoleLimitPerUser() {
+	m.console_limit_per_user = nil
+	m.addconsole_limit_per_user = nil
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (m *CompetitionMutation) SetConsoleLimitPerTeam(i int) {
+	m.console_limit_per_team = &i
+	m.addconsole_limit_per_team = nil
+}
+
+// ConsoleLimitPerTeam returns the value of the "console_limit_per_team" field in the mutation.
+func (m *CompetitionMutation) ConsoleLimitPerTeam() (r int, exists bool) {
+	v := m.console_limit_per_team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsoleLimitPerTeam returns the old "console_limit_per_team" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldConsoleLimitPerTeam(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsoleLimitPerTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsoleLimitPerTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerTeam: %w", err)
+	}
+	return oldValue.ConsoleLimitPerTeam, nil
+}
+
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (m *CompetitionMutation) AddConsoleLimitPerTeam(i int) {
+	if m.addconsole_limit_per_team != nil {
+		*m.addconsole_limit_per_team += i
+	} else {
+		m.addconsole_limit_per_team = &i
+	}
+}
+
+// AddedConsoleLimitPerTeam returns the value that was added to the "console_limit_per_team" field in this mutation.
+func (m *CompetitionMutation) AddedConsoleLimitPerTeam() (r int, exists bool) {
+	v := m.addconsole_limit_per_team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsoleLimitPerTeam resets all changes to the "console_limit_per_team" field.
+func (m *CompetitionMutation) ResetConsoleLimitPerTeam() {
+	m.console_limit_per_team = nil
+	m.addconsole_limit_per_team = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *CompetitionMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *CompetitionMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldStartsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (m *CompetitionMutation) ClearStartsAt() {
+	m.starts_at = nil
+	m.clearedFields[competition.FieldStartsAt] = struct{}{}
+}
+
+// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
+func (m *CompetitionMutation) StartsAtCleared() bool {
+	_, ok := m.clearedFields[competition.FieldStartsAt]
+	return ok
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *CompetitionMutation) ResetStartsAt() {
+	m.starts_at = nil
+	delete(m.clearedFields, competition.FieldStartsAt)
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *CompetitionMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *CompetitionMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldEndsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *CompetitionMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[competition.FieldEndsAt] = struct{}{}
+}
+
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *CompetitionMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[competition.FieldEndsAt]
+	return ok
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *CompetitionMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, competition.FieldEndsAt)
+}
+
+// SetScheduleLocked sets the "schedule_locked" field.
+func (m *CompetitionMutation) SetScheduleLocked(b bool) {
+	m.schedule_locked = &b
+}
+
+// ScheduleLocked returns the value of the "schedule_locked" field in the mutation.
+func (m *CompetitionMutation) ScheduleLocked() (r bool, exists bool) {
+	v := m.schedule_locked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleLocked returns the old "schedule_locked" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldScheduleLocked(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleLocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleLocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleLocked: %w", err)
+	}
+	return oldValue.ScheduleLocked, nil
+}
+
+// ClearScheduleLocked clears the value of the "schedule_locked" field.
+func (m *CompetitionMutation) ClearScheduleLocked() {
+	m.schedule_locked = nil
+	m.clearedFields[competition.FieldScheduleLocked] = struct{}{}
+}
+
+// ScheduleLockedCleared returns if the "schedule_locked" field was cleared in this mutation.
+func (m *CompetitionMutation) ScheduleLockedCleared() bool {
+	_, ok := m.clearedFields[competition.FieldScheduleLocked]
+	return ok
+}
+
+// ResetScheduleLocked resets all changes to the "schedule_locked" field.
+func (m *CompetitionMutation) ResetScheduleLocked() {
+	m.schedule_locked = nil
+	delete(m.clearedFields, competition.FieldScheduleLocked)
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (m *CompetitionMutation) SetAllowedCidrs(s []string) {
+	m.allowed_cidrs = &s
+}
+
+// AllowedCidrs returns the value of the "allowed_cidrs" field in the mutation.
+func (m *CompetitionMutation) AllowedCidrs() (r []string, exists bool) {
+	v := m.allowed_cidrs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedCidrs returns the old "allowed_cidrs" field's value of the Competition entity.
+// If the Competition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompetitionMutation) OldAllowedCidrs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedCidrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedCidrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedCidrs: %w", err)
+	}
+	return oldValue.AllowedCidrs, nil
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (m *CompetitionMutation) ClearAllowedCidrs() {
+	m.allowed_cidrs = nil
+	m.clearedFields[competition.FieldAllowedCidrs] = struct{}{}
+}
+
+// AllowedCidrsCleared returns if the "allowed_cidrs" field was cleared in this mutation.
+func (m *CompetitionMutation) AllowedCidrsCleared() bool {
+	_, ok := m.clearedFields[competition.FieldAllowedCidrs]
+	return ok
+}
+
+// ResetAllowedCidrs resets all changes to the "allowed_cidrs" field.
+func (m *CompetitionMutation) ResetAllowedCidrs() {
+	m.allowed_cidrs = nil
+	delete(m.clearedFields, competition.FieldAllowedCidrs)
+}
+
 // AddCompetitionToTeamIDs adds the "CompetitionToTeams" edge to the Team entity by ids.
 func (m *CompetitionMutation) AddCompetitionToTeamIDs(ids ...uuid.UUID) {
 	if m._CompetitionToTeams == nil {
@@ -902,39 +1359,182 @@ func (m *CompetitionMutation) ResetCompetitionToProvider() {
 	m.cleared_CompetitionToProvider = false
 }
 
-// Where appends a list predicates to the CompetitionMutation builder.
-func (m *CompetitionMutation) Where(ps ...predicate.Competition) {
-	m.predicates = append(m.predicates, ps...)
+// AddCompetitionToAdminIDs adds the "CompetitionToAdmins" edge to the User entity by ids.
+func (m *CompetitionMutation) AddCompetitionToAdminIDs(ids ...uuid.UUID) {
+	if m._CompetitionToAdmins == nil {
+		m._CompetitionToAdmins = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._CompetitionToAdmins[ids[i]] = struct{}{}
+	}
 }
 
-// Op returns the operation name.
-func (m *CompetitionMutation) Op() Op {
-	return m.op
+// ClearCompetitionToAdmins clears the "CompetitionToAdmins" edge to the User entity.
+func (m *CompetitionMutation) ClearCompetitionToAdmins() {
+	m.cleared_CompetitionToAdmins = true
 }
 
-// Type returns the node type of this mutation (Competition).
-func (m *CompetitionMutation) Type() string {
-	return m.typ
+// CompetitionToAdminsCleared reports if the "CompetitionToAdmins" edge to the User entity was cleared.
+func (m *CompetitionMutation) CompetitionToAdminsCleared() bool {
+	return m.cleared_CompetitionToAdmins
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CompetitionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, competition.FieldName)
+// RemoveCompetitionToAdminIDs removes the "CompetitionToAdmins" edge to the User entity by IDs.
+func (m *CompetitionMutation) RemoveCompetitionToAdminIDs(ids ...uuid.UUID) {
+	if m.removed_CompetitionToAdmins == nil {
+		m.removed_CompetitionToAdmins = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._CompetitionToAdmins, ids[i])
+		m.removed_CompetitionToAdmins[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CompetitionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case competition.FieldName:
-		return m.Name()
+// RemovedCompetitionToAdmins returns the removed IDs of the "CompetitionToAdmins" edge to the User entity.
+func (m *CompetitionMutation) RemovedCompetitionToAdminsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_CompetitionToAdmins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CompetitionToAdminsIDs returns the "CompetitionToAdmins" edge IDs in the mutation.
+func (m *CompetitionMutation) CompetitionToAdminsIDs() (ids []uuid.UUID) {
+	for id := range m._CompetitionToAdmins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCompetitionToAdmins resets all changes to the "CompetitionToAdmins" edge.
+func (m *CompetitionMutation) ResetCompetitionToAdmins() {
+	m._CompetitionToAdmins = nil
+	m.cleared_CompetitionToAdmins = false
+	m.removed_CompetitionToAdmins = nil
+}
+
+// AddCompetitionToBreakIDs adds the "CompetitionToBreaks" edge to the CompetitionBreak entity by ids.
+func (m *CompetitionMutation) AddCompetitionToBreakIDs(ids ...uuid.UUID) {
+	if m._CompetitionToBreaks == nil {
+		m._CompetitionToBreaks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._CompetitionToBreaks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCompetitionToBreaks clears the "CompetitionToBreaks" edge to the CompetitionBreak entity.
+func (m *CompetitionMutation) ClearCompetitionToBreaks() {
+	m.cleared_CompetitionToBreaks = true
+}
+
+// CompetitionToBreaksCleared reports if the "CompetitionToBreaks" edge to the CompetitionBreak entity was cleared.
+func (m *CompetitionMutation) CompetitionToBreaksCleared() bool {
+	return m.cleared_CompetitionToBreaks
+}
+
+// RemoveCompetitionToBreakIDs removes the "CompetitionToBreaks" edge to the CompetitionBreak entity by IDs.
+func (m *CompetitionMutation) RemoveCompetitionToBreakIDs(ids ...uuid.UUID) {
+	if m.removed_CompetitionToBreaks == nil {
+		m.removed_CompetitionToBreaks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._CompetitionToBreaks, ids[i])
+		m.removed_CompetitionToBreaks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCompetitionToBreaks returns the removed IDs of the "CompetitionToBreaks" edge to the CompetitionBreak entity.
+func (m *CompetitionMutation) RemovedCompetitionToBreaksIDs() (ids []uuid.UUID) {
+	for id := range m.removed_CompetitionToBreaks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CompetitionToBreaksIDs returns the "CompetitionToBreaks" edge IDs in the mutation.
+func (m *CompetitionMutation) CompetitionToBreaksIDs() (ids []uuid.UUID) {
+	for id := range m._CompetitionToBreaks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCompetitionToBreaks resets all changes to the "CompetitionToBreaks" edge.
+func (m *CompetitionMutation) ResetCompetitionToBreaks() {
+	m._CompetitionToBreaks = nil
+	m.cleared_CompetitionToBreaks = false
+	m.removed_CompetitionToBreaks = nil
+}
+
+// Where appends a list predicates to the CompetitionMutation builder.
+func (m *CompetitionMutation) Where(ps ...predicate.Competition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CompetitionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Competition).
+func (m *CompetitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CompetitionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, competition.FieldName)
+	}
+	if m.console_limit_per_vm != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerVM)
+	}
+	if m.console_limit_per_user != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerUser)
+	}
+	if m.console_limit_per_team != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerTeam)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, competition.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, competition.FieldEndsAt)
+	}
+	if m.schedule_locked != nil {
+		fields = append(fields, competition.FieldScheduleLocked)
+	}
+	if m.allowed_cidrs != nil {
+		fields = append(fields, competition.FieldAllowedCidrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CompetitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case competition.FieldName:
+		return m.Name()
+	case competition.FieldConsoleLimitPerVM:
+		return m.ConsoleLimitPerVM()
+	case competition.FieldConsoleLimitPerUser:
+		return m.ConsoleLimitPerUser()
+	case competition.FieldConsoleLimitPerTeam:
+		return m.ConsoleLimitPerTeam()
+	case competition.FieldStartsAt:
+		return m.StartsAt()
+	case competition.FieldEndsAt:
+		return m.EndsAt()
+	case competition.FieldScheduleLocked:
+		return m.ScheduleLocked()
+	case competition.FieldAllowedCidrs:
+		return m.AllowedCidrs()
 	}
 	return nil, false
 }
@@ -946,6 +1546,20 @@ func (m *CompetitionMutation) OldField(ctx context.Context, name string) (ent.Va
 	switch name {
 	case competition.FieldName:
 		return m.OldName(ctx)
+	case competition.FieldConsoleLimitPerVM:
+		return m.OldConsoleLimitPerVM(ctx)
+	case competition.FieldConsoleLimitPerUser:
+		return m.OldConsoleLimitPerUser(ctx)
+	case competition.FieldConsoleLimitPerTeam:
+		return m.OldConsoleLimitPerTeam(ctx)
+	case competition.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case competition.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case competition.FieldScheduleLocked:
+		return m.OldScheduleLocked(ctx)
+	case competition.FieldAllowedCidrs:
+		return m.OldAllowedCidrs(ctx)
 	}
 	return nil, fmt.Errorf("unknown Competition field %s", name)
 }
@@ -962,6 +1576,55 @@ func (m *CompetitionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case competition.FieldConsoleLimitPerVM:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsoleLimitPerVM(v)
+		return nil
+	case competition.FieldConsoleLimitPerUser:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsoleLimitPerUser(v)
+		return nil
+	case competition.FieldConsoleLimitPerTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsoleLimitPerTeam(v)
+		return nil
+	case competition.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case competition.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case competition.FieldScheduleLocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleLocked(v)
+		return nil
+	case competition.FieldAllowedCidrs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedCidrs(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Competition field %s", name)
 }
@@ -969,13 +1632,31 @@ func (m *CompetitionMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *CompetitionMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addconsole_limit_per_vm != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerVM)
+	}
+	if m.addconsole_limit_per_user != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerUser)
+	}
+	if m.addconsole_limit_per_team != nil {
+		fields = append(fields, competition.FieldConsoleLimitPerTeam)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *CompetitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case competition.FieldConsoleLimitPerVM:
+		return m.AddedConsoleLimitPerVM()
+	case competition.FieldConsoleLimitPerUser:
+		return m.AddedConsoleLimitPerUser()
+	case competition.FieldConsoleLimitPerTeam:
+		return m.AddedConsoleLimitPerTeam()
+	}
 	return nil, false
 }
 
@@ -984,6 +1665,27 @@ func (m *CompetitionMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *CompetitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case competition.FieldConsoleLimitPerVM:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerVM(v)
+		return nil
+	case competition.FieldConsoleLimitPerUser:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerUser(v)
+		return nil
+	case competition.FieldConsoleLimitPerTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerTeam(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Competition numeric field %s", name)
 }
@@ -991,7 +1693,20 @@ func (m *CompetitionMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CompetitionMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(competition.FieldStartsAt) {
+		fields = append(fields, competition.FieldStartsAt)
+	}
+	if m.FieldCleared(competition.FieldEndsAt) {
+		fields = append(fields, competition.FieldEndsAt)
+	}
+	if m.FieldCleared(competition.FieldScheduleLocked) {
+		fields = append(fields, competition.FieldScheduleLocked)
+	}
+	if m.FieldCleared(competition.FieldAllowedCidrs) {
+		fields = append(fields, competition.FieldAllowedCidrs)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1004,6 +1719,20 @@ func (m *CompetitionMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CompetitionMutation) ClearField(name string) error {
+	switch name {
+	case competition.FieldStartsAt:
+		m.ClearStartsAt()
+		return nil
+	case competition.FieldEndsAt:
+		m.ClearEndsAt()
+		return nil
+	case competition.FieldScheduleLocked:
+		m.ClearScheduleLocked()
+		return nil
+	case competition.FieldAllowedCidrs:
+		m.ClearAllowedCidrs()
+		return nil
+	}
 	return fmt.Errorf("unknown Competition nullable field %s", name)
 }
 
@@ -1014,19 +1743,46 @@ func (m *CompetitionMutation) ResetField(name string) error {
 	case competition.FieldName:
 		m.ResetName()
 		return nil
+	case competition.FieldConsoleLimitPerVM:
+		m.ResetConsoleLimitPerVM()
+		return nil
+	case competition.FieldConsoleLimitPerUser:
+		m.ResetConsoleLimitPerUser()
+		return nil
+	case competition.FieldConsoleLimitPerTeam:
+		m.ResetConsoleLimitPerTeam()
+		return nil
+	case competition.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case competition.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case competition.FieldScheduleLocked:
+		m.ResetScheduleLocked()
+		return nil
+	case competition.FieldAllowedCidrs:
+		m.ResetAllowedCidrs()
+		return nil
 	}
 	return fmt.Errorf("unknown Competition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *CompetitionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m._CompetitionToTeams != nil {
 		edges = append(edges, competition.EdgeCompetitionToTeams)
 	}
 	if m._CompetitionToProvider != nil {
 		edges = append(edges, competition.EdgeCompetitionToProvider)
 	}
+	if m._CompetitionToAdmins != nil {
+		edges = append(edges, competition.EdgeCompetitionToAdmins)
+	}
+	if m._CompetitionToBreaks != nil {
+		edges = append(edges, competition.EdgeCompetitionToBreaks)
+	}
 	return edges
 }
 
@@ -1044,16 +1800,34 @@ func (m *CompetitionMutation) AddedIDs(name string) []ent.Value {
 		if id := m._CompetitionToProvider; id != nil {
 			return []ent.Value{*id}
 		}
+	case competition.EdgeCompetitionToAdmins:
+		ids := make([]ent.Value, 0, len(m._CompetitionToAdmins))
+		for id := range m._CompetitionToAdmins {
+			ids = append(ids, id)
+		}
+		return ids
+	case competition.EdgeCompetitionToBreaks:
+		ids := make([]ent.Value, 0, len(m._CompetitionToBreaks))
+		for id := range m._CompetitionToBreaks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *CompetitionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.removed_CompetitionToTeams != nil {
 		edges = append(edges, competition.EdgeCompetitionToTeams)
 	}
+	if m.removed_CompetitionToAdmins != nil {
+		edges = append(edges, competition.EdgeCompetitionToAdmins)
+	}
+	if m.removed_CompetitionToBreaks != nil {
+		edges = append(edges, competition.EdgeCompetitionToBreaks)
+	}
 	return edges
 }
 
@@ -1067,19 +1841,37 @@ func (m *CompetitionMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case competition.EdgeCompetitionToAdmins:
+		ids := make([]ent.Value, 0, len(m.removed_CompetitionToAdmins))
+		for id := range m.removed_CompetitionToAdmins {
+			ids = append(ids, id)
+		}
+		return ids
+	case competition.EdgeCompetitionToBreaks:
+		ids := make([]ent.Value, 0, len(m.removed_CompetitionToBreaks))
+		for id := range m.removed_CompetitionToBreaks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *CompetitionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.cleared_CompetitionToTeams {
 		edges = append(edges, competition.EdgeCompetitionToTeams)
 	}
 	if m.cleared_CompetitionToProvider {
 		edges = append(edges, competition.EdgeCompetitionToProvider)
 	}
+	if m.cleared_CompetitionToAdmins {
+		edges = append(edges, competition.EdgeCompetitionToAdmins)
+	}
+	if m.cleared_CompetitionToBreaks {
+		edges = append(edges, competition.EdgeCompetitionToBreaks)
+	}
 	return edges
 }
 
@@ -1091,6 +1883,10 @@ func (m *CompetitionMutation) EdgeCleared(name string) bool {
 		return m.cleared_CompetitionToTeams
 	case competition.EdgeCompetitionToProvider:
 		return m.cleared_CompetitionToProvider
+	case competition.EdgeCompetitionToAdmins:
+		return m.cleared_CompetitionToAdmins
+	case competition.EdgeCompetitionToBreaks:
+		return m.cleared_CompetitionToBreaks
 	}
 	return false
 }
@@ -1116,39 +1912,44 @@ func (m *CompetitionMutation) ResetEdge(name string) error {
 	case competition.EdgeCompetitionToProvider:
 		m.ResetCompetitionToProvider()
 		return nil
+	case competition.EdgeCompetitionToAdmins:
+		m.ResetCompetitionToAdmins()
+		return nil
+	case competition.EdgeCompetitionToBreaks:
+		m.ResetCompetitionToBreaks()
+		return nil
 	}
 	return fmt.Errorf("unknown Competition edge %s", name)
 }
 
-// ProviderMutation represents an operation that mutates the Provider nodes in the graph.
-type ProviderMutation struct {
+// CompetitionBreakMutation represents an operation that mutates the CompetitionBreak nodes in the graph.
+type CompetitionBreakMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *uuid.UUID
-	name                           *string
-	_type                          *string
-	_config                        *string
-	clearedFields                  map[string]struct{}
-	_ProviderToCompetitions        map[uuid.UUID]struct{}
-	removed_ProviderToCompetitions map[uuid.UUID]struct{}
-	cleared_ProviderToCompetitions bool
-	done                           bool
-	oldValue                       func(context.Context) (*Provider, error)
-	predicates                     []predicate.Provider
-}
-
-var _ ent.Mutation = (*ProviderMutation)(nil)
-
-// providerOption allows management of the mutation configuration using functional options.
-type providerOption func(*ProviderMutation)
-
-// newProviderMutation creates new mutation for the Provider entity.
-func newProviderMutation(c config, op Op, opts ...providerOption) *ProviderMutation {
-	m := &ProviderMutation{
+	op                                    Op
+	typ                                   string
+	id                                    *uuid.UUID
+	name                                  *string
+	starts_at                             *time.Time
+	ends_at                               *time.Time
+	clearedFields                         map[string]struct{}
+	_CompetitionBreakToCompetition        *uuid.UUID
+	cleared_CompetitionBreakToCompetition bool
+	done                                  bool
+	oldValue                              func(context.Context) (*CompetitionBreak, error)
+	predicates                            []predicate.CompetitionBreak
+}
+
+var _ ent.Mutation = (*CompetitionBreakMutation)(nil)
+
+// competitionbreakOption allows management of the mutation configuration using functional options.
+type competitionbreakOption func(*CompetitionBreakMutation)
+
+// newCompetitionBreakMutation creates new mutation for the CompetitionBreak entity.
+func newCompetitionBreakMutation(c config, op Op, opts ...competitionbreakOption) *CompetitionBreakMutation {
+	m := &CompetitionBreakMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProvider,
+		typ:           TypeCompetitionBreak,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1157,20 +1958,20 @@ func newProviderMutation(c config, op Op, opts ...providerOption) *ProviderMutat
 	return m
 }
 
-// withProviderID sets the ID field of the mutation.
-func withProviderID(id uuid.UUID) providerOption {
-	return func(m *ProviderMutation) {
+// withCompetitionBreakID sets the ID field of the mutation.
+func withCompetitionBreakID(id uuid.UUID) competitionbreakOption {
+	return func(m *CompetitionBreakMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Provider
+			value *CompetitionBreak
 		)
-		m.oldValue = func(ctx context.Context) (*Provider, error) {
+		m.oldValue = func(ctx context.Context) (*CompetitionBreak, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Provider.Get(ctx, id)
+					value, err = m.Client().CompetitionBreak.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1179,10 +1980,10 @@ func withProviderID(id uuid.UUID) providerOption {
 	}
 }
 
-// withProvider sets the old Provider of the mutation.
-func withProvider(node *Provider) providerOption {
-	return func(m *ProviderMutation) {
-		m.oldValue = func(context.Context) (*Provider, error) {
+// withCompetitionBreak sets the old CompetitionBreak of the mutation.
+func withCompetitionBreak(node *CompetitionBreak) competitionbreakOption {
+	return func(m *CompetitionBreakMutation) {
+		m.oldValue = func(context.Context) (*CompetitionBreak, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1191,7 +1992,7 @@ func withProvider(node *Provider) providerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProviderMutation) Client() *Client {
+func (m CompetitionBreakMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1199,7 +2000,7 @@ func (m ProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProviderMutation) Tx() (*Tx, error) {
+func (m CompetitionBreakMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1209,14 +2010,14 @@ func (m ProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Provider entities.
-func (m *ProviderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CompetitionBreak entities.
+func (m *CompetitionBreakMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProviderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CompetitionBreakMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1227,7 +2028,7 @@ func (m *ProviderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CompetitionBreakMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1236,19 +2037,19 @@ func (m *ProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Provider.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CompetitionBreak.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ProviderMutation) SetName(s string) {
+func (m *CompetitionBreakMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ProviderMutation) Name() (r string, exists bool) {
+func (m *CompetitionBreakMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1256,10 +2057,10 @@ func (m *ProviderMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Provider entity.
-// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the CompetitionBreak entity.
+// If the CompetitionBreak object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CompetitionBreakMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1274,164 +2075,149 @@ func (m *ProviderMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ProviderMutation) ResetName() {
+func (m *CompetitionBreakMutation) ResetName() {
 	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *ProviderMutation) SetType(s string) {
-	m._type = &s
+// SetStartsAt sets the "starts_at" field.
+func (m *CompetitionBreakMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ProviderMutation) GetType() (r string, exists bool) {
-	v := m._type
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *CompetitionBreakMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Provider entity.
-// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// OldStartsAt returns the old "starts_at" field's value of the CompetitionBreak entity.
+// If the CompetitionBreak object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CompetitionBreakMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.StartsAt, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ProviderMutation) ResetType() {
-	m._type = nil
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *CompetitionBreakMutation) ResetStartsAt() {
+	m.starts_at = nil
 }
 
-// SetConfig sets the "config" field.
-func (m *ProviderMutation) SetConfig(s string) {
-	m._config = &s
+// SetEndsAt sets the "ends_at" field.
+func (m *CompetitionBreakMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *ProviderMutation) Config() (r string, exists bool) {
-	v := m._config
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *CompetitionBreakMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the Provider entity.
-// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// OldEndsAt returns the old "ends_at" field's value of the CompetitionBreak entity.
+// If the CompetitionBreak object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderMutation) OldConfig(ctx context.Context) (v string, err error) {
+func (m *CompetitionBreakMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.EndsAt, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *ProviderMutation) ResetConfig() {
-	m._config = nil
-}
-
-// AddProviderToCompetitionIDs adds the "ProviderToCompetitions" edge to the Competition entity by ids.
-func (m *ProviderMutation) AddProviderToCompetitionIDs(ids ...uuid.UUID) {
-	if m._ProviderToCompetitions == nil {
-		m._ProviderToCompetitions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m._ProviderToCompetitions[ids[i]] = struct{}{}
-	}
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *CompetitionBreakMutation) ResetEndsAt() {
+	m.ends_at = nil
 }
 
-// ClearProviderToCompetitions clears the "ProviderToCompetitions" edge to the Competition entity.
-func (m *ProviderMutation) ClearProviderToCompetitions() {
-	m.cleared_ProviderToCompetitions = true
+// SetCompetitionBreakToCompetitionID sets the "CompetitionBreakToCompetition" edge to the Competition entity by id.
+func (m *CompetitionBreakMutation) SetCompetitionBreakToCompetitionID(id uuid.UUID) {
+	m._CompetitionBreakToCompetition = &id
 }
 
-// ProviderToCompetitionsCleared reports if the "ProviderToCompetitions" edge to the Competition entity was cleared.
-func (m *ProviderMutation) ProviderToCompetitionsCleared() bool {
-	return m.cleared_ProviderToCompetitions
+// ClearCompetitionBreakToCompetition clears the "CompetitionBreakToCompetition" edge to the Competition entity.
+func (m *CompetitionBreakMutation) ClearCompetitionBreakToCompetition() {
+	m.cleared_CompetitionBreakToCompetition = true
 }
 
-// RemoveProviderToCompetitionIDs removes the "ProviderToCompetitions" edge to the Competition entity by IDs.
-func (m *ProviderMutation) RemoveProviderToCompetitionIDs(ids ...uuid.UUID) {
-	if m.removed_ProviderToCompetitions == nil {
-		m.removed_ProviderToCompetitions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m._ProviderToCompetitions, ids[i])
-		m.removed_ProviderToCompetitions[ids[i]] = struct{}{}
-	}
+// CompetitionBreakToCompetitionCleared reports if the "CompetitionBreakToCompetition" edge to the Competition entity was cleared.
+func (m *CompetitionBreakMutation) CompetitionBreakToCompetitionCleared() bool {
+	return m.cleared_CompetitionBreakToCompetition
 }
 
-// RemovedProviderToCompetitions returns the removed IDs of the "ProviderToCompetitions" edge to the Competition entity.
-func (m *ProviderMutation) RemovedProviderToCompetitionsIDs() (ids []uuid.UUID) {
-	for id := range m.removed_ProviderToCompetitions {
-		ids = append(ids, id)
+// CompetitionBreakToCompetitionID returns the "CompetitionBreakToCompetition" edge ID in the mutation.
+func (m *CompetitionBreakMutation) CompetitionBreakToCompetitionID() (id uuid.UUID, exists bool) {
+	if m._CompetitionBreakToCompetition != nil {
+		return *m._CompetitionBreakToCompetition, true
 	}
 	return
 }
 
-// ProviderToCompetitionsIDs returns the "ProviderToCompetitions" edge IDs in the mutation.
-func (m *ProviderMutation) ProviderToCompetitionsIDs() (ids []uuid.UUID) {
-	for id := range m._ProviderToCompetitions {
-		ids = append(ids, id)
+// CompetitionBreakToCompetitionIDs returns the "CompetitionBreakToCompetition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompetitionBreakToCompetitionID instead. It exists only for internal usage by the builders.
+func (m *CompetitionBreakMutation) CompetitionBreakToCompetitionIDs() (ids []uuid.UUID) {
+	if id := m._CompetitionBreakToCompetition; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProviderToCompetitions resets all changes to the "ProviderToCompetitions" edge.
-func (m *ProviderMutation) ResetProviderToCompetitions() {
-	m._ProviderToCompetitions = nil
-	m.cleared_ProviderToCompetitions = false
-	m.removed_ProviderToCompetitions = nil
+// ResetCompetitionBreakToCompetition resets all changes to the "CompetitionBreakToCompetition" edge.
+func (m *CompetitionBreakMutation) ResetCompetitionBreakToCompetition() {
+	m._CompetitionBreakToCompetition = nil
+	m.cleared_CompetitionBreakToCompetition = false
 }
 
-// Where appends a list predicates to the ProviderMutation builder.
-func (m *ProviderMutation) Where(ps ...predicate.Provider) {
+// Where appends a list predicates to the CompetitionBreakMutation builder.
+func (m *CompetitionBreakMutation) Where(ps ...predicate.CompetitionBreak) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ProviderMutation) Op() Op {
+func (m *CompetitionBreakMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Provider).
-func (m *ProviderMutation) Type() string {
+// Type returns the node type of this mutation (CompetitionBreak).
+func (m *CompetitionBreakMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProviderMutation) Fields() []string {
+func (m *CompetitionBreakMutation) Fields() []string {
 	fields := make([]string, 0, 3)
 	if m.name != nil {
-		fields = append(fields, provider.FieldName)
+		fields = append(fields, competitionbreak.FieldName)
 	}
-	if m._type != nil {
-		fields = append(fields, provider.FieldType)
+	if m.starts_at != nil {
+		fields = append(fields, competitionbreak.FieldStartsAt)
 	}
-	if m._config != nil {
-		fields = append(fields, provider.FieldConfig)
+	if m.ends_at != nil {
+		fields = append(fields, competitionbreak.FieldEndsAt)
 	}
 	return fields
 }
@@ -1439,14 +2225,14 @@ func (m *ProviderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProviderMutation) Field(name string) (ent.Value, bool) {
+func (m *CompetitionBreakMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case provider.FieldName:
+	case competitionbreak.FieldName:
 		return m.Name()
-	case provider.FieldType:
-		return m.GetType()
-	case provider.FieldConfig:
-		return m.Config()
+	case competitionbreak.FieldStartsAt:
+		return m.StartsAt()
+	case competitionbreak.FieldEndsAt:
+		return m.EndsAt()
 	}
 	return nil, false
 }
@@ -1454,223 +2240,214 @@ func (m *ProviderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CompetitionBreakMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case provider.FieldName:
+	case competitionbreak.FieldName:
 		return m.OldName(ctx)
-	case provider.FieldType:
-		return m.OldType(ctx)
-	case provider.FieldConfig:
-		return m.OldConfig(ctx)
+	case competitionbreak.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case competitionbreak.FieldEndsAt:
+		return m.OldEndsAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Provider field %s", name)
+	return nil, fmt.Errorf("unknown CompetitionBreak field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProviderMutation) SetField(name string, value ent.Value) error {
+func (m *CompetitionBreakMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case provider.FieldName:
+	case competitionbreak.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case provider.FieldType:
-		v, ok := value.(string)
+	case competitionbreak.FieldStartsAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetStartsAt(v)
 		return nil
-	case provider.FieldConfig:
-		v, ok := value.(string)
+	case competitionbreak.FieldEndsAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfig(v)
+		m.SetEndsAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Provider field %s", name)
+	return fmt.Errorf("unknown CompetitionBreak field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProviderMutation) AddedFields() []string {
+func (m *CompetitionBreakMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProviderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CompetitionBreakMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProviderMutation) AddField(name string, value ent.Value) error {
+func (m *CompetitionBreakMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Provider numeric field %s", name)
+	return fmt.Errorf("unknown CompetitionBreak numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProviderMutation) ClearedFields() []string {
+func (m *CompetitionBreakMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProviderMutation) FieldCleared(name string) bool {
+func (m *CompetitionBreakMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProviderMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Provider nullable field %s", name)
+func (m *CompetitionBreakMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CompetitionBreak nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProviderMutation) ResetField(name string) error {
+func (m *CompetitionBreakMutation) ResetField(name string) error {
 	switch name {
-	case provider.FieldName:
+	case competitionbreak.FieldName:
 		m.ResetName()
 		return nil
-	case provider.FieldType:
-		m.ResetType()
+	case competitionbreak.FieldStartsAt:
+		m.ResetStartsAt()
 		return nil
-	case provider.FieldConfig:
-		m.ResetConfig()
+	case competitionbreak.FieldEndsAt:
+		m.ResetEndsAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Provider field %s", name)
+	return fmt.Errorf("unknown CompetitionBreak field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProviderMutation) AddedEdges() []string {
+func (m *CompetitionBreakMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m._ProviderToCompetitions != nil {
-		edges = append(edges, provider.EdgeProviderToCompetitions)
+	if m._CompetitionBreakToCompetition != nil {
+		edges = append(edges, competitionbreak.EdgeCompetitionBreakToCompetition)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProviderMutation) AddedIDs(name string) []ent.Value {
+func (m *CompetitionBreakMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case provider.EdgeProviderToCompetitions:
-		ids := make([]ent.Value, 0, len(m._ProviderToCompetitions))
-		for id := range m._ProviderToCompetitions {
-			ids = append(ids, id)
+	case competitionbreak.EdgeCompetitionBreakToCompetition:
+		if id := m._CompetitionBreakToCompetition; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProviderMutation) RemovedEdges() []string {
+func (m *CompetitionBreakMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removed_ProviderToCompetitions != nil {
-		edges = append(edges, provider.EdgeProviderToCompetitions)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProviderMutation) RemovedIDs(name string) []ent.Value {
+func (m *CompetitionBreakMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case provider.EdgeProviderToCompetitions:
-		ids := make([]ent.Value, 0, len(m.removed_ProviderToCompetitions))
-		for id := range m.removed_ProviderToCompetitions {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProviderMutation) ClearedEdges() []string {
+func (m *CompetitionBreakMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleared_ProviderToCompetitions {
-		edges = append(edges, provider.EdgeProviderToCompetitions)
+	if m.cleared_CompetitionBreakToCompetition {
+		edges = append(edges, competitionbreak.EdgeCompetitionBreakToCompetition)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProviderMutation) EdgeCleared(name string) bool {
+func (m *CompetitionBreakMutation) EdgeCleared(name string) bool {
 	switch name {
-	case provider.EdgeProviderToCompetitions:
-		return m.cleared_ProviderToCompetitions
+	case competitionbreak.EdgeCompetitionBreakToCompetition:
+		return m.cleared_CompetitionBreakToCompetition
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProviderMutation) ClearEdge(name string) error {
+func (m *CompetitionBreakMutation) ClearEdge(name string) error {
 	switch name {
+	case competitionbreak.EdgeCompetitionBreakToCompetition:
+		m.ClearCompetitionBreakToCompetition()
+		return nil
 	}
-	return fmt.Errorf("unknown Provider unique edge %s", name)
+	return fmt.Errorf("unknown CompetitionBreak unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProviderMutation) ResetEdge(name string) error {
+func (m *CompetitionBreakMutation) ResetEdge(name string) error {
 	switch name {
-	case provider.EdgeProviderToCompetitions:
-		m.ResetProviderToCompetitions()
+	case competitionbreak.EdgeCompetitionBreakToCompetition:
+		m.ResetCompetitionBreakToCompetition()
 		return nil
 	}
-	return fmt.Errorf("unknown Provider edge %s", name)
+	return fmt.Errorf("unknown CompetitionBreak edge %s", name)
 }
 
-// ServiceAccountMutation represents an operation that mutates the ServiceAccount nodes in the graph.
-type ServiceAccountMutation struct {
+// ConsoleSessionMutation represents an operation that mutates the ConsoleSession nodes in the graph.
+type ConsoleSessionMutation struct {
 	config
-	op                              Op
-	typ                             string
-	id                              *uuid.UUID
-	display_name                    *string
-	api_key                         *uuid.UUID
-	api_secret                      *uuid.UUID
-	active                          *bool
-	clearedFields                   map[string]struct{}
-	_ServiceAccountToToken          map[uuid.UUID]struct{}
-	removed_ServiceAccountToToken   map[uuid.UUID]struct{}
-	cleared_ServiceAccountToToken   bool
-	_ServiceAccountToActions        map[uuid.UUID]struct{}
-	removed_ServiceAccountToActions map[uuid.UUID]struct{}
-	cleared_ServiceAccountToActions bool
-	done                            bool
-	oldValue                        func(context.Context) (*ServiceAccount, error)
-	predicates                      []predicate.ServiceAccount
-}
-
-var _ ent.Mutation = (*ServiceAccountMutation)(nil)
-
-// serviceaccountOption allows management of the mutation configuration using functional options.
-type serviceaccountOption func(*ServiceAccountMutation)
-
-// newServiceAccountMutation creates new mutation for the ServiceAccount entity.
-func newServiceAccountMutation(c config, op Op, opts ...serviceaccountOption) *ServiceAccountMutation {
-	m := &ServiceAccountMutation{
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	console_type                     *string
+	ip_address                       *string
+	started_at                       *time.Time
+	ended_at                         *time.Time
+	transcript                       *string
+	clearedFields                    map[string]struct{}
+	_ConsoleSessionToUser            *uuid.UUID
+	cleared_ConsoleSessionToUser     bool
+	_ConsoleSessionToVmObject        *uuid.UUID
+	cleared_ConsoleSessionToVmObject bool
+	done                             bool
+	oldValue                         func(context.Context) (*ConsoleSession, error)
+	predicates                       []predicate.ConsoleSession
+}
+
+var _ ent.Mutation = (*ConsoleSessionMutation)(nil)
+
+// consolesessionOption allows management of the mutation configuration using functional options.
+type consolesessionOption func(*ConsoleSessionMutation)
+
+// newConsoleSessionMutation creates new mutation for the ConsoleSession entity.
+func newConsoleSessionMutation(c config, op Op, opts ...consolesessionOption) *ConsoleSessionMutation {
+	m := &ConsoleSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeServiceAccount,
+		typ:           TypeConsoleSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1679,20 +2456,20 @@ func newServiceAccountMutation(c config, op Op, opts ...serviceaccountOption) *S
 	return m
 }
 
-// withServiceAccountID sets the ID field of the mutation.
-func withServiceAccountID(id uuid.UUID) serviceaccountOption {
-	return func(m *ServiceAccountMutation) {
+// withConsoleSessionID sets the ID field of the mutation.
+func withConsoleSessionID(id uuid.UUID) consolesessionOption {
+	return func(m *ConsoleSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ServiceAccount
+			value *ConsoleSession
 		)
-		m.oldValue = func(ctx context.Context) (*ServiceAccount, error) {
+		m.oldValue = func(ctx context.Context) (*ConsoleSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ServiceAccount.Get(ctx, id)
+					value, err = m.Client().ConsoleSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1701,10 +2478,10 @@ func withServiceAccountID(id uuid.UUID) serviceaccountOption {
 	}
 }
 
-// withServiceAccount sets the old ServiceAccount of the mutation.
-func withServiceAccount(node *ServiceAccount) serviceaccountOption {
-	return func(m *ServiceAccountMutation) {
-		m.oldValue = func(context.Context) (*ServiceAccount, error) {
+// withConsoleSession sets the old ConsoleSession of the mutation.
+func withConsoleSession(node *ConsoleSession) consolesessionOption {
+	return func(m *ConsoleSessionMutation) {
+		m.oldValue = func(context.Context) (*ConsoleSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1713,7 +2490,7 @@ func withServiceAccount(node *ServiceAccount) serviceaccountOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ServiceAccountMutation) Client() *Client {
+func (m ConsoleSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1721,7 +2498,7 @@ func (m ServiceAccountMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ServiceAccountMutation) Tx() (*Tx, error) {
+func (m ConsoleSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1731,14 +2508,14 @@ func (m ServiceAccountMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ServiceAccount entities.
-func (m *ServiceAccountMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConsoleSession entities.
+func (m *ConsoleSessionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ServiceAccountMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConsoleSessionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1749,7 +2526,7 @@ func (m *ServiceAccountMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ServiceAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConsoleSessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1758,295 +2535,317 @@ func (m *ServiceAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ServiceAccount.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConsoleSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *ServiceAccountMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetConsoleType sets the "console_type" field.
+func (m *ConsoleSessionMutation) SetConsoleType(s string) {
+	m.console_type = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *ServiceAccountMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// ConsoleType returns the value of the "console_type" field in the mutation.
+func (m *ConsoleSessionMutation) ConsoleType() (r string, exists bool) {
+	v := m.console_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the ServiceAccount entity.
-// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldConsoleType returns the old "console_type" field's value of the ConsoleSession entity.
+// If the ConsoleSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceAccountMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *ConsoleSessionMutation) OldConsoleType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsoleType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldConsoleType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsoleType: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.ConsoleType, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *ServiceAccountMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetConsoleType resets all changes to the "console_type" field.
+func (m *ConsoleSessionMutation) ResetConsoleType() {
+	m.console_type = nil
 }
 
-// SetAPIKey sets the "api_key" field.
-func (m *ServiceAccountMutation) SetAPIKey(u uuid.UUID) {
-	m.api_key = &u
+// SetIPAddress sets the "ip_address" field.
+func (m *ConsoleSessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
 }
 
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *ServiceAccountMutation) APIKey() (r uuid.UUID, exists bool) {
-	v := m.api_key
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *ConsoleSessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKey returns the old "api_key" field's value of the ServiceAccount entity.
-// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldIPAddress returns the old "ip_address" field's value of the ConsoleSession entity.
+// If the ConsoleSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceAccountMutation) OldAPIKey(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConsoleSessionMutation) OldIPAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
 	}
-	return oldValue.APIKey, nil
+	return oldValue.IPAddress, nil
 }
 
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *ServiceAccountMutation) ResetAPIKey() {
-	m.api_key = nil
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *ConsoleSessionMutation) ResetIPAddress() {
+	m.ip_address = nil
 }
 
-// SetAPISecret sets the "api_secret" field.
-func (m *ServiceAccountMutation) SetAPISecret(u uuid.UUID) {
-	m.api_secret = &u
+// SetStartedAt sets the "started_at" field.
+func (m *ConsoleSessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// APISecret returns the value of the "api_secret" field in the mutation.
-func (m *ServiceAccountMutation) APISecret() (r uuid.UUID, exists bool) {
-	v := m.api_secret
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ConsoleSessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPISecret returns the old "api_secret" field's value of the ServiceAccount entity.
-// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the ConsoleSession entity.
+// If the ConsoleSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceAccountMutation) OldAPISecret(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConsoleSessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPISecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPISecret requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPISecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.APISecret, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetAPISecret resets all changes to the "api_secret" field.
-func (m *ServiceAccountMutation) ResetAPISecret() {
-	m.api_secret = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ConsoleSessionMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetActive sets the "active" field.
-func (m *ServiceAccountMutation) SetActive(b bool) {
-	m.active = &b
+// SetEndedAt sets the "ended_at" field.
+func (m *ConsoleSessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *ServiceAccountMutation) Active() (r bool, exists bool) {
-	v := m.active
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *ConsoleSessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the ServiceAccount entity.
-// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldEndedAt returns the old "ended_at" field's value of the ConsoleSession entity.
+// If the ConsoleSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceAccountMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *ConsoleSessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.EndedAt, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *ServiceAccountMutation) ResetActive() {
-	m.active = nil
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *ConsoleSessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[consolesession.FieldEndedAt] = struct{}{}
 }
 
-// AddServiceAccountToTokenIDs adds the "ServiceAccountToToken" edge to the ServiceToken entity by ids.
-func (m *ServiceAccountMutation) AddServiceAccountToTokenIDs(ids ...uuid.UUID) {
-	if m._ServiceAccountToToken == nil {
-		m._ServiceAccountToToken = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m._ServiceAccountToToken[ids[i]] = struct{}{}
-	}
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *ConsoleSessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[consolesession.FieldEndedAt]
+	return ok
 }
 
-// ClearServiceAccountToToken clears the "ServiceAccountToToken" edge to the ServiceToken entity.
-func (m *ServiceAccountMutation) ClearServiceAccountToToken() {
-	m.cleared_ServiceAccountToToken = true
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *ConsoleSessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, consolesession.FieldEndedAt)
 }
 
-// ServiceAccountToTokenCleared reports if the "ServiceAccountToToken" edge to the ServiceToken entity was cleared.
-func (m *ServiceAccountMutation) ServiceAccountToTokenCleared() bool {
-	return m.cleared_ServiceAccountToToken
+// SetTranscript sets the "transcript" field.
+func (m *ConsoleSessionMutation) SetTranscript(s string) {
+	m.transcript = &s
 }
 
-// RemoveServiceAccountToTokenIDs removes the "ServiceAccountToToken" edge to the ServiceToken entity by IDs.
-func (m *ServiceAccountMutation) RemoveServiceAccountToTokenIDs(ids ...uuid.UUID) {
-	if m.removed_ServiceAccountToToken == nil {
-		m.removed_ServiceAccountToToken = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m._ServiceAccountToToken, ids[i])
-		m.removed_ServiceAccountToToken[ids[i]] = struct{}{}
+// Transcript returns the value of the "transcript" field in the mutation.
+func (m *ConsoleSessionMutation) Transcript() (r string, exists bool) {
+	v := m.transcript
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedServiceAccountToToken returns the removed IDs of the "ServiceAccountToToken" edge to the ServiceToken entity.
-func (m *ServiceAccountMutation) RemovedServiceAccountToTokenIDs() (ids []uuid.UUID) {
-	for id := range m.removed_ServiceAccountToToken {
-		ids = append(ids, id)
+// OldTranscript returns the old "transcript" field's value of the ConsoleSession entity.
+// If the ConsoleSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsoleSessionMutation) OldTranscript(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranscript is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranscript requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranscript: %w", err)
+	}
+	return oldValue.Transcript, nil
 }
 
-// ServiceAccountToTokenIDs returns the "ServiceAccountToToken" edge IDs in the mutation.
-func (m *ServiceAccountMutation) ServiceAccountToTokenIDs() (ids []uuid.UUID) {
-	for id := range m._ServiceAccountToToken {
-		ids = append(ids, id)
-	}
-	return
+// ResetTranscript resets all changes to the "transcript" field.
+func (m *ConsoleSessionMutation) ResetTranscript() {
+	m.transcript = nil
 }
 
-// ResetServiceAccountToToken resets all changes to the "ServiceAccountToToken" edge.
-func (m *ServiceAccountMutation) ResetServiceAccountToToken() {
-	m._ServiceAccountToToken = nil
-	m.cleared_ServiceAccountToToken = false
-	m.removed_ServiceAccountToToken = nil
+// SetConsoleSessionToUserID sets the "ConsoleSessionToUser" edge to the User entity by id.
+func (m *ConsoleSessionMutation) SetConsoleSessionToUserID(id uuid.UUID) {
+	m._ConsoleSessionToUser = &id
 }
 
-// AddServiceAccountToActionIDs adds the "ServiceAccountToActions" edge to the Action entity by ids.
-func (m *ServiceAccountMutation) AddServiceAccountToActionIDs(ids ...uuid.UUID) {
-	if m._ServiceAccountToActions == nil {
-		m._ServiceAccountToActions = make(map[uuid.UUID]struct{})
+// ClearConsoleSessionToUser clears the "ConsoleSessionToUser" edge to the User entity.
+func (m *ConsoleSessionMutation) ClearConsoleSessionToUser() {
+	m.cleared_ConsoleSessionToUser = true
+}
+
+// ConsoleSessionToUserCleared reports if the "ConsoleSessionToUser" edge to the User entity was cleared.
+func (m *ConsoleSessionMutation) ConsoleSessionToUserCleared() bool {
+	return m.cleared_ConsoleSessionToUser
+}
+
+// ConsoleSessionToUserID returns the "ConsoleSessionToUser" edge ID in the mutation.
+func (m *ConsoleSessionMutation) ConsoleSessionToUserID() (id uuid.UUID, exists bool) {
+	if m._ConsoleSessionToUser != nil {
+		return *m._ConsoleSessionToUser, true
 	}
-	for i := range ids {
-		m._ServiceAccountToActions[ids[i]] = struct{}{}
+	return
+}
+
+// ConsoleSessionToUserIDs returns the "ConsoleSessionToUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConsoleSessionToUserID instead. It exists only for internal usage by the builders.
+func (m *ConsoleSessionMutation) ConsoleSessionToUserIDs() (ids []uuid.UUID) {
+	if id := m._ConsoleSessionToUser; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearServiceAccountToActions clears the "ServiceAccountToActions" edge to the Action entity.
-func (m *ServiceAccountMutation) ClearServiceAccountToActions() {
-	m.cleared_ServiceAccountToActions = true
+// ResetConsoleSessionToUser resets all changes to the "ConsoleSessionToUser" edge.
+func (m *ConsoleSessionMutation) ResetConsoleSessionToUser() {
+	m._ConsoleSessionToUser = nil
+	m.cleared_ConsoleSessionToUser = false
 }
 
-// ServiceAccountToActionsCleared reports if the "ServiceAccountToActions" edge to the Action entity was cleared.
-func (m *ServiceAccountMutation) ServiceAccountToActionsCleared() bool {
-	return m.cleared_ServiceAccountToActions
+// SetConsoleSessionToVmObjectID sets the "ConsoleSessionToVmObject" edge to the VmObject entity by id.
+func (m *ConsoleSessionMutation) SetConsoleSessionToVmObjectID(id uuid.UUID) {
+	m._ConsoleSessionToVmObject = &id
 }
 
-// RemoveServiceAccountToActionIDs removes the "ServiceAccountToActions" edge to the Action entity by IDs.
-func (m *ServiceAccountMutation) RemoveServiceAccountToActionIDs(ids ...uuid.UUID) {
-	if m.removed_ServiceAccountToActions == nil {
-		m.removed_ServiceAccountToActions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m._ServiceAccountToActions, ids[i])
-		m.removed_ServiceAccountToActions[ids[i]] = struct{}{}
-	}
+// ClearConsoleSessionToVmObject clears the "ConsoleSessionToVmObject" edge to the VmObject entity.
+func (m *ConsoleSessionMutation) ClearConsoleSessionToVmObject() {
+	m.cleared_ConsoleSessionToVmObject = true
 }
 
-// RemovedServiceAccountToActions returns the removed IDs of the "ServiceAccountToActions" edge to the Action entity.
-func (m *ServiceAccountMutation) RemovedServiceAccountToActionsIDs() (ids []uuid.UUID) {
-	for id := range m.removed_ServiceAccountToActions {
-		ids = append(ids, id)
+// ConsoleSessionToVmObjectCleared reports if the "ConsoleSessionToVmObject" edge to the VmObject entity was cleared.
+func (m *ConsoleSessionMutation) ConsoleSessionToVmObjectCleared() bool {
+	return m.cleared_ConsoleSessionToVmObject
+}
+
+// ConsoleSessionToVmObjectID returns the "ConsoleSessionToVmObject" edge ID in the mutation.
+func (m *ConsoleSessionMutation) ConsoleSessionToVmObjectID() (id uuid.UUID, exists bool) {
+	if m._ConsoleSessionToVmObject != nil {
+		return *m._ConsoleSessionToVmObject, true
 	}
 	return
 }
 
-// ServiceAccountToActionsIDs returns the "ServiceAccountToActions" edge IDs in the mutation.
-func (m *ServiceAccountMutation) ServiceAccountToActionsIDs() (ids []uuid.UUID) {
-	for id := range m._ServiceAccountToActions {
-		ids = append(ids, id)
+// ConsoleSessionToVmObjectIDs returns the "ConsoleSessionToVmObject" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConsoleSessionToVmObjectID instead. It exists only for internal usage by the builders.
+func (m *ConsoleSessionMutation) ConsoleSessionToVmObjectIDs() (ids []uuid.UUID) {
+	if id := m._ConsoleSessionToVmObject; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetServiceAccountToActions resets all changes to the "ServiceAccountToActions" edge.
-func (m *ServiceAccountMutation) ResetServiceAccountToActions() {
-	m._ServiceAccountToActions = nil
-	m.cleared_ServiceAccountToActions = false
-	m.removed_ServiceAccountToActions = nil
+// ResetConsoleSessionToVmObject resets all changes to the "ConsoleSessionToVmObject" edge.
+func (m *ConsoleSessionMutation) ResetConsoleSessionToVmObject() {
+	m._ConsoleSessionToVmObject = nil
+	m.cleared_ConsoleSessionToVmObject = false
 }
 
-// Where appends a list predicates to the ServiceAccountMutation builder.
-func (m *ServiceAccountMutation) Where(ps ...predicate.ServiceAccount) {
+// Where appends a list predicates to the ConsoleSessionMutation builder.
+func (m *ConsoleSessionMutation) Where(ps ...predicate.ConsoleSession) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ServiceAccountMutation) Op() Op {
+func (m *ConsoleSessionMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (ServiceAccount).
-func (m *ServiceAccountMutation) Type() string {
+// Type returns the node type of this mutation (ConsoleSession).
+func (m *ConsoleSessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ServiceAccountMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.display_name != nil {
-		fields = append(fields, serviceaccount.FieldDisplayName)
+func (m *ConsoleSessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.console_type != nil {
+		fields = append(fields, consolesession.FieldConsoleType)
 	}
-	if m.api_key != nil {
-		fields = append(fields, serviceaccount.FieldAPIKey)
+	if m.ip_address != nil {
+		fields = append(fields, consolesession.FieldIPAddress)
 	}
-	if m.api_secret != nil {
-		fields = append(fields, serviceaccount.FieldAPISecret)
+	if m.started_at != nil {
+		fields = append(fields, consolesession.FieldStartedAt)
 	}
-	if m.active != nil {
-		fields = append(fields, serviceaccount.FieldActive)
+	if m.ended_at != nil {
+		fields = append(fields, consolesession.FieldEndedAt)
+	}
+	if m.transcript != nil {
+		fields = append(fields, consolesession.FieldTranscript)
 	}
 	return fields
 }
@@ -2054,16 +2853,18 @@ func (m *ServiceAccountMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ServiceAccountMutation) Field(name string) (ent.Value, bool) {
+func (m *ConsoleSessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case serviceaccount.FieldDisplayName:
-		return m.DisplayName()
-	case serviceaccount.FieldAPIKey:
-		return m.APIKey()
-	case serviceaccount.FieldAPISecret:
-		return m.APISecret()
-	case serviceaccount.FieldActive:
-		return m.Active()
+	case consolesession.FieldConsoleType:
+		return m.ConsoleType()
+	case consolesession.FieldIPAddress:
+		return m.IPAddress()
+	case consolesession.FieldStartedAt:
+		return m.StartedAt()
+	case consolesession.FieldEndedAt:
+		return m.EndedAt()
+	case consolesession.FieldTranscript:
+		return m.Transcript()
 	}
 	return nil, false
 }
@@ -2071,257 +2872,266 @@ func (m *ServiceAccountMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ServiceAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConsoleSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case serviceaccount.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case serviceaccount.FieldAPIKey:
-		return m.OldAPIKey(ctx)
-	case serviceaccount.FieldAPISecret:
-		return m.OldAPISecret(ctx)
-	case serviceaccount.FieldActive:
-		return m.OldActive(ctx)
+	case consolesession.FieldConsoleType:
+		return m.OldConsoleType(ctx)
+	case consolesession.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case consolesession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case consolesession.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case consolesession.FieldTranscript:
+		return m.OldTranscript(ctx)
 	}
-	return nil, fmt.Errorf("unknown ServiceAccount field %s", name)
+	return nil, fmt.Errorf("unknown ConsoleSession field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceAccountMutation) SetField(name string, value ent.Value) error {
+func (m *ConsoleSessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case serviceaccount.FieldDisplayName:
+	case consolesession.FieldConsoleType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetConsoleType(v)
 		return nil
-	case serviceaccount.FieldAPIKey:
-		v, ok := value.(uuid.UUID)
+	case consolesession.FieldIPAddress:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKey(v)
+		m.SetIPAddress(v)
 		return nil
-	case serviceaccount.FieldAPISecret:
-		v, ok := value.(uuid.UUID)
+	case consolesession.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPISecret(v)
+		m.SetStartedAt(v)
 		return nil
-	case serviceaccount.FieldActive:
-		v, ok := value.(bool)
+	case consolesession.FieldEndedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetEndedAt(v)
+		return nil
+	case consolesession.FieldTranscript:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranscript(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceAccount field %s", name)
+	return fmt.Errorf("unknown ConsoleSession field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ServiceAccountMutation) AddedFields() []string {
+func (m *ConsoleSessionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ServiceAccountMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConsoleSessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceAccountMutation) AddField(name string, value ent.Value) error {
+func (m *ConsoleSessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ServiceAccount numeric field %s", name)
+	return fmt.Errorf("unknown ConsoleSession numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ServiceAccountMutation) ClearedFields() []string {
-	return nil
+func (m *ConsoleSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(consolesession.FieldEndedAt) {
+		fields = append(fields, consolesession.FieldEndedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ServiceAccountMutation) FieldCleared(name string) bool {
+func (m *ConsoleSessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ServiceAccountMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ServiceAccount nullable field %s", name)
+func (m *ConsoleSessionMutation) ClearField(name string) error {
+	switch name {
+	case consolesession.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConsoleSession nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ServiceAccountMutation) ResetField(name string) error {
+func (m *ConsoleSessionMutation) ResetField(name string) error {
 	switch name {
-	case serviceaccount.FieldDisplayName:
-		m.ResetDisplayName()
+	case consolesession.FieldConsoleType:
+		m.ResetConsoleType()
 		return nil
-	case serviceaccount.FieldAPIKey:
-		m.ResetAPIKey()
+	case consolesession.FieldIPAddress:
+		m.ResetIPAddress()
 		return nil
-	case serviceaccount.FieldAPISecret:
-		m.ResetAPISecret()
+	case consolesession.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case serviceaccount.FieldActive:
-		m.ResetActive()
+	case consolesession.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case consolesession.FieldTranscript:
+		m.ResetTranscript()
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceAccount field %s", name)
+	return fmt.Errorf("unknown ConsoleSession field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ServiceAccountMutation) AddedEdges() []string {
+func (m *ConsoleSessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m._ServiceAccountToToken != nil {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
+	if m._ConsoleSessionToUser != nil {
+		edges = append(edges, consolesession.EdgeConsoleSessionToUser)
 	}
-	if m._ServiceAccountToActions != nil {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
+	if m._ConsoleSessionToVmObject != nil {
+		edges = append(edges, consolesession.EdgeConsoleSessionToVmObject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ServiceAccountMutation) AddedIDs(name string) []ent.Value {
+func (m *ConsoleSessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case serviceaccount.EdgeServiceAccountToToken:
-		ids := make([]ent.Value, 0, len(m._ServiceAccountToToken))
-		for id := range m._ServiceAccountToToken {
-			ids = append(ids, id)
+	case consolesession.EdgeConsoleSessionToUser:
+		if id := m._ConsoleSessionToUser; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case serviceaccount.EdgeServiceAccountToActions:
-		ids := make([]ent.Value, 0, len(m._ServiceAccountToActions))
-		for id := range m._ServiceAccountToActions {
-			ids = append(ids, id)
+	case consolesession.EdgeConsoleSessionToVmObject:
+		if id := m._ConsoleSessionToVmObject; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ServiceAccountMutation) RemovedEdges() []string {
+func (m *ConsoleSessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removed_ServiceAccountToToken != nil {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
-	}
-	if m.removed_ServiceAccountToActions != nil {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ServiceAccountMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConsoleSessionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case serviceaccount.EdgeServiceAccountToToken:
-		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToToken))
-		for id := range m.removed_ServiceAccountToToken {
-			ids = append(ids, id)
-		}
-		return ids
-	case serviceaccount.EdgeServiceAccountToActions:
-		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToActions))
-		for id := range m.removed_ServiceAccountToActions {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ServiceAccountMutation) ClearedEdges() []string {
+func (m *ConsoleSessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleared_ServiceAccountToToken {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
+	if m.cleared_ConsoleSessionToUser {
+		edges = append(edges, consolesession.EdgeConsoleSessionToUser)
 	}
-	if m.cleared_ServiceAccountToActions {
-		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
+	if m.cleared_ConsoleSessionToVmObject {
+		edges = append(edges, consolesession.EdgeConsoleSessionToVmObject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ServiceAccountMutation) EdgeCleared(name string) bool {
+func (m *ConsoleSessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case serviceaccount.EdgeServiceAccountToToken:
-		return m.cleared_ServiceAccountToToken
-	case serviceaccount.EdgeServiceAccountToActions:
-		return m.cleared_ServiceAccountToActions
+	case consolesession.EdgeConsoleSessionToUser:
+		return m.cleared_ConsoleSessionToUser
+	case consolesession.EdgeConsoleSessionToVmObject:
+		return m.cleared_ConsoleSessionToVmObject
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ServiceAccountMutation) ClearEdge(name string) error {
+func (m *ConsoleSessionMutation) ClearEdge(name string) error {
 	switch name {
+	case consolesession.EdgeConsoleSessionToUser:
+		m.ClearConsoleSessionToUser()
+		return nil
+	case consolesession.EdgeConsoleSessionToVmObject:
+		m.ClearConsoleSessionToVmObject()
+		return nil
 	}
-	return fmt.Errorf("unknown ServiceAccount unique edge %s", name)
+	return fmt.Errorf("unknown ConsoleSession unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ServiceAccountMutation) ResetEdge(name string) error {
+func (m *ConsoleSessionMutation) ResetEdge(name string) error {
 	switch name {
-	case serviceaccount.EdgeServiceAccountToToken:
-		m.ResetServiceAccountToToken()
+	case consolesession.EdgeConsoleSessionToUser:
+		m.ResetConsoleSessionToUser()
 		return nil
-	case serviceaccount.EdgeServiceAccountToActions:
-		m.ResetServiceAccountToActions()
+	case consolesession.EdgeConsoleSessionToVmObject:
+		m.ResetConsoleSessionToVmObject()
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceAccount edge %s", name)
+	return fmt.Errorf("unknown ConsoleSession edge %s", name)
 }
 
-// ServiceTokenMutation represents an operation that mutates the ServiceToken nodes in the graph.
-type ServiceTokenMutation struct {
+// ConsoleShareMutation represents an operation that mutates the ConsoleShare nodes in the graph.
+type ConsoleShareMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	token                         *string
-	refresh_token                 *string
-	issued_at                     *int64
-	addissued_at                  *int64
-	clearedFields                 map[string]struct{}
-	_TokenToServiceAccount        *uuid.UUID
-	cleared_TokenToServiceAccount bool
-	done                          bool
-	oldValue                      func(context.Context) (*ServiceToken, error)
-	predicates                    []predicate.ServiceToken
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	token_hash                     *string
+	console_type                   *string
+	password                       *string
+	created_at                     *time.Time
+	expires_at                     *time.Time
+	revoked                        *bool
+	clearedFields                  map[string]struct{}
+	_ConsoleShareToVmObject        *uuid.UUID
+	cleared_ConsoleShareToVmObject bool
+	_ConsoleShareToUser            *uuid.UUID
+	cleared_ConsoleShareToUser     bool
+	done                           bool
+	oldValue                       func(context.Context) (*ConsoleShare, error)
+	predicates                     []predicate.ConsoleShare
 }
 
-var _ ent.Mutation = (*ServiceTokenMutation)(nil)
+var _ ent.Mutation = (*ConsoleShareMutation)(nil)
 
-// servicetokenOption allows management of the mutation configuration using functional options.
-type servicetokenOption func(*ServiceTokenMutation)
+// consoleshareOption allows management of the mutation configuration using functional options.
+type consoleshareOption func(*ConsoleShareMutation)
 
-// newServiceTokenMutation creates new mutation for the ServiceToken entity.
-func newServiceTokenMutation(c config, op Op, opts ...servicetokenOption) *ServiceTokenMutation {
-	m := &ServiceTokenMutation{
+// newConsoleShareMutation creates new mutation for the ConsoleShare entity.
+func newConsoleShareMutation(c config, op Op, opts ...consoleshareOption) *ConsoleShareMutation {
+	m := &ConsoleShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeServiceToken,
+		typ:           TypeConsoleShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2330,20 +3140,20 @@ func newServiceTokenMutation(c config, op Op, opts ...servicetokenOption) *Servi
 	return m
 }
 
-// withServiceTokenID sets the ID field of the mutation.
-func withServiceTokenID(id uuid.UUID) servicetokenOption {
-	return func(m *ServiceTokenMutation) {
+// withConsoleShareID sets the ID field of the mutation.
+func withConsoleShareID(id uuid.UUID) consoleshareOption {
+	return func(m *ConsoleShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ServiceToken
+			value *ConsoleShare
 		)
-		m.oldValue = func(ctx context.Context) (*ServiceToken, error) {
+		m.oldValue = func(ctx context.Context) (*ConsoleShare, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ServiceToken.Get(ctx, id)
+					value, err = m.Client().ConsoleShare.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2352,10 +3162,10 @@ func withServiceTokenID(id uuid.UUID) servicetokenOption {
 	}
 }
 
-// withServiceToken sets the old ServiceToken of the mutation.
-func withServiceToken(node *ServiceToken) servicetokenOption {
-	return func(m *ServiceTokenMutation) {
-		m.oldValue = func(context.Context) (*ServiceToken, error) {
+// withConsoleShare sets the old ConsoleShare of the mutation.
+func withConsoleShare(node *ConsoleShare) consoleshareOption {
+	return func(m *ConsoleShareMutation) {
+		m.oldValue = func(context.Context) (*ConsoleShare, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2364,7 +3174,7 @@ func withServiceToken(node *ServiceToken) servicetokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ServiceTokenMutation) Client() *Client {
+func (m ConsoleShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2372,7 +3182,7 @@ func (m ServiceTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ServiceTokenMutation) Tx() (*Tx, error) {
+func (m ConsoleShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2382,14 +3192,14 @@ func (m ServiceTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ServiceToken entities.
-func (m *ServiceTokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConsoleShare entities.
+func (m *ConsoleShareMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ServiceTokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConsoleShareMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2400,7 +3210,7 @@ func (m *ServiceTokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ServiceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConsoleShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2409,207 +3219,343 @@ func (m *ServiceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ServiceToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConsoleShare.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *ServiceTokenMutation) SetToken(s string) {
-	m.token = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *ConsoleShareMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *ServiceTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *ConsoleShareMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the ServiceToken entity.
-// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *ConsoleShareMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *ServiceTokenMutation) ResetToken() {
-	m.token = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *ConsoleShareMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetRefreshToken sets the "refresh_token" field.
-func (m *ServiceTokenMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
+// SetConsoleType sets the "console_type" field.
+func (m *ConsoleShareMutation) SetConsoleType(s string) {
+	m.console_type = &s
 }
 
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *ServiceTokenMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
+// ConsoleType returns the value of the "console_type" field in the mutation.
+func (m *ConsoleShareMutation) ConsoleType() (r string, exists bool) {
+	v := m.console_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefreshToken returns the old "refresh_token" field's value of the ServiceToken entity.
-// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldConsoleType returns the old "console_type" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceTokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
+func (m *ConsoleShareMutation) OldConsoleType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsoleType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+		return v, errors.New("OldConsoleType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsoleType: %w", err)
 	}
-	return oldValue.RefreshToken, nil
+	return oldValue.ConsoleType, nil
 }
 
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *ServiceTokenMutation) ResetRefreshToken() {
-	m.refresh_token = nil
+// ResetConsoleType resets all changes to the "console_type" field.
+func (m *ConsoleShareMutation) ResetConsoleType() {
+	m.console_type = nil
 }
 
-// SetIssuedAt sets the "issued_at" field.
-func (m *ServiceTokenMutation) SetIssuedAt(i int64) {
-	m.issued_at = &i
-	m.addissued_at = nil
+// SetPassword sets the "password" field.
+func (m *ConsoleShareMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// IssuedAt returns the value of the "issued_at" field in the mutation.
-func (m *ServiceTokenMutation) IssuedAt() (r int64, exists bool) {
-	v := m.issued_at
+// Password returns the value of the "password" field in the mutation.
+func (m *ConsoleShareMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIssuedAt returns the old "issued_at" field's value of the ServiceToken entity.
-// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceTokenMutation) OldIssuedAt(ctx context.Context) (v int64, err error) {
+func (m *ConsoleShareMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.IssuedAt, nil
+	return oldValue.Password, nil
 }
 
-// AddIssuedAt adds i to the "issued_at" field.
-func (m *ServiceTokenMutation) AddIssuedAt(i int64) {
-	if m.addissued_at != nil {
-		*m.addissued_at += i
-	} else {
-		m.addissued_at = &i
+// ResetPassword resets all changes to the "password" field.
+func (m *ConsoleShareMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConsoleShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConsoleShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedIssuedAt returns the value that was added to the "issued_at" field in this mutation.
-func (m *ServiceTokenMutation) AddedIssuedAt() (r int64, exists bool) {
-	v := m.addissued_at
+// OldCreatedAt returns the old "created_at" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsoleShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConsoleShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConsoleShareMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConsoleShareMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetIssuedAt resets all changes to the "issued_at" field.
-func (m *ServiceTokenMutation) ResetIssuedAt() {
-	m.issued_at = nil
-	m.addissued_at = nil
+// OldExpiresAt returns the old "expires_at" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsoleShareMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// SetTokenToServiceAccountID sets the "TokenToServiceAccount" edge to the ServiceAccount entity by id.
-func (m *ServiceTokenMutation) SetTokenToServiceAccountID(id uuid.UUID) {
-	m._TokenToServiceAccount = &id
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConsoleShareMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// ClearTokenToServiceAccount clears the "TokenToServiceAccount" edge to the ServiceAccount entity.
-func (m *ServiceTokenMutation) ClearTokenToServiceAccount() {
-	m.cleared_TokenToServiceAccount = true
+// SetRevoked sets the "revoked" field.
+func (m *ConsoleShareMutation) SetRevoked(b bool) {
+	m.revoked = &b
 }
 
-// TokenToServiceAccountCleared reports if the "TokenToServiceAccount" edge to the ServiceAccount entity was cleared.
-func (m *ServiceTokenMutation) TokenToServiceAccountCleared() bool {
-	return m.cleared_TokenToServiceAccount
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *ConsoleShareMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenToServiceAccountID returns the "TokenToServiceAccount" edge ID in the mutation.
-func (m *ServiceTokenMutation) TokenToServiceAccountID() (id uuid.UUID, exists bool) {
-	if m._TokenToServiceAccount != nil {
-		return *m._TokenToServiceAccount, true
+// OldRevoked returns the old "revoked" field's value of the ConsoleShare entity.
+// If the ConsoleShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsoleShareMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *ConsoleShareMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetConsoleShareToVmObjectID sets the "ConsoleShareToVmObject" edge to the VmObject entity by id.
+func (m *ConsoleShareMutation) SetConsoleShareToVmObjectID(id uuid.UUID) {
+	m._ConsoleShareToVmObject = &id
+}
+
+// ClearConsoleShareToVmObject clears the "ConsoleShareToVmObject" edge to the VmObject entity.
+func (m *ConsoleShareMutation) ClearConsoleShareToVmObject() {
+	m.cleared_ConsoleShareToVmObject = true
+}
+
+// ConsoleShareToVmObjectCleared reports if the "ConsoleShareToVmObject" edge to the VmObject entity was cleared.
+func (m *ConsoleShareMutation) ConsoleShareToVmObjectCleared() bool {
+	return m.cleared_ConsoleShareToVmObject
+}
+
+// ConsoleShareToVmObjectID returns the "ConsoleShareToVmObject" edge ID in the mutation.
+func (m *ConsoleShareMutation) ConsoleShareToVmObjectID() (id uuid.UUID, exists bool) {
+	if m._ConsoleShareToVmObject != nil {
+		return *m._ConsoleShareToVmObject, true
 	}
 	return
 }
 
-// TokenToServiceAccountIDs returns the "TokenToServiceAccount" edge IDs in the mutation.
+// ConsoleShareToVmObjectIDs returns the "ConsoleShareToVmObject" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenToServiceAccountID instead. It exists only for internal usage by the builders.
-func (m *ServiceTokenMutation) TokenToServiceAccountIDs() (ids []uuid.UUID) {
-	if id := m._TokenToServiceAccount; id != nil {
+// ConsoleShareToVmObjectID instead. It exists only for internal usage by the builders.
+func (m *ConsoleShareMutation) ConsoleShareToVmObjectIDs() (ids []uuid.UUID) {
+	if id := m._ConsoleShareToVmObject; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTokenToServiceAccount resets all changes to the "TokenToServiceAccount" edge.
-func (m *ServiceTokenMutation) ResetTokenToServiceAccount() {
-	m._TokenToServiceAccount = nil
-	m.cleared_TokenToServiceAccount = false
+// ResetConsoleShareToVmObject resets all changes to the "ConsoleShareToVmObject" edge.
+func (m *ConsoleShareMutation) ResetConsoleShareToVmObject() {
+	m._ConsoleShareToVmObject = nil
+	m.cleared_ConsoleShareToVmObject = false
 }
 
-// Where appends a list predicates to the ServiceTokenMutation builder.
-func (m *ServiceTokenMutation) Where(ps ...predicate.ServiceToken) {
+// SetConsoleShareToUserID sets the "ConsoleShareToUser" edge to the User entity by id.
+func (m *ConsoleShareMutation) SetConsoleShareToUserID(id uuid.UUID) {
+	m._ConsoleShareToUser = &id
+}
+
+// ClearConsoleShareToUser clears the "ConsoleShareToUser" edge to the User entity.
+func (m *ConsoleShareMutation) ClearConsoleShareToUser() {
+	m.cleared_ConsoleShareToUser = true
+}
+
+// ConsoleShareToUserCleared reports if the "ConsoleShareToUser" edge to the User entity was cleared.
+func (m *ConsoleShareMutation) ConsoleShareToUserCleared() bool {
+	return m.cleared_ConsoleShareToUser
+}
+
+// ConsoleShareToUserID returns the "ConsoleShareToUser" edge ID in the mutation.
+func (m *ConsoleShareMutation) ConsoleShareToUserID() (id uuid.UUID, exists bool) {
+	if m._ConsoleShareToUser != nil {
+		return *m._ConsoleShareToUser, true
+	}
+	return
+}
+
+// ConsoleShareToUserIDs returns the "ConsoleShareToUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConsoleShareToUserID instead. It exists only for internal usage by the builders.
+func (m *ConsoleShareMutation) ConsoleShareToUserIDs() (ids []uuid.UUID) {
+	if id := m._ConsoleShareToUser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConsoleShareToUser resets all changes to the "ConsoleShareToUser" edge.
+func (m *ConsoleShareMutation) ResetConsoleShareToUser() {
+	m._ConsoleShareToUser = nil
+	m.cleared_ConsoleShareToUser = false
+}
+
+// Where appends a list predicates to the ConsoleShareMutation builder.
+func (m *ConsoleShareMutation) Where(ps ...predicate.ConsoleShare) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ServiceTokenMutation) Op() Op {
+func (m *ConsoleShareMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (ServiceToken).
-func (m *ServiceTokenMutation) Type() string {
+// Type returns the node type of this mutation (ConsoleShare).
+func (m *ConsoleShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ServiceTokenMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.token != nil {
-		fields = append(fields, servicetoken.FieldToken)
+func (m *ConsoleShareMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.token_hash != nil {
+		fields = append(fields, consoleshare.FieldTokenHash)
 	}
-	if m.refresh_token != nil {
-		fields = append(fields, servicetoken.FieldRefreshToken)
+	if m.console_type != nil {
+		fields = append(fields, consoleshare.FieldConsoleType)
 	}
-	if m.issued_at != nil {
-		fields = append(fields, servicetoken.FieldIssuedAt)
+	if m.password != nil {
+		fields = append(fields, consoleshare.FieldPassword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, consoleshare.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, consoleshare.FieldExpiresAt)
+	}
+	if m.revoked != nil {
+		fields = append(fields, consoleshare.FieldRevoked)
 	}
 	return fields
 }
@@ -2617,14 +3563,20 @@ func (m *ServiceTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ServiceTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *ConsoleShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case servicetoken.FieldToken:
-		return m.Token()
-	case servicetoken.FieldRefreshToken:
-		return m.RefreshToken()
-	case servicetoken.FieldIssuedAt:
-		return m.IssuedAt()
+	case consoleshare.FieldTokenHash:
+		return m.TokenHash()
+	case consoleshare.FieldConsoleType:
+		return m.ConsoleType()
+	case consoleshare.FieldPassword:
+		return m.Password()
+	case consoleshare.FieldCreatedAt:
+		return m.CreatedAt()
+	case consoleshare.FieldExpiresAt:
+		return m.ExpiresAt()
+	case consoleshare.FieldRevoked:
+		return m.Revoked()
 	}
 	return nil, false
 }
@@ -2632,136 +3584,164 @@ func (m *ServiceTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ServiceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConsoleShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case servicetoken.FieldToken:
-		return m.OldToken(ctx)
-	case servicetoken.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	case servicetoken.FieldIssuedAt:
-		return m.OldIssuedAt(ctx)
+	case consoleshare.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case consoleshare.FieldConsoleType:
+		return m.OldConsoleType(ctx)
+	case consoleshare.FieldPassword:
+		return m.OldPassword(ctx)
+	case consoleshare.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case consoleshare.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case consoleshare.FieldRevoked:
+		return m.OldRevoked(ctx)
 	}
-	return nil, fmt.Errorf("unknown ServiceToken field %s", name)
+	return nil, fmt.Errorf("unknown ConsoleShare field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceTokenMutation) SetField(name string, value ent.Value) error {
+func (m *ConsoleShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case servicetoken.FieldToken:
+	case consoleshare.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetTokenHash(v)
 		return nil
-	case servicetoken.FieldRefreshToken:
+	case consoleshare.FieldConsoleType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshToken(v)
+		m.SetConsoleType(v)
 		return nil
-	case servicetoken.FieldIssuedAt:
-		v, ok := value.(int64)
+	case consoleshare.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIssuedAt(v)
+		m.SetPassword(v)
+		return nil
+	case consoleshare.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case consoleshare.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case consoleshare.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceToken field %s", name)
+	return fmt.Errorf("unknown ConsoleShare field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ServiceTokenMutation) AddedFields() []string {
-	var fields []string
-	if m.addissued_at != nil {
-		fields = append(fields, servicetoken.FieldIssuedAt)
-	}
-	return fields
+func (m *ConsoleShareMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ServiceTokenMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case servicetoken.FieldIssuedAt:
-		return m.AddedIssuedAt()
-	}
+func (m *ConsoleShareMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceTokenMutation) AddField(name string, value ent.Value) error {
+func (m *ConsoleShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case servicetoken.FieldIssuedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIssuedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ServiceToken numeric field %s", name)
+	return fmt.Errorf("unknown ConsoleShare numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ServiceTokenMutation) ClearedFields() []string {
+func (m *ConsoleShareMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ServiceTokenMutation) FieldCleared(name string) bool {
+func (m *ConsoleShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ServiceTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ServiceToken nullable field %s", name)
+func (m *ConsoleShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConsoleShare nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ServiceTokenMutation) ResetField(name string) error {
+func (m *ConsoleShareMutation) ResetField(name string) error {
 	switch name {
-	case servicetoken.FieldToken:
-		m.ResetToken()
+	case consoleshare.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case servicetoken.FieldRefreshToken:
-		m.ResetRefreshToken()
+	case consoleshare.FieldConsoleType:
+		m.ResetConsoleType()
 		return nil
-	case servicetoken.FieldIssuedAt:
-		m.ResetIssuedAt()
+	case consoleshare.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case consoleshare.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case consoleshare.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case consoleshare.FieldRevoked:
+		m.ResetRevoked()
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceToken field %s", name)
+	return fmt.Errorf("unknown ConsoleShare field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ServiceTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m._TokenToServiceAccount != nil {
-		edges = append(edges, servicetoken.EdgeTokenToServiceAccount)
+func (m *ConsoleShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._ConsoleShareToVmObject != nil {
+		edges = append(edges, consoleshare.EdgeConsoleShareToVmObject)
+	}
+	if m._ConsoleShareToUser != nil {
+		edges = append(edges, consoleshare.EdgeConsoleShareToUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ServiceTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *ConsoleShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case servicetoken.EdgeTokenToServiceAccount:
-		if id := m._TokenToServiceAccount; id != nil {
+	case consoleshare.EdgeConsoleShareToVmObject:
+		if id := m._ConsoleShareToVmObject; id != nil {
+			return []ent.Value{*id}
+		}
+	case consoleshare.EdgeConsoleShareToUser:
+		if id := m._ConsoleShareToUser; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2769,94 +3749,100 @@ func (m *ServiceTokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ServiceTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ConsoleShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ServiceTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConsoleShareMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ServiceTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleared_TokenToServiceAccount {
-		edges = append(edges, servicetoken.EdgeTokenToServiceAccount)
+func (m *ConsoleShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_ConsoleShareToVmObject {
+		edges = append(edges, consoleshare.EdgeConsoleShareToVmObject)
+	}
+	if m.cleared_ConsoleShareToUser {
+		edges = append(edges, consoleshare.EdgeConsoleShareToUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ServiceTokenMutation) EdgeCleared(name string) bool {
+func (m *ConsoleShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case servicetoken.EdgeTokenToServiceAccount:
-		return m.cleared_TokenToServiceAccount
+	case consoleshare.EdgeConsoleShareToVmObject:
+		return m.cleared_ConsoleShareToVmObject
+	case consoleshare.EdgeConsoleShareToUser:
+		return m.cleared_ConsoleShareToUser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ServiceTokenMutation) ClearEdge(name string) error {
+func (m *ConsoleShareMutation) ClearEdge(name string) error {
 	switch name {
-	case servicetoken.EdgeTokenToServiceAccount:
-		m.ClearTokenToServiceAccount()
+	case consoleshare.EdgeConsoleShareToVmObject:
+		m.ClearConsoleShareToVmObject()
+		return nil
+	case consoleshare.EdgeConsoleShareToUser:
+		m.ClearConsoleShareToUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceToken unique edge %s", name)
+	return fmt.Errorf("unknown ConsoleShare unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ServiceTokenMutation) ResetEdge(name string) error {
+func (m *ConsoleShareMutation) ResetEdge(name string) error {
 	switch name {
-	case servicetoken.EdgeTokenToServiceAccount:
-		m.ResetTokenToServiceAccount()
+	case consoleshare.EdgeConsoleShareToVmObject:
+		m.ResetConsoleShareToVmObject()
+		return nil
+	case consoleshare.EdgeConsoleShareToUser:
+		m.ResetConsoleShareToUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ServiceToken edge %s", name)
+	return fmt.Errorf("unknown ConsoleShare edge %s", name)
 }
 
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
+// CustomRoleMutation represents an operation that mutates the CustomRole nodes in the graph.
+type CustomRoleMutation struct {
 	config
 	op                        Op
 	typ                       string
 	id                        *uuid.UUID
-	team_number               *int
-	addteam_number            *int
 	name                      *string
+	description               *string
+	permissions               *[]string
 	clearedFields             map[string]struct{}
-	_TeamToCompetition        *uuid.UUID
-	cleared_TeamToCompetition bool
-	_TeamToVmObjects          map[uuid.UUID]struct{}
-	removed_TeamToVmObjects   map[uuid.UUID]struct{}
-	cleared_TeamToVmObjects   bool
-	_TeamToUsers              map[uuid.UUID]struct{}
-	removed_TeamToUsers       map[uuid.UUID]struct{}
-	cleared_TeamToUsers       bool
+	_CustomRoleToUsers        map[uuid.UUID]struct{}
+	removed_CustomRoleToUsers map[uuid.UUID]struct{}
+	cleared_CustomRoleToUsers bool
 	done                      bool
-	oldValue                  func(context.Context) (*Team, error)
-	predicates                []predicate.Team
+	oldValue                  func(context.Context) (*CustomRole, error)
+	predicates                []predicate.CustomRole
 }
 
-var _ ent.Mutation = (*TeamMutation)(nil)
+var _ ent.Mutation = (*CustomRoleMutation)(nil)
 
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
+// customroleOption allows management of the mutation configuration using functional options.
+type customroleOption func(*CustomRoleMutation)
 
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+// newCustomRoleMutation creates new mutation for the CustomRole entity.
+func newCustomRoleMutation(c config, op Op, opts ...customroleOption) *CustomRoleMutation {
+	m := &CustomRoleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeCustomRole,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2865,20 +3851,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id uuid.UUID) teamOption {
-	return func(m *TeamMutation) {
+// withCustomRoleID sets the ID field of the mutation.
+func withCustomRoleID(id uuid.UUID) customroleOption {
+	return func(m *CustomRoleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *CustomRole
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*CustomRole, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().CustomRole.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2887,10 +3873,10 @@ func withTeamID(id uuid.UUID) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withCustomRole sets the old CustomRole of the mutation.
+func withCustomRole(node *CustomRole) customroleOption {
+	return func(m *CustomRoleMutation) {
+		m.oldValue = func(context.Context) (*CustomRole, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2899,7 +3885,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m CustomRoleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2907,7 +3893,7 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m CustomRoleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2917,14 +3903,14 @@ func (m TeamMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Team entities.
-func (m *TeamMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CustomRole entities.
+func (m *CustomRoleMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CustomRoleMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2935,7 +3921,7 @@ func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CustomRoleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2944,302 +3930,230 @@ func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CustomRole.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTeamNumber sets the "team_number" field.
-func (m *TeamMutation) SetTeamNumber(i int) {
-	m.team_number = &i
-	m.addteam_number = nil
+// SetName sets the "name" field.
+func (m *CustomRoleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// TeamNumber returns the value of the "team_number" field in the mutation.
-func (m *TeamMutation) TeamNumber() (r int, exists bool) {
-	v := m.team_number
+// Name returns the value of the "name" field in the mutation.
+func (m *CustomRoleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamNumber returns the old "team_number" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the CustomRole entity.
+// If the CustomRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldTeamNumber(ctx context.Context) (v int, err error) {
+func (m *CustomRoleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamNumber requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.TeamNumber, nil
+	return oldValue.Name, nil
 }
 
-// AddTeamNumber adds i to the "team_number" field.
-func (m *TeamMutation) AddTeamNumber(i int) {
-	if m.addteam_number != nil {
-		*m.addteam_number += i
-	} else {
-		m.addteam_number = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *CustomRoleMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedTeamNumber returns the value that was added to the "team_number" field in this mutation.
-func (m *TeamMutation) AddedTeamNumber() (r int, exists bool) {
-	v := m.addteam_number
+// SetDescription sets the "description" field.
+func (m *CustomRoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CustomRoleMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTeamNumber resets all changes to the "team_number" field.
-func (m *TeamMutation) ResetTeamNumber() {
-	m.team_number = nil
-	m.addteam_number = nil
+// OldDescription returns the old "description" field's value of the CustomRole entity.
+// If the CustomRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomRoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// ResetDescription resets all changes to the "description" field.
+func (m *CustomRoleMutation) ResetDescription() {
+	m.description = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetPermissions sets the "permissions" field.
+func (m *CustomRoleMutation) SetPermissions(s []string) {
+	m.permissions = &s
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *CustomRoleMutation) Permissions() (r []string, exists bool) {
+	v := m.permissions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldPermissions returns the old "permissions" field's value of the CustomRole entity.
+// If the CustomRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CustomRoleMutation) OldPermissions(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Permissions, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *TeamMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[team.FieldName] = struct{}{}
+// ClearPermissions clears the value of the "permissions" field.
+func (m *CustomRoleMutation) ClearPermissions() {
+	m.permissions = nil
+	m.clearedFields[customrole.FieldPermissions] = struct{}{}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *TeamMutation) NameCleared() bool {
-	_, ok := m.clearedFields[team.FieldName]
+// PermissionsCleared returns if the "permissions" field was cleared in this mutation.
+func (m *CustomRoleMutation) PermissionsCleared() bool {
+	_, ok := m.clearedFields[customrole.FieldPermissions]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, team.FieldName)
-}
-
-// SetTeamToCompetitionID sets the "TeamToCompetition" edge to the Competition entity by id.
-func (m *TeamMutation) SetTeamToCompetitionID(id uuid.UUID) {
-	m._TeamToCompetition = &id
-}
-
-// ClearTeamToCompetition clears the "TeamToCompetition" edge to the Competition entity.
-func (m *TeamMutation) ClearTeamToCompetition() {
-	m.cleared_TeamToCompetition = true
-}
-
-// TeamToCompetitionCleared reports if the "TeamToCompetition" edge to the Competition entity was cleared.
-func (m *TeamMutation) TeamToCompetitionCleared() bool {
-	return m.cleared_TeamToCompetition
-}
-
-// TeamToCompetitionID returns the "TeamToCompetition" edge ID in the mutation.
-func (m *TeamMutation) TeamToCompetitionID() (id uuid.UUID, exists bool) {
-	if m._TeamToCompetition != nil {
-		return *m._TeamToCompetition, true
-	}
-	return
-}
-
-// TeamToCompetitionIDs returns the "TeamToCompetition" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamToCompetitionID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TeamToCompetitionIDs() (ids []uuid.UUID) {
-	if id := m._TeamToCompetition; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTeamToCompetition resets all changes to the "TeamToCompetition" edge.
-func (m *TeamMutation) ResetTeamToCompetition() {
-	m._TeamToCompetition = nil
-	m.cleared_TeamToCompetition = false
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *CustomRoleMutation) ResetPermissions() {
+	m.permissions = nil
+	delete(m.clearedFields, customrole.FieldPermissions)
 }
 
-// AddTeamToVmObjectIDs adds the "TeamToVmObjects" edge to the VmObject entity by ids.
-func (m *TeamMutation) AddTeamToVmObjectIDs(ids ...uuid.UUID) {
-	if m._TeamToVmObjects == nil {
-		m._TeamToVmObjects = make(map[uuid.UUID]struct{})
+// AddCustomRoleToUserIDs adds the "CustomRoleToUsers" edge to the User entity by ids.
+func (m *CustomRoleMutation) AddCustomRoleToUserIDs(ids ...uuid.UUID) {
+	if m._CustomRoleToUsers == nil {
+		m._CustomRoleToUsers = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m._TeamToVmObjects[ids[i]] = struct{}{}
+		m._CustomRoleToUsers[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTeamToVmObjects clears the "TeamToVmObjects" edge to the VmObject entity.
-func (m *TeamMutation) ClearTeamToVmObjects() {
-	m.cleared_TeamToVmObjects = true
+// ClearCustomRoleToUsers clears the "CustomRoleToUsers" edge to the User entity.
+func (m *CustomRoleMutation) ClearCustomRoleToUsers() {
+	m.cleared_CustomRoleToUsers = true
 }
 
-// TeamToVmObjectsCleared reports if the "TeamToVmObjects" edge to the VmObject entity was cleared.
-func (m *TeamMutation) TeamToVmObjectsCleared() bool {
-	return m.cleared_TeamToVmObjects
+// CustomRoleToUsersCleared reports if the "CustomRoleToUsers" edge to the User entity was cleared.
+func (m *CustomRoleMutation) CustomRoleToUsersCleared() bool {
+	return m.cleared_CustomRoleToUsers
 }
 
-// RemoveTeamToVmObjectIDs removes the "TeamToVmObjects" edge to the VmObject entity by IDs.
-func (m *TeamMutation) RemoveTeamToVmObjectIDs(ids ...uuid.UUID) {
-	if m.removed_TeamToVmObjects == nil {
-		m.removed_TeamToVmObjects = make(map[uuid.UUID]struct{})
+// RemoveCustomRoleToUserIDs removes the "CustomRoleToUsers" edge to the User entity by IDs.
+func (m *CustomRoleMutation) RemoveCustomRoleToUserIDs(ids ...uuid.UUID) {
+	if m.removed_CustomRoleToUsers == nil {
+		m.removed_CustomRoleToUsers = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m._TeamToVmObjects, ids[i])
-		m.removed_TeamToVmObjects[ids[i]] = struct{}{}
+		delete(m._CustomRoleToUsers, ids[i])
+		m.removed_CustomRoleToUsers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTeamToVmObjects returns the removed IDs of the "TeamToVmObjects" edge to the VmObject entity.
-func (m *TeamMutation) RemovedTeamToVmObjectsIDs() (ids []uuid.UUID) {
-	for id := range m.removed_TeamToVmObjects {
+// RemovedCustomRoleToUsers returns the removed IDs of the "CustomRoleToUsers" edge to the User entity.
+func (m *CustomRoleMutation) RemovedCustomRoleToUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removed_CustomRoleToUsers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TeamToVmObjectsIDs returns the "TeamToVmObjects" edge IDs in the mutation.
-func (m *TeamMutation) TeamToVmObjectsIDs() (ids []uuid.UUID) {
-	for id := range m._TeamToVmObjects {
+// CustomRoleToUsersIDs returns the "CustomRoleToUsers" edge IDs in the mutation.
+func (m *CustomRoleMutation) CustomRoleToUsersIDs() (ids []uuid.UUID) {
+	for id := range m._CustomRoleToUsers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTeamToVmObjects resets all changes to the "TeamToVmObjects" edge.
-func (m *TeamMutation) ResetTeamToVmObjects() {
-	m._TeamToVmObjects = nil
-	m.cleared_TeamToVmObjects = false
-	m.removed_TeamToVmObjects = nil
+// ResetCustomRoleToUsers resets all changes to the "CustomRoleToUsers" edge.
+func (m *CustomRoleMutation) ResetCustomRoleToUsers() {
+	m._CustomRoleToUsers = nil
+	m.cleared_CustomRoleToUsers = false
+	m.removed_CustomRoleToUsers = nil
 }
 
-// AddTeamToUserIDs adds the "TeamToUsers" edge to the User entity by ids.
-func (m *TeamMutation) AddTeamToUserIDs(ids ...uuid.UUID) {
-	if m._TeamToUsers == nil {
-		m._TeamToUsers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m._TeamToUsers[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the CustomRoleMutation builder.
+func (m *CustomRoleMutation) Where(ps ...predicate.CustomRole) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearTeamToUsers clears the "TeamToUsers" edge to the User entity.
-func (m *TeamMutation) ClearTeamToUsers() {
-	m.cleared_TeamToUsers = true
+// Op returns the operation name.
+func (m *CustomRoleMutation) Op() Op {
+	return m.op
 }
 
-// TeamToUsersCleared reports if the "TeamToUsers" edge to the User entity was cleared.
-func (m *TeamMutation) TeamToUsersCleared() bool {
-	return m.cleared_TeamToUsers
+// Type returns the node type of this mutation (CustomRole).
+func (m *CustomRoleMutation) Type() string {
+	return m.typ
 }
 
-// RemoveTeamToUserIDs removes the "TeamToUsers" edge to the User entity by IDs.
-func (m *TeamMutation) RemoveTeamToUserIDs(ids ...uuid.UUID) {
-	if m.removed_TeamToUsers == nil {
-		m.removed_TeamToUsers = make(map[uuid.UUID]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CustomRoleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, customrole.FieldName)
 	}
-	for i := range ids {
-		delete(m._TeamToUsers, ids[i])
-		m.removed_TeamToUsers[ids[i]] = struct{}{}
+	if m.description != nil {
+		fields = append(fields, customrole.FieldDescription)
+	}
+	if m.permissions != nil {
+		fields = append(fields, customrole.FieldPermissions)
 	}
-}
-
-// RemovedTeamToUsers returns the removed IDs of the "TeamToUsers" edge to the User entity.
-func (m *TeamMutation) RemovedTeamToUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removed_TeamToUsers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TeamToUsersIDs returns the "TeamToUsers" edge IDs in the mutation.
-func (m *TeamMutation) TeamToUsersIDs() (ids []uuid.UUID) {
-	for id := range m._TeamToUsers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTeamToUsers resets all changes to the "TeamToUsers" edge.
-func (m *TeamMutation) ResetTeamToUsers() {
-	m._TeamToUsers = nil
-	m.cleared_TeamToUsers = false
-	m.removed_TeamToUsers = nil
-}
-
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// Op returns the operation name.
-func (m *TeamMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.team_number != nil {
-		fields = append(fields, team.FieldTeamNumber)
-	}
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
-	}
-	return fields
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+func (m *CustomRoleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case team.FieldTeamNumber:
-		return m.TeamNumber()
-	case team.FieldName:
+	case customrole.FieldName:
 		return m.Name()
+	case customrole.FieldDescription:
+		return m.Description()
+	case customrole.FieldPermissions:
+		return m.Permissions()
 	}
 	return nil, false
 }
@@ -3247,150 +4161,131 @@ func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CustomRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case team.FieldTeamNumber:
-		return m.OldTeamNumber(ctx)
-	case team.FieldName:
+	case customrole.FieldName:
 		return m.OldName(ctx)
+	case customrole.FieldDescription:
+		return m.OldDescription(ctx)
+	case customrole.FieldPermissions:
+		return m.OldPermissions(ctx)
 	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+	return nil, fmt.Errorf("unknown CustomRole field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
+func (m *CustomRoleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldTeamNumber:
-		v, ok := value.(int)
+	case customrole.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamNumber(v)
+		m.SetName(v)
 		return nil
-	case team.FieldName:
+	case customrole.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDescription(v)
+		return nil
+	case customrole.FieldPermissions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown CustomRole field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMutation) AddedFields() []string {
-	var fields []string
-	if m.addteam_number != nil {
-		fields = append(fields, team.FieldTeamNumber)
-	}
-	return fields
+func (m *CustomRoleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case team.FieldTeamNumber:
-		return m.AddedTeamNumber()
-	}
+func (m *CustomRoleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
+func (m *CustomRoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldTeamNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTeamNumber(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return fmt.Errorf("unknown CustomRole numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMutation) ClearedFields() []string {
+func (m *CustomRoleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(team.FieldName) {
-		fields = append(fields, team.FieldName)
+	if m.FieldCleared(customrole.FieldPermissions) {
+		fields = append(fields, customrole.FieldPermissions)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
+func (m *CustomRoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
+func (m *CustomRoleMutation) ClearField(name string) error {
 	switch name {
-	case team.FieldName:
-		m.ClearName()
+	case customrole.FieldPermissions:
+		m.ClearPermissions()
 		return nil
 	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+	return fmt.Errorf("unknown CustomRole nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
+func (m *CustomRoleMutation) ResetField(name string) error {
 	switch name {
-	case team.FieldTeamNumber:
-		m.ResetTeamNumber()
-		return nil
-	case team.FieldName:
+	case customrole.FieldName:
 		m.ResetName()
 		return nil
+	case customrole.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case customrole.FieldPermissions:
+		m.ResetPermissions()
+		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown CustomRole field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m._TeamToCompetition != nil {
-		edges = append(edges, team.EdgeTeamToCompetition)
-	}
-	if m._TeamToVmObjects != nil {
-		edges = append(edges, team.EdgeTeamToVmObjects)
-	}
-	if m._TeamToUsers != nil {
-		edges = append(edges, team.EdgeTeamToUsers)
+func (m *CustomRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._CustomRoleToUsers != nil {
+		edges = append(edges, customrole.EdgeCustomRoleToUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+func (m *CustomRoleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeTeamToCompetition:
-		if id := m._TeamToCompetition; id != nil {
-			return []ent.Value{*id}
-		}
-	case team.EdgeTeamToVmObjects:
-		ids := make([]ent.Value, 0, len(m._TeamToVmObjects))
-		for id := range m._TeamToVmObjects {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamToUsers:
-		ids := make([]ent.Value, 0, len(m._TeamToUsers))
-		for id := range m._TeamToUsers {
+	case customrole.EdgeCustomRoleToUsers:
+		ids := make([]ent.Value, 0, len(m._CustomRoleToUsers))
+		for id := range m._CustomRoleToUsers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3399,30 +4294,21 @@ func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removed_TeamToVmObjects != nil {
-		edges = append(edges, team.EdgeTeamToVmObjects)
-	}
-	if m.removed_TeamToUsers != nil {
-		edges = append(edges, team.EdgeTeamToUsers)
+func (m *CustomRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removed_CustomRoleToUsers != nil {
+		edges = append(edges, customrole.EdgeCustomRoleToUsers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+func (m *CustomRoleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeTeamToVmObjects:
-		ids := make([]ent.Value, 0, len(m.removed_TeamToVmObjects))
-		for id := range m.removed_TeamToVmObjects {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamToUsers:
-		ids := make([]ent.Value, 0, len(m.removed_TeamToUsers))
-		for id := range m.removed_TeamToUsers {
+	case customrole.EdgeCustomRoleToUsers:
+		ids := make([]ent.Value, 0, len(m.removed_CustomRoleToUsers))
+		for id := range m.removed_CustomRoleToUsers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3431,90 +4317,76 @@ func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleared_TeamToCompetition {
-		edges = append(edges, team.EdgeTeamToCompetition)
-	}
-	if m.cleared_TeamToVmObjects {
-		edges = append(edges, team.EdgeTeamToVmObjects)
-	}
-	if m.cleared_TeamToUsers {
-		edges = append(edges, team.EdgeTeamToUsers)
+func (m *CustomRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_CustomRoleToUsers {
+		edges = append(edges, customrole.EdgeCustomRoleToUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
+func (m *CustomRoleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case team.EdgeTeamToCompetition:
-		return m.cleared_TeamToCompetition
-	case team.EdgeTeamToVmObjects:
-		return m.cleared_TeamToVmObjects
-	case team.EdgeTeamToUsers:
-		return m.cleared_TeamToUsers
+	case customrole.EdgeCustomRoleToUsers:
+		return m.cleared_CustomRoleToUsers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
+func (m *CustomRoleMutation) ClearEdge(name string) error {
 	switch name {
-	case team.EdgeTeamToCompetition:
-		m.ClearTeamToCompetition()
-		return nil
 	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
+	return fmt.Errorf("unknown CustomRole unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
+func (m *CustomRoleMutation) ResetEdge(name string) error {
 	switch name {
-	case team.EdgeTeamToCompetition:
-		m.ResetTeamToCompetition()
-		return nil
-	case team.EdgeTeamToVmObjects:
-		m.ResetTeamToVmObjects()
-		return nil
-	case team.EdgeTeamToUsers:
-		m.ResetTeamToUsers()
+	case customrole.EdgeCustomRoleToUsers:
+		m.ResetCustomRoleToUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown Team edge %s", name)
+	return fmt.Errorf("unknown CustomRole edge %s", name)
 }
 
-// TokenMutation represents an operation that mutates the Token nodes in the graph.
-type TokenMutation struct {
+// PersonalAccessTokenMutation represents an operation that mutates the PersonalAccessToken nodes in the graph.
+type PersonalAccessTokenMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	token               *string
-	expire_at           *int64
-	addexpire_at        *int64
-	clearedFields       map[string]struct{}
-	_TokenToUser        *uuid.UUID
-	cleared_TokenToUser bool
-	done                bool
-	oldValue            func(context.Context) (*Token, error)
-	predicates          []predicate.Token
-}
-
-var _ ent.Mutation = (*TokenMutation)(nil)
-
-// tokenOption allows management of the mutation configuration using functional options.
-type tokenOption func(*TokenMutation)
-
-// newTokenMutation creates new mutation for the Token entity.
-func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
-	m := &TokenMutation{
+	op                                Op
+	typ                               string
+	id                                *uuid.UUID
+	name                              *string
+	token_hash                        *string
+	prefix                            *string
+	scope                             *personalaccesstoken.Scope
+	created_at                        *time.Time
+	expires_at                        *time.Time
+	last_used_at                      *time.Time
+	last_used_ip                      *string
+	clearedFields                     map[string]struct{}
+	_PersonalAccessTokenToUser        *uuid.UUID
+	cleared_PersonalAccessTokenToUser bool
+	done                              bool
+	oldValue                          func(context.Context) (*PersonalAccessToken, error)
+	predicates                        []predicate.PersonalAccessToken
+}
+
+var _ ent.Mutation = (*PersonalAccessTokenMutation)(nil)
+
+// personalaccesstokenOption allows management of the mutation configuration using functional options.
+type personalaccesstokenOption func(*PersonalAccessTokenMutation)
+
+// newPersonalAccessTokenMutation creates new mutation for the PersonalAccessToken entity.
+func newPersonalAccessTokenMutation(c config, op Op, opts ...personalaccesstokenOption) *PersonalAccessTokenMutation {
+	m := &PersonalAccessTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypePersonalAccessToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3523,20 +4395,20 @@ func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
 	return m
 }
 
-// withTokenID sets the ID field of the mutation.
-func withTokenID(id uuid.UUID) tokenOption {
-	return func(m *TokenMutation) {
+// withPersonalAccessTokenID sets the ID field of the mutation.
+func withPersonalAccessTokenID(id uuid.UUID) personalaccesstokenOption {
+	return func(m *PersonalAccessTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Token
+			value *PersonalAccessToken
 		)
-		m.oldValue = func(ctx context.Context) (*Token, error) {
+		m.oldValue = func(ctx context.Context) (*PersonalAccessToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Token.Get(ctx, id)
+					value, err = m.Client().PersonalAccessToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3545,10 +4417,10 @@ func withTokenID(id uuid.UUID) tokenOption {
 	}
 }
 
-// withToken sets the old Token of the mutation.
-func withToken(node *Token) tokenOption {
-	return func(m *TokenMutation) {
-		m.oldValue = func(context.Context) (*Token, error) {
+// withPersonalAccessToken sets the old PersonalAccessToken of the mutation.
+func withPersonalAccessToken(node *PersonalAccessToken) personalaccesstokenOption {
+	return func(m *PersonalAccessTokenMutation) {
+		m.oldValue = func(context.Context) (*PersonalAccessToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3557,7 +4429,7 @@ func withToken(node *Token) tokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m PersonalAccessTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3565,7 +4437,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m PersonalAccessTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3575,14 +4447,14 @@ func (m TokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Token entities.
-func (m *TokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of PersonalAccessToken entities.
+func (m *PersonalAccessTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PersonalAccessTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3593,7 +4465,7 @@ func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PersonalAccessTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3602,168 +4474,8415 @@ func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PersonalAccessToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *TokenMutation) SetToken(s string) {
-	m.token = &s
+// SetName sets the "name" field.
+func (m *PersonalAccessTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *TokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// Name returns the value of the "name" field in the mutation.
+func (m *PersonalAccessTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *PersonalAccessTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Name, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *TokenMutation) ResetToken() {
-	m.token = nil
+// ResetName resets all changes to the "name" field.
+func (m *PersonalAccessTokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetExpireAt sets the "expire_at" field.
-func (m *TokenMutation) SetExpireAt(i int64) {
-	m.expire_at = &i
-	m.addexpire_at = nil
+// SetTokenHash sets the "token_hash" field.
+func (m *PersonalAccessTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// ExpireAt returns the value of the "expire_at" field in the mutation.
-func (m *TokenMutation) ExpireAt() (r int64, exists bool) {
-	v := m.expire_at
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *PersonalAccessTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpireAt returns the old "expire_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldExpireAt(ctx context.Context) (v int64, err error) {
+func (m *PersonalAccessTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpireAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpireAt requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpireAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.ExpireAt, nil
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *PersonalAccessTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *PersonalAccessTokenMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *PersonalAccessTokenMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *PersonalAccessTokenMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *PersonalAccessTokenMutation) SetScope(pe personalaccesstoken.Scope) {
+	m.scope = &pe
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *PersonalAccessTokenMutation) Scope() (r personalaccesstoken.Scope, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldScope(ctx context.Context) (v personalaccesstoken.Scope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *PersonalAccessTokenMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PersonalAccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersonalAccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PersonalAccessTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *PersonalAccessTokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[personalaccesstoken.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PersonalAccessTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, personalaccesstoken.FieldExpiresAt)
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[personalaccesstoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, personalaccesstoken.FieldLastUsedAt)
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *PersonalAccessTokenMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
+}
+
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *PersonalAccessTokenMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedIP returns the old "last_used_ip" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
+	}
+	return oldValue.LastUsedIP, nil
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (m *PersonalAccessTokenMutation) ClearLastUsedIP() {
+	m.last_used_ip = nil
+	m.clearedFields[personalaccesstoken.FieldLastUsedIP] = struct{}{}
+}
+
+// LastUsedIPCleared returns if the "last_used_ip" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) LastUsedIPCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldLastUsedIP]
+	return ok
+}
+
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *PersonalAccessTokenMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+	delete(m.clearedFields, personalaccesstoken.FieldLastUsedIP)
+}
+
+// SetPersonalAccessTokenToUserID sets the "PersonalAccessTokenToUser" edge to the User entity by id.
+func (m *PersonalAccessTokenMutation) SetPersonalAccessTokenToUserID(id uuid.UUID) {
+	m._PersonalAccessTokenToUser = &id
+}
+
+// ClearPersonalAccessTokenToUser clears the "PersonalAccessTokenToUser" edge to the User entity.
+func (m *PersonalAccessTokenMutation) ClearPersonalAccessTokenToUser() {
+	m.cleared_PersonalAccessTokenToUser = true
+}
+
+// PersonalAccessTokenToUserCleared reports if the "PersonalAccessTokenToUser" edge to the User entity was cleared.
+func (m *PersonalAccessTokenMutation) PersonalAccessTokenToUserCleared() bool {
+	return m.cleared_PersonalAccessTokenToUser
+}
+
+// PersonalAccessTokenToUserID returns the "PersonalAccessTokenToUser" edge ID in the mutation.
+func (m *PersonalAccessTokenMutation) PersonalAccessTokenToUserID() (id uuid.UUID, exists bool) {
+	if m._PersonalAccessTokenToUser != nil {
+		return *m._PersonalAccessTokenToUser, true
+	}
+	return
+}
+
+// PersonalAccessTokenToUserIDs returns the "PersonalAccessTokenToUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PersonalAccessTokenToUserID instead. It exists only for internal usage by the builders.
+func (m *PersonalAccessTokenMutation) PersonalAccessTokenToUserIDs() (ids []uuid.UUID) {
+	if id := m._PersonalAccessTokenToUser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPersonalAccessTokenToUser resets all changes to the "PersonalAccessTokenToUser" edge.
+func (m *PersonalAccessTokenMutation) ResetPersonalAccessTokenToUser() {
+	m._PersonalAccessTokenToUser = nil
+	m.cleared_PersonalAccessTokenToUser = false
+}
+
+// Where appends a list predicates to the PersonalAccessTokenMutation builder.
+func (m *PersonalAccessTokenMutation) Where(ps ...predicate.PersonalAccessToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PersonalAccessTokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PersonalAccessToken).
+func (m *PersonalAccessTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersonalAccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, personalaccesstoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, personalaccesstoken.FieldTokenHash)
+	}
+	if m.prefix != nil {
+		fields = append(fields, personalaccesstoken.FieldPrefix)
+	}
+	if m.scope != nil {
+		fields = append(fields, personalaccesstoken.FieldScope)
+	}
+	if m.created_at != nil {
+		fields = append(fields, personalaccesstoken.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, personalaccesstoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, personalaccesstoken.FieldLastUsedAt)
+	}
+	if m.last_used_ip != nil {
+		fields = append(fields, personalaccesstoken.FieldLastUsedIP)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersonalAccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case personalaccesstoken.FieldName:
+		return m.Name()
+	case personalaccesstoken.FieldTokenHash:
+		return m.TokenHash()
+	case personalaccesstoken.FieldPrefix:
+		return m.Prefix()
+	case personalaccesstoken.FieldScope:
+		return m.Scope()
+	case personalaccesstoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case personalaccesstoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case personalaccesstoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case personalaccesstoken.FieldLastUsedIP:
+		return m.LastUsedIP()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonalAccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case personalaccesstoken.FieldName:
+		return m.OldName(ctx)
+	case personalaccesstoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case personalaccesstoken.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case personalaccesstoken.FieldScope:
+		return m.OldScope(ctx)
+	case personalaccesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case personalaccesstoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case personalaccesstoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case personalaccesstoken.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
+	}
+	return nil, fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalAccessTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case personalaccesstoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case personalaccesstoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case personalaccesstoken.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case personalaccesstoken.FieldScope:
+		v, ok := value.(personalaccesstoken.Scope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case personalaccesstoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case personalaccesstoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case personalaccesstoken.FieldLastUsedIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedIP(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersonalAccessTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersonalAccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalAccessTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PersonalAccessToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersonalAccessTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(personalaccesstoken.FieldExpiresAt) {
+		fields = append(fields, personalaccesstoken.FieldExpiresAt)
+	}
+	if m.FieldCleared(personalaccesstoken.FieldLastUsedAt) {
+		fields = append(fields, personalaccesstoken.FieldLastUsedAt)
+	}
+	if m.FieldCleared(personalaccesstoken.FieldLastUsedIP) {
+		fields = append(fields, personalaccesstoken.FieldLastUsedIP)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersonalAccessTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ClearField(name string) error {
+	switch name {
+	case personalaccesstoken.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case personalaccesstoken.FieldLastUsedIP:
+		m.ClearLastUsedIP()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ResetField(name string) error {
+	switch name {
+	case personalaccesstoken.FieldName:
+		m.ResetName()
+		return nil
+	case personalaccesstoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case personalaccesstoken.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case personalaccesstoken.FieldScope:
+		m.ResetScope()
+		return nil
+	case personalaccesstoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case personalaccesstoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case personalaccesstoken.FieldLastUsedIP:
+		m.ResetLastUsedIP()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersonalAccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._PersonalAccessTokenToUser != nil {
+		edges = append(edges, personalaccesstoken.EdgePersonalAccessTokenToUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersonalAccessTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case personalaccesstoken.EdgePersonalAccessTokenToUser:
+		if id := m._PersonalAccessTokenToUser; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersonalAccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersonalAccessTokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersonalAccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_PersonalAccessTokenToUser {
+		edges = append(edges, personalaccesstoken.EdgePersonalAccessTokenToUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case personalaccesstoken.EdgePersonalAccessTokenToUser:
+		return m.cleared_PersonalAccessTokenToUser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case personalaccesstoken.EdgePersonalAccessTokenToUser:
+		m.ClearPersonalAccessTokenToUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case personalaccesstoken.EdgePersonalAccessTokenToUser:
+		m.ResetPersonalAccessTokenToUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken edge %s", name)
+}
+
+// ProviderMutation represents an operation that mutates the Provider nodes in the graph.
+type ProviderMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	name                           *string
+	_type                          *string
+	_config                        *string
+	clearedFields                  map[string]struct{}
+	_ProviderToCompetitions        map[uuid.UUID]struct{}
+	removed_ProviderToCompetitions map[uuid.UUID]struct{}
+	cleared_ProviderToCompetitions bool
+	done                           bool
+	oldValue                       func(context.Context) (*Provider, error)
+	predicates                     []predicate.Provider
+}
+
+var _ ent.Mutation = (*ProviderMutation)(nil)
+
+// providerOption allows management of the mutation configuration using functional options.
+type providerOption func(*ProviderMutation)
+
+// newProviderMutation creates new mutation for the Provider entity.
+func newProviderMutation(c config, op Op, opts ...providerOption) *ProviderMutation {
+	m := &ProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProviderID sets the ID field of the mutation.
+func withProviderID(id uuid.UUID) providerOption {
+	return func(m *ProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Provider
+		)
+		m.oldValue = func(ctx context.Context) (*Provider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Provider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProvider sets the old Provider of the mutation.
+func withProvider(node *Provider) providerOption {
+	return func(m *ProviderMutation) {
+		m.oldValue = func(context.Context) (*Provider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Provider entities.
+func (m *ProviderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProviderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Provider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Provider entity.
+// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *ProviderMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProviderMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Provider entity.
+// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProviderMutation) ResetType() {
+	m._type = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *ProviderMutation) SetConfig(s string) {
+	m._config = &s
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *ProviderMutation) Config() (r string, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the Provider entity.
+// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderMutation) OldConfig(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *ProviderMutation) ResetConfig() {
+	m._config = nil
+}
+
+// AddProviderToCompetitionIDs adds the "ProviderToCompetitions" edge to the Competition entity by ids.
+func (m *ProviderMutation) AddProviderToCompetitionIDs(ids ...uuid.UUID) {
+	if m._ProviderToCompetitions == nil {
+		m._ProviderToCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._ProviderToCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProviderToCompetitions clears the "ProviderToCompetitions" edge to the Competition entity.
+func (m *ProviderMutation) ClearProviderToCompetitions() {
+	m.cleared_ProviderToCompetitions = true
+}
+
+// ProviderToCompetitionsCleared reports if the "ProviderToCompetitions" edge to the Competition entity was cleared.
+func (m *ProviderMutation) ProviderToCompetitionsCleared() bool {
+	return m.cleared_ProviderToCompetitions
+}
+
+// RemoveProviderToCompetitionIDs removes the "ProviderToCompetitions" edge to the Competition entity by IDs.
+func (m *ProviderMutation) RemoveProviderToCompetitionIDs(ids ...uuid.UUID) {
+	if m.removed_ProviderToCompetitions == nil {
+		m.removed_ProviderToCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._ProviderToCompetitions, ids[i])
+		m.removed_ProviderToCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProviderToCompetitions returns the removed IDs of the "ProviderToCompetitions" edge to the Competition entity.
+func (m *ProviderMutation) RemovedProviderToCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_ProviderToCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProviderToCompetitionsIDs returns the "ProviderToCompetitions" edge IDs in the mutation.
+func (m *ProviderMutation) ProviderToCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m._ProviderToCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProviderToCompetitions resets all changes to the "ProviderToCompetitions" edge.
+func (m *ProviderMutation) ResetProviderToCompetitions() {
+	m._ProviderToCompetitions = nil
+	m.cleared_ProviderToCompetitions = false
+	m.removed_ProviderToCompetitions = nil
+}
+
+// Where appends a list predicates to the ProviderMutation builder.
+func (m *ProviderMutation) Where(ps ...predicate.Provider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ProviderMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Provider).
+func (m *ProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProviderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, provider.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, provider.FieldType)
+	}
+	if m._config != nil {
+		fields = append(fields, provider.FieldConfig)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case provider.FieldName:
+		return m.Name()
+	case provider.FieldType:
+		return m.GetType()
+	case provider.FieldConfig:
+		return m.Config()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case provider.FieldName:
+		return m.OldName(ctx)
+	case provider.FieldType:
+		return m.OldType(ctx)
+	case provider.FieldConfig:
+		return m.OldConfig(ctx)
+	}
+	return nil, fmt.Errorf("unknown Provider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case provider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case provider.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case provider.FieldConfig:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Provider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProviderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProviderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Provider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProviderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProviderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Provider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProviderMutation) ResetField(name string) error {
+	switch name {
+	case provider.FieldName:
+		m.ResetName()
+		return nil
+	case provider.FieldType:
+		m.ResetType()
+		return nil
+	case provider.FieldConfig:
+		m.ResetConfig()
+		return nil
+	}
+	return fmt.Errorf("unknown Provider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._ProviderToCompetitions != nil {
+		edges = append(edges, provider.EdgeProviderToCompetitions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProviderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case provider.EdgeProviderToCompetitions:
+		ids := make([]ent.Value, 0, len(m._ProviderToCompetitions))
+		for id := range m._ProviderToCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removed_ProviderToCompetitions != nil {
+		edges = append(edges, provider.EdgeProviderToCompetitions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProviderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case provider.EdgeProviderToCompetitions:
+		ids := make([]ent.Value, 0, len(m.removed_ProviderToCompetitions))
+		for id := range m.removed_ProviderToCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_ProviderToCompetitions {
+		edges = append(edges, provider.EdgeProviderToCompetitions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProviderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case provider.EdgeProviderToCompetitions:
+		return m.cleared_ProviderToCompetitions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProviderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Provider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProviderMutation) ResetEdge(name string) error {
+	switch name {
+	case provider.EdgeProviderToCompetitions:
+		m.ResetProviderToCompetitions()
+		return nil
+	}
+	return fmt.Errorf("unknown Provider edge %s", name)
+}
+
+// ServiceAccountMutation represents an operation that mutates the ServiceAccount nodes in the graph.
+type ServiceAccountMutation struct {
+	config
+	op                                   Op
+	typ                                  string
+	id                                   *uuid.UUID
+	display_name                         *string
+	api_key                              *uuid.UUID
+	api_secret                           *uuid.UUID
+	api_secret_hash                      *string
+	previous_api_secret_hash             *string
+	previous_api_secret_expires_at       *time.Time
+	secret_generation                    *int
+	addsecret_generation                 *int
+	active                               *bool
+	expires_at                           *time.Time
+	last_used_at                         *time.Time
+	last_used_ip                         *string
+	scopes                               *[]string
+	clearedFields                        map[string]struct{}
+	_ServiceAccountToToken               map[uuid.UUID]struct{}
+	removed_ServiceAccountToToken        map[uuid.UUID]struct{}
+	cleared_ServiceAccountToToken        bool
+	_ServiceAccountToActions             map[uuid.UUID]struct{}
+	removed_ServiceAccountToActions      map[uuid.UUID]struct{}
+	cleared_ServiceAccountToActions      bool
+	_ServiceAccountToCompetitions        map[uuid.UUID]struct{}
+	removed_ServiceAccountToCompetitions map[uuid.UUID]struct{}
+	cleared_ServiceAccountToCompetitions bool
+	_ServiceAccountToTeams               map[uuid.UUID]struct{}
+	removed_ServiceAccountToTeams        map[uuid.UUID]struct{}
+	cleared_ServiceAccountToTeams        bool
+	done                                 bool
+	oldValue                             func(context.Context) (*ServiceAccount, error)
+	predicates                           []predicate.ServiceAccount
+}
+
+var _ ent.Mutation = (*ServiceAccountMutation)(nil)
+
+// serviceaccountOption allows management of the mutation configuration using functional options.
+type serviceaccountOption func(*ServiceAccountMutation)
+
+// newServiceAccountMutation creates new mutation for the ServiceAccount entity.
+func newServiceAccountMutation(c config, op Op, opts ...serviceaccountOption) *ServiceAccountMutation {
+	m := &ServiceAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeServiceAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withServiceAccountID sets the ID field of the mutation.
+func withServiceAccountID(id uuid.UUID) serviceaccountOption {
+	return func(m *ServiceAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ServiceAccount
+		)
+		m.oldValue = func(ctx context.Context) (*ServiceAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ServiceAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withServiceAccount sets the old ServiceAccount of the mutation.
+func withServiceAccount(node *ServiceAccount) serviceaccountOption {
+	return func(m *ServiceAccountMutation) {
+		m.oldValue = func(context.Context) (*ServiceAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ServiceAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ServiceAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ServiceAccount entities.
+func (m *ServiceAccountMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ServiceAccountMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ServiceAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ServiceAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *ServiceAccountMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *ServiceAccountMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *ServiceAccountMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetAPIKey sets the "api_key" field.
+func (m *ServiceAccountMutation) SetAPIKey(u uuid.UUID) {
+	m.api_key = &u
+}
+
+// APIKey returns the value of the "api_key" field in the mutation.
+func (m *ServiceAccountMutation) APIKey() (r uuid.UUID, exists bool) {
+	v := m.api_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKey returns the old "api_key" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldAPIKey(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+	}
+	return oldValue.APIKey, nil
+}
+
+// ResetAPIKey resets all changes to the "api_key" field.
+func (m *ServiceAccountMutation) ResetAPIKey() {
+	m.api_key = nil
+}
+
+// SetAPISecret sets the "api_secret" field.
+func (m *ServiceAccountMutation) SetAPISecret(u uuid.UUID) {
+	m.api_secret = &u
+}
+
+// APISecret returns the value of the "api_secret" field in the mutation.
+func (m *ServiceAccountMutation) APISecret() (r uuid.UUID, exists bool) {
+	v := m.api_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPISecret returns the old "api_secret" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldAPISecret(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPISecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPISecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPISecret: %w", err)
+	}
+	return oldValue.APISecret, nil
+}
+
+// ClearAPISecret clears the value of the "api_secret" field.
+func (m *ServiceAccountMutation) ClearAPISecret() {
+	m.api_secret = nil
+	m.clearedFields[serviceaccount.FieldAPISecret] = struct{}{}
+}
+
+// APISecretCleared returns if the "api_secret" field was cleared in this mutation.
+func (m *ServiceAccountMutation) APISecretCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldAPISecret]
+	return ok
+}
+
+// ResetAPISecret resets all changes to the "api_secret" field.
+func (m *ServiceAccountMutation) ResetAPISecret() {
+	m.api_secret = nil
+	delete(m.clearedFields, serviceaccount.FieldAPISecret)
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (m *ServiceAccountMutation) SetAPISecretHash(s string) {
+	m.api_secret_hash = &s
+}
+
+// APISecretHash returns the value of the "api_secret_hash" field in the mutation.
+func (m *ServiceAccountMutation) APISecretHash() (r string, exists bool) {
+	v := m.api_secret_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPISecretHash returns the old "api_secret_hash" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldAPISecretHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPISecretHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPISecretHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPISecretHash: %w", err)
+	}
+	return oldValue.APISecretHash, nil
+}
+
+// ClearAPISecretHash clears the value of the "api_secret_hash" field.
+func (m *ServiceAccountMutation) ClearAPISecretHash() {
+	m.api_secret_hash = nil
+	m.clearedFields[serviceaccount.FieldAPISecretHash] = struct{}{}
+}
+
+// APISecretHashCleared returns if the "api_secret_hash" field was cleared in this mutation.
+func (m *ServiceAccountMutation) APISecretHashCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldAPISecretHash]
+	return ok
+}
+
+// ResetAPISecretHash resets all changes to the "api_secret_hash" field.
+func (m *ServiceAccountMutation) ResetAPISecretHash() {
+	m.api_secret_hash = nil
+	delete(m.clearedFields, serviceaccount.FieldAPISecretHash)
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (m *ServiceAccountMutation) SetPreviousAPISecretHash(s string) {
+	m.previous_api_secret_hash = &s
+}
+
+// PreviousAPISecretHash returns the value of the "previous_api_secret_hash" field in the mutation.
+func (m *ServiceAccountMutation) PreviousAPISecretHash() (r string, exists bool) {
+	v := m.previous_api_secret_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousAPISecretHash returns the old "previous_api_secret_hash" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldPreviousAPISecretHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousAPISecretHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousAPISecretHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousAPISecretHash: %w", err)
+	}
+	return oldValue.PreviousAPISecretHash, nil
+}
+
+// ClearPreviousAPISecretHash clears the value of the "previous_api_secret_hash" field.
+func (m *ServiceAccountMutation) ClearPreviousAPISecretHash() {
+	m.previous_api_secret_hash = nil
+	m.clearedFields[serviceaccount.FieldPreviousAPISecretHash] = struct{}{}
+}
+
+// PreviousAPISecretHashCleared returns if the "previous_api_secret_hash" field was cleared in this mutation.
+func (m *ServiceAccountMutation) PreviousAPISecretHashCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldPreviousAPISecretHash]
+	return ok
+}
+
+// ResetPreviousAPISecretHash resets all changes to the "previous_api_secret_hash" field.
+func (m *ServiceAccountMutation) ResetPreviousAPISecretHash() {
+	m.previous_api_secret_hash = nil
+	delete(m.clearedFields, serviceaccount.FieldPreviousAPISecretHash)
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (m *ServiceAccountMutation) SetPreviousAPISecretExpiresAt(t time.Time) {
+	m.previous_api_secret_expires_at = &t
+}
+
+// PreviousAPISecretExpiresAt returns the value of the "previous_api_secret_expires_at" field in the mutation.
+func (m *ServiceAccountMutation) PreviousAPISecretExpiresAt() (r time.Time, exists bool) {
+	v := m.previous_api_secret_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousAPISecretExpiresAt returns the old "previous_api_secret_expires_at" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldPreviousAPISecretExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousAPISecretExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousAPISecretExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousAPISecretExpiresAt: %w", err)
+	}
+	return oldValue.PreviousAPISecretExpiresAt, nil
+}
+
+// ClearPreviousAPISecretExpiresAt clears the value of the "previous_api_secret_expires_at" field.
+func (m *ServiceAccountMutation) ClearPreviousAPISecretExpiresAt() {
+	m.previous_api_secret_expires_at = nil
+	m.clearedFields[serviceaccount.FieldPreviousAPISecretExpiresAt] = struct{}{}
+}
+
+// PreviousAPISecretExpiresAtCleared returns if the "previous_api_secret_expires_at" field was cleared in this mutation.
+func (m *ServiceAccountMutation) PreviousAPISecretExpiresAtCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldPreviousAPISecretExpiresAt]
+	return ok
+}
+
+// ResetPreviousAPISecretExpiresAt resets all changes to the "previous_api_secret_expires_at" field.
+func (m *ServiceAccountMutation) ResetPreviousAPISecretExpiresAt() {
+	m.previous_api_secret_expires_at = nil
+	delete(m.clearedFields, serviceaccount.FieldPreviousAPISecretExpiresAt)
+}
+
+// SetSecretGeneration sets the "secret_generation" field.
+func (m *ServiceAccountMutation) SetSecretGeneration(i int) {
+	m.secret_generation = &i
+	m.addsecret_generation = nil
+}
+
+// SecretGeneration returns the value of the "secret_generation" field in the mutation.
+func (m *ServiceAccountMutation) SecretGeneration() (r int, exists bool) {
+	v := m.secret_generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretGeneration returns the old "secret_generation" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldSecretGeneration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretGeneration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretGeneration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretGeneration: %w", err)
+	}
+	return oldValue.SecretGeneration, nil
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (m *ServiceAccountMutation) AddSecretGeneration(i int) {
+	if m.addsecret_generation != nil {
+		*m.addsecret_generation += i
+	} else {
+		m.addsecret_generation = &i
+	}
+}
+
+// AddedSecretGeneration returns the value that was added to the "secret_generation" field in this mutation.
+func (m *ServiceAccountMutation) AddedSecretGeneration() (r int, exists bool) {
+	v := m.addsecret_generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSecretGeneration resets all changes to the "secret_generation" field.
+func (m *ServiceAccountMutation) ResetSecretGeneration() {
+	m.secret_generation = nil
+	m.addsecret_generation = nil
+}
+
+// SetActive sets the "active" field.
+func (m *ServiceAccountMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *ServiceAccountMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *ServiceAccountMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ServiceAccountMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ServiceAccountMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ServiceAccountMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[serviceaccount.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ServiceAccountMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ServiceAccountMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, serviceaccount.FieldExpiresAt)
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ServiceAccountMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ServiceAccountMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ServiceAccountMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[serviceaccount.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ServiceAccountMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ServiceAccountMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, serviceaccount.FieldLastUsedAt)
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *ServiceAccountMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
+}
+
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *ServiceAccountMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedIP returns the old "last_used_ip" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
+	}
+	return oldValue.LastUsedIP, nil
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (m *ServiceAccountMutation) ClearLastUsedIP() {
+	m.last_used_ip = nil
+	m.clearedFields[serviceaccount.FieldLastUsedIP] = struct{}{}
+}
+
+// LastUsedIPCleared returns if the "last_used_ip" field was cleared in this mutation.
+func (m *ServiceAccountMutation) LastUsedIPCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldLastUsedIP]
+	return ok
+}
+
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *ServiceAccountMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+	delete(m.clearedFields, serviceaccount.FieldLastUsedIP)
+}
+
+// SetScopes sets the "scopes" field.
+func (m *ServiceAccountMutation) SetScopes(s []string) {
+	m.scopes = &s
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ServiceAccountMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the ServiceAccount entity.
+// If the ServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceAccountMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *ServiceAccountMutation) ClearScopes() {
+	m.scopes = nil
+	m.clearedFields[serviceaccount.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *ServiceAccountMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[serviceaccount.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ServiceAccountMutation) ResetScopes() {
+	m.scopes = nil
+	delete(m.clearedFields, serviceaccount.FieldScopes)
+}
+
+// AddServiceAccountToTokenIDs adds the "ServiceAccountToToken" edge to the ServiceToken entity by ids.
+func (m *ServiceAccountMutation) AddServiceAccountToTokenIDs(ids ...uuid.UUID) {
+	if m._ServiceAccountToToken == nil {
+		m._ServiceAccountToToken = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._ServiceAccountToToken[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceAccountToToken clears the "ServiceAccountToToken" edge to the ServiceToken entity.
+func (m *ServiceAccountMutation) ClearServiceAccountToToken() {
+	m.cleared_ServiceAccountToToken = true
+}
+
+// ServiceAccountToTokenCleared reports if the "ServiceAccountToToken" edge to the ServiceToken entity was cleared.
+func (m *ServiceAccountMutation) ServiceAccountToTokenCleared() bool {
+	return m.cleared_ServiceAccountToToken
+}
+
+// RemoveServiceAccountToTokenIDs removes the "ServiceAccountToToken" edge to the ServiceToken entity by IDs.
+func (m *ServiceAccountMutation) RemoveServiceAccountToTokenIDs(ids ...uuid.UUID) {
+	if m.removed_ServiceAccountToToken == nil {
+		m.removed_ServiceAccountToToken = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._ServiceAccountToToken, ids[i])
+		m.removed_ServiceAccountToToken[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceAccountToToken returns the removed IDs of the "ServiceAccountToToken" edge to the ServiceToken entity.
+func (m *ServiceAccountMutation) RemovedServiceAccountToTokenIDs() (ids []uuid.UUID) {
+	for id := range m.removed_ServiceAccountToToken {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceAccountToTokenIDs returns the "ServiceAccountToToken" edge IDs in the mutation.
+func (m *ServiceAccountMutation) ServiceAccountToTokenIDs() (ids []uuid.UUID) {
+	for id := range m._ServiceAccountToToken {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceAccountToToken resets all changes to the "ServiceAccountToToken" edge.
+func (m *ServiceAccountMutation) ResetServiceAccountToToken() {
+	m._ServiceAccountToToken = nil
+	m.cleared_ServiceAccountToToken = false
+	m.removed_ServiceAccountToToken = nil
+}
+
+// AddServiceAccountToActionIDs adds the "ServiceAccountToActions" edge to the Action entity by ids.
+func (m *ServiceAccountMutation) AddServiceAccountToActionIDs(ids ...uuid.UUID) {
+	if m._ServiceAccountToActions == nil {
+		m._ServiceAccountToActions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._ServiceAccountToActions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceAccountToActions clears the "ServiceAccountToActions" edge to the Action entity.
+func (m *ServiceAccountMutation) ClearServiceAccountToActions() {
+	m.cleared_ServiceAccountToActions = true
+}
+
+// ServiceAccountToActionsCleared reports if the "ServiceAccountToActions" edge to the Action entity was cleared.
+func (m *ServiceAccountMutation) ServiceAccountToActionsCleared() bool {
+	return m.cleared_ServiceAccountToActions
+}
+
+// RemoveServiceAccountToActionIDs removes the "ServiceAccountToActions" edge to the Action entity by IDs.
+func (m *ServiceAccountMutation) RemoveServiceAccountToActionIDs(ids ...uuid.UUID) {
+	if m.removed_ServiceAccountToActions == nil {
+		m.removed_ServiceAccountToActions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._ServiceAccountToActions, ids[i])
+		m.removed_ServiceAccountToActions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceAccountToActions returns the removed IDs of the "ServiceAccountToActions" edge to the Action entity.
+func (m *ServiceAccountMutation) RemovedServiceAccountToActionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_ServiceAccountToActions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceAccountToActionsIDs returns the "ServiceAccountToActions" edge IDs in the mutation.
+func (m *ServiceAccountMutation) ServiceAccountToActionsIDs() (ids []uuid.UUID) {
+	for id := range m._ServiceAccountToActions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceAccountToActions resets all changes to the "ServiceAccountToActions" edge.
+func (m *ServiceAccountMutation) ResetServiceAccountToActions() {
+	m._ServiceAccountToActions = nil
+	m.cleared_ServiceAccountToActions = false
+	m.removed_ServiceAccountToActions = nil
+}
+
+// AddServiceAccountToCompetitionIDs adds the "ServiceAccountToCompetitions" edge to the Competition entity by ids.
+func (m *ServiceAccountMutation) AddServiceAccountToCompetitionIDs(ids ...uuid.UUID) {
+	if m._ServiceAccountToCompetitions == nil {
+		m._ServiceAccountToCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._ServiceAccountToCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceAccountToCompetitions clears the "ServiceAccountToCompetitions" edge to the Competition entity.
+func (m *ServiceAccountMutation) ClearServiceAccountToCompetitions() {
+	m.cleared_ServiceAccountToCompetitions = true
+}
+
+// ServiceAccountToCompetitionsCleared reports if the "ServiceAccountToCompetitions" edge to the Competition entity was cleared.
+func (m *ServiceAccountMutation) ServiceAccountToCompetitionsCleared() bool {
+	return m.cleared_ServiceAccountToCompetitions
+}
+
+// RemoveServiceAccountToCompetitionIDs removes the "ServiceAccountToCompetitions" edge to the Competition entity by IDs.
+func (m *ServiceAccountMutation) RemoveServiceAccountToCompetitionIDs(ids ...uuid.UUID) {
+	if m.removed_ServiceAccountToCompetitions == nil {
+		m.removed_ServiceAccountToCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._ServiceAccountToCompetitions, ids[i])
+		m.removed_ServiceAccountToCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceAccountToCompetitions returns the removed IDs of the "ServiceAccountToCompetitions" edge to the Competition entity.
+func (m *ServiceAccountMutation) RemovedServiceAccountToCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_ServiceAccountToCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceAccountToCompetitionsIDs returns the "ServiceAccountToCompetitions" edge IDs in the mutation.
+func (m *ServiceAccountMutation) ServiceAccountToCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m._ServiceAccountToCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceAccountToCompetitions resets all changes to the "ServiceAccountToCompetitions" edge.
+func (m *ServiceAccountMutation) ResetServiceAccountToCompetitions() {
+	m._ServiceAccountToCompetitions = nil
+	m.cleared_ServiceAccountToCompetitions = false
+	m.removed_ServiceAccountToCompetitions = nil
+}
+
+// AddServiceAccountToTeamIDs adds the "ServiceAccountToTeams" edge to the Team entity by ids.
+func (m *ServiceAccountMutation) AddServiceAccountToTeamIDs(ids ...uuid.UUID) {
+	if m._ServiceAccountToTeams == nil {
+		m._ServiceAccountToTeams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._ServiceAccountToTeams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceAccountToTeams clears the "ServiceAccountToTeams" edge to the Team entity.
+func (m *ServiceAccountMutation) ClearServiceAccountToTeams() {
+	m.cleared_ServiceAccountToTeams = true
+}
+
+// ServiceAccountToTeamsCleared reports if the "ServiceAccountToTeams" edge to the Team entity was cleared.
+func (m *ServiceAccountMutation) ServiceAccountToTeamsCleared() bool {
+	return m.cleared_ServiceAccountToTeams
+}
+
+// RemoveServiceAccountToTeamIDs removes the "ServiceAccountToTeams" edge to the Team entity by IDs.
+func (m *ServiceAccountMutation) RemoveServiceAccountToTeamIDs(ids ...uuid.UUID) {
+	if m.removed_ServiceAccountToTeams == nil {
+		m.removed_ServiceAccountToTeams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._ServiceAccountToTeams, ids[i])
+		m.removed_ServiceAccountToTeams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceAccountToTeams returns the removed IDs of the "ServiceAccountToTeams" edge to the Team entity.
+func (m *ServiceAccountMutation) RemovedServiceAccountToTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_ServiceAccountToTeams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceAccountToTeamsIDs returns the "ServiceAccountToTeams" edge IDs in the mutation.
+func (m *ServiceAccountMutation) ServiceAccountToTeamsIDs() (ids []uuid.UUID) {
+	for id := range m._ServiceAccountToTeams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceAccountToTeams resets all changes to the "ServiceAccountToTeams" edge.
+func (m *ServiceAccountMutation) ResetServiceAccountToTeams() {
+	m._ServiceAccountToTeams = nil
+	m.cleared_ServiceAccountToTeams = false
+	m.removed_ServiceAccountToTeams = nil
+}
+
+// Where appends a list predicates to the ServiceAccountMutation builder.
+func (m *ServiceAccountMutation) Where(ps ...predicate.ServiceAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ServiceAccountMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ServiceAccount).
+func (m *ServiceAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ServiceAccountMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.display_name != nil {
+		fields = append(fields, serviceaccount.FieldDisplayName)
+	}
+	if m.api_key != nil {
+		fields = append(fields, serviceaccount.FieldAPIKey)
+	}
+	if m.api_secret != nil {
+		fields = append(fields, serviceaccount.FieldAPISecret)
+	}
+	if m.api_secret_hash != nil {
+		fields = append(fields, serviceaccount.FieldAPISecretHash)
+	}
+	if m.previous_api_secret_hash != nil {
+		fields = append(fields, serviceaccount.FieldPreviousAPISecretHash)
+	}
+	if m.previous_api_secret_expires_at != nil {
+		fields = append(fields, serviceaccount.FieldPreviousAPISecretExpiresAt)
+	}
+	if m.secret_generation != nil {
+		fields = append(fields, serviceaccount.FieldSecretGeneration)
+	}
+	if m.active != nil {
+		fields = append(fields, serviceaccount.FieldActive)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, serviceaccount.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, serviceaccount.FieldLastUsedAt)
+	}
+	if m.last_used_ip != nil {
+		fields = append(fields, serviceaccount.FieldLastUsedIP)
+	}
+	if m.scopes != nil {
+		fields = append(fields, serviceaccount.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ServiceAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case serviceaccount.FieldDisplayName:
+		return m.DisplayName()
+	case serviceaccount.FieldAPIKey:
+		return m.APIKey()
+	case serviceaccount.FieldAPISecret:
+		return m.APISecret()
+	case serviceaccount.FieldAPISecretHash:
+		return m.APISecretHash()
+	case serviceaccount.FieldPreviousAPISecretHash:
+		return m.PreviousAPISecretHash()
+	case serviceaccount.FieldPreviousAPISecretExpiresAt:
+		return m.PreviousAPISecretExpiresAt()
+	case serviceaccount.FieldSecretGeneration:
+		return m.SecretGeneration()
+	case serviceaccount.FieldActive:
+		return m.Active()
+	case serviceaccount.FieldExpiresAt:
+		return m.ExpiresAt()
+	case serviceaccount.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case serviceaccount.FieldLastUsedIP:
+		return m.LastUsedIP()
+	case serviceaccount.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ServiceAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case serviceaccount.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case serviceaccount.FieldAPIKey:
+		return m.OldAPIKey(ctx)
+	case serviceaccount.FieldAPISecret:
+		return m.OldAPISecret(ctx)
+	case serviceaccount.FieldAPISecretHash:
+		return m.OldAPISecretHash(ctx)
+	case serviceaccount.FieldPreviousAPISecretHash:
+		return m.OldPreviousAPISecretHash(ctx)
+	case serviceaccount.FieldPreviousAPISecretExpiresAt:
+		return m.OldPreviousAPISecretExpiresAt(ctx)
+	case serviceaccount.FieldSecretGeneration:
+		return m.OldSecretGeneration(ctx)
+	case serviceaccount.FieldActive:
+		return m.OldActive(ctx)
+	case serviceaccount.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case serviceaccount.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case serviceaccount.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
+	case serviceaccount.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case serviceaccount.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case serviceaccount.FieldAPIKey:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKey(v)
+		return nil
+	case serviceaccount.FieldAPISecret:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPISecret(v)
+		return nil
+	case serviceaccount.FieldAPISecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPISecretHash(v)
+		return nil
+	case serviceaccount.FieldPreviousAPISecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousAPISecretHash(v)
+		return nil
+	case serviceaccount.FieldPreviousAPISecretExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousAPISecretExpiresAt(v)
+		return nil
+	case serviceaccount.FieldSecretGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretGeneration(v)
+		return nil
+	case serviceaccount.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case serviceaccount.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case serviceaccount.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case serviceaccount.FieldLastUsedIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedIP(v)
+		return nil
+	case serviceaccount.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ServiceAccountMutation) AddedFields() []string {
+	var fields []string
+	if m.addsecret_generation != nil {
+		fields = append(fields, serviceaccount.FieldSecretGeneration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ServiceAccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case serviceaccount.FieldSecretGeneration:
+		return m.AddedSecretGeneration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case serviceaccount.FieldSecretGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSecretGeneration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ServiceAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(serviceaccount.FieldAPISecret) {
+		fields = append(fields, serviceaccount.FieldAPISecret)
+	}
+	if m.FieldCleared(serviceaccount.FieldAPISecretHash) {
+		fields = append(fields, serviceaccount.FieldAPISecretHash)
+	}
+	if m.FieldCleared(serviceaccount.FieldPreviousAPISecretHash) {
+		fields = append(fields, serviceaccount.FieldPreviousAPISecretHash)
+	}
+	if m.FieldCleared(serviceaccount.FieldPreviousAPISecretExpiresAt) {
+		fields = append(fields, serviceaccount.FieldPreviousAPISecretExpiresAt)
+	}
+	if m.FieldCleared(serviceaccount.FieldExpiresAt) {
+		fields = append(fields, serviceaccount.FieldExpiresAt)
+	}
+	if m.FieldCleared(serviceaccount.FieldLastUsedAt) {
+		fields = append(fields, serviceaccount.FieldLastUsedAt)
+	}
+	if m.FieldCleared(serviceaccount.FieldLastUsedIP) {
+		fields = append(fields, serviceaccount.FieldLastUsedIP)
+	}
+	if m.FieldCleared(serviceaccount.FieldScopes) {
+		fields = append(fields, serviceaccount.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ServiceAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ServiceAccountMutation) ClearField(name string) error {
+	switch name {
+	case serviceaccount.FieldAPISecret:
+		m.ClearAPISecret()
+		return nil
+	case serviceaccount.FieldAPISecretHash:
+		m.ClearAPISecretHash()
+		return nil
+	case serviceaccount.FieldPreviousAPISecretHash:
+		m.ClearPreviousAPISecretHash()
+		return nil
+	case serviceaccount.FieldPreviousAPISecretExpiresAt:
+		m.ClearPreviousAPISecretExpiresAt()
+		return nil
+	case serviceaccount.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case serviceaccount.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case serviceaccount.FieldLastUsedIP:
+		m.ClearLastUsedIP()
+		return nil
+	case serviceaccount.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ServiceAccountMutation) ResetField(name string) error {
+	switch name {
+	case serviceaccount.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case serviceaccount.FieldAPIKey:
+		m.ResetAPIKey()
+		return nil
+	case serviceaccount.FieldAPISecret:
+		m.ResetAPISecret()
+		return nil
+	case serviceaccount.FieldAPISecretHash:
+		m.ResetAPISecretHash()
+		return nil
+	case serviceaccount.FieldPreviousAPISecretHash:
+		m.ResetPreviousAPISecretHash()
+		return nil
+	case serviceaccount.FieldPreviousAPISecretExpiresAt:
+		m.ResetPreviousAPISecretExpiresAt()
+		return nil
+	case serviceaccount.FieldSecretGeneration:
+		m.ResetSecretGeneration()
+		return nil
+	case serviceaccount.FieldActive:
+		m.ResetActive()
+		return nil
+	case serviceaccount.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case serviceaccount.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case serviceaccount.FieldLastUsedIP:
+		m.ResetLastUsedIP()
+		return nil
+	case serviceaccount.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ServiceAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._ServiceAccountToToken != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
+	}
+	if m._ServiceAccountToActions != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
+	}
+	if m._ServiceAccountToCompetitions != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToCompetitions)
+	}
+	if m._ServiceAccountToTeams != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ServiceAccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case serviceaccount.EdgeServiceAccountToToken:
+		ids := make([]ent.Value, 0, len(m._ServiceAccountToToken))
+		for id := range m._ServiceAccountToToken {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToActions:
+		ids := make([]ent.Value, 0, len(m._ServiceAccountToActions))
+		for id := range m._ServiceAccountToActions {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToCompetitions:
+		ids := make([]ent.Value, 0, len(m._ServiceAccountToCompetitions))
+		for id := range m._ServiceAccountToCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToTeams:
+		ids := make([]ent.Value, 0, len(m._ServiceAccountToTeams))
+		for id := range m._ServiceAccountToTeams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ServiceAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removed_ServiceAccountToToken != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
+	}
+	if m.removed_ServiceAccountToActions != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
+	}
+	if m.removed_ServiceAccountToCompetitions != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToCompetitions)
+	}
+	if m.removed_ServiceAccountToTeams != nil {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ServiceAccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case serviceaccount.EdgeServiceAccountToToken:
+		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToToken))
+		for id := range m.removed_ServiceAccountToToken {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToActions:
+		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToActions))
+		for id := range m.removed_ServiceAccountToActions {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToCompetitions:
+		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToCompetitions))
+		for id := range m.removed_ServiceAccountToCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case serviceaccount.EdgeServiceAccountToTeams:
+		ids := make([]ent.Value, 0, len(m.removed_ServiceAccountToTeams))
+		for id := range m.removed_ServiceAccountToTeams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ServiceAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_ServiceAccountToToken {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToToken)
+	}
+	if m.cleared_ServiceAccountToActions {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToActions)
+	}
+	if m.cleared_ServiceAccountToCompetitions {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToCompetitions)
+	}
+	if m.cleared_ServiceAccountToTeams {
+		edges = append(edges, serviceaccount.EdgeServiceAccountToTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ServiceAccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case serviceaccount.EdgeServiceAccountToToken:
+		return m.cleared_ServiceAccountToToken
+	case serviceaccount.EdgeServiceAccountToActions:
+		return m.cleared_ServiceAccountToActions
+	case serviceaccount.EdgeServiceAccountToCompetitions:
+		return m.cleared_ServiceAccountToCompetitions
+	case serviceaccount.EdgeServiceAccountToTeams:
+		return m.cleared_ServiceAccountToTeams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ServiceAccountMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ServiceAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ServiceAccountMutation) ResetEdge(name string) error {
+	switch name {
+	case serviceaccount.EdgeServiceAccountToToken:
+		m.ResetServiceAccountToToken()
+		return nil
+	case serviceaccount.EdgeServiceAccountToActions:
+		m.ResetServiceAccountToActions()
+		return nil
+	case serviceaccount.EdgeServiceAccountToCompetitions:
+		m.ResetServiceAccountToCompetitions()
+		return nil
+	case serviceaccount.EdgeServiceAccountToTeams:
+		m.ResetServiceAccountToTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceAccount edge %s", name)
+}
+
+// ServiceTokenMutation represents an operation that mutates the ServiceToken nodes in the graph.
+type ServiceTokenMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	token                         *string
+	refresh_token                 *string
+	issued_at                     *int64
+	addissued_at                  *int64
+	secret_generation             *int
+	addsecret_generation          *int
+	clearedFields                 map[string]struct{}
+	_TokenToServiceAccount        *uuid.UUID
+	cleared_TokenToServiceAccount bool
+	done                          bool
+	oldValue                      func(context.Context) (*ServiceToken, error)
+	predicates                    []predicate.ServiceToken
+}
+
+var _ ent.Mutation = (*ServiceTokenMutation)(nil)
+
+// servicetokenOption allows management of the mutation configuration using functional options.
+type servicetokenOption func(*ServiceTokenMutation)
+
+// newServiceTokenMutation creates new mutation for the ServiceToken entity.
+func newServiceTokenMutation(c config, op Op, opts ...servicetokenOption) *ServiceTokenMutation {
+	m := &ServiceTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeServiceToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withServiceTokenID sets the ID field of the mutation.
+func withServiceTokenID(id uuid.UUID) servicetokenOption {
+	return func(m *ServiceTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ServiceToken
+		)
+		m.oldValue = func(ctx context.Context) (*ServiceToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ServiceToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withServiceToken sets the old ServiceToken of the mutation.
+func withServiceToken(node *ServiceToken) servicetokenOption {
+	return func(m *ServiceTokenMutation) {
+		m.oldValue = func(context.Context) (*ServiceToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ServiceTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ServiceTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ServiceToken entities.
+func (m *ServiceTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ServiceTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ServiceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ServiceToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *ServiceTokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *ServiceTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the ServiceToken entity.
+// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *ServiceTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (m *ServiceTokenMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
+}
+
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *ServiceTokenMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshToken returns the old "refresh_token" field's value of the ServiceToken entity.
+// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceTokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	}
+	return oldValue.RefreshToken, nil
+}
+
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *ServiceTokenMutation) ResetRefreshToken() {
+	m.refresh_token = nil
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (m *ServiceTokenMutation) SetIssuedAt(i int64) {
+	m.issued_at = &i
+	m.addissued_at = nil
+}
+
+// IssuedAt returns the value of the "issued_at" field in the mutation.
+func (m *ServiceTokenMutation) IssuedAt() (r int64, exists bool) {
+	v := m.issued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedAt returns the old "issued_at" field's value of the ServiceToken entity.
+// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceTokenMutation) OldIssuedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
+	}
+	return oldValue.IssuedAt, nil
+}
+
+// AddIssuedAt adds i to the "issued_at" field.
+func (m *ServiceTokenMutation) AddIssuedAt(i int64) {
+	if m.addissued_at != nil {
+		*m.addissued_at += i
+	} else {
+		m.addissued_at = &i
+	}
+}
+
+// AddedIssuedAt returns the value that was added to the "issued_at" field in this mutation.
+func (m *ServiceTokenMutation) AddedIssuedAt() (r int64, exists bool) {
+	v := m.addissued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssuedAt resets all changes to the "issued_at" field.
+func (m *ServiceTokenMutation) ResetIssuedAt() {
+	m.issued_at = nil
+	m.addissued_at = nil
+}
+
+// SetSecretGeneration sets the "secret_generation" field.
+func (m *ServiceTokenMutation) SetSecretGeneration(i int) {
+	m.secret_generation = &i
+	m.addsecret_generation = nil
+}
+
+// SecretGeneration returns the value of the "secret_generation" field in the mutation.
+func (m *ServiceTokenMutation) SecretGeneration() (r int, exists bool) {
+	v := m.secret_generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretGeneration returns the old "secret_generation" field's value of the ServiceToken entity.
+// If the ServiceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceTokenMutation) OldSecretGeneration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretGeneration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretGeneration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretGeneration: %w", err)
+	}
+	return oldValue.SecretGeneration, nil
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (m *ServiceTokenMutation) AddSecretGeneration(i int) {
+	if m.addsecret_generation != nil {
+		*m.addsecret_generation += i
+	} else {
+		m.addsecret_generation = &i
+	}
+}
+
+// AddedSecretGeneration returns the value that was added to the "secret_generation" field in this mutation.
+func (m *ServiceTokenMutation) AddedSecretGeneration() (r int, exists bool) {
+	v := m.addsecret_generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSecretGeneration resets all changes to the "secret_generation" field.
+func (m *ServiceTokenMutation) ResetSecretGeneration() {
+	m.secret_generation = nil
+	m.addsecret_generation = nil
+}
+
+// SetTokenToServiceAccountID sets the "TokenToServiceAccount" edge to the ServiceAccount entity by id.
+func (m *ServiceTokenMutation) SetTokenToServiceAccountID(id uuid.UUID) {
+	m._TokenToServiceAccount = &id
+}
+
+// ClearTokenToServiceAccount clears the "TokenToServiceAccount" edge to the ServiceAccount entity.
+func (m *ServiceTokenMutation) ClearTokenToServiceAccount() {
+	m.cleared_TokenToServiceAccount = true
+}
+
+// TokenToServiceAccountCleared reports if the "TokenToServiceAccount" edge to the ServiceAccount entity was cleared.
+func (m *ServiceTokenMutation) TokenToServiceAccountCleared() bool {
+	return m.cleared_TokenToServiceAccount
+}
+
+// TokenToServiceAccountID returns the "TokenToServiceAccount" edge ID in the mutation.
+func (m *ServiceTokenMutation) TokenToServiceAccountID() (id uuid.UUID, exists bool) {
+	if m._TokenToServiceAccount != nil {
+		return *m._TokenToServiceAccount, true
+	}
+	return
+}
+
+// TokenToServiceAccountIDs returns the "TokenToServiceAccount" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenToServiceAccountID instead. It exists only for internal usage by the builders.
+func (m *ServiceTokenMutation) TokenToServiceAccountIDs() (ids []uuid.UUID) {
+	if id := m._TokenToServiceAccount; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTokenToServiceAccount resets all changes to the "TokenToServiceAccount" edge.
+func (m *ServiceTokenMutation) ResetTokenToServiceAccount() {
+	m._TokenToServiceAccount = nil
+	m.cleared_TokenToServiceAccount = false
+}
+
+// Where appends a list predicates to the ServiceTokenMutation builder.
+func (m *ServiceTokenMutation) Where(ps ...predicate.ServiceToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ServiceTokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ServiceToken).
+func (m *ServiceTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ServiceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token != nil {
+		fields = append(fields, servicetoken.FieldToken)
+	}
+	if m.refresh_token != nil {
+		fields = append(fields, servicetoken.FieldRefreshToken)
+	}
+	if m.issued_at != nil {
+		fields = append(fields, servicetoken.FieldIssuedAt)
+	}
+	if m.secret_generation != nil {
+		fields = append(fields, servicetoken.FieldSecretGeneration)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ServiceTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case servicetoken.FieldToken:
+		return m.Token()
+	case servicetoken.FieldRefreshToken:
+		return m.RefreshToken()
+	case servicetoken.FieldIssuedAt:
+		return m.IssuedAt()
+	case servicetoken.FieldSecretGeneration:
+		return m.SecretGeneration()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ServiceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case servicetoken.FieldToken:
+		return m.OldToken(ctx)
+	case servicetoken.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case servicetoken.FieldIssuedAt:
+		return m.OldIssuedAt(ctx)
+	case servicetoken.FieldSecretGeneration:
+		return m.OldSecretGeneration(ctx)
+	}
+	return nil, fmt.Errorf("unknown ServiceToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case servicetoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case servicetoken.FieldRefreshToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	case servicetoken.FieldIssuedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedAt(v)
+		return nil
+	case servicetoken.FieldSecretGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretGeneration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ServiceTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addissued_at != nil {
+		fields = append(fields, servicetoken.FieldIssuedAt)
+	}
+	if m.addsecret_generation != nil {
+		fields = append(fields, servicetoken.FieldSecretGeneration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ServiceTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case servicetoken.FieldIssuedAt:
+		return m.AddedIssuedAt()
+	case servicetoken.FieldSecretGeneration:
+		return m.AddedSecretGeneration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case servicetoken.FieldIssuedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuedAt(v)
+		return nil
+	case servicetoken.FieldSecretGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSecretGeneration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ServiceTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ServiceTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ServiceTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ServiceToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ServiceTokenMutation) ResetField(name string) error {
+	switch name {
+	case servicetoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case servicetoken.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	case servicetoken.FieldIssuedAt:
+		m.ResetIssuedAt()
+		return nil
+	case servicetoken.FieldSecretGeneration:
+		m.ResetSecretGeneration()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ServiceTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._TokenToServiceAccount != nil {
+		edges = append(edges, servicetoken.EdgeTokenToServiceAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ServiceTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case servicetoken.EdgeTokenToServiceAccount:
+		if id := m._TokenToServiceAccount; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ServiceTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ServiceTokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ServiceTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_TokenToServiceAccount {
+		edges = append(edges, servicetoken.EdgeTokenToServiceAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ServiceTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case servicetoken.EdgeTokenToServiceAccount:
+		return m.cleared_TokenToServiceAccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ServiceTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case servicetoken.EdgeTokenToServiceAccount:
+		m.ClearTokenToServiceAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ServiceTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case servicetoken.EdgeTokenToServiceAccount:
+		m.ResetTokenToServiceAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ServiceToken edge %s", name)
+}
+
+// SigningKeyMutation represents an operation that mutates the SigningKey nodes in the graph.
+type SigningKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	algorithm     *signingkey.Algorithm
+	private_key   *string
+	public_key    *string
+	created_at    *time.Time
+	retired_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SigningKey, error)
+	predicates    []predicate.SigningKey
+}
+
+var _ ent.Mutation = (*SigningKeyMutation)(nil)
+
+// signingkeyOption allows management of the mutation configuration using functional options.
+type signingkeyOption func(*SigningKeyMutation)
+
+// newSigningKeyMutation creates new mutation for the SigningKey entity.
+func newSigningKeyMutation(c config, op Op, opts ...signingkeyOption) *SigningKeyMutation {
+	m := &SigningKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSigningKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSigningKeyID sets the ID field of the mutation.
+func withSigningKeyID(id uuid.UUID) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SigningKey
+		)
+		m.oldValue = func(ctx context.Context) (*SigningKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SigningKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSigningKey sets the old SigningKey of the mutation.
+func withSigningKey(node *SigningKey) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		m.oldValue = func(context.Context) (*SigningKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SigningKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SigningKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SigningKey entities.
+func (m *SigningKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SigningKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SigningKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SigningKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *SigningKeyMutation) SetAlgorithm(s signingkey.Algorithm) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SigningKeyMutation) Algorithm() (r signingkey.Algorithm, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldAlgorithm(ctx context.Context) (v signingkey.Algorithm, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SigningKeyMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *SigningKeyMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SigningKeyMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SigningKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SigningKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SigningKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SigningKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SigningKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SigningKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SigningKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (m *SigningKeyMutation) SetRetiredAt(t time.Time) {
+	m.retired_at = &t
+}
+
+// RetiredAt returns the value of the "retired_at" field in the mutation.
+func (m *SigningKeyMutation) RetiredAt() (r time.Time, exists bool) {
+	v := m.retired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredAt returns the old "retired_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldRetiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredAt: %w", err)
+	}
+	return oldValue.RetiredAt, nil
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (m *SigningKeyMutation) ClearRetiredAt() {
+	m.retired_at = nil
+	m.clearedFields[signingkey.FieldRetiredAt] = struct{}{}
+}
+
+// RetiredAtCleared returns if the "retired_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) RetiredAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldRetiredAt]
+	return ok
+}
+
+// ResetRetiredAt resets all changes to the "retired_at" field.
+func (m *SigningKeyMutation) ResetRetiredAt() {
+	m.retired_at = nil
+	delete(m.clearedFields, signingkey.FieldRetiredAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SigningKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SigningKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SigningKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[signingkey.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SigningKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, signingkey.FieldExpiresAt)
+}
+
+// Where appends a list predicates to the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Where(ps ...predicate.SigningKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SigningKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (SigningKey).
+func (m *SigningKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SigningKeyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.algorithm != nil {
+		fields = append(fields, signingkey.FieldAlgorithm)
+	}
+	if m.private_key != nil {
+		fields = append(fields, signingkey.FieldPrivateKey)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signingkey.FieldPublicKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, signingkey.FieldCreatedAt)
+	}
+	if m.retired_at != nil {
+		fields = append(fields, signingkey.FieldRetiredAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, signingkey.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SigningKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		return m.Algorithm()
+	case signingkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case signingkey.FieldPublicKey:
+		return m.PublicKey()
+	case signingkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case signingkey.FieldRetiredAt:
+		return m.RetiredAt()
+	case signingkey.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SigningKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case signingkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case signingkey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signingkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case signingkey.FieldRetiredAt:
+		return m.OldRetiredAt(ctx)
+	case signingkey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SigningKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SigningKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		v, ok := value.(signingkey.Algorithm)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case signingkey.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case signingkey.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case signingkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case signingkey.FieldRetiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredAt(v)
+		return nil
+	case signingkey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SigningKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SigningKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SigningKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SigningKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SigningKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SigningKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(signingkey.FieldRetiredAt) {
+		fields = append(fields, signingkey.FieldRetiredAt)
+	}
+	if m.FieldCleared(signingkey.FieldExpiresAt) {
+		fields = append(fields, signingkey.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SigningKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ClearField(name string) error {
+	switch name {
+	case signingkey.FieldRetiredAt:
+		m.ClearRetiredAt()
+		return nil
+	case signingkey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SigningKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ResetField(name string) error {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case signingkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case signingkey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case signingkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case signingkey.FieldRetiredAt:
+		m.ResetRetiredAt()
+		return nil
+	case signingkey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SigningKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SigningKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SigningKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SigningKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SigningKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SigningKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SigningKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SigningKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SigningKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	team_number               *int
+	addteam_number            *int
+	name                      *string
+	allowed_cidrs             *[]string
+	clearedFields             map[string]struct{}
+	_TeamToCompetition        *uuid.UUID
+	cleared_TeamToCompetition bool
+	_TeamToVmObjects          map[uuid.UUID]struct{}
+	removed_TeamToVmObjects   map[uuid.UUID]struct{}
+	cleared_TeamToVmObjects   bool
+	_TeamToUsers              map[uuid.UUID]struct{}
+	removed_TeamToUsers       map[uuid.UUID]struct{}
+	cleared_TeamToUsers       bool
+	_TeamToMemberships        map[uuid.UUID]struct{}
+	removed_TeamToMemberships map[uuid.UUID]struct{}
+	cleared_TeamToMemberships bool
+	done                      bool
+	oldValue                  func(context.Context) (*Team, error)
+	predicates                []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTeamNumber sets the "team_number" field.
+func (m *TeamMutation) SetTeamNumber(i int) {
+	m.team_number = &i
+	m.addteam_number = nil
+}
+
+// TeamNumber returns the value of the "team_number" field in the mutation.
+func (m *TeamMutation) TeamNumber() (r int, exists bool) {
+	v := m.team_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamNumber returns the old "team_number" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldTeamNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamNumber: %w", err)
+	}
+	return oldValue.TeamNumber, nil
+}
+
+// AddTeamNumber adds i to the "team_number" field.
+func (m *TeamMutation) AddTeamNumber(i int) {
+	if m.addteam_number != nil {
+		*m.addteam_number += i
+	} else {
+		m.addteam_number = &i
+	}
+}
+
+// AddedTeamNumber returns the value that was added to the "team_number" field in this mutation.
+func (m *TeamMutation) AddedTeamNumber() (r int, exists bool) {
+	v := m.addteam_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeamNumber resets all changes to the "team_number" field.
+func (m *TeamMutation) ResetTeamNumber() {
+	m.team_number = nil
+	m.addteam_number = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *TeamMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[team.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *TeamMutation) NameCleared() bool {
+	_, ok := m.clearedFields[team.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, team.FieldName)
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (m *TeamMutation) SetAllowedCidrs(s []string) {
+	m.allowed_cidrs = &s
+}
+
+// AllowedCidrs returns the value of the "allowed_cidrs" field in the mutation.
+func (m *TeamMutation) AllowedCidrs() (r []string, exists bool) {
+	v := m.allowed_cidrs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedCidrs returns the old "allowed_cidrs" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldAllowedCidrs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedCidrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedCidrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedCidrs: %w", err)
+	}
+	return oldValue.AllowedCidrs, nil
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (m *TeamMutation) ClearAllowedCidrs() {
+	m.allowed_cidrs = nil
+	m.clearedFields[team.FieldAllowedCidrs] = struct{}{}
+}
+
+// AllowedCidrsCleared returns if the "allowed_cidrs" field was cleared in this mutation.
+func (m *TeamMutation) AllowedCidrsCleared() bool {
+	_, ok := m.clearedFields[team.FieldAllowedCidrs]
+	return ok
+}
+
+// ResetAllowedCidrs resets all changes to the "allowed_cidrs" field.
+func (m *TeamMutation) ResetAllowedCidrs() {
+	m.allowed_cidrs = nil
+	delete(m.clearedFields, team.FieldAllowedCidrs)
+}
+
+// SetTeamToCompetitionID sets the "TeamToCompetition" edge to the Competition entity by id.
+func (m *TeamMutation) SetTeamToCompetitionID(id uuid.UUID) {
+	m._TeamToCompetition = &id
+}
+
+// ClearTeamToCompetition clears the "TeamToCompetition" edge to the Competition entity.
+func (m *TeamMutation) ClearTeamToCompetition() {
+	m.cleared_TeamToCompetition = true
+}
+
+// TeamToCompetitionCleared reports if the "TeamToCompetition" edge to the Competition entity was cleared.
+func (m *TeamMutation) TeamToCompetitionCleared() bool {
+	return m.cleared_TeamToCompetition
+}
+
+// TeamToCompetitionID returns the "TeamToCompetition" edge ID in the mutation.
+func (m *TeamMutation) TeamToCompetitionID() (id uuid.UUID, exists bool) {
+	if m._TeamToCompetition != nil {
+		return *m._TeamToCompetition, true
+	}
+	return
+}
+
+// TeamToCompetitionIDs returns the "TeamToCompetition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamToCompetitionID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TeamToCompetitionIDs() (ids []uuid.UUID) {
+	if id := m._TeamToCompetition; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeamToCompetition resets all changes to the "TeamToCompetition" edge.
+func (m *TeamMutation) ResetTeamToCompetition() {
+	m._TeamToCompetition = nil
+	m.cleared_TeamToCompetition = false
+}
+
+// AddTeamToVmObjectIDs adds the "TeamToVmObjects" edge to the VmObject entity by ids.
+func (m *TeamMutation) AddTeamToVmObjectIDs(ids ...uuid.UUID) {
+	if m._TeamToVmObjects == nil {
+		m._TeamToVmObjects = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._TeamToVmObjects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamToVmObjects clears the "TeamToVmObjects" edge to the VmObject entity.
+func (m *TeamMutation) ClearTeamToVmObjects() {
+	m.cleared_TeamToVmObjects = true
+}
+
+// TeamToVmObjectsCleared reports if the "TeamToVmObjects" edge to the VmObject entity was cleared.
+func (m *TeamMutation) TeamToVmObjectsCleared() bool {
+	return m.cleared_TeamToVmObjects
+}
+
+// RemoveTeamToVmObjectIDs removes the "TeamToVmObjects" edge to the VmObject entity by IDs.
+func (m *TeamMutation) RemoveTeamToVmObjectIDs(ids ...uuid.UUID) {
+	if m.removed_TeamToVmObjects == nil {
+		m.removed_TeamToVmObjects = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._TeamToVmObjects, ids[i])
+		m.removed_TeamToVmObjects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamToVmObjects returns the removed IDs of the "TeamToVmObjects" edge to the VmObject entity.
+func (m *TeamMutation) RemovedTeamToVmObjectsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_TeamToVmObjects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamToVmObjectsIDs returns the "TeamToVmObjects" edge IDs in the mutation.
+func (m *TeamMutation) TeamToVmObjectsIDs() (ids []uuid.UUID) {
+	for id := range m._TeamToVmObjects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamToVmObjects resets all changes to the "TeamToVmObjects" edge.
+func (m *TeamMutation) ResetTeamToVmObjects() {
+	m._TeamToVmObjects = nil
+	m.cleared_TeamToVmObjects = false
+	m.removed_TeamToVmObjects = nil
+}
+
+// AddTeamToUserIDs adds the "TeamToUsers" edge to the User entity by ids.
+func (m *TeamMutation) AddTeamToUserIDs(ids ...uuid.UUID) {
+	if m._TeamToUsers == nil {
+		m._TeamToUsers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._TeamToUsers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamToUsers clears the "TeamToUsers" edge to the User entity.
+func (m *TeamMutation) ClearTeamToUsers() {
+	m.cleared_TeamToUsers = true
+}
+
+// TeamToUsersCleared reports if the "TeamToUsers" edge to the User entity was cleared.
+func (m *TeamMutation) TeamToUsersCleared() bool {
+	return m.cleared_TeamToUsers
+}
+
+// RemoveTeamToUserIDs removes the "TeamToUsers" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveTeamToUserIDs(ids ...uuid.UUID) {
+	if m.removed_TeamToUsers == nil {
+		m.removed_TeamToUsers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._TeamToUsers, ids[i])
+		m.removed_TeamToUsers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamToUsers returns the removed IDs of the "TeamToUsers" edge to the User entity.
+func (m *TeamMutation) RemovedTeamToUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removed_TeamToUsers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamToUsersIDs returns the "TeamToUsers" edge IDs in the mutation.
+func (m *TeamMutation) TeamToUsersIDs() (ids []uuid.UUID) {
+	for id := range m._TeamToUsers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamToUsers resets all changes to the "TeamToUsers" edge.
+func (m *TeamMutation) ResetTeamToUsers() {
+	m._TeamToUsers = nil
+	m.cleared_TeamToUsers = false
+	m.removed_TeamToUsers = nil
+}
+
+// AddTeamToMembershipIDs adds the "TeamToMemberships" edge to the TeamMembership entity by ids.
+func (m *TeamMutation) AddTeamToMembershipIDs(ids ...uuid.UUID) {
+	if m._TeamToMemberships == nil {
+		m._TeamToMemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._TeamToMemberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamToMemberships clears the "TeamToMemberships" edge to the TeamMembership entity.
+func (m *TeamMutation) ClearTeamToMemberships() {
+	m.cleared_TeamToMemberships = true
+}
+
+// TeamToMembershipsCleared reports if the "TeamToMemberships" edge to the TeamMembership entity was cleared.
+func (m *TeamMutation) TeamToMembershipsCleared() bool {
+	return m.cleared_TeamToMemberships
+}
+
+// RemoveTeamToMembershipIDs removes the "TeamToMemberships" edge to the TeamMembership entity by IDs.
+func (m *TeamMutation) RemoveTeamToMembershipIDs(ids ...uuid.UUID) {
+	if m.removed_TeamToMemberships == nil {
+		m.removed_TeamToMemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._TeamToMemberships, ids[i])
+		m.removed_TeamToMemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamToMemberships returns the removed IDs of the "TeamToMemberships" edge to the TeamMembership entity.
+func (m *TeamMutation) RemovedTeamToMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_TeamToMemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamToMembershipsIDs returns the "TeamToMemberships" edge IDs in the mutation.
+func (m *TeamMutation) TeamToMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m._TeamToMemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamToMemberships resets all changes to the "TeamToMemberships" edge.
+func (m *TeamMutation) ResetTeamToMemberships() {
+	m._TeamToMemberships = nil
+	m.cleared_TeamToMemberships = false
+	m.removed_TeamToMemberships = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.team_number != nil {
+		fields = append(fields, team.FieldTeamNumber)
+	}
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.allowed_cidrs != nil {
+		fields = append(fields, team.FieldAllowedCidrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldTeamNumber:
+		return m.TeamNumber()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldAllowedCidrs:
+		return m.AllowedCidrs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldTeamNumber:
+		return m.OldTeamNumber(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldAllowedCidrs:
+		return m.OldAllowedCidrs(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldTeamNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamNumber(v)
+		return nil
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldAllowedCidrs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedCidrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	var fields []string
+	if m.addteam_number != nil {
+		fields = append(fields, team.FieldTeamNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldTeamNumber:
+		return m.AddedTeamNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldTeamNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTeamNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldName) {
+		fields = append(fields, team.FieldName)
+	}
+	if m.FieldCleared(team.FieldAllowedCidrs) {
+		fields = append(fields, team.FieldAllowedCidrs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldName:
+		m.ClearName()
+		return nil
+	case team.FieldAllowedCidrs:
+		m.ClearAllowedCidrs()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldTeamNumber:
+		m.ResetTeamNumber()
+		return nil
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldAllowedCidrs:
+		m.ResetAllowedCidrs()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._TeamToCompetition != nil {
+		edges = append(edges, team.EdgeTeamToCompetition)
+	}
+	if m._TeamToVmObjects != nil {
+		edges = append(edges, team.EdgeTeamToVmObjects)
+	}
+	if m._TeamToUsers != nil {
+		edges = append(edges, team.EdgeTeamToUsers)
+	}
+	if m._TeamToMemberships != nil {
+		edges = append(edges, team.EdgeTeamToMemberships)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeTeamToCompetition:
+		if id := m._TeamToCompetition; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeTeamToVmObjects:
+		ids := make([]ent.Value, 0, len(m._TeamToVmObjects))
+		for id := range m._TeamToVmObjects {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamToUsers:
+		ids := make([]ent.Value, 0, len(m._TeamToUsers))
+		for id := range m._TeamToUsers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamToMemberships:
+		ids := make([]ent.Value, 0, len(m._TeamToMemberships))
+		for id := range m._TeamToMemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removed_TeamToVmObjects != nil {
+		edges = append(edges, team.EdgeTeamToVmObjects)
+	}
+	if m.removed_TeamToUsers != nil {
+		edges = append(edges, team.EdgeTeamToUsers)
+	}
+	if m.removed_TeamToMemberships != nil {
+		edges = append(edges, team.EdgeTeamToMemberships)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeTeamToVmObjects:
+		ids := make([]ent.Value, 0, len(m.removed_TeamToVmObjects))
+		for id := range m.removed_TeamToVmObjects {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamToUsers:
+		ids := make([]ent.Value, 0, len(m.removed_TeamToUsers))
+		for id := range m.removed_TeamToUsers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamToMemberships:
+		ids := make([]ent.Value, 0, len(m.removed_TeamToMemberships))
+		for id := range m.removed_TeamToMemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_TeamToCompetition {
+		edges = append(edges, team.EdgeTeamToCompetition)
+	}
+	if m.cleared_TeamToVmObjects {
+		edges = append(edges, team.EdgeTeamToVmObjects)
+	}
+	if m.cleared_TeamToUsers {
+		edges = append(edges, team.EdgeTeamToUsers)
+	}
+	if m.cleared_TeamToMemberships {
+		edges = append(edges, team.EdgeTeamToMemberships)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeTeamToCompetition:
+		return m.cleared_TeamToCompetition
+	case team.EdgeTeamToVmObjects:
+		return m.cleared_TeamToVmObjects
+	case team.EdgeTeamToUsers:
+		return m.cleared_TeamToUsers
+	case team.EdgeTeamToMemberships:
+		return m.cleared_TeamToMemberships
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	case team.EdgeTeamToCompetition:
+		m.ClearTeamToCompetition()
+		return nil
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeTeamToCompetition:
+		m.ResetTeamToCompetition()
+		return nil
+	case team.EdgeTeamToVmObjects:
+		m.ResetTeamToVmObjects()
+		return nil
+	case team.EdgeTeamToUsers:
+		m.ResetTeamToUsers()
+		return nil
+	case team.EdgeTeamToMemberships:
+		m.ResetTeamToMemberships()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TeamMembershipMutation represents an operation that mutates the TeamMembership nodes in the graph.
+type TeamMembershipMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	role                         *teammembership.Role
+	clearedFields                map[string]struct{}
+	_TeamMembershipToUser        *uuid.UUID
+	cleared_TeamMembershipToUser bool
+	_TeamMembershipToTeam        *uuid.UUID
+	cleared_TeamMembershipToTeam bool
+	done                         bool
+	oldValue                     func(context.Context) (*TeamMembership, error)
+	predicates                   []predicate.TeamMembership
+}
+
+var _ ent.Mutation = (*TeamMembershipMutation)(nil)
+
+// teammembershipOption allows management of the mutation configuration using functional options.
+type teammembershipOption func(*TeamMembershipMutation)
+
+// newTeamMembershipMutation creates new mutation for the TeamMembership entity.
+func newTeamMembershipMutation(c config, op Op, opts ...teammembershipOption) *TeamMembershipMutation {
+	m := &TeamMembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamMembershipID sets the ID field of the mutation.
+func withTeamMembershipID(id uuid.UUID) teammembershipOption {
+	return func(m *TeamMembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamMembership
+		)
+		m.oldValue = func(ctx context.Context) (*TeamMembership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamMembership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamMembership sets the old TeamMembership of the mutation.
+func withTeamMembership(node *TeamMembership) teammembershipOption {
+	return func(m *TeamMembershipMutation) {
+		m.oldValue = func(context.Context) (*TeamMembership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamMembership entities.
+func (m *TeamMembershipMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMembershipMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMembershipMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamMembership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *TeamMembershipMutation) SetRole(t teammembership.Role) {
+	m.role = &t
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *TeamMembershipMutation) Role() (r teammembership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the TeamMembership entity.
+// If the TeamMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMembershipMutation) OldRole(ctx context.Context) (v teammembership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *TeamMembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetTeamMembershipToUserID sets the "TeamMembershipToUser" edge to the User entity by id.
+func (m *TeamMembershipMutation) SetTeamMembershipToUserID(id uuid.UUID) {
+	m._TeamMembershipToUser = &id
+}
+
+// ClearTeamMembershipToUser clears the "TeamMembershipToUser" edge to the User entity.
+func (m *TeamMembershipMutation) ClearTeamMembershipToUser() {
+	m.cleared_TeamMembershipToUser = true
+}
+
+// TeamMembershipToUserCleared reports if the "TeamMembershipToUser" edge to the User entity was cleared.
+func (m *TeamMembershipMutation) TeamMembershipToUserCleared() bool {
+	return m.cleared_TeamMembershipToUser
+}
+
+// TeamMembershipToUserID returns the "TeamMembershipToUser" edge ID in the mutation.
+func (m *TeamMembershipMutation) TeamMembershipToUserID() (id uuid.UUID, exists bool) {
+	if m._TeamMembershipToUser != nil {
+		return *m._TeamMembershipToUser, true
+	}
+	return
+}
+
+// TeamMembershipToUserIDs returns the "TeamMembershipToUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamMembershipToUserID instead. It exists only for internal usage by the builders.
+func (m *TeamMembershipMutation) TeamMembershipToUserIDs() (ids []uuid.UUID) {
+	if id := m._TeamMembershipToUser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeamMembershipToUser resets all changes to the "TeamMembershipToUser" edge.
+func (m *TeamMembershipMutation) ResetTeamMembershipToUser() {
+	m._TeamMembershipToUser = nil
+	m.cleared_TeamMembershipToUser = false
+}
+
+// SetTeamMembershipToTeamID sets the "TeamMembershipToTeam" edge to the Team entity by id.
+func (m *TeamMembershipMutation) SetTeamMembershipToTeamID(id uuid.UUID) {
+	m._TeamMembershipToTeam = &id
+}
+
+// ClearTeamMembershipToTeam clears the "TeamMembershipToTeam" edge to the Team entity.
+func (m *TeamMembershipMutation) ClearTeamMembershipToTeam() {
+	m.cleared_TeamMembershipToTeam = true
+}
+
+// TeamMembershipToTeamCleared reports if the "TeamMembershipToTeam" edge to the Team entity was cleared.
+func (m *TeamMembershipMutation) TeamMembershipToTeamCleared() bool {
+	return m.cleared_TeamMembershipToTeam
+}
+
+// TeamMembershipToTeamID returns the "TeamMembershipToTeam" edge ID in the mutation.
+func (m *TeamMembershipMutation) TeamMembershipToTeamID() (id uuid.UUID, exists bool) {
+	if m._TeamMembershipToTeam != nil {
+		return *m._TeamMembershipToTeam, true
+	}
+	return
+}
+
+// TeamMembershipToTeamIDs returns the "TeamMembershipToTeam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamMembershipToTeamID instead. It exists only for internal usage by the builders.
+func (m *TeamMembershipMutation) TeamMembershipToTeamIDs() (ids []uuid.UUID) {
+	if id := m._TeamMembershipToTeam; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeamMembershipToTeam resets all changes to the "TeamMembershipToTeam" edge.
+func (m *TeamMembershipMutation) ResetTeamMembershipToTeam() {
+	m._TeamMembershipToTeam = nil
+	m.cleared_TeamMembershipToTeam = false
+}
+
+// Where appends a list predicates to the TeamMembershipMutation builder.
+func (m *TeamMembershipMutation) Where(ps ...predicate.TeamMembership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TeamMembershipMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (TeamMembership).
+func (m *TeamMembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMembershipMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.role != nil {
+		fields = append(fields, teammembership.FieldRole)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teammembership.FieldRole:
+		return m.Role()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teammembership.FieldRole:
+		return m.OldRole(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamMembership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teammembership.FieldRole:
+		v, ok := value.(teammembership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMembership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMembershipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMembershipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TeamMembership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamMembership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMembershipMutation) ResetField(name string) error {
+	switch name {
+	case teammembership.FieldRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMembership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._TeamMembershipToUser != nil {
+		edges = append(edges, teammembership.EdgeTeamMembershipToUser)
+	}
+	if m._TeamMembershipToTeam != nil {
+		edges = append(edges, teammembership.EdgeTeamMembershipToTeam)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case teammembership.EdgeTeamMembershipToUser:
+		if id := m._TeamMembershipToUser; id != nil {
+			return []ent.Value{*id}
+		}
+	case teammembership.EdgeTeamMembershipToTeam:
+		if id := m._TeamMembershipToTeam; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMembershipMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_TeamMembershipToUser {
+		edges = append(edges, teammembership.EdgeTeamMembershipToUser)
+	}
+	if m.cleared_TeamMembershipToTeam {
+		edges = append(edges, teammembership.EdgeTeamMembershipToTeam)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case teammembership.EdgeTeamMembershipToUser:
+		return m.cleared_TeamMembershipToUser
+	case teammembership.EdgeTeamMembershipToTeam:
+		return m.cleared_TeamMembershipToTeam
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case teammembership.EdgeTeamMembershipToUser:
+		m.ClearTeamMembershipToUser()
+		return nil
+	case teammembership.EdgeTeamMembershipToTeam:
+		m.ClearTeamMembershipToTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMembership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case teammembership.EdgeTeamMembershipToUser:
+		m.ResetTeamMembershipToUser()
+		return nil
+	case teammembership.EdgeTeamMembershipToTeam:
+		m.ResetTeamMembershipToTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMembership edge %s", name)
+}
+
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uuid.UUID
+	token                           *string
+	expire_at                       *int64
+	addexpire_at                    *int64
+	created_at                      *time.Time
+	last_used_at                    *time.Time
+	ip_address                      *string
+	user_agent                      *string
+	method                          *string
+	clearedFields                   map[string]struct{}
+	_TokenToUser                    *uuid.UUID
+	cleared_TokenToUser             bool
+	_TokenToImpersonatedUser        *uuid.UUID
+	cleared_TokenToImpersonatedUser bool
+	done                            bool
+	oldValue                        func(context.Context) (*Token, error)
+	predicates                      []predicate.Token
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
+
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id uuid.UUID) tokenOption {
+	return func(m *TokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Token
+		)
+		m.oldValue = func(ctx context.Context) (*Token, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Token.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *TokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *TokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *TokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (m *TokenMutation) SetExpireAt(i int64) {
+	m.expire_at = &i
+	m.addexpire_at = nil
+}
+
+// ExpireAt returns the value of the "expire_at" field in the mutation.
+func (m *TokenMutation) ExpireAt() (r int64, exists bool) {
+	v := m.expire_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpireAt returns the old "expire_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldExpireAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireAt: %w", err)
+	}
+	return oldValue.ExpireAt, nil
 }
 
 // AddExpireAt adds i to the "expire_at" field.
 func (m *TokenMutation) AddExpireAt(i int64) {
 	if m.addexpire_at != nil {
-		*m.addexpire_at += i
+		*m.addexpire_at += i
+	} else {
+		m.addexpire_at = &i
+	}
+}
+
+// AddedExpireAt returns the value that was added to the "expire_at" field in this mutation.
+func (m *TokenMutation) AddedExpireAt() (r int64, exists bool) {
+	v := m.addexpire_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpireAt resets all changes to the "expire_at" field.
+func (m *TokenMutation) ResetExpireAt() {
+	m.expire_at = nil
+	m.addexpire_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *TokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *TokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *TokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[token.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *TokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *TokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, token.FieldLastUsedAt)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *TokenMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *TokenMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *TokenMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[token.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *TokenMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[token.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *TokenMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, token.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *TokenMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *TokenMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *TokenMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[token.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *TokenMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[token.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *TokenMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, token.FieldUserAgent)
+}
+
+// SetMethod sets the "method" field.
+func (m *TokenMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *TokenMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ClearMethod clears the value of the "method" field.
+func (m *TokenMutation) ClearMethod() {
+	m.method = nil
+	m.clearedFields[token.FieldMethod] = struct{}{}
+}
+
+// MethodCleared returns if the "method" field was cleared in this mutation.
+func (m *TokenMutation) MethodCleared() bool {
+	_, ok := m.clearedFields[token.FieldMethod]
+	return ok
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *TokenMutation) ResetMethod() {
+	m.method = nil
+	delete(m.clearedFields, token.FieldMethod)
+}
+
+// SetTokenToUserID sets the "TokenToUser" edge to the User entity by id.
+func (m *TokenMutation) SetTokenToUserID(id uuid.UUID) {
+	m._TokenToUser = &id
+}
+
+// ClearTokenToUser clears the "TokenToUser" edge to the User entity.
+func (m *TokenMutation) ClearTokenToUser() {
+	m.cleared_TokenToUser = true
+}
+
+// TokenToUserCleared reports if the "TokenToUser" edge to the User entity was cleared.
+func (m *TokenMutation) TokenToUserCleared() bool {
+	return m.cleared_TokenToUser
+}
+
+// TokenToUserID returns the "TokenToUser" edge ID in the mutation.
+func (m *TokenMutation) TokenToUserID() (id uuid.UUID, exists bool) {
+	if m._TokenToUser != nil {
+		return *m._TokenToUser, true
+	}
+	return
+}
+
+// TokenToUserIDs returns the "TokenToUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenToUserID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) TokenToUserIDs() (ids []uuid.UUID) {
+	if id := m._TokenToUser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTokenToUser resets all changes to the "TokenToUser" edge.
+func (m *TokenMutation) ResetTokenToUser() {
+	m._TokenToUser = nil
+	m.cleared_TokenToUser = false
+}
+
+// SetTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by id.
+func (m *TokenMutation) SetTokenToImpersonatedUserID(id uuid.UUID) {
+	m._TokenToImpersonatedUser = &id
+}
+
+// ClearTokenToImpersonatedUser clears the "TokenToImpersonatedUser" edge to the User entity.
+func (m *TokenMutation) ClearTokenToImpersonatedUser() {
+	m.cleared_TokenToImpersonatedUser = true
+}
+
+// TokenToImpersonatedUserCleared reports if the "TokenToImpersonatedUser" edge to the User entity was cleared.
+func (m *TokenMutation) TokenToImpersonatedUserCleared() bool {
+	return m.cleared_TokenToImpersonatedUser
+}
+
+// TokenToImpersonatedUserID returns the "TokenToImpersonatedUser" edge ID in the mutation.
+func (m *TokenMutation) TokenToImpersonatedUserID() (id uuid.UUID, exists bool) {
+	if m._TokenToImpersonatedUser != nil {
+		return *m._TokenToImpersonatedUser, true
+	}
+	return
+}
+
+// TokenToImpersonatedUserIDs returns the "TokenToImpersonatedUser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenToImpersonatedUserID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) TokenToImpersonatedUserIDs() (ids []uuid.UUID) {
+	if id := m._TokenToImpersonatedUser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTokenToImpersonatedUser resets all changes to the "TokenToImpersonatedUser" edge.
+func (m *TokenMutation) ResetTokenToImpersonatedUser() {
+	m._TokenToImpersonatedUser = nil
+	m.cleared_TokenToImpersonatedUser = false
+}
+
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.token != nil {
+		fields = append(fields, token.FieldToken)
+	}
+	if m.expire_at != nil {
+		fields = append(fields, token.FieldExpireAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, token.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, token.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, token.FieldUserAgent)
+	}
+	if m.method != nil {
+		fields = append(fields, token.FieldMethod)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldToken:
+		return m.Token()
+	case token.FieldExpireAt:
+		return m.ExpireAt()
+	case token.FieldCreatedAt:
+		return m.CreatedAt()
+	case token.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case token.FieldIPAddress:
+		return m.IPAddress()
+	case token.FieldUserAgent:
+		return m.UserAgent()
+	case token.FieldMethod:
+		return m.Method()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case token.FieldToken:
+		return m.OldToken(ctx)
+	case token.FieldExpireAt:
+		return m.OldExpireAt(ctx)
+	case token.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case token.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case token.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case token.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case token.FieldMethod:
+		return m.OldMethod(ctx)
+	}
+	return nil, fmt.Errorf("unknown Token field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case token.FieldExpireAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpireAt(v)
+		return nil
+	case token.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case token.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case token.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case token.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case token.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpire_at != nil {
+		fields = append(fields, token.FieldExpireAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldExpireAt:
+		return m.AddedExpireAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldExpireAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpireAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldLastUsedAt) {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.FieldCleared(token.FieldIPAddress) {
+		fields = append(fields, token.FieldIPAddress)
+	}
+	if m.FieldCleared(token.FieldUserAgent) {
+		fields = append(fields, token.FieldUserAgent)
+	}
+	if m.FieldCleared(token.FieldMethod) {
+		fields = append(fields, token.FieldMethod)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case token.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case token.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case token.FieldMethod:
+		m.ClearMethod()
+		return nil
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldToken:
+		m.ResetToken()
+		return nil
+	case token.FieldExpireAt:
+		m.ResetExpireAt()
+		return nil
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case token.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case token.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case token.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case token.FieldMethod:
+		m.ResetMethod()
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._TokenToUser != nil {
+		edges = append(edges, token.EdgeTokenToUser)
+	}
+	if m._TokenToImpersonatedUser != nil {
+		edges = append(edges, token.EdgeTokenToImpersonatedUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case token.EdgeTokenToUser:
+		if id := m._TokenToUser; id != nil {
+			return []ent.Value{*id}
+		}
+	case token.EdgeTokenToImpersonatedUser:
+		if id := m._TokenToImpersonatedUser; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_TokenToUser {
+		edges = append(edges, token.EdgeTokenToUser)
+	}
+	if m.cleared_TokenToImpersonatedUser {
+		edges = append(edges, token.EdgeTokenToImpersonatedUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case token.EdgeTokenToUser:
+		return m.cleared_TokenToUser
+	case token.EdgeTokenToImpersonatedUser:
+		return m.cleared_TokenToImpersonatedUser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	switch name {
+	case token.EdgeTokenToUser:
+		m.ClearTokenToUser()
+		return nil
+	case token.EdgeTokenToImpersonatedUser:
+		m.ClearTokenToImpersonatedUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Token unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	switch name {
+	case token.EdgeTokenToUser:
+		m.ResetTokenToUser()
+		return nil
+	case token.EdgeTokenToImpersonatedUser:
+		m.ResetTokenToImpersonatedUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Token edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                                 Op
+	typ                                string
+	id                                 *uuid.UUID
+	username                           *string
+	password                           *string
+	first_name                         *string
+	last_name                          *string
+	role                               *user.Role
+	provider                           *user.Provider
+	external_issuer                    *string
+	external_subject                   *string
+	totp_secret                        *string
+	totp_enabled                       *bool
+	totp_last_counter                  *int64
+	addtotp_last_counter               *int64
+	totp_recovery_codes                *[]string
+	must_change_password               *bool
+	clearedFields                      map[string]struct{}
+	_UserToTeam                        *uuid.UUID
+	cleared_UserToTeam                 bool
+	_UserToTeamMemberships             map[uuid.UUID]struct{}
+	removed_UserToTeamMemberships      map[uuid.UUID]struct{}
+	cleared_UserToTeamMemberships      bool
+	_UserToCustomRole                  *uuid.UUID
+	cleared_UserToCustomRole           bool
+	_UserToAdminCompetitions           map[uuid.UUID]struct{}
+	removed_UserToAdminCompetitions    map[uuid.UUID]struct{}
+	cleared_UserToAdminCompetitions    bool
+	_UserToToken                       map[uuid.UUID]struct{}
+	removed_UserToToken                map[uuid.UUID]struct{}
+	cleared_UserToToken                bool
+	_UserToActions                     map[uuid.UUID]struct{}
+	removed_UserToActions              map[uuid.UUID]struct{}
+	cleared_UserToActions              bool
+	_UserToConsoleSessions             map[uuid.UUID]struct{}
+	removed_UserToConsoleSessions      map[uuid.UUID]struct{}
+	cleared_UserToConsoleSessions      bool
+	_UserToConsoleShares               map[uuid.UUID]struct{}
+	removed_UserToConsoleShares        map[uuid.UUID]struct{}
+	cleared_UserToConsoleShares        bool
+	_UserToWebauthnCredentials         map[uuid.UUID]struct{}
+	removed_UserToWebauthnCredentials  map[uuid.UUID]struct{}
+	cleared_UserToWebauthnCredentials  bool
+	_UserToPersonalAccessTokens        map[uuid.UUID]struct{}
+	removed_UserToPersonalAccessTokens map[uuid.UUID]struct{}
+	cleared_UserToPersonalAccessTokens bool
+	done                               bool
+	oldValue                           func(context.Context) (*User, error)
+	predicates                         []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *UserMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *UserMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *UserMutation) ResetFirstName() {
+	m.first_name = nil
+}
+
+// SetLastName sets the "last_name" field.
+func (m *UserMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *UserMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *UserMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *UserMutation) SetProvider(u user.Provider) {
+	m.provider = &u
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UserMutation) Provider() (r user.Provider, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProvider(ctx context.Context) (v user.Provider, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *UserMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetExternalIssuer sets the "external_issuer" field.
+func (m *UserMutation) SetExternalIssuer(s string) {
+	m.external_issuer = &s
+}
+
+// ExternalIssuer returns the value of the "external_issuer" field in the mutation.
+func (m *UserMutation) ExternalIssuer() (r string, exists bool) {
+	v := m.external_issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalIssuer returns the old "external_issuer" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExternalIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalIssuer: %w", err)
+	}
+	return oldValue.ExternalIssuer, nil
+}
+
+// ClearExternalIssuer clears the value of the "external_issuer" field.
+func (m *UserMutation) ClearExternalIssuer() {
+	m.external_issuer = nil
+	m.clearedFields[user.FieldExternalIssuer] = struct{}{}
+}
+
+// ExternalIssuerCleared returns if the "external_issuer" field was cleared in this mutation.
+func (m *UserMutation) ExternalIssuerCleared() bool {
+	_, ok := m.clearedFields[user.FieldExternalIssuer]
+	return ok
+}
+
+// ResetExternalIssuer resets all changes to the "external_issuer" field.
+func (m *UserMutation) ResetExternalIssuer() {
+	m.external_issuer = nil
+	delete(m.clearedFields, user.FieldExternalIssuer)
+}
+
+// SetExternalSubject sets the "external_subject" field.
+func (m *UserMutation) SetExternalSubject(s string) {
+	m.external_subject = &s
+}
+
+// ExternalSubject returns the value of the "external_subject" field in the mutation.
+func (m *UserMutation) ExternalSubject() (r string, exists bool) {
+	v := m.external_subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalSubject returns the old "external_subject" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExternalSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalSubject: %w", err)
+	}
+	return oldValue.ExternalSubject, nil
+}
+
+// ClearExternalSubject clears the value of the "external_subject" field.
+func (m *UserMutation) ClearExternalSubject() {
+	m.external_subject = nil
+	m.clearedFields[user.FieldExternalSubject] = struct{}{}
+}
+
+// ExternalSubjectCleared returns if the "external_subject" field was cleared in this mutation.
+func (m *UserMutation) ExternalSubjectCleared() bool {
+	_, ok := m.clearedFields[user.FieldExternalSubject]
+	return ok
+}
+
+// ResetExternalSubject resets all changes to the "external_subject" field.
+func (m *UserMutation) ResetExternalSubject() {
+	m.external_subject = nil
+	delete(m.clearedFields, user.FieldExternalSubject)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
+	}
+	return oldValue.TotpSecret, nil
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
+}
+
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
+}
+
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (m *UserMutation) SetTotpEnabled(b bool) {
+	m.totp_enabled = &b
+}
+
+// TotpEnabled returns the value of the "totp_enabled" field in the mutation.
+func (m *UserMutation) TotpEnabled() (r bool, exists bool) {
+	v := m.totp_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpEnabled returns the old "totp_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpEnabled: %w", err)
+	}
+	return oldValue.TotpEnabled, nil
+}
+
+// ResetTotpEnabled resets all changes to the "totp_enabled" field.
+func (m *UserMutation) ResetTotpEnabled() {
+	m.totp_enabled = nil
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (m *UserMutation) SetTotpLastCounter(i int64) {
+	m.totp_last_counter = &i
+	m.addtotp_last_counter = nil
+}
+
+// TotpLastCounter returns the value of the "totp_last_counter" field in the mutation.
+func (m *UserMutation) TotpLastCounter() (r int64, exists bool) {
+	v := m.totp_last_counter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpLastCounter returns the old "totp_last_counter" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpLastCounter(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpLastCounter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpLastCounter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpLastCounter: %w", err)
+	}
+	return oldValue.TotpLastCounter, nil
+}
+
+// AddTotpLastCounter adds i to the "totp_last_counter" field.
+func (m *UserMutation) AddTotpLastCounter(i int64) {
+	if m.addtotp_last_counter != nil {
+		*m.addtotp_last_counter += i
+	} else {
+		m.addtotp_last_counter = &i
+	}
+}
+
+// AddedTotpLastCounter returns the value that was added to the "totp_last_counter" field in this mutation.
+func (m *UserMutation) AddedTotpLastCounter() (r int64, exists bool) {
+	v := m.addtotp_last_counter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotpLastCounter resets all changes to the "totp_last_counter" field.
+func (m *UserMutation) ResetTotpLastCounter() {
+	m.totp_last_counter = nil
+	m.addtotp_last_counter = nil
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (m *UserMutation) SetTotpRecoveryCodes(s []string) {
+	m.totp_recovery_codes = &s
+}
+
+// TotpRecoveryCodes returns the value of the "totp_recovery_codes" field in the mutation.
+func (m *UserMutation) TotpRecoveryCodes() (r []string, exists bool) {
+	v := m.totp_recovery_codes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpRecoveryCodes returns the old "totp_recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpRecoveryCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpRecoveryCodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpRecoveryCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpRecoveryCodes: %w", err)
+	}
+	return oldValue.TotpRecoveryCodes, nil
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (m *UserMutation) ClearTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	m.clearedFields[user.FieldTotpRecoveryCodes] = struct{}{}
+}
+
+// TotpRecoveryCodesCleared returns if the "totp_recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) TotpRecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpRecoveryCodes]
+	return ok
+}
+
+// ResetTotpRecoveryCodes resets all changes to the "totp_recovery_codes" field.
+func (m *UserMutation) ResetTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	delete(m.clearedFields, user.FieldTotpRecoveryCodes)
+}
+
+// SetMustChangePassword sets the "must_change_password" field.
+func (m *UserMutation) SetMustChangePassword(b bool) {
+	m.must_change_password = &b
+}
+
+// MustChangePassword returns the value of the "must_change_password" field in the mutation.
+func (m *UserMutation) MustChangePassword() (r bool, exists bool) {
+	v := m.must_change_password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMustChangePassword returns the old "must_change_password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMustChangePassword(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMustChangePassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMustChangePassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMustChangePassword: %w", err)
+	}
+	return oldValue.MustChangePassword, nil
+}
+
+// ResetMustChangePassword resets all changes to the "must_change_password" field.
+func (m *UserMutation) ResetMustChangePassword() {
+	m.must_change_password = nil
+}
+
+// SetUserToTeamID sets the "UserToTeam" edge to the Team entity by id.
+func (m *UserMutation) SetUserToTeamID(id uuid.UUID) {
+	m._UserToTeam = &id
+}
+
+// ClearUserToTeam clears the "UserToTeam" edge to the Team entity.
+func (m *UserMutation) ClearUserToTeam() {
+	m.cleared_UserToTeam = true
+}
+
+// UserToTeamCleared reports if the "UserToTeam" edge to the Team entity was cleared.
+func (m *UserMutation) UserToTeamCleared() bool {
+	return m.cleared_UserToTeam
+}
+
+// UserToTeamID returns the "UserToTeam" edge ID in the mutation.
+func (m *UserMutation) UserToTeamID() (id uuid.UUID, exists bool) {
+	if m._UserToTeam != nil {
+		return *m._UserToTeam, true
+	}
+	return
+}
+
+// UserToTeamIDs returns the "UserToTeam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserToTeamID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) UserToTeamIDs() (ids []uuid.UUID) {
+	if id := m._UserToTeam; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUserToTeam resets all changes to the "UserToTeam" edge.
+func (m *UserMutation) ResetUserToTeam() {
+	m._UserToTeam = nil
+	m.cleared_UserToTeam = false
+}
+
+// AddUserToTeamMembershipIDs adds the "UserToTeamMemberships" edge to the TeamMembership entity by ids.
+func (m *UserMutation) AddUserToTeamMembershipIDs(ids ...uuid.UUID) {
+	if m._UserToTeamMemberships == nil {
+		m._UserToTeamMemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToTeamMemberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToTeamMemberships clears the "UserToTeamMemberships" edge to the TeamMembership entity.
+func (m *UserMutation) ClearUserToTeamMemberships() {
+	m.cleared_UserToTeamMemberships = true
+}
+
+// UserToTeamMembershipsCleared reports if the "UserToTeamMemberships" edge to the TeamMembership entity was cleared.
+func (m *UserMutation) UserToTeamMembershipsCleared() bool {
+	return m.cleared_UserToTeamMemberships
+}
+
+// RemoveUserToTeamMembershipIDs removes the "UserToTeamMemberships" edge to the TeamMembership entity by IDs.
+func (m *UserMutation) RemoveUserToTeamMembershipIDs(ids ...uuid.UUID) {
+	if m.removed_UserToTeamMemberships == nil {
+		m.removed_UserToTeamMemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToTeamMemberships, ids[i])
+		m.removed_UserToTeamMemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToTeamMemberships returns the removed IDs of the "UserToTeamMemberships" edge to the TeamMembership entity.
+func (m *UserMutation) RemovedUserToTeamMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToTeamMemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToTeamMembershipsIDs returns the "UserToTeamMemberships" edge IDs in the mutation.
+func (m *UserMutation) UserToTeamMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m._UserToTeamMemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToTeamMemberships resets all changes to the "UserToTeamMemberships" edge.
+func (m *UserMutation) ResetUserToTeamMemberships() {
+	m._UserToTeamMemberships = nil
+	m.cleared_UserToTeamMemberships = false
+	m.removed_UserToTeamMemberships = nil
+}
+
+// SetUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by id.
+func (m *UserMutation) SetUserToCustomRoleID(id uuid.UUID) {
+	m._UserToCustomRole = &id
+}
+
+// ClearUserToCustomRole clears the "UserToCustomRole" edge to the CustomRole entity.
+func (m *UserMutation) ClearUserToCustomRole() {
+	m.cleared_UserToCustomRole = true
+}
+
+// UserToCustomRoleCleared reports if the "UserToCustomRole" edge to the CustomRole entity was cleared.
+func (m *UserMutation) UserToCustomRoleCleared() bool {
+	return m.cleared_UserToCustomRole
+}
+
+// UserToCustomRoleID returns the "UserToCustomRole" edge ID in the mutation.
+func (m *UserMutation) UserToCustomRoleID() (id uuid.UUID, exists bool) {
+	if m._UserToCustomRole != nil {
+		return *m._UserToCustomRole, true
+	}
+	return
+}
+
+// UserToCustomRoleIDs returns the "UserToCustomRole" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserToCustomRoleID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) UserToCustomRoleIDs() (ids []uuid.UUID) {
+	if id := m._UserToCustomRole; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUserToCustomRole resets all changes to the "UserToCustomRole" edge.
+func (m *UserMutation) ResetUserToCustomRole() {
+	m._UserToCustomRole = nil
+	m.cleared_UserToCustomRole = false
+}
+
+// AddUserToAdminCompetitionIDs adds the "UserToAdminCompetitions" edge to the Competition entity by ids.
+func (m *UserMutation) AddUserToAdminCompetitionIDs(ids ...uuid.UUID) {
+	if m._UserToAdminCompetitions == nil {
+		m._UserToAdminCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToAdminCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToAdminCompetitions clears the "UserToAdminCompetitions" edge to the Competition entity.
+func (m *UserMutation) ClearUserToAdminCompetitions() {
+	m.cleared_UserToAdminCompetitions = true
+}
+
+// UserToAdminCompetitionsCleared reports if the "UserToAdminCompetitions" edge to the Competition entity was cleared.
+func (m *UserMutation) UserToAdminCompetitionsCleared() bool {
+	return m.cleared_UserToAdminCompetitions
+}
+
+// RemoveUserToAdminCompetitionIDs removes the "UserToAdminCompetitions" edge to the Competition entity by IDs.
+func (m *UserMutation) RemoveUserToAdminCompetitionIDs(ids ...uuid.UUID) {
+	if m.removed_UserToAdminCompetitions == nil {
+		m.removed_UserToAdminCompetitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToAdminCompetitions, ids[i])
+		m.removed_UserToAdminCompetitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToAdminCompetitions returns the removed IDs of the "UserToAdminCompetitions" edge to the Competition entity.
+func (m *UserMutation) RemovedUserToAdminCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToAdminCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToAdminCompetitionsIDs returns the "UserToAdminCompetitions" edge IDs in the mutation.
+func (m *UserMutation) UserToAdminCompetitionsIDs() (ids []uuid.UUID) {
+	for id := range m._UserToAdminCompetitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToAdminCompetitions resets all changes to the "UserToAdminCompetitions" edge.
+func (m *UserMutation) ResetUserToAdminCompetitions() {
+	m._UserToAdminCompetitions = nil
+	m.cleared_UserToAdminCompetitions = false
+	m.removed_UserToAdminCompetitions = nil
+}
+
+// AddUserToTokenIDs adds the "UserToToken" edge to the Token entity by ids.
+func (m *UserMutation) AddUserToTokenIDs(ids ...uuid.UUID) {
+	if m._UserToToken == nil {
+		m._UserToToken = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToToken[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToToken clears the "UserToToken" edge to the Token entity.
+func (m *UserMutation) ClearUserToToken() {
+	m.cleared_UserToToken = true
+}
+
+// UserToTokenCleared reports if the "UserToToken" edge to the Token entity was cleared.
+func (m *UserMutation) UserToTokenCleared() bool {
+	return m.cleared_UserToToken
+}
+
+// RemoveUserToTokenIDs removes the "UserToToken" edge to the Token entity by IDs.
+func (m *UserMutation) RemoveUserToTokenIDs(ids ...uuid.UUID) {
+	if m.removed_UserToToken == nil {
+		m.removed_UserToToken = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToToken, ids[i])
+		m.removed_UserToToken[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToToken returns the removed IDs of the "UserToToken" edge to the Token entity.
+func (m *UserMutation) RemovedUserToTokenIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToToken {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToTokenIDs returns the "UserToToken" edge IDs in the mutation.
+func (m *UserMutation) UserToTokenIDs() (ids []uuid.UUID) {
+	for id := range m._UserToToken {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToToken resets all changes to the "UserToToken" edge.
+func (m *UserMutation) ResetUserToToken() {
+	m._UserToToken = nil
+	m.cleared_UserToToken = false
+	m.removed_UserToToken = nil
+}
+
+// AddUserToActionIDs adds the "UserToActions" edge to the Action entity by ids.
+func (m *UserMutation) AddUserToActionIDs(ids ...uuid.UUID) {
+	if m._UserToActions == nil {
+		m._UserToActions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToActions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToActions clears the "UserToActions" edge to the Action entity.
+func (m *UserMutation) ClearUserToActions() {
+	m.cleared_UserToActions = true
+}
+
+// UserToActionsCleared reports if the "UserToActions" edge to the Action entity was cleared.
+func (m *UserMutation) UserToActionsCleared() bool {
+	return m.cleared_UserToActions
+}
+
+// RemoveUserToActionIDs removes the "UserToActions" edge to the Action entity by IDs.
+func (m *UserMutation) RemoveUserToActionIDs(ids ...uuid.UUID) {
+	if m.removed_UserToActions == nil {
+		m.removed_UserToActions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToActions, ids[i])
+		m.removed_UserToActions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToActions returns the removed IDs of the "UserToActions" edge to the Action entity.
+func (m *UserMutation) RemovedUserToActionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToActions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToActionsIDs returns the "UserToActions" edge IDs in the mutation.
+func (m *UserMutation) UserToActionsIDs() (ids []uuid.UUID) {
+	for id := range m._UserToActions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToActions resets all changes to the "UserToActions" edge.
+func (m *UserMutation) ResetUserToActions() {
+	m._UserToActions = nil
+	m.cleared_UserToActions = false
+	m.removed_UserToActions = nil
+}
+
+// AddUserToConsoleSessionIDs adds the "UserToConsoleSessions" edge to the ConsoleSession entity by ids.
+func (m *UserMutation) AddUserToConsoleSessionIDs(ids ...uuid.UUID) {
+	if m._UserToConsoleSessions == nil {
+		m._UserToConsoleSessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToConsoleSessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToConsoleSessions clears the "UserToConsoleSessions" edge to the ConsoleSession entity.
+func (m *UserMutation) ClearUserToConsoleSessions() {
+	m.cleared_UserToConsoleSessions = true
+}
+
+// UserToConsoleSessionsCleared reports if the "UserToConsoleSessions" edge to the ConsoleSession entity was cleared.
+func (m *UserMutation) UserToConsoleSessionsCleared() bool {
+	return m.cleared_UserToConsoleSessions
+}
+
+// RemoveUserToConsoleSessionIDs removes the "UserToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (m *UserMutation) RemoveUserToConsoleSessionIDs(ids ...uuid.UUID) {
+	if m.removed_UserToConsoleSessions == nil {
+		m.removed_UserToConsoleSessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToConsoleSessions, ids[i])
+		m.removed_UserToConsoleSessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToConsoleSessions returns the removed IDs of the "UserToConsoleSessions" edge to the ConsoleSession entity.
+func (m *UserMutation) RemovedUserToConsoleSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToConsoleSessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToConsoleSessionsIDs returns the "UserToConsoleSessions" edge IDs in the mutation.
+func (m *UserMutation) UserToConsoleSessionsIDs() (ids []uuid.UUID) {
+	for id := range m._UserToConsoleSessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToConsoleSessions resets all changes to the "UserToConsoleSessions" edge.
+func (m *UserMutation) ResetUserToConsoleSessions() {
+	m._UserToConsoleSessions = nil
+	m.cleared_UserToConsoleSessions = false
+	m.removed_UserToConsoleSessions = nil
+}
+
+// AddUserToConsoleShareIDs adds the "UserToConsoleShares" edge to the ConsoleShare entity by ids.
+func (m *UserMutation) AddUserToConsoleShareIDs(ids ...uuid.UUID) {
+	if m._UserToConsoleShares == nil {
+		m._UserToConsoleShares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToConsoleShares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToConsoleShares clears the "UserToConsoleShares" edge to the ConsoleShare entity.
+func (m *UserMutation) ClearUserToConsoleShares() {
+	m.cleared_UserToConsoleShares = true
+}
+
+// UserToConsoleSharesCleared reports if the "UserToConsoleShares" edge to the ConsoleShare entity was cleared.
+func (m *UserMutation) UserToConsoleSharesCleared() bool {
+	return m.cleared_UserToConsoleShares
+}
+
+// RemoveUserToConsoleShareIDs removes the "UserToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (m *UserMutation) RemoveUserToConsoleShareIDs(ids ...uuid.UUID) {
+	if m.removed_UserToConsoleShares == nil {
+		m.removed_UserToConsoleShares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToConsoleShares, ids[i])
+		m.removed_UserToConsoleShares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToConsoleShares returns the removed IDs of the "UserToConsoleShares" edge to the ConsoleShare entity.
+func (m *UserMutation) RemovedUserToConsoleSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToConsoleShares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToConsoleSharesIDs returns the "UserToConsoleShares" edge IDs in the mutation.
+func (m *UserMutation) UserToConsoleSharesIDs() (ids []uuid.UUID) {
+	for id := range m._UserToConsoleShares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToConsoleShares resets all changes to the "UserToConsoleShares" edge.
+func (m *UserMutation) ResetUserToConsoleShares() {
+	m._UserToConsoleShares = nil
+	m.cleared_UserToConsoleShares = false
+	m.removed_UserToConsoleShares = nil
+}
+
+// AddUserToWebauthnCredentialIDs adds the "UserToWebauthnCredentials" edge to the WebauthnCredential entity by ids.
+func (m *UserMutation) AddUserToWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m._UserToWebauthnCredentials == nil {
+		m._UserToWebauthnCredentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToWebauthnCredentials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToWebauthnCredentials clears the "UserToWebauthnCredentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) ClearUserToWebauthnCredentials() {
+	m.cleared_UserToWebauthnCredentials = true
+}
+
+// UserToWebauthnCredentialsCleared reports if the "UserToWebauthnCredentials" edge to the WebauthnCredential entity was cleared.
+func (m *UserMutation) UserToWebauthnCredentialsCleared() bool {
+	return m.cleared_UserToWebauthnCredentials
+}
+
+// RemoveUserToWebauthnCredentialIDs removes the "UserToWebauthnCredentials" edge to the WebauthnCredential entity by IDs.
+func (m *UserMutation) RemoveUserToWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m.removed_UserToWebauthnCredentials == nil {
+		m.removed_UserToWebauthnCredentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToWebauthnCredentials, ids[i])
+		m.removed_UserToWebauthnCredentials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToWebauthnCredentials returns the removed IDs of the "UserToWebauthnCredentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) RemovedUserToWebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToWebauthnCredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToWebauthnCredentialsIDs returns the "UserToWebauthnCredentials" edge IDs in the mutation.
+func (m *UserMutation) UserToWebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m._UserToWebauthnCredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToWebauthnCredentials resets all changes to the "UserToWebauthnCredentials" edge.
+func (m *UserMutation) ResetUserToWebauthnCredentials() {
+	m._UserToWebauthnCredentials = nil
+	m.cleared_UserToWebauthnCredentials = false
+	m.removed_UserToWebauthnCredentials = nil
+}
+
+// AddUserToPersonalAccessTokenIDs adds the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity by ids.
+func (m *UserMutation) AddUserToPersonalAccessTokenIDs(ids ...uuid.UUID) {
+	if m._UserToPersonalAccessTokens == nil {
+		m._UserToPersonalAccessTokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._UserToPersonalAccessTokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserToPersonalAccessTokens clears the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity.
+func (m *UserMutation) ClearUserToPersonalAccessTokens() {
+	m.cleared_UserToPersonalAccessTokens = true
+}
+
+// UserToPersonalAccessTokensCleared reports if the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity was cleared.
+func (m *UserMutation) UserToPersonalAccessTokensCleared() bool {
+	return m.cleared_UserToPersonalAccessTokens
+}
+
+// RemoveUserToPersonalAccessTokenIDs removes the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity by IDs.
+func (m *UserMutation) RemoveUserToPersonalAccessTokenIDs(ids ...uuid.UUID) {
+	if m.removed_UserToPersonalAccessTokens == nil {
+		m.removed_UserToPersonalAccessTokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._UserToPersonalAccessTokens, ids[i])
+		m.removed_UserToPersonalAccessTokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserToPersonalAccessTokens returns the removed IDs of the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity.
+func (m *UserMutation) RemovedUserToPersonalAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removed_UserToPersonalAccessTokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserToPersonalAccessTokensIDs returns the "UserToPersonalAccessTokens" edge IDs in the mutation.
+func (m *UserMutation) UserToPersonalAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m._UserToPersonalAccessTokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserToPersonalAccessTokens resets all changes to the "UserToPersonalAccessTokens" edge.
+func (m *UserMutation) ResetUserToPersonalAccessTokens() {
+	m._UserToPersonalAccessTokens = nil
+	m.cleared_UserToPersonalAccessTokens = false
+	m.removed_UserToPersonalAccessTokens = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.first_name != nil {
+		fields = append(fields, user.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, user.FieldLastName)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.provider != nil {
+		fields = append(fields, user.FieldProvider)
+	}
+	if m.external_issuer != nil {
+		fields = append(fields, user.FieldExternalIssuer)
+	}
+	if m.external_subject != nil {
+		fields = append(fields, user.FieldExternalSubject)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled != nil {
+		fields = append(fields, user.FieldTotpEnabled)
+	}
+	if m.totp_last_counter != nil {
+		fields = append(fields, user.FieldTotpLastCounter)
+	}
+	if m.totp_recovery_codes != nil {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
+	}
+	if m.must_change_password != nil {
+		fields = append(fields, user.FieldMustChangePassword)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldFirstName:
+		return m.FirstName()
+	case user.FieldLastName:
+		return m.LastName()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldProvider:
+		return m.Provider()
+	case user.FieldExternalIssuer:
+		return m.ExternalIssuer()
+	case user.FieldExternalSubject:
+		return m.ExternalSubject()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabled:
+		return m.TotpEnabled()
+	case user.FieldTotpLastCounter:
+		return m.TotpLastCounter()
+	case user.FieldTotpRecoveryCodes:
+		return m.TotpRecoveryCodes()
+	case user.FieldMustChangePassword:
+		return m.MustChangePassword()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case user.FieldLastName:
+		return m.OldLastName(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldProvider:
+		return m.OldProvider(ctx)
+	case user.FieldExternalIssuer:
+		return m.OldExternalIssuer(ctx)
+	case user.FieldExternalSubject:
+		return m.OldExternalSubject(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabled:
+		return m.OldTotpEnabled(ctx)
+	case user.FieldTotpLastCounter:
+		return m.OldTotpLastCounter(ctx)
+	case user.FieldTotpRecoveryCodes:
+		return m.OldTotpRecoveryCodes(ctx)
+	case user.FieldMustChangePassword:
+		return m.OldMustChangePassword(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case user.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldProvider:
+		v, ok := value.(user.Provider)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case user.FieldExternalIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalIssuer(v)
+		return nil
+	case user.FieldExternalSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalSubject(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabled(v)
+		return nil
+	case user.FieldTotpLastCounter:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpLastCounter(v)
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpRecoveryCodes(v)
+		return nil
+	case user.FieldMustChangePassword:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMustChangePassword(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotp_last_counter != nil {
+		fields = append(fields, user.FieldTotpLastCounter)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTotpLastCounter:
+		return m.AddedTotpLastCounter()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldTotpLastCounter:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotpLastCounter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldExternalIssuer) {
+		fields = append(fields, user.FieldExternalIssuer)
+	}
+	if m.FieldCleared(user.FieldExternalSubject) {
+		fields = append(fields, user.FieldExternalSubject)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpRecoveryCodes) {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldExternalIssuer:
+		m.ClearExternalIssuer()
+		return nil
+	case user.FieldExternalSubject:
+		m.ClearExternalSubject()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ClearTotpRecoveryCodes()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case user.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case user.FieldExternalIssuer:
+		m.ResetExternalIssuer()
+		return nil
+	case user.FieldExternalSubject:
+		m.ResetExternalSubject()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabled:
+		m.ResetTotpEnabled()
+		return nil
+	case user.FieldTotpLastCounter:
+		m.ResetTotpLastCounter()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ResetTotpRecoveryCodes()
+		return nil
+	case user.FieldMustChangePassword:
+		m.ResetMustChangePassword()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m._UserToTeam != nil {
+		edges = append(edges, user.EdgeUserToTeam)
+	}
+	if m._UserToTeamMemberships != nil {
+		edges = append(edges, user.EdgeUserToTeamMemberships)
+	}
+	if m._UserToCustomRole != nil {
+		edges = append(edges, user.EdgeUserToCustomRole)
+	}
+	if m._UserToAdminCompetitions != nil {
+		edges = append(edges, user.EdgeUserToAdminCompetitions)
+	}
+	if m._UserToToken != nil {
+		edges = append(edges, user.EdgeUserToToken)
+	}
+	if m._UserToActions != nil {
+		edges = append(edges, user.EdgeUserToActions)
+	}
+	if m._UserToConsoleSessions != nil {
+		edges = append(edges, user.EdgeUserToConsoleSessions)
+	}
+	if m._UserToConsoleShares != nil {
+		edges = append(edges, user.EdgeUserToConsoleShares)
+	}
+	if m._UserToWebauthnCredentials != nil {
+		edges = append(edges, user.EdgeUserToWebauthnCredentials)
+	}
+	if m._UserToPersonalAccessTokens != nil {
+		edges = append(edges, user.EdgeUserToPersonalAccessTokens)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserToTeam:
+		if id := m._UserToTeam; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeUserToTeamMemberships:
+		ids := make([]ent.Value, 0, len(m._UserToTeamMemberships))
+		for id := range m._UserToTeamMemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToCustomRole:
+		if id := m._UserToCustomRole; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeUserToAdminCompetitions:
+		ids := make([]ent.Value, 0, len(m._UserToAdminCompetitions))
+		for id := range m._UserToAdminCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToToken:
+		ids := make([]ent.Value, 0, len(m._UserToToken))
+		for id := range m._UserToToken {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToActions:
+		ids := make([]ent.Value, 0, len(m._UserToActions))
+		for id := range m._UserToActions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToConsoleSessions:
+		ids := make([]ent.Value, 0, len(m._UserToConsoleSessions))
+		for id := range m._UserToConsoleSessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToConsoleShares:
+		ids := make([]ent.Value, 0, len(m._UserToConsoleShares))
+		for id := range m._UserToConsoleShares {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m._UserToWebauthnCredentials))
+		for id := range m._UserToWebauthnCredentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToPersonalAccessTokens:
+		ids := make([]ent.Value, 0, len(m._UserToPersonalAccessTokens))
+		for id := range m._UserToPersonalAccessTokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removed_UserToTeamMemberships != nil {
+		edges = append(edges, user.EdgeUserToTeamMemberships)
+	}
+	if m.removed_UserToAdminCompetitions != nil {
+		edges = append(edges, user.EdgeUserToAdminCompetitions)
+	}
+	if m.removed_UserToToken != nil {
+		edges = append(edges, user.EdgeUserToToken)
+	}
+	if m.removed_UserToActions != nil {
+		edges = append(edges, user.EdgeUserToActions)
+	}
+	if m.removed_UserToConsoleSessions != nil {
+		edges = append(edges, user.EdgeUserToConsoleSessions)
+	}
+	if m.removed_UserToConsoleShares != nil {
+		edges = append(edges, user.EdgeUserToConsoleShares)
+	}
+	if m.removed_UserToWebauthnCredentials != nil {
+		edges = append(edges, user.EdgeUserToWebauthnCredentials)
+	}
+	if m.removed_UserToPersonalAccessTokens != nil {
+		edges = append(edges, user.EdgeUserToPersonalAccessTokens)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserToTeamMemberships:
+		ids := make([]ent.Value, 0, len(m.removed_UserToTeamMemberships))
+		for id := range m.removed_UserToTeamMemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToAdminCompetitions:
+		ids := make([]ent.Value, 0, len(m.removed_UserToAdminCompetitions))
+		for id := range m.removed_UserToAdminCompetitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToToken:
+		ids := make([]ent.Value, 0, len(m.removed_UserToToken))
+		for id := range m.removed_UserToToken {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToActions:
+		ids := make([]ent.Value, 0, len(m.removed_UserToActions))
+		for id := range m.removed_UserToActions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToConsoleSessions:
+		ids := make([]ent.Value, 0, len(m.removed_UserToConsoleSessions))
+		for id := range m.removed_UserToConsoleSessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToConsoleShares:
+		ids := make([]ent.Value, 0, len(m.removed_UserToConsoleShares))
+		for id := range m.removed_UserToConsoleShares {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.removed_UserToWebauthnCredentials))
+		for id := range m.removed_UserToWebauthnCredentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserToPersonalAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removed_UserToPersonalAccessTokens))
+		for id := range m.removed_UserToPersonalAccessTokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.cleared_UserToTeam {
+		edges = append(edges, user.EdgeUserToTeam)
+	}
+	if m.cleared_UserToTeamMemberships {
+		edges = append(edges, user.EdgeUserToTeamMemberships)
+	}
+	if m.cleared_UserToCustomRole {
+		edges = append(edges, user.EdgeUserToCustomRole)
+	}
+	if m.cleared_UserToAdminCompetitions {
+		edges = append(edges, user.EdgeUserToAdminCompetitions)
+	}
+	if m.cleared_UserToToken {
+		edges = append(edges, user.EdgeUserToToken)
+	}
+	if m.cleared_UserToActions {
+		edges = append(edges, user.EdgeUserToActions)
+	}
+	if m.cleared_UserToConsoleSessions {
+		edges = append(edges, user.EdgeUserToConsoleSessions)
+	}
+	if m.cleared_UserToConsoleShares {
+		edges = append(edges, user.EdgeUserToConsoleShares)
+	}
+	if m.cleared_UserToWebauthnCredentials {
+		edges = append(edges, user.EdgeUserToWebauthnCredentials)
+	}
+	if m.cleared_UserToPersonalAccessTokens {
+		edges = append(edges, user.EdgeUserToPersonalAccessTokens)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeUserToTeam:
+		return m.cleared_UserToTeam
+	case user.EdgeUserToTeamMemberships:
+		return m.cleared_UserToTeamMemberships
+	case user.EdgeUserToCustomRole:
+		return m.cleared_UserToCustomRole
+	case user.EdgeUserToAdminCompetitions:
+		return m.cleared_UserToAdminCompetitions
+	case user.EdgeUserToToken:
+		return m.cleared_UserToToken
+	case user.EdgeUserToActions:
+		return m.cleared_UserToActions
+	case user.EdgeUserToConsoleSessions:
+		return m.cleared_UserToConsoleSessions
+	case user.EdgeUserToConsoleShares:
+		return m.cleared_UserToConsoleShares
+	case user.EdgeUserToWebauthnCredentials:
+		return m.cleared_UserToWebauthnCredentials
+	case user.EdgeUserToPersonalAccessTokens:
+		return m.cleared_UserToPersonalAccessTokens
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeUserToTeam:
+		m.ClearUserToTeam()
+		return nil
+	case user.EdgeUserToCustomRole:
+		m.ClearUserToCustomRole()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeUserToTeam:
+		m.ResetUserToTeam()
+		return nil
+	case user.EdgeUserToTeamMemberships:
+		m.ResetUserToTeamMemberships()
+		return nil
+	case user.EdgeUserToCustomRole:
+		m.ResetUserToCustomRole()
+		return nil
+	case user.EdgeUserToAdminCompetitions:
+		m.ResetUserToAdminCompetitions()
+		return nil
+	case user.EdgeUserToToken:
+		m.ResetUserToToken()
+		return nil
+	case user.EdgeUserToActions:
+		m.ResetUserToActions()
+		return nil
+	case user.EdgeUserToConsoleSessions:
+		m.ResetUserToConsoleSessions()
+		return nil
+	case user.EdgeUserToConsoleShares:
+		m.ResetUserToConsoleShares()
+		return nil
+	case user.EdgeUserToWebauthnCredentials:
+		m.ResetUserToWebauthnCredentials()
+		return nil
+	case user.EdgeUserToPersonalAccessTokens:
+		m.ResetUserToPersonalAccessTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// VmCredentialMutation represents an operation that mutates the VmCredential nodes in the graph.
+type VmCredentialMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	protocol                       *vmcredential.Protocol
+	port                           *int
+	addport                        *int
+	username                       *string
+	password                       *string
+	private_key                    *string
+	domain                         *string
+	ignore_cert                    *bool
+	clearedFields                  map[string]struct{}
+	_VmCredentialToVmObject        *uuid.UUID
+	cleared_VmCredentialToVmObject bool
+	done                           bool
+	oldValue                       func(context.Context) (*VmCredential, error)
+	predicates                     []predicate.VmCredential
+}
+
+var _ ent.Mutation = (*VmCredentialMutation)(nil)
+
+// vmcredentialOption allows management of the mutation configuration using functional options.
+type vmcredentialOption func(*VmCredentialMutation)
+
+// newVmCredentialMutation creates new mutation for the VmCredential entity.
+func newVmCredentialMutation(c config, op Op, opts ...vmcredentialOption) *VmCredentialMutation {
+	m := &VmCredentialMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVmCredential,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVmCredentialID sets the ID field of the mutation.
+func withVmCredentialID(id uuid.UUID) vmcredentialOption {
+	return func(m *VmCredentialMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VmCredential
+		)
+		m.oldValue = func(ctx context.Context) (*VmCredential, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VmCredential.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVmCredential sets the old VmCredential of the mutation.
+func withVmCredential(node *VmCredential) vmcredentialOption {
+	return func(m *VmCredentialMutation) {
+		m.oldValue = func(context.Context) (*VmCredential, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VmCredentialMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VmCredentialMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VmCredential entities.
+func (m *VmCredentialMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VmCredentialMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VmCredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VmCredential.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *VmCredentialMutation) SetProtocol(v vmcredential.Protocol) {
+	m.protocol = &v
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *VmCredentialMutation) Protocol() (r vmcredential.Protocol, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldProtocol(ctx context.Context) (v vmcredential.Protocol, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *VmCredentialMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetPort sets the "port" field.
+func (m *VmCredentialMutation) SetPort(i int) {
+	m.port = &i
+	m.addport = nil
+}
+
+// Port returns the value of the "port" field in the mutation.
+func (m *VmCredentialMutation) Port() (r int, exists bool) {
+	v := m.port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPort returns the old "port" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+	}
+	return oldValue.Port, nil
+}
+
+// AddPort adds i to the "port" field.
+func (m *VmCredentialMutation) AddPort(i int) {
+	if m.addport != nil {
+		*m.addport += i
 	} else {
-		m.addexpire_at = &i
+		m.addport = &i
 	}
 }
 
-// AddedExpireAt returns the value that was added to the "expire_at" field in this mutation.
-func (m *TokenMutation) AddedExpireAt() (r int64, exists bool) {
-	v := m.addexpire_at
+// AddedPort returns the value that was added to the "port" field in this mutation.
+func (m *VmCredentialMutation) AddedPort() (r int, exists bool) {
+	v := m.addport
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExpireAt resets all changes to the "expire_at" field.
-func (m *TokenMutation) ResetExpireAt() {
-	m.expire_at = nil
-	m.addexpire_at = nil
+// ResetPort resets all changes to the "port" field.
+func (m *VmCredentialMutation) ResetPort() {
+	m.port = nil
+	m.addport = nil
 }
 
-// SetTokenToUserID sets the "TokenToUser" edge to the User entity by id.
-func (m *TokenMutation) SetTokenToUserID(id uuid.UUID) {
-	m._TokenToUser = &id
+// SetUsername sets the "username" field.
+func (m *VmCredentialMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// ClearTokenToUser clears the "TokenToUser" edge to the User entity.
-func (m *TokenMutation) ClearTokenToUser() {
-	m.cleared_TokenToUser = true
+// Username returns the value of the "username" field in the mutation.
+func (m *VmCredentialMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenToUserCleared reports if the "TokenToUser" edge to the User entity was cleared.
-func (m *TokenMutation) TokenToUserCleared() bool {
-	return m.cleared_TokenToUser
+// OldUsername returns the old "username" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
 }
 
-// TokenToUserID returns the "TokenToUser" edge ID in the mutation.
-func (m *TokenMutation) TokenToUserID() (id uuid.UUID, exists bool) {
-	if m._TokenToUser != nil {
-		return *m._TokenToUser, true
+// ResetUsername resets all changes to the "username" field.
+func (m *VmCredentialMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *VmCredentialMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *VmCredentialMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *VmCredentialMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *VmCredentialMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *VmCredentialMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *VmCredentialMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetDomain sets the "domain" field.
+func (m *VmCredentialMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *VmCredentialMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *VmCredentialMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// SetIgnoreCert sets the "ignore_cert" field.
+func (m *VmCredentialMutation) SetIgnoreCert(b bool) {
+	m.ignore_cert = &b
+}
+
+// IgnoreCert returns the value of the "ignore_cert" field in the mutation.
+func (m *VmCredentialMutation) IgnoreCert() (r bool, exists bool) {
+	v := m.ignore_cert
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIgnoreCert returns the old "ignore_cert" field's value of the VmCredential entity.
+// If the VmCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmCredentialMutation) OldIgnoreCert(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIgnoreCert is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIgnoreCert requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIgnoreCert: %w", err)
+	}
+	return oldValue.IgnoreCert, nil
+}
+
+// ResetIgnoreCert resets all changes to the "ignore_cert" field.
+func (m *VmCredentialMutation) ResetIgnoreCert() {
+	m.ignore_cert = nil
+}
+
+// SetVmCredentialToVmObjectID sets the "VmCredentialToVmObject" edge to the VmObject entity by id.
+func (m *VmCredentialMutation) SetVmCredentialToVmObjectID(id uuid.UUID) {
+	m._VmCredentialToVmObject = &id
+}
+
+// ClearVmCredentialToVmObject clears the "VmCredentialToVmObject" edge to the VmObject entity.
+func (m *VmCredentialMutation) ClearVmCredentialToVmObject() {
+	m.cleared_VmCredentialToVmObject = true
+}
+
+// VmCredentialToVmObjectCleared reports if the "VmCredentialToVmObject" edge to the VmObject entity was cleared.
+func (m *VmCredentialMutation) VmCredentialToVmObjectCleared() bool {
+	return m.cleared_VmCredentialToVmObject
+}
+
+// VmCredentialToVmObjectID returns the "VmCredentialToVmObject" edge ID in the mutation.
+func (m *VmCredentialMutation) VmCredentialToVmObjectID() (id uuid.UUID, exists bool) {
+	if m._VmCredentialToVmObject != nil {
+		return *m._VmCredentialToVmObject, true
 	}
 	return
 }
 
-// TokenToUserIDs returns the "TokenToUser" edge IDs in the mutation.
+// VmCredentialToVmObjectIDs returns the "VmCredentialToVmObject" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenToUserID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) TokenToUserIDs() (ids []uuid.UUID) {
-	if id := m._TokenToUser; id != nil {
+// VmCredentialToVmObjectID instead. It exists only for internal usage by the builders.
+func (m *VmCredentialMutation) VmCredentialToVmObjectIDs() (ids []uuid.UUID) {
+	if id := m._VmCredentialToVmObject; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTokenToUser resets all changes to the "TokenToUser" edge.
-func (m *TokenMutation) ResetTokenToUser() {
-	m._TokenToUser = nil
-	m.cleared_TokenToUser = false
+// ResetVmCredentialToVmObject resets all changes to the "VmCredentialToVmObject" edge.
+func (m *VmCredentialMutation) ResetVmCredentialToVmObject() {
+	m._VmCredentialToVmObject = nil
+	m.cleared_VmCredentialToVmObject = false
 }
 
-// Where appends a list predicates to the TokenMutation builder.
-func (m *TokenMutation) Where(ps ...predicate.Token) {
+// Where appends a list predicates to the VmCredentialMutation builder.
+func (m *VmCredentialMutation) Where(ps ...predicate.VmCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *VmCredentialMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (VmCredential).
+func (m *VmCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.token != nil {
-		fields = append(fields, token.FieldToken)
+func (m *VmCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.protocol != nil {
+		fields = append(fields, vmcredential.FieldProtocol)
 	}
-	if m.expire_at != nil {
-		fields = append(fields, token.FieldExpireAt)
+	if m.port != nil {
+		fields = append(fields, vmcredential.FieldPort)
+	}
+	if m.username != nil {
+		fields = append(fields, vmcredential.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, vmcredential.FieldPassword)
+	}
+	if m.private_key != nil {
+		fields = append(fields, vmcredential.FieldPrivateKey)
+	}
+	if m.domain != nil {
+		fields = append(fields, vmcredential.FieldDomain)
+	}
+	if m.ignore_cert != nil {
+		fields = append(fields, vmcredential.FieldIgnoreCert)
 	}
 	return fields
 }
@@ -3771,12 +12890,22 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *VmCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldToken:
-		return m.Token()
-	case token.FieldExpireAt:
-		return m.ExpireAt()
+	case vmcredential.FieldProtocol:
+		return m.Protocol()
+	case vmcredential.FieldPort:
+		return m.Port()
+	case vmcredential.FieldUsername:
+		return m.Username()
+	case vmcredential.FieldPassword:
+		return m.Password()
+	case vmcredential.FieldPrivateKey:
+		return m.PrivateKey()
+	case vmcredential.FieldDomain:
+		return m.Domain()
+	case vmcredential.FieldIgnoreCert:
+		return m.IgnoreCert()
 	}
 	return nil, false
 }
@@ -3784,45 +12913,90 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VmCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case token.FieldToken:
-		return m.OldToken(ctx)
-	case token.FieldExpireAt:
-		return m.OldExpireAt(ctx)
+	case vmcredential.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case vmcredential.FieldPort:
+		return m.OldPort(ctx)
+	case vmcredential.FieldUsername:
+		return m.OldUsername(ctx)
+	case vmcredential.FieldPassword:
+		return m.OldPassword(ctx)
+	case vmcredential.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case vmcredential.FieldDomain:
+		return m.OldDomain(ctx)
+	case vmcredential.FieldIgnoreCert:
+		return m.OldIgnoreCert(ctx)
 	}
-	return nil, fmt.Errorf("unknown Token field %s", name)
+	return nil, fmt.Errorf("unknown VmCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *VmCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldToken:
+	case vmcredential.FieldProtocol:
+		v, ok := value.(vmcredential.Protocol)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case vmcredential.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPort(v)
+		return nil
+	case vmcredential.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetUsername(v)
 		return nil
-	case token.FieldExpireAt:
-		v, ok := value.(int64)
+	case vmcredential.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpireAt(v)
+		m.SetPassword(v)
+		return nil
+	case vmcredential.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case vmcredential.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case vmcredential.FieldIgnoreCert:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIgnoreCert(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown VmCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMutation) AddedFields() []string {
+func (m *VmCredentialMutation) AddedFields() []string {
 	var fields []string
-	if m.addexpire_at != nil {
-		fields = append(fields, token.FieldExpireAt)
+	if m.addport != nil {
+		fields = append(fields, vmcredential.FieldPort)
 	}
 	return fields
 }
@@ -3830,10 +13004,10 @@ func (m *TokenMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VmCredentialMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldExpireAt:
-		return m.AddedExpireAt()
+	case vmcredential.FieldPort:
+		return m.AddedPort()
 	}
 	return nil, false
 }
@@ -3841,67 +13015,82 @@ func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *VmCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldExpireAt:
-		v, ok := value.(int64)
+	case vmcredential.FieldPort:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpireAt(v)
+		m.AddPort(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown VmCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMutation) ClearedFields() []string {
+func (m *VmCredentialMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *VmCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Token nullable field %s", name)
+func (m *VmCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VmCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *VmCredentialMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldToken:
-		m.ResetToken()
+	case vmcredential.FieldProtocol:
+		m.ResetProtocol()
 		return nil
-	case token.FieldExpireAt:
-		m.ResetExpireAt()
+	case vmcredential.FieldPort:
+		m.ResetPort()
+		return nil
+	case vmcredential.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case vmcredential.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case vmcredential.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case vmcredential.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case vmcredential.FieldIgnoreCert:
+		m.ResetIgnoreCert()
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown VmCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMutation) AddedEdges() []string {
+func (m *VmCredentialMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m._TokenToUser != nil {
-		edges = append(edges, token.EdgeTokenToUser)
+	if m._VmCredentialToVmObject != nil {
+		edges = append(edges, vmcredential.EdgeVmCredentialToVmObject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *VmCredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case token.EdgeTokenToUser:
-		if id := m._TokenToUser; id != nil {
+	case vmcredential.EdgeVmCredentialToVmObject:
+		if id := m._VmCredentialToVmObject; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3909,97 +13098,105 @@ func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMutation) RemovedEdges() []string {
+func (m *VmCredentialMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *VmCredentialMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMutation) ClearedEdges() []string {
+func (m *VmCredentialMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleared_TokenToUser {
-		edges = append(edges, token.EdgeTokenToUser)
+	if m.cleared_VmCredentialToVmObject {
+		edges = append(edges, vmcredential.EdgeVmCredentialToVmObject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *VmCredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case token.EdgeTokenToUser:
-		return m.cleared_TokenToUser
+	case vmcredential.EdgeVmCredentialToVmObject:
+		return m.cleared_VmCredentialToVmObject
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
+func (m *VmCredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case token.EdgeTokenToUser:
-		m.ClearTokenToUser()
+	case vmcredential.EdgeVmCredentialToVmObject:
+		m.ClearVmCredentialToVmObject()
 		return nil
 	}
-	return fmt.Errorf("unknown Token unique edge %s", name)
+	return fmt.Errorf("unknown VmCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
+func (m *VmCredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case token.EdgeTokenToUser:
-		m.ResetTokenToUser()
+	case vmcredential.EdgeVmCredentialToVmObject:
+		m.ResetVmCredentialToVmObject()
 		return nil
 	}
-	return fmt.Errorf("unknown Token edge %s", name)
+	return fmt.Errorf("unknown VmCredential edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// VmObjectMutation represents an operation that mutates the VmObject nodes in the graph.
+type VmObjectMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	username              *string
-	password              *string
-	first_name            *string
-	last_name             *string
-	role                  *user.Role
-	provider              *user.Provider
-	clearedFields         map[string]struct{}
-	_UserToTeam           *uuid.UUID
-	cleared_UserToTeam    bool
-	_UserToToken          map[uuid.UUID]struct{}
-	removed_UserToToken   map[uuid.UUID]struct{}
-	cleared_UserToToken   bool
-	_UserToActions        map[uuid.UUID]struct{}
-	removed_UserToActions map[uuid.UUID]struct{}
-	cleared_UserToActions bool
-	done                  bool
-	oldValue              func(context.Context) (*User, error)
-	predicates            []predicate.User
+	op                                Op
+	typ                               string
+	id                                *uuid.UUID
+	name                              *string
+	identifier                        *string
+	ip_addresses                      *[]string
+	locked                            *bool
+	red_team_access                   *bool
+	console_limit_per_vm              *int
+	addconsole_limit_per_vm           *int
+	console_limit_per_user            *int
+	addconsole_limit_per_user         *int
+	console_limit_per_team            *int
+	addconsole_limit_per_team         *int
+	clearedFields                     map[string]struct{}
+	_VmObjectToTeam                   *uuid.UUID
+	cleared_VmObjectToTeam            bool
+	_VmObjectToConsoleSessions        map[uuid.UUID]struct{}
+	removed_VmObjectToConsoleSessions map[uuid.UUID]struct{}
+	cleared_VmObjectToConsoleSessions bool
+	_VmObjectToVmCredentials          map[uuid.UUID]struct{}
+	removed_VmObjectToVmCredentials   map[uuid.UUID]struct{}
+	cleared_VmObjectToVmCredentials   bool
+	_VmObjectToConsoleShares          map[uuid.UUID]struct{}
+	removed_VmObjectToConsoleShares   map[uuid.UUID]struct{}
+	cleared_VmObjectToConsoleShares   bool
+	done                              bool
+	oldValue                          func(context.Context) (*VmObject, error)
+	predicates                        []predicate.VmObject
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*VmObjectMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// vmobjectOption allows management of the mutation configuration using functional options.
+type vmobjectOption func(*VmObjectMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newVmObjectMutation creates new mutation for the VmObject entity.
+func newVmObjectMutation(c config, op Op, opts ...vmobjectOption) *VmObjectMutation {
+	m := &VmObjectMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeVmObject,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4008,20 +13205,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withVmObjectID sets the ID field of the mutation.
+func withVmObjectID(id uuid.UUID) vmobjectOption {
+	return func(m *VmObjectMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *VmObject
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*VmObject, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().VmObject.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4030,10 +13227,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withVmObject sets the old VmObject of the mutation.
+func withVmObject(node *VmObject) vmobjectOption {
+	return func(m *VmObjectMutation) {
+		m.oldValue = func(context.Context) (*VmObject, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4042,7 +13239,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m VmObjectMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4050,7 +13247,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m VmObjectMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4060,14 +13257,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of VmObject entities.
+func (m *VmObjectMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *VmObjectMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4078,7 +13275,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *VmObjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4087,412 +13284,659 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VmObject.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetName sets the "name" field.
+func (m *VmObjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VmObjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmObjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VmObjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetIdentifier sets the "identifier" field.
+func (m *VmObjectMutation) SetIdentifier(s string) {
+	m.identifier = &s
+}
+
+// Identifier returns the value of the "identifier" field in the mutation.
+func (m *VmObjectMutation) Identifier() (r string, exists bool) {
+	v := m.identifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdentifier returns the old "identifier" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmObjectMutation) OldIdentifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentifier: %w", err)
+	}
+	return oldValue.Identifier, nil
+}
+
+// ResetIdentifier resets all changes to the "identifier" field.
+func (m *VmObjectMutation) ResetIdentifier() {
+	m.identifier = nil
+}
+
+// SetIPAddresses sets the "ip_addresses" field.
+func (m *VmObjectMutation) SetIPAddresses(s []string) {
+	m.ip_addresses = &s
+}
+
+// IPAddresses returns the value of the "ip_addresses" field in the mutation.
+func (m *VmObjectMutation) IPAddresses() (r []string, exists bool) {
+	v := m.ip_addresses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddresses returns the old "ip_addresses" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VmObjectMutation) OldIPAddresses(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddresses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddresses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddresses: %w", err)
+	}
+	return oldValue.IPAddresses, nil
+}
+
+// ClearIPAddresses clears the value of the "ip_addresses" field.
+func (m *VmObjectMutation) ClearIPAddresses() {
+	m.ip_addresses = nil
+	m.clearedFields[vmobject.FieldIPAddresses] = struct{}{}
+}
+
+// IPAddressesCleared returns if the "ip_addresses" field was cleared in this mutation.
+func (m *VmObjectMutation) IPAddressesCleared() bool {
+	_, ok := m.clearedFields[vmobject.FieldIPAddresses]
+	return ok
+}
+
+// ResetIPAddresses resets all changes to the "ip_addresses" field.
+func (m *VmObjectMutation) ResetIPAddresses() {
+	m.ip_addresses = nil
+	delete(m.clearedFields, vmobject.FieldIPAddresses)
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// SetLocked sets the "locked" field.
+func (m *VmObjectMutation) SetLocked(b bool) {
+	m.locked = &b
+}
+
+// Locked returns the value of the "locked" field in the mutation.
+func (m *VmObjectMutation) Locked() (r bool, exists bool) {
+	v := m.locked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLocked returns the old "locked" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *VmObjectMutation) OldLocked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldLocked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocked: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Locked, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ResetLocked resets all changes to the "locked" field.
+func (m *VmObjectMutation) ResetLocked() {
+	m.locked = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetRedTeamAccess sets the "red_team_access" field.
+func (m *VmObjectMutation) SetRedTeamAccess(b bool) {
+	m.red_team_access = &b
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// RedTeamAccess returns the value of the "red_team_access" field in the mutation.
+func (m *VmObjectMutation) RedTeamAccess() (r bool, exists bool) {
+	v := m.red_team_access
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldRedTeamAccess returns the old "red_team_access" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *VmObjectMutation) OldRedTeamAccess(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedTeamAccess is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldRedTeamAccess requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedTeamAccess: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.RedTeamAccess, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetRedTeamAccess resets all changes to the "red_team_access" field.
+func (m *VmObjectMutation) ResetRedTeamAccess() {
+	m.red_team_access = nil
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *UserMutation) SetFirstName(s string) {
-	m.first_name = &s
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (m *VmObjectMutation) SetConsoleLimitPerVM(i int) {
+	m.console_limit_per_vm = &i
+	m.addconsole_limit_per_vm = nil
 }
 
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *UserMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
+// ConsoleLimitPerVM returns the value of the "console_limit_per_vm" field in the mutation.
+func (m *VmObjectMutation) ConsoleLimitPerVM() (r int, exists bool) {
+	v := m.console_limit_per_vm
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstName returns the old "first_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldConsoleLimitPerVM returns the old "console_limit_per_vm" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+func (m *VmObjectMutation) OldConsoleLimitPerVM(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsoleLimitPerVM is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
+		return v, errors.New("OldConsoleLimitPerVM requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerVM: %w", err)
 	}
-	return oldValue.FirstName, nil
-}
-
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *UserMutation) ResetFirstName() {
-	m.first_name = nil
+	return oldValue.ConsoleLimitPerVM, nil
 }
 
-// SetLastName sets the "last_name" field.
-func (m *UserMutation) SetLastName(s string) {
-	m.last_name = &s
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (m *VmObjectMutation) AddConsoleLimitPerVM(i int) {
+	if m.addconsole_limit_per_vm != nil {
+		*m.addconsole_limit_per_vm += i
+	} else {
+		m.addconsole_limit_per_vm = &i
+	}
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *UserMutation) LastName() (r string, exists bool) {
-	v := m.last_name
+// AddedConsoleLimitPerVM returns the value that was added to the "console_limit_per_vm" field in this mutation.
+func (m *VmObjectMutation) AddedConsoleLimitPerVM() (r int, exists bool) {
+	v := m.addconsole_limit_per_vm
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastName returns the old "last_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
-	}
-	return oldValue.LastName, nil
+// ClearConsoleLimitPerVM clears the value of the "console_limit_per_vm" field.
+func (m *VmObjectMutation) ClearConsoleLimitPerVM() {
+	m.console_limit_per_vm = nil
+	m.addconsole_limit_per_vm = nil
+	m.clearedFields[vmobject.FieldConsoleLimitPerVM] = struct{}{}
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *UserMutation) ResetLastName() {
-	m.last_name = nil
+// ConsoleLimitPerVMCleared returns if the "console_limit_per_vm" field was cleared in this mutation.
+func (m *VmObjectMutation) ConsoleLimitPerVMCleared() bool {
+	_, ok := m.clearedFields[vmobject.FieldConsoleLimitPerVM]
+	return ok
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(u user.Role) {
-	m.role = &u
+// ResetConsoleLimitPerVM resets all changes to the "console_limit_per_vm" field.
+func (m *VmObjectMutation) ResetConsoleLimitPerVM() {
+	m.console_limit_per_vm = nil
+	m.addconsole_limit_per_vm = nil
+	delete(m.clearedFields, vmobject.FieldConsoleLimitPerVM)
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r user.Role, exists bool) {
-	v := m.role
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (m *VmObjectMutation) SetConsoleLimitPerUser(i int) {
+	m.console_limit_per_user = &i
+	m.addconsole_limit_per_user = nil
+}
+
+// ConsoleLimitPerUser returns the value of the "console_limit_per_user" field in the mutation.
+func (m *VmObjectMutation) ConsoleLimitPerUser() (r int, exists bool) {
+	v := m.console_limit_per_user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldConsoleLimitPerUser returns the old "console_limit_per_user" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+func (m *VmObjectMutation) OldConsoleLimitPerUser(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsoleLimitPerUser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldConsoleLimitPerUser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerUser: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.ConsoleLimitPerUser, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (m *VmObjectMutation) AddConsoleLimitPerUser(i int) {
+	if m.addconsole_limit_per_user != nil {
+		*m.addconsole_limit_per_user += i
+	} else {
+		m.addconsole_limit_per_user = &i
+	}
 }
 
-// SetProvider sets the "provider" field.
-func (m *UserMutation) SetProvider(u user.Provider) {
-	m.provider = &u
+// AddedConsoleLimitPerUser returns the value that was added to the "console_limit_per_user" field in this mutation.
+func (m *VmObjectMutation) AddedConsoleLimitPerUser() (r int, exists bool) {
+	v := m.addconsole_limit_per_user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *UserMutation) Provider() (r user.Provider, exists bool) {
-	v := m.provider
+// ClearConsoleLimitPerUser clears the value of the "console_limit_per_user" field.
+func (m *VmObjectMutation) ClearConsoleLimitPerUser() {
+	m.console_limit_per_user = nil
+	m.addconsole_limit_per_user = nil
+	m.clearedFields[vmobject.FieldConsoleLimitPerUser] = struct{}{}
+}
+
+// ConsoleLimitPerUserCleared returns if the "console_limit_per_user" field was cleared in this mutation.
+func (m *VmObjectMutation) ConsoleLimitPerUserCleared() bool {
+	_, ok := m.clearedFields[vmobject.FieldConsoleLimitPerUser]
+	return ok
+}
+
+// ResetConsoleLimitPerUser resets all changes to the "console_limit_per_user" field.
+func (m *VmObjectMutation) ResetConsoleLimitPerUser() {
+	m.console_limit_per_user = nil
+	m.addconsole_limit_per_user = nil
+	delete(m.clearedFields, vmobject.FieldConsoleLimitPerUser)
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (m *VmObjectMutation) SetConsoleLimitPerTeam(i int) {
+	m.console_limit_per_team = &i
+	m.addconsole_limit_per_team = nil
+}
+
+// ConsoleLimitPerTeam returns the value of the "console_limit_per_team" field in the mutation.
+func (m *VmObjectMutation) ConsoleLimitPerTeam() (r int, exists bool) {
+	v := m.console_limit_per_team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldConsoleLimitPerTeam returns the old "console_limit_per_team" field's value of the VmObject entity.
+// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProvider(ctx context.Context) (v user.Provider, err error) {
+func (m *VmObjectMutation) OldConsoleLimitPerTeam(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsoleLimitPerTeam is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldConsoleLimitPerTeam requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsoleLimitPerTeam: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.ConsoleLimitPerTeam, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *UserMutation) ResetProvider() {
-	m.provider = nil
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (m *VmObjectMutation) AddConsoleLimitPerTeam(i int) {
+	if m.addconsole_limit_per_team != nil {
+		*m.addconsole_limit_per_team += i
+	} else {
+		m.addconsole_limit_per_team = &i
+	}
 }
 
-// SetUserToTeamID sets the "UserToTeam" edge to the Team entity by id.
-func (m *UserMutation) SetUserToTeamID(id uuid.UUID) {
-	m._UserToTeam = &id
+// AddedConsoleLimitPerTeam returns the value that was added to the "console_limit_per_team" field in this mutation.
+func (m *VmObjectMutation) AddedConsoleLimitPerTeam() (r int, exists bool) {
+	v := m.addconsole_limit_per_team
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUserToTeam clears the "UserToTeam" edge to the Team entity.
-func (m *UserMutation) ClearUserToTeam() {
-	m.cleared_UserToTeam = true
+// ClearConsoleLimitPerTeam clears the value of the "console_limit_per_team" field.
+func (m *VmObjectMutation) ClearConsoleLimitPerTeam() {
+	m.console_limit_per_team = nil
+	m.addconsole_limit_per_team = nil
+	m.clearedFields[vmobject.FieldConsoleLimitPerTeam] = struct{}{}
 }
 
-// UserToTeamCleared reports if the "UserToTeam" edge to the Team entity was cleared.
-func (m *UserMutation) UserToTeamCleared() bool {
-	return m.cleared_UserToTeam
+// ConsoleLimitPerTeamCleared returns if the "console_limit_per_team" field was cleared in this mutation.
+func (m *VmObjectMutation) ConsoleLimitPerTeamCleared() bool {
+	_, ok := m.clearedFields[vmobject.FieldConsoleLimitPerTeam]
+	return ok
 }
 
-// UserToTeamID returns the "UserToTeam" edge ID in the mutation.
-func (m *UserMutation) UserToTeamID() (id uuid.UUID, exists bool) {
-	if m._UserToTeam != nil {
-		return *m._UserToTeam, true
+// ResetConsoleLimitPerTeam resets all changes to the "console_limit_per_team" field.
+func (m *VmObjectMutation) ResetConsoleLimitPerTeam() {
+	m.console_limit_per_team = nil
+	m.addconsole_limit_per_team = nil
+	delete(m.clearedFields, vmobject.FieldConsoleLimitPerTeam)
+}
+
+// SetVmObjectToTeamID sets the "VmObjectToTeam" edge to the Team entity by id.
+func (m *VmObjectMutation) SetVmObjectToTeamID(id uuid.UUID) {
+	m._VmObjectToTeam = &id
+}
+
+// ClearVmObjectToTeam clears the "VmObjectToTeam" edge to the Team entity.
+func (m *VmObjectMutation) ClearVmObjectToTeam() {
+	m.cleared_VmObjectToTeam = true
+}
+
+// VmObjectToTeamCleared reports if the "VmObjectToTeam" edge to the Team entity was cleared.
+func (m *VmObjectMutation) VmObjectToTeamCleared() bool {
+	return m.cleared_VmObjectToTeam
+}
+
+// VmObjectToTeamID returns the "VmObjectToTeam" edge ID in the mutation.
+func (m *VmObjectMutation) VmObjectToTeamID() (id uuid.UUID, exists bool) {
+	if m._VmObjectToTeam != nil {
+		return *m._VmObjectToTeam, true
 	}
 	return
 }
 
-// UserToTeamIDs returns the "UserToTeam" edge IDs in the mutation.
+// VmObjectToTeamIDs returns the "VmObjectToTeam" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserToTeamID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) UserToTeamIDs() (ids []uuid.UUID) {
-	if id := m._UserToTeam; id != nil {
+// VmObjectToTeamID instead. It exists only for internal usage by the builders.
+func (m *VmObjectMutation) VmObjectToTeamIDs() (ids []uuid.UUID) {
+	if id := m._VmObjectToTeam; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserToTeam resets all changes to the "UserToTeam" edge.
-func (m *UserMutation) ResetUserToTeam() {
-	m._UserToTeam = nil
-	m.cleared_UserToTeam = false
+// ResetVmObjectToTeam resets all changes to the "VmObjectToTeam" edge.
+func (m *VmObjectMutation) ResetVmObjectToTeam() {
+	m._VmObjectToTeam = nil
+	m.cleared_VmObjectToTeam = false
 }
 
-// AddUserToTokenIDs adds the "UserToToken" edge to the Token entity by ids.
-func (m *UserMutation) AddUserToTokenIDs(ids ...uuid.UUID) {
-	if m._UserToToken == nil {
-		m._UserToToken = make(map[uuid.UUID]struct{})
+// AddVmObjectToConsoleSessionIDs adds the "VmObjectToConsoleSessions" edge to the ConsoleSession entity by ids.
+func (m *VmObjectMutation) AddVmObjectToConsoleSessionIDs(ids ...uuid.UUID) {
+	if m._VmObjectToConsoleSessions == nil {
+		m._VmObjectToConsoleSessions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m._UserToToken[ids[i]] = struct{}{}
+		m._VmObjectToConsoleSessions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUserToToken clears the "UserToToken" edge to the Token entity.
-func (m *UserMutation) ClearUserToToken() {
-	m.cleared_UserToToken = true
+// ClearVmObjectToConsoleSessions clears the "VmObjectToConsoleSessions" edge to the ConsoleSession entity.
+func (m *VmObjectMutation) ClearVmObjectToConsoleSessions() {
+	m.cleared_VmObjectToConsoleSessions = true
 }
 
-// UserToTokenCleared reports if the "UserToToken" edge to the Token entity was cleared.
-func (m *UserMutation) UserToTokenCleared() bool {
-	return m.cleared_UserToToken
+// VmObjectToConsoleSessionsCleared reports if the "VmObjectToConsoleSessions" edge to the ConsoleSession entity was cleared.
+func (m *VmObjectMutation) VmObjectToConsoleSessionsCleared() bool {
+	return m.cleared_VmObjectToConsoleSessions
 }
 
-// RemoveUserToTokenIDs removes the "UserToToken" edge to the Token entity by IDs.
-func (m *UserMutation) RemoveUserToTokenIDs(ids ...uuid.UUID) {
-	if m.removed_UserToToken == nil {
-		m.removed_UserToToken = make(map[uuid.UUID]struct{})
+// RemoveVmObjectToConsoleSessionIDs removes the "VmObjectToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (m *VmObjectMutation) RemoveVmObjectToConsoleSessionIDs(ids ...uuid.UUID) {
+	if m.removed_VmObjectToConsoleSessions == nil {
+		m.removed_VmObjectToConsoleSessions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m._UserToToken, ids[i])
-		m.removed_UserToToken[ids[i]] = struct{}{}
+		delete(m._VmObjectToConsoleSessions, ids[i])
+		m.removed_VmObjectToConsoleSessions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUserToToken returns the removed IDs of the "UserToToken" edge to the Token entity.
-func (m *UserMutation) RemovedUserToTokenIDs() (ids []uuid.UUID) {
-	for id := range m.removed_UserToToken {
+// RemovedVmObjectToConsoleSessions returns the removed IDs of the "VmObjectToConsoleSessions" edge to the ConsoleSession entity.
+func (m *VmObjectMutation) RemovedVmObjectToConsoleSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_VmObjectToConsoleSessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UserToTokenIDs returns the "UserToToken" edge IDs in the mutation.
-func (m *UserMutation) UserToTokenIDs() (ids []uuid.UUID) {
-	for id := range m._UserToToken {
+// VmObjectToConsoleSessionsIDs returns the "VmObjectToConsoleSessions" edge IDs in the mutation.
+func (m *VmObjectMutation) VmObjectToConsoleSessionsIDs() (ids []uuid.UUID) {
+	for id := range m._VmObjectToConsoleSessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUserToToken resets all changes to the "UserToToken" edge.
-func (m *UserMutation) ResetUserToToken() {
-	m._UserToToken = nil
-	m.cleared_UserToToken = false
-	m.removed_UserToToken = nil
+// ResetVmObjectToConsoleSessions resets all changes to the "VmObjectToConsoleSessions" edge.
+func (m *VmObjectMutation) ResetVmObjectToConsoleSessions() {
+	m._VmObjectToConsoleSessions = nil
+	m.cleared_VmObjectToConsoleSessions = false
+	m.removed_VmObjectToConsoleSessions = nil
+}
+
+// AddVmObjectToVmCredentialIDs adds the "VmObjectToVmCredentials" edge to the VmCredential entity by ids.
+func (m *VmObjectMutation) AddVmObjectToVmCredentialIDs(ids ...uuid.UUID) {
+	if m._VmObjectToVmCredentials == nil {
+		m._VmObjectToVmCredentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m._VmObjectToVmCredentials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVmObjectToVmCredentials clears the "VmObjectToVmCredentials" edge to the VmCredential entity.
+func (m *VmObjectMutation) ClearVmObjectToVmCredentials() {
+	m.cleared_VmObjectToVmCredentials = true
+}
+
+// VmObjectToVmCredentialsCleared reports if the "VmObjectToVmCredentials" edge to the VmCredential entity was cleared.
+func (m *VmObjectMutation) VmObjectToVmCredentialsCleared() bool {
+	return m.cleared_VmObjectToVmCredentials
+}
+
+// RemoveVmObjectToVmCredentialIDs removes the "VmObjectToVmCredentials" edge to the VmCredential entity by IDs.
+func (m *VmObjectMutation) RemoveVmObjectToVmCredentialIDs(ids ...uuid.UUID) {
+	if m.removed_VmObjectToVmCredentials == nil {
+		m.removed_VmObjectToVmCredentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m._VmObjectToVmCredentials, ids[i])
+		m.removed_VmObjectToVmCredentials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVmObjectToVmCredentials returns the removed IDs of the "VmObjectToVmCredentials" edge to the VmCredential entity.
+func (m *VmObjectMutation) RemovedVmObjectToVmCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.removed_VmObjectToVmCredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VmObjectToVmCredentialsIDs returns the "VmObjectToVmCredentials" edge IDs in the mutation.
+func (m *VmObjectMutation) VmObjectToVmCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m._VmObjectToVmCredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVmObjectToVmCredentials resets all changes to the "VmObjectToVmCredentials" edge.
+func (m *VmObjectMutation) ResetVmObjectToVmCredentials() {
+	m._VmObjectToVmCredentials = nil
+	m.cleared_VmObjectToVmCredentials = false
+	m.removed_VmObjectToVmCredentials = nil
 }
 
-// AddUserToActionIDs adds the "UserToActions" edge to the Action entity by ids.
-func (m *UserMutation) AddUserToActionIDs(ids ...uuid.UUID) {
-	if m._UserToActions == nil {
-		m._UserToActions = make(map[uuid.UUID]struct{})
+// AddVmObjectToConsoleShareIDs adds the "VmObjectToConsoleShares" edge to the ConsoleShare entity by ids.
+func (m *VmObjectMutation) AddVmObjectToConsoleShareIDs(ids ...uuid.UUID) {
+	if m._VmObjectToConsoleShares == nil {
+		m._VmObjectToConsoleShares = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m._UserToActions[ids[i]] = struct{}{}
+		m._VmObjectToConsoleShares[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUserToActions clears the "UserToActions" edge to the Action entity.
-func (m *UserMutation) ClearUserToActions() {
-	m.cleared_UserToActions = true
+// ClearVmObjectToConsoleShares clears the "VmObjectToConsoleShares" edge to the ConsoleShare entity.
+func (m *VmObjectMutation) ClearVmObjectToConsoleShares() {
+	m.cleared_VmObjectToConsoleShares = true
 }
 
-// UserToActionsCleared reports if the "UserToActions" edge to the Action entity was cleared.
-func (m *UserMutation) UserToActionsCleared() bool {
-	return m.cleared_UserToActions
+// VmObjectToConsoleSharesCleared reports if the "VmObjectToConsoleShares" edge to the ConsoleShare entity was cleared.
+func (m *VmObjectMutation) VmObjectToConsoleSharesCleared() bool {
+	return m.cleared_VmObjectToConsoleShares
 }
 
-// RemoveUserToActionIDs removes the "UserToActions" edge to the Action entity by IDs.
-func (m *UserMutation) RemoveUserToActionIDs(ids ...uuid.UUID) {
-	if m.removed_UserToActions == nil {
-		m.removed_UserToActions = make(map[uuid.UUID]struct{})
+// RemoveVmObjectToConsoleShareIDs removes the "VmObjectToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (m *VmObjectMutation) RemoveVmObjectToConsoleShareIDs(ids ...uuid.UUID) {
+	if m.removed_VmObjectToConsoleShares == nil {
+		m.removed_VmObjectToConsoleShares = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m._UserToActions, ids[i])
-		m.removed_UserToActions[ids[i]] = struct{}{}
+		delete(m._VmObjectToConsoleShares, ids[i])
+		m.removed_VmObjectToConsoleShares[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUserToActions returns the removed IDs of the "UserToActions" edge to the Action entity.
-func (m *UserMutation) RemovedUserToActionsIDs() (ids []uuid.UUID) {
-	for id := range m.removed_UserToActions {
+// RemovedVmObjectToConsoleShares returns the removed IDs of the "VmObjectToConsoleShares" edge to the ConsoleShare entity.
+func (m *VmObjectMutation) RemovedVmObjectToConsoleSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removed_VmObjectToConsoleShares {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UserToActionsIDs returns the "UserToActions" edge IDs in the mutation.
-func (m *UserMutation) UserToActionsIDs() (ids []uuid.UUID) {
-	for id := range m._UserToActions {
+// VmObjectToConsoleSharesIDs returns the "VmObjectToConsoleShares" edge IDs in the mutation.
+func (m *VmObjectMutation) VmObjectToConsoleSharesIDs() (ids []uuid.UUID) {
+	for id := range m._VmObjectToConsoleShares {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUserToActions resets all changes to the "UserToActions" edge.
-func (m *UserMutation) ResetUserToActions() {
-	m._UserToActions = nil
-	m.cleared_UserToActions = false
-	m.removed_UserToActions = nil
+// ResetVmObjectToConsoleShares resets all changes to the "VmObjectToConsoleShares" edge.
+func (m *VmObjectMutation) ResetVmObjectToConsoleShares() {
+	m._VmObjectToConsoleShares = nil
+	m.cleared_VmObjectToConsoleShares = false
+	m.removed_VmObjectToConsoleShares = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the VmObjectMutation builder.
+func (m *VmObjectMutation) Where(ps ...predicate.VmObject) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *VmObjectMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (VmObject).
+func (m *VmObjectMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+func (m *VmObjectMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, vmobject.FieldName)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.identifier != nil {
+		fields = append(fields, vmobject.FieldIdentifier)
 	}
-	if m.first_name != nil {
-		fields = append(fields, user.FieldFirstName)
+	if m.ip_addresses != nil {
+		fields = append(fields, vmobject.FieldIPAddresses)
 	}
-	if m.last_name != nil {
-		fields = append(fields, user.FieldLastName)
+	if m.locked != nil {
+		fields = append(fields, vmobject.FieldLocked)
 	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+	if m.red_team_access != nil {
+		fields = append(fields, vmobject.FieldRedTeamAccess)
 	}
-	if m.provider != nil {
-		fields = append(fields, user.FieldProvider)
+	if m.console_limit_per_vm != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerVM)
+	}
+	if m.console_limit_per_user != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerUser)
+	}
+	if m.console_limit_per_team != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerTeam)
 	}
 	return fields
 }
@@ -4500,20 +13944,24 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *VmObjectMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldFirstName:
-		return m.FirstName()
-	case user.FieldLastName:
-		return m.LastName()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldProvider:
-		return m.Provider()
+	case vmobject.FieldName:
+		return m.Name()
+	case vmobject.FieldIdentifier:
+		return m.Identifier()
+	case vmobject.FieldIPAddresses:
+		return m.IPAddresses()
+	case vmobject.FieldLocked:
+		return m.Locked()
+	case vmobject.FieldRedTeamAccess:
+		return m.RedTeamAccess()
+	case vmobject.FieldConsoleLimitPerVM:
+		return m.ConsoleLimitPerVM()
+	case vmobject.FieldConsoleLimitPerUser:
+		return m.ConsoleLimitPerUser()
+	case vmobject.FieldConsoleLimitPerTeam:
+		return m.ConsoleLimitPerTeam()
 	}
 	return nil, false
 }
@@ -4521,174 +13969,273 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VmObjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case user.FieldLastName:
-		return m.OldLastName(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldProvider:
-		return m.OldProvider(ctx)
+	case vmobject.FieldName:
+		return m.OldName(ctx)
+	case vmobject.FieldIdentifier:
+		return m.OldIdentifier(ctx)
+	case vmobject.FieldIPAddresses:
+		return m.OldIPAddresses(ctx)
+	case vmobject.FieldLocked:
+		return m.OldLocked(ctx)
+	case vmobject.FieldRedTeamAccess:
+		return m.OldRedTeamAccess(ctx)
+	case vmobject.FieldConsoleLimitPerVM:
+		return m.OldConsoleLimitPerVM(ctx)
+	case vmobject.FieldConsoleLimitPerUser:
+		return m.OldConsoleLimitPerUser(ctx)
+	case vmobject.FieldConsoleLimitPerTeam:
+		return m.OldConsoleLimitPerTeam(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown VmObject field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *VmObjectMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
+	case vmobject.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetName(v)
 		return nil
-	case user.FieldPassword:
+	case vmobject.FieldIdentifier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetIdentifier(v)
 		return nil
-	case user.FieldFirstName:
-		v, ok := value.(string)
+	case vmobject.FieldIPAddresses:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstName(v)
+		m.SetIPAddresses(v)
 		return nil
-	case user.FieldLastName:
-		v, ok := value.(string)
+	case vmobject.FieldLocked:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastName(v)
+		m.SetLocked(v)
 		return nil
-	case user.FieldRole:
-		v, ok := value.(user.Role)
+	case vmobject.FieldRedTeamAccess:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetRedTeamAccess(v)
 		return nil
-	case user.FieldProvider:
-		v, ok := value.(user.Provider)
+	case vmobject.FieldConsoleLimitPerVM:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.SetConsoleLimitPerVM(v)
+		return nil
+	case vmobject.FieldConsoleLimitPerUser:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsoleLimitPerUser(v)
+		return nil
+	case vmobject.FieldConsoleLimitPerTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsoleLimitPerTeam(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VmObject field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *VmObjectMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsole_limit_per_vm != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerVM)
+	}
+	if m.addconsole_limit_per_user != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerUser)
+	}
+	if m.addconsole_limit_per_team != nil {
+		fields = append(fields, vmobject.FieldConsoleLimitPerTeam)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VmObjectMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vmobject.FieldConsoleLimitPerVM:
+		return m.AddedConsoleLimitPerVM()
+	case vmobject.FieldConsoleLimitPerUser:
+		return m.AddedConsoleLimitPerUser()
+	case vmobject.FieldConsoleLimitPerTeam:
+		return m.AddedConsoleLimitPerTeam()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *VmObjectMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case vmobject.FieldConsoleLimitPerVM:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerVM(v)
+		return nil
+	case vmobject.FieldConsoleLimitPerUser:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerUser(v)
+		return nil
+	case vmobject.FieldConsoleLimitPerTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsoleLimitPerTeam(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown VmObject numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *VmObjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vmobject.FieldIPAddresses) {
+		fields = append(fields, vmobject.FieldIPAddresses)
+	}
+	if m.FieldCleared(vmobject.FieldConsoleLimitPerVM) {
+		fields = append(fields, vmobject.FieldConsoleLimitPerVM)
+	}
+	if m.FieldCleared(vmobject.FieldConsoleLimitPerUser) {
+		fields = append(fields, vmobject.FieldConsoleLimitPerUser)
+	}
+	if m.FieldCleared(vmobject.FieldConsoleLimitPerTeam) {
+		fields = append(fields, vmobject.FieldConsoleLimitPerTeam)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *VmObjectMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *VmObjectMutation) ClearField(name string) error {
+	switch name {
+	case vmobject.FieldIPAddresses:
+		m.ClearIPAddresses()
+		return nil
+	case vmobject.FieldConsoleLimitPerVM:
+		m.ClearConsoleLimitPerVM()
+		return nil
+	case vmobject.FieldConsoleLimitPerUser:
+		m.ClearConsoleLimitPerUser()
+		return nil
+	case vmobject.FieldConsoleLimitPerTeam:
+		m.ClearConsoleLimitPerTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown VmObject nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *VmObjectMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
+	case vmobject.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case vmobject.FieldIdentifier:
+		m.ResetIdentifier()
 		return nil
-	case user.FieldFirstName:
-		m.ResetFirstName()
+	case vmobject.FieldIPAddresses:
+		m.ResetIPAddresses()
 		return nil
-	case user.FieldLastName:
-		m.ResetLastName()
+	case vmobject.FieldLocked:
+		m.ResetLocked()
 		return nil
-	case user.FieldRole:
-		m.ResetRole()
+	case vmobject.FieldRedTeamAccess:
+		m.ResetRedTeamAccess()
 		return nil
-	case user.FieldProvider:
-		m.ResetProvider()
+	case vmobject.FieldConsoleLimitPerVM:
+		m.ResetConsoleLimitPerVM()
+		return nil
+	case vmobject.FieldConsoleLimitPerUser:
+		m.ResetConsoleLimitPerUser()
+		return nil
+	case vmobject.FieldConsoleLimitPerTeam:
+		m.ResetConsoleLimitPerTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VmObject field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m._UserToTeam != nil {
-		edges = append(edges, user.EdgeUserToTeam)
+func (m *VmObjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._VmObjectToTeam != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToTeam)
 	}
-	if m._UserToToken != nil {
-		edges = append(edges, user.EdgeUserToToken)
+	if m._VmObjectToConsoleSessions != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleSessions)
 	}
-	if m._UserToActions != nil {
-		edges = append(edges, user.EdgeUserToActions)
+	if m._VmObjectToVmCredentials != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToVmCredentials)
+	}
+	if m._VmObjectToConsoleShares != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleShares)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *VmObjectMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeUserToTeam:
-		if id := m._UserToTeam; id != nil {
+	case vmobject.EdgeVmObjectToTeam:
+		if id := m._VmObjectToTeam; id != nil {
 			return []ent.Value{*id}
 		}
-	case user.EdgeUserToToken:
-		ids := make([]ent.Value, 0, len(m._UserToToken))
-		for id := range m._UserToToken {
+	case vmobject.EdgeVmObjectToConsoleSessions:
+		ids := make([]ent.Value, 0, len(m._VmObjectToConsoleSessions))
+		for id := range m._VmObjectToConsoleSessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case vmobject.EdgeVmObjectToVmCredentials:
+		ids := make([]ent.Value, 0, len(m._VmObjectToVmCredentials))
+		for id := range m._VmObjectToVmCredentials {
 			ids = append(ids, id)
 		}
 		return ids
-	case user.EdgeUserToActions:
-		ids := make([]ent.Value, 0, len(m._UserToActions))
-		for id := range m._UserToActions {
+	case vmobject.EdgeVmObjectToConsoleShares:
+		ids := make([]ent.Value, 0, len(m._VmObjectToConsoleShares))
+		for id := range m._VmObjectToConsoleShares {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4697,30 +14244,39 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removed_UserToToken != nil {
-		edges = append(edges, user.EdgeUserToToken)
+func (m *VmObjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removed_VmObjectToConsoleSessions != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleSessions)
 	}
-	if m.removed_UserToActions != nil {
-		edges = append(edges, user.EdgeUserToActions)
+	if m.removed_VmObjectToVmCredentials != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToVmCredentials)
+	}
+	if m.removed_VmObjectToConsoleShares != nil {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleShares)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *VmObjectMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeUserToToken:
-		ids := make([]ent.Value, 0, len(m.removed_UserToToken))
-		for id := range m.removed_UserToToken {
+	case vmobject.EdgeVmObjectToConsoleSessions:
+		ids := make([]ent.Value, 0, len(m.removed_VmObjectToConsoleSessions))
+		for id := range m.removed_VmObjectToConsoleSessions {
 			ids = append(ids, id)
 		}
 		return ids
-	case user.EdgeUserToActions:
-		ids := make([]ent.Value, 0, len(m.removed_UserToActions))
-		for id := range m.removed_UserToActions {
+	case vmobject.EdgeVmObjectToVmCredentials:
+		ids := make([]ent.Value, 0, len(m.removed_VmObjectToVmCredentials))
+		for id := range m.removed_VmObjectToVmCredentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case vmobject.EdgeVmObjectToConsoleShares:
+		ids := make([]ent.Value, 0, len(m.removed_VmObjectToConsoleShares))
+		for id := range m.removed_VmObjectToConsoleShares {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4729,91 +14285,107 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleared_UserToTeam {
-		edges = append(edges, user.EdgeUserToTeam)
+func (m *VmObjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_VmObjectToTeam {
+		edges = append(edges, vmobject.EdgeVmObjectToTeam)
 	}
-	if m.cleared_UserToToken {
-		edges = append(edges, user.EdgeUserToToken)
+	if m.cleared_VmObjectToConsoleSessions {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleSessions)
 	}
-	if m.cleared_UserToActions {
-		edges = append(edges, user.EdgeUserToActions)
+	if m.cleared_VmObjectToVmCredentials {
+		edges = append(edges, vmobject.EdgeVmObjectToVmCredentials)
+	}
+	if m.cleared_VmObjectToConsoleShares {
+		edges = append(edges, vmobject.EdgeVmObjectToConsoleShares)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *VmObjectMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeUserToTeam:
-		return m.cleared_UserToTeam
-	case user.EdgeUserToToken:
-		return m.cleared_UserToToken
-	case user.EdgeUserToActions:
-		return m.cleared_UserToActions
+	case vmobject.EdgeVmObjectToTeam:
+		return m.cleared_VmObjectToTeam
+	case vmobject.EdgeVmObjectToConsoleSessions:
+		return m.cleared_VmObjectToConsoleSessions
+	case vmobject.EdgeVmObjectToVmCredentials:
+		return m.cleared_VmObjectToVmCredentials
+	case vmobject.EdgeVmObjectToConsoleShares:
+		return m.cleared_VmObjectToConsoleShares
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *VmObjectMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeUserToTeam:
-		m.ClearUserToTeam()
+	case vmobject.EdgeVmObjectToTeam:
+		m.ClearVmObjectToTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown VmObject unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *VmObjectMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeUserToTeam:
-		m.ResetUserToTeam()
+	case vmobject.EdgeVmObjectToTeam:
+		m.ResetVmObjectToTeam()
 		return nil
-	case user.EdgeUserToToken:
-		m.ResetUserToToken()
+	case vmobject.EdgeVmObjectToConsoleSessions:
+		m.ResetVmObjectToConsoleSessions()
 		return nil
-	case user.EdgeUserToActions:
-		m.ResetUserToActions()
+	case vmobject.EdgeVmObjectToVmCredentials:
+		m.ResetVmObjectToVmCredentials()
+		return nil
+	case vmobject.EdgeVmObjectToConsoleShares:
+		m.ResetVmObjectToConsoleShares()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown VmObject edge %s", name)
 }
 
-// VmObjectMutation represents an operation that mutates the VmObject nodes in the graph.
-type VmObjectMutation struct {
+// WebauthnCredentialMutation represents an operation that mutates the WebauthnCredential nodes in the graph.
+type WebauthnCredentialMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	name                   *string
-	identifier             *string
-	ip_addresses           *[]string
-	locked                 *bool
-	clearedFields          map[string]struct{}
-	_VmObjectToTeam        *uuid.UUID
-	cleared_VmObjectToTeam bool
-	done                   bool
-	oldValue               func(context.Context) (*VmObject, error)
-	predicates             []predicate.VmObject
-}
-
-var _ ent.Mutation = (*VmObjectMutation)(nil)
-
-// vmobjectOption allows management of the mutation configuration using functional options.
-type vmobjectOption func(*VmObjectMutation)
-
-// newVmObjectMutation creates new mutation for the VmObject entity.
-func newVmObjectMutation(c config, op Op, opts ...vmobjectOption) *VmObjectMutation {
-	m := &VmObjectMutation{
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	name                             *string
+	credential_id                    *[]byte
+	public_key                       *[]byte
+	attestation_type                 *string
+	aaguid                           *[]byte
+	sign_count                       *uint32
+	addsign_count                    *int32
+	transports                       *[]string
+	backup_eligible                  *bool
+	backup_state                     *bool
+	created_at                       *time.Time
+	last_used_at                     *time.Time
+	clearedFields                    map[string]struct{}
+	_WebauthnCredentialToUser        *uuid.UUID
+	cleared_WebauthnCredentialToUser bool
+	done                             bool
+	oldValue                         func(context.Context) (*WebauthnCredential, error)
+	predicates                       []predicate.WebauthnCredential
+}
+
+var _ ent.Mutation = (*WebauthnCredentialMutation)(nil)
+
+// webauthncredentialOption allows management of the mutation configuration using functional options.
+type webauthncredentialOption func(*WebauthnCredentialMutation)
+
+// newWebauthnCredentialMutation creates new mutation for the WebauthnCredential entity.
+func newWebauthnCredentialMutation(c config, op Op, opts ...webauthncredentialOption) *WebauthnCredentialMutation {
+	m := &WebauthnCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeVmObject,
+		typ:           TypeWebauthnCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4822,20 +14394,20 @@ func newVmObjectMutation(c config, op Op, opts ...vmobjectOption) *VmObjectMutat
 	return m
 }
 
-// withVmObjectID sets the ID field of the mutation.
-func withVmObjectID(id uuid.UUID) vmobjectOption {
-	return func(m *VmObjectMutation) {
+// withWebauthnCredentialID sets the ID field of the mutation.
+func withWebauthnCredentialID(id uuid.UUID) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *VmObject
+			value *WebauthnCredential
 		)
-		m.oldValue = func(ctx context.Context) (*VmObject, error) {
+		m.oldValue = func(ctx context.Context) (*WebauthnCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().VmObject.Get(ctx, id)
+					value, err = m.Client().WebauthnCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4844,10 +14416,10 @@ func withVmObjectID(id uuid.UUID) vmobjectOption {
 	}
 }
 
-// withVmObject sets the old VmObject of the mutation.
-func withVmObject(node *VmObject) vmobjectOption {
-	return func(m *VmObjectMutation) {
-		m.oldValue = func(context.Context) (*VmObject, error) {
+// withWebauthnCredential sets the old WebauthnCredential of the mutation.
+func withWebauthnCredential(node *WebauthnCredential) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
+		m.oldValue = func(context.Context) (*WebauthnCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4856,7 +14428,7 @@ func withVmObject(node *VmObject) vmobjectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m VmObjectMutation) Client() *Client {
+func (m WebauthnCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4864,7 +14436,7 @@ func (m VmObjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m VmObjectMutation) Tx() (*Tx, error) {
+func (m WebauthnCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4874,14 +14446,14 @@ func (m VmObjectMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of VmObject entities.
-func (m *VmObjectMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebauthnCredential entities.
+func (m *WebauthnCredentialMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *VmObjectMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebauthnCredentialMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4892,7 +14464,7 @@ func (m *VmObjectMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *VmObjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebauthnCredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4901,239 +14473,558 @@ func (m *VmObjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().VmObject.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebauthnCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *VmObjectMutation) SetName(s string) {
-	m.name = &s
+// SetName sets the "name" field.
+func (m *WebauthnCredentialMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebauthnCredentialMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WebauthnCredentialMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (m *WebauthnCredentialMutation) SetCredentialID(b []byte) {
+	m.credential_id = &b
+}
+
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *WebauthnCredentialMutation) CredentialID() (r []byte, exists bool) {
+	v := m.credential_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialID returns the old "credential_id" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldCredentialID(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
+	}
+	return oldValue.CredentialID, nil
+}
+
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *WebauthnCredentialMutation) ResetCredentialID() {
+	m.credential_id = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *WebauthnCredentialMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *WebauthnCredentialMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *WebauthnCredentialMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (m *WebauthnCredentialMutation) SetAttestationType(s string) {
+	m.attestation_type = &s
+}
+
+// AttestationType returns the value of the "attestation_type" field in the mutation.
+func (m *WebauthnCredentialMutation) AttestationType() (r string, exists bool) {
+	v := m.attestation_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttestationType returns the old "attestation_type" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldAttestationType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttestationType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttestationType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttestationType: %w", err)
+	}
+	return oldValue.AttestationType, nil
+}
+
+// ResetAttestationType resets all changes to the "attestation_type" field.
+func (m *WebauthnCredentialMutation) ResetAttestationType() {
+	m.attestation_type = nil
+}
+
+// SetAaguid sets the "aaguid" field.
+func (m *WebauthnCredentialMutation) SetAaguid(b []byte) {
+	m.aaguid = &b
+}
+
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *WebauthnCredentialMutation) Aaguid() (r []byte, exists bool) {
+	v := m.aaguid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAaguid returns the old "aaguid" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldAaguid(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
+	}
+	return oldValue.Aaguid, nil
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *WebauthnCredentialMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[webauthncredential.FieldAaguid] = struct{}{}
+}
+
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAaguid]
+	return ok
+}
+
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *WebauthnCredentialMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, webauthncredential.FieldAaguid)
+}
+
+// SetSignCount sets the "sign_count" field.
+func (m *WebauthnCredentialMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
+}
+
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *WebauthnCredentialMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignCount returns the old "sign_count" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
+	}
+	return oldValue.SignCount, nil
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (m *WebauthnCredentialMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
+	} else {
+		m.addsign_count = &u
+	}
+}
+
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *WebauthnCredentialMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *WebauthnCredentialMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
+}
+
+// SetTransports sets the "transports" field.
+func (m *WebauthnCredentialMutation) SetTransports(s []string) {
+	m.transports = &s
+}
+
+// Transports returns the value of the "transports" field in the mutation.
+func (m *WebauthnCredentialMutation) Transports() (r []string, exists bool) {
+	v := m.transports
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransports returns the old "transports" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebauthnCredentialMutation) OldTransports(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransports requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
+	}
+	return oldValue.Transports, nil
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (m *WebauthnCredentialMutation) ClearTransports() {
+	m.transports = nil
+	m.clearedFields[webauthncredential.FieldTransports] = struct{}{}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *VmObjectMutation) Name() (r string, exists bool) {
-	v := m.name
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldTransports]
+	return ok
+}
+
+// ResetTransports resets all changes to the "transports" field.
+func (m *WebauthnCredentialMutation) ResetTransports() {
+	m.transports = nil
+	delete(m.clearedFields, webauthncredential.FieldTransports)
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (m *WebauthnCredentialMutation) SetBackupEligible(b bool) {
+	m.backup_eligible = &b
+}
+
+// BackupEligible returns the value of the "backup_eligible" field in the mutation.
+func (m *WebauthnCredentialMutation) BackupEligible() (r bool, exists bool) {
+	v := m.backup_eligible
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the VmObject entity.
-// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// OldBackupEligible returns the old "backup_eligible" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VmObjectMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldBackupEligible(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackupEligible is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldBackupEligible requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackupEligible: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.BackupEligible, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *VmObjectMutation) ResetName() {
-	m.name = nil
+// ResetBackupEligible resets all changes to the "backup_eligible" field.
+func (m *WebauthnCredentialMutation) ResetBackupEligible() {
+	m.backup_eligible = nil
 }
 
-// SetIdentifier sets the "identifier" field.
-func (m *VmObjectMutation) SetIdentifier(s string) {
-	m.identifier = &s
+// SetBackupState sets the "backup_state" field.
+func (m *WebauthnCredentialMutation) SetBackupState(b bool) {
+	m.backup_state = &b
 }
 
-// Identifier returns the value of the "identifier" field in the mutation.
-func (m *VmObjectMutation) Identifier() (r string, exists bool) {
-	v := m.identifier
+// BackupState returns the value of the "backup_state" field in the mutation.
+func (m *WebauthnCredentialMutation) BackupState() (r bool, exists bool) {
+	v := m.backup_state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIdentifier returns the old "identifier" field's value of the VmObject entity.
-// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// OldBackupState returns the old "backup_state" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VmObjectMutation) OldIdentifier(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldBackupState(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIdentifier is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackupState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIdentifier requires an ID field in the mutation")
+		return v, errors.New("OldBackupState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIdentifier: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackupState: %w", err)
 	}
-	return oldValue.Identifier, nil
+	return oldValue.BackupState, nil
 }
 
-// ResetIdentifier resets all changes to the "identifier" field.
-func (m *VmObjectMutation) ResetIdentifier() {
-	m.identifier = nil
+// ResetBackupState resets all changes to the "backup_state" field.
+func (m *WebauthnCredentialMutation) ResetBackupState() {
+	m.backup_state = nil
 }
 
-// SetIPAddresses sets the "ip_addresses" field.
-func (m *VmObjectMutation) SetIPAddresses(s []string) {
-	m.ip_addresses = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WebauthnCredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// IPAddresses returns the value of the "ip_addresses" field in the mutation.
-func (m *VmObjectMutation) IPAddresses() (r []string, exists bool) {
-	v := m.ip_addresses
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebauthnCredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddresses returns the old "ip_addresses" field's value of the VmObject entity.
-// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VmObjectMutation) OldIPAddresses(ctx context.Context) (v []string, err error) {
+func (m *WebauthnCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddresses is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddresses requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddresses: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.IPAddresses, nil
-}
-
-// ClearIPAddresses clears the value of the "ip_addresses" field.
-func (m *VmObjectMutation) ClearIPAddresses() {
-	m.ip_addresses = nil
-	m.clearedFields[vmobject.FieldIPAddresses] = struct{}{}
-}
-
-// IPAddressesCleared returns if the "ip_addresses" field was cleared in this mutation.
-func (m *VmObjectMutation) IPAddressesCleared() bool {
-	_, ok := m.clearedFields[vmobject.FieldIPAddresses]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIPAddresses resets all changes to the "ip_addresses" field.
-func (m *VmObjectMutation) ResetIPAddresses() {
-	m.ip_addresses = nil
-	delete(m.clearedFields, vmobject.FieldIPAddresses)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebauthnCredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLocked sets the "locked" field.
-func (m *VmObjectMutation) SetLocked(b bool) {
-	m.locked = &b
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *WebauthnCredentialMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Locked returns the value of the "locked" field in the mutation.
-func (m *VmObjectMutation) Locked() (r bool, exists bool) {
-	v := m.locked
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *WebauthnCredentialMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocked returns the old "locked" field's value of the VmObject entity.
-// If the VmObject object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VmObjectMutation) OldLocked(ctx context.Context) (v bool, err error) {
+func (m *WebauthnCredentialMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocked requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Locked, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetLocked resets all changes to the "locked" field.
-func (m *VmObjectMutation) ResetLocked() {
-	m.locked = nil
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[webauthncredential.FieldLastUsedAt] = struct{}{}
 }
 
-// SetVmObjectToTeamID sets the "VmObjectToTeam" edge to the Team entity by id.
-func (m *VmObjectMutation) SetVmObjectToTeamID(id uuid.UUID) {
-	m._VmObjectToTeam = &id
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldLastUsedAt]
+	return ok
 }
 
-// ClearVmObjectToTeam clears the "VmObjectToTeam" edge to the Team entity.
-func (m *VmObjectMutation) ClearVmObjectToTeam() {
-	m.cleared_VmObjectToTeam = true
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, webauthncredential.FieldLastUsedAt)
 }
 
-// VmObjectToTeamCleared reports if the "VmObjectToTeam" edge to the Team entity was cleared.
-func (m *VmObjectMutation) VmObjectToTeamCleared() bool {
-	return m.cleared_VmObjectToTeam
+// SetWebauthnCredentialToUserID sets the "WebauthnCredentialToUser" edge to the User entity by id.
+func (m *WebauthnCredentialMutation) SetWebauthnCredentialToUserID(id uuid.UUID) {
+	m._WebauthnCredentialToUser = &id
 }
 
-// VmObjectToTeamID returns the "VmObjectToTeam" edge ID in the mutation.
-func (m *VmObjectMutation) VmObjectToTeamID() (id uuid.UUID, exists bool) {
-	if m._VmObjectToTeam != nil {
-		return *m._VmObjectToTeam, true
+// ClearWebauthnCredentialToUser clears the "WebauthnCredentialToUser" edge to the User entity.
+func (m *WebauthnCredentialMutation) ClearWebauthnCredentialToUser() {
+	m.cleared_WebauthnCredentialToUser = true
+}
+
+// WebauthnCredentialToUserCleared reports if the "WebauthnCredentialToUser" edge to the User entity was cleared.
+func (m *WebauthnCredentialMutation) WebauthnCredentialToUserCleared() bool {
+	return m.cleared_WebauthnCredentialToUser
+}
+
+// WebauthnCredentialToUserID returns the "WebauthnCredentialToUser" edge ID in the mutation.
+func (m *WebauthnCredentialMutation) WebauthnCredentialToUserID() (id uuid.UUID, exists bool) {
+	if m._WebauthnCredentialToUser != nil {
+		return *m._WebauthnCredentialToUser, true
 	}
 	return
 }
 
-// VmObjectToTeamIDs returns the "VmObjectToTeam" edge IDs in the mutation.
+// WebauthnCredentialToUserIDs returns the "WebauthnCredentialToUser" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VmObjectToTeamID instead. It exists only for internal usage by the builders.
-func (m *VmObjectMutation) VmObjectToTeamIDs() (ids []uuid.UUID) {
-	if id := m._VmObjectToTeam; id != nil {
+// WebauthnCredentialToUserID instead. It exists only for internal usage by the builders.
+func (m *WebauthnCredentialMutation) WebauthnCredentialToUserIDs() (ids []uuid.UUID) {
+	if id := m._WebauthnCredentialToUser; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetVmObjectToTeam resets all changes to the "VmObjectToTeam" edge.
-func (m *VmObjectMutation) ResetVmObjectToTeam() {
-	m._VmObjectToTeam = nil
-	m.cleared_VmObjectToTeam = false
+// ResetWebauthnCredentialToUser resets all changes to the "WebauthnCredentialToUser" edge.
+func (m *WebauthnCredentialMutation) ResetWebauthnCredentialToUser() {
+	m._WebauthnCredentialToUser = nil
+	m.cleared_WebauthnCredentialToUser = false
 }
 
-// Where appends a list predicates to the VmObjectMutation builder.
-func (m *VmObjectMutation) Where(ps ...predicate.VmObject) {
+// Where appends a list predicates to the WebauthnCredentialMutation builder.
+func (m *WebauthnCredentialMutation) Where(ps ...predicate.WebauthnCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *VmObjectMutation) Op() Op {
+func (m *WebauthnCredentialMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (VmObject).
-func (m *VmObjectMutation) Type() string {
+// Type returns the node type of this mutation (WebauthnCredential).
+func (m *WebauthnCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *VmObjectMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *WebauthnCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.name != nil {
-		fields = append(fields, vmobject.FieldName)
+		fields = append(fields, webauthncredential.FieldName)
 	}
-	if m.identifier != nil {
-		fields = append(fields, vmobject.FieldIdentifier)
+	if m.credential_id != nil {
+		fields = append(fields, webauthncredential.FieldCredentialID)
 	}
-	if m.ip_addresses != nil {
-		fields = append(fields, vmobject.FieldIPAddresses)
+	if m.public_key != nil {
+		fields = append(fields, webauthncredential.FieldPublicKey)
 	}
-	if m.locked != nil {
-		fields = append(fields, vmobject.FieldLocked)
+	if m.attestation_type != nil {
+		fields = append(fields, webauthncredential.FieldAttestationType)
+	}
+	if m.aaguid != nil {
+		fields = append(fields, webauthncredential.FieldAaguid)
+	}
+	if m.sign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	if m.transports != nil {
+		fields = append(fields, webauthncredential.FieldTransports)
+	}
+	if m.backup_eligible != nil {
+		fields = append(fields, webauthncredential.FieldBackupEligible)
+	}
+	if m.backup_state != nil {
+		fields = append(fields, webauthncredential.FieldBackupState)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webauthncredential.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -5141,16 +15032,30 @@ func (m *VmObjectMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *VmObjectMutation) Field(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case vmobject.FieldName:
+	case webauthncredential.FieldName:
 		return m.Name()
-	case vmobject.FieldIdentifier:
-		return m.Identifier()
-	case vmobject.FieldIPAddresses:
-		return m.IPAddresses()
-	case vmobject.FieldLocked:
-		return m.Locked()
+	case webauthncredential.FieldCredentialID:
+		return m.CredentialID()
+	case webauthncredential.FieldPublicKey:
+		return m.PublicKey()
+	case webauthncredential.FieldAttestationType:
+		return m.AttestationType()
+	case webauthncredential.FieldAaguid:
+		return m.Aaguid()
+	case webauthncredential.FieldSignCount:
+		return m.SignCount()
+	case webauthncredential.FieldTransports:
+		return m.Transports()
+	case webauthncredential.FieldBackupEligible:
+		return m.BackupEligible()
+	case webauthncredential.FieldBackupState:
+		return m.BackupState()
+	case webauthncredential.FieldCreatedAt:
+		return m.CreatedAt()
+	case webauthncredential.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -5158,142 +15063,253 @@ func (m *VmObjectMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *VmObjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebauthnCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case vmobject.FieldName:
+	case webauthncredential.FieldName:
 		return m.OldName(ctx)
-	case vmobject.FieldIdentifier:
-		return m.OldIdentifier(ctx)
-	case vmobject.FieldIPAddresses:
-		return m.OldIPAddresses(ctx)
-	case vmobject.FieldLocked:
-		return m.OldLocked(ctx)
-	}
-	return nil, fmt.Errorf("unknown VmObject field %s", name)
+	case webauthncredential.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case webauthncredential.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case webauthncredential.FieldAttestationType:
+		return m.OldAttestationType(ctx)
+	case webauthncredential.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case webauthncredential.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case webauthncredential.FieldTransports:
+		return m.OldTransports(ctx)
+	case webauthncredential.FieldBackupEligible:
+		return m.OldBackupEligible(ctx)
+	case webauthncredential.FieldBackupState:
+		return m.OldBackupState(ctx)
+	case webauthncredential.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webauthncredential.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VmObjectMutation) SetField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case vmobject.FieldName:
+	case webauthncredential.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case vmobject.FieldIdentifier:
+	case webauthncredential.FieldCredentialID:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialID(v)
+		return nil
+	case webauthncredential.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case webauthncredential.FieldAttestationType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIdentifier(v)
+		m.SetAttestationType(v)
 		return nil
-	case vmobject.FieldIPAddresses:
+	case webauthncredential.FieldAaguid:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAaguid(v)
+		return nil
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignCount(v)
+		return nil
+	case webauthncredential.FieldTransports:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddresses(v)
+		m.SetTransports(v)
 		return nil
-	case vmobject.FieldLocked:
+	case webauthncredential.FieldBackupEligible:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocked(v)
+		m.SetBackupEligible(v)
+		return nil
+	case webauthncredential.FieldBackupState:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackupState(v)
+		return nil
+	case webauthncredential.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown VmObject field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *VmObjectMutation) AddedFields() []string {
-	return nil
+func (m *WebauthnCredentialMutation) AddedFields() []string {
+	var fields []string
+	if m.addsign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *VmObjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webauthncredential.FieldSignCount:
+		return m.AddedSignCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VmObjectMutation) AddField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSignCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown VmObject numeric field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *VmObjectMutation) ClearedFields() []string {
+func (m *WebauthnCredentialMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(vmobject.FieldIPAddresses) {
-		fields = append(fields, vmobject.FieldIPAddresses)
+	if m.FieldCleared(webauthncredential.FieldAaguid) {
+		fields = append(fields, webauthncredential.FieldAaguid)
+	}
+	if m.FieldCleared(webauthncredential.FieldTransports) {
+		fields = append(fields, webauthncredential.FieldTransports)
+	}
+	if m.FieldCleared(webauthncredential.FieldLastUsedAt) {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *VmObjectMutation) FieldCleared(name string) bool {
+func (m *WebauthnCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *VmObjectMutation) ClearField(name string) error {
+func (m *WebauthnCredentialMutation) ClearField(name string) error {
 	switch name {
-	case vmobject.FieldIPAddresses:
-		m.ClearIPAddresses()
+	case webauthncredential.FieldAaguid:
+		m.ClearAaguid()
+		return nil
+	case webauthncredential.FieldTransports:
+		m.ClearTransports()
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown VmObject nullable field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *VmObjectMutation) ResetField(name string) error {
+func (m *WebauthnCredentialMutation) ResetField(name string) error {
 	switch name {
-	case vmobject.FieldName:
+	case webauthncredential.FieldName:
 		m.ResetName()
 		return nil
-	case vmobject.FieldIdentifier:
-		m.ResetIdentifier()
+	case webauthncredential.FieldCredentialID:
+		m.ResetCredentialID()
 		return nil
-	case vmobject.FieldIPAddresses:
-		m.ResetIPAddresses()
+	case webauthncredential.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case vmobject.FieldLocked:
-		m.ResetLocked()
+	case webauthncredential.FieldAttestationType:
+		m.ResetAttestationType()
+		return nil
+	case webauthncredential.FieldAaguid:
+		m.ResetAaguid()
+		return nil
+	case webauthncredential.FieldSignCount:
+		m.ResetSignCount()
+		return nil
+	case webauthncredential.FieldTransports:
+		m.ResetTransports()
+		return nil
+	case webauthncredential.FieldBackupEligible:
+		m.ResetBackupEligible()
+		return nil
+	case webauthncredential.FieldBackupState:
+		m.ResetBackupState()
+		return nil
+	case webauthncredential.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown VmObject field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *VmObjectMutation) AddedEdges() []string {
+func (m *WebauthnCredentialMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m._VmObjectToTeam != nil {
-		edges = append(edges, vmobject.EdgeVmObjectToTeam)
+	if m._WebauthnCredentialToUser != nil {
+		edges = append(edges, webauthncredential.EdgeWebauthnCredentialToUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *VmObjectMutation) AddedIDs(name string) []ent.Value {
+func (m *WebauthnCredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case vmobject.EdgeVmObjectToTeam:
-		if id := m._VmObjectToTeam; id != nil {
+	case webauthncredential.EdgeWebauthnCredentialToUser:
+		if id := m._WebauthnCredentialToUser; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5301,56 +15317,56 @@ func (m *VmObjectMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *VmObjectMutation) RemovedEdges() []string {
+func (m *WebauthnCredentialMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *VmObjectMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebauthnCredentialMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *VmObjectMutation) ClearedEdges() []string {
+func (m *WebauthnCredentialMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleared_VmObjectToTeam {
-		edges = append(edges, vmobject.EdgeVmObjectToTeam)
+	if m.cleared_WebauthnCredentialToUser {
+		edges = append(edges, webauthncredential.EdgeWebauthnCredentialToUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *VmObjectMutation) EdgeCleared(name string) bool {
+func (m *WebauthnCredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case vmobject.EdgeVmObjectToTeam:
-		return m.cleared_VmObjectToTeam
+	case webauthncredential.EdgeWebauthnCredentialToUser:
+		return m.cleared_WebauthnCredentialToUser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *VmObjectMutation) ClearEdge(name string) error {
+func (m *WebauthnCredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case vmobject.EdgeVmObjectToTeam:
-		m.ClearVmObjectToTeam()
+	case webauthncredential.EdgeWebauthnCredentialToUser:
+		m.ClearWebauthnCredentialToUser()
 		return nil
 	}
-	return fmt.Errorf("unknown VmObject unique edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *VmObjectMutation) ResetEdge(name string) error {
+func (m *WebauthnCredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case vmobject.EdgeVmObjectToTeam:
-		m.ResetVmObjectToTeam()
+	case webauthncredential.EdgeWebauthnCredentialToUser:
+		m.ResetWebauthnCredentialToUser()
 		return nil
 	}
-	return fmt.Errorf("unknown VmObject edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential edge %s", name)
 }
@@ -0,0 +1,228 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessToken is the model entity for the PersonalAccessToken schema.
+type PersonalAccessToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	// [REQUIRED] The display name the user gave the token.
+	Name string `json:"name,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	// [REQUIRED] The SHA-256 hash of the token. The token itself is never stored.
+	TokenHash string `json:"-"`
+	// Prefix holds the value of the "prefix" field.
+	// [REQUIRED] The start of the token, so users can tell their tokens apart.
+	Prefix string `json:"prefix,omitempty"`
+	// Scope holds the value of the "scope" field.
+	// [REQUIRED] READ_ONLY tokens can't run mutations.
+	Scope personalaccesstoken.Scope `json:"scope,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	// [REQUIRED] (default is now) When the token was created.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	// [OPTIONAL] The token can't be used after this time. Never expires when not set.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	// [INTERNAL] When the token was last used. Only updated about once a minute.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	// [INTERNAL] The IP address the token was last used from.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PersonalAccessTokenQuery when eager-loading is set.
+	Edges                               PersonalAccessTokenEdges `json:"edges"`
+	user_user_to_personal_access_tokens *uuid.UUID
+}
+
+// PersonalAccessTokenEdges holds the relations/edges for other nodes in the graph.
+type PersonalAccessTokenEdges struct {
+	// PersonalAccessTokenToUser holds the value of the PersonalAccessTokenToUser edge.
+	PersonalAccessTokenToUser *User `json:"PersonalAccessTokenToUser,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PersonalAccessTokenToUserOrErr returns the PersonalAccessTokenToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PersonalAccessTokenEdges) PersonalAccessTokenToUserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.PersonalAccessTokenToUser == nil {
+			// The edge PersonalAccessTokenToUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.PersonalAccessTokenToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "PersonalAccessTokenToUser"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PersonalAccessToken) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldName, personalaccesstoken.FieldTokenHash, personalaccesstoken.FieldPrefix, personalaccesstoken.FieldScope, personalaccesstoken.FieldLastUsedIP:
+			values[i] = new(sql.NullString)
+		case personalaccesstoken.FieldCreatedAt, personalaccesstoken.FieldExpiresAt, personalaccesstoken.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case personalaccesstoken.FieldID:
+			values[i] = new(uuid.UUID)
+		case personalaccesstoken.ForeignKeys[0]: // user_user_to_personal_access_tokens
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PersonalAccessToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PersonalAccessToken fields.
+func (pat *PersonalAccessToken) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pat.ID = *value
+			}
+		case personalaccesstoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pat.Name = value.String
+			}
+		case personalaccesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				pat.TokenHash = value.String
+			}
+		case personalaccesstoken.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				pat.Prefix = value.String
+			}
+		case personalaccesstoken.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				pat.Scope = personalaccesstoken.Scope(value.String)
+			}
+		case personalaccesstoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pat.CreatedAt = value.Time
+			}
+		case personalaccesstoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pat.ExpiresAt = new(time.Time)
+				*pat.ExpiresAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				pat.LastUsedAt = new(time.Time)
+				*pat.LastUsedAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				pat.LastUsedIP = value.String
+			}
+		case personalaccesstoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_user_to_personal_access_tokens", values[i])
+			} else if value.Valid {
+				pat.user_user_to_personal_access_tokens = new(uuid.UUID)
+				*pat.user_user_to_personal_access_tokens = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPersonalAccessTokenToUser queries the "PersonalAccessTokenToUser" edge of the PersonalAccessToken entity.
+func (pat *PersonalAccessToken) QueryPersonalAccessTokenToUser() *UserQuery {
+	return (&PersonalAccessTokenClient{config: pat.config}).QueryPersonalAccessTokenToUser(pat)
+}
+
+// Update returns a builder for updating this PersonalAccessToken.
+// Note that you need to call PersonalAccessToken.Unwrap() before calling this method if this PersonalAccessToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pat *PersonalAccessToken) Update() *PersonalAccessTokenUpdateOne {
+	return (&PersonalAccessTokenClient{config: pat.config}).UpdateOne(pat)
+}
+
+// Unwrap unwraps the PersonalAccessToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pat *PersonalAccessToken) Unwrap() *PersonalAccessToken {
+	tx, ok := pat.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PersonalAccessToken is not a transactional entity")
+	}
+	pat.config.driver = tx.drv
+	return pat
+}
+
+// String implements the fmt.Stringer.
+func (pat *PersonalAccessToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("PersonalAccessToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", pat.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(pat.Name)
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", prefix=")
+	builder.WriteString(pat.Prefix)
+	builder.WriteString(", scope=")
+	builder.WriteString(fmt.Sprintf("%v", pat.Scope))
+	builder.WriteString(", created_at=")
+	builder.WriteString(pat.CreatedAt.Format(time.ANSIC))
+	if v := pat.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := pat.LastUsedAt; v != nil {
+		builder.WriteString(", last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", last_used_ip=")
+	builder.WriteString(pat.LastUsedIP)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PersonalAccessTokens is a parsable slice of PersonalAccessToken.
+type PersonalAccessTokens []*PersonalAccessToken
+
+func (pat PersonalAccessTokens) config(cfg config) {
+	for _i := range pat {
+		pat[_i].config = cfg
+	}
+}
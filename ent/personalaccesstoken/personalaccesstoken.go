@@ -0,0 +1,128 @@
+// Code generated by entc, DO NOT EDIT.
+
+package personalaccesstoken
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the personalaccesstoken type in the database.
+	Label = "personal_access_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldLastUsedIP holds the string denoting the last_used_ip field in the database.
+	FieldLastUsedIP = "last_used_ip"
+	// EdgePersonalAccessTokenToUser holds the string denoting the personalaccesstokentouser edge name in mutations.
+	EdgePersonalAccessTokenToUser = "PersonalAccessTokenToUser"
+	// Table holds the table name of the personalaccesstoken in the database.
+	Table = "personal_access_tokens"
+	// PersonalAccessTokenToUserTable is the table that holds the PersonalAccessTokenToUser relation/edge.
+	PersonalAccessTokenToUserTable = "personal_access_tokens"
+	// PersonalAccessTokenToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	PersonalAccessTokenToUserInverseTable = "users"
+	// PersonalAccessTokenToUserColumn is the table column denoting the PersonalAccessTokenToUser relation/edge.
+	PersonalAccessTokenToUserColumn = "user_user_to_personal_access_tokens"
+)
+
+// Columns holds all SQL columns for personalaccesstoken fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTokenHash,
+	FieldPrefix,
+	FieldScope,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldLastUsedAt,
+	FieldLastUsedIP,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "personal_access_tokens"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_user_to_personal_access_tokens",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Scope defines the type for the "scope" enum field.
+type Scope string
+
+// Scope values.
+const (
+	ScopeREAD_ONLY Scope = "READ_ONLY"
+	ScopeFULL      Scope = "FULL"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopeREAD_ONLY, ScopeFULL:
+		return nil
+	default:
+		return fmt.Errorf("personalaccesstoken: invalid enum value for scope field: %q", s)
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (s Scope) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(s.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (s *Scope) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*s = Scope(str)
+	if err := ScopeValidator(*s); err != nil {
+		return fmt.Errorf("%s is not a valid Scope", str)
+	}
+	return nil
+}
@@ -0,0 +1,437 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenCreate is the builder for creating a PersonalAccessToken entity.
+type PersonalAccessTokenCreate struct {
+	config
+	mutation *PersonalAccessTokenMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (patc *PersonalAccessTokenCreate) SetName(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetName(s)
+	return patc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patc *PersonalAccessTokenCreate) SetTokenHash(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetTokenHash(s)
+	return patc
+}
+
+// SetPrefix sets the "prefix" field.
+func (patc *PersonalAccessTokenCreate) SetPrefix(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetPrefix(s)
+	return patc
+}
+
+// SetScope sets the "scope" field.
+func (patc *PersonalAccessTokenCreate) SetScope(pe personalaccesstoken.Scope) *PersonalAccessTokenCreate {
+	patc.mutation.SetScope(pe)
+	return patc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (patc *PersonalAccessTokenCreate) SetCreatedAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetCreatedAt(t)
+	return patc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableCreatedAt(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetCreatedAt(*t)
+	}
+	return patc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patc *PersonalAccessTokenCreate) SetExpiresAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetExpiresAt(t)
+	return patc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetExpiresAt(*t)
+	}
+	return patc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patc *PersonalAccessTokenCreate) SetLastUsedAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetLastUsedAt(t)
+	return patc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetLastUsedAt(*t)
+	}
+	return patc
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (patc *PersonalAccessTokenCreate) SetLastUsedIP(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetLastUsedIP(s)
+	return patc
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableLastUsedIP(s *string) *PersonalAccessTokenCreate {
+	if s != nil {
+		patc.SetLastUsedIP(*s)
+	}
+	return patc
+}
+
+// SetID sets the "id" field.
+func (patc *PersonalAccessTokenCreate) SetID(u uuid.UUID) *PersonalAccessTokenCreate {
+	patc.mutation.SetID(u)
+	return patc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableID(u *uuid.UUID) *PersonalAccessTokenCreate {
+	if u != nil {
+		patc.SetID(*u)
+	}
+	return patc
+}
+
+// SetPersonalAccessTokenToUserID sets the "PersonalAccessTokenToUser" edge to the User entity by ID.
+func (patc *PersonalAccessTokenCreate) SetPersonalAccessTokenToUserID(id uuid.UUID) *PersonalAccessTokenCreate {
+	patc.mutation.SetPersonalAccessTokenToUserID(id)
+	return patc
+}
+
+// SetPersonalAccessTokenToUser sets the "PersonalAccessTokenToUser" edge to the User entity.
+func (patc *PersonalAccessTokenCreate) SetPersonalAccessTokenToUser(u *User) *PersonalAccessTokenCreate {
+	return patc.SetPersonalAccessTokenToUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patc *PersonalAccessTokenCreate) Mutation() *PersonalAccessTokenMutation {
+	return patc.mutation
+}
+
+// Save creates the PersonalAccessToken in the database.
+func (patc *PersonalAccessTokenCreate) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	var (
+		err  error
+		node *PersonalAccessToken
+	)
+	patc.defaults()
+	if len(patc.hooks) == 0 {
+		if err = patc.check(); err != nil {
+			return nil, err
+		}
+		node, err = patc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PersonalAccessTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = patc.check(); err != nil {
+				return nil, err
+			}
+			patc.mutation = mutation
+			if node, err = patc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(patc.hooks) - 1; i >= 0; i-- {
+			if patc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = patc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, patc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (patc *PersonalAccessTokenCreate) SaveX(ctx context.Context) *PersonalAccessToken {
+	v, err := patc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (patc *PersonalAccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := patc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patc *PersonalAccessTokenCreate) ExecX(ctx context.Context) {
+	if err := patc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (patc *PersonalAccessTokenCreate) defaults() {
+	if _, ok := patc.mutation.CreatedAt(); !ok {
+		v := personalaccesstoken.DefaultCreatedAt()
+		patc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := patc.mutation.ID(); !ok {
+		v := personalaccesstoken.DefaultID()
+		patc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patc *PersonalAccessTokenCreate) check() error {
+	if _, ok := patc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PersonalAccessToken.name"`)}
+	}
+	if _, ok := patc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PersonalAccessToken.token_hash"`)}
+	}
+	if _, ok := patc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "PersonalAccessToken.prefix"`)}
+	}
+	if _, ok := patc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "PersonalAccessToken.scope"`)}
+	}
+	if v, ok := patc.mutation.Scope(); ok {
+		if err := personalaccesstoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.scope": %w`, err)}
+		}
+	}
+	if _, ok := patc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersonalAccessToken.created_at"`)}
+	}
+	if _, ok := patc.mutation.PersonalAccessTokenToUserID(); !ok {
+		return &ValidationError{Name: "PersonalAccessTokenToUser", err: errors.New(`ent: missing required edge "PersonalAccessToken.PersonalAccessTokenToUser"`)}
+	}
+	return nil
+}
+
+func (patc *PersonalAccessTokenCreate) sqlSave(ctx context.Context) (*PersonalAccessToken, error) {
+	_node, _spec := patc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, patc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (patc *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonalAccessToken{config: patc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: personalaccesstoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: personalaccesstoken.FieldID,
+			},
+		}
+	)
+	if id, ok := patc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := patc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := patc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := patc.mutation.Prefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldPrefix,
+		})
+		_node.Prefix = value
+	}
+	if value, ok := patc.mutation.Scope(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: personalaccesstoken.FieldScope,
+		})
+		_node.Scope = value
+	}
+	if value, ok := patc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := patc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := patc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := patc.mutation.LastUsedIP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedIP,
+		})
+		_node.LastUsedIP = value
+	}
+	if nodes := patc.mutation.PersonalAccessTokenToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.PersonalAccessTokenToUserTable,
+			Columns: []string{personalaccesstoken.PersonalAccessTokenToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_user_to_personal_access_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PersonalAccessTokenCreateBulk is the builder for creating many PersonalAccessToken entities in bulk.
+type PersonalAccessTokenCreateBulk struct {
+	config
+	builders []*PersonalAccessTokenCreate
+}
+
+// Save creates the PersonalAccessToken entities in the database.
+func (patcb *PersonalAccessTokenCreateBulk) Save(ctx context.Context) ([]*PersonalAccessToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(patcb.builders))
+	nodes := make([]*PersonalAccessToken, len(patcb.builders))
+	mutators := make([]Mutator, len(patcb.builders))
+	for i := range patcb.builders {
+		func(i int, root context.Context) {
+			builder := patcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonalAccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, patcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, patcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, patcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patcb *PersonalAccessTokenCreateBulk) SaveX(ctx context.Context) []*PersonalAccessToken {
+	v, err := patcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (patcb *PersonalAccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := patcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patcb *PersonalAccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := patcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
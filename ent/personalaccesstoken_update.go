@@ -0,0 +1,728 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenUpdate is the builder for updating PersonalAccessToken entities.
+type PersonalAccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (patu *PersonalAccessTokenUpdate) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdate {
+	patu.mutation.Where(ps...)
+	return patu
+}
+
+// SetName sets the "name" field.
+func (patu *PersonalAccessTokenUpdate) SetName(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetName(s)
+	return patu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patu *PersonalAccessTokenUpdate) SetTokenHash(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetTokenHash(s)
+	return patu
+}
+
+// SetPrefix sets the "prefix" field.
+func (patu *PersonalAccessTokenUpdate) SetPrefix(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetPrefix(s)
+	return patu
+}
+
+// SetScope sets the "scope" field.
+func (patu *PersonalAccessTokenUpdate) SetScope(pe personalaccesstoken.Scope) *PersonalAccessTokenUpdate {
+	patu.mutation.SetScope(pe)
+	return patu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (patu *PersonalAccessTokenUpdate) SetCreatedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetCreatedAt(t)
+	return patu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableCreatedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetCreatedAt(*t)
+	}
+	return patu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patu *PersonalAccessTokenUpdate) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetExpiresAt(t)
+	return patu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetExpiresAt(*t)
+	}
+	return patu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearExpiresAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearExpiresAt()
+	return patu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetLastUsedAt(t)
+	return patu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetLastUsedAt(*t)
+	}
+	return patu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearLastUsedAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearLastUsedAt()
+	return patu
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (patu *PersonalAccessTokenUpdate) SetLastUsedIP(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetLastUsedIP(s)
+	return patu
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableLastUsedIP(s *string) *PersonalAccessTokenUpdate {
+	if s != nil {
+		patu.SetLastUsedIP(*s)
+	}
+	return patu
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (patu *PersonalAccessTokenUpdate) ClearLastUsedIP() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearLastUsedIP()
+	return patu
+}
+
+// SetPersonalAccessTokenToUserID sets the "PersonalAccessTokenToUser" edge to the User entity by ID.
+func (patu *PersonalAccessTokenUpdate) SetPersonalAccessTokenToUserID(id uuid.UUID) *PersonalAccessTokenUpdate {
+	patu.mutation.SetPersonalAccessTokenToUserID(id)
+	return patu
+}
+
+// SetPersonalAccessTokenToUser sets the "PersonalAccessTokenToUser" edge to the User entity.
+func (patu *PersonalAccessTokenUpdate) SetPersonalAccessTokenToUser(u *User) *PersonalAccessTokenUpdate {
+	return patu.SetPersonalAccessTokenToUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patu *PersonalAccessTokenUpdate) Mutation() *PersonalAccessTokenMutation {
+	return patu.mutation
+}
+
+// ClearPersonalAccessTokenToUser clears the "PersonalAccessTokenToUser" edge to the User entity.
+func (patu *PersonalAccessTokenUpdate) ClearPersonalAccessTokenToUser() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearPersonalAccessTokenToUser()
+	return patu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (patu *PersonalAccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(patu.hooks) == 0 {
+		if err = patu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = patu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PersonalAccessTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = patu.check(); err != nil {
+				return 0, err
+			}
+			patu.mutation = mutation
+			affected, err = patu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(patu.hooks) - 1; i >= 0; i-- {
+			if patu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = patu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, patu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := patu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (patu *PersonalAccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := patu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := patu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patu *PersonalAccessTokenUpdate) check() error {
+	if v, ok := patu.mutation.Scope(); ok {
+		if err := personalaccesstoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.scope": %w`, err)}
+		}
+	}
+	if _, ok := patu.mutation.PersonalAccessTokenToUserID(); patu.mutation.PersonalAccessTokenToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.PersonalAccessTokenToUser"`)
+	}
+	return nil
+}
+
+func (patu *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   personalaccesstoken.Table,
+			Columns: personalaccesstoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: personalaccesstoken.FieldID,
+			},
+		},
+	}
+	if ps := patu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldName,
+		})
+	}
+	if value, ok := patu.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldTokenHash,
+		})
+	}
+	if value, ok := patu.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldPrefix,
+		})
+	}
+	if value, ok := patu.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: personalaccesstoken.FieldScope,
+		})
+	}
+	if value, ok := patu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldCreatedAt,
+		})
+	}
+	if value, ok := patu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldExpiresAt,
+		})
+	}
+	if patu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: personalaccesstoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := patu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedAt,
+		})
+	}
+	if patu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: personalaccesstoken.FieldLastUsedAt,
+		})
+	}
+	if value, ok := patu.mutation.LastUsedIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedIP,
+		})
+	}
+	if patu.mutation.LastUsedIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: personalaccesstoken.FieldLastUsedIP,
+		})
+	}
+	if patu.mutation.PersonalAccessTokenToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.PersonalAccessTokenToUserTable,
+			Columns: []string{personalaccesstoken.PersonalAccessTokenToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := patu.mutation.PersonalAccessTokenToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.PersonalAccessTokenToUserTable,
+			Columns: []string{personalaccesstoken.PersonalAccessTokenToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, patu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PersonalAccessTokenUpdateOne is the builder for updating a single PersonalAccessToken entity.
+type PersonalAccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// SetName sets the "name" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetName(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetName(s)
+	return patuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetTokenHash(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetTokenHash(s)
+	return patuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetPrefix(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetPrefix(s)
+	return patuo
+}
+
+// SetScope sets the "scope" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetScope(pe personalaccesstoken.Scope) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetScope(pe)
+	return patuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetCreatedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetCreatedAt(t)
+	return patuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetCreatedAt(*t)
+	}
+	return patuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetExpiresAt(t)
+	return patuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetExpiresAt(*t)
+	}
+	return patuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearExpiresAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearExpiresAt()
+	return patuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetLastUsedAt(t)
+	return patuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetLastUsedAt(*t)
+	}
+	return patuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearLastUsedAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearLastUsedAt()
+	return patuo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetLastUsedIP(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetLastUsedIP(s)
+	return patuo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableLastUsedIP(s *string) *PersonalAccessTokenUpdateOne {
+	if s != nil {
+		patuo.SetLastUsedIP(*s)
+	}
+	return patuo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearLastUsedIP() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearLastUsedIP()
+	return patuo
+}
+
+// SetPersonalAccessTokenToUserID sets the "PersonalAccessTokenToUser" edge to the User entity by ID.
+func (patuo *PersonalAccessTokenUpdateOne) SetPersonalAccessTokenToUserID(id uuid.UUID) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetPersonalAccessTokenToUserID(id)
+	return patuo
+}
+
+// SetPersonalAccessTokenToUser sets the "PersonalAccessTokenToUser" edge to the User entity.
+func (patuo *PersonalAccessTokenUpdateOne) SetPersonalAccessTokenToUser(u *User) *PersonalAccessTokenUpdateOne {
+	return patuo.SetPersonalAccessTokenToUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patuo *PersonalAccessTokenUpdateOne) Mutation() *PersonalAccessTokenMutation {
+	return patuo.mutation
+}
+
+// ClearPersonalAccessTokenToUser clears the "PersonalAccessTokenToUser" edge to the User entity.
+func (patuo *PersonalAccessTokenUpdateOne) ClearPersonalAccessTokenToUser() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearPersonalAccessTokenToUser()
+	return patuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (patuo *PersonalAccessTokenUpdateOne) Select(field string, fields ...string) *PersonalAccessTokenUpdateOne {
+	patuo.fields = append([]string{field}, fields...)
+	return patuo
+}
+
+// Save executes the query and returns the updated PersonalAccessToken entity.
+func (patuo *PersonalAccessTokenUpdateOne) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	var (
+		err  error
+		node *PersonalAccessToken
+	)
+	if len(patuo.hooks) == 0 {
+		if err = patuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = patuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PersonalAccessTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = patuo.check(); err != nil {
+				return nil, err
+			}
+			patuo.mutation = mutation
+			node, err = patuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(patuo.hooks) - 1; i >= 0; i-- {
+			if patuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = patuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, patuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) SaveX(ctx context.Context) *PersonalAccessToken {
+	node, err := patuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (patuo *PersonalAccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := patuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := patuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patuo *PersonalAccessTokenUpdateOne) check() error {
+	if v, ok := patuo.mutation.Scope(); ok {
+		if err := personalaccesstoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.scope": %w`, err)}
+		}
+	}
+	if _, ok := patuo.mutation.PersonalAccessTokenToUserID(); patuo.mutation.PersonalAccessTokenToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.PersonalAccessTokenToUser"`)
+	}
+	return nil
+}
+
+func (patuo *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *PersonalAccessToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   personalaccesstoken.Table,
+			Columns: personalaccesstoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: personalaccesstoken.FieldID,
+			},
+		},
+	}
+	id, ok := patuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersonalAccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := patuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personalaccesstoken.FieldID)
+		for _, f := range fields {
+			if !personalaccesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != personalaccesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := patuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldName,
+		})
+	}
+	if value, ok := patuo.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldTokenHash,
+		})
+	}
+	if value, ok := patuo.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldPrefix,
+		})
+	}
+	if value, ok := patuo.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: personalaccesstoken.FieldScope,
+		})
+	}
+	if value, ok := patuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldCreatedAt,
+		})
+	}
+	if value, ok := patuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldExpiresAt,
+		})
+	}
+	if patuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: personalaccesstoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := patuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedAt,
+		})
+	}
+	if patuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: personalaccesstoken.FieldLastUsedAt,
+		})
+	}
+	if value, ok := patuo.mutation.LastUsedIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: personalaccesstoken.FieldLastUsedIP,
+		})
+	}
+	if patuo.mutation.LastUsedIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: personalaccesstoken.FieldLastUsedIP,
+		})
+	}
+	if patuo.mutation.PersonalAccessTokenToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.PersonalAccessTokenToUserTable,
+			Columns: []string{personalaccesstoken.PersonalAccessTokenToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := patuo.mutation.PersonalAccessTokenToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.PersonalAccessTokenToUserTable,
+			Columns: []string{personalaccesstoken.PersonalAccessTokenToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PersonalAccessToken{config: patuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, patuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
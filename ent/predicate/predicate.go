@@ -12,6 +12,21 @@ type Action func(*sql.Selector)
 // Competition is the predicate function for competition builders.
 type Competition func(*sql.Selector)
 
+// CompetitionBreak is the predicate function for competitionbreak builders.
+type CompetitionBreak func(*sql.Selector)
+
+// ConsoleSession is the predicate function for consolesession builders.
+type ConsoleSession func(*sql.Selector)
+
+// ConsoleShare is the predicate function for consoleshare builders.
+type ConsoleShare func(*sql.Selector)
+
+// CustomRole is the predicate function for customrole builders.
+type CustomRole func(*sql.Selector)
+
+// PersonalAccessToken is the predicate function for personalaccesstoken builders.
+type PersonalAccessToken func(*sql.Selector)
+
 // Provider is the predicate function for provider builders.
 type Provider func(*sql.Selector)
 
@@ -21,14 +36,26 @@ type ServiceAccount func(*sql.Selector)
 // ServiceToken is the predicate function for servicetoken builders.
 type ServiceToken func(*sql.Selector)
 
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
 // Team is the predicate function for team builders.
 type Team func(*sql.Selector)
 
+// TeamMembership is the predicate function for teammembership builders.
+type TeamMembership func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// VmCredential is the predicate function for vmcredential builders.
+type VmCredential func(*sql.Selector)
+
 // VmObject is the predicate function for vmobject builders.
 type VmObject func(*sql.Selector)
+
+// WebauthnCredential is the predicate function for webauthncredential builders.
+type WebauthnCredential func(*sql.Selector)
@@ -3,6 +3,7 @@
 package provider
 
 import (
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -48,7 +49,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
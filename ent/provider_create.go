@@ -79,7 +79,9 @@ func (pc *ProviderCreate) Save(ctx context.Context) (*Provider, error) {
 		err  error
 		node *Provider
 	)
-	pc.defaults()
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -138,11 +140,15 @@ func (pc *ProviderCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pc *ProviderCreate) defaults() {
+func (pc *ProviderCreate) defaults() error {
 	if _, ok := pc.mutation.ID(); !ok {
+		if provider.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized provider.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := provider.DefaultID()
 		pc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
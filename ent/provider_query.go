@@ -343,6 +343,12 @@ func (pq *ProviderQuery) prepareQuery(ctx context.Context) error {
 		}
 		pq.sql = prev
 	}
+	if provider.Policy == nil {
+		return errors.New("ent: uninitialized provider.Policy (forgotten import ent/runtime?)")
+	}
+	if err := provider.Policy.EvalQuery(ctx, pq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2,7 +2,380 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/BradHacker/compsole/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/provider"
+	"github.com/BradHacker/compsole/ent/schema"
+	"github.com/BradHacker/compsole/ent/serviceaccount"
+	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/signingkey"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/token"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	action.Policy = privacy.NewPolicies(schema.Action{})
+	action.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := action.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	actionHooks := schema.Action{}.Hooks()
+
+	action.Hooks[1] = actionHooks[0]
+	actionFields := schema.Action{}.Fields()
+	_ = actionFields
+	// actionDescIPAddress is the schema descriptor for ip_address field.
+	actionDescIPAddress := actionFields[1].Descriptor()
+	// action.DefaultIPAddress holds the default value on creation for the ip_address field.
+	action.DefaultIPAddress = actionDescIPAddress.Default.(string)
+	// actionDescPerformedAt is the schema descriptor for performed_at field.
+	actionDescPerformedAt := actionFields[4].Descriptor()
+	// action.DefaultPerformedAt holds the default value on creation for the performed_at field.
+	action.DefaultPerformedAt = actionDescPerformedAt.Default.(func() time.Time)
+	// actionDescID is the schema descriptor for id field.
+	actionDescID := actionFields[0].Descriptor()
+	// action.DefaultID holds the default value on creation for the id field.
+	action.DefaultID = actionDescID.Default.(func() uuid.UUID)
+	competition.Policy = privacy.NewPolicies(schema.Competition{})
+	competition.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := competition.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	competitionFields := schema.Competition{}.Fields()
+	_ = competitionFields
+	// competitionDescConsoleLimitPerVM is the schema descriptor for console_limit_per_vm field.
+	competitionDescConsoleLimitPerVM := competitionFields[2].Descriptor()
+	// competition.DefaultConsoleLimitPerVM holds the default value on creation for the console_limit_per_vm field.
+	competition.DefaultConsoleLimitPerVM = competitionDescConsoleLimitPerVM.Default.(int)
+	// competition.ConsoleLimitPerVMValidator is a validator for the "console_limit_per_vm" field. It is called by the builders before save.
+	competition.ConsoleLimitPerVMValidator = competitionDescConsoleLimitPerVM.Validators[0].(func(int) error)
+	// competitionDescConsoleLimitPerUser is the schema descriptor for console_limit_per_user field.
+	competitionDescConsoleLimitPerUser := competitionFields[3].Descriptor()
+	// competition.DefaultConsoleLimitPerUser holds the default value on creation for the console_limit_per_user field.
+	competition.DefaultConsoleLimitPerUser = competitionDescConsoleLimitPerUser.Default.(int)
+	// competition.ConsoleLimitPerUserValidator is a validator for the "console_limit_per_user" field. It is called by the builders before save.
+	competition.ConsoleLimitPerUserValidator = competitionDescConsoleLimitPerUser.Validators[0].(func(int) error)
+	// competitionDescConsoleLimitPerTeam is the schema descriptor for console_limit_per_team field.
+	competitionDescConsoleLimitPerTeam := competitionFields[4].Descriptor()
+	// competition.DefaultConsoleLimitPerTeam holds the default value on creation for the console_limit_per_team field.
+	competition.DefaultConsoleLimitPerTeam = competitionDescConsoleLimitPerTeam.Default.(int)
+	// competition.ConsoleLimitPerTeamValidator is a validator for the "console_limit_per_team" field. It is called by the builders before save.
+	competition.ConsoleLimitPerTeamValidator = competitionDescConsoleLimitPerTeam.Validators[0].(func(int) error)
+	// competitionDescID is the schema descriptor for id field.
+	competitionDescID := competitionFields[0].Descriptor()
+	// competition.DefaultID holds the default value on creation for the id field.
+	competition.DefaultID = competitionDescID.Default.(func() uuid.UUID)
+	competitionbreakFields := schema.CompetitionBreak{}.Fields()
+	_ = competitionbreakFields
+	// competitionbreakDescName is the schema descriptor for name field.
+	competitionbreakDescName := competitionbreakFields[1].Descriptor()
+	// competitionbreak.DefaultName holds the default value on creation for the name field.
+	competitionbreak.DefaultName = competitionbreakDescName.Default.(string)
+	// competitionbreakDescID is the schema descriptor for id field.
+	competitionbreakDescID := competitionbreakFields[0].Descriptor()
+	// competitionbreak.DefaultID holds the default value on creation for the id field.
+	competitionbreak.DefaultID = competitionbreakDescID.Default.(func() uuid.UUID)
+	consolesessionFields := schema.ConsoleSession{}.Fields()
+	_ = consolesessionFields
+	// consolesessionDescIPAddress is the schema descriptor for ip_address field.
+	consolesessionDescIPAddress := consolesessionFields[2].Descriptor()
+	// consolesession.DefaultIPAddress holds the default value on creation for the ip_address field.
+	consolesession.DefaultIPAddress = consolesessionDescIPAddress.Default.(string)
+	// consolesessionDescStartedAt is the schema descriptor for started_at field.
+	consolesessionDescStartedAt := consolesessionFields[3].Descriptor()
+	// consolesession.DefaultStartedAt holds the default value on creation for the started_at field.
+	consolesession.DefaultStartedAt = consolesessionDescStartedAt.Default.(func() time.Time)
+	// consolesessionDescTranscript is the schema descriptor for transcript field.
+	consolesessionDescTranscript := consolesessionFields[5].Descriptor()
+	// consolesession.DefaultTranscript holds the default value on creation for the transcript field.
+	consolesession.DefaultTranscript = consolesessionDescTranscript.Default.(string)
+	// consolesessionDescID is the schema descriptor for id field.
+	consolesessionDescID := consolesessionFields[0].Descriptor()
+	// consolesession.DefaultID holds the default value on creation for the id field.
+	consolesession.DefaultID = consolesessionDescID.Default.(func() uuid.UUID)
+	consoleshareFields := schema.ConsoleShare{}.Fields()
+	_ = consoleshareFields
+	// consoleshareDescPassword is the schema descriptor for password field.
+	consoleshareDescPassword := consoleshareFields[3].Descriptor()
+	// consoleshare.DefaultPassword holds the default value on creation for the password field.
+	consoleshare.DefaultPassword = consoleshareDescPassword.Default.(string)
+	// consoleshareDescCreatedAt is the schema descriptor for created_at field.
+	consoleshareDescCreatedAt := consoleshareFields[4].Descriptor()
+	// consoleshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	consoleshare.DefaultCreatedAt = consoleshareDescCreatedAt.Default.(func() time.Time)
+	// consoleshareDescRevoked is the schema descriptor for revoked field.
+	consoleshareDescRevoked := consoleshareFields[6].Descriptor()
+	// consoleshare.DefaultRevoked holds the default value on creation for the revoked field.
+	consoleshare.DefaultRevoked = consoleshareDescRevoked.Default.(bool)
+	// consoleshareDescID is the schema descriptor for id field.
+	consoleshareDescID := consoleshareFields[0].Descriptor()
+	// consoleshare.DefaultID holds the default value on creation for the id field.
+	consoleshare.DefaultID = consoleshareDescID.Default.(func() uuid.UUID)
+	customroleFields := schema.CustomRole{}.Fields()
+	_ = customroleFields
+	// customroleDescDescription is the schema descriptor for description field.
+	customroleDescDescription := customroleFields[2].Descriptor()
+	// customrole.DefaultDescription holds the default value on creation for the description field.
+	customrole.DefaultDescription = customroleDescDescription.Default.(string)
+	// customroleDescID is the schema descriptor for id field.
+	customroleDescID := customroleFields[0].Descriptor()
+	// customrole.DefaultID holds the default value on creation for the id field.
+	customrole.DefaultID = customroleDescID.Default.(func() uuid.UUID)
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenFields[5].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescID is the schema descriptor for id field.
+	personalaccesstokenDescID := personalaccesstokenFields[0].Descriptor()
+	// personalaccesstoken.DefaultID holds the default value on creation for the id field.
+	personalaccesstoken.DefaultID = personalaccesstokenDescID.Default.(func() uuid.UUID)
+	provider.Policy = privacy.NewPolicies(schema.Provider{})
+	provider.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := provider.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	providerFields := schema.Provider{}.Fields()
+	_ = providerFields
+	// providerDescID is the schema descriptor for id field.
+	providerDescID := providerFields[0].Descriptor()
+	// provider.DefaultID holds the default value on creation for the id field.
+	provider.DefaultID = providerDescID.Default.(func() uuid.UUID)
+	serviceaccountFields := schema.ServiceAccount{}.Fields()
+	_ = serviceaccountFields
+	// serviceaccountDescSecretGeneration is the schema descriptor for secret_generation field.
+	serviceaccountDescSecretGeneration := serviceaccountFields[7].Descriptor()
+	// serviceaccount.DefaultSecretGeneration holds the default value on creation for the secret_generation field.
+	serviceaccount.DefaultSecretGeneration = serviceaccountDescSecretGeneration.Default.(int)
+	// serviceaccountDescID is the schema descriptor for id field.
+	serviceaccountDescID := serviceaccountFields[0].Descriptor()
+	// serviceaccount.DefaultID holds the default value on creation for the id field.
+	serviceaccount.DefaultID = serviceaccountDescID.Default.(func() uuid.UUID)
+	servicetokenFields := schema.ServiceToken{}.Fields()
+	_ = servicetokenFields
+	// servicetokenDescSecretGeneration is the schema descriptor for secret_generation field.
+	servicetokenDescSecretGeneration := servicetokenFields[4].Descriptor()
+	// servicetoken.DefaultSecretGeneration holds the default value on creation for the secret_generation field.
+	servicetoken.DefaultSecretGeneration = servicetokenDescSecretGeneration.Default.(int)
+	// servicetokenDescID is the schema descriptor for id field.
+	servicetokenDescID := servicetokenFields[0].Descriptor()
+	// servicetoken.DefaultID holds the default value on creation for the id field.
+	servicetoken.DefaultID = servicetokenDescID.Default.(func() uuid.UUID)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[4].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	// signingkeyDescID is the schema descriptor for id field.
+	signingkeyDescID := signingkeyFields[0].Descriptor()
+	// signingkey.DefaultID holds the default value on creation for the id field.
+	signingkey.DefaultID = signingkeyDescID.Default.(func() uuid.UUID)
+	team.Policy = privacy.NewPolicies(schema.Team{})
+	team.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := team.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescID is the schema descriptor for id field.
+	teamDescID := teamFields[0].Descriptor()
+	// team.DefaultID holds the default value on creation for the id field.
+	team.DefaultID = teamDescID.Default.(func() uuid.UUID)
+	teammembership.Policy = privacy.NewPolicies(schema.TeamMembership{})
+	teammembership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := teammembership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	teammembershipFields := schema.TeamMembership{}.Fields()
+	_ = teammembershipFields
+	// teammembershipDescID is the schema descriptor for id field.
+	teammembershipDescID := teammembershipFields[0].Descriptor()
+	// teammembership.DefaultID holds the default value on creation for the id field.
+	teammembership.DefaultID = teammembershipDescID.Default.(func() uuid.UUID)
+	tokenFields := schema.Token{}.Fields()
+	_ = tokenFields
+	// tokenDescCreatedAt is the schema descriptor for created_at field.
+	tokenDescCreatedAt := tokenFields[3].Descriptor()
+	// token.DefaultCreatedAt holds the default value on creation for the created_at field.
+	token.DefaultCreatedAt = tokenDescCreatedAt.Default.(func() time.Time)
+	// tokenDescID is the schema descriptor for id field.
+	tokenDescID := tokenFields[0].Descriptor()
+	// token.DefaultID holds the default value on creation for the id field.
+	token.DefaultID = tokenDescID.Default.(func() uuid.UUID)
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescFirstName is the schema descriptor for first_name field.
+	userDescFirstName := userFields[3].Descriptor()
+	// user.DefaultFirstName holds the default value on creation for the first_name field.
+	user.DefaultFirstName = userDescFirstName.Default.(string)
+	// userDescLastName is the schema descriptor for last_name field.
+	userDescLastName := userFields[4].Descriptor()
+	// user.DefaultLastName holds the default value on creation for the last_name field.
+	user.DefaultLastName = userDescLastName.Default.(string)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[10].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastCounter is the schema descriptor for totp_last_counter field.
+	userDescTotpLastCounter := userFields[11].Descriptor()
+	// user.DefaultTotpLastCounter holds the default value on creation for the totp_last_counter field.
+	user.DefaultTotpLastCounter = userDescTotpLastCounter.Default.(int64)
+	// userDescMustChangePassword is the schema descriptor for must_change_password field.
+	userDescMustChangePassword := userFields[13].Descriptor()
+	// user.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	user.DefaultMustChangePassword = userDescMustChangePassword.Default.(bool)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	vmcredentialFields := schema.VmCredential{}.Fields()
+	_ = vmcredentialFields
+	// vmcredentialDescPort is the schema descriptor for port field.
+	vmcredentialDescPort := vmcredentialFields[2].Descriptor()
+	// vmcredential.DefaultPort holds the default value on creation for the port field.
+	vmcredential.DefaultPort = vmcredentialDescPort.Default.(int)
+	// vmcredentialDescUsername is the schema descriptor for username field.
+	vmcredentialDescUsername := vmcredentialFields[3].Descriptor()
+	// vmcredential.DefaultUsername holds the default value on creation for the username field.
+	vmcredential.DefaultUsername = vmcredentialDescUsername.Default.(string)
+	// vmcredentialDescPassword is the schema descriptor for password field.
+	vmcredentialDescPassword := vmcredentialFields[4].Descriptor()
+	// vmcredential.DefaultPassword holds the default value on creation for the password field.
+	vmcredential.DefaultPassword = vmcredentialDescPassword.Default.(string)
+	// vmcredentialDescPrivateKey is the schema descriptor for private_key field.
+	vmcredentialDescPrivateKey := vmcredentialFields[5].Descriptor()
+	// vmcredential.DefaultPrivateKey holds the default value on creation for the private_key field.
+	vmcredential.DefaultPrivateKey = vmcredentialDescPrivateKey.Default.(string)
+	// vmcredentialDescDomain is the schema descriptor for domain field.
+	vmcredentialDescDomain := vmcredentialFields[6].Descriptor()
+	// vmcredential.DefaultDomain holds the default value on creation for the domain field.
+	vmcredential.DefaultDomain = vmcredentialDescDomain.Default.(string)
+	// vmcredentialDescIgnoreCert is the schema descriptor for ignore_cert field.
+	vmcredentialDescIgnoreCert := vmcredentialFields[7].Descriptor()
+	// vmcredential.DefaultIgnoreCert holds the default value on creation for the ignore_cert field.
+	vmcredential.DefaultIgnoreCert = vmcredentialDescIgnoreCert.Default.(bool)
+	// vmcredentialDescID is the schema descriptor for id field.
+	vmcredentialDescID := vmcredentialFields[0].Descriptor()
+	// vmcredential.DefaultID holds the default value on creation for the id field.
+	vmcredential.DefaultID = vmcredentialDescID.Default.(func() uuid.UUID)
+	vmobject.Policy = privacy.NewPolicies(schema.VmObject{})
+	vmobject.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := vmobject.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	vmobjectFields := schema.VmObject{}.Fields()
+	_ = vmobjectFields
+	// vmobjectDescLocked is the schema descriptor for locked field.
+	vmobjectDescLocked := vmobjectFields[4].Descriptor()
+	// vmobject.DefaultLocked holds the default value on creation for the locked field.
+	vmobject.DefaultLocked = vmobjectDescLocked.Default.(bool)
+	// vmobjectDescRedTeamAccess is the schema descriptor for red_team_access field.
+	vmobjectDescRedTeamAccess := vmobjectFields[5].Descriptor()
+	// vmobject.DefaultRedTeamAccess holds the default value on creation for the red_team_access field.
+	vmobject.DefaultRedTeamAccess = vmobjectDescRedTeamAccess.Default.(bool)
+	// vmobjectDescConsoleLimitPerVM is the schema descriptor for console_limit_per_vm field.
+	vmobjectDescConsoleLimitPerVM := vmobjectFields[6].Descriptor()
+	// vmobject.ConsoleLimitPerVMValidator is a validator for the "console_limit_per_vm" field. It is called by the builders before save.
+	vmobject.ConsoleLimitPerVMValidator = vmobjectDescConsoleLimitPerVM.Validators[0].(func(int) error)
+	// vmobjectDescConsoleLimitPerUser is the schema descriptor for console_limit_per_user field.
+	vmobjectDescConsoleLimitPerUser := vmobjectFields[7].Descriptor()
+	// vmobject.ConsoleLimitPerUserValidator is a validator for the "console_limit_per_user" field. It is called by the builders before save.
+	vmobject.ConsoleLimitPerUserValidator = vmobjectDescConsoleLimitPerUser.Validators[0].(func(int) error)
+	// vmobjectDescConsoleLimitPerTeam is the schema descriptor for console_limit_per_team field.
+	vmobjectDescConsoleLimitPerTeam := vmobjectFields[8].Descriptor()
+	// vmobject.ConsoleLimitPerTeamValidator is a validator for the "console_limit_per_team" field. It is called by the builders before save.
+	vmobject.ConsoleLimitPerTeamValidator = vmobjectDescConsoleLimitPerTeam.Validators[0].(func(int) error)
+	// vmobjectDescID is the schema descriptor for id field.
+	vmobjectDescID := vmobjectFields[0].Descriptor()
+	// vmobject.DefaultID holds the default value on creation for the id field.
+	vmobject.DefaultID = vmobjectDescID.Default.(func() uuid.UUID)
+	webauthncredentialFields := schema.WebauthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredentialDescAttestationType is the schema descriptor for attestation_type field.
+	webauthncredentialDescAttestationType := webauthncredentialFields[4].Descriptor()
+	// webauthncredential.DefaultAttestationType holds the default value on creation for the attestation_type field.
+	webauthncredential.DefaultAttestationType = webauthncredentialDescAttestationType.Default.(string)
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	webauthncredentialDescBackupEligible := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	webauthncredential.DefaultBackupEligible = webauthncredentialDescBackupEligible.Default.(bool)
+	// webauthncredentialDescBackupState is the schema descriptor for backup_state field.
+	webauthncredentialDescBackupState := webauthncredentialFields[9].Descriptor()
+	// webauthncredential.DefaultBackupState holds the default value on creation for the backup_state field.
+	webauthncredential.DefaultBackupState = webauthncredentialDescBackupState.Default.(bool)
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialFields[10].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.DefaultID holds the default value on creation for the id field.
+	webauthncredential.DefaultID = webauthncredentialDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.10.1"                                         // Version of ent codegen.
@@ -1,11 +1,18 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	gen "github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/hook"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -21,7 +28,7 @@ func (Action) Fields() []ent.Field {
 			Default(uuid.New).
 			StorageKey("oid"),
 		field.String("ip_address").Default(""),
-		field.Enum("type").Values("SIGN_IN", "FAILED_SIGN_IN", "SIGN_OUT", "API_CALL", "CONSOLE_ACCESS", "POWER_STATE", "REBOOT", "SHUTDOWN", "POWER_ON", "POWER_OFF", "CHANGE_SELF_PASSWORD", "CHANGE_PASSWORD", "CREATE_OBJECT", "UPDATE_OBJECT", "DELETE_OBJECT", "UPDATE_LOCKOUT"),
+		field.Enum("type").Values("SIGN_IN", "FAILED_SIGN_IN", "SIGN_OUT", "API_CALL", "CONSOLE_ACCESS", "POWER_STATE", "REBOOT", "SHUTDOWN", "POWER_ON", "POWER_OFF", "CHANGE_SELF_PASSWORD", "CHANGE_PASSWORD", "CREATE_OBJECT", "UPDATE_OBJECT", "DELETE_OBJECT", "UPDATE_LOCKOUT", "MFA_ENROLL", "MFA_DISABLE", "FAILED_MFA", "ACCOUNT_LOCKED", "ACCOUNT_UNLOCKED", "REVOKE_SESSION", "ROTATE_SECRET", "CREATE_ACCESS_TOKEN", "REVOKE_ACCESS_TOKEN", "IMPERSONATE", "IP_NOT_ALLOWED"),
 		field.String("message"),
 		field.Time("performed_at").Default(time.Now),
 	}
@@ -32,5 +39,38 @@ func (Action) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("ActionToUser", User.Type).Ref("UserToActions").Unique(),
 		edge.From("ActionToServiceAccount", ServiceAccount.Type).Ref("ServiceAccountToActions").Unique(),
+		edge.To("ActionToImpersonator", User.Type).Unique().Comment("[OPTIONAL] The admin who performed the action while impersonating ActionToUser."),
+	}
+}
+
+// Hooks of the Action.
+func (Action) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Actions performed while impersonating are recorded with both the impersonated user and the admin
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.ActionFunc(func(ctx context.Context, m *gen.ActionMutation) (ent.Value, error) {
+				if v := viewer.FromContext(ctx); v != nil && v.Impersonator != nil {
+					m.SetActionToImpersonatorID(v.Impersonator.ID)
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpCreate),
+	}
+}
+
+// Policy of the Action. Users can only see their own actions without the "logs:read" permission.
+func (Action) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.LogsRead),
+			rule.FilterActionQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			// Every viewer logs their own actions, but nobody can change the logs
+			privacy.OnMutationOperation(privacy.AlwaysAllowRule(), ent.OpCreate),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
@@ -5,6 +5,9 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -20,6 +23,13 @@ func (Competition) Fields() []ent.Field {
 			Default(uuid.New).
 			StorageKey("oid"),
 		field.String("name").Unique().Comment("[REQUIRED] The unique name (aka. slug) for the competition."),
+		field.Int("console_limit_per_vm").NonNegative().Default(0).Comment("[OPTIONAL] (default is 0) The max number of simultaneous console sessions on a single VM. 0 is unlimited."),
+		field.Int("console_limit_per_user").NonNegative().Default(0).Comment("[OPTIONAL] (default is 0) The max number of simultaneous console sessions a single user can hold. 0 is unlimited."),
+		field.Int("console_limit_per_team").NonNegative().Default(0).Comment("[OPTIONAL] (default is 0) The max number of simultaneous console sessions a team's users can hold. 0 is unlimited."),
+		field.Time("starts_at").Optional().Nillable().Comment("[OPTIONAL] When competitors can start accessing their VMs. The VMs are locked before this time."),
+		field.Time("ends_at").Optional().Nillable().Comment("[OPTIONAL] When competitor access ends. The VMs are locked after this time."),
+		field.Bool("schedule_locked").Optional().Nillable().Comment("[OPTIONAL] The lockout the scheduler last applied to the VMs. VMs are only locked and unlocked when the schedule moves between open and closed, so manual lockouts stick until then."),
+		field.Strings("allowed_cidrs").Optional().Comment("[OPTIONAL] The networks (in CIDR notation) the competition's users can connect from. Empty allows any network."),
 	}
 }
 
@@ -31,5 +41,27 @@ func (Competition) Edges() []ent.Edge {
 				OnDelete: entsql.Cascade,
 			}),
 		edge.To("CompetitionToProvider", Provider.Type).Unique().Required(),
+		edge.To("CompetitionToAdmins", User.Type).Comment("[OPTIONAL] Users who can manage the teams, VMs, users and lockouts of only this competition."),
+		edge.To("CompetitionToBreaks", CompetitionBreak.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}).
+			Comment("[OPTIONAL] Windows during the competition when the VMs are locked."),
+	}
+}
+
+// Policy of the Competition. Users can only see the competitions of their teams and the competitions they administer.
+func (Competition) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.CompetitionRead),
+			rule.FilterCompetitionQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.CompetitionWrite),
+			rule.FilterCompetitionMutation(),
+		},
 	}
 }
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CompetitionBreak holds the schema definition for the CompetitionBreak entity.
+type CompetitionBreak struct {
+	ent.Schema
+}
+
+// Fields of the CompetitionBreak.
+func (CompetitionBreak) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("name").Default("").Comment("[OPTIONAL] What the break is for (eg. \"Lunch\")."),
+		field.Time("starts_at").Comment("[REQUIRED] When competitors are locked out of their VMs."),
+		field.Time("ends_at").Comment("[REQUIRED] When competitors can access their VMs again."),
+	}
+}
+
+// Edges of the CompetitionBreak.
+func (CompetitionBreak) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("CompetitionBreakToCompetition", Competition.Type).Ref("CompetitionToBreaks").Unique().Required(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ConsoleSession holds the schema definition for the ConsoleSession entity.
+type ConsoleSession struct {
+	ent.Schema
+}
+
+// Fields of the ConsoleSession.
+func (ConsoleSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("console_type").Comment("[REQUIRED] The type of console this session was opened with."),
+		field.String("ip_address").Default("").Comment("[OPTIONAL] The IP address of the client which opened the session."),
+		field.Time("started_at").Default(time.Now).Comment("[REQUIRED] The time the session was opened."),
+		field.Time("ended_at").Optional().Nillable().Comment("[OPTIONAL] The time the session was closed. Empty while the session is active."),
+		field.Text("transcript").Default("").Comment("[OPTIONAL] The output of the console for proxied text consoles."),
+	}
+}
+
+// Edges of the ConsoleSession.
+func (ConsoleSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ConsoleSessionToUser", User.Type).Ref("UserToConsoleSessions").Unique(),
+		edge.From("ConsoleSessionToVmObject", VmObject.Type).Ref("VmObjectToConsoleSessions").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ConsoleShare holds the schema definition for the ConsoleShare entity.
+type ConsoleShare struct {
+	ent.Schema
+}
+
+// Fields of the ConsoleShare.
+func (ConsoleShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("token_hash").Unique().Sensitive().Comment("[REQUIRED] The SHA-256 hash of the share link token."),
+		field.String("console_type").Comment("[REQUIRED] The type of console being shared. Only consoles proxied through Compsole can be shared."),
+		field.String("password").Sensitive().Default("").Comment("[OPTIONAL] The hashed password required to view the console. Empty if no password is required."),
+		field.Time("created_at").Default(time.Now).Comment("[REQUIRED] (default is now) When the share link was created."),
+		field.Time("expires_at").Comment("[REQUIRED] When the share link stops working."),
+		field.Bool("revoked").Default(false).Comment("[REQUIRED] (default is false) Revoked share links can no longer be used."),
+	}
+}
+
+// Edges of the ConsoleShare.
+func (ConsoleShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ConsoleShareToVmObject", VmObject.Type).Ref("VmObjectToConsoleShares").Unique().Required(),
+		edge.From("ConsoleShareToUser", User.Type).Ref("UserToConsoleShares").Unique(),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CustomRole holds the schema definition for the CustomRole entity.
+type CustomRole struct {
+	ent.Schema
+}
+
+// Fields of the CustomRole.
+func (CustomRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("name").Unique().Comment("[REQUIRED] The display name for the role."),
+		field.String("description").Default("").Comment("[OPTIONAL] What the role is for."),
+		field.Strings("permissions").Optional().Comment("[OPTIONAL] The permissions granted to users with this role (eg. \"vm:power\"), on top of the permissions of their built-in role."),
+	}
+}
+
+// Edges of the CustomRole.
+func (CustomRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("CustomRoleToUsers", User.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.SetNull,
+		}),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("name").Comment("[REQUIRED] The display name the user gave the token."),
+		field.String("token_hash").Unique().Sensitive().Comment("[REQUIRED] The SHA-256 hash of the token. The token itself is never stored."),
+		field.String("prefix").Comment("[REQUIRED] The start of the token, so users can tell their tokens apart."),
+		field.Enum("scope").Values("READ_ONLY", "FULL").Comment("[REQUIRED] READ_ONLY tokens can't run mutations."),
+		field.Time("created_at").Default(time.Now).Comment("[REQUIRED] (default is now) When the token was created."),
+		field.Time("expires_at").Optional().Nillable().Comment("[OPTIONAL] The token can't be used after this time. Never expires when not set."),
+		field.Time("last_used_at").Optional().Nillable().Comment("[INTERNAL] When the token was last used. Only updated about once a minute."),
+		field.String("last_used_ip").Optional().Comment("[INTERNAL] The IP address the token was last used from."),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("PersonalAccessTokenToUser", User.Type).Ref("UserToPersonalAccessTokens").Unique().Required(),
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -34,3 +37,20 @@ func (Provider) Edges() []ent.Edge {
 			}),
 	}
 }
+
+// Policy of the Provider. Users can only see the providers of the competitions they can see, which are needed to power and open consoles on
+// their VMs.
+func (Provider) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.ProviderRead),
+			rule.FilterProviderQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.ProviderWrite),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -21,8 +21,16 @@ func (ServiceAccount) Fields() []ent.Field {
 			StorageKey("oid"),
 		field.String("display_name").Comment("[REQUIRED] The display/common name for the service account."),
 		field.UUID("api_key", uuid.UUID{}).Comment("[REQUIRED] The API key for the service account. Equivalent to a username."),
-		field.UUID("api_secret", uuid.UUID{}).Comment("[REQUIRED] The API secret for the service account. This value MUST be protected."),
+		field.UUID("api_secret", uuid.UUID{}).Optional().Nillable().StructTag(`json:"-"`).Comment("[DEPRECATED] The plaintext API secret of service accounts created before secrets were hashed. Moved to api_secret_hash on startup."),
+		field.String("api_secret_hash").Optional().Sensitive().Comment("[REQUIRED] The SHA-256 hash of the API secret for the service account. The secret itself is never stored."),
+		field.String("previous_api_secret_hash").Optional().Sensitive().Comment("[OPTIONAL] The hash of the API secret which was rotated out. Accepted until previous_api_secret_expires_at."),
+		field.Time("previous_api_secret_expires_at").Optional().Nillable().Comment("[OPTIONAL] When the previous API secret stops working."),
+		field.Int("secret_generation").Default(0).Comment("[INTERNAL] Incremented every time the API secret is rotated."),
 		field.Bool("active").Comment("[REQUIRED] Determines whether or not the service account is active or not"),
+		field.Time("expires_at").Optional().Nillable().Comment("[OPTIONAL] The service account can't authenticate after this time. Never expires when not set."),
+		field.Time("last_used_at").Optional().Nillable().Comment("[INTERNAL] The last time the service account signed in or made a request."),
+		field.String("last_used_ip").Optional().Comment("[INTERNAL] The IP address the service account was last used from."),
+		field.Strings("scopes").Optional().Comment("[OPTIONAL] The permissions granted to the service account (eg. \"vm:read\"). Accounts created before scopes existed are granted every scope on startup."),
 	}
 }
 
@@ -37,5 +45,7 @@ func (ServiceAccount) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Restrict,
 			}),
+		edge.To("ServiceAccountToCompetitions", Competition.Type).Comment("[OPTIONAL] Limits the service account to these competitions (and their teams)."),
+		edge.To("ServiceAccountToTeams", Team.Type).Comment("[OPTIONAL] Limits the service account to these teams."),
 	}
 }
@@ -20,6 +20,7 @@ func (ServiceToken) Fields() []ent.Field {
 		field.String("token").Comment("[REQUIRED] The API token for a service account session."),
 		field.String("refresh_token").Comment("[REQUIRED] The refresh token used to renew an expired service account session. These are valid for `REFRESH_WINDOW` hours."),
 		field.Int64("issued_at").Comment("[REQUIRED] The time the token was issued"),
+		field.Int("secret_generation").Default(0).Comment("[INTERNAL] The secret_generation of the service account's secret which started this session. Sessions started with the previous secret end with its overlap."),
 	}
 }
 
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.Enum("algorithm").Values("RS256", "EdDSA").Comment("[REQUIRED] The JWT signing algorithm the key is used with."),
+		field.String("private_key").Sensitive().Comment("[REQUIRED] The PEM encoded PKCS #8 private key."),
+		field.String("public_key").Comment("[REQUIRED] The PEM encoded PKIX public key."),
+		field.Time("created_at").Default(time.Now).Comment("[REQUIRED] (default is now) When the key was generated."),
+		field.Time("retired_at").Optional().Nillable().Comment("[OPTIONAL] When the key stopped signing new tokens. The newest key which isn't retired signs tokens."),
+		field.Time("expires_at").Optional().Nillable().Comment("[OPTIONAL] Tokens signed by the key are rejected after this time. Set when the key is retired, so the tokens it signed keep working until they expire."),
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
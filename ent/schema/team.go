@@ -5,6 +5,9 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -21,6 +24,7 @@ func (Team) Fields() []ent.Field {
 			StorageKey("oid"),
 		field.Int("team_number").Comment("[REQUIRED] The team number."),
 		field.String("name").Optional().Comment("[OPTIONAL] The display name for the team."),
+		field.Strings("allowed_cidrs").Optional().Comment("[OPTIONAL] The networks (in CIDR notation) the team's users can connect from, on top of the competition's allowlist. Empty allows any network."),
 	}
 }
 
@@ -35,5 +39,24 @@ func (Team) Edges() []ent.Edge {
 		edge.To("TeamToUsers", User.Type).Annotations(entsql.Annotation{
 			OnDelete: entsql.SetNull,
 		}),
+		edge.To("TeamToMemberships", TeamMembership.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}),
+	}
+}
+
+// Policy of the Team. Users can only see the teams they are a member of and the teams of the competitions they administer.
+func (Team) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.TeamRead),
+			rule.FilterTeamQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.TeamWrite),
+			rule.FilterTeamMutation(),
+		},
 	}
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
+	"github.com/google/uuid"
+)
+
+// TeamMembership holds the schema definition for the TeamMembership entity.
+type TeamMembership struct {
+	ent.Schema
+}
+
+// Fields of the TeamMembership.
+func (TeamMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.Enum("role").Values("MEMBER", "CAPTAIN", "OBSERVER").Default("MEMBER").Comment("[REQUIRED] (default is MEMBER) The user's role on the team. Observers can only view the team's VMs."),
+	}
+}
+
+// Edges of the TeamMembership.
+func (TeamMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("TeamMembershipToUser", User.Type).Ref("UserToTeamMemberships").Unique().Required(),
+		edge.From("TeamMembershipToTeam", Team.Type).Ref("TeamToMemberships").Unique().Required(),
+	}
+}
+
+// Indexes of the TeamMembership.
+func (TeamMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// Users can only be on a team once
+		index.Edges("TeamMembershipToUser", "TeamMembershipToTeam").Unique(),
+	}
+}
+
+// Policy of the TeamMembership. Memberships are only visible along with their team and can only be changed by the
+// admins of the team's competition.
+func (TeamMembership) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.TeamRead),
+			rule.FilterTeamMembershipQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.UserWrite),
+			rule.FilterTeamMembershipMutation(),
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -17,8 +19,13 @@ func (Token) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New),
-		field.String("token").Comment("[REQUIRED] The auth-token cookie value for the user session."),
+		field.String("token").Sensitive().Comment("[REQUIRED] The auth-token cookie value for the user session."),
 		field.Int64("expire_at").Comment("[REQUIRED] The time the token should expire."),
+		field.Time("created_at").Default(time.Now).Comment("[REQUIRED] (default is now) When the user signed in."),
+		field.Time("last_used_at").Optional().Nillable().Comment("[OPTIONAL] When the session was last used. Only updated about once a minute."),
+		field.String("ip_address").Optional().Comment("[OPTIONAL] The IP address the user signed in from."),
+		field.String("user_agent").Optional().Comment("[OPTIONAL] The user agent of the browser the user signed in with."),
+		field.String("method").Optional().Comment("[OPTIONAL] How the user signed in (eg. \"local and totp\")."),
 	}
 }
 
@@ -29,5 +36,8 @@ func (Token) Edges() []ent.Edge {
 			Ref("UserToToken").
 			Unique().
 			Required(),
+		edge.To("TokenToImpersonatedUser", User.Type).
+			Unique().
+			Comment("[OPTIONAL] The user an admin is viewing Compsole as with this session."),
 	}
 }
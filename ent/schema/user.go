@@ -5,6 +5,10 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -23,15 +27,27 @@ func (User) Fields() []ent.Field {
 		field.String("password").Sensitive().Comment("[REQUIRED] The hashed password for the user."),
 		field.String("first_name").Default("").Comment("[OPTIONAL] The display first name for the user."),
 		field.String("last_name").Default("").Comment("[OPTIONAL] The display last name for the user"),
-		field.Enum("role").Values("USER", "ADMIN").Comment("[REQUIRED] The role of the user. Admins have full access."),
-		field.Enum("provider").Values("LOCAL", "GITLAB").Comment("[REQUIRED] The type of login the user will be using."),
+		field.Enum("role").Values("USER", "ADMIN", "WHITE_TEAM", "BLACK_TEAM", "RED_TEAM").Comment("[REQUIRED] The built-in role of the user. Admins have full access. See compsole/permissions for what each role can do."),
+		field.Enum("provider").Values("LOCAL", "GITLAB", "OIDC", "LDAP", "SAML").Comment("[REQUIRED] The type of login the user will be using."),
+		field.String("external_issuer").Optional().Comment("[OPTIONAL] Where the user's external account comes from (eg. the GitLab url or the OIDC issuer url)."),
+		field.String("external_subject").Optional().Comment("[OPTIONAL] The stable id of the user's external account at the issuer (eg. the OIDC sub claim). External logins are matched on the issuer and subject, never the username, which providers let users change."),
+		field.String("totp_secret").Optional().Sensitive().Comment("[OPTIONAL] The TOTP secret for multi-factor authentication. Set during enrollment."),
+		field.Bool("totp_enabled").Default(false).Comment("[OPTIONAL] (default is false) Whether the user must enter a TOTP code after their password."),
+		field.Int64("totp_last_counter").Default(0).Comment("[INTERNAL] The time step of the last accepted TOTP code, so codes can't be replayed."),
+		field.Strings("totp_recovery_codes").Optional().StructTag(`json:"-"`).Comment("[OPTIONAL] The hashes of the unused recovery codes."),
+		field.Bool("must_change_password").Default(false).Comment("[OPTIONAL] (default is false) Whether the user must change their password before they can do anything else."),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("UserToTeam", Team.Type).Ref("TeamToUsers").Unique(),
+		edge.From("UserToTeam", Team.Type).Ref("TeamToUsers").Unique().Comment("[OPTIONAL] The user's active team, which must be one of the teams they are a member of."),
+		edge.To("UserToTeamMemberships", TeamMembership.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}).Comment("[OPTIONAL] The teams the user is a member of."),
+		edge.From("UserToCustomRole", CustomRole.Type).Ref("CustomRoleToUsers").Unique().Comment("[OPTIONAL] Grants the user extra permissions on top of their built-in role."),
+		edge.From("UserToAdminCompetitions", Competition.Type).Ref("CompetitionToAdmins").Comment("[OPTIONAL] The competitions which the user is a competition-scoped admin of."),
 		edge.To("UserToToken", Token.Type).
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
@@ -39,5 +55,43 @@ func (User) Edges() []ent.Edge {
 		edge.To("UserToActions", Action.Type).Annotations(entsql.Annotation{
 			OnDelete: entsql.SetNull,
 		}),
+		edge.To("UserToConsoleSessions", ConsoleSession.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.SetNull,
+		}),
+		edge.To("UserToConsoleShares", ConsoleShare.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.SetNull,
+		}),
+		edge.To("UserToWebauthnCredentials", WebauthnCredential.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}),
+		edge.To("UserToPersonalAccessTokens", PersonalAccessToken.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// Each external account can only be linked to one user
+		index.Fields("external_issuer", "external_subject").Unique(),
+	}
+}
+
+// Policy of the User. Users can only see themselves and the members of the teams in the competitions they administer.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			// Logs show who performed each action
+			rule.AllowIfPermission(permissions.UserRead, permissions.LogsRead),
+			rule.FilterUserQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.UserWrite),
+			rule.AllowUserSelfUpdate(),
+			rule.FilterUserMutation(),
+		},
 	}
 }
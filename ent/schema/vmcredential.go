@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// VmCredential holds the schema definition for the VmCredential entity.
+type VmCredential struct {
+	ent.Schema
+}
+
+// Fields of the VmCredential.
+func (VmCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.Enum("protocol").Values("SSH", "RDP").Comment("[REQUIRED] The protocol guacd will use to connect to the VM with these credentials."),
+		field.Int("port").Default(0).Comment("[OPTIONAL] The port to connect to. Defaults to the standard port for the protocol when 0."),
+		field.String("username").Default("").Comment("[OPTIONAL] The username to log in as."),
+		field.String("password").Sensitive().Default("").Comment("[OPTIONAL] The password to log in with. This value MUST be protected."),
+		field.Text("private_key").Sensitive().Default("").Comment("[OPTIONAL] (SSH only) The PEM encoded private key to log in with. This value MUST be protected."),
+		field.String("domain").Default("").Comment("[OPTIONAL] (RDP only) The domain to authenticate against."),
+		field.Bool("ignore_cert").Default(true).Comment("[REQUIRED] (default is true) (RDP only) Whether to ignore the VM's certificate. Competition VMs usually have self-signed certificates."),
+	}
+}
+
+// Edges of the VmCredential.
+func (VmCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("VmCredentialToVmObject", VmObject.Type).Ref("VmObjectToVmCredentials").Unique().Required(),
+	}
+}
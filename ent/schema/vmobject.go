@@ -2,8 +2,12 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/rule"
+	"github.com/BradHacker/compsole/ent/privacy"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +26,10 @@ func (VmObject) Fields() []ent.Field {
 		field.String("identifier").Comment("[REQUIRED] The identifier of the VM. This will be provider-specific."),
 		field.Strings("ip_addresses").Optional().Comment("[OPTIONAL] IP addresses of the VM. This will be displayed to the user."),
 		field.Bool("locked").Default(false).Comment("[REQUIRED] (default is false) If a vm is locked, standard users will not be able to access this VM."),
+		field.Bool("red_team_access").Default(false).Comment("[OPTIONAL] (default is false) Whether users with the \"red_team:console\" permission can open consoles on this VM, regardless of their team."),
+		field.Int("console_limit_per_vm").NonNegative().Optional().Nillable().Comment("[OPTIONAL] Overrides the competition's console_limit_per_vm for this VM."),
+		field.Int("console_limit_per_user").NonNegative().Optional().Nillable().Comment("[OPTIONAL] Overrides the competition's console_limit_per_user for this VM."),
+		field.Int("console_limit_per_team").NonNegative().Optional().Nillable().Comment("[OPTIONAL] Overrides the competition's console_limit_per_team for this VM."),
 	}
 }
 
@@ -29,5 +37,32 @@ func (VmObject) Fields() []ent.Field {
 func (VmObject) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("VmObjectToTeam", Team.Type).Ref("TeamToVmObjects").Unique(),
+		edge.To("VmObjectToConsoleSessions", ConsoleSession.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.SetNull,
+		}),
+		edge.To("VmObjectToVmCredentials", VmCredential.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}),
+		edge.To("VmObjectToConsoleShares", ConsoleShare.Type).Annotations(entsql.Annotation{
+			OnDelete: entsql.Cascade,
+		}),
+	}
+}
+
+// Policy of the VmObject. Users can only see the VMs of their active team, the competitions they administer and, with the "red_team:console"
+// permission, the VMs marked for red team access.
+func (VmObject) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.VmRead),
+			rule.FilterVmObjectQuery(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission(permissions.VmWrite),
+			rule.AllowVmObjectLockout(),
+			rule.FilterVmObjectMutation(),
+		},
 	}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebauthnCredential holds the schema definition for the WebauthnCredential entity.
+type WebauthnCredential struct {
+	ent.Schema
+}
+
+// Fields of the WebauthnCredential.
+func (WebauthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("oid"),
+		field.String("name").Default("Passkey").Comment("[OPTIONAL] (default is \"Passkey\") The display name the user gave the credential."),
+		field.Bytes("credential_id").Unique().Comment("[REQUIRED] The credential ID chosen by the authenticator."),
+		field.Bytes("public_key").Sensitive().Comment("[REQUIRED] The COSE encoded public key of the credential."),
+		field.String("attestation_type").Default("").Comment("[OPTIONAL] The attestation format the authenticator used during registration."),
+		field.Bytes("aaguid").Optional().Comment("[OPTIONAL] The AAGUID identifying the authenticator model."),
+		field.Uint32("sign_count").Default(0).Comment("[INTERNAL] The last signature counter reported by the authenticator, used to detect cloned authenticators."),
+		field.Strings("transports").Optional().Comment("[OPTIONAL] The transports the authenticator supports (eg. usb, nfc, internal)."),
+		field.Bool("backup_eligible").Default(false).Comment("[OPTIONAL] (default is false) Whether the credential can be synced between devices."),
+		field.Bool("backup_state").Default(false).Comment("[OPTIONAL] (default is false) Whether the credential is currently synced between devices."),
+		field.Time("created_at").Default(time.Now).Comment("[REQUIRED] (default is now) When the credential was registered."),
+		field.Time("last_used_at").Optional().Nillable().Comment("[OPTIONAL] When the credential was last used to login."),
+	}
+}
+
+// Edges of the WebauthnCredential.
+func (WebauthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("WebauthnCredentialToUser", User.Type).Ref("UserToWebauthnCredentials").Unique().Required(),
+	}
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
@@ -23,11 +25,35 @@ type ServiceAccount struct {
 	// [REQUIRED] The API key for the service account. Equivalent to a username.
 	APIKey uuid.UUID `json:"api_key,omitempty"`
 	// APISecret holds the value of the "api_secret" field.
-	// [REQUIRED] The API secret for the service account. This value MUST be protected.
-	APISecret uuid.UUID `json:"api_secret,omitempty"`
+	// [DEPRECATED] The plaintext API secret of service accounts created before secrets were hashed. Moved to api_secret_hash on startup.
+	APISecret *uuid.UUID `json:"-"`
+	// APISecretHash holds the value of the "api_secret_hash" field.
+	// [REQUIRED] The SHA-256 hash of the API secret for the service account. The secret itself is never stored.
+	APISecretHash string `json:"-"`
+	// PreviousAPISecretHash holds the value of the "previous_api_secret_hash" field.
+	// [OPTIONAL] The hash of the API secret which was rotated out. Accepted until previous_api_secret_expires_at.
+	PreviousAPISecretHash string `json:"-"`
+	// PreviousAPISecretExpiresAt holds the value of the "previous_api_secret_expires_at" field.
+	// [OPTIONAL] When the previous API secret stops working.
+	PreviousAPISecretExpiresAt *time.Time `json:"previous_api_secret_expires_at,omitempty"`
+	// SecretGeneration holds the value of the "secret_generation" field.
+	// [INTERNAL] Incremented every time the API secret is rotated.
+	SecretGeneration int `json:"secret_generation,omitempty"`
 	// Active holds the value of the "active" field.
 	// [REQUIRED] Determines whether or not the service account is active or not
 	Active bool `json:"active,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	// [OPTIONAL] The service account can't authenticate after this time. Never expires when not set.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	// [INTERNAL] The last time the service account signed in or made a request.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	// [INTERNAL] The IP address the service account was last used from.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	// [OPTIONAL] The permissions granted to the service account (eg. "vm:read"). Accounts created before scopes existed are granted every scope on startup.
+	Scopes []string `json:"scopes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ServiceAccountQuery when eager-loading is set.
 	Edges ServiceAccountEdges `json:"edges"`
@@ -39,9 +65,13 @@ type ServiceAccountEdges struct {
 	ServiceAccountToToken []*ServiceToken `json:"ServiceAccountToToken,omitempty"`
 	// ServiceAccountToActions holds the value of the ServiceAccountToActions edge.
 	ServiceAccountToActions []*Action `json:"ServiceAccountToActions,omitempty"`
+	// ServiceAccountToCompetitions holds the value of the ServiceAccountToCompetitions edge.
+	ServiceAccountToCompetitions []*Competition `json:"ServiceAccountToCompetitions,omitempty"`
+	// ServiceAccountToTeams holds the value of the ServiceAccountToTeams edge.
+	ServiceAccountToTeams []*Team `json:"ServiceAccountToTeams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ServiceAccountToTokenOrErr returns the ServiceAccountToToken value or an error if the edge
@@ -62,16 +92,42 @@ func (e ServiceAccountEdges) ServiceAccountToActionsOrErr() ([]*Action, error) {
 	return nil, &NotLoadedError{edge: "ServiceAccountToActions"}
 }
 
+// ServiceAccountToCompetitionsOrErr returns the ServiceAccountToCompetitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceAccountEdges) ServiceAccountToCompetitionsOrErr() ([]*Competition, error) {
+	if e.loadedTypes[2] {
+		return e.ServiceAccountToCompetitions, nil
+	}
+	return nil, &NotLoadedError{edge: "ServiceAccountToCompetitions"}
+}
+
+// ServiceAccountToTeamsOrErr returns the ServiceAccountToTeams value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceAccountEdges) ServiceAccountToTeamsOrErr() ([]*Team, error) {
+	if e.loadedTypes[3] {
+		return e.ServiceAccountToTeams, nil
+	}
+	return nil, &NotLoadedError{edge: "ServiceAccountToTeams"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ServiceAccount) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case serviceaccount.FieldAPISecret:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case serviceaccount.FieldScopes:
+			values[i] = new([]byte)
 		case serviceaccount.FieldActive:
 			values[i] = new(sql.NullBool)
-		case serviceaccount.FieldDisplayName:
+		case serviceaccount.FieldSecretGeneration:
+			values[i] = new(sql.NullInt64)
+		case serviceaccount.FieldDisplayName, serviceaccount.FieldAPISecretHash, serviceaccount.FieldPreviousAPISecretHash, serviceaccount.FieldLastUsedIP:
 			values[i] = new(sql.NullString)
-		case serviceaccount.FieldID, serviceaccount.FieldAPIKey, serviceaccount.FieldAPISecret:
+		case serviceaccount.FieldPreviousAPISecretExpiresAt, serviceaccount.FieldExpiresAt, serviceaccount.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case serviceaccount.FieldID, serviceaccount.FieldAPIKey:
 			values[i] = new(uuid.UUID)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type ServiceAccount", columns[i])
@@ -107,10 +163,36 @@ func (sa *ServiceAccount) assignValues(columns []string, values []interface{}) e
 				sa.APIKey = *value
 			}
 		case serviceaccount.FieldAPISecret:
-			if value, ok := values[i].(*uuid.UUID); !ok {
+			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field api_secret", values[i])
-			} else if value != nil {
-				sa.APISecret = *value
+			} else if value.Valid {
+				sa.APISecret = new(uuid.UUID)
+				*sa.APISecret = *value.S.(*uuid.UUID)
+			}
+		case serviceaccount.FieldAPISecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_secret_hash", values[i])
+			} else if value.Valid {
+				sa.APISecretHash = value.String
+			}
+		case serviceaccount.FieldPreviousAPISecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_secret_hash", values[i])
+			} else if value.Valid {
+				sa.PreviousAPISecretHash = value.String
+			}
+		case serviceaccount.FieldPreviousAPISecretExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_secret_expires_at", values[i])
+			} else if value.Valid {
+				sa.PreviousAPISecretExpiresAt = new(time.Time)
+				*sa.PreviousAPISecretExpiresAt = value.Time
+			}
+		case serviceaccount.FieldSecretGeneration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_generation", values[i])
+			} else if value.Valid {
+				sa.SecretGeneration = int(value.Int64)
 			}
 		case serviceaccount.FieldActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
@@ -118,6 +200,34 @@ func (sa *ServiceAccount) assignValues(columns []string, values []interface{}) e
 			} else if value.Valid {
 				sa.Active = value.Bool
 			}
+		case serviceaccount.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sa.ExpiresAt = new(time.Time)
+				*sa.ExpiresAt = value.Time
+			}
+		case serviceaccount.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				sa.LastUsedAt = new(time.Time)
+				*sa.LastUsedAt = value.Time
+			}
+		case serviceaccount.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				sa.LastUsedIP = value.String
+			}
+		case serviceaccount.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
 		}
 	}
 	return nil
@@ -133,6 +243,16 @@ func (sa *ServiceAccount) QueryServiceAccountToActions() *ActionQuery {
 	return (&ServiceAccountClient{config: sa.config}).QueryServiceAccountToActions(sa)
 }
 
+// QueryServiceAccountToCompetitions queries the "ServiceAccountToCompetitions" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryServiceAccountToCompetitions() *CompetitionQuery {
+	return (&ServiceAccountClient{config: sa.config}).QueryServiceAccountToCompetitions(sa)
+}
+
+// QueryServiceAccountToTeams queries the "ServiceAccountToTeams" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryServiceAccountToTeams() *TeamQuery {
+	return (&ServiceAccountClient{config: sa.config}).QueryServiceAccountToTeams(sa)
+}
+
 // Update returns a builder for updating this ServiceAccount.
 // Note that you need to call ServiceAccount.Unwrap() before calling this method if this ServiceAccount
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -160,10 +280,32 @@ func (sa *ServiceAccount) String() string {
 	builder.WriteString(sa.DisplayName)
 	builder.WriteString(", api_key=")
 	builder.WriteString(fmt.Sprintf("%v", sa.APIKey))
-	builder.WriteString(", api_secret=")
-	builder.WriteString(fmt.Sprintf("%v", sa.APISecret))
+	if v := sa.APISecret; v != nil {
+		builder.WriteString(", api_secret=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", api_secret_hash=<sensitive>")
+	builder.WriteString(", previous_api_secret_hash=<sensitive>")
+	if v := sa.PreviousAPISecretExpiresAt; v != nil {
+		builder.WriteString(", previous_api_secret_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", secret_generation=")
+	builder.WriteString(fmt.Sprintf("%v", sa.SecretGeneration))
 	builder.WriteString(", active=")
 	builder.WriteString(fmt.Sprintf("%v", sa.Active))
+	if v := sa.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := sa.LastUsedAt; v != nil {
+		builder.WriteString(", last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", last_used_ip=")
+	builder.WriteString(sa.LastUsedIP)
+	builder.WriteString(", scopes=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Scopes))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,12 +17,32 @@ const (
 	FieldAPIKey = "api_key"
 	// FieldAPISecret holds the string denoting the api_secret field in the database.
 	FieldAPISecret = "api_secret"
+	// FieldAPISecretHash holds the string denoting the api_secret_hash field in the database.
+	FieldAPISecretHash = "api_secret_hash"
+	// FieldPreviousAPISecretHash holds the string denoting the previous_api_secret_hash field in the database.
+	FieldPreviousAPISecretHash = "previous_api_secret_hash"
+	// FieldPreviousAPISecretExpiresAt holds the string denoting the previous_api_secret_expires_at field in the database.
+	FieldPreviousAPISecretExpiresAt = "previous_api_secret_expires_at"
+	// FieldSecretGeneration holds the string denoting the secret_generation field in the database.
+	FieldSecretGeneration = "secret_generation"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldLastUsedIP holds the string denoting the last_used_ip field in the database.
+	FieldLastUsedIP = "last_used_ip"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
 	// EdgeServiceAccountToToken holds the string denoting the serviceaccounttotoken edge name in mutations.
 	EdgeServiceAccountToToken = "ServiceAccountToToken"
 	// EdgeServiceAccountToActions holds the string denoting the serviceaccounttoactions edge name in mutations.
 	EdgeServiceAccountToActions = "ServiceAccountToActions"
+	// EdgeServiceAccountToCompetitions holds the string denoting the serviceaccounttocompetitions edge name in mutations.
+	EdgeServiceAccountToCompetitions = "ServiceAccountToCompetitions"
+	// EdgeServiceAccountToTeams holds the string denoting the serviceaccounttoteams edge name in mutations.
+	EdgeServiceAccountToTeams = "ServiceAccountToTeams"
 	// ServiceTokenFieldID holds the string denoting the ID field of the ServiceToken.
 	ServiceTokenFieldID = "id"
 	// Table holds the table name of the serviceaccount in the database.
@@ -41,6 +61,20 @@ const (
 	ServiceAccountToActionsInverseTable = "actions"
 	// ServiceAccountToActionsColumn is the table column denoting the ServiceAccountToActions relation/edge.
 	ServiceAccountToActionsColumn = "service_account_service_account_to_actions"
+	// ServiceAccountToCompetitionsTable is the table that holds the ServiceAccountToCompetitions relation/edge.
+	ServiceAccountToCompetitionsTable = "competitions"
+	// ServiceAccountToCompetitionsInverseTable is the table name for the Competition entity.
+	// It exists in this package in order to avoid circular dependency with the "competition" package.
+	ServiceAccountToCompetitionsInverseTable = "competitions"
+	// ServiceAccountToCompetitionsColumn is the table column denoting the ServiceAccountToCompetitions relation/edge.
+	ServiceAccountToCompetitionsColumn = "service_account_service_account_to_competitions"
+	// ServiceAccountToTeamsTable is the table that holds the ServiceAccountToTeams relation/edge.
+	ServiceAccountToTeamsTable = "teams"
+	// ServiceAccountToTeamsInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	ServiceAccountToTeamsInverseTable = "teams"
+	// ServiceAccountToTeamsColumn is the table column denoting the ServiceAccountToTeams relation/edge.
+	ServiceAccountToTeamsColumn = "service_account_service_account_to_teams"
 )
 
 // Columns holds all SQL columns for serviceaccount fields.
@@ -49,7 +83,15 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldAPIKey,
 	FieldAPISecret,
+	FieldAPISecretHash,
+	FieldPreviousAPISecretHash,
+	FieldPreviousAPISecretExpiresAt,
+	FieldSecretGeneration,
 	FieldActive,
+	FieldExpiresAt,
+	FieldLastUsedAt,
+	FieldLastUsedIP,
+	FieldScopes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,6 +105,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultSecretGeneration holds the default value on creation for the "secret_generation" field.
+	DefaultSecretGeneration int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -3,6 +3,8 @@
 package serviceaccount
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/BradHacker/compsole/ent/predicate"
@@ -113,6 +115,34 @@ func APISecret(v uuid.UUID) predicate.ServiceAccount {
 	})
 }
 
+// APISecretHash applies equality check predicate on the "api_secret_hash" field. It's identical to APISecretHashEQ.
+func APISecretHash(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHash applies equality check predicate on the "previous_api_secret_hash" field. It's identical to PreviousAPISecretHashEQ.
+func PreviousAPISecretHash(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretExpiresAt applies equality check predicate on the "previous_api_secret_expires_at" field. It's identical to PreviousAPISecretExpiresAtEQ.
+func PreviousAPISecretExpiresAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// SecretGeneration applies equality check predicate on the "secret_generation" field. It's identical to SecretGenerationEQ.
+func SecretGeneration(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
 // Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
 func Active(v bool) predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
@@ -120,6 +150,27 @@ func Active(v bool) predicate.ServiceAccount {
 	})
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedIP applies equality check predicate on the "last_used_ip" field. It's identical to LastUsedIPEQ.
+func LastUsedIP(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedIP), v))
+	})
+}
+
 // DisplayNameEQ applies the EQ predicate on the "display_name" field.
 func DisplayNameEQ(v string) predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
@@ -383,67 +434,872 @@ func APISecretLTE(v uuid.UUID) predicate.ServiceAccount {
 	})
 }
 
-// ActiveEQ applies the EQ predicate on the "active" field.
-func ActiveEQ(v bool) predicate.ServiceAccount {
+// APISecretIsNil applies the IsNil predicate on the "api_secret" field.
+func APISecretIsNil() predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldActive), v))
+		s.Where(sql.IsNull(s.C(FieldAPISecret)))
 	})
 }
 
-// ActiveNEQ applies the NEQ predicate on the "active" field.
-func ActiveNEQ(v bool) predicate.ServiceAccount {
+// APISecretNotNil applies the NotNil predicate on the "api_secret" field.
+func APISecretNotNil() predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldActive), v))
+		s.Where(sql.NotNull(s.C(FieldAPISecret)))
 	})
 }
 
-// HasServiceAccountToToken applies the HasEdge predicate on the "ServiceAccountToToken" edge.
-func HasServiceAccountToToken() predicate.ServiceAccount {
+// APISecretHashEQ applies the EQ predicate on the "api_secret_hash" field.
+func APISecretHashEQ(v string) predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ServiceAccountToTokenTable, ServiceTokenFieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTokenTable, ServiceAccountToTokenColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.EQ(s.C(FieldAPISecretHash), v))
 	})
 }
 
-// HasServiceAccountToTokenWith applies the HasEdge predicate on the "ServiceAccountToToken" edge with a given conditions (other predicates).
-func HasServiceAccountToTokenWith(preds ...predicate.ServiceToken) predicate.ServiceAccount {
+// APISecretHashNEQ applies the NEQ predicate on the "api_secret_hash" field.
+func APISecretHashNEQ(v string) predicate.ServiceAccount {
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ServiceAccountToTokenInverseTable, ServiceTokenFieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTokenTable, ServiceAccountToTokenColumn),
-		)
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
+		s.Where(sql.NEQ(s.C(FieldAPISecretHash), v))
 	})
 }
 
-// HasServiceAccountToActions applies the HasEdge predicate on the "ServiceAccountToActions" edge.
-func HasServiceAccountToActions() predicate.ServiceAccount {
+// APISecretHashIn applies the In predicate on the "api_secret_hash" field.
+func APISecretHashIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ServiceAccountToActionsTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToActionsTable, ServiceAccountToActionsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAPISecretHash), v...))
 	})
 }
 
-// HasServiceAccountToActionsWith applies the HasEdge predicate on the "ServiceAccountToActions" edge with a given conditions (other predicates).
-func HasServiceAccountToActionsWith(preds ...predicate.Action) predicate.ServiceAccount {
+// APISecretHashNotIn applies the NotIn predicate on the "api_secret_hash" field.
+func APISecretHashNotIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.ServiceAccount(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ServiceAccountToActionsInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToActionsTable, ServiceAccountToActionsColumn),
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAPISecretHash), v...))
+	})
+}
+
+// APISecretHashGT applies the GT predicate on the "api_secret_hash" field.
+func APISecretHashGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashGTE applies the GTE predicate on the "api_secret_hash" field.
+func APISecretHashGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashLT applies the LT predicate on the "api_secret_hash" field.
+func APISecretHashLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashLTE applies the LTE predicate on the "api_secret_hash" field.
+func APISecretHashLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashContains applies the Contains predicate on the "api_secret_hash" field.
+func APISecretHashContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashHasPrefix applies the HasPrefix predicate on the "api_secret_hash" field.
+func APISecretHashHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashHasSuffix applies the HasSuffix predicate on the "api_secret_hash" field.
+func APISecretHashHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashIsNil applies the IsNil predicate on the "api_secret_hash" field.
+func APISecretHashIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAPISecretHash)))
+	})
+}
+
+// APISecretHashNotNil applies the NotNil predicate on the "api_secret_hash" field.
+func APISecretHashNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAPISecretHash)))
+	})
+}
+
+// APISecretHashEqualFold applies the EqualFold predicate on the "api_secret_hash" field.
+func APISecretHashEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// APISecretHashContainsFold applies the ContainsFold predicate on the "api_secret_hash" field.
+func APISecretHashContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashEQ applies the EQ predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashNEQ applies the NEQ predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashIn applies the In predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPreviousAPISecretHash), v...))
+	})
+}
+
+// PreviousAPISecretHashNotIn applies the NotIn predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNotIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPreviousAPISecretHash), v...))
+	})
+}
+
+// PreviousAPISecretHashGT applies the GT predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashGTE applies the GTE predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashLT applies the LT predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashLTE applies the LTE predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashContains applies the Contains predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashHasPrefix applies the HasPrefix predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashHasSuffix applies the HasSuffix predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashIsNil applies the IsNil predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousAPISecretHash)))
+	})
+}
+
+// PreviousAPISecretHashNotNil applies the NotNil predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousAPISecretHash)))
+	})
+}
+
+// PreviousAPISecretHashEqualFold applies the EqualFold predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretHashContainsFold applies the ContainsFold predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPreviousAPISecretHash), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtEQ applies the EQ predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtNEQ applies the NEQ predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtIn applies the In predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPreviousAPISecretExpiresAt), v...))
+	})
+}
+
+// PreviousAPISecretExpiresAtNotIn applies the NotIn predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPreviousAPISecretExpiresAt), v...))
+	})
+}
+
+// PreviousAPISecretExpiresAtGT applies the GT predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtGTE applies the GTE predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtLT applies the LT predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtLTE applies the LTE predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPreviousAPISecretExpiresAt), v))
+	})
+}
+
+// PreviousAPISecretExpiresAtIsNil applies the IsNil predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousAPISecretExpiresAt)))
+	})
+}
+
+// PreviousAPISecretExpiresAtNotNil applies the NotNil predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousAPISecretExpiresAt)))
+	})
+}
+
+// SecretGenerationEQ applies the EQ predicate on the "secret_generation" field.
+func SecretGenerationEQ(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationNEQ applies the NEQ predicate on the "secret_generation" field.
+func SecretGenerationNEQ(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationIn applies the In predicate on the "secret_generation" field.
+func SecretGenerationIn(vs ...int) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSecretGeneration), v...))
+	})
+}
+
+// SecretGenerationNotIn applies the NotIn predicate on the "secret_generation" field.
+func SecretGenerationNotIn(vs ...int) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSecretGeneration), v...))
+	})
+}
+
+// SecretGenerationGT applies the GT predicate on the "secret_generation" field.
+func SecretGenerationGT(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationGTE applies the GTE predicate on the "secret_generation" field.
+func SecretGenerationGTE(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationLT applies the LT predicate on the "secret_generation" field.
+func SecretGenerationLT(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationLTE applies the LTE predicate on the "secret_generation" field.
+func SecretGenerationLTE(v int) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActive), v))
+	})
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActive), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedIPEQ applies the EQ predicate on the "last_used_ip" field.
+func LastUsedIPEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPNEQ applies the NEQ predicate on the "last_used_ip" field.
+func LastUsedIPNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPIn applies the In predicate on the "last_used_ip" field.
+func LastUsedIPIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedIP), v...))
+	})
+}
+
+// LastUsedIPNotIn applies the NotIn predicate on the "last_used_ip" field.
+func LastUsedIPNotIn(vs ...string) predicate.ServiceAccount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedIP), v...))
+	})
+}
+
+// LastUsedIPGT applies the GT predicate on the "last_used_ip" field.
+func LastUsedIPGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPGTE applies the GTE predicate on the "last_used_ip" field.
+func LastUsedIPGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPLT applies the LT predicate on the "last_used_ip" field.
+func LastUsedIPLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPLTE applies the LTE predicate on the "last_used_ip" field.
+func LastUsedIPLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPContains applies the Contains predicate on the "last_used_ip" field.
+func LastUsedIPContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPHasPrefix applies the HasPrefix predicate on the "last_used_ip" field.
+func LastUsedIPHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPHasSuffix applies the HasSuffix predicate on the "last_used_ip" field.
+func LastUsedIPHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPIsNil applies the IsNil predicate on the "last_used_ip" field.
+func LastUsedIPIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedIP)))
+	})
+}
+
+// LastUsedIPNotNil applies the NotNil predicate on the "last_used_ip" field.
+func LastUsedIPNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedIP)))
+	})
+}
+
+// LastUsedIPEqualFold applies the EqualFold predicate on the "last_used_ip" field.
+func LastUsedIPEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// LastUsedIPContainsFold applies the ContainsFold predicate on the "last_used_ip" field.
+func LastUsedIPContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLastUsedIP), v))
+	})
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScopes)))
+	})
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScopes)))
+	})
+}
+
+// HasServiceAccountToToken applies the HasEdge predicate on the "ServiceAccountToToken" edge.
+func HasServiceAccountToToken() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToTokenTable, ServiceTokenFieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTokenTable, ServiceAccountToTokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceAccountToTokenWith applies the HasEdge predicate on the "ServiceAccountToToken" edge with a given conditions (other predicates).
+func HasServiceAccountToTokenWith(preds ...predicate.ServiceToken) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToTokenInverseTable, ServiceTokenFieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTokenTable, ServiceAccountToTokenColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServiceAccountToActions applies the HasEdge predicate on the "ServiceAccountToActions" edge.
+func HasServiceAccountToActions() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToActionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToActionsTable, ServiceAccountToActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceAccountToActionsWith applies the HasEdge predicate on the "ServiceAccountToActions" edge with a given conditions (other predicates).
+func HasServiceAccountToActionsWith(preds ...predicate.Action) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToActionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToActionsTable, ServiceAccountToActionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServiceAccountToCompetitions applies the HasEdge predicate on the "ServiceAccountToCompetitions" edge.
+func HasServiceAccountToCompetitions() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToCompetitionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToCompetitionsTable, ServiceAccountToCompetitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceAccountToCompetitionsWith applies the HasEdge predicate on the "ServiceAccountToCompetitions" edge with a given conditions (other predicates).
+func HasServiceAccountToCompetitionsWith(preds ...predicate.Competition) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToCompetitionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToCompetitionsTable, ServiceAccountToCompetitionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServiceAccountToTeams applies the HasEdge predicate on the "ServiceAccountToTeams" edge.
+func HasServiceAccountToTeams() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToTeamsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTeamsTable, ServiceAccountToTeamsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceAccountToTeamsWith applies the HasEdge predicate on the "ServiceAccountToTeams" edge with a given conditions (other predicates).
+func HasServiceAccountToTeamsWith(preds ...predicate.Team) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceAccountToTeamsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountToTeamsTable, ServiceAccountToTeamsColumn),
 		)
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
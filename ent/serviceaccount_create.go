@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/team"
 	"github.com/google/uuid"
 )
 
@@ -40,12 +43,124 @@ func (sac *ServiceAccountCreate) SetAPISecret(u uuid.UUID) *ServiceAccountCreate
 	return sac
 }
 
+// SetNillableAPISecret sets the "api_secret" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableAPISecret(u *uuid.UUID) *ServiceAccountCreate {
+	if u != nil {
+		sac.SetAPISecret(*u)
+	}
+	return sac
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (sac *ServiceAccountCreate) SetAPISecretHash(s string) *ServiceAccountCreate {
+	sac.mutation.SetAPISecretHash(s)
+	return sac
+}
+
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableAPISecretHash(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetAPISecretHash(*s)
+	}
+	return sac
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (sac *ServiceAccountCreate) SetPreviousAPISecretHash(s string) *ServiceAccountCreate {
+	sac.mutation.SetPreviousAPISecretHash(s)
+	return sac
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillablePreviousAPISecretHash(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetPreviousAPISecretHash(*s)
+	}
+	return sac
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (sac *ServiceAccountCreate) SetPreviousAPISecretExpiresAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetPreviousAPISecretExpiresAt(t)
+	return sac
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillablePreviousAPISecretExpiresAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetPreviousAPISecretExpiresAt(*t)
+	}
+	return sac
+}
+
+// SetSecretGeneration sets the "secret_generation" field.
+func (sac *ServiceAccountCreate) SetSecretGeneration(i int) *ServiceAccountCreate {
+	sac.mutation.SetSecretGeneration(i)
+	return sac
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableSecretGeneration(i *int) *ServiceAccountCreate {
+	if i != nil {
+		sac.SetSecretGeneration(*i)
+	}
+	return sac
+}
+
 // SetActive sets the "active" field.
 func (sac *ServiceAccountCreate) SetActive(b bool) *ServiceAccountCreate {
 	sac.mutation.SetActive(b)
 	return sac
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (sac *ServiceAccountCreate) SetExpiresAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetExpiresAt(t)
+	return sac
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableExpiresAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetExpiresAt(*t)
+	}
+	return sac
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sac *ServiceAccountCreate) SetLastUsedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetLastUsedAt(t)
+	return sac
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableLastUsedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetLastUsedAt(*t)
+	}
+	return sac
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (sac *ServiceAccountCreate) SetLastUsedIP(s string) *ServiceAccountCreate {
+	sac.mutation.SetLastUsedIP(s)
+	return sac
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableLastUsedIP(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetLastUsedIP(*s)
+	}
+	return sac
+}
+
+// SetScopes sets the "scopes" field.
+func (sac *ServiceAccountCreate) SetScopes(s []string) *ServiceAccountCreate {
+	sac.mutation.SetScopes(s)
+	return sac
+}
+
 // SetID sets the "id" field.
 func (sac *ServiceAccountCreate) SetID(u uuid.UUID) *ServiceAccountCreate {
 	sac.mutation.SetID(u)
@@ -90,6 +205,36 @@ func (sac *ServiceAccountCreate) AddServiceAccountToActions(a ...*Action) *Servi
 	return sac.AddServiceAccountToActionIDs(ids...)
 }
 
+// AddServiceAccountToCompetitionIDs adds the "ServiceAccountToCompetitions" edge to the Competition entity by IDs.
+func (sac *ServiceAccountCreate) AddServiceAccountToCompetitionIDs(ids ...uuid.UUID) *ServiceAccountCreate {
+	sac.mutation.AddServiceAccountToCompetitionIDs(ids...)
+	return sac
+}
+
+// AddServiceAccountToCompetitions adds the "ServiceAccountToCompetitions" edges to the Competition entity.
+func (sac *ServiceAccountCreate) AddServiceAccountToCompetitions(c ...*Competition) *ServiceAccountCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sac.AddServiceAccountToCompetitionIDs(ids...)
+}
+
+// AddServiceAccountToTeamIDs adds the "ServiceAccountToTeams" edge to the Team entity by IDs.
+func (sac *ServiceAccountCreate) AddServiceAccountToTeamIDs(ids ...uuid.UUID) *ServiceAccountCreate {
+	sac.mutation.AddServiceAccountToTeamIDs(ids...)
+	return sac
+}
+
+// AddServiceAccountToTeams adds the "ServiceAccountToTeams" edges to the Team entity.
+func (sac *ServiceAccountCreate) AddServiceAccountToTeams(t ...*Team) *ServiceAccountCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sac.AddServiceAccountToTeamIDs(ids...)
+}
+
 // Mutation returns the ServiceAccountMutation object of the builder.
 func (sac *ServiceAccountCreate) Mutation() *ServiceAccountMutation {
 	return sac.mutation
@@ -161,6 +306,10 @@ func (sac *ServiceAccountCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (sac *ServiceAccountCreate) defaults() {
+	if _, ok := sac.mutation.SecretGeneration(); !ok {
+		v := serviceaccount.DefaultSecretGeneration
+		sac.mutation.SetSecretGeneration(v)
+	}
 	if _, ok := sac.mutation.ID(); !ok {
 		v := serviceaccount.DefaultID()
 		sac.mutation.SetID(v)
@@ -175,8 +324,8 @@ func (sac *ServiceAccountCreate) check() error {
 	if _, ok := sac.mutation.APIKey(); !ok {
 		return &ValidationError{Name: "api_key", err: errors.New(`ent: missing required field "ServiceAccount.api_key"`)}
 	}
-	if _, ok := sac.mutation.APISecret(); !ok {
-		return &ValidationError{Name: "api_secret", err: errors.New(`ent: missing required field "ServiceAccount.api_secret"`)}
+	if _, ok := sac.mutation.SecretGeneration(); !ok {
+		return &ValidationError{Name: "secret_generation", err: errors.New(`ent: missing required field "ServiceAccount.secret_generation"`)}
 	}
 	if _, ok := sac.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "ServiceAccount.active"`)}
@@ -239,7 +388,39 @@ func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.Create
 			Value:  value,
 			Column: serviceaccount.FieldAPISecret,
 		})
-		_node.APISecret = value
+		_node.APISecret = &value
+	}
+	if value, ok := sac.mutation.APISecretHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldAPISecretHash,
+		})
+		_node.APISecretHash = value
+	}
+	if value, ok := sac.mutation.PreviousAPISecretHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretHash,
+		})
+		_node.PreviousAPISecretHash = value
+	}
+	if value, ok := sac.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretExpiresAt,
+		})
+		_node.PreviousAPISecretExpiresAt = &value
+	}
+	if value, ok := sac.mutation.SecretGeneration(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: serviceaccount.FieldSecretGeneration,
+		})
+		_node.SecretGeneration = value
 	}
 	if value, ok := sac.mutation.Active(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
@@ -249,6 +430,38 @@ func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.Create
 		})
 		_node.Active = value
 	}
+	if value, ok := sac.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sac.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := sac.mutation.LastUsedIP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedIP,
+		})
+		_node.LastUsedIP = value
+	}
+	if value, ok := sac.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: serviceaccount.FieldScopes,
+		})
+		_node.Scopes = value
+	}
 	if nodes := sac.mutation.ServiceAccountToTokenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -287,6 +500,44 @@ func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.Create
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sac.mutation.ServiceAccountToCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.ServiceAccountToTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
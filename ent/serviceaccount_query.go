@@ -13,9 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/team"
 	"github.com/google/uuid"
 )
 
@@ -29,8 +31,10 @@ type ServiceAccountQuery struct {
 	fields     []string
 	predicates []predicate.ServiceAccount
 	// eager-loading edges.
-	withServiceAccountToToken   *ServiceTokenQuery
-	withServiceAccountToActions *ActionQuery
+	withServiceAccountToToken        *ServiceTokenQuery
+	withServiceAccountToActions      *ActionQuery
+	withServiceAccountToCompetitions *CompetitionQuery
+	withServiceAccountToTeams        *TeamQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -111,6 +115,50 @@ func (saq *ServiceAccountQuery) QueryServiceAccountToActions() *ActionQuery {
 	return query
 }
 
+// QueryServiceAccountToCompetitions chains the current query on the "ServiceAccountToCompetitions" edge.
+func (saq *ServiceAccountQuery) QueryServiceAccountToCompetitions() *CompetitionQuery {
+	query := &CompetitionQuery{config: saq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, selector),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serviceaccount.ServiceAccountToCompetitionsTable, serviceaccount.ServiceAccountToCompetitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryServiceAccountToTeams chains the current query on the "ServiceAccountToTeams" edge.
+func (saq *ServiceAccountQuery) QueryServiceAccountToTeams() *TeamQuery {
+	query := &TeamQuery{config: saq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceaccount.Table, serviceaccount.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serviceaccount.ServiceAccountToTeamsTable, serviceaccount.ServiceAccountToTeamsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ServiceAccount entity from the query.
 // Returns a *NotFoundError when no ServiceAccount was found.
 func (saq *ServiceAccountQuery) First(ctx context.Context) (*ServiceAccount, error) {
@@ -287,13 +335,15 @@ func (saq *ServiceAccountQuery) Clone() *ServiceAccountQuery {
 		return nil
 	}
 	return &ServiceAccountQuery{
-		config:                      saq.config,
-		limit:                       saq.limit,
-		offset:                      saq.offset,
-		order:                       append([]OrderFunc{}, saq.order...),
-		predicates:                  append([]predicate.ServiceAccount{}, saq.predicates...),
-		withServiceAccountToToken:   saq.withServiceAccountToToken.Clone(),
-		withServiceAccountToActions: saq.withServiceAccountToActions.Clone(),
+		config:                           saq.config,
+		limit:                            saq.limit,
+		offset:                           saq.offset,
+		order:                            append([]OrderFunc{}, saq.order...),
+		predicates:                       append([]predicate.ServiceAccount{}, saq.predicates...),
+		withServiceAccountToToken:        saq.withServiceAccountToToken.Clone(),
+		withServiceAccountToActions:      saq.withServiceAccountToActions.Clone(),
+		withServiceAccountToCompetitions: saq.withServiceAccountToCompetitions.Clone(),
+		withServiceAccountToTeams:        saq.withServiceAccountToTeams.Clone(),
 		// clone intermediate query.
 		sql:    saq.sql.Clone(),
 		path:   saq.path,
@@ -323,6 +373,28 @@ func (saq *ServiceAccountQuery) WithServiceAccountToActions(opts ...func(*Action
 	return saq
 }
 
+// WithServiceAccountToCompetitions tells the query-builder to eager-load the nodes that are connected to
+// the "ServiceAccountToCompetitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ServiceAccountQuery) WithServiceAccountToCompetitions(opts ...func(*CompetitionQuery)) *ServiceAccountQuery {
+	query := &CompetitionQuery{config: saq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withServiceAccountToCompetitions = query
+	return saq
+}
+
+// WithServiceAccountToTeams tells the query-builder to eager-load the nodes that are connected to
+// the "ServiceAccountToTeams" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ServiceAccountQuery) WithServiceAccountToTeams(opts ...func(*TeamQuery)) *ServiceAccountQuery {
+	query := &TeamQuery{config: saq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withServiceAccountToTeams = query
+	return saq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -386,9 +458,11 @@ func (saq *ServiceAccountQuery) sqlAll(ctx context.Context) ([]*ServiceAccount,
 	var (
 		nodes       = []*ServiceAccount{}
 		_spec       = saq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			saq.withServiceAccountToToken != nil,
 			saq.withServiceAccountToActions != nil,
+			saq.withServiceAccountToCompetitions != nil,
+			saq.withServiceAccountToTeams != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -469,6 +543,64 @@ func (saq *ServiceAccountQuery) sqlAll(ctx context.Context) ([]*ServiceAccount,
 		}
 	}
 
+	if query := saq.withServiceAccountToCompetitions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*ServiceAccount)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ServiceAccountToCompetitions = []*Competition{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Competition(func(s *sql.Selector) {
+			s.Where(sql.InValues(serviceaccount.ServiceAccountToCompetitionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.service_account_service_account_to_competitions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "service_account_service_account_to_competitions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "service_account_service_account_to_competitions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ServiceAccountToCompetitions = append(node.Edges.ServiceAccountToCompetitions, n)
+		}
+	}
+
+	if query := saq.withServiceAccountToTeams; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*ServiceAccount)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ServiceAccountToTeams = []*Team{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Team(func(s *sql.Selector) {
+			s.Where(sql.InValues(serviceaccount.ServiceAccountToTeamsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.service_account_service_account_to_teams
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "service_account_service_account_to_teams" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "service_account_service_account_to_teams" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ServiceAccountToTeams = append(node.Edges.ServiceAccountToTeams, n)
+		}
+	}
+
 	return nodes, nil
 }
 
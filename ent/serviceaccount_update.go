@@ -6,14 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
 	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/team"
 	"github.com/google/uuid"
 )
 
@@ -48,12 +51,179 @@ func (sau *ServiceAccountUpdate) SetAPISecret(u uuid.UUID) *ServiceAccountUpdate
 	return sau
 }
 
+// SetNillableAPISecret sets the "api_secret" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableAPISecret(u *uuid.UUID) *ServiceAccountUpdate {
+	if u != nil {
+		sau.SetAPISecret(*u)
+	}
+	return sau
+}
+
+// ClearAPISecret clears the value of the "api_secret" field.
+func (sau *ServiceAccountUpdate) ClearAPISecret() *ServiceAccountUpdate {
+	sau.mutation.ClearAPISecret()
+	return sau
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (sau *ServiceAccountUpdate) SetAPISecretHash(s string) *ServiceAccountUpdate {
+	sau.mutation.SetAPISecretHash(s)
+	return sau
+}
+
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableAPISecretHash(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetAPISecretHash(*s)
+	}
+	return sau
+}
+
+// ClearAPISecretHash clears the value of the "api_secret_hash" field.
+func (sau *ServiceAccountUpdate) ClearAPISecretHash() *ServiceAccountUpdate {
+	sau.mutation.ClearAPISecretHash()
+	return sau
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (sau *ServiceAccountUpdate) SetPreviousAPISecretHash(s string) *ServiceAccountUpdate {
+	sau.mutation.SetPreviousAPISecretHash(s)
+	return sau
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillablePreviousAPISecretHash(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetPreviousAPISecretHash(*s)
+	}
+	return sau
+}
+
+// ClearPreviousAPISecretHash clears the value of the "previous_api_secret_hash" field.
+func (sau *ServiceAccountUpdate) ClearPreviousAPISecretHash() *ServiceAccountUpdate {
+	sau.mutation.ClearPreviousAPISecretHash()
+	return sau
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (sau *ServiceAccountUpdate) SetPreviousAPISecretExpiresAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetPreviousAPISecretExpiresAt(t)
+	return sau
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillablePreviousAPISecretExpiresAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetPreviousAPISecretExpiresAt(*t)
+	}
+	return sau
+}
+
+// ClearPreviousAPISecretExpiresAt clears the value of the "previous_api_secret_expires_at" field.
+func (sau *ServiceAccountUpdate) ClearPreviousAPISecretExpiresAt() *ServiceAccountUpdate {
+	sau.mutation.ClearPreviousAPISecretExpiresAt()
+	return sau
+}
+
+// SetSecretGeneration sets the "secret_generation" field.
+func (sau *ServiceAccountUpdate) SetSecretGeneration(i int) *ServiceAccountUpdate {
+	sau.mutation.ResetSecretGeneration()
+	sau.mutation.SetSecretGeneration(i)
+	return sau
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableSecretGeneration(i *int) *ServiceAccountUpdate {
+	if i != nil {
+		sau.SetSecretGeneration(*i)
+	}
+	return sau
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (sau *ServiceAccountUpdate) AddSecretGeneration(i int) *ServiceAccountUpdate {
+	sau.mutation.AddSecretGeneration(i)
+	return sau
+}
+
 // SetActive sets the "active" field.
 func (sau *ServiceAccountUpdate) SetActive(b bool) *ServiceAccountUpdate {
 	sau.mutation.SetActive(b)
 	return sau
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (sau *ServiceAccountUpdate) SetExpiresAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetExpiresAt(t)
+	return sau
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableExpiresAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetExpiresAt(*t)
+	}
+	return sau
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sau *ServiceAccountUpdate) ClearExpiresAt() *ServiceAccountUpdate {
+	sau.mutation.ClearExpiresAt()
+	return sau
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sau *ServiceAccountUpdate) SetLastUsedAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetLastUsedAt(t)
+	return sau
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableLastUsedAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetLastUsedAt(*t)
+	}
+	return sau
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sau *ServiceAccountUpdate) ClearLastUsedAt() *ServiceAccountUpdate {
+	sau.mutation.ClearLastUsedAt()
+	return sau
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (sau *ServiceAccountUpdate) SetLastUsedIP(s string) *ServiceAccountUpdate {
+	sau.mutation.SetLastUsedIP(s)
+	return sau
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableLastUsedIP(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetLastUsedIP(*s)
+	}
+	return sau
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (sau *ServiceAccountUpdate) ClearLastUsedIP() *ServiceAccountUpdate {
+	sau.mutation.ClearLastUsedIP()
+	return sau
+}
+
+// SetScopes sets the "scopes" field.
+func (sau *ServiceAccountUpdate) SetScopes(s []string) *ServiceAccountUpdate {
+	sau.mutation.SetScopes(s)
+	return sau
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (sau *ServiceAccountUpdate) ClearScopes() *ServiceAccountUpdate {
+	sau.mutation.ClearScopes()
+	return sau
+}
+
 // AddServiceAccountToTokenIDs adds the "ServiceAccountToToken" edge to the ServiceToken entity by IDs.
 func (sau *ServiceAccountUpdate) AddServiceAccountToTokenIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
 	sau.mutation.AddServiceAccountToTokenIDs(ids...)
@@ -84,6 +254,36 @@ func (sau *ServiceAccountUpdate) AddServiceAccountToActions(a ...*Action) *Servi
 	return sau.AddServiceAccountToActionIDs(ids...)
 }
 
+// AddServiceAccountToCompetitionIDs adds the "ServiceAccountToCompetitions" edge to the Competition entity by IDs.
+func (sau *ServiceAccountUpdate) AddServiceAccountToCompetitionIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.AddServiceAccountToCompetitionIDs(ids...)
+	return sau
+}
+
+// AddServiceAccountToCompetitions adds the "ServiceAccountToCompetitions" edges to the Competition entity.
+func (sau *ServiceAccountUpdate) AddServiceAccountToCompetitions(c ...*Competition) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sau.AddServiceAccountToCompetitionIDs(ids...)
+}
+
+// AddServiceAccountToTeamIDs adds the "ServiceAccountToTeams" edge to the Team entity by IDs.
+func (sau *ServiceAccountUpdate) AddServiceAccountToTeamIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.AddServiceAccountToTeamIDs(ids...)
+	return sau
+}
+
+// AddServiceAccountToTeams adds the "ServiceAccountToTeams" edges to the Team entity.
+func (sau *ServiceAccountUpdate) AddServiceAccountToTeams(t ...*Team) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sau.AddServiceAccountToTeamIDs(ids...)
+}
+
 // Mutation returns the ServiceAccountMutation object of the builder.
 func (sau *ServiceAccountUpdate) Mutation() *ServiceAccountMutation {
 	return sau.mutation
@@ -131,6 +331,48 @@ func (sau *ServiceAccountUpdate) RemoveServiceAccountToActions(a ...*Action) *Se
 	return sau.RemoveServiceAccountToActionIDs(ids...)
 }
 
+// ClearServiceAccountToCompetitions clears all "ServiceAccountToCompetitions" edges to the Competition entity.
+func (sau *ServiceAccountUpdate) ClearServiceAccountToCompetitions() *ServiceAccountUpdate {
+	sau.mutation.ClearServiceAccountToCompetitions()
+	return sau
+}
+
+// RemoveServiceAccountToCompetitionIDs removes the "ServiceAccountToCompetitions" edge to Competition entities by IDs.
+func (sau *ServiceAccountUpdate) RemoveServiceAccountToCompetitionIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.RemoveServiceAccountToCompetitionIDs(ids...)
+	return sau
+}
+
+// RemoveServiceAccountToCompetitions removes "ServiceAccountToCompetitions" edges to Competition entities.
+func (sau *ServiceAccountUpdate) RemoveServiceAccountToCompetitions(c ...*Competition) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sau.RemoveServiceAccountToCompetitionIDs(ids...)
+}
+
+// ClearServiceAccountToTeams clears all "ServiceAccountToTeams" edges to the Team entity.
+func (sau *ServiceAccountUpdate) ClearServiceAccountToTeams() *ServiceAccountUpdate {
+	sau.mutation.ClearServiceAccountToTeams()
+	return sau
+}
+
+// RemoveServiceAccountToTeamIDs removes the "ServiceAccountToTeams" edge to Team entities by IDs.
+func (sau *ServiceAccountUpdate) RemoveServiceAccountToTeamIDs(ids ...uuid.UUID) *ServiceAccountUpdate {
+	sau.mutation.RemoveServiceAccountToTeamIDs(ids...)
+	return sau
+}
+
+// RemoveServiceAccountToTeams removes "ServiceAccountToTeams" edges to Team entities.
+func (sau *ServiceAccountUpdate) RemoveServiceAccountToTeams(t ...*Team) *ServiceAccountUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sau.RemoveServiceAccountToTeamIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (sau *ServiceAccountUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -224,6 +466,65 @@ func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error)
 			Column: serviceaccount.FieldAPISecret,
 		})
 	}
+	if sau.mutation.APISecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: serviceaccount.FieldAPISecret,
+		})
+	}
+	if value, ok := sau.mutation.APISecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldAPISecretHash,
+		})
+	}
+	if sau.mutation.APISecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldAPISecretHash,
+		})
+	}
+	if value, ok := sau.mutation.PreviousAPISecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretHash,
+		})
+	}
+	if sau.mutation.PreviousAPISecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldPreviousAPISecretHash,
+		})
+	}
+	if value, ok := sau.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretExpiresAt,
+		})
+	}
+	if sau.mutation.PreviousAPISecretExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldPreviousAPISecretExpiresAt,
+		})
+	}
+	if value, ok := sau.mutation.SecretGeneration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: serviceaccount.FieldSecretGeneration,
+		})
+	}
+	if value, ok := sau.mutation.AddedSecretGeneration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: serviceaccount.FieldSecretGeneration,
+		})
+	}
 	if value, ok := sau.mutation.Active(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -231,6 +532,58 @@ func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error)
 			Column: serviceaccount.FieldActive,
 		})
 	}
+	if value, ok := sau.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldExpiresAt,
+		})
+	}
+	if sau.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldExpiresAt,
+		})
+	}
+	if value, ok := sau.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedAt,
+		})
+	}
+	if sau.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldLastUsedAt,
+		})
+	}
+	if value, ok := sau.mutation.LastUsedIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedIP,
+		})
+	}
+	if sau.mutation.LastUsedIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldLastUsedIP,
+		})
+	}
+	if value, ok := sau.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: serviceaccount.FieldScopes,
+		})
+	}
+	if sau.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: serviceaccount.FieldScopes,
+		})
+	}
 	if sau.mutation.ServiceAccountToTokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -339,6 +692,114 @@ func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if sau.mutation.ServiceAccountToCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.RemovedServiceAccountToCompetitionsIDs(); len(nodes) > 0 && !sau.mutation.ServiceAccountToCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.ServiceAccountToCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sau.mutation.ServiceAccountToTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.RemovedServiceAccountToTeamsIDs(); len(nodes) > 0 && !sau.mutation.ServiceAccountToTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.ServiceAccountToTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{serviceaccount.Label}
@@ -376,12 +837,179 @@ func (sauo *ServiceAccountUpdateOne) SetAPISecret(u uuid.UUID) *ServiceAccountUp
 	return sauo
 }
 
+// SetNillableAPISecret sets the "api_secret" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableAPISecret(u *uuid.UUID) *ServiceAccountUpdateOne {
+	if u != nil {
+		sauo.SetAPISecret(*u)
+	}
+	return sauo
+}
+
+// ClearAPISecret clears the value of the "api_secret" field.
+func (sauo *ServiceAccountUpdateOne) ClearAPISecret() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearAPISecret()
+	return sauo
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (sauo *ServiceAccountUpdateOne) SetAPISecretHash(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetAPISecretHash(s)
+	return sauo
+}
+
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableAPISecretHash(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetAPISecretHash(*s)
+	}
+	return sauo
+}
+
+// ClearAPISecretHash clears the value of the "api_secret_hash" field.
+func (sauo *ServiceAccountUpdateOne) ClearAPISecretHash() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearAPISecretHash()
+	return sauo
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (sauo *ServiceAccountUpdateOne) SetPreviousAPISecretHash(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetPreviousAPISecretHash(s)
+	return sauo
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillablePreviousAPISecretHash(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetPreviousAPISecretHash(*s)
+	}
+	return sauo
+}
+
+// ClearPreviousAPISecretHash clears the value of the "previous_api_secret_hash" field.
+func (sauo *ServiceAccountUpdateOne) ClearPreviousAPISecretHash() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearPreviousAPISecretHash()
+	return sauo
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (sauo *ServiceAccountUpdateOne) SetPreviousAPISecretExpiresAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetPreviousAPISecretExpiresAt(t)
+	return sauo
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillablePreviousAPISecretExpiresAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetPreviousAPISecretExpiresAt(*t)
+	}
+	return sauo
+}
+
+// ClearPreviousAPISecretExpiresAt clears the value of the "previous_api_secret_expires_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearPreviousAPISecretExpiresAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearPreviousAPISecretExpiresAt()
+	return sauo
+}
+
+// SetSecretGeneration sets the "secret_generation" field.
+func (sauo *ServiceAccountUpdateOne) SetSecretGeneration(i int) *ServiceAccountUpdateOne {
+	sauo.mutation.ResetSecretGeneration()
+	sauo.mutation.SetSecretGeneration(i)
+	return sauo
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableSecretGeneration(i *int) *ServiceAccountUpdateOne {
+	if i != nil {
+		sauo.SetSecretGeneration(*i)
+	}
+	return sauo
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (sauo *ServiceAccountUpdateOne) AddSecretGeneration(i int) *ServiceAccountUpdateOne {
+	sauo.mutation.AddSecretGeneration(i)
+	return sauo
+}
+
 // SetActive sets the "active" field.
 func (sauo *ServiceAccountUpdateOne) SetActive(b bool) *ServiceAccountUpdateOne {
 	sauo.mutation.SetActive(b)
 	return sauo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (sauo *ServiceAccountUpdateOne) SetExpiresAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetExpiresAt(t)
+	return sauo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableExpiresAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetExpiresAt(*t)
+	}
+	return sauo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearExpiresAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearExpiresAt()
+	return sauo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sauo *ServiceAccountUpdateOne) SetLastUsedAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetLastUsedAt(t)
+	return sauo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableLastUsedAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetLastUsedAt(*t)
+	}
+	return sauo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearLastUsedAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearLastUsedAt()
+	return sauo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (sauo *ServiceAccountUpdateOne) SetLastUsedIP(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetLastUsedIP(s)
+	return sauo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableLastUsedIP(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetLastUsedIP(*s)
+	}
+	return sauo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (sauo *ServiceAccountUpdateOne) ClearLastUsedIP() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearLastUsedIP()
+	return sauo
+}
+
+// SetScopes sets the "scopes" field.
+func (sauo *ServiceAccountUpdateOne) SetScopes(s []string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetScopes(s)
+	return sauo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (sauo *ServiceAccountUpdateOne) ClearScopes() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearScopes()
+	return sauo
+}
+
 // AddServiceAccountToTokenIDs adds the "ServiceAccountToToken" edge to the ServiceToken entity by IDs.
 func (sauo *ServiceAccountUpdateOne) AddServiceAccountToTokenIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
 	sauo.mutation.AddServiceAccountToTokenIDs(ids...)
@@ -412,6 +1040,36 @@ func (sauo *ServiceAccountUpdateOne) AddServiceAccountToActions(a ...*Action) *S
 	return sauo.AddServiceAccountToActionIDs(ids...)
 }
 
+// AddServiceAccountToCompetitionIDs adds the "ServiceAccountToCompetitions" edge to the Competition entity by IDs.
+func (sauo *ServiceAccountUpdateOne) AddServiceAccountToCompetitionIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.AddServiceAccountToCompetitionIDs(ids...)
+	return sauo
+}
+
+// AddServiceAccountToCompetitions adds the "ServiceAccountToCompetitions" edges to the Competition entity.
+func (sauo *ServiceAccountUpdateOne) AddServiceAccountToCompetitions(c ...*Competition) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sauo.AddServiceAccountToCompetitionIDs(ids...)
+}
+
+// AddServiceAccountToTeamIDs adds the "ServiceAccountToTeams" edge to the Team entity by IDs.
+func (sauo *ServiceAccountUpdateOne) AddServiceAccountToTeamIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.AddServiceAccountToTeamIDs(ids...)
+	return sauo
+}
+
+// AddServiceAccountToTeams adds the "ServiceAccountToTeams" edges to the Team entity.
+func (sauo *ServiceAccountUpdateOne) AddServiceAccountToTeams(t ...*Team) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sauo.AddServiceAccountToTeamIDs(ids...)
+}
+
 // Mutation returns the ServiceAccountMutation object of the builder.
 func (sauo *ServiceAccountUpdateOne) Mutation() *ServiceAccountMutation {
 	return sauo.mutation
@@ -459,6 +1117,48 @@ func (sauo *ServiceAccountUpdateOne) RemoveServiceAccountToActions(a ...*Action)
 	return sauo.RemoveServiceAccountToActionIDs(ids...)
 }
 
+// ClearServiceAccountToCompetitions clears all "ServiceAccountToCompetitions" edges to the Competition entity.
+func (sauo *ServiceAccountUpdateOne) ClearServiceAccountToCompetitions() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearServiceAccountToCompetitions()
+	return sauo
+}
+
+// RemoveServiceAccountToCompetitionIDs removes the "ServiceAccountToCompetitions" edge to Competition entities by IDs.
+func (sauo *ServiceAccountUpdateOne) RemoveServiceAccountToCompetitionIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.RemoveServiceAccountToCompetitionIDs(ids...)
+	return sauo
+}
+
+// RemoveServiceAccountToCompetitions removes "ServiceAccountToCompetitions" edges to Competition entities.
+func (sauo *ServiceAccountUpdateOne) RemoveServiceAccountToCompetitions(c ...*Competition) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sauo.RemoveServiceAccountToCompetitionIDs(ids...)
+}
+
+// ClearServiceAccountToTeams clears all "ServiceAccountToTeams" edges to the Team entity.
+func (sauo *ServiceAccountUpdateOne) ClearServiceAccountToTeams() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearServiceAccountToTeams()
+	return sauo
+}
+
+// RemoveServiceAccountToTeamIDs removes the "ServiceAccountToTeams" edge to Team entities by IDs.
+func (sauo *ServiceAccountUpdateOne) RemoveServiceAccountToTeamIDs(ids ...uuid.UUID) *ServiceAccountUpdateOne {
+	sauo.mutation.RemoveServiceAccountToTeamIDs(ids...)
+	return sauo
+}
+
+// RemoveServiceAccountToTeams removes "ServiceAccountToTeams" edges to Team entities.
+func (sauo *ServiceAccountUpdateOne) RemoveServiceAccountToTeams(t ...*Team) *ServiceAccountUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sauo.RemoveServiceAccountToTeamIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (sauo *ServiceAccountUpdateOne) Select(field string, fields ...string) *ServiceAccountUpdateOne {
@@ -576,6 +1276,65 @@ func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *Servic
 			Column: serviceaccount.FieldAPISecret,
 		})
 	}
+	if sauo.mutation.APISecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: serviceaccount.FieldAPISecret,
+		})
+	}
+	if value, ok := sauo.mutation.APISecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldAPISecretHash,
+		})
+	}
+	if sauo.mutation.APISecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldAPISecretHash,
+		})
+	}
+	if value, ok := sauo.mutation.PreviousAPISecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretHash,
+		})
+	}
+	if sauo.mutation.PreviousAPISecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldPreviousAPISecretHash,
+		})
+	}
+	if value, ok := sauo.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldPreviousAPISecretExpiresAt,
+		})
+	}
+	if sauo.mutation.PreviousAPISecretExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldPreviousAPISecretExpiresAt,
+		})
+	}
+	if value, ok := sauo.mutation.SecretGeneration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: serviceaccount.FieldSecretGeneration,
+		})
+	}
+	if value, ok := sauo.mutation.AddedSecretGeneration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: serviceaccount.FieldSecretGeneration,
+		})
+	}
 	if value, ok := sauo.mutation.Active(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -583,6 +1342,58 @@ func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *Servic
 			Column: serviceaccount.FieldActive,
 		})
 	}
+	if value, ok := sauo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldExpiresAt,
+		})
+	}
+	if sauo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldExpiresAt,
+		})
+	}
+	if value, ok := sauo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedAt,
+		})
+	}
+	if sauo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: serviceaccount.FieldLastUsedAt,
+		})
+	}
+	if value, ok := sauo.mutation.LastUsedIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: serviceaccount.FieldLastUsedIP,
+		})
+	}
+	if sauo.mutation.LastUsedIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: serviceaccount.FieldLastUsedIP,
+		})
+	}
+	if value, ok := sauo.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: serviceaccount.FieldScopes,
+		})
+	}
+	if sauo.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: serviceaccount.FieldScopes,
+		})
+	}
 	if sauo.mutation.ServiceAccountToTokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -691,6 +1502,114 @@ func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *Servic
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if sauo.mutation.ServiceAccountToCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.RemovedServiceAccountToCompetitionsIDs(); len(nodes) > 0 && !sauo.mutation.ServiceAccountToCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.ServiceAccountToCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToCompetitionsTable,
+			Columns: []string{serviceaccount.ServiceAccountToCompetitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sauo.mutation.ServiceAccountToTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.RemovedServiceAccountToTeamsIDs(); len(nodes) > 0 && !sauo.mutation.ServiceAccountToTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.ServiceAccountToTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serviceaccount.ServiceAccountToTeamsTable,
+			Columns: []string{serviceaccount.ServiceAccountToTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ServiceAccount{config: sauo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -26,6 +26,9 @@ type ServiceToken struct {
 	// IssuedAt holds the value of the "issued_at" field.
 	// [REQUIRED] The time the token was issued
 	IssuedAt int64 `json:"issued_at,omitempty"`
+	// SecretGeneration holds the value of the "secret_generation" field.
+	// [INTERNAL] The secret_generation of the service account's secret which started this session. Sessions started with the previous secret end with its overlap.
+	SecretGeneration int `json:"secret_generation,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ServiceTokenQuery when eager-loading is set.
 	Edges                                    ServiceTokenEdges `json:"edges"`
@@ -60,7 +63,7 @@ func (*ServiceToken) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case servicetoken.FieldIssuedAt:
+		case servicetoken.FieldIssuedAt, servicetoken.FieldSecretGeneration:
 			values[i] = new(sql.NullInt64)
 		case servicetoken.FieldToken, servicetoken.FieldRefreshToken:
 			values[i] = new(sql.NullString)
@@ -107,6 +110,12 @@ func (st *ServiceToken) assignValues(columns []string, values []interface{}) err
 			} else if value.Valid {
 				st.IssuedAt = value.Int64
 			}
+		case servicetoken.FieldSecretGeneration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_generation", values[i])
+			} else if value.Valid {
+				st.SecretGeneration = int(value.Int64)
+			}
 		case servicetoken.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field service_account_service_account_to_token", values[i])
@@ -153,6 +162,8 @@ func (st *ServiceToken) String() string {
 	builder.WriteString(st.RefreshToken)
 	builder.WriteString(", issued_at=")
 	builder.WriteString(fmt.Sprintf("%v", st.IssuedAt))
+	builder.WriteString(", secret_generation=")
+	builder.WriteString(fmt.Sprintf("%v", st.SecretGeneration))
 	builder.WriteByte(')')
 	return builder.String()
 }
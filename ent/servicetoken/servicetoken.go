@@ -17,6 +17,8 @@ const (
 	FieldRefreshToken = "refresh_token"
 	// FieldIssuedAt holds the string denoting the issued_at field in the database.
 	FieldIssuedAt = "issued_at"
+	// FieldSecretGeneration holds the string denoting the secret_generation field in the database.
+	FieldSecretGeneration = "secret_generation"
 	// EdgeTokenToServiceAccount holds the string denoting the tokentoserviceaccount edge name in mutations.
 	EdgeTokenToServiceAccount = "TokenToServiceAccount"
 	// ServiceAccountFieldID holds the string denoting the ID field of the ServiceAccount.
@@ -38,6 +40,7 @@ var Columns = []string{
 	FieldToken,
 	FieldRefreshToken,
 	FieldIssuedAt,
+	FieldSecretGeneration,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "service_tokens"
@@ -62,6 +65,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultSecretGeneration holds the default value on creation for the "secret_generation" field.
+	DefaultSecretGeneration int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -113,6 +113,13 @@ func IssuedAt(v int64) predicate.ServiceToken {
 	})
 }
 
+// SecretGeneration applies equality check predicate on the "secret_generation" field. It's identical to SecretGenerationEQ.
+func SecretGeneration(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
 // TokenEQ applies the EQ predicate on the "token" field.
 func TokenEQ(v string) predicate.ServiceToken {
 	return predicate.ServiceToken(func(s *sql.Selector) {
@@ -411,6 +418,82 @@ func IssuedAtLTE(v int64) predicate.ServiceToken {
 	})
 }
 
+// SecretGenerationEQ applies the EQ predicate on the "secret_generation" field.
+func SecretGenerationEQ(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationNEQ applies the NEQ predicate on the "secret_generation" field.
+func SecretGenerationNEQ(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationIn applies the In predicate on the "secret_generation" field.
+func SecretGenerationIn(vs ...int) predicate.ServiceToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSecretGeneration), v...))
+	})
+}
+
+// SecretGenerationNotIn applies the NotIn predicate on the "secret_generation" field.
+func SecretGenerationNotIn(vs ...int) predicate.ServiceToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSecretGeneration), v...))
+	})
+}
+
+// SecretGenerationGT applies the GT predicate on the "secret_generation" field.
+func SecretGenerationGT(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationGTE applies the GTE predicate on the "secret_generation" field.
+func SecretGenerationGTE(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationLT applies the LT predicate on the "secret_generation" field.
+func SecretGenerationLT(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSecretGeneration), v))
+	})
+}
+
+// SecretGenerationLTE applies the LTE predicate on the "secret_generation" field.
+func SecretGenerationLTE(v int) predicate.ServiceToken {
+	return predicate.ServiceToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSecretGeneration), v))
+	})
+}
+
 // HasTokenToServiceAccount applies the HasEdge predicate on the "TokenToServiceAccount" edge.
 func HasTokenToServiceAccount() predicate.ServiceToken {
 	return predicate.ServiceToken(func(s *sql.Selector) {
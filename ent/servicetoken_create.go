@@ -39,6 +39,20 @@ func (stc *ServiceTokenCreate) SetIssuedAt(i int64) *ServiceTokenCreate {
 	return stc
 }
 
+// SetSecretGeneration sets the "secret_generation" field.
+func (stc *ServiceTokenCreate) SetSecretGeneration(i int) *ServiceTokenCreate {
+	stc.mutation.SetSecretGeneration(i)
+	return stc
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (stc *ServiceTokenCreate) SetNillableSecretGeneration(i *int) *ServiceTokenCreate {
+	if i != nil {
+		stc.SetSecretGeneration(*i)
+	}
+	return stc
+}
+
 // SetID sets the "id" field.
 func (stc *ServiceTokenCreate) SetID(u uuid.UUID) *ServiceTokenCreate {
 	stc.mutation.SetID(u)
@@ -135,6 +149,10 @@ func (stc *ServiceTokenCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (stc *ServiceTokenCreate) defaults() {
+	if _, ok := stc.mutation.SecretGeneration(); !ok {
+		v := servicetoken.DefaultSecretGeneration
+		stc.mutation.SetSecretGeneration(v)
+	}
 	if _, ok := stc.mutation.ID(); !ok {
 		v := servicetoken.DefaultID()
 		stc.mutation.SetID(v)
@@ -152,6 +170,9 @@ func (stc *ServiceTokenCreate) check() error {
 	if _, ok := stc.mutation.IssuedAt(); !ok {
 		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "ServiceToken.issued_at"`)}
 	}
+	if _, ok := stc.mutation.SecretGeneration(); !ok {
+		return &ValidationError{Name: "secret_generation", err: errors.New(`ent: missing required field "ServiceToken.secret_generation"`)}
+	}
 	if _, ok := stc.mutation.TokenToServiceAccountID(); !ok {
 		return &ValidationError{Name: "TokenToServiceAccount", err: errors.New(`ent: missing required edge "ServiceToken.TokenToServiceAccount"`)}
 	}
@@ -215,6 +236,14 @@ func (stc *ServiceTokenCreate) createSpec() (*ServiceToken, *sqlgraph.CreateSpec
 		})
 		_node.IssuedAt = value
 	}
+	if value, ok := stc.mutation.SecretGeneration(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: servicetoken.FieldSecretGeneration,
+		})
+		_node.SecretGeneration = value
+	}
 	if nodes := stc.mutation.TokenToServiceAccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
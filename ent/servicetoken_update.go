@@ -54,6 +54,27 @@ func (stu *ServiceTokenUpdate) AddIssuedAt(i int64) *ServiceTokenUpdate {
 	return stu
 }
 
+// SetSecretGeneration sets the "secret_generation" field.
+func (stu *ServiceTokenUpdate) SetSecretGeneration(i int) *ServiceTokenUpdate {
+	stu.mutation.ResetSecretGeneration()
+	stu.mutation.SetSecretGeneration(i)
+	return stu
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (stu *ServiceTokenUpdate) SetNillableSecretGeneration(i *int) *ServiceTokenUpdate {
+	if i != nil {
+		stu.SetSecretGeneration(*i)
+	}
+	return stu
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (stu *ServiceTokenUpdate) AddSecretGeneration(i int) *ServiceTokenUpdate {
+	stu.mutation.AddSecretGeneration(i)
+	return stu
+}
+
 // SetTokenToServiceAccountID sets the "TokenToServiceAccount" edge to the ServiceAccount entity by ID.
 func (stu *ServiceTokenUpdate) SetTokenToServiceAccountID(id uuid.UUID) *ServiceTokenUpdate {
 	stu.mutation.SetTokenToServiceAccountID(id)
@@ -190,6 +211,20 @@ func (stu *ServiceTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: servicetoken.FieldIssuedAt,
 		})
 	}
+	if value, ok := stu.mutation.SecretGeneration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: servicetoken.FieldSecretGeneration,
+		})
+	}
+	if value, ok := stu.mutation.AddedSecretGeneration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: servicetoken.FieldSecretGeneration,
+		})
+	}
 	if stu.mutation.TokenToServiceAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -269,6 +304,27 @@ func (stuo *ServiceTokenUpdateOne) AddIssuedAt(i int64) *ServiceTokenUpdateOne {
 	return stuo
 }
 
+// SetSecretGeneration sets the "secret_generation" field.
+func (stuo *ServiceTokenUpdateOne) SetSecretGeneration(i int) *ServiceTokenUpdateOne {
+	stuo.mutation.ResetSecretGeneration()
+	stuo.mutation.SetSecretGeneration(i)
+	return stuo
+}
+
+// SetNillableSecretGeneration sets the "secret_generation" field if the given value is not nil.
+func (stuo *ServiceTokenUpdateOne) SetNillableSecretGeneration(i *int) *ServiceTokenUpdateOne {
+	if i != nil {
+		stuo.SetSecretGeneration(*i)
+	}
+	return stuo
+}
+
+// AddSecretGeneration adds i to the "secret_generation" field.
+func (stuo *ServiceTokenUpdateOne) AddSecretGeneration(i int) *ServiceTokenUpdateOne {
+	stuo.mutation.AddSecretGeneration(i)
+	return stuo
+}
+
 // SetTokenToServiceAccountID sets the "TokenToServiceAccount" edge to the ServiceAccount entity by ID.
 func (stuo *ServiceTokenUpdateOne) SetTokenToServiceAccountID(id uuid.UUID) *ServiceTokenUpdateOne {
 	stuo.mutation.SetTokenToServiceAccountID(id)
@@ -429,6 +485,20 @@ func (stuo *ServiceTokenUpdateOne) sqlSave(ctx context.Context) (_node *ServiceT
 			Column: servicetoken.FieldIssuedAt,
 		})
 	}
+	if value, ok := stuo.mutation.SecretGeneration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: servicetoken.FieldSecretGeneration,
+		})
+	}
+	if value, ok := stuo.mutation.AddedSecretGeneration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: servicetoken.FieldSecretGeneration,
+		})
+	}
 	if stuo.mutation.TokenToServiceAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
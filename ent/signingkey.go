@@ -0,0 +1,164 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/signingkey"
+	"github.com/google/uuid"
+)
+
+// SigningKey is the model entity for the SigningKey schema.
+type SigningKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	// [REQUIRED] The JWT signing algorithm the key is used with.
+	Algorithm signingkey.Algorithm `json:"algorithm,omitempty"`
+	// PrivateKey holds the value of the "private_key" field.
+	// [REQUIRED] The PEM encoded PKCS #8 private key.
+	PrivateKey string `json:"-"`
+	// PublicKey holds the value of the "public_key" field.
+	// [REQUIRED] The PEM encoded PKIX public key.
+	PublicKey string `json:"public_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	// [REQUIRED] (default is now) When the key was generated.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RetiredAt holds the value of the "retired_at" field.
+	// [OPTIONAL] When the key stopped signing new tokens. The newest key which isn't retired signs tokens.
+	RetiredAt *time.Time `json:"retired_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	// [OPTIONAL] Tokens signed by the key are rejected after this time. Set when the key is retired, so the tokens it signed keep working until they expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningKey) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldAlgorithm, signingkey.FieldPrivateKey, signingkey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case signingkey.FieldCreatedAt, signingkey.FieldRetiredAt, signingkey.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case signingkey.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SigningKey", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningKey fields.
+func (sk *SigningKey) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sk.ID = *value
+			}
+		case signingkey.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				sk.Algorithm = signingkey.Algorithm(value.String)
+			}
+		case signingkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				sk.PrivateKey = value.String
+			}
+		case signingkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				sk.PublicKey = value.String
+			}
+		case signingkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		case signingkey.FieldRetiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_at", values[i])
+			} else if value.Valid {
+				sk.RetiredAt = new(time.Time)
+				*sk.RetiredAt = value.Time
+			}
+		case signingkey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sk.ExpiresAt = new(time.Time)
+				*sk.ExpiresAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SigningKey.
+// Note that you need to call SigningKey.Unwrap() before calling this method if this SigningKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SigningKey) Update() *SigningKeyUpdateOne {
+	return (&SigningKeyClient{config: sk.config}).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SigningKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SigningKey) Unwrap() *SigningKey {
+	tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningKey is not a transactional entity")
+	}
+	sk.config.driver = tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SigningKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", sk.ID))
+	builder.WriteString(", algorithm=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Algorithm))
+	builder.WriteString(", private_key=<sensitive>")
+	builder.WriteString(", public_key=")
+	builder.WriteString(sk.PublicKey)
+	builder.WriteString(", created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	if v := sk.RetiredAt; v != nil {
+		builder.WriteString(", retired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := sk.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningKeys is a parsable slice of SigningKey.
+type SigningKeys []*SigningKey
+
+func (sk SigningKeys) config(cfg config) {
+	for _i := range sk {
+		sk[_i].config = cfg
+	}
+}
@@ -0,0 +1,102 @@
+// Code generated by entc, DO NOT EDIT.
+
+package signingkey
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the signingkey type in the database.
+	Label = "signing_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldPrivateKey holds the string denoting the private_key field in the database.
+	FieldPrivateKey = "private_key"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRetiredAt holds the string denoting the retired_at field in the database.
+	FieldRetiredAt = "retired_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the signingkey in the database.
+	Table = "signing_keys"
+)
+
+// Columns holds all SQL columns for signingkey fields.
+var Columns = []string{
+	FieldID,
+	FieldAlgorithm,
+	FieldPrivateKey,
+	FieldPublicKey,
+	FieldCreatedAt,
+	FieldRetiredAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Algorithm defines the type for the "algorithm" enum field.
+type Algorithm string
+
+// Algorithm values.
+const (
+	AlgorithmRS256 Algorithm = "RS256"
+	AlgorithmEdDSA Algorithm = "EdDSA"
+)
+
+func (a Algorithm) String() string {
+	return string(a)
+}
+
+// AlgorithmValidator is a validator for the "algorithm" field enum values. It is called by the builders before save.
+func AlgorithmValidator(a Algorithm) error {
+	switch a {
+	case AlgorithmRS256, AlgorithmEdDSA:
+		return nil
+	default:
+		return fmt.Errorf("signingkey: invalid enum value for algorithm field: %q", a)
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (a Algorithm) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(a.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (a *Algorithm) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*a = Algorithm(str)
+	if err := AlgorithmValidator(*a); err != nil {
+		return fmt.Errorf("%s is not a valid Algorithm", str)
+	}
+	return nil
+}
@@ -0,0 +1,363 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/signingkey"
+	"github.com/google/uuid"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (skc *SigningKeyCreate) SetAlgorithm(s signingkey.Algorithm) *SigningKeyCreate {
+	skc.mutation.SetAlgorithm(s)
+	return skc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skc *SigningKeyCreate) SetPrivateKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPrivateKey(s)
+	return skc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skc *SigningKeyCreate) SetPublicKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPublicKey(s)
+	return skc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *SigningKeyCreate) SetCreatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableCreatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (skc *SigningKeyCreate) SetRetiredAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetRetiredAt(t)
+	return skc
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableRetiredAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetRetiredAt(*t)
+	}
+	return skc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (skc *SigningKeyCreate) SetExpiresAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetExpiresAt(t)
+	return skc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableExpiresAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetExpiresAt(*t)
+	}
+	return skc
+}
+
+// SetID sets the "id" field.
+func (skc *SigningKeyCreate) SetID(u uuid.UUID) *SigningKeyCreate {
+	skc.mutation.SetID(u)
+	return skc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableID(u *uuid.UUID) *SigningKeyCreate {
+	if u != nil {
+		skc.SetID(*u)
+	}
+	return skc
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skc *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (skc *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	var (
+		err  error
+		node *SigningKey
+	)
+	skc.defaults()
+	if len(skc.hooks) == 0 {
+		if err = skc.check(); err != nil {
+			return nil, err
+		}
+		node, err = skc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = skc.check(); err != nil {
+				return nil, err
+			}
+			skc.mutation = mutation
+			if node, err = skc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(skc.hooks) - 1; i >= 0; i-- {
+			if skc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = skc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, skc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SigningKeyCreate) defaults() {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := signingkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := skc.mutation.ID(); !ok {
+		v := signingkey.DefaultID()
+		skc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SigningKeyCreate) check() error {
+	if _, ok := skc.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SigningKey.algorithm"`)}
+	}
+	if v, ok := skc.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "SigningKey.private_key"`)}
+	}
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SigningKey.public_key"`)}
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SigningKey.created_at"`)}
+	}
+	return nil
+}
+
+func (skc *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (skc *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: skc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: signingkey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: signingkey.FieldID,
+			},
+		}
+	)
+	if id, ok := skc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := skc.mutation.Algorithm(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldAlgorithm,
+		})
+		_node.Algorithm = value
+	}
+	if value, ok := skc.mutation.PrivateKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPrivateKey,
+		})
+		_node.PrivateKey = value
+	}
+	if value, ok := skc.mutation.PublicKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPublicKey,
+		})
+		_node.PublicKey = value
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.RetiredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetiredAt,
+		})
+		_node.RetiredAt = &value
+	}
+	if value, ok := skc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	builders []*SigningKeyCreate
+}
+
+// Save creates the SigningKey entities in the database.
+func (skcb *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SigningKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,524 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/signingkey"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (sku *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (sku *SigningKeyUpdate) SetAlgorithm(s signingkey.Algorithm) *SigningKeyUpdate {
+	sku.mutation.SetAlgorithm(s)
+	return sku
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (sku *SigningKeyUpdate) SetPrivateKey(s string) *SigningKeyUpdate {
+	sku.mutation.SetPrivateKey(s)
+	return sku
+}
+
+// SetPublicKey sets the "public_key" field.
+func (sku *SigningKeyUpdate) SetPublicKey(s string) *SigningKeyUpdate {
+	sku.mutation.SetPublicKey(s)
+	return sku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sku *SigningKeyUpdate) SetCreatedAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetCreatedAt(t)
+	return sku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableCreatedAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetCreatedAt(*t)
+	}
+	return sku
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (sku *SigningKeyUpdate) SetRetiredAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetRetiredAt(t)
+	return sku
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableRetiredAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetRetiredAt(*t)
+	}
+	return sku
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (sku *SigningKeyUpdate) ClearRetiredAt() *SigningKeyUpdate {
+	sku.mutation.ClearRetiredAt()
+	return sku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sku *SigningKeyUpdate) SetExpiresAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetExpiresAt(t)
+	return sku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableExpiresAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetExpiresAt(*t)
+	}
+	return sku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sku *SigningKeyUpdate) ClearExpiresAt() *SigningKeyUpdate {
+	sku.mutation.ClearExpiresAt()
+	return sku
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (sku *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return sku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sku.hooks) == 0 {
+		if err = sku.check(); err != nil {
+			return 0, err
+		}
+		affected, err = sku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sku.check(); err != nil {
+				return 0, err
+			}
+			sku.mutation = mutation
+			affected, err = sku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sku.hooks) - 1; i >= 0; i-- {
+			if sku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *SigningKeyUpdate) check() error {
+	if v, ok := sku.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sku *SigningKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   signingkey.Table,
+			Columns: signingkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: signingkey.FieldID,
+			},
+		},
+	}
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.Algorithm(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldAlgorithm,
+		})
+	}
+	if value, ok := sku.mutation.PrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPrivateKey,
+		})
+	}
+	if value, ok := sku.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPublicKey,
+		})
+	}
+	if value, ok := sku.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldCreatedAt,
+		})
+	}
+	if value, ok := sku.mutation.RetiredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetiredAt,
+		})
+	}
+	if sku.mutation.RetiredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldRetiredAt,
+		})
+	}
+	if value, ok := sku.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldExpiresAt,
+		})
+	}
+	if sku.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldExpiresAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (skuo *SigningKeyUpdateOne) SetAlgorithm(s signingkey.Algorithm) *SigningKeyUpdateOne {
+	skuo.mutation.SetAlgorithm(s)
+	return skuo
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skuo *SigningKeyUpdateOne) SetPrivateKey(s string) *SigningKeyUpdateOne {
+	skuo.mutation.SetPrivateKey(s)
+	return skuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skuo *SigningKeyUpdateOne) SetPublicKey(s string) *SigningKeyUpdateOne {
+	skuo.mutation.SetPublicKey(s)
+	return skuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skuo *SigningKeyUpdateOne) SetCreatedAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetCreatedAt(t)
+	return skuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetCreatedAt(*t)
+	}
+	return skuo
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (skuo *SigningKeyUpdateOne) SetRetiredAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetRetiredAt(t)
+	return skuo
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableRetiredAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetRetiredAt(*t)
+	}
+	return skuo
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (skuo *SigningKeyUpdateOne) ClearRetiredAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearRetiredAt()
+	return skuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (skuo *SigningKeyUpdateOne) SetExpiresAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetExpiresAt(t)
+	return skuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetExpiresAt(*t)
+	}
+	return skuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (skuo *SigningKeyUpdateOne) ClearExpiresAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearExpiresAt()
+	return skuo
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skuo *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return skuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (skuo *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	var (
+		err  error
+		node *SigningKey
+	)
+	if len(skuo.hooks) == 0 {
+		if err = skuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = skuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = skuo.check(); err != nil {
+				return nil, err
+			}
+			skuo.mutation = mutation
+			node, err = skuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(skuo.hooks) - 1; i >= 0; i-- {
+			if skuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = skuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, skuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *SigningKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (skuo *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   signingkey.Table,
+			Columns: signingkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: signingkey.FieldID,
+			},
+		},
+	}
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.Algorithm(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldAlgorithm,
+		})
+	}
+	if value, ok := skuo.mutation.PrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPrivateKey,
+		})
+	}
+	if value, ok := skuo.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPublicKey,
+		})
+	}
+	if value, ok := skuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldCreatedAt,
+		})
+	}
+	if value, ok := skuo.mutation.RetiredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetiredAt,
+		})
+	}
+	if skuo.mutation.RetiredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldRetiredAt,
+		})
+	}
+	if value, ok := skuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldExpiresAt,
+		})
+	}
+	if skuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldExpiresAt,
+		})
+	}
+	_node = &SigningKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -23,10 +24,14 @@ type Team struct {
 	// Name holds the value of the "name" field.
 	// [OPTIONAL] The display name for the team.
 	Name string `json:"name,omitempty"`
+	// AllowedCidrs holds the value of the "allowed_cidrs" field.
+	// [OPTIONAL] The networks (in CIDR notation) the team's users can connect from, on top of the competition's allowlist. Empty allows any network.
+	AllowedCidrs []string `json:"allowed_cidrs,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamQuery when eager-loading is set.
-	Edges                            TeamEdges `json:"edges"`
-	competition_competition_to_teams *uuid.UUID
+	Edges                                    TeamEdges `json:"edges"`
+	competition_competition_to_teams         *uuid.UUID
+	service_account_service_account_to_teams *uuid.UUID
 }
 
 // TeamEdges holds the relations/edges for other nodes in the graph.
@@ -37,9 +42,11 @@ type TeamEdges struct {
 	TeamToVmObjects []*VmObject `json:"TeamToVmObjects,omitempty"`
 	// TeamToUsers holds the value of the TeamToUsers edge.
 	TeamToUsers []*User `json:"TeamToUsers,omitempty"`
+	// TeamToMemberships holds the value of the TeamToMemberships edge.
+	TeamToMemberships []*TeamMembership `json:"TeamToMemberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // TeamToCompetitionOrErr returns the TeamToCompetition value or an error if the edge
@@ -74,11 +81,22 @@ func (e TeamEdges) TeamToUsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "TeamToUsers"}
 }
 
+// TeamToMembershipsOrErr returns the TeamToMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) TeamToMembershipsOrErr() ([]*TeamMembership, error) {
+	if e.loadedTypes[3] {
+		return e.TeamToMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "TeamToMemberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Team) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case team.FieldAllowedCidrs:
+			values[i] = new([]byte)
 		case team.FieldTeamNumber:
 			values[i] = new(sql.NullInt64)
 		case team.FieldName:
@@ -87,6 +105,8 @@ func (*Team) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new(uuid.UUID)
 		case team.ForeignKeys[0]: // competition_competition_to_teams
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case team.ForeignKeys[1]: // service_account_service_account_to_teams
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Team", columns[i])
 		}
@@ -120,6 +140,14 @@ func (t *Team) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.Name = value.String
 			}
+		case team.FieldAllowedCidrs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_cidrs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.AllowedCidrs); err != nil {
+					return fmt.Errorf("unmarshal field allowed_cidrs: %w", err)
+				}
+			}
 		case team.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field competition_competition_to_teams", values[i])
@@ -127,6 +155,13 @@ func (t *Team) assignValues(columns []string, values []interface{}) error {
 				t.competition_competition_to_teams = new(uuid.UUID)
 				*t.competition_competition_to_teams = *value.S.(*uuid.UUID)
 			}
+		case team.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account_service_account_to_teams", values[i])
+			} else if value.Valid {
+				t.service_account_service_account_to_teams = new(uuid.UUID)
+				*t.service_account_service_account_to_teams = *value.S.(*uuid.UUID)
+			}
 		}
 	}
 	return nil
@@ -147,6 +182,11 @@ func (t *Team) QueryTeamToUsers() *UserQuery {
 	return (&TeamClient{config: t.config}).QueryTeamToUsers(t)
 }
 
+// QueryTeamToMemberships queries the "TeamToMemberships" edge of the Team entity.
+func (t *Team) QueryTeamToMemberships() *TeamMembershipQuery {
+	return (&TeamClient{config: t.config}).QueryTeamToMemberships(t)
+}
+
 // Update returns a builder for updating this Team.
 // Note that you need to call Team.Unwrap() before calling this method if this Team
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -174,6 +214,8 @@ func (t *Team) String() string {
 	builder.WriteString(fmt.Sprintf("%v", t.TeamNumber))
 	builder.WriteString(", name=")
 	builder.WriteString(t.Name)
+	builder.WriteString(", allowed_cidrs=")
+	builder.WriteString(fmt.Sprintf("%v", t.AllowedCidrs))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package team
 
 import (
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -15,12 +16,16 @@ const (
 	FieldTeamNumber = "team_number"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldAllowedCidrs holds the string denoting the allowed_cidrs field in the database.
+	FieldAllowedCidrs = "allowed_cidrs"
 	// EdgeTeamToCompetition holds the string denoting the teamtocompetition edge name in mutations.
 	EdgeTeamToCompetition = "TeamToCompetition"
 	// EdgeTeamToVmObjects holds the string denoting the teamtovmobjects edge name in mutations.
 	EdgeTeamToVmObjects = "TeamToVmObjects"
 	// EdgeTeamToUsers holds the string denoting the teamtousers edge name in mutations.
 	EdgeTeamToUsers = "TeamToUsers"
+	// EdgeTeamToMemberships holds the string denoting the teamtomemberships edge name in mutations.
+	EdgeTeamToMemberships = "TeamToMemberships"
 	// Table holds the table name of the team in the database.
 	Table = "teams"
 	// TeamToCompetitionTable is the table that holds the TeamToCompetition relation/edge.
@@ -44,6 +49,13 @@ const (
 	TeamToUsersInverseTable = "users"
 	// TeamToUsersColumn is the table column denoting the TeamToUsers relation/edge.
 	TeamToUsersColumn = "team_team_to_users"
+	// TeamToMembershipsTable is the table that holds the TeamToMemberships relation/edge.
+	TeamToMembershipsTable = "team_memberships"
+	// TeamToMembershipsInverseTable is the table name for the TeamMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "teammembership" package.
+	TeamToMembershipsInverseTable = "team_memberships"
+	// TeamToMembershipsColumn is the table column denoting the TeamToMemberships relation/edge.
+	TeamToMembershipsColumn = "team_team_to_memberships"
 )
 
 // Columns holds all SQL columns for team fields.
@@ -51,12 +63,14 @@ var Columns = []string{
 	FieldID,
 	FieldTeamNumber,
 	FieldName,
+	FieldAllowedCidrs,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "teams"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"competition_competition_to_teams",
+	"service_account_service_account_to_teams",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,7 +88,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
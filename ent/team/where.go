@@ -307,6 +307,20 @@ func NameContainsFold(v string) predicate.Team {
 	})
 }
 
+// AllowedCidrsIsNil applies the IsNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsIsNil() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAllowedCidrs)))
+	})
+}
+
+// AllowedCidrsNotNil applies the NotNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsNotNil() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAllowedCidrs)))
+	})
+}
+
 // HasTeamToCompetition applies the HasEdge predicate on the "TeamToCompetition" edge.
 func HasTeamToCompetition() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
@@ -391,6 +405,34 @@ func HasTeamToUsersWith(preds ...predicate.User) predicate.Team {
 	})
 }
 
+// HasTeamToMemberships applies the HasEdge predicate on the "TeamToMemberships" edge.
+func HasTeamToMemberships() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TeamToMembershipsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamToMembershipsTable, TeamToMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamToMembershipsWith applies the HasEdge predicate on the "TeamToMemberships" edge with a given conditions (other predicates).
+func HasTeamToMembershipsWith(preds ...predicate.TeamMembership) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TeamToMembershipsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamToMembershipsTable, TeamToMembershipsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Team) predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
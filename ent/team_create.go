@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
@@ -43,6 +44,12 @@ func (tc *TeamCreate) SetNillableName(s *string) *TeamCreate {
 	return tc
 }
 
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (tc *TeamCreate) SetAllowedCidrs(s []string) *TeamCreate {
+	tc.mutation.SetAllowedCidrs(s)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TeamCreate) SetID(u uuid.UUID) *TeamCreate {
 	tc.mutation.SetID(u)
@@ -98,6 +105,21 @@ func (tc *TeamCreate) AddTeamToUsers(u ...*User) *TeamCreate {
 	return tc.AddTeamToUserIDs(ids...)
 }
 
+// AddTeamToMembershipIDs adds the "TeamToMemberships" edge to the TeamMembership entity by IDs.
+func (tc *TeamCreate) AddTeamToMembershipIDs(ids ...uuid.UUID) *TeamCreate {
+	tc.mutation.AddTeamToMembershipIDs(ids...)
+	return tc
+}
+
+// AddTeamToMemberships adds the "TeamToMemberships" edges to the TeamMembership entity.
+func (tc *TeamCreate) AddTeamToMemberships(t ...*TeamMembership) *TeamCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTeamToMembershipIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (tc *TeamCreate) Mutation() *TeamMutation {
 	return tc.mutation
@@ -109,7 +131,9 @@ func (tc *TeamCreate) Save(ctx context.Context) (*Team, error) {
 		err  error
 		node *Team
 	)
-	tc.defaults()
+	if err := tc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(tc.hooks) == 0 {
 		if err = tc.check(); err != nil {
 			return nil, err
@@ -168,11 +192,15 @@ func (tc *TeamCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (tc *TeamCreate) defaults() {
+func (tc *TeamCreate) defaults() error {
 	if _, ok := tc.mutation.ID(); !ok {
+		if team.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized team.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := team.DefaultID()
 		tc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -235,6 +263,14 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		})
 		_node.Name = value
 	}
+	if value, ok := tc.mutation.AllowedCidrs(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: team.FieldAllowedCidrs,
+		})
+		_node.AllowedCidrs = value
+	}
 	if nodes := tc.mutation.TeamToCompetitionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -293,6 +329,25 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.TeamToMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
@@ -33,6 +34,7 @@ type TeamQuery struct {
 	withTeamToCompetition *CompetitionQuery
 	withTeamToVmObjects   *VmObjectQuery
 	withTeamToUsers       *UserQuery
+	withTeamToMemberships *TeamMembershipQuery
 	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -136,6 +138,28 @@ func (tq *TeamQuery) QueryTeamToUsers() *UserQuery {
 	return query
 }
 
+// QueryTeamToMemberships chains the current query on the "TeamToMemberships" edge.
+func (tq *TeamQuery) QueryTeamToMemberships() *TeamMembershipQuery {
+	query := &TeamMembershipQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(teammembership.Table, teammembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamToMembershipsTable, team.TeamToMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Team entity from the query.
 // Returns a *NotFoundError when no Team was found.
 func (tq *TeamQuery) First(ctx context.Context) (*Team, error) {
@@ -320,6 +344,7 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		withTeamToCompetition: tq.withTeamToCompetition.Clone(),
 		withTeamToVmObjects:   tq.withTeamToVmObjects.Clone(),
 		withTeamToUsers:       tq.withTeamToUsers.Clone(),
+		withTeamToMemberships: tq.withTeamToMemberships.Clone(),
 		// clone intermediate query.
 		sql:    tq.sql.Clone(),
 		path:   tq.path,
@@ -360,6 +385,17 @@ func (tq *TeamQuery) WithTeamToUsers(opts ...func(*UserQuery)) *TeamQuery {
 	return tq
 }
 
+// WithTeamToMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "TeamToMemberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithTeamToMemberships(opts ...func(*TeamMembershipQuery)) *TeamQuery {
+	query := &TeamMembershipQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTeamToMemberships = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -416,6 +452,12 @@ func (tq *TeamQuery) prepareQuery(ctx context.Context) error {
 		}
 		tq.sql = prev
 	}
+	if team.Policy == nil {
+		return errors.New("ent: uninitialized team.Policy (forgotten import ent/runtime?)")
+	}
+	if err := team.Policy.EvalQuery(ctx, tq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -424,10 +466,11 @@ func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 		nodes       = []*Team{}
 		withFKs     = tq.withFKs
 		_spec       = tq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			tq.withTeamToCompetition != nil,
 			tq.withTeamToVmObjects != nil,
 			tq.withTeamToUsers != nil,
+			tq.withTeamToMemberships != nil,
 		}
 	)
 	if tq.withTeamToCompetition != nil {
@@ -543,6 +586,35 @@ func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 		}
 	}
 
+	if query := tq.withTeamToMemberships; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Team)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.TeamToMemberships = []*TeamMembership{}
+		}
+		query.withFKs = true
+		query.Where(predicate.TeamMembership(func(s *sql.Selector) {
+			s.Where(sql.InValues(team.TeamToMembershipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.team_team_to_memberships
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "team_team_to_memberships" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "team_team_to_memberships" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.TeamToMemberships = append(node.Edges.TeamToMemberships, n)
+		}
+	}
+
 	return nodes, nil
 }
 
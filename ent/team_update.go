@@ -13,6 +13,7 @@ import (
 	"github.com/BradHacker/compsole/ent/competition"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
@@ -64,6 +65,18 @@ func (tu *TeamUpdate) ClearName() *TeamUpdate {
 	return tu
 }
 
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (tu *TeamUpdate) SetAllowedCidrs(s []string) *TeamUpdate {
+	tu.mutation.SetAllowedCidrs(s)
+	return tu
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (tu *TeamUpdate) ClearAllowedCidrs() *TeamUpdate {
+	tu.mutation.ClearAllowedCidrs()
+	return tu
+}
+
 // SetTeamToCompetitionID sets the "TeamToCompetition" edge to the Competition entity by ID.
 func (tu *TeamUpdate) SetTeamToCompetitionID(id uuid.UUID) *TeamUpdate {
 	tu.mutation.SetTeamToCompetitionID(id)
@@ -105,6 +118,21 @@ func (tu *TeamUpdate) AddTeamToUsers(u ...*User) *TeamUpdate {
 	return tu.AddTeamToUserIDs(ids...)
 }
 
+// AddTeamToMembershipIDs adds the "TeamToMemberships" edge to the TeamMembership entity by IDs.
+func (tu *TeamUpdate) AddTeamToMembershipIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.AddTeamToMembershipIDs(ids...)
+	return tu
+}
+
+// AddTeamToMemberships adds the "TeamToMemberships" edges to the TeamMembership entity.
+func (tu *TeamUpdate) AddTeamToMemberships(t ...*TeamMembership) *TeamUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTeamToMembershipIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (tu *TeamUpdate) Mutation() *TeamMutation {
 	return tu.mutation
@@ -158,6 +186,27 @@ func (tu *TeamUpdate) RemoveTeamToUsers(u ...*User) *TeamUpdate {
 	return tu.RemoveTeamToUserIDs(ids...)
 }
 
+// ClearTeamToMemberships clears all "TeamToMemberships" edges to the TeamMembership entity.
+func (tu *TeamUpdate) ClearTeamToMemberships() *TeamUpdate {
+	tu.mutation.ClearTeamToMemberships()
+	return tu
+}
+
+// RemoveTeamToMembershipIDs removes the "TeamToMemberships" edge to TeamMembership entities by IDs.
+func (tu *TeamUpdate) RemoveTeamToMembershipIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.RemoveTeamToMembershipIDs(ids...)
+	return tu
+}
+
+// RemoveTeamToMemberships removes "TeamToMemberships" edges to TeamMembership entities.
+func (tu *TeamUpdate) RemoveTeamToMemberships(t ...*TeamMembership) *TeamUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTeamToMembershipIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TeamUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -271,6 +320,19 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: team.FieldName,
 		})
 	}
+	if value, ok := tu.mutation.AllowedCidrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: team.FieldAllowedCidrs,
+		})
+	}
+	if tu.mutation.AllowedCidrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: team.FieldAllowedCidrs,
+		})
+	}
 	if tu.mutation.TeamToCompetitionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -414,6 +476,60 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.TeamToMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTeamToMembershipsIDs(); len(nodes) > 0 && !tu.mutation.TeamToMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TeamToMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{team.Label}
@@ -466,6 +582,18 @@ func (tuo *TeamUpdateOne) ClearName() *TeamUpdateOne {
 	return tuo
 }
 
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (tuo *TeamUpdateOne) SetAllowedCidrs(s []string) *TeamUpdateOne {
+	tuo.mutation.SetAllowedCidrs(s)
+	return tuo
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (tuo *TeamUpdateOne) ClearAllowedCidrs() *TeamUpdateOne {
+	tuo.mutation.ClearAllowedCidrs()
+	return tuo
+}
+
 // SetTeamToCompetitionID sets the "TeamToCompetition" edge to the Competition entity by ID.
 func (tuo *TeamUpdateOne) SetTeamToCompetitionID(id uuid.UUID) *TeamUpdateOne {
 	tuo.mutation.SetTeamToCompetitionID(id)
@@ -507,6 +635,21 @@ func (tuo *TeamUpdateOne) AddTeamToUsers(u ...*User) *TeamUpdateOne {
 	return tuo.AddTeamToUserIDs(ids...)
 }
 
+// AddTeamToMembershipIDs adds the "TeamToMemberships" edge to the TeamMembership entity by IDs.
+func (tuo *TeamUpdateOne) AddTeamToMembershipIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.AddTeamToMembershipIDs(ids...)
+	return tuo
+}
+
+// AddTeamToMemberships adds the "TeamToMemberships" edges to the TeamMembership entity.
+func (tuo *TeamUpdateOne) AddTeamToMemberships(t ...*TeamMembership) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTeamToMembershipIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (tuo *TeamUpdateOne) Mutation() *TeamMutation {
 	return tuo.mutation
@@ -560,6 +703,27 @@ func (tuo *TeamUpdateOne) RemoveTeamToUsers(u ...*User) *TeamUpdateOne {
 	return tuo.RemoveTeamToUserIDs(ids...)
 }
 
+// ClearTeamToMemberships clears all "TeamToMemberships" edges to the TeamMembership entity.
+func (tuo *TeamUpdateOne) ClearTeamToMemberships() *TeamUpdateOne {
+	tuo.mutation.ClearTeamToMemberships()
+	return tuo
+}
+
+// RemoveTeamToMembershipIDs removes the "TeamToMemberships" edge to TeamMembership entities by IDs.
+func (tuo *TeamUpdateOne) RemoveTeamToMembershipIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.RemoveTeamToMembershipIDs(ids...)
+	return tuo
+}
+
+// RemoveTeamToMemberships removes "TeamToMemberships" edges to TeamMembership entities.
+func (tuo *TeamUpdateOne) RemoveTeamToMemberships(t ...*TeamMembership) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTeamToMembershipIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (tuo *TeamUpdateOne) Select(field string, fields ...string) *TeamUpdateOne {
@@ -697,6 +861,19 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 			Column: team.FieldName,
 		})
 	}
+	if value, ok := tuo.mutation.AllowedCidrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: team.FieldAllowedCidrs,
+		})
+	}
+	if tuo.mutation.AllowedCidrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: team.FieldAllowedCidrs,
+		})
+	}
 	if tuo.mutation.TeamToCompetitionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -840,6 +1017,60 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.TeamToMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTeamToMembershipsIDs(); len(nodes) > 0 && !tuo.mutation.TeamToMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TeamToMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamToMembershipsTable,
+			Columns: []string{team.TeamToMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Team{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,175 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// TeamMembership is the model entity for the TeamMembership schema.
+type TeamMembership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	// [REQUIRED] (default is MEMBER) The user's role on the team. Observers can only view the team's VMs.
+	Role teammembership.Role `json:"role,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamMembershipQuery when eager-loading is set.
+	Edges                         TeamMembershipEdges `json:"edges"`
+	team_team_to_memberships      *uuid.UUID
+	user_user_to_team_memberships *uuid.UUID
+}
+
+// TeamMembershipEdges holds the relations/edges for other nodes in the graph.
+type TeamMembershipEdges struct {
+	// TeamMembershipToUser holds the value of the TeamMembershipToUser edge.
+	TeamMembershipToUser *User `json:"TeamMembershipToUser,omitempty"`
+	// TeamMembershipToTeam holds the value of the TeamMembershipToTeam edge.
+	TeamMembershipToTeam *Team `json:"TeamMembershipToTeam,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TeamMembershipToUserOrErr returns the TeamMembershipToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMembershipEdges) TeamMembershipToUserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.TeamMembershipToUser == nil {
+			// The edge TeamMembershipToUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.TeamMembershipToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "TeamMembershipToUser"}
+}
+
+// TeamMembershipToTeamOrErr returns the TeamMembershipToTeam value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMembershipEdges) TeamMembershipToTeamOrErr() (*Team, error) {
+	if e.loadedTypes[1] {
+		if e.TeamMembershipToTeam == nil {
+			// The edge TeamMembershipToTeam was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: team.Label}
+		}
+		return e.TeamMembershipToTeam, nil
+	}
+	return nil, &NotLoadedError{edge: "TeamMembershipToTeam"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamMembership) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teammembership.FieldRole:
+			values[i] = new(sql.NullString)
+		case teammembership.FieldID:
+			values[i] = new(uuid.UUID)
+		case teammembership.ForeignKeys[0]: // team_team_to_memberships
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case teammembership.ForeignKeys[1]: // user_user_to_team_memberships
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TeamMembership", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamMembership fields.
+func (tm *TeamMembership) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teammembership.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tm.ID = *value
+			}
+		case teammembership.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				tm.Role = teammembership.Role(value.String)
+			}
+		case teammembership.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field team_team_to_memberships", values[i])
+			} else if value.Valid {
+				tm.team_team_to_memberships = new(uuid.UUID)
+				*tm.team_team_to_memberships = *value.S.(*uuid.UUID)
+			}
+		case teammembership.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_user_to_team_memberships", values[i])
+			} else if value.Valid {
+				tm.user_user_to_team_memberships = new(uuid.UUID)
+				*tm.user_user_to_team_memberships = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryTeamMembershipToUser queries the "TeamMembershipToUser" edge of the TeamMembership entity.
+func (tm *TeamMembership) QueryTeamMembershipToUser() *UserQuery {
+	return (&TeamMembershipClient{config: tm.config}).QueryTeamMembershipToUser(tm)
+}
+
+// QueryTeamMembershipToTeam queries the "TeamMembershipToTeam" edge of the TeamMembership entity.
+func (tm *TeamMembership) QueryTeamMembershipToTeam() *TeamQuery {
+	return (&TeamMembershipClient{config: tm.config}).QueryTeamMembershipToTeam(tm)
+}
+
+// Update returns a builder for updating this TeamMembership.
+// Note that you need to call TeamMembership.Unwrap() before calling this method if this TeamMembership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tm *TeamMembership) Update() *TeamMembershipUpdateOne {
+	return (&TeamMembershipClient{config: tm.config}).UpdateOne(tm)
+}
+
+// Unwrap unwraps the TeamMembership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tm *TeamMembership) Unwrap() *TeamMembership {
+	tx, ok := tm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamMembership is not a transactional entity")
+	}
+	tm.config.driver = tx.drv
+	return tm
+}
+
+// String implements the fmt.Stringer.
+func (tm *TeamMembership) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamMembership(")
+	builder.WriteString(fmt.Sprintf("id=%v", tm.ID))
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Role))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamMemberships is a parsable slice of TeamMembership.
+type TeamMemberships []*TeamMembership
+
+func (tm TeamMemberships) config(cfg config) {
+	for _i := range tm {
+		tm[_i].config = cfg
+	}
+}
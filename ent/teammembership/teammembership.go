@@ -0,0 +1,126 @@
+// Code generated by entc, DO NOT EDIT.
+
+package teammembership
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the teammembership type in the database.
+	Label = "team_membership"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// EdgeTeamMembershipToUser holds the string denoting the teammembershiptouser edge name in mutations.
+	EdgeTeamMembershipToUser = "TeamMembershipToUser"
+	// EdgeTeamMembershipToTeam holds the string denoting the teammembershiptoteam edge name in mutations.
+	EdgeTeamMembershipToTeam = "TeamMembershipToTeam"
+	// Table holds the table name of the teammembership in the database.
+	Table = "team_memberships"
+	// TeamMembershipToUserTable is the table that holds the TeamMembershipToUser relation/edge.
+	TeamMembershipToUserTable = "team_memberships"
+	// TeamMembershipToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	TeamMembershipToUserInverseTable = "users"
+	// TeamMembershipToUserColumn is the table column denoting the TeamMembershipToUser relation/edge.
+	TeamMembershipToUserColumn = "user_user_to_team_memberships"
+	// TeamMembershipToTeamTable is the table that holds the TeamMembershipToTeam relation/edge.
+	TeamMembershipToTeamTable = "team_memberships"
+	// TeamMembershipToTeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamMembershipToTeamInverseTable = "teams"
+	// TeamMembershipToTeamColumn is the table column denoting the TeamMembershipToTeam relation/edge.
+	TeamMembershipToTeamColumn = "team_team_to_memberships"
+)
+
+// Columns holds all SQL columns for teammembership fields.
+var Columns = []string{
+	FieldID,
+	FieldRole,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "team_memberships"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"team_team_to_memberships",
+	"user_user_to_team_memberships",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleMEMBER is the default value of the Role enum.
+const DefaultRole = RoleMEMBER
+
+// Role values.
+const (
+	RoleMEMBER   Role = "MEMBER"
+	RoleCAPTAIN  Role = "CAPTAIN"
+	RoleOBSERVER Role = "OBSERVER"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleMEMBER, RoleCAPTAIN, RoleOBSERVER:
+		return nil
+	default:
+		return fmt.Errorf("teammembership: invalid enum value for role field: %q", r)
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (r Role) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(r.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (r *Role) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*r = Role(str)
+	if err := RoleValidator(*r); err != nil {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
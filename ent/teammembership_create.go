@@ -0,0 +1,343 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// TeamMembershipCreate is the builder for creating a TeamMembership entity.
+type TeamMembershipCreate struct {
+	config
+	mutation *TeamMembershipMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (tmc *TeamMembershipCreate) SetRole(t teammembership.Role) *TeamMembershipCreate {
+	tmc.mutation.SetRole(t)
+	return tmc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmc *TeamMembershipCreate) SetNillableRole(t *teammembership.Role) *TeamMembershipCreate {
+	if t != nil {
+		tmc.SetRole(*t)
+	}
+	return tmc
+}
+
+// SetID sets the "id" field.
+func (tmc *TeamMembershipCreate) SetID(u uuid.UUID) *TeamMembershipCreate {
+	tmc.mutation.SetID(u)
+	return tmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tmc *TeamMembershipCreate) SetNillableID(u *uuid.UUID) *TeamMembershipCreate {
+	if u != nil {
+		tmc.SetID(*u)
+	}
+	return tmc
+}
+
+// SetTeamMembershipToUserID sets the "TeamMembershipToUser" edge to the User entity by ID.
+func (tmc *TeamMembershipCreate) SetTeamMembershipToUserID(id uuid.UUID) *TeamMembershipCreate {
+	tmc.mutation.SetTeamMembershipToUserID(id)
+	return tmc
+}
+
+// SetTeamMembershipToUser sets the "TeamMembershipToUser" edge to the User entity.
+func (tmc *TeamMembershipCreate) SetTeamMembershipToUser(u *User) *TeamMembershipCreate {
+	return tmc.SetTeamMembershipToUserID(u.ID)
+}
+
+// SetTeamMembershipToTeamID sets the "TeamMembershipToTeam" edge to the Team entity by ID.
+func (tmc *TeamMembershipCreate) SetTeamMembershipToTeamID(id uuid.UUID) *TeamMembershipCreate {
+	tmc.mutation.SetTeamMembershipToTeamID(id)
+	return tmc
+}
+
+// SetTeamMembershipToTeam sets the "TeamMembershipToTeam" edge to the Team entity.
+func (tmc *TeamMembershipCreate) SetTeamMembershipToTeam(t *Team) *TeamMembershipCreate {
+	return tmc.SetTeamMembershipToTeamID(t.ID)
+}
+
+// Mutation returns the TeamMembershipMutation object of the builder.
+func (tmc *TeamMembershipCreate) Mutation() *TeamMembershipMutation {
+	return tmc.mutation
+}
+
+// Save creates the TeamMembership in the database.
+func (tmc *TeamMembershipCreate) Save(ctx context.Context) (*TeamMembership, error) {
+	var (
+		err  error
+		node *TeamMembership
+	)
+	if err := tmc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(tmc.hooks) == 0 {
+		if err = tmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tmc.check(); err != nil {
+				return nil, err
+			}
+			tmc.mutation = mutation
+			if node, err = tmc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tmc.hooks) - 1; i >= 0; i-- {
+			if tmc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tmc *TeamMembershipCreate) SaveX(ctx context.Context) *TeamMembership {
+	v, err := tmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmc *TeamMembershipCreate) Exec(ctx context.Context) error {
+	_, err := tmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmc *TeamMembershipCreate) ExecX(ctx context.Context) {
+	if err := tmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmc *TeamMembershipCreate) defaults() error {
+	if _, ok := tmc.mutation.Role(); !ok {
+		v := teammembership.DefaultRole
+		tmc.mutation.SetRole(v)
+	}
+	if _, ok := tmc.mutation.ID(); !ok {
+		if teammembership.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized teammembership.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := teammembership.DefaultID()
+		tmc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmc *TeamMembershipCreate) check() error {
+	if _, ok := tmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TeamMembership.role"`)}
+	}
+	if v, ok := tmc.mutation.Role(); ok {
+		if err := teammembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := tmc.mutation.TeamMembershipToUserID(); !ok {
+		return &ValidationError{Name: "TeamMembershipToUser", err: errors.New(`ent: missing required edge "TeamMembership.TeamMembershipToUser"`)}
+	}
+	if _, ok := tmc.mutation.TeamMembershipToTeamID(); !ok {
+		return &ValidationError{Name: "TeamMembershipToTeam", err: errors.New(`ent: missing required edge "TeamMembership.TeamMembershipToTeam"`)}
+	}
+	return nil
+}
+
+func (tmc *TeamMembershipCreate) sqlSave(ctx context.Context) (*TeamMembership, error) {
+	_node, _spec := tmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (tmc *TeamMembershipCreate) createSpec() (*TeamMembership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamMembership{config: tmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: teammembership.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: teammembership.FieldID,
+			},
+		}
+	)
+	if id, ok := tmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tmc.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: teammembership.FieldRole,
+		})
+		_node.Role = value
+	}
+	if nodes := tmc.mutation.TeamMembershipToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToUserTable,
+			Columns: []string{teammembership.TeamMembershipToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_user_to_team_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tmc.mutation.TeamMembershipToTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToTeamTable,
+			Columns: []string{teammembership.TeamMembershipToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_team_to_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TeamMembershipCreateBulk is the builder for creating many TeamMembership entities in bulk.
+type TeamMembershipCreateBulk struct {
+	config
+	builders []*TeamMembershipCreate
+}
+
+// Save creates the TeamMembership entities in the database.
+func (tmcb *TeamMembershipCreateBulk) Save(ctx context.Context) ([]*TeamMembership, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tmcb.builders))
+	nodes := make([]*TeamMembership, len(tmcb.builders))
+	mutators := make([]Mutator, len(tmcb.builders))
+	for i := range tmcb.builders {
+		func(i int, root context.Context) {
+			builder := tmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamMembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmcb *TeamMembershipCreateBulk) SaveX(ctx context.Context) []*TeamMembership {
+	v, err := tmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmcb *TeamMembershipCreateBulk) Exec(ctx context.Context) error {
+	_, err := tmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmcb *TeamMembershipCreateBulk) ExecX(ctx context.Context) {
+	if err := tmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
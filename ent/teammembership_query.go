@@ -0,0 +1,1071 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// TeamMembershipQuery is the builder for querying TeamMembership entities.
+type TeamMembershipQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TeamMembership
+	// eager-loading edges.
+	withTeamMembershipToUser *UserQuery
+	withTeamMembershipToTeam *TeamQuery
+	withFKs                  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamMembershipQuery builder.
+func (tmq *TeamMembershipQuery) Where(ps ...predicate.TeamMembership) *TeamMembershipQuery {
+	tmq.predicates = append(tmq.predicates, ps...)
+	return tmq
+}
+
+// Limit adds a limit step to the query.
+func (tmq *TeamMembershipQuery) Limit(limit int) *TeamMembershipQuery {
+	tmq.limit = &limit
+	return tmq
+}
+
+// Offset adds an offset step to the query.
+func (tmq *TeamMembershipQuery) Offset(offset int) *TeamMembershipQuery {
+	tmq.offset = &offset
+	return tmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tmq *TeamMembershipQuery) Unique(unique bool) *TeamMembershipQuery {
+	tmq.unique = &unique
+	return tmq
+}
+
+// Order adds an order step to the query.
+func (tmq *TeamMembershipQuery) Order(o ...OrderFunc) *TeamMembershipQuery {
+	tmq.order = append(tmq.order, o...)
+	return tmq
+}
+
+// QueryTeamMembershipToUser chains the current query on the "TeamMembershipToUser" edge.
+func (tmq *TeamMembershipQuery) QueryTeamMembershipToUser() *UserQuery {
+	query := &UserQuery{config: tmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammembership.Table, teammembership.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammembership.TeamMembershipToUserTable, teammembership.TeamMembershipToUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamMembershipToTeam chains the current query on the "TeamMembershipToTeam" edge.
+func (tmq *TeamMembershipQuery) QueryTeamMembershipToTeam() *TeamQuery {
+	query := &TeamQuery{config: tmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammembership.Table, teammembership.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammembership.TeamMembershipToTeamTable, teammembership.TeamMembershipToTeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TeamMembership entity from the query.
+// Returns a *NotFoundError when no TeamMembership was found.
+func (tmq *TeamMembershipQuery) First(ctx context.Context) (*TeamMembership, error) {
+	nodes, err := tmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teammembership.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) FirstX(ctx context.Context) *TeamMembership {
+	node, err := tmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamMembership ID from the query.
+// Returns a *NotFoundError when no TeamMembership ID was found.
+func (tmq *TeamMembershipQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teammembership.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamMembership entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamMembership entity is found.
+// Returns a *NotFoundError when no TeamMembership entities are found.
+func (tmq *TeamMembershipQuery) Only(ctx context.Context) (*TeamMembership, error) {
+	nodes, err := tmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teammembership.Label}
+	default:
+		return nil, &NotSingularError{teammembership.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) OnlyX(ctx context.Context) *TeamMembership {
+	node, err := tmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamMembership ID in the query.
+// Returns a *NotSingularError when more than one TeamMembership ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tmq *TeamMembershipQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = &NotSingularError{teammembership.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamMemberships.
+func (tmq *TeamMembershipQuery) All(ctx context.Context) ([]*TeamMembership, error) {
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return tmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) AllX(ctx context.Context) []*TeamMembership {
+	nodes, err := tmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamMembership IDs.
+func (tmq *TeamMembershipQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := tmq.Select(teammembership.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tmq *TeamMembershipQuery) Count(ctx context.Context) (int, error) {
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return tmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) CountX(ctx context.Context) int {
+	count, err := tmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tmq *TeamMembershipQuery) Exist(ctx context.Context) (bool, error) {
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return tmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tmq *TeamMembershipQuery) ExistX(ctx context.Context) bool {
+	exist, err := tmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamMembershipQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tmq *TeamMembershipQuery) Clone() *TeamMembershipQuery {
+	if tmq == nil {
+		return nil
+	}
+	return &TeamMembershipQuery{
+		config:                   tmq.config,
+		limit:                    tmq.limit,
+		offset:                   tmq.offset,
+		order:                    append([]OrderFunc{}, tmq.order...),
+		predicates:               append([]predicate.TeamMembership{}, tmq.predicates...),
+		withTeamMembershipToUser: tmq.withTeamMembershipToUser.Clone(),
+		withTeamMembershipToTeam: tmq.withTeamMembershipToTeam.Clone(),
+		// clone intermediate query.
+		sql:    tmq.sql.Clone(),
+		path:   tmq.path,
+		unique: tmq.unique,
+	}
+}
+
+// WithTeamMembershipToUser tells the query-builder to eager-load the nodes that are connected to
+// the "TeamMembershipToUser" edge. The optional arguments are used to configure the query builder of the edge.
+func (tmq *TeamMembershipQuery) WithTeamMembershipToUser(opts ...func(*UserQuery)) *TeamMembershipQuery {
+	query := &UserQuery{config: tmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tmq.withTeamMembershipToUser = query
+	return tmq
+}
+
+// WithTeamMembershipToTeam tells the query-builder to eager-load the nodes that are connected to
+// the "TeamMembershipToTeam" edge. The optional arguments are used to configure the query builder of the edge.
+func (tmq *TeamMembershipQuery) WithTeamMembershipToTeam(opts ...func(*TeamQuery)) *TeamMembershipQuery {
+	query := &TeamQuery{config: tmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tmq.withTeamMembershipToTeam = query
+	return tmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Role teammembership.Role `json:"role,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamMembership.Query().
+//		GroupBy(teammembership.FieldRole).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tmq *TeamMembershipQuery) GroupBy(field string, fields ...string) *TeamMembershipGroupBy {
+	group := &TeamMembershipGroupBy{config: tmq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := tmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tmq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Role teammembership.Role `json:"role,omitempty"`
+//	}
+//
+//	client.TeamMembership.Query().
+//		Select(teammembership.FieldRole).
+//		Scan(ctx, &v)
+func (tmq *TeamMembershipQuery) Select(fields ...string) *TeamMembershipSelect {
+	tmq.fields = append(tmq.fields, fields...)
+	return &TeamMembershipSelect{TeamMembershipQuery: tmq}
+}
+
+func (tmq *TeamMembershipQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range tmq.fields {
+		if !teammembership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tmq.path != nil {
+		prev, err := tmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tmq.sql = prev
+	}
+	if teammembership.Policy == nil {
+		return errors.New("ent: uninitialized teammembership.Policy (forgotten import ent/runtime?)")
+	}
+	if err := teammembership.Policy.EvalQuery(ctx, tmq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tmq *TeamMembershipQuery) sqlAll(ctx context.Context) ([]*TeamMembership, error) {
+	var (
+		nodes       = []*TeamMembership{}
+		withFKs     = tmq.withFKs
+		_spec       = tmq.querySpec()
+		loadedTypes = [2]bool{
+			tmq.withTeamMembershipToUser != nil,
+			tmq.withTeamMembershipToTeam != nil,
+		}
+	)
+	if tmq.withTeamMembershipToUser != nil || tmq.withTeamMembershipToTeam != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, teammembership.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &TeamMembership{config: tmq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := tmq.withTeamMembershipToUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*TeamMembership)
+		for i := range nodes {
+			if nodes[i].user_user_to_team_memberships == nil {
+				continue
+			}
+			fk := *nodes[i].user_user_to_team_memberships
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_team_memberships" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.TeamMembershipToUser = n
+			}
+		}
+	}
+
+	if query := tmq.withTeamMembershipToTeam; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*TeamMembership)
+		for i := range nodes {
+			if nodes[i].team_team_to_memberships == nil {
+				continue
+			}
+			fk := *nodes[i].team_team_to_memberships
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(team.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "team_team_to_memberships" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.TeamMembershipToTeam = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (tmq *TeamMembershipQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tmq.querySpec()
+	_spec.Node.Columns = tmq.fields
+	if len(tmq.fields) > 0 {
+		_spec.Unique = tmq.unique != nil && *tmq.unique
+	}
+	return sqlgraph.CountNodes(ctx, tmq.driver, _spec)
+}
+
+func (tmq *TeamMembershipQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := tmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (tmq *TeamMembershipQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   teammembership.Table,
+			Columns: teammembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: teammembership.FieldID,
+			},
+		},
+		From:   tmq.sql,
+		Unique: true,
+	}
+	if unique := tmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := tmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teammembership.FieldID)
+		for i := range fields {
+			if fields[i] != teammembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tmq *TeamMembershipQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tmq.driver.Dialect())
+	t1 := builder.Table(teammembership.Table)
+	columns := tmq.fields
+	if len(columns) == 0 {
+		columns = teammembership.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tmq.sql != nil {
+		selector = tmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tmq.unique != nil && *tmq.unique {
+		selector.Distinct()
+	}
+	for _, p := range tmq.predicates {
+		p(selector)
+	}
+	for _, p := range tmq.order {
+		p(selector)
+	}
+	if offset := tmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TeamMembershipGroupBy is the group-by builder for TeamMembership entities.
+type TeamMembershipGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tmgb *TeamMembershipGroupBy) Aggregate(fns ...AggregateFunc) *TeamMembershipGroupBy {
+	tmgb.fns = append(tmgb.fns, fns...)
+	return tmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tmgb *TeamMembershipGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tmgb.sql = query
+	return tmgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tmgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tmgb.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tmgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tmgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) StringX(ctx context.Context) string {
+	v, err := tmgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tmgb.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tmgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tmgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) IntX(ctx context.Context) int {
+	v, err := tmgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tmgb.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tmgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tmgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tmgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tmgb.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tmgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tmgb *TeamMembershipGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tmgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tmgb *TeamMembershipGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tmgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tmgb *TeamMembershipGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tmgb.fields {
+		if !teammembership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tmgb *TeamMembershipGroupBy) sqlQuery() *sql.Selector {
+	selector := tmgb.sql.Select()
+	aggregation := make([]string, 0, len(tmgb.fns))
+	for _, fn := range tmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(tmgb.fields)+len(tmgb.fns))
+		for _, f := range tmgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(tmgb.fields...)...)
+}
+
+// TeamMembershipSelect is the builder for selecting fields of TeamMembership entities.
+type TeamMembershipSelect struct {
+	*TeamMembershipQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tms *TeamMembershipSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := tms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	tms.sql = tms.TeamMembershipQuery.sqlQuery(ctx)
+	return tms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tms *TeamMembershipSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := tms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(tms.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := tms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tms *TeamMembershipSelect) StringsX(ctx context.Context) []string {
+	v, err := tms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tms *TeamMembershipSelect) StringX(ctx context.Context) string {
+	v, err := tms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(tms.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := tms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tms *TeamMembershipSelect) IntsX(ctx context.Context) []int {
+	v, err := tms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tms *TeamMembershipSelect) IntX(ctx context.Context) int {
+	v, err := tms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tms.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := tms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tms *TeamMembershipSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := tms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tms *TeamMembershipSelect) Float64X(ctx context.Context) float64 {
+	v, err := tms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(tms.fields) > 1 {
+		return nil, errors.New("ent: TeamMembershipSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := tms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tms *TeamMembershipSelect) BoolsX(ctx context.Context) []bool {
+	v, err := tms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (tms *TeamMembershipSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{teammembership.Label}
+	default:
+		err = fmt.Errorf("ent: TeamMembershipSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tms *TeamMembershipSelect) BoolX(ctx context.Context) bool {
+	v, err := tms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tms *TeamMembershipSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := tms.sql.Query()
+	if err := tms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,536 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/google/uuid"
+)
+
+// TeamMembershipUpdate is the builder for updating TeamMembership entities.
+type TeamMembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMembershipMutation
+}
+
+// Where appends a list predicates to the TeamMembershipUpdate builder.
+func (tmu *TeamMembershipUpdate) Where(ps ...predicate.TeamMembership) *TeamMembershipUpdate {
+	tmu.mutation.Where(ps...)
+	return tmu
+}
+
+// SetRole sets the "role" field.
+func (tmu *TeamMembershipUpdate) SetRole(t teammembership.Role) *TeamMembershipUpdate {
+	tmu.mutation.SetRole(t)
+	return tmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmu *TeamMembershipUpdate) SetNillableRole(t *teammembership.Role) *TeamMembershipUpdate {
+	if t != nil {
+		tmu.SetRole(*t)
+	}
+	return tmu
+}
+
+// SetTeamMembershipToUserID sets the "TeamMembershipToUser" edge to the User entity by ID.
+func (tmu *TeamMembershipUpdate) SetTeamMembershipToUserID(id uuid.UUID) *TeamMembershipUpdate {
+	tmu.mutation.SetTeamMembershipToUserID(id)
+	return tmu
+}
+
+// SetTeamMembershipToUser sets the "TeamMembershipToUser" edge to the User entity.
+func (tmu *TeamMembershipUpdate) SetTeamMembershipToUser(u *User) *TeamMembershipUpdate {
+	return tmu.SetTeamMembershipToUserID(u.ID)
+}
+
+// SetTeamMembershipToTeamID sets the "TeamMembershipToTeam" edge to the Team entity by ID.
+func (tmu *TeamMembershipUpdate) SetTeamMembershipToTeamID(id uuid.UUID) *TeamMembershipUpdate {
+	tmu.mutation.SetTeamMembershipToTeamID(id)
+	return tmu
+}
+
+// SetTeamMembershipToTeam sets the "TeamMembershipToTeam" edge to the Team entity.
+func (tmu *TeamMembershipUpdate) SetTeamMembershipToTeam(t *Team) *TeamMembershipUpdate {
+	return tmu.SetTeamMembershipToTeamID(t.ID)
+}
+
+// Mutation returns the TeamMembershipMutation object of the builder.
+func (tmu *TeamMembershipUpdate) Mutation() *TeamMembershipMutation {
+	return tmu.mutation
+}
+
+// ClearTeamMembershipToUser clears the "TeamMembershipToUser" edge to the User entity.
+func (tmu *TeamMembershipUpdate) ClearTeamMembershipToUser() *TeamMembershipUpdate {
+	tmu.mutation.ClearTeamMembershipToUser()
+	return tmu
+}
+
+// ClearTeamMembershipToTeam clears the "TeamMembershipToTeam" edge to the Team entity.
+func (tmu *TeamMembershipUpdate) ClearTeamMembershipToTeam() *TeamMembershipUpdate {
+	tmu.mutation.ClearTeamMembershipToTeam()
+	return tmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmu *TeamMembershipUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tmu.hooks) == 0 {
+		if err = tmu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tmu.check(); err != nil {
+				return 0, err
+			}
+			tmu.mutation = mutation
+			affected, err = tmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tmu.hooks) - 1; i >= 0; i-- {
+			if tmu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmu *TeamMembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmu *TeamMembershipUpdate) Exec(ctx context.Context) error {
+	_, err := tmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmu *TeamMembershipUpdate) ExecX(ctx context.Context) {
+	if err := tmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmu *TeamMembershipUpdate) check() error {
+	if v, ok := tmu.mutation.Role(); ok {
+		if err := teammembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := tmu.mutation.TeamMembershipToUserID(); tmu.mutation.TeamMembershipToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TeamMembership.TeamMembershipToUser"`)
+	}
+	if _, ok := tmu.mutation.TeamMembershipToTeamID(); tmu.mutation.TeamMembershipToTeamCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TeamMembership.TeamMembershipToTeam"`)
+	}
+	return nil
+}
+
+func (tmu *TeamMembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   teammembership.Table,
+			Columns: teammembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: teammembership.FieldID,
+			},
+		},
+	}
+	if ps := tmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: teammembership.FieldRole,
+		})
+	}
+	if tmu.mutation.TeamMembershipToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToUserTable,
+			Columns: []string{teammembership.TeamMembershipToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.TeamMembershipToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToUserTable,
+			Columns: []string{teammembership.TeamMembershipToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmu.mutation.TeamMembershipToTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToTeamTable,
+			Columns: []string{teammembership.TeamMembershipToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.TeamMembershipToTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToTeamTable,
+			Columns: []string{teammembership.TeamMembershipToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TeamMembershipUpdateOne is the builder for updating a single TeamMembership entity.
+type TeamMembershipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMembershipMutation
+}
+
+// SetRole sets the "role" field.
+func (tmuo *TeamMembershipUpdateOne) SetRole(t teammembership.Role) *TeamMembershipUpdateOne {
+	tmuo.mutation.SetRole(t)
+	return tmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmuo *TeamMembershipUpdateOne) SetNillableRole(t *teammembership.Role) *TeamMembershipUpdateOne {
+	if t != nil {
+		tmuo.SetRole(*t)
+	}
+	return tmuo
+}
+
+// SetTeamMembershipToUserID sets the "TeamMembershipToUser" edge to the User entity by ID.
+func (tmuo *TeamMembershipUpdateOne) SetTeamMembershipToUserID(id uuid.UUID) *TeamMembershipUpdateOne {
+	tmuo.mutation.SetTeamMembershipToUserID(id)
+	return tmuo
+}
+
+// SetTeamMembershipToUser sets the "TeamMembershipToUser" edge to the User entity.
+func (tmuo *TeamMembershipUpdateOne) SetTeamMembershipToUser(u *User) *TeamMembershipUpdateOne {
+	return tmuo.SetTeamMembershipToUserID(u.ID)
+}
+
+// SetTeamMembershipToTeamID sets the "TeamMembershipToTeam" edge to the Team entity by ID.
+func (tmuo *TeamMembershipUpdateOne) SetTeamMembershipToTeamID(id uuid.UUID) *TeamMembershipUpdateOne {
+	tmuo.mutation.SetTeamMembershipToTeamID(id)
+	return tmuo
+}
+
+// SetTeamMembershipToTeam sets the "TeamMembershipToTeam" edge to the Team entity.
+func (tmuo *TeamMembershipUpdateOne) SetTeamMembershipToTeam(t *Team) *TeamMembershipUpdateOne {
+	return tmuo.SetTeamMembershipToTeamID(t.ID)
+}
+
+// Mutation returns the TeamMembershipMutation object of the builder.
+func (tmuo *TeamMembershipUpdateOne) Mutation() *TeamMembershipMutation {
+	return tmuo.mutation
+}
+
+// ClearTeamMembershipToUser clears the "TeamMembershipToUser" edge to the User entity.
+func (tmuo *TeamMembershipUpdateOne) ClearTeamMembershipToUser() *TeamMembershipUpdateOne {
+	tmuo.mutation.ClearTeamMembershipToUser()
+	return tmuo
+}
+
+// ClearTeamMembershipToTeam clears the "TeamMembershipToTeam" edge to the Team entity.
+func (tmuo *TeamMembershipUpdateOne) ClearTeamMembershipToTeam() *TeamMembershipUpdateOne {
+	tmuo.mutation.ClearTeamMembershipToTeam()
+	return tmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmuo *TeamMembershipUpdateOne) Select(field string, fields ...string) *TeamMembershipUpdateOne {
+	tmuo.fields = append([]string{field}, fields...)
+	return tmuo
+}
+
+// Save executes the query and returns the updated TeamMembership entity.
+func (tmuo *TeamMembershipUpdateOne) Save(ctx context.Context) (*TeamMembership, error) {
+	var (
+		err  error
+		node *TeamMembership
+	)
+	if len(tmuo.hooks) == 0 {
+		if err = tmuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = tmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tmuo.check(); err != nil {
+				return nil, err
+			}
+			tmuo.mutation = mutation
+			node, err = tmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tmuo.hooks) - 1; i >= 0; i-- {
+			if tmuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmuo *TeamMembershipUpdateOne) SaveX(ctx context.Context) *TeamMembership {
+	node, err := tmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmuo *TeamMembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmuo *TeamMembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := tmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmuo *TeamMembershipUpdateOne) check() error {
+	if v, ok := tmuo.mutation.Role(); ok {
+		if err := teammembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := tmuo.mutation.TeamMembershipToUserID(); tmuo.mutation.TeamMembershipToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TeamMembership.TeamMembershipToUser"`)
+	}
+	if _, ok := tmuo.mutation.TeamMembershipToTeamID(); tmuo.mutation.TeamMembershipToTeamCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TeamMembership.TeamMembershipToTeam"`)
+	}
+	return nil
+}
+
+func (tmuo *TeamMembershipUpdateOne) sqlSave(ctx context.Context) (_node *TeamMembership, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   teammembership.Table,
+			Columns: teammembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: teammembership.FieldID,
+			},
+		},
+	}
+	id, ok := tmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamMembership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teammembership.FieldID)
+		for _, f := range fields {
+			if !teammembership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teammembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: teammembership.FieldRole,
+		})
+	}
+	if tmuo.mutation.TeamMembershipToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToUserTable,
+			Columns: []string{teammembership.TeamMembershipToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.TeamMembershipToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToUserTable,
+			Columns: []string{teammembership.TeamMembershipToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmuo.mutation.TeamMembershipToTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToTeamTable,
+			Columns: []string{teammembership.TeamMembershipToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.TeamMembershipToTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammembership.TeamMembershipToTeamTable,
+			Columns: []string{teammembership.TeamMembershipToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TeamMembership{config: tmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/BradHacker/compsole/ent/token"
@@ -19,23 +20,41 @@ type Token struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Token holds the value of the "token" field.
 	// [REQUIRED] The auth-token cookie value for the user session.
-	Token string `json:"token,omitempty"`
+	Token string `json:"-"`
 	// ExpireAt holds the value of the "expire_at" field.
 	// [REQUIRED] The time the token should expire.
 	ExpireAt int64 `json:"expire_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	// [REQUIRED] (default is now) When the user signed in.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	// [OPTIONAL] When the session was last used. Only updated about once a minute.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	// [OPTIONAL] The IP address the user signed in from.
+	IPAddress string `json:"ip_address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	// [OPTIONAL] The user agent of the browser the user signed in with.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Method holds the value of the "method" field.
+	// [OPTIONAL] How the user signed in (eg. "local and totp").
+	Method string `json:"method,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenQuery when eager-loading is set.
-	Edges              TokenEdges `json:"edges"`
-	user_user_to_token *uuid.UUID
+	Edges                            TokenEdges `json:"edges"`
+	token_token_to_impersonated_user *uuid.UUID
+	user_user_to_token               *uuid.UUID
 }
 
 // TokenEdges holds the relations/edges for other nodes in the graph.
 type TokenEdges struct {
 	// TokenToUser holds the value of the TokenToUser edge.
 	TokenToUser *User `json:"TokenToUser,omitempty"`
+	// TokenToImpersonatedUser holds the value of the TokenToImpersonatedUser edge.
+	TokenToImpersonatedUser *User `json:"TokenToImpersonatedUser,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TokenToUserOrErr returns the TokenToUser value or an error if the edge
@@ -52,6 +71,20 @@ func (e TokenEdges) TokenToUserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "TokenToUser"}
 }
 
+// TokenToImpersonatedUserOrErr returns the TokenToImpersonatedUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TokenEdges) TokenToImpersonatedUserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.TokenToImpersonatedUser == nil {
+			// The edge TokenToImpersonatedUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.TokenToImpersonatedUser, nil
+	}
+	return nil, &NotLoadedError{edge: "TokenToImpersonatedUser"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Token) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -59,11 +92,15 @@ func (*Token) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case token.FieldExpireAt:
 			values[i] = new(sql.NullInt64)
-		case token.FieldToken:
+		case token.FieldToken, token.FieldIPAddress, token.FieldUserAgent, token.FieldMethod:
 			values[i] = new(sql.NullString)
+		case token.FieldCreatedAt, token.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
 		case token.FieldID:
 			values[i] = new(uuid.UUID)
-		case token.ForeignKeys[0]: // user_user_to_token
+		case token.ForeignKeys[0]: // token_token_to_impersonated_user
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case token.ForeignKeys[1]: // user_user_to_token
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Token", columns[i])
@@ -98,7 +135,45 @@ func (t *Token) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.ExpireAt = value.Int64
 			}
+		case token.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case token.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				t.LastUsedAt = new(time.Time)
+				*t.LastUsedAt = value.Time
+			}
+		case token.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				t.IPAddress = value.String
+			}
+		case token.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				t.UserAgent = value.String
+			}
+		case token.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				t.Method = value.String
+			}
 		case token.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field token_token_to_impersonated_user", values[i])
+			} else if value.Valid {
+				t.token_token_to_impersonated_user = new(uuid.UUID)
+				*t.token_token_to_impersonated_user = *value.S.(*uuid.UUID)
+			}
+		case token.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_user_to_token", values[i])
 			} else if value.Valid {
@@ -115,6 +190,11 @@ func (t *Token) QueryTokenToUser() *UserQuery {
 	return (&TokenClient{config: t.config}).QueryTokenToUser(t)
 }
 
+// QueryTokenToImpersonatedUser queries the "TokenToImpersonatedUser" edge of the Token entity.
+func (t *Token) QueryTokenToImpersonatedUser() *UserQuery {
+	return (&TokenClient{config: t.config}).QueryTokenToImpersonatedUser(t)
+}
+
 // Update returns a builder for updating this Token.
 // Note that you need to call Token.Unwrap() before calling this method if this Token
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -138,10 +218,21 @@ func (t *Token) String() string {
 	var builder strings.Builder
 	builder.WriteString("Token(")
 	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
-	builder.WriteString(", token=")
-	builder.WriteString(t.Token)
+	builder.WriteString(", token=<sensitive>")
 	builder.WriteString(", expire_at=")
 	builder.WriteString(fmt.Sprintf("%v", t.ExpireAt))
+	builder.WriteString(", created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	if v := t.LastUsedAt; v != nil {
+		builder.WriteString(", last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ip_address=")
+	builder.WriteString(t.IPAddress)
+	builder.WriteString(", user_agent=")
+	builder.WriteString(t.UserAgent)
+	builder.WriteString(", method=")
+	builder.WriteString(t.Method)
 	builder.WriteByte(')')
 	return builder.String()
 }
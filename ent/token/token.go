@@ -3,6 +3,8 @@
 package token
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -15,8 +17,20 @@ const (
 	FieldToken = "token"
 	// FieldExpireAt holds the string denoting the expire_at field in the database.
 	FieldExpireAt = "expire_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldIPAddress holds the string denoting the ip_address field in the database.
+	FieldIPAddress = "ip_address"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
 	// EdgeTokenToUser holds the string denoting the tokentouser edge name in mutations.
 	EdgeTokenToUser = "TokenToUser"
+	// EdgeTokenToImpersonatedUser holds the string denoting the tokentoimpersonateduser edge name in mutations.
+	EdgeTokenToImpersonatedUser = "TokenToImpersonatedUser"
 	// UserFieldID holds the string denoting the ID field of the User.
 	UserFieldID = "oid"
 	// Table holds the table name of the token in the database.
@@ -28,6 +42,13 @@ const (
 	TokenToUserInverseTable = "users"
 	// TokenToUserColumn is the table column denoting the TokenToUser relation/edge.
 	TokenToUserColumn = "user_user_to_token"
+	// TokenToImpersonatedUserTable is the table that holds the TokenToImpersonatedUser relation/edge.
+	TokenToImpersonatedUserTable = "tokens"
+	// TokenToImpersonatedUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	TokenToImpersonatedUserInverseTable = "users"
+	// TokenToImpersonatedUserColumn is the table column denoting the TokenToImpersonatedUser relation/edge.
+	TokenToImpersonatedUserColumn = "token_token_to_impersonated_user"
 )
 
 // Columns holds all SQL columns for token fields.
@@ -35,11 +56,17 @@ var Columns = []string{
 	FieldID,
 	FieldToken,
 	FieldExpireAt,
+	FieldCreatedAt,
+	FieldLastUsedAt,
+	FieldIPAddress,
+	FieldUserAgent,
+	FieldMethod,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tokens"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"token_token_to_impersonated_user",
 	"user_user_to_token",
 }
 
@@ -59,6 +86,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -33,6 +34,76 @@ func (tc *TokenCreate) SetExpireAt(i int64) *TokenCreate {
 	return tc
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (tc *TokenCreate) SetCreatedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableCreatedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tc *TokenCreate) SetLastUsedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetLastUsedAt(t)
+	return tc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableLastUsedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetLastUsedAt(*t)
+	}
+	return tc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (tc *TokenCreate) SetIPAddress(s string) *TokenCreate {
+	tc.mutation.SetIPAddress(s)
+	return tc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableIPAddress(s *string) *TokenCreate {
+	if s != nil {
+		tc.SetIPAddress(*s)
+	}
+	return tc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (tc *TokenCreate) SetUserAgent(s string) *TokenCreate {
+	tc.mutation.SetUserAgent(s)
+	return tc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableUserAgent(s *string) *TokenCreate {
+	if s != nil {
+		tc.SetUserAgent(*s)
+	}
+	return tc
+}
+
+// SetMethod sets the "method" field.
+func (tc *TokenCreate) SetMethod(s string) *TokenCreate {
+	tc.mutation.SetMethod(s)
+	return tc
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableMethod(s *string) *TokenCreate {
+	if s != nil {
+		tc.SetMethod(*s)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TokenCreate) SetID(u uuid.UUID) *TokenCreate {
 	tc.mutation.SetID(u)
@@ -58,6 +129,25 @@ func (tc *TokenCreate) SetTokenToUser(u *User) *TokenCreate {
 	return tc.SetTokenToUserID(u.ID)
 }
 
+// SetTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID.
+func (tc *TokenCreate) SetTokenToImpersonatedUserID(id uuid.UUID) *TokenCreate {
+	tc.mutation.SetTokenToImpersonatedUserID(id)
+	return tc
+}
+
+// SetNillableTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID if the given value is not nil.
+func (tc *TokenCreate) SetNillableTokenToImpersonatedUserID(id *uuid.UUID) *TokenCreate {
+	if id != nil {
+		tc = tc.SetTokenToImpersonatedUserID(*id)
+	}
+	return tc
+}
+
+// SetTokenToImpersonatedUser sets the "TokenToImpersonatedUser" edge to the User entity.
+func (tc *TokenCreate) SetTokenToImpersonatedUser(u *User) *TokenCreate {
+	return tc.SetTokenToImpersonatedUserID(u.ID)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tc *TokenCreate) Mutation() *TokenMutation {
 	return tc.mutation
@@ -129,6 +219,10 @@ func (tc *TokenCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tc *TokenCreate) defaults() {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := token.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := token.DefaultID()
 		tc.mutation.SetID(v)
@@ -143,6 +237,9 @@ func (tc *TokenCreate) check() error {
 	if _, ok := tc.mutation.ExpireAt(); !ok {
 		return &ValidationError{Name: "expire_at", err: errors.New(`ent: missing required field "Token.expire_at"`)}
 	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Token.created_at"`)}
+	}
 	if _, ok := tc.mutation.TokenToUserID(); !ok {
 		return &ValidationError{Name: "TokenToUser", err: errors.New(`ent: missing required edge "Token.TokenToUser"`)}
 	}
@@ -198,6 +295,46 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		})
 		_node.ExpireAt = value
 	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := tc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := tc.mutation.IPAddress(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldIPAddress,
+		})
+		_node.IPAddress = value
+	}
+	if value, ok := tc.mutation.UserAgent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldUserAgent,
+		})
+		_node.UserAgent = value
+	}
+	if value, ok := tc.mutation.Method(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldMethod,
+		})
+		_node.Method = value
+	}
 	if nodes := tc.mutation.TokenToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -218,6 +355,26 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_node.user_user_to_token = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.TokenToImpersonatedUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   token.TokenToImpersonatedUserTable,
+			Columns: []string{token.TokenToImpersonatedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.token_token_to_impersonated_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
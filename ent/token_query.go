@@ -27,8 +27,9 @@ type TokenQuery struct {
 	fields     []string
 	predicates []predicate.Token
 	// eager-loading edges.
-	withTokenToUser *UserQuery
-	withFKs         bool
+	withTokenToUser             *UserQuery
+	withTokenToImpersonatedUser *UserQuery
+	withFKs                     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +88,28 @@ func (tq *TokenQuery) QueryTokenToUser() *UserQuery {
 	return query
 }
 
+// QueryTokenToImpersonatedUser chains the current query on the "TokenToImpersonatedUser" edge.
+func (tq *TokenQuery) QueryTokenToImpersonatedUser() *UserQuery {
+	query := &UserQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, token.TokenToImpersonatedUserTable, token.TokenToImpersonatedUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Token entity from the query.
 // Returns a *NotFoundError when no Token was found.
 func (tq *TokenQuery) First(ctx context.Context) (*Token, error) {
@@ -263,12 +286,13 @@ func (tq *TokenQuery) Clone() *TokenQuery {
 		return nil
 	}
 	return &TokenQuery{
-		config:          tq.config,
-		limit:           tq.limit,
-		offset:          tq.offset,
-		order:           append([]OrderFunc{}, tq.order...),
-		predicates:      append([]predicate.Token{}, tq.predicates...),
-		withTokenToUser: tq.withTokenToUser.Clone(),
+		config:                      tq.config,
+		limit:                       tq.limit,
+		offset:                      tq.offset,
+		order:                       append([]OrderFunc{}, tq.order...),
+		predicates:                  append([]predicate.Token{}, tq.predicates...),
+		withTokenToUser:             tq.withTokenToUser.Clone(),
+		withTokenToImpersonatedUser: tq.withTokenToImpersonatedUser.Clone(),
 		// clone intermediate query.
 		sql:    tq.sql.Clone(),
 		path:   tq.path,
@@ -287,6 +311,17 @@ func (tq *TokenQuery) WithTokenToUser(opts ...func(*UserQuery)) *TokenQuery {
 	return tq
 }
 
+// WithTokenToImpersonatedUser tells the query-builder to eager-load the nodes that are connected to
+// the "TokenToImpersonatedUser" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TokenQuery) WithTokenToImpersonatedUser(opts ...func(*UserQuery)) *TokenQuery {
+	query := &UserQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTokenToImpersonatedUser = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -351,11 +386,12 @@ func (tq *TokenQuery) sqlAll(ctx context.Context) ([]*Token, error) {
 		nodes       = []*Token{}
 		withFKs     = tq.withFKs
 		_spec       = tq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			tq.withTokenToUser != nil,
+			tq.withTokenToImpersonatedUser != nil,
 		}
 	)
-	if tq.withTokenToUser != nil {
+	if tq.withTokenToUser != nil || tq.withTokenToImpersonatedUser != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -410,6 +446,35 @@ func (tq *TokenQuery) sqlAll(ctx context.Context) ([]*Token, error) {
 		}
 	}
 
+	if query := tq.withTokenToImpersonatedUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*Token)
+		for i := range nodes {
+			if nodes[i].token_token_to_impersonated_user == nil {
+				continue
+			}
+			fk := *nodes[i].token_token_to_impersonated_user
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "token_token_to_impersonated_user" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.TokenToImpersonatedUser = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
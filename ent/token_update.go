@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -48,6 +49,100 @@ func (tu *TokenUpdate) AddExpireAt(i int64) *TokenUpdate {
 	return tu
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (tu *TokenUpdate) SetCreatedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableCreatedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tu *TokenUpdate) SetLastUsedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetLastUsedAt(t)
+	return tu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableLastUsedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetLastUsedAt(*t)
+	}
+	return tu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (tu *TokenUpdate) ClearLastUsedAt() *TokenUpdate {
+	tu.mutation.ClearLastUsedAt()
+	return tu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (tu *TokenUpdate) SetIPAddress(s string) *TokenUpdate {
+	tu.mutation.SetIPAddress(s)
+	return tu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableIPAddress(s *string) *TokenUpdate {
+	if s != nil {
+		tu.SetIPAddress(*s)
+	}
+	return tu
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (tu *TokenUpdate) ClearIPAddress() *TokenUpdate {
+	tu.mutation.ClearIPAddress()
+	return tu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (tu *TokenUpdate) SetUserAgent(s string) *TokenUpdate {
+	tu.mutation.SetUserAgent(s)
+	return tu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableUserAgent(s *string) *TokenUpdate {
+	if s != nil {
+		tu.SetUserAgent(*s)
+	}
+	return tu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (tu *TokenUpdate) ClearUserAgent() *TokenUpdate {
+	tu.mutation.ClearUserAgent()
+	return tu
+}
+
+// SetMethod sets the "method" field.
+func (tu *TokenUpdate) SetMethod(s string) *TokenUpdate {
+	tu.mutation.SetMethod(s)
+	return tu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableMethod(s *string) *TokenUpdate {
+	if s != nil {
+		tu.SetMethod(*s)
+	}
+	return tu
+}
+
+// ClearMethod clears the value of the "method" field.
+func (tu *TokenUpdate) ClearMethod() *TokenUpdate {
+	tu.mutation.ClearMethod()
+	return tu
+}
+
 // SetTokenToUserID sets the "TokenToUser" edge to the User entity by ID.
 func (tu *TokenUpdate) SetTokenToUserID(id uuid.UUID) *TokenUpdate {
 	tu.mutation.SetTokenToUserID(id)
@@ -59,6 +154,25 @@ func (tu *TokenUpdate) SetTokenToUser(u *User) *TokenUpdate {
 	return tu.SetTokenToUserID(u.ID)
 }
 
+// SetTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID.
+func (tu *TokenUpdate) SetTokenToImpersonatedUserID(id uuid.UUID) *TokenUpdate {
+	tu.mutation.SetTokenToImpersonatedUserID(id)
+	return tu
+}
+
+// SetNillableTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID if the given value is not nil.
+func (tu *TokenUpdate) SetNillableTokenToImpersonatedUserID(id *uuid.UUID) *TokenUpdate {
+	if id != nil {
+		tu = tu.SetTokenToImpersonatedUserID(*id)
+	}
+	return tu
+}
+
+// SetTokenToImpersonatedUser sets the "TokenToImpersonatedUser" edge to the User entity.
+func (tu *TokenUpdate) SetTokenToImpersonatedUser(u *User) *TokenUpdate {
+	return tu.SetTokenToImpersonatedUserID(u.ID)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tu *TokenUpdate) Mutation() *TokenMutation {
 	return tu.mutation
@@ -70,6 +184,12 @@ func (tu *TokenUpdate) ClearTokenToUser() *TokenUpdate {
 	return tu
 }
 
+// ClearTokenToImpersonatedUser clears the "TokenToImpersonatedUser" edge to the User entity.
+func (tu *TokenUpdate) ClearTokenToImpersonatedUser() *TokenUpdate {
+	tu.mutation.ClearTokenToImpersonatedUser()
+	return tu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TokenUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -177,6 +297,65 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: token.FieldExpireAt,
 		})
 	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldCreatedAt,
+		})
+	}
+	if value, ok := tu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if tu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if value, ok := tu.mutation.IPAddress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldIPAddress,
+		})
+	}
+	if tu.mutation.IPAddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldIPAddress,
+		})
+	}
+	if value, ok := tu.mutation.UserAgent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldUserAgent,
+		})
+	}
+	if tu.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldUserAgent,
+		})
+	}
+	if value, ok := tu.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldMethod,
+		})
+	}
+	if tu.mutation.MethodCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldMethod,
+		})
+	}
 	if tu.mutation.TokenToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -212,6 +391,41 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.TokenToImpersonatedUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   token.TokenToImpersonatedUserTable,
+			Columns: []string{token.TokenToImpersonatedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TokenToImpersonatedUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   token.TokenToImpersonatedUserTable,
+			Columns: []string{token.TokenToImpersonatedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
@@ -250,6 +464,100 @@ func (tuo *TokenUpdateOne) AddExpireAt(i int64) *TokenUpdateOne {
 	return tuo
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TokenUpdateOne) SetCreatedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableCreatedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tuo *TokenUpdateOne) SetLastUsedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetLastUsedAt(t)
+	return tuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetLastUsedAt(*t)
+	}
+	return tuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (tuo *TokenUpdateOne) ClearLastUsedAt() *TokenUpdateOne {
+	tuo.mutation.ClearLastUsedAt()
+	return tuo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (tuo *TokenUpdateOne) SetIPAddress(s string) *TokenUpdateOne {
+	tuo.mutation.SetIPAddress(s)
+	return tuo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableIPAddress(s *string) *TokenUpdateOne {
+	if s != nil {
+		tuo.SetIPAddress(*s)
+	}
+	return tuo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (tuo *TokenUpdateOne) ClearIPAddress() *TokenUpdateOne {
+	tuo.mutation.ClearIPAddress()
+	return tuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (tuo *TokenUpdateOne) SetUserAgent(s string) *TokenUpdateOne {
+	tuo.mutation.SetUserAgent(s)
+	return tuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableUserAgent(s *string) *TokenUpdateOne {
+	if s != nil {
+		tuo.SetUserAgent(*s)
+	}
+	return tuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (tuo *TokenUpdateOne) ClearUserAgent() *TokenUpdateOne {
+	tuo.mutation.ClearUserAgent()
+	return tuo
+}
+
+// SetMethod sets the "method" field.
+func (tuo *TokenUpdateOne) SetMethod(s string) *TokenUpdateOne {
+	tuo.mutation.SetMethod(s)
+	return tuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableMethod(s *string) *TokenUpdateOne {
+	if s != nil {
+		tuo.SetMethod(*s)
+	}
+	return tuo
+}
+
+// ClearMethod clears the value of the "method" field.
+func (tuo *TokenUpdateOne) ClearMethod() *TokenUpdateOne {
+	tuo.mutation.ClearMethod()
+	return tuo
+}
+
 // SetTokenToUserID sets the "TokenToUser" edge to the User entity by ID.
 func (tuo *TokenUpdateOne) SetTokenToUserID(id uuid.UUID) *TokenUpdateOne {
 	tuo.mutation.SetTokenToUserID(id)
@@ -261,6 +569,25 @@ func (tuo *TokenUpdateOne) SetTokenToUser(u *User) *TokenUpdateOne {
 	return tuo.SetTokenToUserID(u.ID)
 }
 
+// SetTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID.
+func (tuo *TokenUpdateOne) SetTokenToImpersonatedUserID(id uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.SetTokenToImpersonatedUserID(id)
+	return tuo
+}
+
+// SetNillableTokenToImpersonatedUserID sets the "TokenToImpersonatedUser" edge to the User entity by ID if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableTokenToImpersonatedUserID(id *uuid.UUID) *TokenUpdateOne {
+	if id != nil {
+		tuo = tuo.SetTokenToImpersonatedUserID(*id)
+	}
+	return tuo
+}
+
+// SetTokenToImpersonatedUser sets the "TokenToImpersonatedUser" edge to the User entity.
+func (tuo *TokenUpdateOne) SetTokenToImpersonatedUser(u *User) *TokenUpdateOne {
+	return tuo.SetTokenToImpersonatedUserID(u.ID)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tuo *TokenUpdateOne) Mutation() *TokenMutation {
 	return tuo.mutation
@@ -272,6 +599,12 @@ func (tuo *TokenUpdateOne) ClearTokenToUser() *TokenUpdateOne {
 	return tuo
 }
 
+// ClearTokenToImpersonatedUser clears the "TokenToImpersonatedUser" edge to the User entity.
+func (tuo *TokenUpdateOne) ClearTokenToImpersonatedUser() *TokenUpdateOne {
+	tuo.mutation.ClearTokenToImpersonatedUser()
+	return tuo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (tuo *TokenUpdateOne) Select(field string, fields ...string) *TokenUpdateOne {
@@ -403,6 +736,65 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 			Column: token.FieldExpireAt,
 		})
 	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldCreatedAt,
+		})
+	}
+	if value, ok := tuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if tuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if value, ok := tuo.mutation.IPAddress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldIPAddress,
+		})
+	}
+	if tuo.mutation.IPAddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldIPAddress,
+		})
+	}
+	if value, ok := tuo.mutation.UserAgent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldUserAgent,
+		})
+	}
+	if tuo.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldUserAgent,
+		})
+	}
+	if value, ok := tuo.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldMethod,
+		})
+	}
+	if tuo.mutation.MethodCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: token.FieldMethod,
+		})
+	}
 	if tuo.mutation.TokenToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -438,6 +830,41 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.TokenToImpersonatedUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   token.TokenToImpersonatedUserTable,
+			Columns: []string{token.TokenToImpersonatedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TokenToImpersonatedUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   token.TokenToImpersonatedUserTable,
+			Columns: []string{token.TokenToImpersonatedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Token{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -16,20 +16,38 @@ type Tx struct {
 	Action *ActionClient
 	// Competition is the client for interacting with the Competition builders.
 	Competition *CompetitionClient
+	// CompetitionBreak is the client for interacting with the CompetitionBreak builders.
+	CompetitionBreak *CompetitionBreakClient
+	// ConsoleSession is the client for interacting with the ConsoleSession builders.
+	ConsoleSession *ConsoleSessionClient
+	// ConsoleShare is the client for interacting with the ConsoleShare builders.
+	ConsoleShare *ConsoleShareClient
+	// CustomRole is the client for interacting with the CustomRole builders.
+	CustomRole *CustomRoleClient
+	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
+	PersonalAccessToken *PersonalAccessTokenClient
 	// Provider is the client for interacting with the Provider builders.
 	Provider *ProviderClient
 	// ServiceAccount is the client for interacting with the ServiceAccount builders.
 	ServiceAccount *ServiceAccountClient
 	// ServiceToken is the client for interacting with the ServiceToken builders.
 	ServiceToken *ServiceTokenClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
+	// TeamMembership is the client for interacting with the TeamMembership builders.
+	TeamMembership *TeamMembershipClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VmCredential is the client for interacting with the VmCredential builders.
+	VmCredential *VmCredentialClient
 	// VmObject is the client for interacting with the VmObject builders.
 	VmObject *VmObjectClient
+	// WebauthnCredential is the client for interacting with the WebauthnCredential builders.
+	WebauthnCredential *WebauthnCredentialClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,13 +185,22 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Action = NewActionClient(tx.config)
 	tx.Competition = NewCompetitionClient(tx.config)
+	tx.CompetitionBreak = NewCompetitionBreakClient(tx.config)
+	tx.ConsoleSession = NewConsoleSessionClient(tx.config)
+	tx.ConsoleShare = NewConsoleShareClient(tx.config)
+	tx.CustomRole = NewCustomRoleClient(tx.config)
+	tx.PersonalAccessToken = NewPersonalAccessTokenClient(tx.config)
 	tx.Provider = NewProviderClient(tx.config)
 	tx.ServiceAccount = NewServiceAccountClient(tx.config)
 	tx.ServiceToken = NewServiceTokenClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
 	tx.Team = NewTeamClient(tx.config)
+	tx.TeamMembership = NewTeamMembershipClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.VmCredential = NewVmCredentialClient(tx.config)
 	tx.VmObject = NewVmObjectClient(tx.config)
+	tx.WebauthnCredential = NewWebauthnCredentialClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
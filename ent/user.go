@@ -3,10 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/customrole"
 	"github.com/BradHacker/compsole/ent/team"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/google/uuid"
@@ -30,28 +32,64 @@ type User struct {
 	// [OPTIONAL] The display last name for the user
 	LastName string `json:"last_name,omitempty"`
 	// Role holds the value of the "role" field.
-	// [REQUIRED] The role of the user. Admins have full access.
+	// [REQUIRED] The built-in role of the user. Admins have full access. See compsole/permissions for what each role can do.
 	Role user.Role `json:"role,omitempty"`
 	// Provider holds the value of the "provider" field.
 	// [REQUIRED] The type of login the user will be using.
 	Provider user.Provider `json:"provider,omitempty"`
+	// ExternalIssuer holds the value of the "external_issuer" field.
+	// [OPTIONAL] Where the user's external account comes from (eg. the GitLab url or the OIDC issuer url).
+	ExternalIssuer string `json:"external_issuer,omitempty"`
+	// ExternalSubject holds the value of the "external_subject" field.
+	// [OPTIONAL] The stable id of the user's external account at the issuer (eg. the OIDC sub claim). External logins are matched on the issuer and subject, never the username, which providers let users change.
+	ExternalSubject string `json:"external_subject,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	// [OPTIONAL] The TOTP secret for multi-factor authentication. Set during enrollment.
+	TotpSecret string `json:"-"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	// [OPTIONAL] (default is false) Whether the user must enter a TOTP code after their password.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// TotpLastCounter holds the value of the "totp_last_counter" field.
+	// [INTERNAL] The time step of the last accepted TOTP code, so codes can't be replayed.
+	TotpLastCounter int64 `json:"totp_last_counter,omitempty"`
+	// TotpRecoveryCodes holds the value of the "totp_recovery_codes" field.
+	// [OPTIONAL] The hashes of the unused recovery codes.
+	TotpRecoveryCodes []string `json:"-"`
+	// MustChangePassword holds the value of the "must_change_password" field.
+	// [OPTIONAL] (default is false) Whether the user must change their password before they can do anything else.
+	MustChangePassword bool `json:"must_change_password,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges              UserEdges `json:"edges"`
-	team_team_to_users *uuid.UUID
+	Edges                            UserEdges `json:"edges"`
+	custom_role_custom_role_to_users *uuid.UUID
+	team_team_to_users               *uuid.UUID
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
 	// UserToTeam holds the value of the UserToTeam edge.
 	UserToTeam *Team `json:"UserToTeam,omitempty"`
+	// UserToTeamMemberships holds the value of the UserToTeamMemberships edge.
+	UserToTeamMemberships []*TeamMembership `json:"UserToTeamMemberships,omitempty"`
+	// UserToCustomRole holds the value of the UserToCustomRole edge.
+	UserToCustomRole *CustomRole `json:"UserToCustomRole,omitempty"`
+	// UserToAdminCompetitions holds the value of the UserToAdminCompetitions edge.
+	UserToAdminCompetitions []*Competition `json:"UserToAdminCompetitions,omitempty"`
 	// UserToToken holds the value of the UserToToken edge.
 	UserToToken []*Token `json:"UserToToken,omitempty"`
 	// UserToActions holds the value of the UserToActions edge.
 	UserToActions []*Action `json:"UserToActions,omitempty"`
+	// UserToConsoleSessions holds the value of the UserToConsoleSessions edge.
+	UserToConsoleSessions []*ConsoleSession `json:"UserToConsoleSessions,omitempty"`
+	// UserToConsoleShares holds the value of the UserToConsoleShares edge.
+	UserToConsoleShares []*ConsoleShare `json:"UserToConsoleShares,omitempty"`
+	// UserToWebauthnCredentials holds the value of the UserToWebauthnCredentials edge.
+	UserToWebauthnCredentials []*WebauthnCredential `json:"UserToWebauthnCredentials,omitempty"`
+	// UserToPersonalAccessTokens holds the value of the UserToPersonalAccessTokens edge.
+	UserToPersonalAccessTokens []*PersonalAccessToken `json:"UserToPersonalAccessTokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // UserToTeamOrErr returns the UserToTeam value or an error if the edge
@@ -68,10 +106,42 @@ func (e UserEdges) UserToTeamOrErr() (*Team, error) {
 	return nil, &NotLoadedError{edge: "UserToTeam"}
 }
 
+// UserToTeamMembershipsOrErr returns the UserToTeamMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToTeamMembershipsOrErr() ([]*TeamMembership, error) {
+	if e.loadedTypes[1] {
+		return e.UserToTeamMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToTeamMemberships"}
+}
+
+// UserToCustomRoleOrErr returns the UserToCustomRole value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) UserToCustomRoleOrErr() (*CustomRole, error) {
+	if e.loadedTypes[2] {
+		if e.UserToCustomRole == nil {
+			// The edge UserToCustomRole was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: customrole.Label}
+		}
+		return e.UserToCustomRole, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToCustomRole"}
+}
+
+// UserToAdminCompetitionsOrErr returns the UserToAdminCompetitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToAdminCompetitionsOrErr() ([]*Competition, error) {
+	if e.loadedTypes[3] {
+		return e.UserToAdminCompetitions, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToAdminCompetitions"}
+}
+
 // UserToTokenOrErr returns the UserToToken value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UserToTokenOrErr() ([]*Token, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[4] {
 		return e.UserToToken, nil
 	}
 	return nil, &NotLoadedError{edge: "UserToToken"}
@@ -80,22 +150,66 @@ func (e UserEdges) UserToTokenOrErr() ([]*Token, error) {
 // UserToActionsOrErr returns the UserToActions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UserToActionsOrErr() ([]*Action, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[5] {
 		return e.UserToActions, nil
 	}
 	return nil, &NotLoadedError{edge: "UserToActions"}
 }
 
+// UserToConsoleSessionsOrErr returns the UserToConsoleSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToConsoleSessionsOrErr() ([]*ConsoleSession, error) {
+	if e.loadedTypes[6] {
+		return e.UserToConsoleSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToConsoleSessions"}
+}
+
+// UserToConsoleSharesOrErr returns the UserToConsoleShares value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToConsoleSharesOrErr() ([]*ConsoleShare, error) {
+	if e.loadedTypes[7] {
+		return e.UserToConsoleShares, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToConsoleShares"}
+}
+
+// UserToWebauthnCredentialsOrErr returns the UserToWebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToWebauthnCredentialsOrErr() ([]*WebauthnCredential, error) {
+	if e.loadedTypes[8] {
+		return e.UserToWebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToWebauthnCredentials"}
+}
+
+// UserToPersonalAccessTokensOrErr returns the UserToPersonalAccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserToPersonalAccessTokensOrErr() ([]*PersonalAccessToken, error) {
+	if e.loadedTypes[9] {
+		return e.UserToPersonalAccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "UserToPersonalAccessTokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldUsername, user.FieldPassword, user.FieldFirstName, user.FieldLastName, user.FieldRole, user.FieldProvider:
+		case user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldTotpEnabled, user.FieldMustChangePassword:
+			values[i] = new(sql.NullBool)
+		case user.FieldTotpLastCounter:
+			values[i] = new(sql.NullInt64)
+		case user.FieldUsername, user.FieldPassword, user.FieldFirstName, user.FieldLastName, user.FieldRole, user.FieldProvider, user.FieldExternalIssuer, user.FieldExternalSubject, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
-		case user.ForeignKeys[0]: // team_team_to_users
+		case user.ForeignKeys[0]: // custom_role_custom_role_to_users
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case user.ForeignKeys[1]: // team_team_to_users
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
@@ -154,7 +268,58 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.Provider = user.Provider(value.String)
 			}
+		case user.FieldExternalIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_issuer", values[i])
+			} else if value.Valid {
+				u.ExternalIssuer = value.String
+			}
+		case user.FieldExternalSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_subject", values[i])
+			} else if value.Valid {
+				u.ExternalSubject = value.String
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastCounter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_counter", values[i])
+			} else if value.Valid {
+				u.TotpLastCounter = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
+		case user.FieldMustChangePassword:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field must_change_password", values[i])
+			} else if value.Valid {
+				u.MustChangePassword = value.Bool
+			}
 		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_role_custom_role_to_users", values[i])
+			} else if value.Valid {
+				u.custom_role_custom_role_to_users = new(uuid.UUID)
+				*u.custom_role_custom_role_to_users = *value.S.(*uuid.UUID)
+			}
+		case user.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field team_team_to_users", values[i])
 			} else if value.Valid {
@@ -171,6 +336,21 @@ func (u *User) QueryUserToTeam() *TeamQuery {
 	return (&UserClient{config: u.config}).QueryUserToTeam(u)
 }
 
+// QueryUserToTeamMemberships queries the "UserToTeamMemberships" edge of the User entity.
+func (u *User) QueryUserToTeamMemberships() *TeamMembershipQuery {
+	return (&UserClient{config: u.config}).QueryUserToTeamMemberships(u)
+}
+
+// QueryUserToCustomRole queries the "UserToCustomRole" edge of the User entity.
+func (u *User) QueryUserToCustomRole() *CustomRoleQuery {
+	return (&UserClient{config: u.config}).QueryUserToCustomRole(u)
+}
+
+// QueryUserToAdminCompetitions queries the "UserToAdminCompetitions" edge of the User entity.
+func (u *User) QueryUserToAdminCompetitions() *CompetitionQuery {
+	return (&UserClient{config: u.config}).QueryUserToAdminCompetitions(u)
+}
+
 // QueryUserToToken queries the "UserToToken" edge of the User entity.
 func (u *User) QueryUserToToken() *TokenQuery {
 	return (&UserClient{config: u.config}).QueryUserToToken(u)
@@ -181,6 +361,26 @@ func (u *User) QueryUserToActions() *ActionQuery {
 	return (&UserClient{config: u.config}).QueryUserToActions(u)
 }
 
+// QueryUserToConsoleSessions queries the "UserToConsoleSessions" edge of the User entity.
+func (u *User) QueryUserToConsoleSessions() *ConsoleSessionQuery {
+	return (&UserClient{config: u.config}).QueryUserToConsoleSessions(u)
+}
+
+// QueryUserToConsoleShares queries the "UserToConsoleShares" edge of the User entity.
+func (u *User) QueryUserToConsoleShares() *ConsoleShareQuery {
+	return (&UserClient{config: u.config}).QueryUserToConsoleShares(u)
+}
+
+// QueryUserToWebauthnCredentials queries the "UserToWebauthnCredentials" edge of the User entity.
+func (u *User) QueryUserToWebauthnCredentials() *WebauthnCredentialQuery {
+	return (&UserClient{config: u.config}).QueryUserToWebauthnCredentials(u)
+}
+
+// QueryUserToPersonalAccessTokens queries the "UserToPersonalAccessTokens" edge of the User entity.
+func (u *User) QueryUserToPersonalAccessTokens() *PersonalAccessTokenQuery {
+	return (&UserClient{config: u.config}).QueryUserToPersonalAccessTokens(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -215,6 +415,19 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteString(", provider=")
 	builder.WriteString(fmt.Sprintf("%v", u.Provider))
+	builder.WriteString(", external_issuer=")
+	builder.WriteString(u.ExternalIssuer)
+	builder.WriteString(", external_subject=")
+	builder.WriteString(u.ExternalSubject)
+	builder.WriteString(", totp_secret=<sensitive>")
+	builder.WriteString(", totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", totp_last_counter=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastCounter))
+	builder.WriteString(", totp_recovery_codes=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpRecoveryCodes))
+	builder.WriteString(", must_change_password=")
+	builder.WriteString(fmt.Sprintf("%v", u.MustChangePassword))
 	builder.WriteByte(')')
 	return builder.String()
 }
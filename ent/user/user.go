@@ -7,6 +7,7 @@ import (
 	"io"
 	"strconv"
 
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -27,12 +28,40 @@ const (
 	FieldRole = "role"
 	// FieldProvider holds the string denoting the provider field in the database.
 	FieldProvider = "provider"
+	// FieldExternalIssuer holds the string denoting the external_issuer field in the database.
+	FieldExternalIssuer = "external_issuer"
+	// FieldExternalSubject holds the string denoting the external_subject field in the database.
+	FieldExternalSubject = "external_subject"
+	// FieldTotpSecret holds the string denoting the totp_secret field in the database.
+	FieldTotpSecret = "totp_secret"
+	// FieldTotpEnabled holds the string denoting the totp_enabled field in the database.
+	FieldTotpEnabled = "totp_enabled"
+	// FieldTotpLastCounter holds the string denoting the totp_last_counter field in the database.
+	FieldTotpLastCounter = "totp_last_counter"
+	// FieldTotpRecoveryCodes holds the string denoting the totp_recovery_codes field in the database.
+	FieldTotpRecoveryCodes = "totp_recovery_codes"
+	// FieldMustChangePassword holds the string denoting the must_change_password field in the database.
+	FieldMustChangePassword = "must_change_password"
 	// EdgeUserToTeam holds the string denoting the usertoteam edge name in mutations.
 	EdgeUserToTeam = "UserToTeam"
+	// EdgeUserToTeamMemberships holds the string denoting the usertoteammemberships edge name in mutations.
+	EdgeUserToTeamMemberships = "UserToTeamMemberships"
+	// EdgeUserToCustomRole holds the string denoting the usertocustomrole edge name in mutations.
+	EdgeUserToCustomRole = "UserToCustomRole"
+	// EdgeUserToAdminCompetitions holds the string denoting the usertoadmincompetitions edge name in mutations.
+	EdgeUserToAdminCompetitions = "UserToAdminCompetitions"
 	// EdgeUserToToken holds the string denoting the usertotoken edge name in mutations.
 	EdgeUserToToken = "UserToToken"
 	// EdgeUserToActions holds the string denoting the usertoactions edge name in mutations.
 	EdgeUserToActions = "UserToActions"
+	// EdgeUserToConsoleSessions holds the string denoting the usertoconsolesessions edge name in mutations.
+	EdgeUserToConsoleSessions = "UserToConsoleSessions"
+	// EdgeUserToConsoleShares holds the string denoting the usertoconsoleshares edge name in mutations.
+	EdgeUserToConsoleShares = "UserToConsoleShares"
+	// EdgeUserToWebauthnCredentials holds the string denoting the usertowebauthncredentials edge name in mutations.
+	EdgeUserToWebauthnCredentials = "UserToWebauthnCredentials"
+	// EdgeUserToPersonalAccessTokens holds the string denoting the usertopersonalaccesstokens edge name in mutations.
+	EdgeUserToPersonalAccessTokens = "UserToPersonalAccessTokens"
 	// TokenFieldID holds the string denoting the ID field of the Token.
 	TokenFieldID = "id"
 	// Table holds the table name of the user in the database.
@@ -44,6 +73,25 @@ const (
 	UserToTeamInverseTable = "teams"
 	// UserToTeamColumn is the table column denoting the UserToTeam relation/edge.
 	UserToTeamColumn = "team_team_to_users"
+	// UserToTeamMembershipsTable is the table that holds the UserToTeamMemberships relation/edge.
+	UserToTeamMembershipsTable = "team_memberships"
+	// UserToTeamMembershipsInverseTable is the table name for the TeamMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "teammembership" package.
+	UserToTeamMembershipsInverseTable = "team_memberships"
+	// UserToTeamMembershipsColumn is the table column denoting the UserToTeamMemberships relation/edge.
+	UserToTeamMembershipsColumn = "user_user_to_team_memberships"
+	// UserToCustomRoleTable is the table that holds the UserToCustomRole relation/edge.
+	UserToCustomRoleTable = "users"
+	// UserToCustomRoleInverseTable is the table name for the CustomRole entity.
+	// It exists in this package in order to avoid circular dependency with the "customrole" package.
+	UserToCustomRoleInverseTable = "custom_roles"
+	// UserToCustomRoleColumn is the table column denoting the UserToCustomRole relation/edge.
+	UserToCustomRoleColumn = "custom_role_custom_role_to_users"
+	// UserToAdminCompetitionsTable is the table that holds the UserToAdminCompetitions relation/edge. The primary key declared below.
+	UserToAdminCompetitionsTable = "competition_CompetitionToAdmins"
+	// UserToAdminCompetitionsInverseTable is the table name for the Competition entity.
+	// It exists in this package in order to avoid circular dependency with the "competition" package.
+	UserToAdminCompetitionsInverseTable = "competitions"
 	// UserToTokenTable is the table that holds the UserToToken relation/edge.
 	UserToTokenTable = "tokens"
 	// UserToTokenInverseTable is the table name for the Token entity.
@@ -58,6 +106,34 @@ const (
 	UserToActionsInverseTable = "actions"
 	// UserToActionsColumn is the table column denoting the UserToActions relation/edge.
 	UserToActionsColumn = "user_user_to_actions"
+	// UserToConsoleSessionsTable is the table that holds the UserToConsoleSessions relation/edge.
+	UserToConsoleSessionsTable = "console_sessions"
+	// UserToConsoleSessionsInverseTable is the table name for the ConsoleSession entity.
+	// It exists in this package in order to avoid circular dependency with the "consolesession" package.
+	UserToConsoleSessionsInverseTable = "console_sessions"
+	// UserToConsoleSessionsColumn is the table column denoting the UserToConsoleSessions relation/edge.
+	UserToConsoleSessionsColumn = "user_user_to_console_sessions"
+	// UserToConsoleSharesTable is the table that holds the UserToConsoleShares relation/edge.
+	UserToConsoleSharesTable = "console_shares"
+	// UserToConsoleSharesInverseTable is the table name for the ConsoleShare entity.
+	// It exists in this package in order to avoid circular dependency with the "consoleshare" package.
+	UserToConsoleSharesInverseTable = "console_shares"
+	// UserToConsoleSharesColumn is the table column denoting the UserToConsoleShares relation/edge.
+	UserToConsoleSharesColumn = "user_user_to_console_shares"
+	// UserToWebauthnCredentialsTable is the table that holds the UserToWebauthnCredentials relation/edge.
+	UserToWebauthnCredentialsTable = "webauthn_credentials"
+	// UserToWebauthnCredentialsInverseTable is the table name for the WebauthnCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "webauthncredential" package.
+	UserToWebauthnCredentialsInverseTable = "webauthn_credentials"
+	// UserToWebauthnCredentialsColumn is the table column denoting the UserToWebauthnCredentials relation/edge.
+	UserToWebauthnCredentialsColumn = "user_user_to_webauthn_credentials"
+	// UserToPersonalAccessTokensTable is the table that holds the UserToPersonalAccessTokens relation/edge.
+	UserToPersonalAccessTokensTable = "personal_access_tokens"
+	// UserToPersonalAccessTokensInverseTable is the table name for the PersonalAccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "personalaccesstoken" package.
+	UserToPersonalAccessTokensInverseTable = "personal_access_tokens"
+	// UserToPersonalAccessTokensColumn is the table column denoting the UserToPersonalAccessTokens relation/edge.
+	UserToPersonalAccessTokensColumn = "user_user_to_personal_access_tokens"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -69,14 +145,28 @@ var Columns = []string{
 	FieldLastName,
 	FieldRole,
 	FieldProvider,
+	FieldExternalIssuer,
+	FieldExternalSubject,
+	FieldTotpSecret,
+	FieldTotpEnabled,
+	FieldTotpLastCounter,
+	FieldTotpRecoveryCodes,
+	FieldMustChangePassword,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"custom_role_custom_role_to_users",
 	"team_team_to_users",
 }
 
+var (
+	// UserToAdminCompetitionsPrimaryKey and UserToAdminCompetitionsColumn2 are the table columns denoting the
+	// primary key for the UserToAdminCompetitions relation (M2M).
+	UserToAdminCompetitionsPrimaryKey = []string{"competition_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -92,11 +182,24 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultFirstName holds the default value on creation for the "first_name" field.
 	DefaultFirstName string
 	// DefaultLastName holds the default value on creation for the "last_name" field.
 	DefaultLastName string
+	// DefaultTotpEnabled holds the default value on creation for the "totp_enabled" field.
+	DefaultTotpEnabled bool
+	// DefaultTotpLastCounter holds the default value on creation for the "totp_last_counter" field.
+	DefaultTotpLastCounter int64
+	// DefaultMustChangePassword holds the default value on creation for the "must_change_password" field.
+	DefaultMustChangePassword bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -106,8 +209,11 @@ type Role string
 
 // Role values.
 const (
-	RoleUSER  Role = "USER"
-	RoleADMIN Role = "ADMIN"
+	RoleUSER       Role = "USER"
+	RoleADMIN      Role = "ADMIN"
+	RoleWHITE_TEAM Role = "WHITE_TEAM"
+	RoleBLACK_TEAM Role = "BLACK_TEAM"
+	RoleRED_TEAM   Role = "RED_TEAM"
 )
 
 func (r Role) String() string {
@@ -117,7 +223,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleUSER, RoleADMIN:
+	case RoleUSER, RoleADMIN, RoleWHITE_TEAM, RoleBLACK_TEAM, RoleRED_TEAM:
 		return nil
 	default:
 		return fmt.Errorf("user: invalid enum value for role field: %q", r)
@@ -131,6 +237,9 @@ type Provider string
 const (
 	ProviderLOCAL  Provider = "LOCAL"
 	ProviderGITLAB Provider = "GITLAB"
+	ProviderOIDC   Provider = "OIDC"
+	ProviderLDAP   Provider = "LDAP"
+	ProviderSAML   Provider = "SAML"
 )
 
 func (pr Provider) String() string {
@@ -140,7 +249,7 @@ func (pr Provider) String() string {
 // ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
 func ProviderValidator(pr Provider) error {
 	switch pr {
-	case ProviderLOCAL, ProviderGITLAB:
+	case ProviderLOCAL, ProviderGITLAB, ProviderOIDC, ProviderLDAP, ProviderSAML:
 		return nil
 	default:
 		return fmt.Errorf("user: invalid enum value for provider field: %q", pr)
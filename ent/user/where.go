@@ -120,6 +120,48 @@ func LastName(v string) predicate.User {
 	})
 }
 
+// ExternalIssuer applies equality check predicate on the "external_issuer" field. It's identical to ExternalIssuerEQ.
+func ExternalIssuer(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalSubject applies equality check predicate on the "external_subject" field. It's identical to ExternalSubjectEQ.
+func ExternalSubject(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalSubject), v))
+	})
+}
+
+// TotpSecret applies equality check predicate on the "totp_secret" field. It's identical to TotpSecretEQ.
+func TotpSecret(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpEnabled applies equality check predicate on the "totp_enabled" field. It's identical to TotpEnabledEQ.
+func TotpEnabled(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpEnabled), v))
+	})
+}
+
+// TotpLastCounter applies equality check predicate on the "totp_last_counter" field. It's identical to TotpLastCounterEQ.
+func TotpLastCounter(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// MustChangePassword applies equality check predicate on the "must_change_password" field. It's identical to MustChangePasswordEQ.
+func MustChangePassword(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMustChangePassword), v))
+	})
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -660,6 +702,499 @@ func ProviderNotIn(vs ...Provider) predicate.User {
 	})
 }
 
+// ExternalIssuerEQ applies the EQ predicate on the "external_issuer" field.
+func ExternalIssuerEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerNEQ applies the NEQ predicate on the "external_issuer" field.
+func ExternalIssuerNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerIn applies the In predicate on the "external_issuer" field.
+func ExternalIssuerIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalIssuer), v...))
+	})
+}
+
+// ExternalIssuerNotIn applies the NotIn predicate on the "external_issuer" field.
+func ExternalIssuerNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalIssuer), v...))
+	})
+}
+
+// ExternalIssuerGT applies the GT predicate on the "external_issuer" field.
+func ExternalIssuerGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerGTE applies the GTE predicate on the "external_issuer" field.
+func ExternalIssuerGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerLT applies the LT predicate on the "external_issuer" field.
+func ExternalIssuerLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerLTE applies the LTE predicate on the "external_issuer" field.
+func ExternalIssuerLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerContains applies the Contains predicate on the "external_issuer" field.
+func ExternalIssuerContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerHasPrefix applies the HasPrefix predicate on the "external_issuer" field.
+func ExternalIssuerHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerHasSuffix applies the HasSuffix predicate on the "external_issuer" field.
+func ExternalIssuerHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerIsNil applies the IsNil predicate on the "external_issuer" field.
+func ExternalIssuerIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExternalIssuer)))
+	})
+}
+
+// ExternalIssuerNotNil applies the NotNil predicate on the "external_issuer" field.
+func ExternalIssuerNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExternalIssuer)))
+	})
+}
+
+// ExternalIssuerEqualFold applies the EqualFold predicate on the "external_issuer" field.
+func ExternalIssuerEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalIssuerContainsFold applies the ContainsFold predicate on the "external_issuer" field.
+func ExternalIssuerContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalIssuer), v))
+	})
+}
+
+// ExternalSubjectEQ applies the EQ predicate on the "external_subject" field.
+func ExternalSubjectEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectNEQ applies the NEQ predicate on the "external_subject" field.
+func ExternalSubjectNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectIn applies the In predicate on the "external_subject" field.
+func ExternalSubjectIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalSubject), v...))
+	})
+}
+
+// ExternalSubjectNotIn applies the NotIn predicate on the "external_subject" field.
+func ExternalSubjectNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalSubject), v...))
+	})
+}
+
+// ExternalSubjectGT applies the GT predicate on the "external_subject" field.
+func ExternalSubjectGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectGTE applies the GTE predicate on the "external_subject" field.
+func ExternalSubjectGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectLT applies the LT predicate on the "external_subject" field.
+func ExternalSubjectLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectLTE applies the LTE predicate on the "external_subject" field.
+func ExternalSubjectLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectContains applies the Contains predicate on the "external_subject" field.
+func ExternalSubjectContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectHasPrefix applies the HasPrefix predicate on the "external_subject" field.
+func ExternalSubjectHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectHasSuffix applies the HasSuffix predicate on the "external_subject" field.
+func ExternalSubjectHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectIsNil applies the IsNil predicate on the "external_subject" field.
+func ExternalSubjectIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExternalSubject)))
+	})
+}
+
+// ExternalSubjectNotNil applies the NotNil predicate on the "external_subject" field.
+func ExternalSubjectNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExternalSubject)))
+	})
+}
+
+// ExternalSubjectEqualFold applies the EqualFold predicate on the "external_subject" field.
+func ExternalSubjectEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalSubject), v))
+	})
+}
+
+// ExternalSubjectContainsFold applies the ContainsFold predicate on the "external_subject" field.
+func ExternalSubjectContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalSubject), v))
+	})
+}
+
+// TotpSecretEQ applies the EQ predicate on the "totp_secret" field.
+func TotpSecretEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretNEQ applies the NEQ predicate on the "totp_secret" field.
+func TotpSecretNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretIn applies the In predicate on the "totp_secret" field.
+func TotpSecretIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotpSecret), v...))
+	})
+}
+
+// TotpSecretNotIn applies the NotIn predicate on the "totp_secret" field.
+func TotpSecretNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotpSecret), v...))
+	})
+}
+
+// TotpSecretGT applies the GT predicate on the "totp_secret" field.
+func TotpSecretGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretGTE applies the GTE predicate on the "totp_secret" field.
+func TotpSecretGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretLT applies the LT predicate on the "totp_secret" field.
+func TotpSecretLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretLTE applies the LTE predicate on the "totp_secret" field.
+func TotpSecretLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretContains applies the Contains predicate on the "totp_secret" field.
+func TotpSecretContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretHasPrefix applies the HasPrefix predicate on the "totp_secret" field.
+func TotpSecretHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretHasSuffix applies the HasSuffix predicate on the "totp_secret" field.
+func TotpSecretHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretIsNil applies the IsNil predicate on the "totp_secret" field.
+func TotpSecretIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTotpSecret)))
+	})
+}
+
+// TotpSecretNotNil applies the NotNil predicate on the "totp_secret" field.
+func TotpSecretNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTotpSecret)))
+	})
+}
+
+// TotpSecretEqualFold applies the EqualFold predicate on the "totp_secret" field.
+func TotpSecretEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpSecretContainsFold applies the ContainsFold predicate on the "totp_secret" field.
+func TotpSecretContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTotpSecret), v))
+	})
+}
+
+// TotpEnabledEQ applies the EQ predicate on the "totp_enabled" field.
+func TotpEnabledEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpEnabled), v))
+	})
+}
+
+// TotpEnabledNEQ applies the NEQ predicate on the "totp_enabled" field.
+func TotpEnabledNEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotpEnabled), v))
+	})
+}
+
+// TotpLastCounterEQ applies the EQ predicate on the "totp_last_counter" field.
+func TotpLastCounterEQ(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpLastCounterNEQ applies the NEQ predicate on the "totp_last_counter" field.
+func TotpLastCounterNEQ(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpLastCounterIn applies the In predicate on the "totp_last_counter" field.
+func TotpLastCounterIn(vs ...int64) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotpLastCounter), v...))
+	})
+}
+
+// TotpLastCounterNotIn applies the NotIn predicate on the "totp_last_counter" field.
+func TotpLastCounterNotIn(vs ...int64) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotpLastCounter), v...))
+	})
+}
+
+// TotpLastCounterGT applies the GT predicate on the "totp_last_counter" field.
+func TotpLastCounterGT(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpLastCounterGTE applies the GTE predicate on the "totp_last_counter" field.
+func TotpLastCounterGTE(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpLastCounterLT applies the LT predicate on the "totp_last_counter" field.
+func TotpLastCounterLT(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpLastCounterLTE applies the LTE predicate on the "totp_last_counter" field.
+func TotpLastCounterLTE(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotpLastCounter), v))
+	})
+}
+
+// TotpRecoveryCodesIsNil applies the IsNil predicate on the "totp_recovery_codes" field.
+func TotpRecoveryCodesIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTotpRecoveryCodes)))
+	})
+}
+
+// TotpRecoveryCodesNotNil applies the NotNil predicate on the "totp_recovery_codes" field.
+func TotpRecoveryCodesNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTotpRecoveryCodes)))
+	})
+}
+
+// MustChangePasswordEQ applies the EQ predicate on the "must_change_password" field.
+func MustChangePasswordEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMustChangePassword), v))
+	})
+}
+
+// MustChangePasswordNEQ applies the NEQ predicate on the "must_change_password" field.
+func MustChangePasswordNEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMustChangePassword), v))
+	})
+}
+
 // HasUserToTeam applies the HasEdge predicate on the "UserToTeam" edge.
 func HasUserToTeam() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -688,6 +1223,90 @@ func HasUserToTeamWith(preds ...predicate.Team) predicate.User {
 	})
 }
 
+// HasUserToTeamMemberships applies the HasEdge predicate on the "UserToTeamMemberships" edge.
+func HasUserToTeamMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToTeamMembershipsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToTeamMembershipsTable, UserToTeamMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToTeamMembershipsWith applies the HasEdge predicate on the "UserToTeamMemberships" edge with a given conditions (other predicates).
+func HasUserToTeamMembershipsWith(preds ...predicate.TeamMembership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToTeamMembershipsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToTeamMembershipsTable, UserToTeamMembershipsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserToCustomRole applies the HasEdge predicate on the "UserToCustomRole" edge.
+func HasUserToCustomRole() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToCustomRoleTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserToCustomRoleTable, UserToCustomRoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToCustomRoleWith applies the HasEdge predicate on the "UserToCustomRole" edge with a given conditions (other predicates).
+func HasUserToCustomRoleWith(preds ...predicate.CustomRole) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToCustomRoleInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserToCustomRoleTable, UserToCustomRoleColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserToAdminCompetitions applies the HasEdge predicate on the "UserToAdminCompetitions" edge.
+func HasUserToAdminCompetitions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToAdminCompetitionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, UserToAdminCompetitionsTable, UserToAdminCompetitionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToAdminCompetitionsWith applies the HasEdge predicate on the "UserToAdminCompetitions" edge with a given conditions (other predicates).
+func HasUserToAdminCompetitionsWith(preds ...predicate.Competition) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToAdminCompetitionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, UserToAdminCompetitionsTable, UserToAdminCompetitionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUserToToken applies the HasEdge predicate on the "UserToToken" edge.
 func HasUserToToken() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -744,6 +1363,118 @@ func HasUserToActionsWith(preds ...predicate.Action) predicate.User {
 	})
 }
 
+// HasUserToConsoleSessions applies the HasEdge predicate on the "UserToConsoleSessions" edge.
+func HasUserToConsoleSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToConsoleSessionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToConsoleSessionsTable, UserToConsoleSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToConsoleSessionsWith applies the HasEdge predicate on the "UserToConsoleSessions" edge with a given conditions (other predicates).
+func HasUserToConsoleSessionsWith(preds ...predicate.ConsoleSession) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToConsoleSessionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToConsoleSessionsTable, UserToConsoleSessionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserToConsoleShares applies the HasEdge predicate on the "UserToConsoleShares" edge.
+func HasUserToConsoleShares() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToConsoleSharesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToConsoleSharesTable, UserToConsoleSharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToConsoleSharesWith applies the HasEdge predicate on the "UserToConsoleShares" edge with a given conditions (other predicates).
+func HasUserToConsoleSharesWith(preds ...predicate.ConsoleShare) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToConsoleSharesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToConsoleSharesTable, UserToConsoleSharesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserToWebauthnCredentials applies the HasEdge predicate on the "UserToWebauthnCredentials" edge.
+func HasUserToWebauthnCredentials() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToWebauthnCredentialsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToWebauthnCredentialsTable, UserToWebauthnCredentialsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToWebauthnCredentialsWith applies the HasEdge predicate on the "UserToWebauthnCredentials" edge with a given conditions (other predicates).
+func HasUserToWebauthnCredentialsWith(preds ...predicate.WebauthnCredential) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToWebauthnCredentialsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToWebauthnCredentialsTable, UserToWebauthnCredentialsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserToPersonalAccessTokens applies the HasEdge predicate on the "UserToPersonalAccessTokens" edge.
+func HasUserToPersonalAccessTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToPersonalAccessTokensTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToPersonalAccessTokensTable, UserToPersonalAccessTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserToPersonalAccessTokensWith applies the HasEdge predicate on the "UserToPersonalAccessTokens" edge with a given conditions (other predicates).
+func HasUserToPersonalAccessTokensWith(preds ...predicate.PersonalAccessToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserToPersonalAccessTokensInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserToPersonalAccessTokensTable, UserToPersonalAccessTokensColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
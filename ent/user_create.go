@@ -10,9 +10,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 )
 
@@ -75,6 +82,96 @@ func (uc *UserCreate) SetProvider(u user.Provider) *UserCreate {
 	return uc
 }
 
+// SetExternalIssuer sets the "external_issuer" field.
+func (uc *UserCreate) SetExternalIssuer(s string) *UserCreate {
+	uc.mutation.SetExternalIssuer(s)
+	return uc
+}
+
+// SetNillableExternalIssuer sets the "external_issuer" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExternalIssuer(s *string) *UserCreate {
+	if s != nil {
+		uc.SetExternalIssuer(*s)
+	}
+	return uc
+}
+
+// SetExternalSubject sets the "external_subject" field.
+func (uc *UserCreate) SetExternalSubject(s string) *UserCreate {
+	uc.mutation.SetExternalSubject(s)
+	return uc
+}
+
+// SetNillableExternalSubject sets the "external_subject" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExternalSubject(s *string) *UserCreate {
+	if s != nil {
+		uc.SetExternalSubject(*s)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uc *UserCreate) SetTotpEnabled(b bool) *UserCreate {
+	uc.mutation.SetTotpEnabled(b)
+	return uc
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTotpEnabled(*b)
+	}
+	return uc
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (uc *UserCreate) SetTotpLastCounter(i int64) *UserCreate {
+	uc.mutation.SetTotpLastCounter(i)
+	return uc
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastCounter(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastCounter(*i)
+	}
+	return uc
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uc *UserCreate) SetTotpRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetTotpRecoveryCodes(s)
+	return uc
+}
+
+// SetMustChangePassword sets the "must_change_password" field.
+func (uc *UserCreate) SetMustChangePassword(b bool) *UserCreate {
+	uc.mutation.SetMustChangePassword(b)
+	return uc
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMustChangePassword(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetMustChangePassword(*b)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -108,6 +205,55 @@ func (uc *UserCreate) SetUserToTeam(t *Team) *UserCreate {
 	return uc.SetUserToTeamID(t.ID)
 }
 
+// AddUserToTeamMembershipIDs adds the "UserToTeamMemberships" edge to the TeamMembership entity by IDs.
+func (uc *UserCreate) AddUserToTeamMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToTeamMembershipIDs(ids...)
+	return uc
+}
+
+// AddUserToTeamMemberships adds the "UserToTeamMemberships" edges to the TeamMembership entity.
+func (uc *UserCreate) AddUserToTeamMemberships(t ...*TeamMembership) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddUserToTeamMembershipIDs(ids...)
+}
+
+// SetUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID.
+func (uc *UserCreate) SetUserToCustomRoleID(id uuid.UUID) *UserCreate {
+	uc.mutation.SetUserToCustomRoleID(id)
+	return uc
+}
+
+// SetNillableUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableUserToCustomRoleID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		uc = uc.SetUserToCustomRoleID(*id)
+	}
+	return uc
+}
+
+// SetUserToCustomRole sets the "UserToCustomRole" edge to the CustomRole entity.
+func (uc *UserCreate) SetUserToCustomRole(c *CustomRole) *UserCreate {
+	return uc.SetUserToCustomRoleID(c.ID)
+}
+
+// AddUserToAdminCompetitionIDs adds the "UserToAdminCompetitions" edge to the Competition entity by IDs.
+func (uc *UserCreate) AddUserToAdminCompetitionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToAdminCompetitionIDs(ids...)
+	return uc
+}
+
+// AddUserToAdminCompetitions adds the "UserToAdminCompetitions" edges to the Competition entity.
+func (uc *UserCreate) AddUserToAdminCompetitions(c ...*Competition) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddUserToAdminCompetitionIDs(ids...)
+}
+
 // AddUserToTokenIDs adds the "UserToToken" edge to the Token entity by IDs.
 func (uc *UserCreate) AddUserToTokenIDs(ids ...uuid.UUID) *UserCreate {
 	uc.mutation.AddUserToTokenIDs(ids...)
@@ -138,6 +284,66 @@ func (uc *UserCreate) AddUserToActions(a ...*Action) *UserCreate {
 	return uc.AddUserToActionIDs(ids...)
 }
 
+// AddUserToConsoleSessionIDs adds the "UserToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (uc *UserCreate) AddUserToConsoleSessionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToConsoleSessionIDs(ids...)
+	return uc
+}
+
+// AddUserToConsoleSessions adds the "UserToConsoleSessions" edges to the ConsoleSession entity.
+func (uc *UserCreate) AddUserToConsoleSessions(c ...*ConsoleSession) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddUserToConsoleSessionIDs(ids...)
+}
+
+// AddUserToConsoleShareIDs adds the "UserToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (uc *UserCreate) AddUserToConsoleShareIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToConsoleShareIDs(ids...)
+	return uc
+}
+
+// AddUserToConsoleShares adds the "UserToConsoleShares" edges to the ConsoleShare entity.
+func (uc *UserCreate) AddUserToConsoleShares(c ...*ConsoleShare) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddUserToConsoleShareIDs(ids...)
+}
+
+// AddUserToWebauthnCredentialIDs adds the "UserToWebauthnCredentials" edge to the WebauthnCredential entity by IDs.
+func (uc *UserCreate) AddUserToWebauthnCredentialIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToWebauthnCredentialIDs(ids...)
+	return uc
+}
+
+// AddUserToWebauthnCredentials adds the "UserToWebauthnCredentials" edges to the WebauthnCredential entity.
+func (uc *UserCreate) AddUserToWebauthnCredentials(w ...*WebauthnCredential) *UserCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uc.AddUserToWebauthnCredentialIDs(ids...)
+}
+
+// AddUserToPersonalAccessTokenIDs adds the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity by IDs.
+func (uc *UserCreate) AddUserToPersonalAccessTokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserToPersonalAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddUserToPersonalAccessTokens adds the "UserToPersonalAccessTokens" edges to the PersonalAccessToken entity.
+func (uc *UserCreate) AddUserToPersonalAccessTokens(p ...*PersonalAccessToken) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddUserToPersonalAccessTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -149,7 +355,9 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 		err  error
 		node *User
 	)
-	uc.defaults()
+	if err := uc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(uc.hooks) == 0 {
 		if err = uc.check(); err != nil {
 			return nil, err
@@ -208,7 +416,7 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uc *UserCreate) defaults() {
+func (uc *UserCreate) defaults() error {
 	if _, ok := uc.mutation.FirstName(); !ok {
 		v := user.DefaultFirstName
 		uc.mutation.SetFirstName(v)
@@ -217,10 +425,26 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultLastName
 		uc.mutation.SetLastName(v)
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		uc.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := uc.mutation.TotpLastCounter(); !ok {
+		v := user.DefaultTotpLastCounter
+		uc.mutation.SetTotpLastCounter(v)
+	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		v := user.DefaultMustChangePassword
+		uc.mutation.SetMustChangePassword(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
+		if user.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -253,6 +477,15 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "User.provider": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
+	if _, ok := uc.mutation.TotpLastCounter(); !ok {
+		return &ValidationError{Name: "totp_last_counter", err: errors.New(`ent: missing required field "User.totp_last_counter"`)}
+	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		return &ValidationError{Name: "must_change_password", err: errors.New(`ent: missing required field "User.must_change_password"`)}
+	}
 	return nil
 }
 
@@ -337,6 +570,62 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Provider = value
 	}
+	if value, ok := uc.mutation.ExternalIssuer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalIssuer,
+		})
+		_node.ExternalIssuer = value
+	}
+	if value, ok := uc.mutation.ExternalSubject(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalSubject,
+		})
+		_node.ExternalSubject = value
+	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldTotpSecret,
+		})
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldTotpEnabled,
+		})
+		_node.TotpEnabled = value
+	}
+	if value, ok := uc.mutation.TotpLastCounter(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldTotpLastCounter,
+		})
+		_node.TotpLastCounter = value
+	}
+	if value, ok := uc.mutation.TotpRecoveryCodes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldTotpRecoveryCodes,
+		})
+		_node.TotpRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.MustChangePassword(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldMustChangePassword,
+		})
+		_node.MustChangePassword = value
+	}
 	if nodes := uc.mutation.UserToTeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -357,6 +646,64 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.team_team_to_users = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.UserToTeamMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UserToCustomRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.UserToCustomRoleTable,
+			Columns: []string{user.UserToCustomRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: customrole.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_role_custom_role_to_users = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UserToAdminCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.UserToTokenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -395,6 +742,82 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.UserToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UserToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UserToWebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UserToPersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
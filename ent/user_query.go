@@ -13,10 +13,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 )
 
@@ -30,10 +37,17 @@ type UserQuery struct {
 	fields     []string
 	predicates []predicate.User
 	// eager-loading edges.
-	withUserToTeam    *TeamQuery
-	withUserToToken   *TokenQuery
-	withUserToActions *ActionQuery
-	withFKs           bool
+	withUserToTeam                 *TeamQuery
+	withUserToTeamMemberships      *TeamMembershipQuery
+	withUserToCustomRole           *CustomRoleQuery
+	withUserToAdminCompetitions    *CompetitionQuery
+	withUserToToken                *TokenQuery
+	withUserToActions              *ActionQuery
+	withUserToConsoleSessions      *ConsoleSessionQuery
+	withUserToConsoleShares        *ConsoleShareQuery
+	withUserToWebauthnCredentials  *WebauthnCredentialQuery
+	withUserToPersonalAccessTokens *PersonalAccessTokenQuery
+	withFKs                        bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -92,6 +106,72 @@ func (uq *UserQuery) QueryUserToTeam() *TeamQuery {
 	return query
 }
 
+// QueryUserToTeamMemberships chains the current query on the "UserToTeamMemberships" edge.
+func (uq *UserQuery) QueryUserToTeamMemberships() *TeamMembershipQuery {
+	query := &TeamMembershipQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(teammembership.Table, teammembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToTeamMembershipsTable, user.UserToTeamMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserToCustomRole chains the current query on the "UserToCustomRole" edge.
+func (uq *UserQuery) QueryUserToCustomRole() *CustomRoleQuery {
+	query := &CustomRoleQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(customrole.Table, customrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.UserToCustomRoleTable, user.UserToCustomRoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserToAdminCompetitions chains the current query on the "UserToAdminCompetitions" edge.
+func (uq *UserQuery) QueryUserToAdminCompetitions() *CompetitionQuery {
+	query := &CompetitionQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(competition.Table, competition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.UserToAdminCompetitionsTable, user.UserToAdminCompetitionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUserToToken chains the current query on the "UserToToken" edge.
 func (uq *UserQuery) QueryUserToToken() *TokenQuery {
 	query := &TokenQuery{config: uq.config}
@@ -136,6 +216,94 @@ func (uq *UserQuery) QueryUserToActions() *ActionQuery {
 	return query
 }
 
+// QueryUserToConsoleSessions chains the current query on the "UserToConsoleSessions" edge.
+func (uq *UserQuery) QueryUserToConsoleSessions() *ConsoleSessionQuery {
+	query := &ConsoleSessionQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(consolesession.Table, consolesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToConsoleSessionsTable, user.UserToConsoleSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserToConsoleShares chains the current query on the "UserToConsoleShares" edge.
+func (uq *UserQuery) QueryUserToConsoleShares() *ConsoleShareQuery {
+	query := &ConsoleShareQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(consoleshare.Table, consoleshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToConsoleSharesTable, user.UserToConsoleSharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserToWebauthnCredentials chains the current query on the "UserToWebauthnCredentials" edge.
+func (uq *UserQuery) QueryUserToWebauthnCredentials() *WebauthnCredentialQuery {
+	query := &WebauthnCredentialQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(webauthncredential.Table, webauthncredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToWebauthnCredentialsTable, user.UserToWebauthnCredentialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserToPersonalAccessTokens chains the current query on the "UserToPersonalAccessTokens" edge.
+func (uq *UserQuery) QueryUserToPersonalAccessTokens() *PersonalAccessTokenQuery {
+	query := &PersonalAccessTokenQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(personalaccesstoken.Table, personalaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserToPersonalAccessTokensTable, user.UserToPersonalAccessTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -312,14 +480,21 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:            uq.config,
-		limit:             uq.limit,
-		offset:            uq.offset,
-		order:             append([]OrderFunc{}, uq.order...),
-		predicates:        append([]predicate.User{}, uq.predicates...),
-		withUserToTeam:    uq.withUserToTeam.Clone(),
-		withUserToToken:   uq.withUserToToken.Clone(),
-		withUserToActions: uq.withUserToActions.Clone(),
+		config:                         uq.config,
+		limit:                          uq.limit,
+		offset:                         uq.offset,
+		order:                          append([]OrderFunc{}, uq.order...),
+		predicates:                     append([]predicate.User{}, uq.predicates...),
+		withUserToTeam:                 uq.withUserToTeam.Clone(),
+		withUserToTeamMemberships:      uq.withUserToTeamMemberships.Clone(),
+		withUserToCustomRole:           uq.withUserToCustomRole.Clone(),
+		withUserToAdminCompetitions:    uq.withUserToAdminCompetitions.Clone(),
+		withUserToToken:                uq.withUserToToken.Clone(),
+		withUserToActions:              uq.withUserToActions.Clone(),
+		withUserToConsoleSessions:      uq.withUserToConsoleSessions.Clone(),
+		withUserToConsoleShares:        uq.withUserToConsoleShares.Clone(),
+		withUserToWebauthnCredentials:  uq.withUserToWebauthnCredentials.Clone(),
+		withUserToPersonalAccessTokens: uq.withUserToPersonalAccessTokens.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
 		path:   uq.path,
@@ -338,6 +513,39 @@ func (uq *UserQuery) WithUserToTeam(opts ...func(*TeamQuery)) *UserQuery {
 	return uq
 }
 
+// WithUserToTeamMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "UserToTeamMemberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToTeamMemberships(opts ...func(*TeamMembershipQuery)) *UserQuery {
+	query := &TeamMembershipQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToTeamMemberships = query
+	return uq
+}
+
+// WithUserToCustomRole tells the query-builder to eager-load the nodes that are connected to
+// the "UserToCustomRole" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToCustomRole(opts ...func(*CustomRoleQuery)) *UserQuery {
+	query := &CustomRoleQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToCustomRole = query
+	return uq
+}
+
+// WithUserToAdminCompetitions tells the query-builder to eager-load the nodes that are connected to
+// the "UserToAdminCompetitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToAdminCompetitions(opts ...func(*CompetitionQuery)) *UserQuery {
+	query := &CompetitionQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToAdminCompetitions = query
+	return uq
+}
+
 // WithUserToToken tells the query-builder to eager-load the nodes that are connected to
 // the "UserToToken" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithUserToToken(opts ...func(*TokenQuery)) *UserQuery {
@@ -360,6 +568,50 @@ func (uq *UserQuery) WithUserToActions(opts ...func(*ActionQuery)) *UserQuery {
 	return uq
 }
 
+// WithUserToConsoleSessions tells the query-builder to eager-load the nodes that are connected to
+// the "UserToConsoleSessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToConsoleSessions(opts ...func(*ConsoleSessionQuery)) *UserQuery {
+	query := &ConsoleSessionQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToConsoleSessions = query
+	return uq
+}
+
+// WithUserToConsoleShares tells the query-builder to eager-load the nodes that are connected to
+// the "UserToConsoleShares" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToConsoleShares(opts ...func(*ConsoleShareQuery)) *UserQuery {
+	query := &ConsoleShareQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToConsoleShares = query
+	return uq
+}
+
+// WithUserToWebauthnCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "UserToWebauthnCredentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToWebauthnCredentials(opts ...func(*WebauthnCredentialQuery)) *UserQuery {
+	query := &WebauthnCredentialQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToWebauthnCredentials = query
+	return uq
+}
+
+// WithUserToPersonalAccessTokens tells the query-builder to eager-load the nodes that are connected to
+// the "UserToPersonalAccessTokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserToPersonalAccessTokens(opts ...func(*PersonalAccessTokenQuery)) *UserQuery {
+	query := &PersonalAccessTokenQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUserToPersonalAccessTokens = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -416,6 +668,12 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 		}
 		uq.sql = prev
 	}
+	if user.Policy == nil {
+		return errors.New("ent: uninitialized user.Policy (forgotten import ent/runtime?)")
+	}
+	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -424,13 +682,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [10]bool{
 			uq.withUserToTeam != nil,
+			uq.withUserToTeamMemberships != nil,
+			uq.withUserToCustomRole != nil,
+			uq.withUserToAdminCompetitions != nil,
 			uq.withUserToToken != nil,
 			uq.withUserToActions != nil,
+			uq.withUserToConsoleSessions != nil,
+			uq.withUserToConsoleShares != nil,
+			uq.withUserToWebauthnCredentials != nil,
+			uq.withUserToPersonalAccessTokens != nil,
 		}
 	)
-	if uq.withUserToTeam != nil {
+	if uq.withUserToTeam != nil || uq.withUserToCustomRole != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -485,6 +750,129 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withUserToTeamMemberships; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UserToTeamMemberships = []*TeamMembership{}
+		}
+		query.withFKs = true
+		query.Where(predicate.TeamMembership(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UserToTeamMembershipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_user_to_team_memberships
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_user_to_team_memberships" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_team_memberships" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UserToTeamMemberships = append(node.Edges.UserToTeamMemberships, n)
+		}
+	}
+
+	if query := uq.withUserToCustomRole; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*User)
+		for i := range nodes {
+			if nodes[i].custom_role_custom_role_to_users == nil {
+				continue
+			}
+			fk := *nodes[i].custom_role_custom_role_to_users
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(customrole.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "custom_role_custom_role_to_users" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.UserToCustomRole = n
+			}
+		}
+	}
+
+	if query := uq.withUserToAdminCompetitions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[uuid.UUID]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.UserToAdminCompetitions = []*Competition{}
+		}
+		var (
+			edgeids []uuid.UUID
+			edges   = make(map[uuid.UUID][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   user.UserToAdminCompetitionsTable,
+				Columns: user.UserToAdminCompetitionsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(user.UserToAdminCompetitionsPrimaryKey[1], fks...))
+			},
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{new(uuid.UUID), new(uuid.UUID)}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*uuid.UUID)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*uuid.UUID)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := *eout
+				inValue := *ein
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "UserToAdminCompetitions": %w`, err)
+		}
+		query.Where(competition.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "UserToAdminCompetitions" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.UserToAdminCompetitions = append(nodes[i].Edges.UserToAdminCompetitions, n)
+			}
+		}
+	}
+
 	if query := uq.withUserToToken; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[uuid.UUID]*User)
@@ -543,6 +931,122 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withUserToConsoleSessions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UserToConsoleSessions = []*ConsoleSession{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ConsoleSession(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UserToConsoleSessionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_user_to_console_sessions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_user_to_console_sessions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_console_sessions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UserToConsoleSessions = append(node.Edges.UserToConsoleSessions, n)
+		}
+	}
+
+	if query := uq.withUserToConsoleShares; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UserToConsoleShares = []*ConsoleShare{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ConsoleShare(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UserToConsoleSharesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_user_to_console_shares
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_user_to_console_shares" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_console_shares" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UserToConsoleShares = append(node.Edges.UserToConsoleShares, n)
+		}
+	}
+
+	if query := uq.withUserToWebauthnCredentials; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UserToWebauthnCredentials = []*WebauthnCredential{}
+		}
+		query.withFKs = true
+		query.Where(predicate.WebauthnCredential(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UserToWebauthnCredentialsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_user_to_webauthn_credentials
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_user_to_webauthn_credentials" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_webauthn_credentials" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UserToWebauthnCredentials = append(node.Edges.UserToWebauthnCredentials, n)
+		}
+	}
+
+	if query := uq.withUserToPersonalAccessTokens; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UserToPersonalAccessTokens = []*PersonalAccessToken{}
+		}
+		query.withFKs = true
+		query.Where(predicate.PersonalAccessToken(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UserToPersonalAccessTokensColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_user_to_personal_access_tokens
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_user_to_personal_access_tokens" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_user_to_personal_access_tokens" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UserToPersonalAccessTokens = append(node.Edges.UserToPersonalAccessTokens, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -11,10 +11,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
 	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/google/uuid"
 )
 
@@ -83,6 +90,127 @@ func (uu *UserUpdate) SetProvider(u user.Provider) *UserUpdate {
 	return uu
 }
 
+// SetExternalIssuer sets the "external_issuer" field.
+func (uu *UserUpdate) SetExternalIssuer(s string) *UserUpdate {
+	uu.mutation.SetExternalIssuer(s)
+	return uu
+}
+
+// SetNillableExternalIssuer sets the "external_issuer" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableExternalIssuer(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetExternalIssuer(*s)
+	}
+	return uu
+}
+
+// ClearExternalIssuer clears the value of the "external_issuer" field.
+func (uu *UserUpdate) ClearExternalIssuer() *UserUpdate {
+	uu.mutation.ClearExternalIssuer()
+	return uu
+}
+
+// SetExternalSubject sets the "external_subject" field.
+func (uu *UserUpdate) SetExternalSubject(s string) *UserUpdate {
+	uu.mutation.SetExternalSubject(s)
+	return uu
+}
+
+// SetNillableExternalSubject sets the "external_subject" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableExternalSubject(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetExternalSubject(*s)
+	}
+	return uu
+}
+
+// ClearExternalSubject clears the value of the "external_subject" field.
+func (uu *UserUpdate) ClearExternalSubject() *UserUpdate {
+	uu.mutation.ClearExternalSubject()
+	return uu
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uu *UserUpdate) SetTotpSecret(s string) *UserUpdate {
+	uu.mutation.SetTotpSecret(s)
+	return uu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpSecret(*s)
+	}
+	return uu
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uu *UserUpdate) ClearTotpSecret() *UserUpdate {
+	uu.mutation.ClearTotpSecret()
+	return uu
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uu *UserUpdate) SetTotpEnabled(b bool) *UserUpdate {
+	uu.mutation.SetTotpEnabled(b)
+	return uu
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpEnabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetTotpEnabled(*b)
+	}
+	return uu
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (uu *UserUpdate) SetTotpLastCounter(i int64) *UserUpdate {
+	uu.mutation.ResetTotpLastCounter()
+	uu.mutation.SetTotpLastCounter(i)
+	return uu
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpLastCounter(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTotpLastCounter(*i)
+	}
+	return uu
+}
+
+// AddTotpLastCounter adds i to the "totp_last_counter" field.
+func (uu *UserUpdate) AddTotpLastCounter(i int64) *UserUpdate {
+	uu.mutation.AddTotpLastCounter(i)
+	return uu
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uu *UserUpdate) SetTotpRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.SetTotpRecoveryCodes(s)
+	return uu
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uu *UserUpdate) ClearTotpRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearTotpRecoveryCodes()
+	return uu
+}
+
+// SetMustChangePassword sets the "must_change_password" field.
+func (uu *UserUpdate) SetMustChangePassword(b bool) *UserUpdate {
+	uu.mutation.SetMustChangePassword(b)
+	return uu
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMustChangePassword(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetMustChangePassword(*b)
+	}
+	return uu
+}
+
 // SetUserToTeamID sets the "UserToTeam" edge to the Team entity by ID.
 func (uu *UserUpdate) SetUserToTeamID(id uuid.UUID) *UserUpdate {
 	uu.mutation.SetUserToTeamID(id)
@@ -102,6 +230,55 @@ func (uu *UserUpdate) SetUserToTeam(t *Team) *UserUpdate {
 	return uu.SetUserToTeamID(t.ID)
 }
 
+// AddUserToTeamMembershipIDs adds the "UserToTeamMemberships" edge to the TeamMembership entity by IDs.
+func (uu *UserUpdate) AddUserToTeamMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToTeamMembershipIDs(ids...)
+	return uu
+}
+
+// AddUserToTeamMemberships adds the "UserToTeamMemberships" edges to the TeamMembership entity.
+func (uu *UserUpdate) AddUserToTeamMemberships(t ...*TeamMembership) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddUserToTeamMembershipIDs(ids...)
+}
+
+// SetUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID.
+func (uu *UserUpdate) SetUserToCustomRoleID(id uuid.UUID) *UserUpdate {
+	uu.mutation.SetUserToCustomRoleID(id)
+	return uu
+}
+
+// SetNillableUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableUserToCustomRoleID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		uu = uu.SetUserToCustomRoleID(*id)
+	}
+	return uu
+}
+
+// SetUserToCustomRole sets the "UserToCustomRole" edge to the CustomRole entity.
+func (uu *UserUpdate) SetUserToCustomRole(c *CustomRole) *UserUpdate {
+	return uu.SetUserToCustomRoleID(c.ID)
+}
+
+// AddUserToAdminCompetitionIDs adds the "UserToAdminCompetitions" edge to the Competition entity by IDs.
+func (uu *UserUpdate) AddUserToAdminCompetitionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToAdminCompetitionIDs(ids...)
+	return uu
+}
+
+// AddUserToAdminCompetitions adds the "UserToAdminCompetitions" edges to the Competition entity.
+func (uu *UserUpdate) AddUserToAdminCompetitions(c ...*Competition) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddUserToAdminCompetitionIDs(ids...)
+}
+
 // AddUserToTokenIDs adds the "UserToToken" edge to the Token entity by IDs.
 func (uu *UserUpdate) AddUserToTokenIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddUserToTokenIDs(ids...)
@@ -132,6 +309,66 @@ func (uu *UserUpdate) AddUserToActions(a ...*Action) *UserUpdate {
 	return uu.AddUserToActionIDs(ids...)
 }
 
+// AddUserToConsoleSessionIDs adds the "UserToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (uu *UserUpdate) AddUserToConsoleSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToConsoleSessionIDs(ids...)
+	return uu
+}
+
+// AddUserToConsoleSessions adds the "UserToConsoleSessions" edges to the ConsoleSession entity.
+func (uu *UserUpdate) AddUserToConsoleSessions(c ...*ConsoleSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddUserToConsoleSessionIDs(ids...)
+}
+
+// AddUserToConsoleShareIDs adds the "UserToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (uu *UserUpdate) AddUserToConsoleShareIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToConsoleShareIDs(ids...)
+	return uu
+}
+
+// AddUserToConsoleShares adds the "UserToConsoleShares" edges to the ConsoleShare entity.
+func (uu *UserUpdate) AddUserToConsoleShares(c ...*ConsoleShare) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddUserToConsoleShareIDs(ids...)
+}
+
+// AddUserToWebauthnCredentialIDs adds the "UserToWebauthnCredentials" edge to the WebauthnCredential entity by IDs.
+func (uu *UserUpdate) AddUserToWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// AddUserToWebauthnCredentials adds the "UserToWebauthnCredentials" edges to the WebauthnCredential entity.
+func (uu *UserUpdate) AddUserToWebauthnCredentials(w ...*WebauthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.AddUserToWebauthnCredentialIDs(ids...)
+}
+
+// AddUserToPersonalAccessTokenIDs adds the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity by IDs.
+func (uu *UserUpdate) AddUserToPersonalAccessTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserToPersonalAccessTokenIDs(ids...)
+	return uu
+}
+
+// AddUserToPersonalAccessTokens adds the "UserToPersonalAccessTokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) AddUserToPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddUserToPersonalAccessTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -143,6 +380,54 @@ func (uu *UserUpdate) ClearUserToTeam() *UserUpdate {
 	return uu
 }
 
+// ClearUserToTeamMemberships clears all "UserToTeamMemberships" edges to the TeamMembership entity.
+func (uu *UserUpdate) ClearUserToTeamMemberships() *UserUpdate {
+	uu.mutation.ClearUserToTeamMemberships()
+	return uu
+}
+
+// RemoveUserToTeamMembershipIDs removes the "UserToTeamMemberships" edge to TeamMembership entities by IDs.
+func (uu *UserUpdate) RemoveUserToTeamMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToTeamMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveUserToTeamMemberships removes "UserToTeamMemberships" edges to TeamMembership entities.
+func (uu *UserUpdate) RemoveUserToTeamMemberships(t ...*TeamMembership) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveUserToTeamMembershipIDs(ids...)
+}
+
+// ClearUserToCustomRole clears the "UserToCustomRole" edge to the CustomRole entity.
+func (uu *UserUpdate) ClearUserToCustomRole() *UserUpdate {
+	uu.mutation.ClearUserToCustomRole()
+	return uu
+}
+
+// ClearUserToAdminCompetitions clears all "UserToAdminCompetitions" edges to the Competition entity.
+func (uu *UserUpdate) ClearUserToAdminCompetitions() *UserUpdate {
+	uu.mutation.ClearUserToAdminCompetitions()
+	return uu
+}
+
+// RemoveUserToAdminCompetitionIDs removes the "UserToAdminCompetitions" edge to Competition entities by IDs.
+func (uu *UserUpdate) RemoveUserToAdminCompetitionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToAdminCompetitionIDs(ids...)
+	return uu
+}
+
+// RemoveUserToAdminCompetitions removes "UserToAdminCompetitions" edges to Competition entities.
+func (uu *UserUpdate) RemoveUserToAdminCompetitions(c ...*Competition) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveUserToAdminCompetitionIDs(ids...)
+}
+
 // ClearUserToToken clears all "UserToToken" edges to the Token entity.
 func (uu *UserUpdate) ClearUserToToken() *UserUpdate {
 	uu.mutation.ClearUserToToken()
@@ -185,6 +470,90 @@ func (uu *UserUpdate) RemoveUserToActions(a ...*Action) *UserUpdate {
 	return uu.RemoveUserToActionIDs(ids...)
 }
 
+// ClearUserToConsoleSessions clears all "UserToConsoleSessions" edges to the ConsoleSession entity.
+func (uu *UserUpdate) ClearUserToConsoleSessions() *UserUpdate {
+	uu.mutation.ClearUserToConsoleSessions()
+	return uu
+}
+
+// RemoveUserToConsoleSessionIDs removes the "UserToConsoleSessions" edge to ConsoleSession entities by IDs.
+func (uu *UserUpdate) RemoveUserToConsoleSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToConsoleSessionIDs(ids...)
+	return uu
+}
+
+// RemoveUserToConsoleSessions removes "UserToConsoleSessions" edges to ConsoleSession entities.
+func (uu *UserUpdate) RemoveUserToConsoleSessions(c ...*ConsoleSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveUserToConsoleSessionIDs(ids...)
+}
+
+// ClearUserToConsoleShares clears all "UserToConsoleShares" edges to the ConsoleShare entity.
+func (uu *UserUpdate) ClearUserToConsoleShares() *UserUpdate {
+	uu.mutation.ClearUserToConsoleShares()
+	return uu
+}
+
+// RemoveUserToConsoleShareIDs removes the "UserToConsoleShares" edge to ConsoleShare entities by IDs.
+func (uu *UserUpdate) RemoveUserToConsoleShareIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToConsoleShareIDs(ids...)
+	return uu
+}
+
+// RemoveUserToConsoleShares removes "UserToConsoleShares" edges to ConsoleShare entities.
+func (uu *UserUpdate) RemoveUserToConsoleShares(c ...*ConsoleShare) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveUserToConsoleShareIDs(ids...)
+}
+
+// ClearUserToWebauthnCredentials clears all "UserToWebauthnCredentials" edges to the WebauthnCredential entity.
+func (uu *UserUpdate) ClearUserToWebauthnCredentials() *UserUpdate {
+	uu.mutation.ClearUserToWebauthnCredentials()
+	return uu
+}
+
+// RemoveUserToWebauthnCredentialIDs removes the "UserToWebauthnCredentials" edge to WebauthnCredential entities by IDs.
+func (uu *UserUpdate) RemoveUserToWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// RemoveUserToWebauthnCredentials removes "UserToWebauthnCredentials" edges to WebauthnCredential entities.
+func (uu *UserUpdate) RemoveUserToWebauthnCredentials(w ...*WebauthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.RemoveUserToWebauthnCredentialIDs(ids...)
+}
+
+// ClearUserToPersonalAccessTokens clears all "UserToPersonalAccessTokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) ClearUserToPersonalAccessTokens() *UserUpdate {
+	uu.mutation.ClearUserToPersonalAccessTokens()
+	return uu
+}
+
+// RemoveUserToPersonalAccessTokenIDs removes the "UserToPersonalAccessTokens" edge to PersonalAccessToken entities by IDs.
+func (uu *UserUpdate) RemoveUserToPersonalAccessTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserToPersonalAccessTokenIDs(ids...)
+	return uu
+}
+
+// RemoveUserToPersonalAccessTokens removes "UserToPersonalAccessTokens" edges to PersonalAccessToken entities.
+func (uu *UserUpdate) RemoveUserToPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemoveUserToPersonalAccessTokenIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -320,6 +689,86 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldProvider,
 		})
 	}
+	if value, ok := uu.mutation.ExternalIssuer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalIssuer,
+		})
+	}
+	if uu.mutation.ExternalIssuerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldExternalIssuer,
+		})
+	}
+	if value, ok := uu.mutation.ExternalSubject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalSubject,
+		})
+	}
+	if uu.mutation.ExternalSubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldExternalSubject,
+		})
+	}
+	if value, ok := uu.mutation.TotpSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldTotpSecret,
+		})
+	}
+	if uu.mutation.TotpSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldTotpSecret,
+		})
+	}
+	if value, ok := uu.mutation.TotpEnabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldTotpEnabled,
+		})
+	}
+	if value, ok := uu.mutation.TotpLastCounter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldTotpLastCounter,
+		})
+	}
+	if value, ok := uu.mutation.AddedTotpLastCounter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldTotpLastCounter,
+		})
+	}
+	if value, ok := uu.mutation.TotpRecoveryCodes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldTotpRecoveryCodes,
+		})
+	}
+	if uu.mutation.TotpRecoveryCodesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTotpRecoveryCodes,
+		})
+	}
+	if value, ok := uu.mutation.MustChangePassword(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldMustChangePassword,
+		})
+	}
 	if uu.mutation.UserToTeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -355,33 +804,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.UserToTokenCleared() {
+	if uu.mutation.UserToTeamMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UserToTokenTable,
-			Columns: []string{user.UserToTokenColumn},
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: token.FieldID,
+					Column: teammembership.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedUserToTokenIDs(); len(nodes) > 0 && !uu.mutation.UserToTokenCleared() {
+	if nodes := uu.mutation.RemovedUserToTeamMembershipsIDs(); len(nodes) > 0 && !uu.mutation.UserToTeamMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UserToTokenTable,
-			Columns: []string{user.UserToTokenColumn},
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: token.FieldID,
+					Column: teammembership.FieldID,
 				},
 			},
 		}
@@ -390,17 +839,17 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.UserToTokenIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.UserToTeamMembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UserToTokenTable,
-			Columns: []string{user.UserToTokenColumn},
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: token.FieldID,
+					Column: teammembership.FieldID,
 				},
 			},
 		}
@@ -409,57 +858,416 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.UserToActionsCleared() {
+	if uu.mutation.UserToCustomRoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.UserToActionsTable,
-			Columns: []string{user.UserToActionsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.UserToCustomRoleTable,
+			Columns: []string{user.UserToCustomRoleColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: action.FieldID,
+					Column: customrole.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedUserToActionsIDs(); len(nodes) > 0 && !uu.mutation.UserToActionsCleared() {
+	if nodes := uu.mutation.UserToCustomRoleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.UserToActionsTable,
-			Columns: []string{user.UserToActionsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.UserToCustomRoleTable,
+			Columns: []string{user.UserToCustomRoleColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: action.FieldID,
+					Column: customrole.FieldID,
 				},
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if nodes := uu.mutation.UserToActionsIDs(); len(nodes) > 0 {
+	if uu.mutation.UserToAdminCompetitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.UserToActionsTable,
-			Columns: []string{user.UserToActionsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: action.FieldID,
+					Column: competition.FieldID,
 				},
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToAdminCompetitionsIDs(); len(nodes) > 0 && !uu.mutation.UserToAdminCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToAdminCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTokenTable,
+			Columns: []string{user.UserToTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: token.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToTokenIDs(); len(nodes) > 0 && !uu.mutation.UserToTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTokenTable,
+			Columns: []string{user.UserToTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: token.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTokenTable,
+			Columns: []string{user.UserToTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: token.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToActionsTable,
+			Columns: []string{user.UserToActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: action.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToActionsIDs(); len(nodes) > 0 && !uu.mutation.UserToActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToActionsTable,
+			Columns: []string{user.UserToActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: action.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToActionsTable,
+			Columns: []string{user.UserToActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: action.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToConsoleSessionsIDs(); len(nodes) > 0 && !uu.mutation.UserToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToConsoleSharesIDs(); len(nodes) > 0 && !uu.mutation.UserToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToWebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToWebauthnCredentialsIDs(); len(nodes) > 0 && !uu.mutation.UserToWebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToWebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserToPersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserToPersonalAccessTokensIDs(); len(nodes) > 0 && !uu.mutation.UserToPersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserToPersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
@@ -471,66 +1279,187 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	return n, nil
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetFirstName sets the "first_name" field.
+func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
+	uuo.mutation.SetFirstName(s)
+	return uuo
+}
+
+// SetNillableFirstName sets the "first_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetFirstName(*s)
+	}
+	return uuo
+}
+
+// SetLastName sets the "last_name" field.
+func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
+	uuo.mutation.SetLastName(s)
+	return uuo
+}
+
+// SetNillableLastName sets the "last_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLastName(*s)
+	}
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetProvider sets the "provider" field.
+func (uuo *UserUpdateOne) SetProvider(u user.Provider) *UserUpdateOne {
+	uuo.mutation.SetProvider(u)
+	return uuo
+}
+
+// SetExternalIssuer sets the "external_issuer" field.
+func (uuo *UserUpdateOne) SetExternalIssuer(s string) *UserUpdateOne {
+	uuo.mutation.SetExternalIssuer(s)
+	return uuo
+}
+
+// SetNillableExternalIssuer sets the "external_issuer" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableExternalIssuer(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetExternalIssuer(*s)
+	}
+	return uuo
+}
+
+// ClearExternalIssuer clears the value of the "external_issuer" field.
+func (uuo *UserUpdateOne) ClearExternalIssuer() *UserUpdateOne {
+	uuo.mutation.ClearExternalIssuer()
+	return uuo
+}
+
+// SetExternalSubject sets the "external_subject" field.
+func (uuo *UserUpdateOne) SetExternalSubject(s string) *UserUpdateOne {
+	uuo.mutation.SetExternalSubject(s)
+	return uuo
+}
+
+// SetNillableExternalSubject sets the "external_subject" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableExternalSubject(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetExternalSubject(*s)
+	}
+	return uuo
+}
+
+// ClearExternalSubject clears the value of the "external_subject" field.
+func (uuo *UserUpdateOne) ClearExternalSubject() *UserUpdateOne {
+	uuo.mutation.ClearExternalSubject()
+	return uuo
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uuo *UserUpdateOne) SetTotpSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpSecret(s)
+	return uuo
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpSecret(*s)
+	}
+	return uuo
 }
 
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uuo *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	uuo.mutation.ClearTotpSecret()
+	return uuo
 }
 
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uuo *UserUpdateOne) SetTotpEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetTotpEnabled(b)
 	return uuo
 }
 
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetTotpEnabled(*b)
+	}
 	return uuo
 }
 
-// SetFirstName sets the "first_name" field.
-func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
-	uuo.mutation.SetFirstName(s)
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (uuo *UserUpdateOne) SetTotpLastCounter(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTotpLastCounter()
+	uuo.mutation.SetTotpLastCounter(i)
 	return uuo
 }
 
-// SetNillableFirstName sets the "first_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetFirstName(*s)
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpLastCounter(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTotpLastCounter(*i)
 	}
 	return uuo
 }
 
-// SetLastName sets the "last_name" field.
-func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
-	uuo.mutation.SetLastName(s)
+// AddTotpLastCounter adds i to the "totp_last_counter" field.
+func (uuo *UserUpdateOne) AddTotpLastCounter(i int64) *UserUpdateOne {
+	uuo.mutation.AddTotpLastCounter(i)
 	return uuo
 }
 
-// SetNillableLastName sets the "last_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetLastName(*s)
-	}
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) SetTotpRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.SetTotpRecoveryCodes(s)
 	return uuo
 }
 
-// SetRole sets the "role" field.
-func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
-	uuo.mutation.SetRole(u)
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) ClearTotpRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearTotpRecoveryCodes()
 	return uuo
 }
 
-// SetProvider sets the "provider" field.
-func (uuo *UserUpdateOne) SetProvider(u user.Provider) *UserUpdateOne {
-	uuo.mutation.SetProvider(u)
+// SetMustChangePassword sets the "must_change_password" field.
+func (uuo *UserUpdateOne) SetMustChangePassword(b bool) *UserUpdateOne {
+	uuo.mutation.SetMustChangePassword(b)
+	return uuo
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMustChangePassword(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetMustChangePassword(*b)
+	}
 	return uuo
 }
 
@@ -553,6 +1482,55 @@ func (uuo *UserUpdateOne) SetUserToTeam(t *Team) *UserUpdateOne {
 	return uuo.SetUserToTeamID(t.ID)
 }
 
+// AddUserToTeamMembershipIDs adds the "UserToTeamMemberships" edge to the TeamMembership entity by IDs.
+func (uuo *UserUpdateOne) AddUserToTeamMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToTeamMembershipIDs(ids...)
+	return uuo
+}
+
+// AddUserToTeamMemberships adds the "UserToTeamMemberships" edges to the TeamMembership entity.
+func (uuo *UserUpdateOne) AddUserToTeamMemberships(t ...*TeamMembership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddUserToTeamMembershipIDs(ids...)
+}
+
+// SetUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID.
+func (uuo *UserUpdateOne) SetUserToCustomRoleID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetUserToCustomRoleID(id)
+	return uuo
+}
+
+// SetNillableUserToCustomRoleID sets the "UserToCustomRole" edge to the CustomRole entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUserToCustomRoleID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetUserToCustomRoleID(*id)
+	}
+	return uuo
+}
+
+// SetUserToCustomRole sets the "UserToCustomRole" edge to the CustomRole entity.
+func (uuo *UserUpdateOne) SetUserToCustomRole(c *CustomRole) *UserUpdateOne {
+	return uuo.SetUserToCustomRoleID(c.ID)
+}
+
+// AddUserToAdminCompetitionIDs adds the "UserToAdminCompetitions" edge to the Competition entity by IDs.
+func (uuo *UserUpdateOne) AddUserToAdminCompetitionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToAdminCompetitionIDs(ids...)
+	return uuo
+}
+
+// AddUserToAdminCompetitions adds the "UserToAdminCompetitions" edges to the Competition entity.
+func (uuo *UserUpdateOne) AddUserToAdminCompetitions(c ...*Competition) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddUserToAdminCompetitionIDs(ids...)
+}
+
 // AddUserToTokenIDs adds the "UserToToken" edge to the Token entity by IDs.
 func (uuo *UserUpdateOne) AddUserToTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddUserToTokenIDs(ids...)
@@ -583,6 +1561,66 @@ func (uuo *UserUpdateOne) AddUserToActions(a ...*Action) *UserUpdateOne {
 	return uuo.AddUserToActionIDs(ids...)
 }
 
+// AddUserToConsoleSessionIDs adds the "UserToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (uuo *UserUpdateOne) AddUserToConsoleSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToConsoleSessionIDs(ids...)
+	return uuo
+}
+
+// AddUserToConsoleSessions adds the "UserToConsoleSessions" edges to the ConsoleSession entity.
+func (uuo *UserUpdateOne) AddUserToConsoleSessions(c ...*ConsoleSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddUserToConsoleSessionIDs(ids...)
+}
+
+// AddUserToConsoleShareIDs adds the "UserToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (uuo *UserUpdateOne) AddUserToConsoleShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToConsoleShareIDs(ids...)
+	return uuo
+}
+
+// AddUserToConsoleShares adds the "UserToConsoleShares" edges to the ConsoleShare entity.
+func (uuo *UserUpdateOne) AddUserToConsoleShares(c ...*ConsoleShare) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddUserToConsoleShareIDs(ids...)
+}
+
+// AddUserToWebauthnCredentialIDs adds the "UserToWebauthnCredentials" edge to the WebauthnCredential entity by IDs.
+func (uuo *UserUpdateOne) AddUserToWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// AddUserToWebauthnCredentials adds the "UserToWebauthnCredentials" edges to the WebauthnCredential entity.
+func (uuo *UserUpdateOne) AddUserToWebauthnCredentials(w ...*WebauthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.AddUserToWebauthnCredentialIDs(ids...)
+}
+
+// AddUserToPersonalAccessTokenIDs adds the "UserToPersonalAccessTokens" edge to the PersonalAccessToken entity by IDs.
+func (uuo *UserUpdateOne) AddUserToPersonalAccessTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserToPersonalAccessTokenIDs(ids...)
+	return uuo
+}
+
+// AddUserToPersonalAccessTokens adds the "UserToPersonalAccessTokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) AddUserToPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddUserToPersonalAccessTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -594,6 +1632,54 @@ func (uuo *UserUpdateOne) ClearUserToTeam() *UserUpdateOne {
 	return uuo
 }
 
+// ClearUserToTeamMemberships clears all "UserToTeamMemberships" edges to the TeamMembership entity.
+func (uuo *UserUpdateOne) ClearUserToTeamMemberships() *UserUpdateOne {
+	uuo.mutation.ClearUserToTeamMemberships()
+	return uuo
+}
+
+// RemoveUserToTeamMembershipIDs removes the "UserToTeamMemberships" edge to TeamMembership entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToTeamMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToTeamMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToTeamMemberships removes "UserToTeamMemberships" edges to TeamMembership entities.
+func (uuo *UserUpdateOne) RemoveUserToTeamMemberships(t ...*TeamMembership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveUserToTeamMembershipIDs(ids...)
+}
+
+// ClearUserToCustomRole clears the "UserToCustomRole" edge to the CustomRole entity.
+func (uuo *UserUpdateOne) ClearUserToCustomRole() *UserUpdateOne {
+	uuo.mutation.ClearUserToCustomRole()
+	return uuo
+}
+
+// ClearUserToAdminCompetitions clears all "UserToAdminCompetitions" edges to the Competition entity.
+func (uuo *UserUpdateOne) ClearUserToAdminCompetitions() *UserUpdateOne {
+	uuo.mutation.ClearUserToAdminCompetitions()
+	return uuo
+}
+
+// RemoveUserToAdminCompetitionIDs removes the "UserToAdminCompetitions" edge to Competition entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToAdminCompetitionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToAdminCompetitionIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToAdminCompetitions removes "UserToAdminCompetitions" edges to Competition entities.
+func (uuo *UserUpdateOne) RemoveUserToAdminCompetitions(c ...*Competition) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveUserToAdminCompetitionIDs(ids...)
+}
+
 // ClearUserToToken clears all "UserToToken" edges to the Token entity.
 func (uuo *UserUpdateOne) ClearUserToToken() *UserUpdateOne {
 	uuo.mutation.ClearUserToToken()
@@ -636,6 +1722,90 @@ func (uuo *UserUpdateOne) RemoveUserToActions(a ...*Action) *UserUpdateOne {
 	return uuo.RemoveUserToActionIDs(ids...)
 }
 
+// ClearUserToConsoleSessions clears all "UserToConsoleSessions" edges to the ConsoleSession entity.
+func (uuo *UserUpdateOne) ClearUserToConsoleSessions() *UserUpdateOne {
+	uuo.mutation.ClearUserToConsoleSessions()
+	return uuo
+}
+
+// RemoveUserToConsoleSessionIDs removes the "UserToConsoleSessions" edge to ConsoleSession entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToConsoleSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToConsoleSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToConsoleSessions removes "UserToConsoleSessions" edges to ConsoleSession entities.
+func (uuo *UserUpdateOne) RemoveUserToConsoleSessions(c ...*ConsoleSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveUserToConsoleSessionIDs(ids...)
+}
+
+// ClearUserToConsoleShares clears all "UserToConsoleShares" edges to the ConsoleShare entity.
+func (uuo *UserUpdateOne) ClearUserToConsoleShares() *UserUpdateOne {
+	uuo.mutation.ClearUserToConsoleShares()
+	return uuo
+}
+
+// RemoveUserToConsoleShareIDs removes the "UserToConsoleShares" edge to ConsoleShare entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToConsoleShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToConsoleShareIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToConsoleShares removes "UserToConsoleShares" edges to ConsoleShare entities.
+func (uuo *UserUpdateOne) RemoveUserToConsoleShares(c ...*ConsoleShare) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveUserToConsoleShareIDs(ids...)
+}
+
+// ClearUserToWebauthnCredentials clears all "UserToWebauthnCredentials" edges to the WebauthnCredential entity.
+func (uuo *UserUpdateOne) ClearUserToWebauthnCredentials() *UserUpdateOne {
+	uuo.mutation.ClearUserToWebauthnCredentials()
+	return uuo
+}
+
+// RemoveUserToWebauthnCredentialIDs removes the "UserToWebauthnCredentials" edge to WebauthnCredential entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToWebauthnCredentials removes "UserToWebauthnCredentials" edges to WebauthnCredential entities.
+func (uuo *UserUpdateOne) RemoveUserToWebauthnCredentials(w ...*WebauthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.RemoveUserToWebauthnCredentialIDs(ids...)
+}
+
+// ClearUserToPersonalAccessTokens clears all "UserToPersonalAccessTokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) ClearUserToPersonalAccessTokens() *UserUpdateOne {
+	uuo.mutation.ClearUserToPersonalAccessTokens()
+	return uuo
+}
+
+// RemoveUserToPersonalAccessTokenIDs removes the "UserToPersonalAccessTokens" edge to PersonalAccessToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserToPersonalAccessTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserToPersonalAccessTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveUserToPersonalAccessTokens removes "UserToPersonalAccessTokens" edges to PersonalAccessToken entities.
+func (uuo *UserUpdateOne) RemoveUserToPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveUserToPersonalAccessTokenIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
@@ -781,47 +1951,270 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldLastName,
 		})
 	}
-	if value, ok := uuo.mutation.Role(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeEnum,
-			Value:  value,
-			Column: user.FieldRole,
-		})
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+	}
+	if value, ok := uuo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldProvider,
+		})
+	}
+	if value, ok := uuo.mutation.ExternalIssuer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalIssuer,
+		})
+	}
+	if uuo.mutation.ExternalIssuerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldExternalIssuer,
+		})
+	}
+	if value, ok := uuo.mutation.ExternalSubject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalSubject,
+		})
+	}
+	if uuo.mutation.ExternalSubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldExternalSubject,
+		})
+	}
+	if value, ok := uuo.mutation.TotpSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldTotpSecret,
+		})
+	}
+	if uuo.mutation.TotpSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldTotpSecret,
+		})
+	}
+	if value, ok := uuo.mutation.TotpEnabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldTotpEnabled,
+		})
+	}
+	if value, ok := uuo.mutation.TotpLastCounter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldTotpLastCounter,
+		})
+	}
+	if value, ok := uuo.mutation.AddedTotpLastCounter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldTotpLastCounter,
+		})
+	}
+	if value, ok := uuo.mutation.TotpRecoveryCodes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldTotpRecoveryCodes,
+		})
+	}
+	if uuo.mutation.TotpRecoveryCodesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTotpRecoveryCodes,
+		})
+	}
+	if value, ok := uuo.mutation.MustChangePassword(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldMustChangePassword,
+		})
+	}
+	if uuo.mutation.UserToTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.UserToTeamTable,
+			Columns: []string{user.UserToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.UserToTeamTable,
+			Columns: []string{user.UserToTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UserToTeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToTeamMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.UserToTeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Provider(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeEnum,
-			Value:  value,
-			Column: user.FieldProvider,
-		})
+	if nodes := uuo.mutation.UserToTeamMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToTeamMembershipsTable,
+			Columns: []string{user.UserToTeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: teammembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.UserToTeamCleared() {
+	if uuo.mutation.UserToCustomRoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   user.UserToTeamTable,
-			Columns: []string{user.UserToTeamColumn},
+			Table:   user.UserToCustomRoleTable,
+			Columns: []string{user.UserToCustomRoleColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: team.FieldID,
+					Column: customrole.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.UserToTeamIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.UserToCustomRoleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   user.UserToTeamTable,
-			Columns: []string{user.UserToTeamColumn},
+			Table:   user.UserToCustomRoleTable,
+			Columns: []string{user.UserToCustomRoleColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: team.FieldID,
+					Column: customrole.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UserToAdminCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToAdminCompetitionsIDs(); len(nodes) > 0 && !uuo.mutation.UserToAdminCompetitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToAdminCompetitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.UserToAdminCompetitionsTable,
+			Columns: user.UserToAdminCompetitionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: competition.FieldID,
 				},
 			},
 		}
@@ -938,6 +2331,222 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.UserToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToConsoleSessionsIDs(); len(nodes) > 0 && !uuo.mutation.UserToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSessionsTable,
+			Columns: []string{user.UserToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UserToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToConsoleSharesIDs(); len(nodes) > 0 && !uuo.mutation.UserToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToConsoleSharesTable,
+			Columns: []string{user.UserToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UserToWebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToWebauthnCredentialsIDs(); len(nodes) > 0 && !uuo.mutation.UserToWebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToWebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToWebauthnCredentialsTable,
+			Columns: []string{user.UserToWebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webauthncredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UserToPersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserToPersonalAccessTokensIDs(); len(nodes) > 0 && !uuo.mutation.UserToPersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserToPersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserToPersonalAccessTokensTable,
+			Columns: []string{user.UserToPersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: personalaccesstoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,211 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// VmCredential is the model entity for the VmCredential schema.
+type VmCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Protocol holds the value of the "protocol" field.
+	// [REQUIRED] The protocol guacd will use to connect to the VM with these credentials.
+	Protocol vmcredential.Protocol `json:"protocol,omitempty"`
+	// Port holds the value of the "port" field.
+	// [OPTIONAL] The port to connect to. Defaults to the standard port for the protocol when 0.
+	Port int `json:"port,omitempty"`
+	// Username holds the value of the "username" field.
+	// [OPTIONAL] The username to log in as.
+	Username string `json:"username,omitempty"`
+	// Password holds the value of the "password" field.
+	// [OPTIONAL] The password to log in with. This value MUST be protected.
+	Password string `json:"-"`
+	// PrivateKey holds the value of the "private_key" field.
+	// [OPTIONAL] (SSH only) The PEM encoded private key to log in with. This value MUST be protected.
+	PrivateKey string `json:"-"`
+	// Domain holds the value of the "domain" field.
+	// [OPTIONAL] (RDP only) The domain to authenticate against.
+	Domain string `json:"domain,omitempty"`
+	// IgnoreCert holds the value of the "ignore_cert" field.
+	// [REQUIRED] (default is true) (RDP only) Whether to ignore the VM's certificate. Competition VMs usually have self-signed certificates.
+	IgnoreCert bool `json:"ignore_cert,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VmCredentialQuery when eager-loading is set.
+	Edges                                 VmCredentialEdges `json:"edges"`
+	vm_object_vm_object_to_vm_credentials *uuid.UUID
+}
+
+// VmCredentialEdges holds the relations/edges for other nodes in the graph.
+type VmCredentialEdges struct {
+	// VmCredentialToVmObject holds the value of the VmCredentialToVmObject edge.
+	VmCredentialToVmObject *VmObject `json:"VmCredentialToVmObject,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VmCredentialToVmObjectOrErr returns the VmCredentialToVmObject value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VmCredentialEdges) VmCredentialToVmObjectOrErr() (*VmObject, error) {
+	if e.loadedTypes[0] {
+		if e.VmCredentialToVmObject == nil {
+			// The edge VmCredentialToVmObject was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: vmobject.Label}
+		}
+		return e.VmCredentialToVmObject, nil
+	}
+	return nil, &NotLoadedError{edge: "VmCredentialToVmObject"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VmCredential) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vmcredential.FieldIgnoreCert:
+			values[i] = new(sql.NullBool)
+		case vmcredential.FieldPort:
+			values[i] = new(sql.NullInt64)
+		case vmcredential.FieldProtocol, vmcredential.FieldUsername, vmcredential.FieldPassword, vmcredential.FieldPrivateKey, vmcredential.FieldDomain:
+			values[i] = new(sql.NullString)
+		case vmcredential.FieldID:
+			values[i] = new(uuid.UUID)
+		case vmcredential.ForeignKeys[0]: // vm_object_vm_object_to_vm_credentials
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type VmCredential", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VmCredential fields.
+func (vc *VmCredential) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vmcredential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				vc.ID = *value
+			}
+		case vmcredential.FieldProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[i])
+			} else if value.Valid {
+				vc.Protocol = vmcredential.Protocol(value.String)
+			}
+		case vmcredential.FieldPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field port", values[i])
+			} else if value.Valid {
+				vc.Port = int(value.Int64)
+			}
+		case vmcredential.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				vc.Username = value.String
+			}
+		case vmcredential.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				vc.Password = value.String
+			}
+		case vmcredential.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				vc.PrivateKey = value.String
+			}
+		case vmcredential.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				vc.Domain = value.String
+			}
+		case vmcredential.FieldIgnoreCert:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ignore_cert", values[i])
+			} else if value.Valid {
+				vc.IgnoreCert = value.Bool
+			}
+		case vmcredential.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vm_object_vm_object_to_vm_credentials", values[i])
+			} else if value.Valid {
+				vc.vm_object_vm_object_to_vm_credentials = new(uuid.UUID)
+				*vc.vm_object_vm_object_to_vm_credentials = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryVmCredentialToVmObject queries the "VmCredentialToVmObject" edge of the VmCredential entity.
+func (vc *VmCredential) QueryVmCredentialToVmObject() *VmObjectQuery {
+	return (&VmCredentialClient{config: vc.config}).QueryVmCredentialToVmObject(vc)
+}
+
+// Update returns a builder for updating this VmCredential.
+// Note that you need to call VmCredential.Unwrap() before calling this method if this VmCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vc *VmCredential) Update() *VmCredentialUpdateOne {
+	return (&VmCredentialClient{config: vc.config}).UpdateOne(vc)
+}
+
+// Unwrap unwraps the VmCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vc *VmCredential) Unwrap() *VmCredential {
+	tx, ok := vc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VmCredential is not a transactional entity")
+	}
+	vc.config.driver = tx.drv
+	return vc
+}
+
+// String implements the fmt.Stringer.
+func (vc *VmCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("VmCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v", vc.ID))
+	builder.WriteString(", protocol=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Protocol))
+	builder.WriteString(", port=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Port))
+	builder.WriteString(", username=")
+	builder.WriteString(vc.Username)
+	builder.WriteString(", password=<sensitive>")
+	builder.WriteString(", private_key=<sensitive>")
+	builder.WriteString(", domain=")
+	builder.WriteString(vc.Domain)
+	builder.WriteString(", ignore_cert=")
+	builder.WriteString(fmt.Sprintf("%v", vc.IgnoreCert))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VmCredentials is a parsable slice of VmCredential.
+type VmCredentials []*VmCredential
+
+func (vc VmCredentials) config(cfg config) {
+	for _i := range vc {
+		vc[_i].config = cfg
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by entc, DO NOT EDIT.
+
+package vmcredential
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the vmcredential type in the database.
+	Label = "vm_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldProtocol holds the string denoting the protocol field in the database.
+	FieldProtocol = "protocol"
+	// FieldPort holds the string denoting the port field in the database.
+	FieldPort = "port"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldPrivateKey holds the string denoting the private_key field in the database.
+	FieldPrivateKey = "private_key"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldIgnoreCert holds the string denoting the ignore_cert field in the database.
+	FieldIgnoreCert = "ignore_cert"
+	// EdgeVmCredentialToVmObject holds the string denoting the vmcredentialtovmobject edge name in mutations.
+	EdgeVmCredentialToVmObject = "VmCredentialToVmObject"
+	// Table holds the table name of the vmcredential in the database.
+	Table = "vm_credentials"
+	// VmCredentialToVmObjectTable is the table that holds the VmCredentialToVmObject relation/edge.
+	VmCredentialToVmObjectTable = "vm_credentials"
+	// VmCredentialToVmObjectInverseTable is the table name for the VmObject entity.
+	// It exists in this package in order to avoid circular dependency with the "vmobject" package.
+	VmCredentialToVmObjectInverseTable = "vm_objects"
+	// VmCredentialToVmObjectColumn is the table column denoting the VmCredentialToVmObject relation/edge.
+	VmCredentialToVmObjectColumn = "vm_object_vm_object_to_vm_credentials"
+)
+
+// Columns holds all SQL columns for vmcredential fields.
+var Columns = []string{
+	FieldID,
+	FieldProtocol,
+	FieldPort,
+	FieldUsername,
+	FieldPassword,
+	FieldPrivateKey,
+	FieldDomain,
+	FieldIgnoreCert,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "vm_credentials"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"vm_object_vm_object_to_vm_credentials",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPort holds the default value on creation for the "port" field.
+	DefaultPort int
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// DefaultPassword holds the default value on creation for the "password" field.
+	DefaultPassword string
+	// DefaultPrivateKey holds the default value on creation for the "private_key" field.
+	DefaultPrivateKey string
+	// DefaultDomain holds the default value on creation for the "domain" field.
+	DefaultDomain string
+	// DefaultIgnoreCert holds the default value on creation for the "ignore_cert" field.
+	DefaultIgnoreCert bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Protocol defines the type for the "protocol" enum field.
+type Protocol string
+
+// Protocol values.
+const (
+	ProtocolSSH Protocol = "SSH"
+	ProtocolRDP Protocol = "RDP"
+)
+
+func (pr Protocol) String() string {
+	return string(pr)
+}
+
+// ProtocolValidator is a validator for the "protocol" field enum values. It is called by the builders before save.
+func ProtocolValidator(pr Protocol) error {
+	switch pr {
+	case ProtocolSSH, ProtocolRDP:
+		return nil
+	default:
+		return fmt.Errorf("vmcredential: invalid enum value for protocol field: %q", pr)
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (pr Protocol) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(pr.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (pr *Protocol) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*pr = Protocol(str)
+	if err := ProtocolValidator(*pr); err != nil {
+		return fmt.Errorf("%s is not a valid Protocol", str)
+	}
+	return nil
+}
@@ -0,0 +1,777 @@
+// Code generated by entc, DO NOT EDIT.
+
+package vmcredential
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Port applies equality check predicate on the "port" field. It's identical to PortEQ.
+func Port(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPort), v))
+	})
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUsername), v))
+	})
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPassword), v))
+	})
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDomain), v))
+	})
+}
+
+// IgnoreCert applies equality check predicate on the "ignore_cert" field. It's identical to IgnoreCertEQ.
+func IgnoreCert(v bool) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIgnoreCert), v))
+	})
+}
+
+// ProtocolEQ applies the EQ predicate on the "protocol" field.
+func ProtocolEQ(v Protocol) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProtocol), v))
+	})
+}
+
+// ProtocolNEQ applies the NEQ predicate on the "protocol" field.
+func ProtocolNEQ(v Protocol) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProtocol), v))
+	})
+}
+
+// ProtocolIn applies the In predicate on the "protocol" field.
+func ProtocolIn(vs ...Protocol) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProtocol), v...))
+	})
+}
+
+// ProtocolNotIn applies the NotIn predicate on the "protocol" field.
+func ProtocolNotIn(vs ...Protocol) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProtocol), v...))
+	})
+}
+
+// PortEQ applies the EQ predicate on the "port" field.
+func PortEQ(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPort), v))
+	})
+}
+
+// PortNEQ applies the NEQ predicate on the "port" field.
+func PortNEQ(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPort), v))
+	})
+}
+
+// PortIn applies the In predicate on the "port" field.
+func PortIn(vs ...int) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPort), v...))
+	})
+}
+
+// PortNotIn applies the NotIn predicate on the "port" field.
+func PortNotIn(vs ...int) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPort), v...))
+	})
+}
+
+// PortGT applies the GT predicate on the "port" field.
+func PortGT(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPort), v))
+	})
+}
+
+// PortGTE applies the GTE predicate on the "port" field.
+func PortGTE(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPort), v))
+	})
+}
+
+// PortLT applies the LT predicate on the "port" field.
+func PortLT(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPort), v))
+	})
+}
+
+// PortLTE applies the LTE predicate on the "port" field.
+func PortLTE(v int) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPort), v))
+	})
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUsername), v...))
+	})
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUsername), v...))
+	})
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUsername), v))
+	})
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUsername), v))
+	})
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPassword), v...))
+	})
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPassword), v...))
+	})
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPassword), v))
+	})
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrivateKey), v...))
+	})
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrivateKey), v...))
+	})
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPrivateKey), v))
+	})
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDomain), v))
+	})
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDomain), v))
+	})
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDomain), v...))
+	})
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.VmCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDomain), v...))
+	})
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDomain), v))
+	})
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDomain), v))
+	})
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDomain), v))
+	})
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDomain), v))
+	})
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDomain), v))
+	})
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDomain), v))
+	})
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDomain), v))
+	})
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDomain), v))
+	})
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDomain), v))
+	})
+}
+
+// IgnoreCertEQ applies the EQ predicate on the "ignore_cert" field.
+func IgnoreCertEQ(v bool) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIgnoreCert), v))
+	})
+}
+
+// IgnoreCertNEQ applies the NEQ predicate on the "ignore_cert" field.
+func IgnoreCertNEQ(v bool) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIgnoreCert), v))
+	})
+}
+
+// HasVmCredentialToVmObject applies the HasEdge predicate on the "VmCredentialToVmObject" edge.
+func HasVmCredentialToVmObject() predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmCredentialToVmObjectTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VmCredentialToVmObjectTable, VmCredentialToVmObjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVmCredentialToVmObjectWith applies the HasEdge predicate on the "VmCredentialToVmObject" edge with a given conditions (other predicates).
+func HasVmCredentialToVmObjectWith(preds ...predicate.VmObject) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmCredentialToVmObjectInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VmCredentialToVmObjectTable, VmCredentialToVmObjectColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VmCredential) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VmCredential) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VmCredential) predicate.VmCredential {
+	return predicate.VmCredential(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
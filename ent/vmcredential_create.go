@@ -0,0 +1,464 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// VmCredentialCreate is the builder for creating a VmCredential entity.
+type VmCredentialCreate struct {
+	config
+	mutation *VmCredentialMutation
+	hooks    []Hook
+}
+
+// SetProtocol sets the "protocol" field.
+func (vcc *VmCredentialCreate) SetProtocol(v vmcredential.Protocol) *VmCredentialCreate {
+	vcc.mutation.SetProtocol(v)
+	return vcc
+}
+
+// SetPort sets the "port" field.
+func (vcc *VmCredentialCreate) SetPort(i int) *VmCredentialCreate {
+	vcc.mutation.SetPort(i)
+	return vcc
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillablePort(i *int) *VmCredentialCreate {
+	if i != nil {
+		vcc.SetPort(*i)
+	}
+	return vcc
+}
+
+// SetUsername sets the "username" field.
+func (vcc *VmCredentialCreate) SetUsername(s string) *VmCredentialCreate {
+	vcc.mutation.SetUsername(s)
+	return vcc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillableUsername(s *string) *VmCredentialCreate {
+	if s != nil {
+		vcc.SetUsername(*s)
+	}
+	return vcc
+}
+
+// SetPassword sets the "password" field.
+func (vcc *VmCredentialCreate) SetPassword(s string) *VmCredentialCreate {
+	vcc.mutation.SetPassword(s)
+	return vcc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillablePassword(s *string) *VmCredentialCreate {
+	if s != nil {
+		vcc.SetPassword(*s)
+	}
+	return vcc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (vcc *VmCredentialCreate) SetPrivateKey(s string) *VmCredentialCreate {
+	vcc.mutation.SetPrivateKey(s)
+	return vcc
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillablePrivateKey(s *string) *VmCredentialCreate {
+	if s != nil {
+		vcc.SetPrivateKey(*s)
+	}
+	return vcc
+}
+
+// SetDomain sets the "domain" field.
+func (vcc *VmCredentialCreate) SetDomain(s string) *VmCredentialCreate {
+	vcc.mutation.SetDomain(s)
+	return vcc
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillableDomain(s *string) *VmCredentialCreate {
+	if s != nil {
+		vcc.SetDomain(*s)
+	}
+	return vcc
+}
+
+// SetIgnoreCert sets the "ignore_cert" field.
+func (vcc *VmCredentialCreate) SetIgnoreCert(b bool) *VmCredentialCreate {
+	vcc.mutation.SetIgnoreCert(b)
+	return vcc
+}
+
+// SetNillableIgnoreCert sets the "ignore_cert" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillableIgnoreCert(b *bool) *VmCredentialCreate {
+	if b != nil {
+		vcc.SetIgnoreCert(*b)
+	}
+	return vcc
+}
+
+// SetID sets the "id" field.
+func (vcc *VmCredentialCreate) SetID(u uuid.UUID) *VmCredentialCreate {
+	vcc.mutation.SetID(u)
+	return vcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (vcc *VmCredentialCreate) SetNillableID(u *uuid.UUID) *VmCredentialCreate {
+	if u != nil {
+		vcc.SetID(*u)
+	}
+	return vcc
+}
+
+// SetVmCredentialToVmObjectID sets the "VmCredentialToVmObject" edge to the VmObject entity by ID.
+func (vcc *VmCredentialCreate) SetVmCredentialToVmObjectID(id uuid.UUID) *VmCredentialCreate {
+	vcc.mutation.SetVmCredentialToVmObjectID(id)
+	return vcc
+}
+
+// SetVmCredentialToVmObject sets the "VmCredentialToVmObject" edge to the VmObject entity.
+func (vcc *VmCredentialCreate) SetVmCredentialToVmObject(v *VmObject) *VmCredentialCreate {
+	return vcc.SetVmCredentialToVmObjectID(v.ID)
+}
+
+// Mutation returns the VmCredentialMutation object of the builder.
+func (vcc *VmCredentialCreate) Mutation() *VmCredentialMutation {
+	return vcc.mutation
+}
+
+// Save creates the VmCredential in the database.
+func (vcc *VmCredentialCreate) Save(ctx context.Context) (*VmCredential, error) {
+	var (
+		err  error
+		node *VmCredential
+	)
+	vcc.defaults()
+	if len(vcc.hooks) == 0 {
+		if err = vcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = vcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VmCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vcc.check(); err != nil {
+				return nil, err
+			}
+			vcc.mutation = mutation
+			if node, err = vcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vcc.hooks) - 1; i >= 0; i-- {
+			if vcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vcc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vcc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vcc *VmCredentialCreate) SaveX(ctx context.Context) *VmCredential {
+	v, err := vcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcc *VmCredentialCreate) Exec(ctx context.Context) error {
+	_, err := vcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcc *VmCredentialCreate) ExecX(ctx context.Context) {
+	if err := vcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcc *VmCredentialCreate) defaults() {
+	if _, ok := vcc.mutation.Port(); !ok {
+		v := vmcredential.DefaultPort
+		vcc.mutation.SetPort(v)
+	}
+	if _, ok := vcc.mutation.Username(); !ok {
+		v := vmcredential.DefaultUsername
+		vcc.mutation.SetUsername(v)
+	}
+	if _, ok := vcc.mutation.Password(); !ok {
+		v := vmcredential.DefaultPassword
+		vcc.mutation.SetPassword(v)
+	}
+	if _, ok := vcc.mutation.PrivateKey(); !ok {
+		v := vmcredential.DefaultPrivateKey
+		vcc.mutation.SetPrivateKey(v)
+	}
+	if _, ok := vcc.mutation.Domain(); !ok {
+		v := vmcredential.DefaultDomain
+		vcc.mutation.SetDomain(v)
+	}
+	if _, ok := vcc.mutation.IgnoreCert(); !ok {
+		v := vmcredential.DefaultIgnoreCert
+		vcc.mutation.SetIgnoreCert(v)
+	}
+	if _, ok := vcc.mutation.ID(); !ok {
+		v := vmcredential.DefaultID()
+		vcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcc *VmCredentialCreate) check() error {
+	if _, ok := vcc.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "VmCredential.protocol"`)}
+	}
+	if v, ok := vcc.mutation.Protocol(); ok {
+		if err := vmcredential.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "VmCredential.protocol": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.Port(); !ok {
+		return &ValidationError{Name: "port", err: errors.New(`ent: missing required field "VmCredential.port"`)}
+	}
+	if _, ok := vcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "VmCredential.username"`)}
+	}
+	if _, ok := vcc.mutation.Password(); !ok {
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "VmCredential.password"`)}
+	}
+	if _, ok := vcc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "VmCredential.private_key"`)}
+	}
+	if _, ok := vcc.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "VmCredential.domain"`)}
+	}
+	if _, ok := vcc.mutation.IgnoreCert(); !ok {
+		return &ValidationError{Name: "ignore_cert", err: errors.New(`ent: missing required field "VmCredential.ignore_cert"`)}
+	}
+	if _, ok := vcc.mutation.VmCredentialToVmObjectID(); !ok {
+		return &ValidationError{Name: "VmCredentialToVmObject", err: errors.New(`ent: missing required edge "VmCredential.VmCredentialToVmObject"`)}
+	}
+	return nil
+}
+
+func (vcc *VmCredentialCreate) sqlSave(ctx context.Context) (*VmCredential, error) {
+	_node, _spec := vcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (vcc *VmCredentialCreate) createSpec() (*VmCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VmCredential{config: vcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: vmcredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: vmcredential.FieldID,
+			},
+		}
+	)
+	if id, ok := vcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := vcc.mutation.Protocol(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: vmcredential.FieldProtocol,
+		})
+		_node.Protocol = value
+	}
+	if value, ok := vcc.mutation.Port(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmcredential.FieldPort,
+		})
+		_node.Port = value
+	}
+	if value, ok := vcc.mutation.Username(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldUsername,
+		})
+		_node.Username = value
+	}
+	if value, ok := vcc.mutation.Password(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPassword,
+		})
+		_node.Password = value
+	}
+	if value, ok := vcc.mutation.PrivateKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPrivateKey,
+		})
+		_node.PrivateKey = value
+	}
+	if value, ok := vcc.mutation.Domain(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldDomain,
+		})
+		_node.Domain = value
+	}
+	if value, ok := vcc.mutation.IgnoreCert(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmcredential.FieldIgnoreCert,
+		})
+		_node.IgnoreCert = value
+	}
+	if nodes := vcc.mutation.VmCredentialToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vmcredential.VmCredentialToVmObjectTable,
+			Columns: []string{vmcredential.VmCredentialToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vm_object_vm_object_to_vm_credentials = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VmCredentialCreateBulk is the builder for creating many VmCredential entities in bulk.
+type VmCredentialCreateBulk struct {
+	config
+	builders []*VmCredentialCreate
+}
+
+// Save creates the VmCredential entities in the database.
+func (vccb *VmCredentialCreateBulk) Save(ctx context.Context) ([]*VmCredential, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(vccb.builders))
+	nodes := make([]*VmCredential, len(vccb.builders))
+	mutators := make([]Mutator, len(vccb.builders))
+	for i := range vccb.builders {
+		func(i int, root context.Context) {
+			builder := vccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VmCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vccb *VmCredentialCreateBulk) SaveX(ctx context.Context) []*VmCredential {
+	v, err := vccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vccb *VmCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := vccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vccb *VmCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := vccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
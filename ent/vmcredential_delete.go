@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+)
+
+// VmCredentialDelete is the builder for deleting a VmCredential entity.
+type VmCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *VmCredentialMutation
+}
+
+// Where appends a list predicates to the VmCredentialDelete builder.
+func (vcd *VmCredentialDelete) Where(ps ...predicate.VmCredential) *VmCredentialDelete {
+	vcd.mutation.Where(ps...)
+	return vcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vcd *VmCredentialDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(vcd.hooks) == 0 {
+		affected, err = vcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VmCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vcd.mutation = mutation
+			affected, err = vcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(vcd.hooks) - 1; i >= 0; i-- {
+			if vcd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcd *VmCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := vcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vcd *VmCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: vmcredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: vmcredential.FieldID,
+			},
+		},
+	}
+	if ps := vcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, vcd.driver, _spec)
+}
+
+// VmCredentialDeleteOne is the builder for deleting a single VmCredential entity.
+type VmCredentialDeleteOne struct {
+	vcd *VmCredentialDelete
+}
+
+// Exec executes the deletion query.
+func (vcdo *VmCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := vcdo.vcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vmcredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcdo *VmCredentialDeleteOne) ExecX(ctx context.Context) {
+	vcdo.vcd.ExecX(ctx)
+}
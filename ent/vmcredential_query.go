@@ -0,0 +1,999 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// VmCredentialQuery is the builder for querying VmCredential entities.
+type VmCredentialQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.VmCredential
+	// eager-loading edges.
+	withVmCredentialToVmObject *VmObjectQuery
+	withFKs                    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VmCredentialQuery builder.
+func (vcq *VmCredentialQuery) Where(ps ...predicate.VmCredential) *VmCredentialQuery {
+	vcq.predicates = append(vcq.predicates, ps...)
+	return vcq
+}
+
+// Limit adds a limit step to the query.
+func (vcq *VmCredentialQuery) Limit(limit int) *VmCredentialQuery {
+	vcq.limit = &limit
+	return vcq
+}
+
+// Offset adds an offset step to the query.
+func (vcq *VmCredentialQuery) Offset(offset int) *VmCredentialQuery {
+	vcq.offset = &offset
+	return vcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vcq *VmCredentialQuery) Unique(unique bool) *VmCredentialQuery {
+	vcq.unique = &unique
+	return vcq
+}
+
+// Order adds an order step to the query.
+func (vcq *VmCredentialQuery) Order(o ...OrderFunc) *VmCredentialQuery {
+	vcq.order = append(vcq.order, o...)
+	return vcq
+}
+
+// QueryVmCredentialToVmObject chains the current query on the "VmCredentialToVmObject" edge.
+func (vcq *VmCredentialQuery) QueryVmCredentialToVmObject() *VmObjectQuery {
+	query := &VmObjectQuery{config: vcq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmcredential.Table, vmcredential.FieldID, selector),
+			sqlgraph.To(vmobject.Table, vmobject.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vmcredential.VmCredentialToVmObjectTable, vmcredential.VmCredentialToVmObjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VmCredential entity from the query.
+// Returns a *NotFoundError when no VmCredential was found.
+func (vcq *VmCredentialQuery) First(ctx context.Context) (*VmCredential, error) {
+	nodes, err := vcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vmcredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vcq *VmCredentialQuery) FirstX(ctx context.Context) *VmCredential {
+	node, err := vcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VmCredential ID from the query.
+// Returns a *NotFoundError when no VmCredential ID was found.
+func (vcq *VmCredentialQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vmcredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vcq *VmCredentialQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := vcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VmCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VmCredential entity is found.
+// Returns a *NotFoundError when no VmCredential entities are found.
+func (vcq *VmCredentialQuery) Only(ctx context.Context) (*VmCredential, error) {
+	nodes, err := vcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vmcredential.Label}
+	default:
+		return nil, &NotSingularError{vmcredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vcq *VmCredentialQuery) OnlyX(ctx context.Context) *VmCredential {
+	node, err := vcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VmCredential ID in the query.
+// Returns a *NotSingularError when more than one VmCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vcq *VmCredentialQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = &NotSingularError{vmcredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vcq *VmCredentialQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := vcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VmCredentials.
+func (vcq *VmCredentialQuery) All(ctx context.Context) ([]*VmCredential, error) {
+	if err := vcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return vcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vcq *VmCredentialQuery) AllX(ctx context.Context) []*VmCredential {
+	nodes, err := vcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VmCredential IDs.
+func (vcq *VmCredentialQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := vcq.Select(vmcredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vcq *VmCredentialQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := vcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vcq *VmCredentialQuery) Count(ctx context.Context) (int, error) {
+	if err := vcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return vcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vcq *VmCredentialQuery) CountX(ctx context.Context) int {
+	count, err := vcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vcq *VmCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	if err := vcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return vcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vcq *VmCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := vcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VmCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vcq *VmCredentialQuery) Clone() *VmCredentialQuery {
+	if vcq == nil {
+		return nil
+	}
+	return &VmCredentialQuery{
+		config:                     vcq.config,
+		limit:                      vcq.limit,
+		offset:                     vcq.offset,
+		order:                      append([]OrderFunc{}, vcq.order...),
+		predicates:                 append([]predicate.VmCredential{}, vcq.predicates...),
+		withVmCredentialToVmObject: vcq.withVmCredentialToVmObject.Clone(),
+		// clone intermediate query.
+		sql:    vcq.sql.Clone(),
+		path:   vcq.path,
+		unique: vcq.unique,
+	}
+}
+
+// WithVmCredentialToVmObject tells the query-builder to eager-load the nodes that are connected to
+// the "VmCredentialToVmObject" edge. The optional arguments are used to configure the query builder of the edge.
+func (vcq *VmCredentialQuery) WithVmCredentialToVmObject(opts ...func(*VmObjectQuery)) *VmCredentialQuery {
+	query := &VmObjectQuery{config: vcq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	vcq.withVmCredentialToVmObject = query
+	return vcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Protocol vmcredential.Protocol `json:"protocol,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VmCredential.Query().
+//		GroupBy(vmcredential.FieldProtocol).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vcq *VmCredentialQuery) GroupBy(field string, fields ...string) *VmCredentialGroupBy {
+	group := &VmCredentialGroupBy{config: vcq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := vcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return vcq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Protocol vmcredential.Protocol `json:"protocol,omitempty"`
+//	}
+//
+//	client.VmCredential.Query().
+//		Select(vmcredential.FieldProtocol).
+//		Scan(ctx, &v)
+func (vcq *VmCredentialQuery) Select(fields ...string) *VmCredentialSelect {
+	vcq.fields = append(vcq.fields, fields...)
+	return &VmCredentialSelect{VmCredentialQuery: vcq}
+}
+
+func (vcq *VmCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range vcq.fields {
+		if !vmcredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vcq.path != nil {
+		prev, err := vcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vcq.sql = prev
+	}
+	return nil
+}
+
+func (vcq *VmCredentialQuery) sqlAll(ctx context.Context) ([]*VmCredential, error) {
+	var (
+		nodes       = []*VmCredential{}
+		withFKs     = vcq.withFKs
+		_spec       = vcq.querySpec()
+		loadedTypes = [1]bool{
+			vcq.withVmCredentialToVmObject != nil,
+		}
+	)
+	if vcq.withVmCredentialToVmObject != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, vmcredential.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &VmCredential{config: vcq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := vcq.withVmCredentialToVmObject; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*VmCredential)
+		for i := range nodes {
+			if nodes[i].vm_object_vm_object_to_vm_credentials == nil {
+				continue
+			}
+			fk := *nodes[i].vm_object_vm_object_to_vm_credentials
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(vmobject.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_vm_credentials" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.VmCredentialToVmObject = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (vcq *VmCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vcq.querySpec()
+	_spec.Node.Columns = vcq.fields
+	if len(vcq.fields) > 0 {
+		_spec.Unique = vcq.unique != nil && *vcq.unique
+	}
+	return sqlgraph.CountNodes(ctx, vcq.driver, _spec)
+}
+
+func (vcq *VmCredentialQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := vcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (vcq *VmCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vmcredential.Table,
+			Columns: vmcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: vmcredential.FieldID,
+			},
+		},
+		From:   vcq.sql,
+		Unique: true,
+	}
+	if unique := vcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := vcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vmcredential.FieldID)
+		for i := range fields {
+			if fields[i] != vmcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vcq *VmCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vcq.driver.Dialect())
+	t1 := builder.Table(vmcredential.Table)
+	columns := vcq.fields
+	if len(columns) == 0 {
+		columns = vmcredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vcq.sql != nil {
+		selector = vcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vcq.unique != nil && *vcq.unique {
+		selector.Distinct()
+	}
+	for _, p := range vcq.predicates {
+		p(selector)
+	}
+	for _, p := range vcq.order {
+		p(selector)
+	}
+	if offset := vcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VmCredentialGroupBy is the group-by builder for VmCredential entities.
+type VmCredentialGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vcgb *VmCredentialGroupBy) Aggregate(fns ...AggregateFunc) *VmCredentialGroupBy {
+	vcgb.fns = append(vcgb.fns, fns...)
+	return vcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (vcgb *VmCredentialGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := vcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	vcgb.sql = query
+	return vcgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := vcgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(vcgb.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := vcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) StringsX(ctx context.Context) []string {
+	v, err := vcgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = vcgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) StringX(ctx context.Context) string {
+	v, err := vcgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(vcgb.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := vcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) IntsX(ctx context.Context) []int {
+	v, err := vcgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = vcgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) IntX(ctx context.Context) int {
+	v, err := vcgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(vcgb.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := vcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := vcgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = vcgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := vcgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(vcgb.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := vcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := vcgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (vcgb *VmCredentialGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = vcgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (vcgb *VmCredentialGroupBy) BoolX(ctx context.Context) bool {
+	v, err := vcgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (vcgb *VmCredentialGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range vcgb.fields {
+		if !vmcredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := vcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (vcgb *VmCredentialGroupBy) sqlQuery() *sql.Selector {
+	selector := vcgb.sql.Select()
+	aggregation := make([]string, 0, len(vcgb.fns))
+	for _, fn := range vcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(vcgb.fields)+len(vcgb.fns))
+		for _, f := range vcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(vcgb.fields...)...)
+}
+
+// VmCredentialSelect is the builder for selecting fields of VmCredential entities.
+type VmCredentialSelect struct {
+	*VmCredentialQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vcs *VmCredentialSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := vcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	vcs.sql = vcs.VmCredentialQuery.sqlQuery(ctx)
+	return vcs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (vcs *VmCredentialSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := vcs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(vcs.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := vcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (vcs *VmCredentialSelect) StringsX(ctx context.Context) []string {
+	v, err := vcs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = vcs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (vcs *VmCredentialSelect) StringX(ctx context.Context) string {
+	v, err := vcs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(vcs.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := vcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (vcs *VmCredentialSelect) IntsX(ctx context.Context) []int {
+	v, err := vcs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = vcs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (vcs *VmCredentialSelect) IntX(ctx context.Context) int {
+	v, err := vcs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(vcs.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := vcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (vcs *VmCredentialSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := vcs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = vcs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (vcs *VmCredentialSelect) Float64X(ctx context.Context) float64 {
+	v, err := vcs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(vcs.fields) > 1 {
+		return nil, errors.New("ent: VmCredentialSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := vcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (vcs *VmCredentialSelect) BoolsX(ctx context.Context) []bool {
+	v, err := vcs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (vcs *VmCredentialSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = vcs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{vmcredential.Label}
+	default:
+		err = fmt.Errorf("ent: VmCredentialSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (vcs *VmCredentialSelect) BoolX(ctx context.Context) bool {
+	v, err := vcs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (vcs *VmCredentialSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := vcs.sql.Query()
+	if err := vcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,689 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/vmcredential"
+	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/google/uuid"
+)
+
+// VmCredentialUpdate is the builder for updating VmCredential entities.
+type VmCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VmCredentialMutation
+}
+
+// Where appends a list predicates to the VmCredentialUpdate builder.
+func (vcu *VmCredentialUpdate) Where(ps ...predicate.VmCredential) *VmCredentialUpdate {
+	vcu.mutation.Where(ps...)
+	return vcu
+}
+
+// SetProtocol sets the "protocol" field.
+func (vcu *VmCredentialUpdate) SetProtocol(v vmcredential.Protocol) *VmCredentialUpdate {
+	vcu.mutation.SetProtocol(v)
+	return vcu
+}
+
+// SetPort sets the "port" field.
+func (vcu *VmCredentialUpdate) SetPort(i int) *VmCredentialUpdate {
+	vcu.mutation.ResetPort()
+	vcu.mutation.SetPort(i)
+	return vcu
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillablePort(i *int) *VmCredentialUpdate {
+	if i != nil {
+		vcu.SetPort(*i)
+	}
+	return vcu
+}
+
+// AddPort adds i to the "port" field.
+func (vcu *VmCredentialUpdate) AddPort(i int) *VmCredentialUpdate {
+	vcu.mutation.AddPort(i)
+	return vcu
+}
+
+// SetUsername sets the "username" field.
+func (vcu *VmCredentialUpdate) SetUsername(s string) *VmCredentialUpdate {
+	vcu.mutation.SetUsername(s)
+	return vcu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillableUsername(s *string) *VmCredentialUpdate {
+	if s != nil {
+		vcu.SetUsername(*s)
+	}
+	return vcu
+}
+
+// SetPassword sets the "password" field.
+func (vcu *VmCredentialUpdate) SetPassword(s string) *VmCredentialUpdate {
+	vcu.mutation.SetPassword(s)
+	return vcu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillablePassword(s *string) *VmCredentialUpdate {
+	if s != nil {
+		vcu.SetPassword(*s)
+	}
+	return vcu
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (vcu *VmCredentialUpdate) SetPrivateKey(s string) *VmCredentialUpdate {
+	vcu.mutation.SetPrivateKey(s)
+	return vcu
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillablePrivateKey(s *string) *VmCredentialUpdate {
+	if s != nil {
+		vcu.SetPrivateKey(*s)
+	}
+	return vcu
+}
+
+// SetDomain sets the "domain" field.
+func (vcu *VmCredentialUpdate) SetDomain(s string) *VmCredentialUpdate {
+	vcu.mutation.SetDomain(s)
+	return vcu
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillableDomain(s *string) *VmCredentialUpdate {
+	if s != nil {
+		vcu.SetDomain(*s)
+	}
+	return vcu
+}
+
+// SetIgnoreCert sets the "ignore_cert" field.
+func (vcu *VmCredentialUpdate) SetIgnoreCert(b bool) *VmCredentialUpdate {
+	vcu.mutation.SetIgnoreCert(b)
+	return vcu
+}
+
+// SetNillableIgnoreCert sets the "ignore_cert" field if the given value is not nil.
+func (vcu *VmCredentialUpdate) SetNillableIgnoreCert(b *bool) *VmCredentialUpdate {
+	if b != nil {
+		vcu.SetIgnoreCert(*b)
+	}
+	return vcu
+}
+
+// SetVmCredentialToVmObjectID sets the "VmCredentialToVmObject" edge to the VmObject entity by ID.
+func (vcu *VmCredentialUpdate) SetVmCredentialToVmObjectID(id uuid.UUID) *VmCredentialUpdate {
+	vcu.mutation.SetVmCredentialToVmObjectID(id)
+	return vcu
+}
+
+// SetVmCredentialToVmObject sets the "VmCredentialToVmObject" edge to the VmObject entity.
+func (vcu *VmCredentialUpdate) SetVmCredentialToVmObject(v *VmObject) *VmCredentialUpdate {
+	return vcu.SetVmCredentialToVmObjectID(v.ID)
+}
+
+// Mutation returns the VmCredentialMutation object of the builder.
+func (vcu *VmCredentialUpdate) Mutation() *VmCredentialMutation {
+	return vcu.mutation
+}
+
+// ClearVmCredentialToVmObject clears the "VmCredentialToVmObject" edge to the VmObject entity.
+func (vcu *VmCredentialUpdate) ClearVmCredentialToVmObject() *VmCredentialUpdate {
+	vcu.mutation.ClearVmCredentialToVmObject()
+	return vcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vcu *VmCredentialUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(vcu.hooks) == 0 {
+		if err = vcu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = vcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VmCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vcu.check(); err != nil {
+				return 0, err
+			}
+			vcu.mutation = mutation
+			affected, err = vcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(vcu.hooks) - 1; i >= 0; i-- {
+			if vcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcu *VmCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := vcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vcu *VmCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := vcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcu *VmCredentialUpdate) ExecX(ctx context.Context) {
+	if err := vcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcu *VmCredentialUpdate) check() error {
+	if v, ok := vcu.mutation.Protocol(); ok {
+		if err := vmcredential.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "VmCredential.protocol": %w`, err)}
+		}
+	}
+	if _, ok := vcu.mutation.VmCredentialToVmObjectID(); vcu.mutation.VmCredentialToVmObjectCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "VmCredential.VmCredentialToVmObject"`)
+	}
+	return nil
+}
+
+func (vcu *VmCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vmcredential.Table,
+			Columns: vmcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: vmcredential.FieldID,
+			},
+		},
+	}
+	if ps := vcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcu.mutation.Protocol(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: vmcredential.FieldProtocol,
+		})
+	}
+	if value, ok := vcu.mutation.Port(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmcredential.FieldPort,
+		})
+	}
+	if value, ok := vcu.mutation.AddedPort(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmcredential.FieldPort,
+		})
+	}
+	if value, ok := vcu.mutation.Username(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldUsername,
+		})
+	}
+	if value, ok := vcu.mutation.Password(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPassword,
+		})
+	}
+	if value, ok := vcu.mutation.PrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPrivateKey,
+		})
+	}
+	if value, ok := vcu.mutation.Domain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldDomain,
+		})
+	}
+	if value, ok := vcu.mutation.IgnoreCert(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmcredential.FieldIgnoreCert,
+		})
+	}
+	if vcu.mutation.VmCredentialToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vmcredential.VmCredentialToVmObjectTable,
+			Columns: []string{vmcredential.VmCredentialToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcu.mutation.VmCredentialToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vmcredential.VmCredentialToVmObjectTable,
+			Columns: []string{vmcredential.VmCredentialToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// VmCredentialUpdateOne is the builder for updating a single VmCredential entity.
+type VmCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VmCredentialMutation
+}
+
+// SetProtocol sets the "protocol" field.
+func (vcuo *VmCredentialUpdateOne) SetProtocol(v vmcredential.Protocol) *VmCredentialUpdateOne {
+	vcuo.mutation.SetProtocol(v)
+	return vcuo
+}
+
+// SetPort sets the "port" field.
+func (vcuo *VmCredentialUpdateOne) SetPort(i int) *VmCredentialUpdateOne {
+	vcuo.mutation.ResetPort()
+	vcuo.mutation.SetPort(i)
+	return vcuo
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillablePort(i *int) *VmCredentialUpdateOne {
+	if i != nil {
+		vcuo.SetPort(*i)
+	}
+	return vcuo
+}
+
+// AddPort adds i to the "port" field.
+func (vcuo *VmCredentialUpdateOne) AddPort(i int) *VmCredentialUpdateOne {
+	vcuo.mutation.AddPort(i)
+	return vcuo
+}
+
+// SetUsername sets the "username" field.
+func (vcuo *VmCredentialUpdateOne) SetUsername(s string) *VmCredentialUpdateOne {
+	vcuo.mutation.SetUsername(s)
+	return vcuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillableUsername(s *string) *VmCredentialUpdateOne {
+	if s != nil {
+		vcuo.SetUsername(*s)
+	}
+	return vcuo
+}
+
+// SetPassword sets the "password" field.
+func (vcuo *VmCredentialUpdateOne) SetPassword(s string) *VmCredentialUpdateOne {
+	vcuo.mutation.SetPassword(s)
+	return vcuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillablePassword(s *string) *VmCredentialUpdateOne {
+	if s != nil {
+		vcuo.SetPassword(*s)
+	}
+	return vcuo
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (vcuo *VmCredentialUpdateOne) SetPrivateKey(s string) *VmCredentialUpdateOne {
+	vcuo.mutation.SetPrivateKey(s)
+	return vcuo
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillablePrivateKey(s *string) *VmCredentialUpdateOne {
+	if s != nil {
+		vcuo.SetPrivateKey(*s)
+	}
+	return vcuo
+}
+
+// SetDomain sets the "domain" field.
+func (vcuo *VmCredentialUpdateOne) SetDomain(s string) *VmCredentialUpdateOne {
+	vcuo.mutation.SetDomain(s)
+	return vcuo
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillableDomain(s *string) *VmCredentialUpdateOne {
+	if s != nil {
+		vcuo.SetDomain(*s)
+	}
+	return vcuo
+}
+
+// SetIgnoreCert sets the "ignore_cert" field.
+func (vcuo *VmCredentialUpdateOne) SetIgnoreCert(b bool) *VmCredentialUpdateOne {
+	vcuo.mutation.SetIgnoreCert(b)
+	return vcuo
+}
+
+// SetNillableIgnoreCert sets the "ignore_cert" field if the given value is not nil.
+func (vcuo *VmCredentialUpdateOne) SetNillableIgnoreCert(b *bool) *VmCredentialUpdateOne {
+	if b != nil {
+		vcuo.SetIgnoreCert(*b)
+	}
+	return vcuo
+}
+
+// SetVmCredentialToVmObjectID sets the "VmCredentialToVmObject" edge to the VmObject entity by ID.
+func (vcuo *VmCredentialUpdateOne) SetVmCredentialToVmObjectID(id uuid.UUID) *VmCredentialUpdateOne {
+	vcuo.mutation.SetVmCredentialToVmObjectID(id)
+	return vcuo
+}
+
+// SetVmCredentialToVmObject sets the "VmCredentialToVmObject" edge to the VmObject entity.
+func (vcuo *VmCredentialUpdateOne) SetVmCredentialToVmObject(v *VmObject) *VmCredentialUpdateOne {
+	return vcuo.SetVmCredentialToVmObjectID(v.ID)
+}
+
+// Mutation returns the VmCredentialMutation object of the builder.
+func (vcuo *VmCredentialUpdateOne) Mutation() *VmCredentialMutation {
+	return vcuo.mutation
+}
+
+// ClearVmCredentialToVmObject clears the "VmCredentialToVmObject" edge to the VmObject entity.
+func (vcuo *VmCredentialUpdateOne) ClearVmCredentialToVmObject() *VmCredentialUpdateOne {
+	vcuo.mutation.ClearVmCredentialToVmObject()
+	return vcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vcuo *VmCredentialUpdateOne) Select(field string, fields ...string) *VmCredentialUpdateOne {
+	vcuo.fields = append([]string{field}, fields...)
+	return vcuo
+}
+
+// Save executes the query and returns the updated VmCredential entity.
+func (vcuo *VmCredentialUpdateOne) Save(ctx context.Context) (*VmCredential, error) {
+	var (
+		err  error
+		node *VmCredential
+	)
+	if len(vcuo.hooks) == 0 {
+		if err = vcuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = vcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VmCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vcuo.check(); err != nil {
+				return nil, err
+			}
+			vcuo.mutation = mutation
+			node, err = vcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vcuo.hooks) - 1; i >= 0; i-- {
+			if vcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vcuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vcuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcuo *VmCredentialUpdateOne) SaveX(ctx context.Context) *VmCredential {
+	node, err := vcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vcuo *VmCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := vcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcuo *VmCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := vcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcuo *VmCredentialUpdateOne) check() error {
+	if v, ok := vcuo.mutation.Protocol(); ok {
+		if err := vmcredential.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "VmCredential.protocol": %w`, err)}
+		}
+	}
+	if _, ok := vcuo.mutation.VmCredentialToVmObjectID(); vcuo.mutation.VmCredentialToVmObjectCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "VmCredential.VmCredentialToVmObject"`)
+	}
+	return nil
+}
+
+func (vcuo *VmCredentialUpdateOne) sqlSave(ctx context.Context) (_node *VmCredential, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   vmcredential.Table,
+			Columns: vmcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: vmcredential.FieldID,
+			},
+		},
+	}
+	id, ok := vcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VmCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vmcredential.FieldID)
+		for _, f := range fields {
+			if !vmcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vmcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcuo.mutation.Protocol(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: vmcredential.FieldProtocol,
+		})
+	}
+	if value, ok := vcuo.mutation.Port(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmcredential.FieldPort,
+		})
+	}
+	if value, ok := vcuo.mutation.AddedPort(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmcredential.FieldPort,
+		})
+	}
+	if value, ok := vcuo.mutation.Username(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldUsername,
+		})
+	}
+	if value, ok := vcuo.mutation.Password(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPassword,
+		})
+	}
+	if value, ok := vcuo.mutation.PrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldPrivateKey,
+		})
+	}
+	if value, ok := vcuo.mutation.Domain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: vmcredential.FieldDomain,
+		})
+	}
+	if value, ok := vcuo.mutation.IgnoreCert(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmcredential.FieldIgnoreCert,
+		})
+	}
+	if vcuo.mutation.VmCredentialToVmObjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vmcredential.VmCredentialToVmObjectTable,
+			Columns: []string{vmcredential.VmCredentialToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcuo.mutation.VmCredentialToVmObjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vmcredential.VmCredentialToVmObjectTable,
+			Columns: []string{vmcredential.VmCredentialToVmObjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmobject.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VmCredential{config: vcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -30,6 +30,18 @@ type VmObject struct {
 	// Locked holds the value of the "locked" field.
 	// [REQUIRED] (default is false) If a vm is locked, standard users will not be able to access this VM.
 	Locked bool `json:"locked,omitempty"`
+	// RedTeamAccess holds the value of the "red_team_access" field.
+	// [OPTIONAL] (default is false) Whether users with the "red_team:console" permission can open consoles on this VM, regardless of their team.
+	RedTeamAccess bool `json:"red_team_access,omitempty"`
+	// ConsoleLimitPerVM holds the value of the "console_limit_per_vm" field.
+	// [OPTIONAL] Overrides the competition's console_limit_per_vm for this VM.
+	ConsoleLimitPerVM *int `json:"console_limit_per_vm,omitempty"`
+	// ConsoleLimitPerUser holds the value of the "console_limit_per_user" field.
+	// [OPTIONAL] Overrides the competition's console_limit_per_user for this VM.
+	ConsoleLimitPerUser *int `json:"console_limit_per_user,omitempty"`
+	// ConsoleLimitPerTeam holds the value of the "console_limit_per_team" field.
+	// [OPTIONAL] Overrides the competition's console_limit_per_team for this VM.
+	ConsoleLimitPerTeam *int `json:"console_limit_per_team,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VmObjectQuery when eager-loading is set.
 	Edges                   VmObjectEdges `json:"edges"`
@@ -40,9 +52,15 @@ type VmObject struct {
 type VmObjectEdges struct {
 	// VmObjectToTeam holds the value of the VmObjectToTeam edge.
 	VmObjectToTeam *Team `json:"VmObjectToTeam,omitempty"`
+	// VmObjectToConsoleSessions holds the value of the VmObjectToConsoleSessions edge.
+	VmObjectToConsoleSessions []*ConsoleSession `json:"VmObjectToConsoleSessions,omitempty"`
+	// VmObjectToVmCredentials holds the value of the VmObjectToVmCredentials edge.
+	VmObjectToVmCredentials []*VmCredential `json:"VmObjectToVmCredentials,omitempty"`
+	// VmObjectToConsoleShares holds the value of the VmObjectToConsoleShares edge.
+	VmObjectToConsoleShares []*ConsoleShare `json:"VmObjectToConsoleShares,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // VmObjectToTeamOrErr returns the VmObjectToTeam value or an error if the edge
@@ -59,6 +77,33 @@ func (e VmObjectEdges) VmObjectToTeamOrErr() (*Team, error) {
 	return nil, &NotLoadedError{edge: "VmObjectToTeam"}
 }
 
+// VmObjectToConsoleSessionsOrErr returns the VmObjectToConsoleSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e VmObjectEdges) VmObjectToConsoleSessionsOrErr() ([]*ConsoleSession, error) {
+	if e.loadedTypes[1] {
+		return e.VmObjectToConsoleSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "VmObjectToConsoleSessions"}
+}
+
+// VmObjectToVmCredentialsOrErr returns the VmObjectToVmCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e VmObjectEdges) VmObjectToVmCredentialsOrErr() ([]*VmCredential, error) {
+	if e.loadedTypes[2] {
+		return e.VmObjectToVmCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "VmObjectToVmCredentials"}
+}
+
+// VmObjectToConsoleSharesOrErr returns the VmObjectToConsoleShares value or an error if the edge
+// was not loaded in eager-loading.
+func (e VmObjectEdges) VmObjectToConsoleSharesOrErr() ([]*ConsoleShare, error) {
+	if e.loadedTypes[3] {
+		return e.VmObjectToConsoleShares, nil
+	}
+	return nil, &NotLoadedError{edge: "VmObjectToConsoleShares"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*VmObject) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -66,8 +111,10 @@ func (*VmObject) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case vmobject.FieldIPAddresses:
 			values[i] = new([]byte)
-		case vmobject.FieldLocked:
+		case vmobject.FieldLocked, vmobject.FieldRedTeamAccess:
 			values[i] = new(sql.NullBool)
+		case vmobject.FieldConsoleLimitPerVM, vmobject.FieldConsoleLimitPerUser, vmobject.FieldConsoleLimitPerTeam:
+			values[i] = new(sql.NullInt64)
 		case vmobject.FieldName, vmobject.FieldIdentifier:
 			values[i] = new(sql.NullString)
 		case vmobject.FieldID:
@@ -121,6 +168,33 @@ func (vo *VmObject) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				vo.Locked = value.Bool
 			}
+		case vmobject.FieldRedTeamAccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field red_team_access", values[i])
+			} else if value.Valid {
+				vo.RedTeamAccess = value.Bool
+			}
+		case vmobject.FieldConsoleLimitPerVM:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_vm", values[i])
+			} else if value.Valid {
+				vo.ConsoleLimitPerVM = new(int)
+				*vo.ConsoleLimitPerVM = int(value.Int64)
+			}
+		case vmobject.FieldConsoleLimitPerUser:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_user", values[i])
+			} else if value.Valid {
+				vo.ConsoleLimitPerUser = new(int)
+				*vo.ConsoleLimitPerUser = int(value.Int64)
+			}
+		case vmobject.FieldConsoleLimitPerTeam:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field console_limit_per_team", values[i])
+			} else if value.Valid {
+				vo.ConsoleLimitPerTeam = new(int)
+				*vo.ConsoleLimitPerTeam = int(value.Int64)
+			}
 		case vmobject.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field team_team_to_vm_objects", values[i])
@@ -138,6 +212,21 @@ func (vo *VmObject) QueryVmObjectToTeam() *TeamQuery {
 	return (&VmObjectClient{config: vo.config}).QueryVmObjectToTeam(vo)
 }
 
+// QueryVmObjectToConsoleSessions queries the "VmObjectToConsoleSessions" edge of the VmObject entity.
+func (vo *VmObject) QueryVmObjectToConsoleSessions() *ConsoleSessionQuery {
+	return (&VmObjectClient{config: vo.config}).QueryVmObjectToConsoleSessions(vo)
+}
+
+// QueryVmObjectToVmCredentials queries the "VmObjectToVmCredentials" edge of the VmObject entity.
+func (vo *VmObject) QueryVmObjectToVmCredentials() *VmCredentialQuery {
+	return (&VmObjectClient{config: vo.config}).QueryVmObjectToVmCredentials(vo)
+}
+
+// QueryVmObjectToConsoleShares queries the "VmObjectToConsoleShares" edge of the VmObject entity.
+func (vo *VmObject) QueryVmObjectToConsoleShares() *ConsoleShareQuery {
+	return (&VmObjectClient{config: vo.config}).QueryVmObjectToConsoleShares(vo)
+}
+
 // Update returns a builder for updating this VmObject.
 // Note that you need to call VmObject.Unwrap() before calling this method if this VmObject
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -169,6 +258,20 @@ func (vo *VmObject) String() string {
 	builder.WriteString(fmt.Sprintf("%v", vo.IPAddresses))
 	builder.WriteString(", locked=")
 	builder.WriteString(fmt.Sprintf("%v", vo.Locked))
+	builder.WriteString(", red_team_access=")
+	builder.WriteString(fmt.Sprintf("%v", vo.RedTeamAccess))
+	if v := vo.ConsoleLimitPerVM; v != nil {
+		builder.WriteString(", console_limit_per_vm=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := vo.ConsoleLimitPerUser; v != nil {
+		builder.WriteString(", console_limit_per_user=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := vo.ConsoleLimitPerTeam; v != nil {
+		builder.WriteString(", console_limit_per_team=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package vmobject
 
 import (
+	"entgo.io/ent"
 	"github.com/google/uuid"
 )
 
@@ -19,8 +20,22 @@ const (
 	FieldIPAddresses = "ip_addresses"
 	// FieldLocked holds the string denoting the locked field in the database.
 	FieldLocked = "locked"
+	// FieldRedTeamAccess holds the string denoting the red_team_access field in the database.
+	FieldRedTeamAccess = "red_team_access"
+	// FieldConsoleLimitPerVM holds the string denoting the console_limit_per_vm field in the database.
+	FieldConsoleLimitPerVM = "console_limit_per_vm"
+	// FieldConsoleLimitPerUser holds the string denoting the console_limit_per_user field in the database.
+	FieldConsoleLimitPerUser = "console_limit_per_user"
+	// FieldConsoleLimitPerTeam holds the string denoting the console_limit_per_team field in the database.
+	FieldConsoleLimitPerTeam = "console_limit_per_team"
 	// EdgeVmObjectToTeam holds the string denoting the vmobjecttoteam edge name in mutations.
 	EdgeVmObjectToTeam = "VmObjectToTeam"
+	// EdgeVmObjectToConsoleSessions holds the string denoting the vmobjecttoconsolesessions edge name in mutations.
+	EdgeVmObjectToConsoleSessions = "VmObjectToConsoleSessions"
+	// EdgeVmObjectToVmCredentials holds the string denoting the vmobjecttovmcredentials edge name in mutations.
+	EdgeVmObjectToVmCredentials = "VmObjectToVmCredentials"
+	// EdgeVmObjectToConsoleShares holds the string denoting the vmobjecttoconsoleshares edge name in mutations.
+	EdgeVmObjectToConsoleShares = "VmObjectToConsoleShares"
 	// Table holds the table name of the vmobject in the database.
 	Table = "vm_objects"
 	// VmObjectToTeamTable is the table that holds the VmObjectToTeam relation/edge.
@@ -30,6 +45,27 @@ const (
 	VmObjectToTeamInverseTable = "teams"
 	// VmObjectToTeamColumn is the table column denoting the VmObjectToTeam relation/edge.
 	VmObjectToTeamColumn = "team_team_to_vm_objects"
+	// VmObjectToConsoleSessionsTable is the table that holds the VmObjectToConsoleSessions relation/edge.
+	VmObjectToConsoleSessionsTable = "console_sessions"
+	// VmObjectToConsoleSessionsInverseTable is the table name for the ConsoleSession entity.
+	// It exists in this package in order to avoid circular dependency with the "consolesession" package.
+	VmObjectToConsoleSessionsInverseTable = "console_sessions"
+	// VmObjectToConsoleSessionsColumn is the table column denoting the VmObjectToConsoleSessions relation/edge.
+	VmObjectToConsoleSessionsColumn = "vm_object_vm_object_to_console_sessions"
+	// VmObjectToVmCredentialsTable is the table that holds the VmObjectToVmCredentials relation/edge.
+	VmObjectToVmCredentialsTable = "vm_credentials"
+	// VmObjectToVmCredentialsInverseTable is the table name for the VmCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "vmcredential" package.
+	VmObjectToVmCredentialsInverseTable = "vm_credentials"
+	// VmObjectToVmCredentialsColumn is the table column denoting the VmObjectToVmCredentials relation/edge.
+	VmObjectToVmCredentialsColumn = "vm_object_vm_object_to_vm_credentials"
+	// VmObjectToConsoleSharesTable is the table that holds the VmObjectToConsoleShares relation/edge.
+	VmObjectToConsoleSharesTable = "console_shares"
+	// VmObjectToConsoleSharesInverseTable is the table name for the ConsoleShare entity.
+	// It exists in this package in order to avoid circular dependency with the "consoleshare" package.
+	VmObjectToConsoleSharesInverseTable = "console_shares"
+	// VmObjectToConsoleSharesColumn is the table column denoting the VmObjectToConsoleShares relation/edge.
+	VmObjectToConsoleSharesColumn = "vm_object_vm_object_to_console_shares"
 )
 
 // Columns holds all SQL columns for vmobject fields.
@@ -39,6 +75,10 @@ var Columns = []string{
 	FieldIdentifier,
 	FieldIPAddresses,
 	FieldLocked,
+	FieldRedTeamAccess,
+	FieldConsoleLimitPerVM,
+	FieldConsoleLimitPerUser,
+	FieldConsoleLimitPerTeam,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "vm_objects"
@@ -62,9 +102,24 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/BradHacker/compsole/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultLocked holds the default value on creation for the "locked" field.
 	DefaultLocked bool
+	// DefaultRedTeamAccess holds the default value on creation for the "red_team_access" field.
+	DefaultRedTeamAccess bool
+	// ConsoleLimitPerVMValidator is a validator for the "console_limit_per_vm" field. It is called by the builders before save.
+	ConsoleLimitPerVMValidator func(int) error
+	// ConsoleLimitPerUserValidator is a validator for the "console_limit_per_user" field. It is called by the builders before save.
+	ConsoleLimitPerUserValidator func(int) error
+	// ConsoleLimitPerTeamValidator is a validator for the "console_limit_per_team" field. It is called by the builders before save.
+	ConsoleLimitPerTeamValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
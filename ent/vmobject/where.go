@@ -113,6 +113,34 @@ func Locked(v bool) predicate.VmObject {
 	})
 }
 
+// RedTeamAccess applies equality check predicate on the "red_team_access" field. It's identical to RedTeamAccessEQ.
+func RedTeamAccess(v bool) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRedTeamAccess), v))
+	})
+}
+
+// ConsoleLimitPerVM applies equality check predicate on the "console_limit_per_vm" field. It's identical to ConsoleLimitPerVMEQ.
+func ConsoleLimitPerVM(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerUser applies equality check predicate on the "console_limit_per_user" field. It's identical to ConsoleLimitPerUserEQ.
+func ConsoleLimitPerUser(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerTeam applies equality check predicate on the "console_limit_per_team" field. It's identical to ConsoleLimitPerTeamEQ.
+func ConsoleLimitPerTeam(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.VmObject {
 	return predicate.VmObject(func(s *sql.Selector) {
@@ -363,6 +391,290 @@ func LockedNEQ(v bool) predicate.VmObject {
 	})
 }
 
+// RedTeamAccessEQ applies the EQ predicate on the "red_team_access" field.
+func RedTeamAccessEQ(v bool) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRedTeamAccess), v))
+	})
+}
+
+// RedTeamAccessNEQ applies the NEQ predicate on the "red_team_access" field.
+func RedTeamAccessNEQ(v bool) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRedTeamAccess), v))
+	})
+}
+
+// ConsoleLimitPerVMEQ applies the EQ predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMNEQ applies the NEQ predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMNEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMIn applies the In predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerVM), v...))
+	})
+}
+
+// ConsoleLimitPerVMNotIn applies the NotIn predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMNotIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerVM), v...))
+	})
+}
+
+// ConsoleLimitPerVMGT applies the GT predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMGT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMGTE applies the GTE predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMGTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMLT applies the LT predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMLT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMLTE applies the LTE predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMLTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerVM), v))
+	})
+}
+
+// ConsoleLimitPerVMIsNil applies the IsNil predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMIsNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConsoleLimitPerVM)))
+	})
+}
+
+// ConsoleLimitPerVMNotNil applies the NotNil predicate on the "console_limit_per_vm" field.
+func ConsoleLimitPerVMNotNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConsoleLimitPerVM)))
+	})
+}
+
+// ConsoleLimitPerUserEQ applies the EQ predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserNEQ applies the NEQ predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserNEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserIn applies the In predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerUser), v...))
+	})
+}
+
+// ConsoleLimitPerUserNotIn applies the NotIn predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserNotIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerUser), v...))
+	})
+}
+
+// ConsoleLimitPerUserGT applies the GT predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserGT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserGTE applies the GTE predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserGTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserLT applies the LT predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserLT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserLTE applies the LTE predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserLTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerUser), v))
+	})
+}
+
+// ConsoleLimitPerUserIsNil applies the IsNil predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserIsNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConsoleLimitPerUser)))
+	})
+}
+
+// ConsoleLimitPerUserNotNil applies the NotNil predicate on the "console_limit_per_user" field.
+func ConsoleLimitPerUserNotNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConsoleLimitPerUser)))
+	})
+}
+
+// ConsoleLimitPerTeamEQ applies the EQ predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamNEQ applies the NEQ predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamNEQ(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamIn applies the In predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConsoleLimitPerTeam), v...))
+	})
+}
+
+// ConsoleLimitPerTeamNotIn applies the NotIn predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamNotIn(vs ...int) predicate.VmObject {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VmObject(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConsoleLimitPerTeam), v...))
+	})
+}
+
+// ConsoleLimitPerTeamGT applies the GT predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamGT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamGTE applies the GTE predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamGTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamLT applies the LT predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamLT(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamLTE applies the LTE predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamLTE(v int) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConsoleLimitPerTeam), v))
+	})
+}
+
+// ConsoleLimitPerTeamIsNil applies the IsNil predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamIsNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConsoleLimitPerTeam)))
+	})
+}
+
+// ConsoleLimitPerTeamNotNil applies the NotNil predicate on the "console_limit_per_team" field.
+func ConsoleLimitPerTeamNotNil() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConsoleLimitPerTeam)))
+	})
+}
+
 // HasVmObjectToTeam applies the HasEdge predicate on the "VmObjectToTeam" edge.
 func HasVmObjectToTeam() predicate.VmObject {
 	return predicate.VmObject(func(s *sql.Selector) {
@@ -391,6 +703,90 @@ func HasVmObjectToTeamWith(preds ...predicate.Team) predicate.VmObject {
 	})
 }
 
+// HasVmObjectToConsoleSessions applies the HasEdge predicate on the "VmObjectToConsoleSessions" edge.
+func HasVmObjectToConsoleSessions() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToConsoleSessionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToConsoleSessionsTable, VmObjectToConsoleSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVmObjectToConsoleSessionsWith applies the HasEdge predicate on the "VmObjectToConsoleSessions" edge with a given conditions (other predicates).
+func HasVmObjectToConsoleSessionsWith(preds ...predicate.ConsoleSession) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToConsoleSessionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToConsoleSessionsTable, VmObjectToConsoleSessionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVmObjectToVmCredentials applies the HasEdge predicate on the "VmObjectToVmCredentials" edge.
+func HasVmObjectToVmCredentials() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToVmCredentialsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToVmCredentialsTable, VmObjectToVmCredentialsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVmObjectToVmCredentialsWith applies the HasEdge predicate on the "VmObjectToVmCredentials" edge with a given conditions (other predicates).
+func HasVmObjectToVmCredentialsWith(preds ...predicate.VmCredential) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToVmCredentialsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToVmCredentialsTable, VmObjectToVmCredentialsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVmObjectToConsoleShares applies the HasEdge predicate on the "VmObjectToConsoleShares" edge.
+func HasVmObjectToConsoleShares() predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToConsoleSharesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToConsoleSharesTable, VmObjectToConsoleSharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVmObjectToConsoleSharesWith applies the HasEdge predicate on the "VmObjectToConsoleShares" edge with a given conditions (other predicates).
+func HasVmObjectToConsoleSharesWith(preds ...predicate.ConsoleShare) predicate.VmObject {
+	return predicate.VmObject(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VmObjectToConsoleSharesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VmObjectToConsoleSharesTable, VmObjectToConsoleSharesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.VmObject) predicate.VmObject {
 	return predicate.VmObject(func(s *sql.Selector) {
@@ -9,7 +9,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
 )
@@ -53,6 +56,62 @@ func (voc *VmObjectCreate) SetNillableLocked(b *bool) *VmObjectCreate {
 	return voc
 }
 
+// SetRedTeamAccess sets the "red_team_access" field.
+func (voc *VmObjectCreate) SetRedTeamAccess(b bool) *VmObjectCreate {
+	voc.mutation.SetRedTeamAccess(b)
+	return voc
+}
+
+// SetNillableRedTeamAccess sets the "red_team_access" field if the given value is not nil.
+func (voc *VmObjectCreate) SetNillableRedTeamAccess(b *bool) *VmObjectCreate {
+	if b != nil {
+		voc.SetRedTeamAccess(*b)
+	}
+	return voc
+}
+
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (voc *VmObjectCreate) SetConsoleLimitPerVM(i int) *VmObjectCreate {
+	voc.mutation.SetConsoleLimitPerVM(i)
+	return voc
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (voc *VmObjectCreate) SetNillableConsoleLimitPerVM(i *int) *VmObjectCreate {
+	if i != nil {
+		voc.SetConsoleLimitPerVM(*i)
+	}
+	return voc
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (voc *VmObjectCreate) SetConsoleLimitPerUser(i int) *VmObjectCreate {
+	voc.mutation.SetConsoleLimitPerUser(i)
+	return voc
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (voc *VmObjectCreate) SetNillableConsoleLimitPerUser(i *int) *VmObjectCreate {
+	if i != nil {
+		voc.SetConsoleLimitPerUser(*i)
+	}
+	return voc
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (voc *VmObjectCreate) SetConsoleLimitPerTeam(i int) *VmObjectCreate {
+	voc.mutation.SetConsoleLimitPerTeam(i)
+	return voc
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (voc *VmObjectCreate) SetNillableConsoleLimitPerTeam(i *int) *VmObjectCreate {
+	if i != nil {
+		voc.SetConsoleLimitPerTeam(*i)
+	}
+	return voc
+}
+
 // SetID sets the "id" field.
 func (voc *VmObjectCreate) SetID(u uuid.UUID) *VmObjectCreate {
 	voc.mutation.SetID(u)
@@ -86,6 +145,51 @@ func (voc *VmObjectCreate) SetVmObjectToTeam(t *Team) *VmObjectCreate {
 	return voc.SetVmObjectToTeamID(t.ID)
 }
 
+// AddVmObjectToConsoleSessionIDs adds the "VmObjectToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (voc *VmObjectCreate) AddVmObjectToConsoleSessionIDs(ids ...uuid.UUID) *VmObjectCreate {
+	voc.mutation.AddVmObjectToConsoleSessionIDs(ids...)
+	return voc
+}
+
+// AddVmObjectToConsoleSessions adds the "VmObjectToConsoleSessions" edges to the ConsoleSession entity.
+func (voc *VmObjectCreate) AddVmObjectToConsoleSessions(c ...*ConsoleSession) *VmObjectCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return voc.AddVmObjectToConsoleSessionIDs(ids...)
+}
+
+// AddVmObjectToVmCredentialIDs adds the "VmObjectToVmCredentials" edge to the VmCredential entity by IDs.
+func (voc *VmObjectCreate) AddVmObjectToVmCredentialIDs(ids ...uuid.UUID) *VmObjectCreate {
+	voc.mutation.AddVmObjectToVmCredentialIDs(ids...)
+	return voc
+}
+
+// AddVmObjectToVmCredentials adds the "VmObjectToVmCredentials" edges to the VmCredential entity.
+func (voc *VmObjectCreate) AddVmObjectToVmCredentials(v ...*VmCredential) *VmObjectCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return voc.AddVmObjectToVmCredentialIDs(ids...)
+}
+
+// AddVmObjectToConsoleShareIDs adds the "VmObjectToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (voc *VmObjectCreate) AddVmObjectToConsoleShareIDs(ids ...uuid.UUID) *VmObjectCreate {
+	voc.mutation.AddVmObjectToConsoleShareIDs(ids...)
+	return voc
+}
+
+// AddVmObjectToConsoleShares adds the "VmObjectToConsoleShares" edges to the ConsoleShare entity.
+func (voc *VmObjectCreate) AddVmObjectToConsoleShares(c ...*ConsoleShare) *VmObjectCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return voc.AddVmObjectToConsoleShareIDs(ids...)
+}
+
 // Mutation returns the VmObjectMutation object of the builder.
 func (voc *VmObjectCreate) Mutation() *VmObjectMutation {
 	return voc.mutation
@@ -97,7 +201,9 @@ func (voc *VmObjectCreate) Save(ctx context.Context) (*VmObject, error) {
 		err  error
 		node *VmObject
 	)
-	voc.defaults()
+	if err := voc.defaults(); err != nil {
+		return nil, err
+	}
 	if len(voc.hooks) == 0 {
 		if err = voc.check(); err != nil {
 			return nil, err
@@ -156,15 +262,23 @@ func (voc *VmObjectCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (voc *VmObjectCreate) defaults() {
+func (voc *VmObjectCreate) defaults() error {
 	if _, ok := voc.mutation.Locked(); !ok {
 		v := vmobject.DefaultLocked
 		voc.mutation.SetLocked(v)
 	}
+	if _, ok := voc.mutation.RedTeamAccess(); !ok {
+		v := vmobject.DefaultRedTeamAccess
+		voc.mutation.SetRedTeamAccess(v)
+	}
 	if _, ok := voc.mutation.ID(); !ok {
+		if vmobject.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized vmobject.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := vmobject.DefaultID()
 		voc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -178,6 +292,24 @@ func (voc *VmObjectCreate) check() error {
 	if _, ok := voc.mutation.Locked(); !ok {
 		return &ValidationError{Name: "locked", err: errors.New(`ent: missing required field "VmObject.locked"`)}
 	}
+	if _, ok := voc.mutation.RedTeamAccess(); !ok {
+		return &ValidationError{Name: "red_team_access", err: errors.New(`ent: missing required field "VmObject.red_team_access"`)}
+	}
+	if v, ok := voc.mutation.ConsoleLimitPerVM(); ok {
+		if err := vmobject.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if v, ok := voc.mutation.ConsoleLimitPerUser(); ok {
+		if err := vmobject.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_user": %w`, err)}
+		}
+	}
+	if v, ok := voc.mutation.ConsoleLimitPerTeam(); ok {
+		if err := vmobject.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_team": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -246,6 +378,38 @@ func (voc *VmObjectCreate) createSpec() (*VmObject, *sqlgraph.CreateSpec) {
 		})
 		_node.Locked = value
 	}
+	if value, ok := voc.mutation.RedTeamAccess(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmobject.FieldRedTeamAccess,
+		})
+		_node.RedTeamAccess = value
+	}
+	if value, ok := voc.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+		_node.ConsoleLimitPerVM = &value
+	}
+	if value, ok := voc.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+		_node.ConsoleLimitPerUser = &value
+	}
+	if value, ok := voc.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+		_node.ConsoleLimitPerTeam = &value
+	}
 	if nodes := voc.mutation.VmObjectToTeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -266,6 +430,63 @@ func (voc *VmObjectCreate) createSpec() (*VmObject, *sqlgraph.CreateSpec) {
 		_node.team_team_to_vm_objects = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := voc.mutation.VmObjectToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := voc.mutation.VmObjectToVmCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := voc.mutation.VmObjectToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -11,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
 )
@@ -27,8 +31,11 @@ type VmObjectQuery struct {
 	fields     []string
 	predicates []predicate.VmObject
 	// eager-loading edges.
-	withVmObjectToTeam *TeamQuery
-	withFKs            bool
+	withVmObjectToTeam            *TeamQuery
+	withVmObjectToConsoleSessions *ConsoleSessionQuery
+	withVmObjectToVmCredentials   *VmCredentialQuery
+	withVmObjectToConsoleShares   *ConsoleShareQuery
+	withFKs                       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +94,72 @@ func (voq *VmObjectQuery) QueryVmObjectToTeam() *TeamQuery {
 	return query
 }
 
+// QueryVmObjectToConsoleSessions chains the current query on the "VmObjectToConsoleSessions" edge.
+func (voq *VmObjectQuery) QueryVmObjectToConsoleSessions() *ConsoleSessionQuery {
+	query := &ConsoleSessionQuery{config: voq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := voq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := voq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, selector),
+			sqlgraph.To(consolesession.Table, consolesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToConsoleSessionsTable, vmobject.VmObjectToConsoleSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(voq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVmObjectToVmCredentials chains the current query on the "VmObjectToVmCredentials" edge.
+func (voq *VmObjectQuery) QueryVmObjectToVmCredentials() *VmCredentialQuery {
+	query := &VmCredentialQuery{config: voq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := voq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := voq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, selector),
+			sqlgraph.To(vmcredential.Table, vmcredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToVmCredentialsTable, vmobject.VmObjectToVmCredentialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(voq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVmObjectToConsoleShares chains the current query on the "VmObjectToConsoleShares" edge.
+func (voq *VmObjectQuery) QueryVmObjectToConsoleShares() *ConsoleShareQuery {
+	query := &ConsoleShareQuery{config: voq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := voq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := voq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vmobject.Table, vmobject.FieldID, selector),
+			sqlgraph.To(consoleshare.Table, consoleshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vmobject.VmObjectToConsoleSharesTable, vmobject.VmObjectToConsoleSharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(voq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first VmObject entity from the query.
 // Returns a *NotFoundError when no VmObject was found.
 func (voq *VmObjectQuery) First(ctx context.Context) (*VmObject, error) {
@@ -263,12 +336,15 @@ func (voq *VmObjectQuery) Clone() *VmObjectQuery {
 		return nil
 	}
 	return &VmObjectQuery{
-		config:             voq.config,
-		limit:              voq.limit,
-		offset:             voq.offset,
-		order:              append([]OrderFunc{}, voq.order...),
-		predicates:         append([]predicate.VmObject{}, voq.predicates...),
-		withVmObjectToTeam: voq.withVmObjectToTeam.Clone(),
+		config:                        voq.config,
+		limit:                         voq.limit,
+		offset:                        voq.offset,
+		order:                         append([]OrderFunc{}, voq.order...),
+		predicates:                    append([]predicate.VmObject{}, voq.predicates...),
+		withVmObjectToTeam:            voq.withVmObjectToTeam.Clone(),
+		withVmObjectToConsoleSessions: voq.withVmObjectToConsoleSessions.Clone(),
+		withVmObjectToVmCredentials:   voq.withVmObjectToVmCredentials.Clone(),
+		withVmObjectToConsoleShares:   voq.withVmObjectToConsoleShares.Clone(),
 		// clone intermediate query.
 		sql:    voq.sql.Clone(),
 		path:   voq.path,
@@ -287,6 +363,39 @@ func (voq *VmObjectQuery) WithVmObjectToTeam(opts ...func(*TeamQuery)) *VmObject
 	return voq
 }
 
+// WithVmObjectToConsoleSessions tells the query-builder to eager-load the nodes that are connected to
+// the "VmObjectToConsoleSessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (voq *VmObjectQuery) WithVmObjectToConsoleSessions(opts ...func(*ConsoleSessionQuery)) *VmObjectQuery {
+	query := &ConsoleSessionQuery{config: voq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	voq.withVmObjectToConsoleSessions = query
+	return voq
+}
+
+// WithVmObjectToVmCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "VmObjectToVmCredentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (voq *VmObjectQuery) WithVmObjectToVmCredentials(opts ...func(*VmCredentialQuery)) *VmObjectQuery {
+	query := &VmCredentialQuery{config: voq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	voq.withVmObjectToVmCredentials = query
+	return voq
+}
+
+// WithVmObjectToConsoleShares tells the query-builder to eager-load the nodes that are connected to
+// the "VmObjectToConsoleShares" edge. The optional arguments are used to configure the query builder of the edge.
+func (voq *VmObjectQuery) WithVmObjectToConsoleShares(opts ...func(*ConsoleShareQuery)) *VmObjectQuery {
+	query := &ConsoleShareQuery{config: voq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	voq.withVmObjectToConsoleShares = query
+	return voq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -343,6 +452,12 @@ func (voq *VmObjectQuery) prepareQuery(ctx context.Context) error {
 		}
 		voq.sql = prev
 	}
+	if vmobject.Policy == nil {
+		return errors.New("ent: uninitialized vmobject.Policy (forgotten import ent/runtime?)")
+	}
+	if err := vmobject.Policy.EvalQuery(ctx, voq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -351,8 +466,11 @@ func (voq *VmObjectQuery) sqlAll(ctx context.Context) ([]*VmObject, error) {
 		nodes       = []*VmObject{}
 		withFKs     = voq.withFKs
 		_spec       = voq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			voq.withVmObjectToTeam != nil,
+			voq.withVmObjectToConsoleSessions != nil,
+			voq.withVmObjectToVmCredentials != nil,
+			voq.withVmObjectToConsoleShares != nil,
 		}
 	)
 	if voq.withVmObjectToTeam != nil {
@@ -410,6 +528,93 @@ func (voq *VmObjectQuery) sqlAll(ctx context.Context) ([]*VmObject, error) {
 		}
 	}
 
+	if query := voq.withVmObjectToConsoleSessions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*VmObject)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.VmObjectToConsoleSessions = []*ConsoleSession{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ConsoleSession(func(s *sql.Selector) {
+			s.Where(sql.InValues(vmobject.VmObjectToConsoleSessionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.vm_object_vm_object_to_console_sessions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "vm_object_vm_object_to_console_sessions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_console_sessions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.VmObjectToConsoleSessions = append(node.Edges.VmObjectToConsoleSessions, n)
+		}
+	}
+
+	if query := voq.withVmObjectToVmCredentials; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*VmObject)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.VmObjectToVmCredentials = []*VmCredential{}
+		}
+		query.withFKs = true
+		query.Where(predicate.VmCredential(func(s *sql.Selector) {
+			s.Where(sql.InValues(vmobject.VmObjectToVmCredentialsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.vm_object_vm_object_to_vm_credentials
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "vm_object_vm_object_to_vm_credentials" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_vm_credentials" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.VmObjectToVmCredentials = append(node.Edges.VmObjectToVmCredentials, n)
+		}
+	}
+
+	if query := voq.withVmObjectToConsoleShares; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*VmObject)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.VmObjectToConsoleShares = []*ConsoleShare{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ConsoleShare(func(s *sql.Selector) {
+			s.Where(sql.InValues(vmobject.VmObjectToConsoleSharesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.vm_object_vm_object_to_console_shares
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "vm_object_vm_object_to_console_shares" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "vm_object_vm_object_to_console_shares" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.VmObjectToConsoleShares = append(node.Edges.VmObjectToConsoleShares, n)
+		}
+	}
+
 	return nodes, nil
 }
 
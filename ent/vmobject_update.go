@@ -10,8 +10,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
 	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
 	"github.com/google/uuid"
 )
@@ -67,6 +70,101 @@ func (vou *VmObjectUpdate) SetNillableLocked(b *bool) *VmObjectUpdate {
 	return vou
 }
 
+// SetRedTeamAccess sets the "red_team_access" field.
+func (vou *VmObjectUpdate) SetRedTeamAccess(b bool) *VmObjectUpdate {
+	vou.mutation.SetRedTeamAccess(b)
+	return vou
+}
+
+// SetNillableRedTeamAccess sets the "red_team_access" field if the given value is not nil.
+func (vou *VmObjectUpdate) SetNillableRedTeamAccess(b *bool) *VmObjectUpdate {
+	if b != nil {
+		vou.SetRedTeamAccess(*b)
+	}
+	return vou
+}
+
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (vou *VmObjectUpdate) SetConsoleLimitPerVM(i int) *VmObjectUpdate {
+	vou.mutation.ResetConsoleLimitPerVM()
+	vou.mutation.SetConsoleLimitPerVM(i)
+	return vou
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (vou *VmObjectUpdate) SetNillableConsoleLimitPerVM(i *int) *VmObjectUpdate {
+	if i != nil {
+		vou.SetConsoleLimitPerVM(*i)
+	}
+	return vou
+}
+
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (vou *VmObjectUpdate) AddConsoleLimitPerVM(i int) *VmObjectUpdate {
+	vou.mutation.AddConsoleLimitPerVM(i)
+	return vou
+}
+
+// ClearConsoleLimitPerVM clears the value of the "console_limit_per_vm" field.
+func (vou *VmObjectUpdate) ClearConsoleLimitPerVM() *VmObjectUpdate {
+	vou.mutation.ClearConsoleLimitPerVM()
+	return vou
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (vou *VmObjectUpdate) SetConsoleLimitPerUser(i int) *VmObjectUpdate {
+	vou.mutation.ResetConsoleLimitPerUser()
+	vou.mutation.SetConsoleLimitPerUser(i)
+	return vou
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (vou *VmObjectUpdate) SetNillableConsoleLimitPerUser(i *int) *VmObjectUpdate {
+	if i != nil {
+		vou.SetConsoleLimitPerUser(*i)
+	}
+	return vou
+}
+
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (vou *VmObjectUpdate) AddConsoleLimitPerUser(i int) *VmObjectUpdate {
+	vou.mutation.AddConsoleLimitPerUser(i)
+	return vou
+}
+
+// ClearConsoleLimitPerUser clears the value of the "console_limit_per_user" field.
+func (vou *VmObjectUpdate) ClearConsoleLimitPerUser() *VmObjectUpdate {
+	vou.mutation.ClearConsoleLimitPerUser()
+	return vou
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (vou *VmObjectUpdate) SetConsoleLimitPerTeam(i int) *VmObjectUpdate {
+	vou.mutation.ResetConsoleLimitPerTeam()
+	vou.mutation.SetConsoleLimitPerTeam(i)
+	return vou
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (vou *VmObjectUpdate) SetNillableConsoleLimitPerTeam(i *int) *VmObjectUpdate {
+	if i != nil {
+		vou.SetConsoleLimitPerTeam(*i)
+	}
+	return vou
+}
+
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (vou *VmObjectUpdate) AddConsoleLimitPerTeam(i int) *VmObjectUpdate {
+	vou.mutation.AddConsoleLimitPerTeam(i)
+	return vou
+}
+
+// ClearConsoleLimitPerTeam clears the value of the "console_limit_per_team" field.
+func (vou *VmObjectUpdate) ClearConsoleLimitPerTeam() *VmObjectUpdate {
+	vou.mutation.ClearConsoleLimitPerTeam()
+	return vou
+}
+
 // SetVmObjectToTeamID sets the "VmObjectToTeam" edge to the Team entity by ID.
 func (vou *VmObjectUpdate) SetVmObjectToTeamID(id uuid.UUID) *VmObjectUpdate {
 	vou.mutation.SetVmObjectToTeamID(id)
@@ -86,6 +184,51 @@ func (vou *VmObjectUpdate) SetVmObjectToTeam(t *Team) *VmObjectUpdate {
 	return vou.SetVmObjectToTeamID(t.ID)
 }
 
+// AddVmObjectToConsoleSessionIDs adds the "VmObjectToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (vou *VmObjectUpdate) AddVmObjectToConsoleSessionIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.AddVmObjectToConsoleSessionIDs(ids...)
+	return vou
+}
+
+// AddVmObjectToConsoleSessions adds the "VmObjectToConsoleSessions" edges to the ConsoleSession entity.
+func (vou *VmObjectUpdate) AddVmObjectToConsoleSessions(c ...*ConsoleSession) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vou.AddVmObjectToConsoleSessionIDs(ids...)
+}
+
+// AddVmObjectToVmCredentialIDs adds the "VmObjectToVmCredentials" edge to the VmCredential entity by IDs.
+func (vou *VmObjectUpdate) AddVmObjectToVmCredentialIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.AddVmObjectToVmCredentialIDs(ids...)
+	return vou
+}
+
+// AddVmObjectToVmCredentials adds the "VmObjectToVmCredentials" edges to the VmCredential entity.
+func (vou *VmObjectUpdate) AddVmObjectToVmCredentials(v ...*VmCredential) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vou.AddVmObjectToVmCredentialIDs(ids...)
+}
+
+// AddVmObjectToConsoleShareIDs adds the "VmObjectToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (vou *VmObjectUpdate) AddVmObjectToConsoleShareIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.AddVmObjectToConsoleShareIDs(ids...)
+	return vou
+}
+
+// AddVmObjectToConsoleShares adds the "VmObjectToConsoleShares" edges to the ConsoleShare entity.
+func (vou *VmObjectUpdate) AddVmObjectToConsoleShares(c ...*ConsoleShare) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vou.AddVmObjectToConsoleShareIDs(ids...)
+}
+
 // Mutation returns the VmObjectMutation object of the builder.
 func (vou *VmObjectUpdate) Mutation() *VmObjectMutation {
 	return vou.mutation
@@ -97,6 +240,69 @@ func (vou *VmObjectUpdate) ClearVmObjectToTeam() *VmObjectUpdate {
 	return vou
 }
 
+// ClearVmObjectToConsoleSessions clears all "VmObjectToConsoleSessions" edges to the ConsoleSession entity.
+func (vou *VmObjectUpdate) ClearVmObjectToConsoleSessions() *VmObjectUpdate {
+	vou.mutation.ClearVmObjectToConsoleSessions()
+	return vou
+}
+
+// RemoveVmObjectToConsoleSessionIDs removes the "VmObjectToConsoleSessions" edge to ConsoleSession entities by IDs.
+func (vou *VmObjectUpdate) RemoveVmObjectToConsoleSessionIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.RemoveVmObjectToConsoleSessionIDs(ids...)
+	return vou
+}
+
+// RemoveVmObjectToConsoleSessions removes "VmObjectToConsoleSessions" edges to ConsoleSession entities.
+func (vou *VmObjectUpdate) RemoveVmObjectToConsoleSessions(c ...*ConsoleSession) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vou.RemoveVmObjectToConsoleSessionIDs(ids...)
+}
+
+// ClearVmObjectToVmCredentials clears all "VmObjectToVmCredentials" edges to the VmCredential entity.
+func (vou *VmObjectUpdate) ClearVmObjectToVmCredentials() *VmObjectUpdate {
+	vou.mutation.ClearVmObjectToVmCredentials()
+	return vou
+}
+
+// RemoveVmObjectToVmCredentialIDs removes the "VmObjectToVmCredentials" edge to VmCredential entities by IDs.
+func (vou *VmObjectUpdate) RemoveVmObjectToVmCredentialIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.RemoveVmObjectToVmCredentialIDs(ids...)
+	return vou
+}
+
+// RemoveVmObjectToVmCredentials removes "VmObjectToVmCredentials" edges to VmCredential entities.
+func (vou *VmObjectUpdate) RemoveVmObjectToVmCredentials(v ...*VmCredential) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vou.RemoveVmObjectToVmCredentialIDs(ids...)
+}
+
+// ClearVmObjectToConsoleShares clears all "VmObjectToConsoleShares" edges to the ConsoleShare entity.
+func (vou *VmObjectUpdate) ClearVmObjectToConsoleShares() *VmObjectUpdate {
+	vou.mutation.ClearVmObjectToConsoleShares()
+	return vou
+}
+
+// RemoveVmObjectToConsoleShareIDs removes the "VmObjectToConsoleShares" edge to ConsoleShare entities by IDs.
+func (vou *VmObjectUpdate) RemoveVmObjectToConsoleShareIDs(ids ...uuid.UUID) *VmObjectUpdate {
+	vou.mutation.RemoveVmObjectToConsoleShareIDs(ids...)
+	return vou
+}
+
+// RemoveVmObjectToConsoleShares removes "VmObjectToConsoleShares" edges to ConsoleShare entities.
+func (vou *VmObjectUpdate) RemoveVmObjectToConsoleShares(c ...*ConsoleShare) *VmObjectUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vou.RemoveVmObjectToConsoleShareIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (vou *VmObjectUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -104,6 +310,9 @@ func (vou *VmObjectUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(vou.hooks) == 0 {
+		if err = vou.check(); err != nil {
+			return 0, err
+		}
 		affected, err = vou.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -111,6 +320,9 @@ func (vou *VmObjectUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = vou.check(); err != nil {
+				return 0, err
+			}
 			vou.mutation = mutation
 			affected, err = vou.sqlSave(ctx)
 			mutation.done = true
@@ -151,6 +363,26 @@ func (vou *VmObjectUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (vou *VmObjectUpdate) check() error {
+	if v, ok := vou.mutation.ConsoleLimitPerVM(); ok {
+		if err := vmobject.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if v, ok := vou.mutation.ConsoleLimitPerUser(); ok {
+		if err := vmobject.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_user": %w`, err)}
+		}
+	}
+	if v, ok := vou.mutation.ConsoleLimitPerTeam(); ok {
+		if err := vmobject.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_team": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (vou *VmObjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -203,6 +435,73 @@ func (vou *VmObjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: vmobject.FieldLocked,
 		})
 	}
+	if value, ok := vou.mutation.RedTeamAccess(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmobject.FieldRedTeamAccess,
+		})
+	}
+	if value, ok := vou.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := vou.mutation.AddedConsoleLimitPerVM(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if vou.mutation.ConsoleLimitPerVMCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := vou.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := vou.mutation.AddedConsoleLimitPerUser(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if vou.mutation.ConsoleLimitPerUserCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := vou.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := vou.mutation.AddedConsoleLimitPerTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
+	if vou.mutation.ConsoleLimitPerTeamCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
 	if vou.mutation.VmObjectToTeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -238,6 +537,168 @@ func (vou *VmObjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if vou.mutation.VmObjectToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.RemovedVmObjectToConsoleSessionsIDs(); len(nodes) > 0 && !vou.mutation.VmObjectToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.VmObjectToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vou.mutation.VmObjectToVmCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.RemovedVmObjectToVmCredentialsIDs(); len(nodes) > 0 && !vou.mutation.VmObjectToVmCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.VmObjectToVmCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vou.mutation.VmObjectToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.RemovedVmObjectToConsoleSharesIDs(); len(nodes) > 0 && !vou.mutation.VmObjectToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vou.mutation.VmObjectToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, vou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vmobject.Label}
@@ -295,6 +756,101 @@ func (vouo *VmObjectUpdateOne) SetNillableLocked(b *bool) *VmObjectUpdateOne {
 	return vouo
 }
 
+// SetRedTeamAccess sets the "red_team_access" field.
+func (vouo *VmObjectUpdateOne) SetRedTeamAccess(b bool) *VmObjectUpdateOne {
+	vouo.mutation.SetRedTeamAccess(b)
+	return vouo
+}
+
+// SetNillableRedTeamAccess sets the "red_team_access" field if the given value is not nil.
+func (vouo *VmObjectUpdateOne) SetNillableRedTeamAccess(b *bool) *VmObjectUpdateOne {
+	if b != nil {
+		vouo.SetRedTeamAccess(*b)
+	}
+	return vouo
+}
+
+// SetConsoleLimitPerVM sets the "console_limit_per_vm" field.
+func (vouo *VmObjectUpdateOne) SetConsoleLimitPerVM(i int) *VmObjectUpdateOne {
+	vouo.mutation.ResetConsoleLimitPerVM()
+	vouo.mutation.SetConsoleLimitPerVM(i)
+	return vouo
+}
+
+// SetNillableConsoleLimitPerVM sets the "console_limit_per_vm" field if the given value is not nil.
+func (vouo *VmObjectUpdateOne) SetNillableConsoleLimitPerVM(i *int) *VmObjectUpdateOne {
+	if i != nil {
+		vouo.SetConsoleLimitPerVM(*i)
+	}
+	return vouo
+}
+
+// AddConsoleLimitPerVM adds i to the "console_limit_per_vm" field.
+func (vouo *VmObjectUpdateOne) AddConsoleLimitPerVM(i int) *VmObjectUpdateOne {
+	vouo.mutation.AddConsoleLimitPerVM(i)
+	return vouo
+}
+
+// ClearConsoleLimitPerVM clears the value of the "console_limit_per_vm" field.
+func (vouo *VmObjectUpdateOne) ClearConsoleLimitPerVM() *VmObjectUpdateOne {
+	vouo.mutation.ClearConsoleLimitPerVM()
+	return vouo
+}
+
+// SetConsoleLimitPerUser sets the "console_limit_per_user" field.
+func (vouo *VmObjectUpdateOne) SetConsoleLimitPerUser(i int) *VmObjectUpdateOne {
+	vouo.mutation.ResetConsoleLimitPerUser()
+	vouo.mutation.SetConsoleLimitPerUser(i)
+	return vouo
+}
+
+// SetNillableConsoleLimitPerUser sets the "console_limit_per_user" field if the given value is not nil.
+func (vouo *VmObjectUpdateOne) SetNillableConsoleLimitPerUser(i *int) *VmObjectUpdateOne {
+	if i != nil {
+		vouo.SetConsoleLimitPerUser(*i)
+	}
+	return vouo
+}
+
+// AddConsoleLimitPerUser adds i to the "console_limit_per_user" field.
+func (vouo *VmObjectUpdateOne) AddConsoleLimitPerUser(i int) *VmObjectUpdateOne {
+	vouo.mutation.AddConsoleLimitPerUser(i)
+	return vouo
+}
+
+// ClearConsoleLimitPerUser clears the value of the "console_limit_per_user" field.
+func (vouo *VmObjectUpdateOne) ClearConsoleLimitPerUser() *VmObjectUpdateOne {
+	vouo.mutation.ClearConsoleLimitPerUser()
+	return vouo
+}
+
+// SetConsoleLimitPerTeam sets the "console_limit_per_team" field.
+func (vouo *VmObjectUpdateOne) SetConsoleLimitPerTeam(i int) *VmObjectUpdateOne {
+	vouo.mutation.ResetConsoleLimitPerTeam()
+	vouo.mutation.SetConsoleLimitPerTeam(i)
+	return vouo
+}
+
+// SetNillableConsoleLimitPerTeam sets the "console_limit_per_team" field if the given value is not nil.
+func (vouo *VmObjectUpdateOne) SetNillableConsoleLimitPerTeam(i *int) *VmObjectUpdateOne {
+	if i != nil {
+		vouo.SetConsoleLimitPerTeam(*i)
+	}
+	return vouo
+}
+
+// AddConsoleLimitPerTeam adds i to the "console_limit_per_team" field.
+func (vouo *VmObjectUpdateOne) AddConsoleLimitPerTeam(i int) *VmObjectUpdateOne {
+	vouo.mutation.AddConsoleLimitPerTeam(i)
+	return vouo
+}
+
+// ClearConsoleLimitPerTeam clears the value of the "console_limit_per_team" field.
+func (vouo *VmObjectUpdateOne) ClearConsoleLimitPerTeam() *VmObjectUpdateOne {
+	vouo.mutation.ClearConsoleLimitPerTeam()
+	return vouo
+}
+
 // SetVmObjectToTeamID sets the "VmObjectToTeam" edge to the Team entity by ID.
 func (vouo *VmObjectUpdateOne) SetVmObjectToTeamID(id uuid.UUID) *VmObjectUpdateOne {
 	vouo.mutation.SetVmObjectToTeamID(id)
@@ -314,6 +870,51 @@ func (vouo *VmObjectUpdateOne) SetVmObjectToTeam(t *Team) *VmObjectUpdateOne {
 	return vouo.SetVmObjectToTeamID(t.ID)
 }
 
+// AddVmObjectToConsoleSessionIDs adds the "VmObjectToConsoleSessions" edge to the ConsoleSession entity by IDs.
+func (vouo *VmObjectUpdateOne) AddVmObjectToConsoleSessionIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.AddVmObjectToConsoleSessionIDs(ids...)
+	return vouo
+}
+
+// AddVmObjectToConsoleSessions adds the "VmObjectToConsoleSessions" edges to the ConsoleSession entity.
+func (vouo *VmObjectUpdateOne) AddVmObjectToConsoleSessions(c ...*ConsoleSession) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vouo.AddVmObjectToConsoleSessionIDs(ids...)
+}
+
+// AddVmObjectToVmCredentialIDs adds the "VmObjectToVmCredentials" edge to the VmCredential entity by IDs.
+func (vouo *VmObjectUpdateOne) AddVmObjectToVmCredentialIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.AddVmObjectToVmCredentialIDs(ids...)
+	return vouo
+}
+
+// AddVmObjectToVmCredentials adds the "VmObjectToVmCredentials" edges to the VmCredential entity.
+func (vouo *VmObjectUpdateOne) AddVmObjectToVmCredentials(v ...*VmCredential) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vouo.AddVmObjectToVmCredentialIDs(ids...)
+}
+
+// AddVmObjectToConsoleShareIDs adds the "VmObjectToConsoleShares" edge to the ConsoleShare entity by IDs.
+func (vouo *VmObjectUpdateOne) AddVmObjectToConsoleShareIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.AddVmObjectToConsoleShareIDs(ids...)
+	return vouo
+}
+
+// AddVmObjectToConsoleShares adds the "VmObjectToConsoleShares" edges to the ConsoleShare entity.
+func (vouo *VmObjectUpdateOne) AddVmObjectToConsoleShares(c ...*ConsoleShare) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vouo.AddVmObjectToConsoleShareIDs(ids...)
+}
+
 // Mutation returns the VmObjectMutation object of the builder.
 func (vouo *VmObjectUpdateOne) Mutation() *VmObjectMutation {
 	return vouo.mutation
@@ -325,6 +926,69 @@ func (vouo *VmObjectUpdateOne) ClearVmObjectToTeam() *VmObjectUpdateOne {
 	return vouo
 }
 
+// ClearVmObjectToConsoleSessions clears all "VmObjectToConsoleSessions" edges to the ConsoleSession entity.
+func (vouo *VmObjectUpdateOne) ClearVmObjectToConsoleSessions() *VmObjectUpdateOne {
+	vouo.mutation.ClearVmObjectToConsoleSessions()
+	return vouo
+}
+
+// RemoveVmObjectToConsoleSessionIDs removes the "VmObjectToConsoleSessions" edge to ConsoleSession entities by IDs.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToConsoleSessionIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.RemoveVmObjectToConsoleSessionIDs(ids...)
+	return vouo
+}
+
+// RemoveVmObjectToConsoleSessions removes "VmObjectToConsoleSessions" edges to ConsoleSession entities.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToConsoleSessions(c ...*ConsoleSession) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vouo.RemoveVmObjectToConsoleSessionIDs(ids...)
+}
+
+// ClearVmObjectToVmCredentials clears all "VmObjectToVmCredentials" edges to the VmCredential entity.
+func (vouo *VmObjectUpdateOne) ClearVmObjectToVmCredentials() *VmObjectUpdateOne {
+	vouo.mutation.ClearVmObjectToVmCredentials()
+	return vouo
+}
+
+// RemoveVmObjectToVmCredentialIDs removes the "VmObjectToVmCredentials" edge to VmCredential entities by IDs.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToVmCredentialIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.RemoveVmObjectToVmCredentialIDs(ids...)
+	return vouo
+}
+
+// RemoveVmObjectToVmCredentials removes "VmObjectToVmCredentials" edges to VmCredential entities.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToVmCredentials(v ...*VmCredential) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vouo.RemoveVmObjectToVmCredentialIDs(ids...)
+}
+
+// ClearVmObjectToConsoleShares clears all "VmObjectToConsoleShares" edges to the ConsoleShare entity.
+func (vouo *VmObjectUpdateOne) ClearVmObjectToConsoleShares() *VmObjectUpdateOne {
+	vouo.mutation.ClearVmObjectToConsoleShares()
+	return vouo
+}
+
+// RemoveVmObjectToConsoleShareIDs removes the "VmObjectToConsoleShares" edge to ConsoleShare entities by IDs.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToConsoleShareIDs(ids ...uuid.UUID) *VmObjectUpdateOne {
+	vouo.mutation.RemoveVmObjectToConsoleShareIDs(ids...)
+	return vouo
+}
+
+// RemoveVmObjectToConsoleShares removes "VmObjectToConsoleShares" edges to ConsoleShare entities.
+func (vouo *VmObjectUpdateOne) RemoveVmObjectToConsoleShares(c ...*ConsoleShare) *VmObjectUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vouo.RemoveVmObjectToConsoleShareIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (vouo *VmObjectUpdateOne) Select(field string, fields ...string) *VmObjectUpdateOne {
@@ -339,6 +1003,9 @@ func (vouo *VmObjectUpdateOne) Save(ctx context.Context) (*VmObject, error) {
 		node *VmObject
 	)
 	if len(vouo.hooks) == 0 {
+		if err = vouo.check(); err != nil {
+			return nil, err
+		}
 		node, err = vouo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -346,6 +1013,9 @@ func (vouo *VmObjectUpdateOne) Save(ctx context.Context) (*VmObject, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = vouo.check(); err != nil {
+				return nil, err
+			}
 			vouo.mutation = mutation
 			node, err = vouo.sqlSave(ctx)
 			mutation.done = true
@@ -386,6 +1056,26 @@ func (vouo *VmObjectUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (vouo *VmObjectUpdateOne) check() error {
+	if v, ok := vouo.mutation.ConsoleLimitPerVM(); ok {
+		if err := vmobject.ConsoleLimitPerVMValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_vm", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_vm": %w`, err)}
+		}
+	}
+	if v, ok := vouo.mutation.ConsoleLimitPerUser(); ok {
+		if err := vmobject.ConsoleLimitPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_user", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_user": %w`, err)}
+		}
+	}
+	if v, ok := vouo.mutation.ConsoleLimitPerTeam(); ok {
+		if err := vmobject.ConsoleLimitPerTeamValidator(v); err != nil {
+			return &ValidationError{Name: "console_limit_per_team", err: fmt.Errorf(`ent: validator failed for field "VmObject.console_limit_per_team": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (vouo *VmObjectUpdateOne) sqlSave(ctx context.Context) (_node *VmObject, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -455,6 +1145,73 @@ func (vouo *VmObjectUpdateOne) sqlSave(ctx context.Context) (_node *VmObject, er
 			Column: vmobject.FieldLocked,
 		})
 	}
+	if value, ok := vouo.mutation.RedTeamAccess(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: vmobject.FieldRedTeamAccess,
+		})
+	}
+	if value, ok := vouo.mutation.ConsoleLimitPerVM(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := vouo.mutation.AddedConsoleLimitPerVM(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if vouo.mutation.ConsoleLimitPerVMCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerVM,
+		})
+	}
+	if value, ok := vouo.mutation.ConsoleLimitPerUser(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := vouo.mutation.AddedConsoleLimitPerUser(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if vouo.mutation.ConsoleLimitPerUserCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerUser,
+		})
+	}
+	if value, ok := vouo.mutation.ConsoleLimitPerTeam(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
+	if value, ok := vouo.mutation.AddedConsoleLimitPerTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
+	if vouo.mutation.ConsoleLimitPerTeamCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: vmobject.FieldConsoleLimitPerTeam,
+		})
+	}
 	if vouo.mutation.VmObjectToTeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -490,6 +1247,168 @@ func (vouo *VmObjectUpdateOne) sqlSave(ctx context.Context) (_node *VmObject, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if vouo.mutation.VmObjectToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.RemovedVmObjectToConsoleSessionsIDs(); len(nodes) > 0 && !vouo.mutation.VmObjectToConsoleSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.VmObjectToConsoleSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSessionsTable,
+			Columns: []string{vmobject.VmObjectToConsoleSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consolesession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vouo.mutation.VmObjectToVmCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.RemovedVmObjectToVmCredentialsIDs(); len(nodes) > 0 && !vouo.mutation.VmObjectToVmCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.VmObjectToVmCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToVmCredentialsTable,
+			Columns: []string{vmobject.VmObjectToVmCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: vmcredential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vouo.mutation.VmObjectToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.RemovedVmObjectToConsoleSharesIDs(); len(nodes) > 0 && !vouo.mutation.VmObjectToConsoleSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vouo.mutation.VmObjectToConsoleSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vmobject.VmObjectToConsoleSharesTable,
+			Columns: []string{vmobject.VmObjectToConsoleSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: consoleshare.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &VmObject{config: vouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
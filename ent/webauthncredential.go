@@ -0,0 +1,267 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
+	"github.com/google/uuid"
+)
+
+// WebauthnCredential is the model entity for the WebauthnCredential schema.
+type WebauthnCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	// [OPTIONAL] (default is "Passkey") The display name the user gave the credential.
+	Name string `json:"name,omitempty"`
+	// CredentialID holds the value of the "credential_id" field.
+	// [REQUIRED] The credential ID chosen by the authenticator.
+	CredentialID []byte `json:"credential_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	// [REQUIRED] The COSE encoded public key of the credential.
+	PublicKey []byte `json:"-"`
+	// AttestationType holds the value of the "attestation_type" field.
+	// [OPTIONAL] The attestation format the authenticator used during registration.
+	AttestationType string `json:"attestation_type,omitempty"`
+	// Aaguid holds the value of the "aaguid" field.
+	// [OPTIONAL] The AAGUID identifying the authenticator model.
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// SignCount holds the value of the "sign_count" field.
+	// [INTERNAL] The last signature counter reported by the authenticator, used to detect cloned authenticators.
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// Transports holds the value of the "transports" field.
+	// [OPTIONAL] The transports the authenticator supports (eg. usb, nfc, internal).
+	Transports []string `json:"transports,omitempty"`
+	// BackupEligible holds the value of the "backup_eligible" field.
+	// [OPTIONAL] (default is false) Whether the credential can be synced between devices.
+	BackupEligible bool `json:"backup_eligible,omitempty"`
+	// BackupState holds the value of the "backup_state" field.
+	// [OPTIONAL] (default is false) Whether the credential is currently synced between devices.
+	BackupState bool `json:"backup_state,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	// [REQUIRED] (default is now) When the credential was registered.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	// [OPTIONAL] When the credential was last used to login.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebauthnCredentialQuery when eager-loading is set.
+	Edges                             WebauthnCredentialEdges `json:"edges"`
+	user_user_to_webauthn_credentials *uuid.UUID
+}
+
+// WebauthnCredentialEdges holds the relations/edges for other nodes in the graph.
+type WebauthnCredentialEdges struct {
+	// WebauthnCredentialToUser holds the value of the WebauthnCredentialToUser edge.
+	WebauthnCredentialToUser *User `json:"WebauthnCredentialToUser,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebauthnCredentialToUserOrErr returns the WebauthnCredentialToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebauthnCredentialEdges) WebauthnCredentialToUserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.WebauthnCredentialToUser == nil {
+			// The edge WebauthnCredentialToUser was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.WebauthnCredentialToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "WebauthnCredentialToUser"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebauthnCredential) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldCredentialID, webauthncredential.FieldPublicKey, webauthncredential.FieldAaguid, webauthncredential.FieldTransports:
+			values[i] = new([]byte)
+		case webauthncredential.FieldBackupEligible, webauthncredential.FieldBackupState:
+			values[i] = new(sql.NullBool)
+		case webauthncredential.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case webauthncredential.FieldName, webauthncredential.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case webauthncredential.FieldCreatedAt, webauthncredential.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case webauthncredential.FieldID:
+			values[i] = new(uuid.UUID)
+		case webauthncredential.ForeignKeys[0]: // user_user_to_webauthn_credentials
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebauthnCredential", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebauthnCredential fields.
+func (wc *WebauthnCredential) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wc.ID = *value
+			}
+		case webauthncredential.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wc.Name = value.String
+			}
+		case webauthncredential.FieldCredentialID:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value != nil {
+				wc.CredentialID = *value
+			}
+		case webauthncredential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				wc.PublicKey = *value
+			}
+		case webauthncredential.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				wc.AttestationType = value.String
+			}
+		case webauthncredential.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				wc.Aaguid = *value
+			}
+		case webauthncredential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				wc.SignCount = uint32(value.Int64)
+			}
+		case webauthncredential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wc.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case webauthncredential.FieldBackupEligible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_eligible", values[i])
+			} else if value.Valid {
+				wc.BackupEligible = value.Bool
+			}
+		case webauthncredential.FieldBackupState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_state", values[i])
+			} else if value.Valid {
+				wc.BackupState = value.Bool
+			}
+		case webauthncredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wc.CreatedAt = value.Time
+			}
+		case webauthncredential.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				wc.LastUsedAt = new(time.Time)
+				*wc.LastUsedAt = value.Time
+			}
+		case webauthncredential.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_user_to_webauthn_credentials", values[i])
+			} else if value.Valid {
+				wc.user_user_to_webauthn_credentials = new(uuid.UUID)
+				*wc.user_user_to_webauthn_credentials = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWebauthnCredentialToUser queries the "WebauthnCredentialToUser" edge of the WebauthnCredential entity.
+func (wc *WebauthnCredential) QueryWebauthnCredentialToUser() *UserQuery {
+	return (&WebauthnCredentialClient{config: wc.config}).QueryWebauthnCredentialToUser(wc)
+}
+
+// Update returns a builder for updating this WebauthnCredential.
+// Note that you need to call WebauthnCredential.Unwrap() before calling this method if this WebauthnCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wc *WebauthnCredential) Update() *WebauthnCredentialUpdateOne {
+	return (&WebauthnCredentialClient{config: wc.config}).UpdateOne(wc)
+}
+
+// Unwrap unwraps the WebauthnCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wc *WebauthnCredential) Unwrap() *WebauthnCredential {
+	tx, ok := wc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebauthnCredential is not a transactional entity")
+	}
+	wc.config.driver = tx.drv
+	return wc
+}
+
+// String implements the fmt.Stringer.
+func (wc *WebauthnCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebauthnCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v", wc.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(wc.Name)
+	builder.WriteString(", credential_id=")
+	builder.WriteString(fmt.Sprintf("%v", wc.CredentialID))
+	builder.WriteString(", public_key=<sensitive>")
+	builder.WriteString(", attestation_type=")
+	builder.WriteString(wc.AttestationType)
+	builder.WriteString(", aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", wc.Aaguid))
+	builder.WriteString(", sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", wc.SignCount))
+	builder.WriteString(", transports=")
+	builder.WriteString(fmt.Sprintf("%v", wc.Transports))
+	builder.WriteString(", backup_eligible=")
+	builder.WriteString(fmt.Sprintf("%v", wc.BackupEligible))
+	builder.WriteString(", backup_state=")
+	builder.WriteString(fmt.Sprintf("%v", wc.BackupState))
+	builder.WriteString(", created_at=")
+	builder.WriteString(wc.CreatedAt.Format(time.ANSIC))
+	if v := wc.LastUsedAt; v != nil {
+		builder.WriteString(", last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebauthnCredentials is a parsable slice of WebauthnCredential.
+type WebauthnCredentials []*WebauthnCredential
+
+func (wc WebauthnCredentials) config(cfg config) {
+	for _i := range wc {
+		wc[_i].config = cfg
+	}
+}
@@ -0,0 +1,103 @@
+// Code generated by entc, DO NOT EDIT.
+
+package webauthncredential
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the webauthncredential type in the database.
+	Label = "webauthn_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "oid"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCredentialID holds the string denoting the credential_id field in the database.
+	FieldCredentialID = "credential_id"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldAttestationType holds the string denoting the attestation_type field in the database.
+	FieldAttestationType = "attestation_type"
+	// FieldAaguid holds the string denoting the aaguid field in the database.
+	FieldAaguid = "aaguid"
+	// FieldSignCount holds the string denoting the sign_count field in the database.
+	FieldSignCount = "sign_count"
+	// FieldTransports holds the string denoting the transports field in the database.
+	FieldTransports = "transports"
+	// FieldBackupEligible holds the string denoting the backup_eligible field in the database.
+	FieldBackupEligible = "backup_eligible"
+	// FieldBackupState holds the string denoting the backup_state field in the database.
+	FieldBackupState = "backup_state"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// EdgeWebauthnCredentialToUser holds the string denoting the webauthncredentialtouser edge name in mutations.
+	EdgeWebauthnCredentialToUser = "WebauthnCredentialToUser"
+	// Table holds the table name of the webauthncredential in the database.
+	Table = "webauthn_credentials"
+	// WebauthnCredentialToUserTable is the table that holds the WebauthnCredentialToUser relation/edge.
+	WebauthnCredentialToUserTable = "webauthn_credentials"
+	// WebauthnCredentialToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	WebauthnCredentialToUserInverseTable = "users"
+	// WebauthnCredentialToUserColumn is the table column denoting the WebauthnCredentialToUser relation/edge.
+	WebauthnCredentialToUserColumn = "user_user_to_webauthn_credentials"
+)
+
+// Columns holds all SQL columns for webauthncredential fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCredentialID,
+	FieldPublicKey,
+	FieldAttestationType,
+	FieldAaguid,
+	FieldSignCount,
+	FieldTransports,
+	FieldBackupEligible,
+	FieldBackupState,
+	FieldCreatedAt,
+	FieldLastUsedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "webauthn_credentials"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_user_to_webauthn_credentials",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultAttestationType holds the default value on creation for the "attestation_type" field.
+	DefaultAttestationType string
+	// DefaultSignCount holds the default value on creation for the "sign_count" field.
+	DefaultSignCount uint32
+	// DefaultBackupEligible holds the default value on creation for the "backup_eligible" field.
+	DefaultBackupEligible bool
+	// DefaultBackupState holds the default value on creation for the "backup_state" field.
+	DefaultBackupState bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
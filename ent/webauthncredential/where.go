@@ -0,0 +1,973 @@
+// Code generated by entc, DO NOT EDIT.
+
+package webauthncredential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialID), v))
+	})
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAaguid), v))
+	})
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignCount), v))
+	})
+}
+
+// BackupEligible applies equality check predicate on the "backup_eligible" field. It's identical to BackupEligibleEQ.
+func BackupEligible(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackupEligible), v))
+	})
+}
+
+// BackupState applies equality check predicate on the "backup_state" field. It's identical to BackupStateEQ.
+func BackupState(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackupState), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialID), v))
+	})
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCredentialID), v))
+	})
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCredentialID), v...))
+	})
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCredentialID), v...))
+	})
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCredentialID), v))
+	})
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCredentialID), v))
+	})
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCredentialID), v))
+	})
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCredentialID), v))
+	})
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPublicKey), v...))
+	})
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPublicKey), v...))
+	})
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPublicKey), v))
+	})
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttestationType), v...))
+	})
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttestationType), v...))
+	})
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAttestationType), v))
+	})
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAaguid), v...))
+	})
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...[]byte) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAaguid), v...))
+	})
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAaguid)))
+	})
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAaguid)))
+	})
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSignCount), v...))
+	})
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSignCount), v...))
+	})
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSignCount), v))
+	})
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTransports)))
+	})
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTransports)))
+	})
+}
+
+// BackupEligibleEQ applies the EQ predicate on the "backup_eligible" field.
+func BackupEligibleEQ(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackupEligible), v))
+	})
+}
+
+// BackupEligibleNEQ applies the NEQ predicate on the "backup_eligible" field.
+func BackupEligibleNEQ(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBackupEligible), v))
+	})
+}
+
+// BackupStateEQ applies the EQ predicate on the "backup_state" field.
+func BackupStateEQ(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackupState), v))
+	})
+}
+
+// BackupStateNEQ applies the NEQ predicate on the "backup_state" field.
+func BackupStateNEQ(v bool) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBackupState), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.WebauthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// HasWebauthnCredentialToUser applies the HasEdge predicate on the "WebauthnCredentialToUser" edge.
+func HasWebauthnCredentialToUser() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WebauthnCredentialToUserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WebauthnCredentialToUserTable, WebauthnCredentialToUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebauthnCredentialToUserWith applies the HasEdge predicate on the "WebauthnCredentialToUser" edge with a given conditions (other predicates).
+func HasWebauthnCredentialToUserWith(preds ...predicate.User) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WebauthnCredentialToUserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WebauthnCredentialToUserTable, WebauthnCredentialToUserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
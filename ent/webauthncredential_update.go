@@ -0,0 +1,872 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
+	"github.com/google/uuid"
+)
+
+// WebauthnCredentialUpdate is the builder for updating WebauthnCredential entities.
+type WebauthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (wcu *WebauthnCredentialUpdate) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdate {
+	wcu.mutation.Where(ps...)
+	return wcu
+}
+
+// SetName sets the "name" field.
+func (wcu *WebauthnCredentialUpdate) SetName(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetName(s)
+	return wcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableName(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetName(*s)
+	}
+	return wcu
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (wcu *WebauthnCredentialUpdate) SetCredentialID(b []byte) *WebauthnCredentialUpdate {
+	wcu.mutation.SetCredentialID(b)
+	return wcu
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wcu *WebauthnCredentialUpdate) SetPublicKey(b []byte) *WebauthnCredentialUpdate {
+	wcu.mutation.SetPublicKey(b)
+	return wcu
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wcu *WebauthnCredentialUpdate) SetAttestationType(s string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetAttestationType(s)
+	return wcu
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableAttestationType(s *string) *WebauthnCredentialUpdate {
+	if s != nil {
+		wcu.SetAttestationType(*s)
+	}
+	return wcu
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wcu *WebauthnCredentialUpdate) SetAaguid(b []byte) *WebauthnCredentialUpdate {
+	wcu.mutation.SetAaguid(b)
+	return wcu
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wcu *WebauthnCredentialUpdate) ClearAaguid() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearAaguid()
+	return wcu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) SetSignCount(u uint32) *WebauthnCredentialUpdate {
+	wcu.mutation.ResetSignCount()
+	wcu.mutation.SetSignCount(u)
+	return wcu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableSignCount(u *uint32) *WebauthnCredentialUpdate {
+	if u != nil {
+		wcu.SetSignCount(*u)
+	}
+	return wcu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wcu *WebauthnCredentialUpdate) AddSignCount(u int32) *WebauthnCredentialUpdate {
+	wcu.mutation.AddSignCount(u)
+	return wcu
+}
+
+// SetTransports sets the "transports" field.
+func (wcu *WebauthnCredentialUpdate) SetTransports(s []string) *WebauthnCredentialUpdate {
+	wcu.mutation.SetTransports(s)
+	return wcu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcu *WebauthnCredentialUpdate) ClearTransports() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearTransports()
+	return wcu
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupEligible(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupEligible(b)
+	return wcu
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupEligible(*b)
+	}
+	return wcu
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcu *WebauthnCredentialUpdate) SetBackupState(b bool) *WebauthnCredentialUpdate {
+	wcu.mutation.SetBackupState(b)
+	return wcu
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableBackupState(b *bool) *WebauthnCredentialUpdate {
+	if b != nil {
+		wcu.SetBackupState(*b)
+	}
+	return wcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wcu *WebauthnCredentialUpdate) SetCreatedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetCreatedAt(t)
+	return wcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableCreatedAt(t *time.Time) *WebauthnCredentialUpdate {
+	if t != nil {
+		wcu.SetCreatedAt(*t)
+	}
+	return wcu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdate {
+	wcu.mutation.SetLastUsedAt(t)
+	return wcu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcu *WebauthnCredentialUpdate) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdate {
+	if t != nil {
+		wcu.SetLastUsedAt(*t)
+	}
+	return wcu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcu *WebauthnCredentialUpdate) ClearLastUsedAt() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearLastUsedAt()
+	return wcu
+}
+
+// SetWebauthnCredentialToUserID sets the "WebauthnCredentialToUser" edge to the User entity by ID.
+func (wcu *WebauthnCredentialUpdate) SetWebauthnCredentialToUserID(id uuid.UUID) *WebauthnCredentialUpdate {
+	wcu.mutation.SetWebauthnCredentialToUserID(id)
+	return wcu
+}
+
+// SetWebauthnCredentialToUser sets the "WebauthnCredentialToUser" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) SetWebauthnCredentialToUser(u *User) *WebauthnCredentialUpdate {
+	return wcu.SetWebauthnCredentialToUserID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcu *WebauthnCredentialUpdate) Mutation() *WebauthnCredentialMutation {
+	return wcu.mutation
+}
+
+// ClearWebauthnCredentialToUser clears the "WebauthnCredentialToUser" edge to the User entity.
+func (wcu *WebauthnCredentialUpdate) ClearWebauthnCredentialToUser() *WebauthnCredentialUpdate {
+	wcu.mutation.ClearWebauthnCredentialToUser()
+	return wcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wcu *WebauthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wcu.hooks) == 0 {
+		if err = wcu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebauthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wcu.check(); err != nil {
+				return 0, err
+			}
+			wcu.mutation = mutation
+			affected, err = wcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wcu.hooks) - 1; i >= 0; i-- {
+			if wcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := wcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wcu *WebauthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := wcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcu *WebauthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := wcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcu *WebauthnCredentialUpdate) check() error {
+	if _, ok := wcu.mutation.WebauthnCredentialToUserID(); wcu.mutation.WebauthnCredentialToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.WebauthnCredentialToUser"`)
+	}
+	return nil
+}
+
+func (wcu *WebauthnCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webauthncredential.FieldID,
+			},
+		},
+	}
+	if ps := wcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldName,
+		})
+	}
+	if value, ok := wcu.mutation.CredentialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldCredentialID,
+		})
+	}
+	if value, ok := wcu.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldPublicKey,
+		})
+	}
+	if value, ok := wcu.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldAttestationType,
+		})
+	}
+	if value, ok := wcu.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if wcu.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if value, ok := wcu.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wcu.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wcu.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if wcu.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if value, ok := wcu.mutation.BackupEligible(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webauthncredential.FieldBackupEligible,
+		})
+	}
+	if value, ok := wcu.mutation.BackupState(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webauthncredential.FieldBackupState,
+		})
+	}
+	if value, ok := wcu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldCreatedAt,
+		})
+	}
+	if value, ok := wcu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldLastUsedAt,
+		})
+	}
+	if wcu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webauthncredential.FieldLastUsedAt,
+		})
+	}
+	if wcu.mutation.WebauthnCredentialToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.WebauthnCredentialToUserTable,
+			Columns: []string{webauthncredential.WebauthnCredentialToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcu.mutation.WebauthnCredentialToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.WebauthnCredentialToUserTable,
+			Columns: []string{webauthncredential.WebauthnCredentialToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebauthnCredentialUpdateOne is the builder for updating a single WebauthnCredential entity.
+type WebauthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// SetName sets the "name" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetName(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetName(s)
+	return wcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableName(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetName(*s)
+	}
+	return wcuo
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetCredentialID(b []byte) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetCredentialID(b)
+	return wcuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetPublicKey(b []byte) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetPublicKey(b)
+	return wcuo
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetAttestationType(s string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetAttestationType(s)
+	return wcuo
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableAttestationType(s *string) *WebauthnCredentialUpdateOne {
+	if s != nil {
+		wcuo.SetAttestationType(*s)
+	}
+	return wcuo
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetAaguid(b []byte) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetAaguid(b)
+	return wcuo
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearAaguid() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearAaguid()
+	return wcuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetSignCount(u uint32) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ResetSignCount()
+	wcuo.mutation.SetSignCount(u)
+	return wcuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableSignCount(u *uint32) *WebauthnCredentialUpdateOne {
+	if u != nil {
+		wcuo.SetSignCount(*u)
+	}
+	return wcuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wcuo *WebauthnCredentialUpdateOne) AddSignCount(u int32) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.AddSignCount(u)
+	return wcuo
+}
+
+// SetTransports sets the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetTransports(s []string) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetTransports(s)
+	return wcuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearTransports() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearTransports()
+	return wcuo
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupEligible(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupEligible(b)
+	return wcuo
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupEligible(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupEligible(*b)
+	}
+	return wcuo
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetBackupState(b bool) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetBackupState(b)
+	return wcuo
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableBackupState(b *bool) *WebauthnCredentialUpdateOne {
+	if b != nil {
+		wcuo.SetBackupState(*b)
+	}
+	return wcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetCreatedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetCreatedAt(t)
+	return wcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableCreatedAt(t *time.Time) *WebauthnCredentialUpdateOne {
+	if t != nil {
+		wcuo.SetCreatedAt(*t)
+	}
+	return wcuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) SetLastUsedAt(t time.Time) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetLastUsedAt(t)
+	return wcuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wcuo *WebauthnCredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *WebauthnCredentialUpdateOne {
+	if t != nil {
+		wcuo.SetLastUsedAt(*t)
+	}
+	return wcuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wcuo *WebauthnCredentialUpdateOne) ClearLastUsedAt() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearLastUsedAt()
+	return wcuo
+}
+
+// SetWebauthnCredentialToUserID sets the "WebauthnCredentialToUser" edge to the User entity by ID.
+func (wcuo *WebauthnCredentialUpdateOne) SetWebauthnCredentialToUserID(id uuid.UUID) *WebauthnCredentialUpdateOne {
+	wcuo.mutation.SetWebauthnCredentialToUserID(id)
+	return wcuo
+}
+
+// SetWebauthnCredentialToUser sets the "WebauthnCredentialToUser" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) SetWebauthnCredentialToUser(u *User) *WebauthnCredentialUpdateOne {
+	return wcuo.SetWebauthnCredentialToUserID(u.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (wcuo *WebauthnCredentialUpdateOne) Mutation() *WebauthnCredentialMutation {
+	return wcuo.mutation
+}
+
+// ClearWebauthnCredentialToUser clears the "WebauthnCredentialToUser" edge to the User entity.
+func (wcuo *WebauthnCredentialUpdateOne) ClearWebauthnCredentialToUser() *WebauthnCredentialUpdateOne {
+	wcuo.mutation.ClearWebauthnCredentialToUser()
+	return wcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wcuo *WebauthnCredentialUpdateOne) Select(field string, fields ...string) *WebauthnCredentialUpdateOne {
+	wcuo.fields = append([]string{field}, fields...)
+	return wcuo
+}
+
+// Save executes the query and returns the updated WebauthnCredential entity.
+func (wcuo *WebauthnCredentialUpdateOne) Save(ctx context.Context) (*WebauthnCredential, error) {
+	var (
+		err  error
+		node *WebauthnCredential
+	)
+	if len(wcuo.hooks) == 0 {
+		if err = wcuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebauthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wcuo.check(); err != nil {
+				return nil, err
+			}
+			wcuo.mutation = mutation
+			node, err = wcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wcuo.hooks) - 1; i >= 0; i-- {
+			if wcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wcuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wcuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) SaveX(ctx context.Context) *WebauthnCredential {
+	node, err := wcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wcuo *WebauthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := wcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcuo *WebauthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := wcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcuo *WebauthnCredentialUpdateOne) check() error {
+	if _, ok := wcuo.mutation.WebauthnCredentialToUserID(); wcuo.mutation.WebauthnCredentialToUserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.WebauthnCredentialToUser"`)
+	}
+	return nil
+}
+
+func (wcuo *WebauthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebauthnCredential, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webauthncredential.FieldID,
+			},
+		},
+	}
+	id, ok := wcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebauthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wcuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldName,
+		})
+	}
+	if value, ok := wcuo.mutation.CredentialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldCredentialID,
+		})
+	}
+	if value, ok := wcuo.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldPublicKey,
+		})
+	}
+	if value, ok := wcuo.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldAttestationType,
+		})
+	}
+	if value, ok := wcuo.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if wcuo.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if value, ok := wcuo.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wcuo.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wcuo.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if wcuo.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if value, ok := wcuo.mutation.BackupEligible(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webauthncredential.FieldBackupEligible,
+		})
+	}
+	if value, ok := wcuo.mutation.BackupState(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webauthncredential.FieldBackupState,
+		})
+	}
+	if value, ok := wcuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldCreatedAt,
+		})
+	}
+	if value, ok := wcuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldLastUsedAt,
+		})
+	}
+	if wcuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webauthncredential.FieldLastUsedAt,
+		})
+	}
+	if wcuo.mutation.WebauthnCredentialToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.WebauthnCredentialToUserTable,
+			Columns: []string{webauthncredential.WebauthnCredentialToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wcuo.mutation.WebauthnCredentialToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.WebauthnCredentialToUserTable,
+			Columns: []string{webauthncredential.WebauthnCredentialToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebauthnCredential{config: wcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
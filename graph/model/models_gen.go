@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/BradHacker/compsole/ent"
 )
@@ -15,6 +16,14 @@ type AccountInput struct {
 	LastName  string `json:"LastName"`
 }
 
+type AccountLockout struct {
+	Type LockoutType `json:"Type"`
+	// The username, IP address, API key or share link ID which is locked out
+	Identifier  string    `json:"Identifier"`
+	Failures    int       `json:"Failures"`
+	LockedUntil time.Time `json:"LockedUntil"`
+}
+
 type ActionsResult struct {
 	Results      []*ent.Action `json:"results"`
 	Offset       int           `json:"offset"`
@@ -25,10 +34,31 @@ type ActionsResult struct {
 	Types        []ActionType  `json:"types"`
 }
 
+type CompetitionBreakInput struct {
+	Name     *string   `json:"Name"`
+	StartsAt time.Time `json:"StartsAt"`
+	EndsAt   time.Time `json:"EndsAt"`
+}
+
 type CompetitionInput struct {
-	ID                    *string `json:"ID"`
-	Name                  string  `json:"Name"`
-	CompetitionToProvider string  `json:"CompetitionToProvider"`
+	ID   *string `json:"ID"`
+	Name string  `json:"Name"`
+	// 0 is unlimited. Leave null to keep the existing limit on update operations.
+	ConsoleLimitPerVM *int `json:"ConsoleLimitPerVm"`
+	// 0 is unlimited. Leave null to keep the existing limit on update operations.
+	ConsoleLimitPerUser *int `json:"ConsoleLimitPerUser"`
+	// 0 is unlimited. Leave null to keep the existing limit on update operations.
+	ConsoleLimitPerTeam *int `json:"ConsoleLimitPerTeam"`
+	// Networks in CIDR notation (eg. "10.0.1.0/24"). Leave null to keep the existing allowlist on update operations.
+	AllowedCidrs          []string `json:"AllowedCidrs"`
+	CompetitionToProvider string   `json:"CompetitionToProvider"`
+}
+
+// Leave StartsAt or EndsAt null for a competition without a start or an end. The breaks replace any existing breaks.
+type CompetitionScheduleInput struct {
+	StartsAt *time.Time               `json:"StartsAt"`
+	EndsAt   *time.Time               `json:"EndsAt"`
+	Breaks   []*CompetitionBreakInput `json:"Breaks"`
 }
 
 type CompetitionUser struct {
@@ -38,6 +68,44 @@ type CompetitionUser struct {
 	UserToTeam *ent.Team `json:"UserToTeam"`
 }
 
+type ConsoleSessionsResult struct {
+	Results      []*ent.ConsoleSession `json:"results"`
+	Offset       int                   `json:"offset"`
+	Limit        int                   `json:"limit"`
+	Page         int                   `json:"page"`
+	TotalPages   int                   `json:"totalPages"`
+	TotalResults int                   `json:"totalResults"`
+}
+
+type ConsoleShareDetails struct {
+	ID          string      `json:"ID"`
+	ConsoleType ConsoleType `json:"ConsoleType"`
+	ExpiresAt   time.Time   `json:"ExpiresAt"`
+	// The share link token. This is only returned once and is used at /api/console/share/{token}.
+	Token string `json:"Token"`
+}
+
+type CustomRoleInput struct {
+	ID          *string      `json:"ID"`
+	Name        string       `json:"Name"`
+	Description string       `json:"Description"`
+	Permissions []Permission `json:"Permissions"`
+}
+
+type PersonalAccessTokenDetails struct {
+	// The token is only returned when it is created. Send it as `Authorization: Bearer <token>` to /api/graphql/query.
+	Token               string                   `json:"Token"`
+	PersonalAccessToken *ent.PersonalAccessToken `json:"PersonalAccessToken"`
+}
+
+type PersonalAccessTokenInput struct {
+	Name string `json:"Name"`
+	// READ_ONLY tokens can only run queries
+	Scope PersonalAccessTokenScope `json:"Scope"`
+	// Leave null for a token which never expires.
+	ExpiresAt *time.Time `json:"ExpiresAt"`
+}
+
 type PowerStateUpdate struct {
 	ID    string     `json:"ID"`
 	State PowerState `json:"State"`
@@ -51,17 +119,28 @@ type ProviderInput struct {
 }
 
 type ServiceAccountDetails struct {
-	ID          string `json:"ID"`
-	DisplayName string `json:"DisplayName"`
-	APIKey      string `json:"ApiKey"`
-	APISecret   string `json:"ApiSecret"`
-	Active      bool   `json:"Active"`
+	ID                           string                `json:"ID"`
+	DisplayName                  string                `json:"DisplayName"`
+	APIKey                       string                `json:"ApiKey"`
+	APISecret                    string                `json:"ApiSecret"`
+	Active                       bool                  `json:"Active"`
+	ExpiresAt                    *time.Time            `json:"ExpiresAt"`
+	Scopes                       []ServiceAccountScope `json:"Scopes"`
+	ServiceAccountToCompetitions []*ent.Competition    `json:"ServiceAccountToCompetitions"`
+	ServiceAccountToTeams        []*ent.Team           `json:"ServiceAccountToTeams"`
 }
 
 type ServiceAccountInput struct {
 	ID          *string `json:"ID"`
 	DisplayName string  `json:"DisplayName"`
 	Active      bool    `json:"Active"`
+	// Leave null for a service account which never expires.
+	ExpiresAt *time.Time `json:"ExpiresAt"`
+	// Leave null to grant every scope on create or to keep the existing scopes on update operations.
+	Scopes []ServiceAccountScope `json:"Scopes"`
+	// Limits the service account to these competitions and teams. Leave null to keep the existing limits on update operations.
+	ServiceAccountToCompetitions []string `json:"ServiceAccountToCompetitions"`
+	ServiceAccountToTeams        []string `json:"ServiceAccountToTeams"`
 }
 
 type SkeletonVMObject struct {
@@ -71,22 +150,57 @@ type SkeletonVMObject struct {
 }
 
 type TeamInput struct {
-	ID                *string `json:"ID"`
-	TeamNumber        int     `json:"TeamNumber"`
-	Name              *string `json:"Name"`
-	TeamToCompetition string  `json:"TeamToCompetition"`
+	ID         *string `json:"ID"`
+	TeamNumber int     `json:"TeamNumber"`
+	Name       *string `json:"Name"`
+	// Networks in CIDR notation (eg. "10.0.1.0/24"). Leave null to keep the existing allowlist on update operations.
+	AllowedCidrs      []string `json:"AllowedCidrs"`
+	TeamToCompetition string   `json:"TeamToCompetition"`
+}
+
+type TeamMembershipInput struct {
+	Team string             `json:"Team"`
+	Role TeamMembershipRole `json:"Role"`
+}
+
+type TotpEnrollment struct {
+	Secret          string `json:"Secret"`
+	ProvisioningURI string `json:"ProvisioningUri"`
+	QRCode          string `json:"QrCode"`
 }
 
 type UserInput struct {
-	ID         *string      `json:"ID"`
-	Username   string       `json:"Username"`
-	FirstName  string       `json:"FirstName"`
-	LastName   string       `json:"LastName"`
-	Role       Role         `json:"Role"`
-	Provider   AuthProvider `json:"Provider"`
-	UserToTeam *string      `json:"UserToTeam"`
+	ID        *string      `json:"ID"`
+	Username  string       `json:"Username"`
+	FirstName string       `json:"FirstName"`
+	LastName  string       `json:"LastName"`
+	Role      Role         `json:"Role"`
+	Provider  AuthProvider `json:"Provider"`
+	// The active team. Users are added to it as a member if it isn't one of their team memberships. When UserToTeamMemberships is null on update operations, users are taken off of their previous active team.
+	UserToTeam *string `json:"UserToTeam"`
+	// Replaces the user's team memberships. Leave null to keep the existing memberships on update operations.
+	UserToTeamMemberships []*TeamMembershipInput `json:"UserToTeamMemberships"`
+	UserToCustomRole      *string                `json:"UserToCustomRole"`
+	// Makes the user a competition-scoped admin of these competitions. Leave null to keep the existing competitions on update operations.
+	UserToAdminCompetitions []string `json:"UserToAdminCompetitions"`
 	// Value will be ignore on update operations. Use ChangePassword mutation instead.
 	Password string `json:"Password"`
+	// Forces the user to change their password the next time they sign in
+	MustChangePassword *bool `json:"MustChangePassword"`
+}
+
+type VMCredentialInput struct {
+	ID       *string              `json:"ID"`
+	Protocol VMCredentialProtocol `json:"Protocol"`
+	Port     int                  `json:"Port"`
+	Username string               `json:"Username"`
+	// Leave null to keep the existing password on update operations.
+	Password *string `json:"Password"`
+	// Leave null to keep the existing private key on update operations.
+	PrivateKey             *string `json:"PrivateKey"`
+	Domain                 string  `json:"Domain"`
+	IgnoreCert             bool    `json:"IgnoreCert"`
+	VMCredentialToVMObject string  `json:"VmCredentialToVmObject"`
 }
 
 type VMObjectInput struct {
@@ -95,6 +209,7 @@ type VMObjectInput struct {
 	Identifier     string   `json:"Identifier"`
 	IPAddresses    []string `json:"IPAddresses"`
 	Locked         *bool    `json:"Locked"`
+	RedTeamAccess  *bool    `json:"RedTeamAccess"`
 	VMObjectToTeam *string  `json:"VmObjectToTeam"`
 }
 
@@ -116,6 +231,17 @@ const (
 	ActionTypeUpdateObject       ActionType = "UPDATE_OBJECT"
 	ActionTypeDeleteObject       ActionType = "DELETE_OBJECT"
 	ActionTypeUpdateLockout      ActionType = "UPDATE_LOCKOUT"
+	ActionTypeMfaEnroll          ActionType = "MFA_ENROLL"
+	ActionTypeMfaDisable         ActionType = "MFA_DISABLE"
+	ActionTypeFailedMfa          ActionType = "FAILED_MFA"
+	ActionTypeAccountLocked      ActionType = "ACCOUNT_LOCKED"
+	ActionTypeAccountUnlocked    ActionType = "ACCOUNT_UNLOCKED"
+	ActionTypeRevokeSession      ActionType = "REVOKE_SESSION"
+	ActionTypeRotateSecret       ActionType = "ROTATE_SECRET"
+	ActionTypeCreateAccessToken  ActionType = "CREATE_ACCESS_TOKEN"
+	ActionTypeRevokeAccessToken  ActionType = "REVOKE_ACCESS_TOKEN"
+	ActionTypeImpersonate        ActionType = "IMPERSONATE"
+	ActionTypeIPNotAllowed       ActionType = "IP_NOT_ALLOWED"
 	ActionTypeUndefined          ActionType = "UNDEFINED"
 )
 
@@ -135,12 +261,23 @@ var AllActionType = []ActionType{
 	ActionTypeUpdateObject,
 	ActionTypeDeleteObject,
 	ActionTypeUpdateLockout,
+	ActionTypeMfaEnroll,
+	ActionTypeMfaDisable,
+	ActionTypeFailedMfa,
+	ActionTypeAccountLocked,
+	ActionTypeAccountUnlocked,
+	ActionTypeRevokeSession,
+	ActionTypeRotateSecret,
+	ActionTypeCreateAccessToken,
+	ActionTypeRevokeAccessToken,
+	ActionTypeImpersonate,
+	ActionTypeIPNotAllowed,
 	ActionTypeUndefined,
 }
 
 func (e ActionType) IsValid() bool {
 	switch e {
-	case ActionTypeSignIn, ActionTypeFailedSignIn, ActionTypeSignOut, ActionTypeAPICall, ActionTypeConsoleAccess, ActionTypeReboot, ActionTypeShutdown, ActionTypePowerOn, ActionTypePowerOff, ActionTypeChangeSelfPassword, ActionTypeChangePassword, ActionTypeCreateObject, ActionTypeUpdateObject, ActionTypeDeleteObject, ActionTypeUpdateLockout, ActionTypeUndefined:
+	case ActionTypeSignIn, ActionTypeFailedSignIn, ActionTypeSignOut, ActionTypeAPICall, ActionTypeConsoleAccess, ActionTypeReboot, ActionTypeShutdown, ActionTypePowerOn, ActionTypePowerOff, ActionTypeChangeSelfPassword, ActionTypeChangePassword, ActionTypeCreateObject, ActionTypeUpdateObject, ActionTypeDeleteObject, ActionTypeUpdateLockout, ActionTypeMfaEnroll, ActionTypeMfaDisable, ActionTypeFailedMfa, ActionTypeAccountLocked, ActionTypeAccountUnlocked, ActionTypeRevokeSession, ActionTypeRotateSecret, ActionTypeCreateAccessToken, ActionTypeRevokeAccessToken, ActionTypeImpersonate, ActionTypeIPNotAllowed, ActionTypeUndefined:
 		return true
 	}
 	return false
@@ -172,18 +309,24 @@ type AuthProvider string
 const (
 	AuthProviderLocal     AuthProvider = "LOCAL"
 	AuthProviderGitlab    AuthProvider = "GITLAB"
+	AuthProviderOidc      AuthProvider = "OIDC"
+	AuthProviderLdap      AuthProvider = "LDAP"
+	AuthProviderSaml      AuthProvider = "SAML"
 	AuthProviderUndefined AuthProvider = "UNDEFINED"
 )
 
 var AllAuthProvider = []AuthProvider{
 	AuthProviderLocal,
 	AuthProviderGitlab,
+	AuthProviderOidc,
+	AuthProviderLdap,
+	AuthProviderSaml,
 	AuthProviderUndefined,
 }
 
 func (e AuthProvider) IsValid() bool {
 	switch e {
-	case AuthProviderLocal, AuthProviderGitlab, AuthProviderUndefined:
+	case AuthProviderLocal, AuthProviderGitlab, AuthProviderOidc, AuthProviderLdap, AuthProviderSaml, AuthProviderUndefined:
 		return true
 	}
 	return false
@@ -213,11 +356,13 @@ func (e AuthProvider) MarshalGQL(w io.Writer) {
 type ConsoleType string
 
 const (
-	ConsoleTypeNovnc  ConsoleType = "NOVNC"
-	ConsoleTypeSpice  ConsoleType = "SPICE"
-	ConsoleTypeRdp    ConsoleType = "RDP"
-	ConsoleTypeSerial ConsoleType = "SERIAL"
-	ConsoleTypeMks    ConsoleType = "MKS"
+	ConsoleTypeNovnc   ConsoleType = "NOVNC"
+	ConsoleTypeSpice   ConsoleType = "SPICE"
+	ConsoleTypeRdp     ConsoleType = "RDP"
+	ConsoleTypeSerial  ConsoleType = "SERIAL"
+	ConsoleTypeMks     ConsoleType = "MKS"
+	ConsoleTypeGuacSSH ConsoleType = "GUAC_SSH"
+	ConsoleTypeGuacRdp ConsoleType = "GUAC_RDP"
 )
 
 var AllConsoleType = []ConsoleType{
@@ -226,11 +371,13 @@ var AllConsoleType = []ConsoleType{
 	ConsoleTypeRdp,
 	ConsoleTypeSerial,
 	ConsoleTypeMks,
+	ConsoleTypeGuacSSH,
+	ConsoleTypeGuacRdp,
 }
 
 func (e ConsoleType) IsValid() bool {
 	switch e {
-	case ConsoleTypeNovnc, ConsoleTypeSpice, ConsoleTypeRdp, ConsoleTypeSerial, ConsoleTypeMks:
+	case ConsoleTypeNovnc, ConsoleTypeSpice, ConsoleTypeRdp, ConsoleTypeSerial, ConsoleTypeMks, ConsoleTypeGuacSSH, ConsoleTypeGuacRdp:
 		return true
 	}
 	return false
@@ -257,6 +404,168 @@ func (e ConsoleType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LockoutType string
+
+const (
+	LockoutTypeUsername LockoutType = "USERNAME"
+	LockoutTypeIP       LockoutType = "IP"
+	LockoutTypeAPIKey   LockoutType = "API_KEY"
+	// Wrong passwords for a console share link, identified by the share link's ID
+	LockoutTypeShareLink LockoutType = "SHARE_LINK"
+)
+
+var AllLockoutType = []LockoutType{
+	LockoutTypeUsername,
+	LockoutTypeIP,
+	LockoutTypeAPIKey,
+	LockoutTypeShareLink,
+}
+
+func (e LockoutType) IsValid() bool {
+	switch e {
+	case LockoutTypeUsername, LockoutTypeIP, LockoutTypeAPIKey, LockoutTypeShareLink:
+		return true
+	}
+	return false
+}
+
+func (e LockoutType) String() string {
+	return string(e)
+}
+
+func (e *LockoutType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LockoutType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LockoutType", str)
+	}
+	return nil
+}
+
+func (e LockoutType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Permission string
+
+const (
+	PermissionCompetitionRead     Permission = "COMPETITION_READ"
+	PermissionCompetitionWrite    Permission = "COMPETITION_WRITE"
+	PermissionTeamRead            Permission = "TEAM_READ"
+	PermissionTeamWrite           Permission = "TEAM_WRITE"
+	PermissionUserRead            Permission = "USER_READ"
+	PermissionUserWrite           Permission = "USER_WRITE"
+	PermissionUserImpersonate     Permission = "USER_IMPERSONATE"
+	PermissionVMRead              Permission = "VM_READ"
+	PermissionVMWrite             Permission = "VM_WRITE"
+	PermissionVMConsole           Permission = "VM_CONSOLE"
+	PermissionVMPower             Permission = "VM_POWER"
+	PermissionVMLockout           Permission = "VM_LOCKOUT"
+	PermissionRedTeamConsole      Permission = "RED_TEAM_CONSOLE"
+	PermissionProviderRead        Permission = "PROVIDER_READ"
+	PermissionProviderWrite       Permission = "PROVIDER_WRITE"
+	PermissionServiceAccountRead  Permission = "SERVICE_ACCOUNT_READ"
+	PermissionServiceAccountWrite Permission = "SERVICE_ACCOUNT_WRITE"
+	PermissionLogsRead            Permission = "LOGS_READ"
+	PermissionRoleWrite           Permission = "ROLE_WRITE"
+)
+
+var AllPermission = []Permission{
+	PermissionCompetitionRead,
+	PermissionCompetitionWrite,
+	PermissionTeamRead,
+	PermissionTeamWrite,
+	PermissionUserRead,
+	PermissionUserWrite,
+	PermissionUserImpersonate,
+	PermissionVMRead,
+	PermissionVMWrite,
+	PermissionVMConsole,
+	PermissionVMPower,
+	PermissionVMLockout,
+	PermissionRedTeamConsole,
+	PermissionProviderRead,
+	PermissionProviderWrite,
+	PermissionServiceAccountRead,
+	PermissionServiceAccountWrite,
+	PermissionLogsRead,
+	PermissionRoleWrite,
+}
+
+func (e Permission) IsValid() bool {
+	switch e {
+	case PermissionCompetitionRead, PermissionCompetitionWrite, PermissionTeamRead, PermissionTeamWrite, PermissionUserRead, PermissionUserWrite, PermissionUserImpersonate, PermissionVMRead, PermissionVMWrite, PermissionVMConsole, PermissionVMPower, PermissionVMLockout, PermissionRedTeamConsole, PermissionProviderRead, PermissionProviderWrite, PermissionServiceAccountRead, PermissionServiceAccountWrite, PermissionLogsRead, PermissionRoleWrite:
+		return true
+	}
+	return false
+}
+
+func (e Permission) String() string {
+	return string(e)
+}
+
+func (e *Permission) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Permission(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Permission", str)
+	}
+	return nil
+}
+
+func (e Permission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PersonalAccessTokenScope string
+
+const (
+	PersonalAccessTokenScopeReadOnly PersonalAccessTokenScope = "READ_ONLY"
+	PersonalAccessTokenScopeFull     PersonalAccessTokenScope = "FULL"
+)
+
+var AllPersonalAccessTokenScope = []PersonalAccessTokenScope{
+	PersonalAccessTokenScopeReadOnly,
+	PersonalAccessTokenScopeFull,
+}
+
+func (e PersonalAccessTokenScope) IsValid() bool {
+	switch e {
+	case PersonalAccessTokenScopeReadOnly, PersonalAccessTokenScopeFull:
+		return true
+	}
+	return false
+}
+
+func (e PersonalAccessTokenScope) String() string {
+	return string(e)
+}
+
+func (e *PersonalAccessTokenScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PersonalAccessTokenScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PersonalAccessTokenScope", str)
+	}
+	return nil
+}
+
+func (e PersonalAccessTokenScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PowerState string
 
 const (
@@ -352,18 +661,24 @@ type Role string
 const (
 	RoleUser      Role = "USER"
 	RoleAdmin     Role = "ADMIN"
+	RoleWhiteTeam Role = "WHITE_TEAM"
+	RoleBlackTeam Role = "BLACK_TEAM"
+	RoleRedTeam   Role = "RED_TEAM"
 	RoleUndefined Role = "UNDEFINED"
 )
 
 var AllRole = []Role{
 	RoleUser,
 	RoleAdmin,
+	RoleWhiteTeam,
+	RoleBlackTeam,
+	RoleRedTeam,
 	RoleUndefined,
 }
 
 func (e Role) IsValid() bool {
 	switch e {
-	case RoleUser, RoleAdmin, RoleUndefined:
+	case RoleUser, RoleAdmin, RoleWhiteTeam, RoleBlackTeam, RoleRedTeam, RoleUndefined:
 		return true
 	}
 	return false
@@ -389,3 +704,147 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type ServiceAccountScope string
+
+const (
+	ServiceAccountScopeVMRead           ServiceAccountScope = "VM_READ"
+	ServiceAccountScopeVMWrite          ServiceAccountScope = "VM_WRITE"
+	ServiceAccountScopeVMPower          ServiceAccountScope = "VM_POWER"
+	ServiceAccountScopeCompetitionRead  ServiceAccountScope = "COMPETITION_READ"
+	ServiceAccountScopeCompetitionWrite ServiceAccountScope = "COMPETITION_WRITE"
+	ServiceAccountScopeTeamRead         ServiceAccountScope = "TEAM_READ"
+	ServiceAccountScopeTeamWrite        ServiceAccountScope = "TEAM_WRITE"
+	ServiceAccountScopeUserRead         ServiceAccountScope = "USER_READ"
+	ServiceAccountScopeUserWrite        ServiceAccountScope = "USER_WRITE"
+	ServiceAccountScopeProviderRead     ServiceAccountScope = "PROVIDER_READ"
+	ServiceAccountScopeProviderWrite    ServiceAccountScope = "PROVIDER_WRITE"
+)
+
+var AllServiceAccountScope = []ServiceAccountScope{
+	ServiceAccountScopeVMRead,
+	ServiceAccountScopeVMWrite,
+	ServiceAccountScopeVMPower,
+	ServiceAccountScopeCompetitionRead,
+	ServiceAccountScopeCompetitionWrite,
+	ServiceAccountScopeTeamRead,
+	ServiceAccountScopeTeamWrite,
+	ServiceAccountScopeUserRead,
+	ServiceAccountScopeUserWrite,
+	ServiceAccountScopeProviderRead,
+	ServiceAccountScopeProviderWrite,
+}
+
+func (e ServiceAccountScope) IsValid() bool {
+	switch e {
+	case ServiceAccountScopeVMRead, ServiceAccountScopeVMWrite, ServiceAccountScopeVMPower, ServiceAccountScopeCompetitionRead, ServiceAccountScopeCompetitionWrite, ServiceAccountScopeTeamRead, ServiceAccountScopeTeamWrite, ServiceAccountScopeUserRead, ServiceAccountScopeUserWrite, ServiceAccountScopeProviderRead, ServiceAccountScopeProviderWrite:
+		return true
+	}
+	return false
+}
+
+func (e ServiceAccountScope) String() string {
+	return string(e)
+}
+
+func (e *ServiceAccountScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServiceAccountScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceAccountScope", str)
+	}
+	return nil
+}
+
+func (e ServiceAccountScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TeamMembershipRole string
+
+const (
+	TeamMembershipRoleMember  TeamMembershipRole = "MEMBER"
+	TeamMembershipRoleCaptain TeamMembershipRole = "CAPTAIN"
+	// Observers can view their team's VMs but can't open consoles on or power them
+	TeamMembershipRoleObserver TeamMembershipRole = "OBSERVER"
+)
+
+var AllTeamMembershipRole = []TeamMembershipRole{
+	TeamMembershipRoleMember,
+	TeamMembershipRoleCaptain,
+	TeamMembershipRoleObserver,
+}
+
+func (e TeamMembershipRole) IsValid() bool {
+	switch e {
+	case TeamMembershipRoleMember, TeamMembershipRoleCaptain, TeamMembershipRoleObserver:
+		return true
+	}
+	return false
+}
+
+func (e TeamMembershipRole) String() string {
+	return string(e)
+}
+
+func (e *TeamMembershipRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TeamMembershipRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TeamMembershipRole", str)
+	}
+	return nil
+}
+
+func (e TeamMembershipRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type VMCredentialProtocol string
+
+const (
+	VMCredentialProtocolSSH VMCredentialProtocol = "SSH"
+	VMCredentialProtocolRdp VMCredentialProtocol = "RDP"
+)
+
+var AllVMCredentialProtocol = []VMCredentialProtocol{
+	VMCredentialProtocolSSH,
+	VMCredentialProtocolRdp,
+}
+
+func (e VMCredentialProtocol) IsValid() bool {
+	switch e {
+	case VMCredentialProtocolSSH, VMCredentialProtocolRdp:
+		return true
+	}
+	return false
+}
+
+func (e VMCredentialProtocol) String() string {
+	return string(e)
+}
+
+func (e *VMCredentialProtocol) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VMCredentialProtocol(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VmCredentialProtocol", str)
+	}
+	return nil
+}
+
+func (e VMCredentialProtocol) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -2,16 +2,31 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/consolecache"
+	"github.com/BradHacker/compsole/compsole/mfa"
+	"github.com/BradHacker/compsole/compsole/permissions"
 	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/sessions"
 	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/action"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/predicate"
+	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/token"
+	"github.com/BradHacker/compsole/ent/user"
 	"github.com/BradHacker/compsole/graph/generated"
 	"github.com/BradHacker/compsole/graph/model"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -23,9 +38,28 @@ import (
 //go:generate go run github.com/99designs/gqlgen generate
 
 type Resolver struct {
-	client    *ent.Client
-	rdb       *redis.Client
-	providers *providers.ProviderMap
+	client       *ent.Client
+	rdb          *redis.Client
+	providers    *providers.ProviderMap
+	consoleCache *consolecache.ConsoleCache
+	loginLimiter *ratelimit.Limiter
+	sessions     *sessions.Manager
+}
+
+// mfaEnrollmentFields are the only operations allowed for users who must set up multi-factor authentication but
+// haven't yet
+var mfaEnrollmentFields = map[string]bool{
+	"me":          true,
+	"enrollTotp":  true,
+	"confirmTotp": true,
+	// Passkeys are registered through /api/auth/webauthn/register
+	"myWebauthnCredentials": true,
+}
+
+// passwordChangeFields are the only operations allowed for users who must change their password
+var passwordChangeFields = map[string]bool{
+	"me":                 true,
+	"changeSelfPassword": true,
 }
 
 type ContextKey string
@@ -35,48 +69,391 @@ const (
 )
 
 // NewSchema creates a graphql executable schema.
-func NewSchema(ctx context.Context, client *ent.Client, rdb *redis.Client) graphql.ExecutableSchema {
-	// Inject providers
-	compsoleProviders := &providers.ProviderMap{}
-	entProviders, err := client.Provider.Query().All(ctx)
-	if err != nil {
-		logrus.Fatalf("failed to startup graphql resolver: failed to query providers")
-	}
-	for _, entProvider := range entProviders {
-		// Generate the provider
-		provider, err := providers.NewProvider(ctx, entProvider.Type, entProvider.Config)
-		if err != nil {
-			logrus.Errorf("failed to create provider from config: %v", err)
-		} else {
-			compsoleProviders.Set(entProvider.ID, provider)
-		}
-	}
-
+func NewSchema(ctx context.Context, client *ent.Client, rdb *redis.Client, compsoleProviders *providers.ProviderMap, sessionManager *sessions.Manager) graphql.ExecutableSchema {
 	GQLConfig := generated.Config{
 		Resolvers: &Resolver{
-			client:    client,
-			rdb:       rdb,
-			providers: compsoleProviders,
+			client:       client,
+			rdb:          rdb,
+			providers:    compsoleProviders,
+			consoleCache: consolecache.New(rdb),
+			loginLimiter: ratelimit.New(rdb),
+			sessions:     sessionManager,
 		},
 	}
-	GQLConfig.Directives.HasRole = func(ctx context.Context, obj interface{}, next graphql.Resolver, roles []model.Role) (res interface{}, err error) {
+	GQLConfig.Directives.Authenticated = func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
+		currentUser, err := api.ForContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkUserRequirements(ctx, currentUser); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+	GQLConfig.Directives.HasPermission = func(ctx context.Context, obj interface{}, next graphql.Resolver, permission model.Permission, scoped *bool) (res interface{}, err error) {
 		currentUser, err := api.ForContext(ctx)
 		if err != nil {
 			return nil, err
 		}
-		for _, role := range roles {
-			if role.String() == string(currentUser.Role) {
-				return next(ctx)
+		var hasPermission bool
+		if scoped != nil && *scoped {
+			// The resolver limits competition admins to their competitions
+			hasPermission, err = permissions.HasAnywhere(ctx, currentUser, permissionFromModel(permission))
+		} else {
+			hasPermission, err = permissions.Has(ctx, currentUser, permissionFromModel(permission))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !hasPermission {
+			return nil, &gqlerror.Error{
+				Message: "User is not authorized to perform this action",
+				Extensions: map[string]interface{}{
+					"code": "401",
+				},
 			}
 		}
-		return nil, &gqlerror.Error{
-			Message: "User is not authorized to perform this action",
+		if err := checkUserRequirements(ctx, currentUser); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+	return generated.NewExecutableSchema(GQLConfig)
+}
+
+// checkUserRequirements only allows the fields needed to change the user's password or set up multi-factor
+// authentication until they have done so
+func checkUserRequirements(ctx context.Context, currentUser *ent.User) error {
+	// The admin's own requirements apply while they are impersonating someone
+	if entImpersonator, err := api.ForContextImpersonator(ctx); err == nil {
+		currentUser = entImpersonator
+	}
+	if currentUser.MustChangePassword {
+		if !passwordChangeFields[graphql.GetFieldContext(ctx).Field.Name] {
+			return &gqlerror.Error{
+				Message: "Password must be changed before continuing",
+				Extensions: map[string]interface{}{
+					"code": "PASSWORD_CHANGE_REQUIRED",
+				},
+			}
+		}
+		return nil
+	}
+	enrollmentRequired, err := mfa.EnrollmentRequired(ctx, currentUser)
+	if err != nil {
+		return err
+	}
+	if enrollmentRequired && !mfaEnrollmentFields[graphql.GetFieldContext(ctx).Field.Name] {
+		return &gqlerror.Error{
+			Message: "Multi-factor authentication must be set up before continuing",
 			Extensions: map[string]interface{}{
-				"code": "401",
+				"code": "MFA_ENROLLMENT_REQUIRED",
 			},
 		}
 	}
-	return generated.NewExecutableSchema(GQLConfig)
+	return nil
+}
+
+// checkCanGrant returns an error if the user is missing any of the permissions, so users can't give anyone
+// permissions they don't have themselves
+func checkCanGrant(ctx context.Context, authUser *ent.User, requested []permissions.Permission) error {
+	missing, err := permissions.Missing(ctx, authUser, requested)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("can't grant permissions you don't have: %s", strings.Join(permissions.Strings(missing), ", "))
+	}
+	return nil
+}
+
+// canManageUser returns whether authUser can change entUser's account. Users need every permission entUser has, so
+// they can't take over more privileged accounts. Competition admins can only manage users who are only on the teams
+// of their competitions and don't administer any other competitions.
+func canManageUser(ctx context.Context, authUser *ent.User, entUser *ent.User) (bool, error) {
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
+	if errors.Is(err, permissions.ErrNotPermitted) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !scope.IsGlobal() {
+		onScopedTeam, err := entUser.QueryUserToTeamMemberships().
+			Where(teammembership.HasTeamMembershipToTeamWith(scope.Teams())).
+			Exist(ctx)
+		if err != nil || !onScopedTeam {
+			return false, err
+		}
+		memberOutside, err := scope.MemberOutside(ctx, entUser)
+		if err != nil || memberOutside {
+			return false, err
+		}
+		administersOutside, err := scope.AdministersOutside(ctx, entUser)
+		if err != nil || administersOutside {
+			return false, err
+		}
+	}
+	userPermissions, err := permissions.ForUser(ctx, entUser)
+	if err != nil {
+		return false, err
+	}
+	missing, err := permissions.Missing(ctx, authUser, userPermissions)
+	if err != nil {
+		return false, err
+	}
+	return len(missing) == 0, nil
+}
+
+// rolePermissions returns the permissions a user with the built-in role and custom role would have
+func rolePermissions(role user.Role, entCustomRole *ent.CustomRole) []permissions.Permission {
+	requested := permissions.ForRole(role)
+	if entCustomRole != nil {
+		for _, permission := range entCustomRole.Permissions {
+			requested = append(requested, permissions.Permission(permission))
+		}
+	}
+	return requested
+}
+
+// customRoleFromInput queries the custom role set on a UserInput, if any
+func (r *Resolver) customRoleFromInput(ctx context.Context, customRoleId *string) (*ent.CustomRole, error) {
+	if customRoleId == nil {
+		return nil, nil
+	}
+	customRoleUuid, err := uuid.Parse(*customRoleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse UserToCustomRole UUID: %v", err)
+	}
+	entCustomRole, err := r.client.CustomRole.Get(ctx, customRoleUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom role: %v", err)
+	}
+	return entCustomRole, nil
+}
+
+// adminCompetitionsFromInput parses the competitions set on a UserInput and returns whether they differ from the
+// competitions entUser (nil for new users) currently administers. Competition admins get the competition-scoped
+// permissions, so only users who have those everywhere can change them.
+func adminCompetitionsFromInput(ctx context.Context, authUser *ent.User, entUser *ent.User, competitionIds []string) ([]uuid.UUID, bool, error) {
+	if competitionIds == nil {
+		return nil, false, nil
+	}
+	competitionUuids, err := parseUuids(competitionIds)
+	if err != nil {
+		return nil, false, err
+	}
+	current := make(map[uuid.UUID]bool)
+	if entUser != nil {
+		currentUuids, err := entUser.QueryUserToAdminCompetitions().IDs(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to query admin competitions from user: %v", err)
+		}
+		for _, competitionUuid := range currentUuids {
+			current[competitionUuid] = true
+		}
+	}
+	changed := len(current) != len(competitionUuids)
+	for _, competitionUuid := range competitionUuids {
+		if !current[competitionUuid] {
+			changed = true
+		}
+	}
+	if !changed {
+		return competitionUuids, false, nil
+	}
+	if err = checkCanGrant(ctx, authUser, permissions.CompetitionScoped); err != nil {
+		return nil, false, err
+	}
+	return competitionUuids, true, nil
+}
+
+// teamMembership is a TeamMembershipInput with its team queried
+type teamMembership struct {
+	team *ent.Team
+	role teammembership.Role
+}
+
+// teamMembershipsFromInput queries the teams of the memberships set on a UserInput. Competition admins can only add
+// users to the teams of their competitions.
+func (r *Resolver) teamMembershipsFromInput(ctx context.Context, scope *permissions.Scope, input []*model.TeamMembershipInput) ([]teamMembership, error) {
+	if input == nil {
+		return nil, nil
+	}
+	memberships := make([]teamMembership, 0, len(input))
+	seen := make(map[uuid.UUID]bool)
+	for _, membershipInput := range input {
+		teamUuid, err := uuid.Parse(membershipInput.Team)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+		}
+		if seen[teamUuid] {
+			return nil, fmt.Errorf("users can only have one membership per team")
+		}
+		seen[teamUuid] = true
+		entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query team: %v", err)
+		}
+		memberships = append(memberships, teamMembership{
+			team: entTeam,
+			role: teammembership.Role(membershipInput.Role),
+		})
+	}
+	return memberships, nil
+}
+
+// setTeamMemberships replaces entUser's team memberships
+func (r *Resolver) setTeamMemberships(ctx context.Context, entUser *ent.User, memberships []teamMembership) error {
+	_, err := r.client.TeamMembership.Delete().
+		Where(teammembership.HasTeamMembershipToUserWith(user.IDEQ(entUser.ID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete team memberships: %v", err)
+	}
+	entMembershipCreates := make([]*ent.TeamMembershipCreate, len(memberships))
+	for i, membership := range memberships {
+		entMembershipCreates[i] = r.client.TeamMembership.Create().
+			SetTeamMembershipToUser(entUser).
+			SetTeamMembershipToTeam(membership.team).
+			SetRole(membership.role)
+	}
+	if err = r.client.TeamMembership.CreateBulk(entMembershipCreates...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create team memberships: %v", err)
+	}
+	return nil
+}
+
+// revokeUserSessions signs the user out of every session except the one making the request and logs why
+func (r *Resolver) revokeUserSessions(ctx context.Context, authUser *ent.User, entUser *ent.User, clientIp string, reason string) error {
+	where := []predicate.Token{token.HasTokenToUserWith(user.IDEQ(entUser.ID))}
+	if currentSession, err := api.ForContextSession(ctx); err == nil {
+		where = append(where, token.IDNEQ(currentSession.ID))
+	}
+	revoked, err := r.sessions.Revoke(ctx, where...)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %v", err)
+	}
+	if revoked == 0 {
+		return nil
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeREVOKE_SESSION).
+		SetMessage(fmt.Sprintf("revoked %d sessions for user %s (%s)", revoked, entUser.Username, reason)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log REVOKE_SESSION: %v", err)
+	}
+	return nil
+}
+
+// revokeUserAccessTokens deletes every personal access token of the user and logs why
+func (r *Resolver) revokeUserAccessTokens(ctx context.Context, authUser *ent.User, entUser *ent.User, clientIp string, reason string) error {
+	revoked, err := r.client.PersonalAccessToken.Delete().
+		Where(personalaccesstoken.HasPersonalAccessTokenToUserWith(user.IDEQ(entUser.ID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %v", err)
+	}
+	if revoked == 0 {
+		return nil
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeREVOKE_ACCESS_TOKEN).
+		SetMessage(fmt.Sprintf("revoked %d personal access tokens for user %s (%s)", revoked, entUser.Username, reason)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log REVOKE_ACCESS_TOKEN: %v", err)
+	}
+	return nil
+}
+
+// scopesToModel converts the scopes stored on a service account (eg. "vm:read") to the GraphQL enum (eg. VM_READ)
+func scopesToModel(scopes []string) []model.ServiceAccountScope {
+	modelScopes := make([]model.ServiceAccountScope, len(scopes))
+	for i, scope := range scopes {
+		modelScopes[i] = model.ServiceAccountScope(strings.ToUpper(strings.Replace(scope, ":", "_", 1)))
+	}
+	return modelScopes
+}
+
+// scopesFromModel converts the GraphQL enum (eg. VM_READ) to the scopes stored on a service account (eg. "vm:read")
+func scopesFromModel(modelScopes []model.ServiceAccountScope) []string {
+	scopes := make([]string, len(modelScopes))
+	for i, modelScope := range modelScopes {
+		scopes[i] = strings.ToLower(strings.Replace(string(modelScope), "_", ":", 1))
+	}
+	return scopes
+}
+
+// permissionsToModel converts permissions (eg. "vm:read") to the GraphQL enum (eg. VM_READ)
+func permissionsToModel(userPermissions []permissions.Permission) []model.Permission {
+	modelPermissions := make([]model.Permission, len(userPermissions))
+	for i, permission := range userPermissions {
+		modelPermissions[i] = model.Permission(strings.ToUpper(strings.Replace(string(permission), ":", "_", 1)))
+	}
+	return modelPermissions
+}
+
+// permissionFromModel converts the GraphQL enum (eg. VM_READ) to a permission (eg. "vm:read"). Permissions can't be
+// converted back by swapping the first underscore since some contain more than one (eg. SERVICE_ACCOUNT_READ).
+func permissionFromModel(modelPermission model.Permission) permissions.Permission {
+	for _, permission := range permissions.All {
+		if permissionsToModel([]permissions.Permission{permission})[0] == modelPermission {
+			return permission
+		}
+	}
+	return permissions.Permission(modelPermission)
+}
+
+// permissionsFromModel converts a list of the GraphQL enum to permissions
+func permissionsFromModel(modelPermissions []model.Permission) []permissions.Permission {
+	userPermissions := make([]permissions.Permission, len(modelPermissions))
+	for i, modelPermission := range modelPermissions {
+		userPermissions[i] = permissionFromModel(modelPermission)
+	}
+	return userPermissions
+}
+
+// parseUuids parses a list of IDs from a GraphQL input
+func parseUuids(ids []string) ([]uuid.UUID, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse uuid \"%s\": %v", id, err)
+		}
+		uuids[i] = parsed
+	}
+	return uuids, nil
+}
+
+// serviceAccountDetails returns the service account along with its secret. Only used right after the secret is
+// generated since it is never stored.
+func serviceAccountDetails(ctx context.Context, entServiceAccount *ent.ServiceAccount, apiSecret uuid.UUID) (*model.ServiceAccountDetails, error) {
+	entCompetitions, err := entServiceAccount.QueryServiceAccountToCompetitions().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service account competitions: %v", err)
+	}
+	entTeams, err := entServiceAccount.QueryServiceAccountToTeams().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service account teams: %v", err)
+	}
+	return &model.ServiceAccountDetails{
+		ID:                           entServiceAccount.ID.String(),
+		DisplayName:                  entServiceAccount.DisplayName,
+		APIKey:                       entServiceAccount.APIKey.String(),
+		APISecret:                    apiSecret.String(),
+		Active:                       entServiceAccount.Active,
+		ExpiresAt:                    entServiceAccount.ExpiresAt,
+		Scopes:                       scopesToModel(entServiceAccount.Scopes),
+		ServiceAccountToCompetitions: entCompetitions,
+		ServiceAccountToTeams:        entTeams,
+	}, nil
 }
 
 func GinContextToContextMiddleware() gin.HandlerFunc {
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/viewer"
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/enttest"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestResolver returns a resolver backed by an in-memory database and redis, along with a context which bypasses
+// the privacy policies for setting up fixtures
+func newTestResolver(t *testing.T) (context.Context, *Resolver) {
+	t.Helper()
+	ctx := viewer.SystemContext(context.Background())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+	if err := signing.Init(ctx, client); err != nil {
+		t.Fatalf("failed to init signing keys: %v", err)
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	return ctx, &Resolver{
+		client:       client,
+		rdb:          rdb,
+		loginLimiter: ratelimit.New(rdb),
+		sessions:     sessions.New(client, rdb),
+	}
+}
+
+// asUser signs the user in and calls fn with the context a GraphQL request of theirs would have, after it went through
+// the same middleware as in server.go
+func asUser(t *testing.T, r *Resolver, entUser *ent.User, fn func(ctx context.Context)) {
+	t.Helper()
+	expiresAt := time.Now().Add(time.Hour)
+	tokenString, err := signing.Sign(&api.CompsoleJWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   entUser.ID.String(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to sign session: %v", err)
+	}
+	r.client.Token.Create().
+		SetTokenToUser(entUser).
+		SetToken(tokenString).
+		SetExpireAt(expiresAt.Unix()).
+		ExecX(viewer.SystemContext(context.Background()))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.ContextWithFallback = true
+	called := false
+	router.POST("/api/graphql", api.UnauthenticatedMiddleware(), api.Middleware(r.client), GinContextToContextMiddleware(), func(c *gin.Context) {
+		called = true
+		fn(c.Request.Context())
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", nil)
+	req.AddCookie(&http.Cookie{Name: "auth-cookie", Value: tokenString})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if !called {
+		t.Fatalf("request was rejected: %d %s", w.Code, w.Body.String())
+	}
+}
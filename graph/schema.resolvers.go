@@ -12,16 +12,33 @@ import (
 	"time"
 
 	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/allowlist"
+	"github.com/BradHacker/compsole/compsole/mfa"
+	"github.com/BradHacker/compsole/compsole/permissions"
 	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/schedule"
 	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/compsole/viewer"
 	"github.com/BradHacker/compsole/ent"
 	"github.com/BradHacker/compsole/ent/action"
 	"github.com/BradHacker/compsole/ent/competition"
+	"github.com/BradHacker/compsole/ent/competitionbreak"
+	"github.com/BradHacker/compsole/ent/consolesession"
+	"github.com/BradHacker/compsole/ent/consoleshare"
+	"github.com/BradHacker/compsole/ent/customrole"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	"github.com/BradHacker/compsole/ent/predicate"
 	"github.com/BradHacker/compsole/ent/provider"
 	"github.com/BradHacker/compsole/ent/serviceaccount"
+	"github.com/BradHacker/compsole/ent/servicetoken"
 	"github.com/BradHacker/compsole/ent/team"
+	"github.com/BradHacker/compsole/ent/teammembership"
+	"github.com/BradHacker/compsole/ent/token"
 	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/ent/vmcredential"
 	"github.com/BradHacker/compsole/ent/vmobject"
+	"github.com/BradHacker/compsole/ent/webauthncredential"
 	"github.com/BradHacker/compsole/graph/generated"
 	"github.com/BradHacker/compsole/graph/model"
 	"github.com/google/uuid"
@@ -43,6 +60,50 @@ func (r *competitionResolver) ID(ctx context.Context, obj *ent.Competition) (str
 	return obj.ID.String(), nil
 }
 
+// ID is the resolver for the ID field.
+func (r *competitionBreakResolver) ID(ctx context.Context, obj *ent.CompetitionBreak) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// ID is the resolver for the ID field.
+func (r *consoleSessionResolver) ID(ctx context.Context, obj *ent.ConsoleSession) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// ConsoleType is the resolver for the ConsoleType field.
+func (r *consoleSessionResolver) ConsoleType(ctx context.Context, obj *ent.ConsoleSession) (model.ConsoleType, error) {
+	return model.ConsoleType(obj.ConsoleType), nil
+}
+
+// ID is the resolver for the ID field.
+func (r *consoleShareResolver) ID(ctx context.Context, obj *ent.ConsoleShare) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// ConsoleType is the resolver for the ConsoleType field.
+func (r *consoleShareResolver) ConsoleType(ctx context.Context, obj *ent.ConsoleShare) (model.ConsoleType, error) {
+	return model.ConsoleType(obj.ConsoleType), nil
+}
+
+// HasPassword is the resolver for the HasPassword field.
+func (r *consoleShareResolver) HasPassword(ctx context.Context, obj *ent.ConsoleShare) (bool, error) {
+	return obj.Password != "", nil
+}
+
+// ID is the resolver for the ID field.
+func (r *customRoleResolver) ID(ctx context.Context, obj *ent.CustomRole) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// Permissions is the resolver for the Permissions field.
+func (r *customRoleResolver) Permissions(ctx context.Context, obj *ent.CustomRole) ([]model.Permission, error) {
+	modelPermissions := make([]model.Permission, len(obj.Permissions))
+	for i, permission := range obj.Permissions {
+		modelPermissions[i] = permissionsToModel([]permissions.Permission{permissions.Permission(permission)})[0]
+	}
+	return modelPermissions, nil
+}
+
 // Reboot is the resolver for the reboot field.
 func (r *mutationResolver) Reboot(ctx context.Context, vmObjectID string, rebootType model.RebootType) (bool, error) {
 	entUser, err := api.ForContext(ctx)
@@ -76,14 +137,18 @@ func (r *mutationResolver) Reboot(ctx context.Context, vmObjectID string, reboot
 		return false, fmt.Errorf("failed to query vm object: %v", err)
 	}
 	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser, permissions.VmPower)
 	if err != nil {
 		return false, fmt.Errorf("failed to check access to vm: %v", err)
 	}
 	if !canAccessVm {
 		return false, fmt.Errorf("user does not have permission to access this vm")
 	}
-	if entUser.Role != user.RoleADMIN && entVmObject.Locked {
+	lockedOut, err := utils.UserIsLockedOut(ctx, entVmObject, entUser, permissions.VmPower)
+	if err != nil {
+		return false, fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
 		return false, fmt.Errorf("VM is currently locked out")
 	}
 	// Get DB objects
@@ -110,7 +175,15 @@ func (r *mutationResolver) Reboot(ctx context.Context, vmObjectID string, reboot
 		logrus.Warnf("failed to log REBOOT: %v", err)
 	}
 	// Reboot the VM
-	return true, provider.RestartVM(ctx, entVmObject, utils.RebootType(rebootType))
+	err = provider.RestartVM(ctx, entVmObject, utils.RebootType(rebootType))
+	if err != nil {
+		return false, err
+	}
+	// Consoles issued before the power change are no longer valid
+	if err := r.consoleCache.Invalidate(ctx, entVmObject.ID); err != nil {
+		logrus.Warnf("failed to invalidate cached consoles after reboot: %v", err)
+	}
+	return true, nil
 }
 
 // PowerOn is the resolver for the powerOn field.
@@ -146,14 +219,18 @@ func (r *mutationResolver) PowerOn(ctx context.Context, vmObjectID string) (bool
 		return false, fmt.Errorf("failed to query vm object: %v", err)
 	}
 	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser, permissions.VmPower)
 	if err != nil {
 		return false, fmt.Errorf("failed to check access to vm: %v", err)
 	}
 	if !canAccessVm {
 		return false, fmt.Errorf("user does not have permission to access this vm")
 	}
-	if entUser.Role != user.RoleADMIN && entVmObject.Locked {
+	lockedOut, err := utils.UserIsLockedOut(ctx, entVmObject, entUser, permissions.VmPower)
+	if err != nil {
+		return false, fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
 		return false, fmt.Errorf("VM is currently locked out")
 	}
 	// Get DB objects
@@ -180,7 +257,15 @@ func (r *mutationResolver) PowerOn(ctx context.Context, vmObjectID string) (bool
 		logrus.Warnf("failed to log POWER_ON: %v", err)
 	}
 	// Power on the VM
-	return true, provider.PowerOnVM(ctx, entVmObject)
+	err = provider.PowerOnVM(ctx, entVmObject)
+	if err != nil {
+		return false, err
+	}
+	// Consoles issued before the power change are no longer valid
+	if err := r.consoleCache.Invalidate(ctx, entVmObject.ID); err != nil {
+		logrus.Warnf("failed to invalidate cached consoles after power on: %v", err)
+	}
+	return true, nil
 }
 
 // PowerOff is the resolver for the powerOff field.
@@ -216,14 +301,18 @@ func (r *mutationResolver) PowerOff(ctx context.Context, vmObjectID string) (boo
 		return false, fmt.Errorf("failed to query vm object: %v", err)
 	}
 	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser, permissions.VmPower)
 	if err != nil {
 		return false, fmt.Errorf("failed to check access to vm: %v", err)
 	}
 	if !canAccessVm {
 		return false, fmt.Errorf("user does not have permission to access this vm")
 	}
-	if entUser.Role != user.RoleADMIN && entVmObject.Locked {
+	lockedOut, err := utils.UserIsLockedOut(ctx, entVmObject, entUser, permissions.VmPower)
+	if err != nil {
+		return false, fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
 		return false, fmt.Errorf("VM is currently locked out")
 	}
 	// Get DB objects
@@ -250,7 +339,15 @@ func (r *mutationResolver) PowerOff(ctx context.Context, vmObjectID string) (boo
 		logrus.Warnf("failed to log POWER_OFF: %v", err)
 	}
 	// Power on the VM
-	return true, provider.PowerOffVM(ctx, entVmObject)
+	err = provider.PowerOffVM(ctx, entVmObject)
+	if err != nil {
+		return false, err
+	}
+	// Consoles issued before the power change are no longer valid
+	if err := r.consoleCache.Invalidate(ctx, entVmObject.ID); err != nil {
+		logrus.Warnf("failed to invalidate cached consoles after power off: %v", err)
+	}
+	return true, nil
 }
 
 // UpdateAccount is the resolver for the updateAccount field.
@@ -292,8 +389,71 @@ func (r *mutationResolver) UpdateAccount(ctx context.Context, input model.Accoun
 	return entUser, nil
 }
 
+// SetActiveTeam is the resolver for the setActiveTeam field.
+func (r *mutationResolver) SetActiveTeam(ctx context.Context, teamID string) (*ent.User, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"SetActiveTeam\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	teamUuid, err := uuid.Parse(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+	}
+	entMembership, err := entUser.QueryUserToTeamMemberships().
+		Where(teammembership.HasTeamMembershipToTeamWith(team.IDEQ(teamUuid))).
+		WithTeamMembershipToTeam(func(tq *ent.TeamQuery) {
+			tq.WithTeamToCompetition()
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user is not a member of the team")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team membership: %v", err)
+	}
+	// Switching to a team which isn't allowed from here would lock the user out of every request
+	isExempt, err := allowlist.IsExempt(ctx, entUser, entMembership.Edges.TeamMembershipToTeam.Edges.TeamToCompetition.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ip allowlist: %v", err)
+	}
+	if !isExempt && !allowlist.TeamAllows(entMembership.Edges.TeamMembershipToTeam, clientIp) {
+		return nil, fmt.Errorf("team %d can't be used from this network", entMembership.Edges.TeamMembershipToTeam.TeamNumber)
+	}
+	entUser, err = entUser.Update().SetUserToTeam(entMembership.Edges.TeamMembershipToTeam).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update active team: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("switched active team of %s to team %d", entUser.Username, entMembership.Edges.TeamMembershipToTeam.TeamNumber)).
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entUser, nil
+}
+
 // ChangeSelfPassword is the resolver for the changeSelfPassword field.
-func (r *mutationResolver) ChangeSelfPassword(ctx context.Context, password string) (bool, error) {
+func (r *mutationResolver) ChangeSelfPassword(ctx context.Context, currentPassword string, password string) (bool, error) {
 	entUser, err := api.ForContext(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get user from context: %v", err)
@@ -315,12 +475,27 @@ func (r *mutationResolver) ChangeSelfPassword(ctx context.Context, password stri
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
+	// A stolen session shouldn't be enough to take over the account, so wrong guesses count as failed logins
+	limitSubjects := []ratelimit.Subject{ratelimit.Username(entUser.Username), ratelimit.IP(clientIp)}
+	if retryAfter := api.CheckLoginRateLimit(gCtx, r.loginLimiter, limitSubjects...); retryAfter > 0 {
+		return false, api.TooManyLoginsError(retryAfter)
+	}
+	if utils.CheckPassword(currentPassword, entUser.Password) != nil {
+		api.RecordLoginFailure(gCtx, r.client, r.loginLimiter, limitSubjects...)
+		return false, fmt.Errorf("current password is incorrect")
+	}
+	if err = utils.LoadPasswordPolicy().Validate(password, entUser.Username); err != nil {
+		return false, err
+	}
+	if entUser.MustChangePassword && utils.CheckPassword(password, entUser.Password) == nil {
+		return false, fmt.Errorf("new password must be different from the current password")
+	}
 	hashedPassword, err := utils.HashPassword(password)
 	if err != nil {
 		return false, fmt.Errorf("failed to hash password: %v", err)
 	}
 
-	err = entUser.Update().SetPassword(hashedPassword).Exec(ctx)
+	err = entUser.Update().SetPassword(hashedPassword).SetMustChangePassword(false).Exec(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to update self password: %v", err)
 	}
@@ -333,11 +508,17 @@ func (r *mutationResolver) ChangeSelfPassword(ctx context.Context, password stri
 	if err != nil {
 		logrus.Warnf("failed to log CHANGE_SELF_PASSWORD: %v", err)
 	}
+	if err = r.revokeUserSessions(ctx, entUser, entUser, clientIp, "password changed"); err != nil {
+		return false, fmt.Errorf("password was changed but %v", err)
+	}
+	if err = r.revokeUserAccessTokens(ctx, entUser, entUser, clientIp, "password changed"); err != nil {
+		return false, fmt.Errorf("password was changed but %v", err)
+	}
 	return true, nil
 }
 
-// CreateUser is the resolver for the createUser field.
-func (r *mutationResolver) CreateUser(ctx context.Context, input model.UserInput) (*ent.User, error) {
+// EnrollTotp is the resolver for the enrollTotp field.
+func (r *mutationResolver) EnrollTotp(ctx context.Context) (*model.TotpEnrollment, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -353,65 +534,35 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.UserInput
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateUser\" endpoint").
+		SetMessage("called \"EnrollTotp\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	usernameExists, err := r.client.User.Query().Where(
-		user.UsernameEQ(strings.ToLower(input.Username)), // Lowercase username
-	).Exist(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query if username is already in use: %v", err)
-	}
-	if usernameExists {
-		return nil, fmt.Errorf("failed to create user: username already in use")
-	}
-
-	var entTeam *ent.Team = nil
-	if input.UserToTeam != nil {
-		teamUuid, err := uuid.Parse(*input.UserToTeam)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse UserToTeam UUID: %v", err)
-		}
-		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query team: %v", err)
-		}
-	}
-	hashedPassword, err := utils.HashPassword(input.Password)
-	if err != nil {
-		return nil, fmt.Errorf("failed to hash user password: %v", err)
+	if authUser.Provider != user.ProviderLOCAL {
+		return nil, fmt.Errorf("multi-factor authentication is managed by your login provider")
 	}
-	entUserCreate := r.client.User.Create().
-		SetUsername(strings.ToLower(input.Username)).
-		SetPassword(hashedPassword).
-		SetFirstName(input.FirstName).
-		SetLastName(input.LastName).
-		SetRole(user.Role(input.Role)).
-		SetProvider(user.Provider(input.Provider))
-	if entTeam != nil {
-		entUserCreate = entUserCreate.SetUserToTeam(entTeam)
+	if authUser.TotpEnabled {
+		return nil, fmt.Errorf("totp is already enabled, disable it before enrolling a new authenticator")
 	}
-	entUser, err := entUserCreate.Save(ctx)
+	enrollment, err := mfa.NewEnrollment(authUser)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %v", err)
+		return nil, err
 	}
-	err = r.client.Action.Create().
-		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created user %s", entUser.Username)).
-		SetActionToUser(entUser).
-		Exec(ctx)
+	err = authUser.Update().SetTotpSecret(enrollment.Secret).SetTotpLastCounter(0).Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		return nil, fmt.Errorf("failed to save totp secret: %v", err)
 	}
-	return entUser, nil
+	return &model.TotpEnrollment{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+		QRCode:          enrollment.QRCode,
+	}, nil
 }
 
-// UpdateUser is the resolver for the updateUser field.
-func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UserInput) (*ent.User, error) {
+// ConfirmTotp is the resolver for the confirmTotp field.
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -427,71 +578,60 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UserInput
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateUser\" endpoint").
+		SetMessage("called \"ConfirmTotp\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query user: ID must not be nil")
+	if authUser.TotpEnabled {
+		return nil, fmt.Errorf("totp is already enabled")
 	}
-	userUuid, err := uuid.Parse(*input.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse user UUID: %v", err)
+	if authUser.TotpSecret == "" {
+		return nil, fmt.Errorf("totp enrollment has not been started")
 	}
-	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid)).Only(ctx)
+	err = mfa.ValidateCode(ctx, r.client, authUser, code)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user: %v", err)
-	}
-	var entTeam *ent.Team = nil
-	if input.UserToTeam != nil {
-		teamUuid, err := uuid.Parse(*input.UserToTeam)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse team UUID: %v", err)
-		}
-		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
+		err = r.client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeFAILED_MFA).
+			SetMessage(fmt.Sprintf("invalid mfa code from user \"%s\" while confirming totp: %v", authUser.Username, err)).
+			SetActionToUser(authUser).
+			Exec(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to query team: %v", err)
+			logrus.Warnf("failed to log FAILED_MFA: %v", err)
 		}
+		return nil, fmt.Errorf("invalid code")
 	}
-	entUserUpdate := entUser.Update().
-		SetFirstName(input.FirstName).
-		SetLastName(input.LastName).
-		SetRole(user.Role(input.Role)).
-		SetProvider(user.Provider(input.Provider))
-	if entTeam != nil {
-		entUserUpdate = entUserUpdate.
-			SetUserToTeam(entTeam)
-	} else {
-		entUserUpdate = entUserUpdate.ClearUserToTeam()
+	recoveryCodes, recoveryCodeHashes, err := mfa.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
 	}
-	entUser, err = entUserUpdate.
-		Save(ctx)
+	err = authUser.Update().SetTotpEnabled(true).SetTotpRecoveryCodes(recoveryCodeHashes).Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update user: %v", err)
+		return nil, fmt.Errorf("failed to enable totp: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated user %s", entUser.Username)).
-		SetActionToUser(entUser).
+		SetType(action.TypeMFA_ENROLL).
+		SetMessage(fmt.Sprintf("enabled totp for user %s", authUser.Username)).
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log MFA_ENROLL: %v", err)
 	}
-	return entUser, nil
+	return recoveryCodes, nil
 }
 
-// DeleteUser is the resolver for the deleteUser field.
-func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, error) {
+// RegenerateRecoveryCodes is the resolver for the regenerateRecoveryCodes field.
+func (r *mutationResolver) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -500,45 +640,50 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, err
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteUser\" endpoint").
+		SetMessage("called \"RegenerateRecoveryCodes\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	userUuid, err := uuid.Parse(id)
+	if !authUser.TotpEnabled {
+		return nil, fmt.Errorf("totp is not enabled")
+	}
+	err = mfa.ValidateCode(ctx, r.client, authUser, code)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+		err = r.client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeFAILED_MFA).
+			SetMessage(fmt.Sprintf("invalid mfa code from user \"%s\" while regenerating recovery codes: %v", authUser.Username, err)).
+			SetActionToUser(authUser).
+			Exec(ctx)
+		if err != nil {
+			logrus.Warnf("failed to log FAILED_MFA: %v", err)
+		}
+		return nil, fmt.Errorf("invalid code")
 	}
-	// Must maintain at least one admin user in the database (count all admin users who's ID's don't match the one we're deleting)
-	if userCount, err := r.client.User.Query().Where(
-		user.And(
-			user.IDNEQ(userUuid),
-			user.RoleEQ(user.RoleADMIN),
-		),
-	).Count(ctx); err != nil {
-		return false, fmt.Errorf("failed to count users: %v", err)
-	} else if userCount <= 0 {
-		return false, fmt.Errorf("at least one admin user must exist")
+	recoveryCodes, recoveryCodeHashes, err := mfa.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
 	}
-	_, err = r.client.User.Delete().Where(user.IDEQ(userUuid)).Exec(ctx)
+	err = authUser.Update().SetTotpRecoveryCodes(recoveryCodeHashes).Exec(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete user: %v", err)
+		return nil, fmt.Errorf("failed to update recovery codes: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted user %s", id)).
+		SetType(action.TypeMFA_ENROLL).
+		SetMessage(fmt.Sprintf("regenerated recovery codes for user %s", authUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+		logrus.Warnf("failed to log MFA_ENROLL: %v", err)
 	}
-	return true, nil
+	return recoveryCodes, nil
 }
 
-// ChangePassword is the resolver for the changePassword field.
-func (r *mutationResolver) ChangePassword(ctx context.Context, id string, password string) (bool, error) {
+// DisableTotp is the resolver for the disableTotp field.
+func (r *mutationResolver) DisableTotp(ctx context.Context, code string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get user from context: %v", err)
@@ -554,43 +699,56 @@ func (r *mutationResolver) ChangePassword(ctx context.Context, id string, passwo
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"ChangePassword\" endpoint").
+		SetMessage("called \"DisableTotp\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	userUuid, err := uuid.Parse(id)
-	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	if !authUser.TotpEnabled {
+		return false, fmt.Errorf("totp is not enabled")
 	}
-	hashedPassword, err := utils.HashPassword(password)
-	if err != nil {
-		return false, fmt.Errorf("failed to hash password: %v", err)
+	if mfa.Required(authUser) {
+		// Passkeys still satisfy the requirement without TOTP
+		hasPasskey, err := authUser.QueryUserToWebauthnCredentials().Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to query passkeys: %v", err)
+		}
+		if !hasPasskey {
+			return false, fmt.Errorf("multi-factor authentication is required for your role")
+		}
 	}
-
-	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid)).Only(ctx)
+	_, err = mfa.Verify(ctx, r.client, authUser, code)
 	if err != nil {
-		return false, fmt.Errorf("failed to query user: %v", err)
+		err = r.client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeFAILED_MFA).
+			SetMessage(fmt.Sprintf("invalid mfa code from user \"%s\" while disabling totp: %v", authUser.Username, err)).
+			SetActionToUser(authUser).
+			Exec(ctx)
+		if err != nil {
+			logrus.Warnf("failed to log FAILED_MFA: %v", err)
+		}
+		return false, fmt.Errorf("invalid code")
 	}
-	err = entUser.Update().SetPassword(hashedPassword).Exec(ctx)
+	err = authUser.Update().SetTotpEnabled(false).ClearTotpSecret().ClearTotpRecoveryCodes().Exec(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to update password: %v", err)
+		return false, fmt.Errorf("failed to disable totp: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCHANGE_PASSWORD).
-		SetMessage(fmt.Sprintf("changed password for user %s", entUser.Username)).
+		SetType(action.TypeMFA_DISABLE).
+		SetMessage(fmt.Sprintf("disabled totp for user %s", authUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CHANGE_PASSWORD: %v", err)
+		logrus.Warnf("failed to log MFA_DISABLE: %v", err)
 	}
 	return true, nil
 }
 
-// GenerateCompetitionUsers is the resolver for the generateCompetitionUsers field.
-func (r *mutationResolver) GenerateCompetitionUsers(ctx context.Context, competitionID string, usersPerTeam int) ([]*model.CompetitionUser, error) {
+// RenameWebauthnCredential is the resolver for the renameWebauthnCredential field.
+func (r *mutationResolver) RenameWebauthnCredential(ctx context.Context, id string, name string) (*ent.WebauthnCredential, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -606,84 +764,40 @@ func (r *mutationResolver) GenerateCompetitionUsers(ctx context.Context, competi
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateTeam\" endpoint").
+		SetMessage("called \"RenameWebauthnCredential\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	competitionUuid, err := uuid.Parse(competitionID)
+	credentialUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
 	}
-	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
+	entCredential, err := authUser.QueryUserToWebauthnCredentials().Where(webauthncredential.IDEQ(credentialUuid)).Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query competition: %v", err)
+		return nil, fmt.Errorf("failed to query passkey: %v", err)
 	}
-	entTeams, err := r.client.Team.Query().Where(team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid))).All(ctx)
+	entCredential, err = entCredential.Update().SetName(name).Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query teams for competition: %v", err)
+		return nil, fmt.Errorf("failed to update passkey: %v", err)
 	}
-	competitionUsers := make([]*model.CompetitionUser, 0)
-	for _, entTeam := range entTeams {
-		for i := 0; i < usersPerTeam; i++ {
-			// user details:
-			// username = [comp_name][team_num][user_letter]
-			//   ex. comp01a
-			username := fmt.Sprintf(
-				"%s%02d%c",
-				entCompetition.Name,
-				entTeam.TeamNumber, rune('a'+i),
-			)
-			if usersPerTeam == 1 {
-				// Don't use a letter at the end if only 1 per team
-				username = fmt.Sprintf(
-					"%s%02d",
-					entCompetition.Name,
-					entTeam.TeamNumber,
-				)
-			}
-			// password = randomly generated (noun + num + adj + num + noun)
-			password := utils.NewPassword()
-			hashedPassword, err := utils.HashPassword(password)
-			if err != nil {
-				logrus.Errorf("failed to create user: %v", err)
-				continue
-			}
-			entUser, err := r.client.User.Create().
-				SetFirstName("Team").
-				SetLastName(strconv.Itoa(entTeam.TeamNumber)).
-				SetUsername(strings.ToLower(username)).
-				SetPassword(hashedPassword).
-				SetProvider(user.ProviderLOCAL).
-				SetRole(user.RoleUSER).
-				SetUserToTeam(entTeam).
-				Save(ctx)
-			if err != nil {
-				// log failed users and keep trying to make them
-				logrus.Errorf("failed to create user: %v", err)
-				continue
-			}
-			competitionUsers = append(competitionUsers, &model.CompetitionUser{
-				ID:         entUser.ID.String(),
-				Username:   entUser.Username,
-				Password:   password,
-				UserToTeam: entTeam,
-			})
-		}
-	}
-	return competitionUsers, nil
+	return entCredential, nil
 }
 
-// CreateTeam is the resolver for the createTeam field.
-func (r *mutationResolver) CreateTeam(ctx context.Context, input model.TeamInput) (*ent.Team, error) {
+// DeleteWebauthnCredential is the resolver for the deleteWebauthnCredential field.
+func (r *mutationResolver) DeleteWebauthnCredential(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -692,52 +806,64 @@ func (r *mutationResolver) CreateTeam(ctx context.Context, input model.TeamInput
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateTeam\" endpoint").
+		SetMessage("called \"DeleteWebauthnCredential\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	competitionUuid, err := uuid.Parse(input.TeamToCompetition)
+	credentialUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	teamExists, err := r.client.Team.Query().Where(team.And(team.TeamNumberEQ(input.TeamNumber), team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid)))).Exist(ctx)
+	entCredential, err := r.client.WebauthnCredential.Query().Where(webauthncredential.IDEQ(credentialUuid)).WithWebauthnCredentialToUser().Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query if team already exists: %v", err)
+		return false, fmt.Errorf("failed to query passkey: %v", err)
 	}
-	if teamExists {
-		return nil, fmt.Errorf("failed to create team: team already exists")
-	}
-	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query competition: %v", err)
+	entUser := entCredential.Edges.WebauthnCredentialToUser
+	if entUser.ID != authUser.ID {
+		canManage, err := canManageUser(ctx, authUser, entUser)
+		if err != nil {
+			return false, err
+		}
+		if !canManage {
+			return false, fmt.Errorf("failed to query passkey: ent: webauthn_credential not found")
+		}
+	} else if mfa.Required(authUser) && !authUser.TotpEnabled {
+		// Users can't remove their last second factor when their role requires one
+		passkeyCount, err := authUser.QueryUserToWebauthnCredentials().Count(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to query passkeys: %v", err)
+		}
+		if passkeyCount <= 1 {
+			return false, fmt.Errorf("multi-factor authentication is required for your role")
+		}
 	}
-	entTeam, err := r.client.Team.Create().SetTeamNumber(input.TeamNumber).SetName(*input.Name).SetTeamToCompetition(entCompetition).Save(ctx)
+	err = r.client.WebauthnCredential.DeleteOne(entCredential).Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %v", err)
+		return false, fmt.Errorf("failed to delete passkey: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created team %s", entTeam.Name)).
+		SetType(action.TypeMFA_DISABLE).
+		SetMessage(fmt.Sprintf("deleted passkey \"%s\" for user %s", entCredential.Name, entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log MFA_DISABLE: %v", err)
 	}
-	return entTeam, nil
+	return true, nil
 }
 
-// BatchCreateTeams is the resolver for the batchCreateTeams field.
-func (r *mutationResolver) BatchCreateTeams(ctx context.Context, input []*model.TeamInput) ([]*ent.Team, error) {
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -746,72 +872,55 @@ func (r *mutationResolver) BatchCreateTeams(ctx context.Context, input []*model.
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"BatchCreateTeams\" endpoint").
+		SetMessage("called \"RevokeSession\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entTeams := make([]*ent.TeamCreate, len(input))
-	tx, err := r.client.Tx(ctx)
+	sessionUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create ent transactional client: %v", err)
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	for i, inputTeam := range input {
-		competitionUuid, err := uuid.Parse(inputTeam.TeamToCompetition)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
-		}
-		teamExists, err := tx.Team.Query().Where(team.And(team.TeamNumberEQ(inputTeam.TeamNumber), team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid)))).Exist(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query if team already exists: %v", err)
-		}
-		if teamExists {
-			return nil, fmt.Errorf("failed to create team: team already exists")
-		}
-		entCompetition, err := tx.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
+	entToken, err := r.client.Token.Query().Where(token.IDEQ(sessionUuid)).WithTokenToUser().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query session: %v", err)
+	}
+	entUser := entToken.Edges.TokenToUser
+	if entUser.ID != authUser.ID {
+		canManage, err := canManageUser(ctx, authUser, entUser)
 		if err != nil {
-			return nil, fmt.Errorf("failed to query competition: %v", err)
+			return false, err
 		}
-		entTeam := tx.Team.Create().SetTeamNumber(inputTeam.TeamNumber).SetName(*inputTeam.Name).SetTeamToCompetition(entCompetition)
-		entTeams[i] = entTeam
-	}
-	newEntTeams, err := tx.Team.CreateBulk(entTeams...).Save(ctx)
-	if err != nil {
-		if rerr := tx.Rollback(); rerr != nil {
-			err = fmt.Errorf("%w: rolling back transaction: failed to bulk create teams: %v", err, rerr)
+		if !canManage {
+			return false, fmt.Errorf("failed to query session: ent: token not found")
 		}
-		return nil, err
 	}
-	err = tx.Commit()
+	_, err = r.sessions.Revoke(ctx, token.IDEQ(entToken.ID))
 	if err != nil {
-		return nil, fmt.Errorf("failed to commit transation. rolled back database: %v", err)
-	}
-	unwrappedTeams := make([]*ent.Team, len(newEntTeams))
-	for i, entTeam := range newEntTeams {
-		unwrappedTeams[i] = entTeam.Unwrap()
+		return false, err
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("batch created %d teams", len(unwrappedTeams))).
+		SetType(action.TypeREVOKE_SESSION).
+		SetMessage(fmt.Sprintf("revoked session from %s for user %s", entToken.IPAddress, entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log REVOKE_SESSION: %v", err)
 	}
-	return unwrappedTeams, nil
+	return true, nil
 }
 
-// UpdateTeam is the resolver for the updateTeam field.
-func (r *mutationResolver) UpdateTeam(ctx context.Context, input model.TeamInput) (*ent.Team, error) {
-	authUser, err := api.ForContext(ctx)
+// StopImpersonating is the resolver for the stopImpersonating field.
+func (r *mutationResolver) StopImpersonating(ctx context.Context) (bool, error) {
+	entUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -820,56 +929,45 @@ func (r *mutationResolver) UpdateTeam(ctx context.Context, input model.TeamInput
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateTeam\" endpoint").
-		SetActionToUser(authUser).
+		SetMessage("called \"StopImpersonating\" endpoint").
+		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query team: ID must not be nil")
-	}
-	teamUuid, err := uuid.Parse(*input.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse team UUID: %v", err)
-	}
-	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query team: %v", err)
-	}
-	competitionUuid, err := uuid.Parse(input.TeamToCompetition)
+	entImpersonator, err := api.ForContextImpersonator(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+		return false, err
 	}
-	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
+	entToken, err := api.ForContextSession(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query competition: %v", err)
+		return false, fmt.Errorf("failed to get session from context: %v", err)
 	}
-	entTeam, err = entTeam.Update().SetName(*input.Name).SetTeamToCompetition(entCompetition).Save(ctx)
+	err = entToken.Update().ClearTokenToImpersonatedUser().Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update team: %v", err)
+		return false, fmt.Errorf("failed to update session: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated team %s", entTeam.Name)).
-		SetActionToUser(authUser).
+		SetType(action.TypeIMPERSONATE).
+		SetMessage(fmt.Sprintf("%s stopped impersonating user %s", entImpersonator.Username, entUser.Username)).
+		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log IMPERSONATE: %v", err)
 	}
-	return entTeam, nil
+	return true, nil
 }
 
-// DeleteTeam is the resolver for the deleteTeam field.
-func (r *mutationResolver) DeleteTeam(ctx context.Context, id string) (bool, error) {
+// CreatePersonalAccessToken is the resolver for the createPersonalAccessToken field.
+func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input model.PersonalAccessTokenInput) (*model.PersonalAccessTokenDetails, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -878,41 +976,61 @@ func (r *mutationResolver) DeleteTeam(ctx context.Context, id string) (bool, err
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteTeam\" endpoint").
+		SetMessage("called \"CreatePersonalAccessToken\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	teamUuid, err := uuid.Parse(id)
+	// Otherwise a leaked token could be used to mint tokens which outlive it
+	if _, err := api.ForContextPersonalAccessToken(ctx); err == nil {
+		return nil, fmt.Errorf("personal access tokens can't be used to create personal access tokens")
+	}
+	if input.Name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiresAt must be in the future")
+	}
+	tokenStr, tokenHash, tokenPrefix, err := api.NewPersonalAccessToken()
 	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+		return nil, err
 	}
-	_, err = r.client.Team.Delete().Where(team.IDEQ(teamUuid)).Exec(ctx)
+	entPersonalAccessToken, err := r.client.PersonalAccessToken.Create().
+		SetName(input.Name).
+		SetTokenHash(tokenHash).
+		SetPrefix(tokenPrefix).
+		SetScope(personalaccesstoken.Scope(input.Scope)).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetPersonalAccessTokenToUser(authUser).
+		Save(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete team: %v", err)
+		return nil, fmt.Errorf("failed to create personal access token: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted team %s", id)).
+		SetType(action.TypeCREATE_ACCESS_TOKEN).
+		SetMessage(fmt.Sprintf("created %s personal access token \"%s\" (%s) for user %s", entPersonalAccessToken.Scope, entPersonalAccessToken.Name, entPersonalAccessToken.Prefix, authUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log DELETED_OBJECT: %v", err)
+		logrus.Warnf("failed to log CREATE_ACCESS_TOKEN: %v", err)
 	}
-	return true, nil
+	return &model.PersonalAccessTokenDetails{
+		Token:               tokenStr,
+		PersonalAccessToken: entPersonalAccessToken,
+	}, nil
 }
 
-// CreateCompetition is the resolver for the createCompetition field.
-func (r *mutationResolver) CreateCompetition(ctx context.Context, input model.CompetitionInput) (*ent.Competition, error) {
+// RevokePersonalAccessToken is the resolver for the revokePersonalAccessToken field.
+func (r *mutationResolver) RevokePersonalAccessToken(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -921,38 +1039,48 @@ func (r *mutationResolver) CreateCompetition(ctx context.Context, input model.Co
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateCompetition\" endpoint").
+		SetMessage("called \"RevokePersonalAccessToken\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	providerUuid, err := uuid.Parse(input.CompetitionToProvider)
+	personalAccessTokenUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	entPersonalAccessToken, err := r.client.PersonalAccessToken.Query().Where(personalaccesstoken.IDEQ(personalAccessTokenUuid)).WithPersonalAccessTokenToUser().Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query provider: %v", err)
+		return false, fmt.Errorf("failed to query personal access token: %v", err)
+	}
+	entUser := entPersonalAccessToken.Edges.PersonalAccessTokenToUser
+	if entUser.ID != authUser.ID {
+		canManage, err := canManageUser(ctx, authUser, entUser)
+		if err != nil {
+			return false, err
+		}
+		if !canManage {
+			return false, fmt.Errorf("failed to query personal access token: ent: personal_access_token not found")
+		}
 	}
-	entCompetition, err := r.client.Competition.Create().SetName(input.Name).SetCompetitionToProvider(entProvider).Save(ctx)
+	err = r.client.PersonalAccessToken.DeleteOne(entPersonalAccessToken).Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create competition: %v", err)
+		return false, fmt.Errorf("failed to revoke personal access token: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created competition %s", entCompetition.Name)).
+		SetType(action.TypeREVOKE_ACCESS_TOKEN).
+		SetMessage(fmt.Sprintf("revoked personal access token \"%s\" (%s) for user %s", entPersonalAccessToken.Name, entPersonalAccessToken.Prefix, entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log REVOKE_ACCESS_TOKEN: %v", err)
 	}
-	return entCompetition, nil
+	return true, nil
 }
 
-// UpdateCompetition is the resolver for the updateCompetition field.
-func (r *mutationResolver) UpdateCompetition(ctx context.Context, input model.CompetitionInput) (*ent.Competition, error) {
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, input model.UserInput) (*ent.User, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -968,92 +1096,115 @@ func (r *mutationResolver) UpdateCompetition(ctx context.Context, input model.Co
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateCompetition\" endpoint").
+		SetMessage("called \"CreateUser\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query competition: ID must not be nil")
-	}
-	competitionUuid, err := uuid.Parse(*input.ID)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
+	// Usernames are unique across every user, not just the ones the user can see
+	usernameExists, err := r.client.User.Query().Where(
+		user.UsernameEQ(strings.ToLower(input.Username)), // Lowercase username
+	).Exist(viewer.SystemContext(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("failed to query competition: %v", err)
+		return nil, fmt.Errorf("failed to query if username is already in use: %v", err)
 	}
-	providerUuid, err := uuid.Parse(input.CompetitionToProvider)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+	if usernameExists {
+		return nil, fmt.Errorf("failed to create user: username already in use")
 	}
-	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query provider: %v", err)
+
+	var entTeam *ent.Team = nil
+	if input.UserToTeam != nil {
+		teamUuid, err := uuid.Parse(*input.UserToTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse UserToTeam UUID: %v", err)
+		}
+		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query team: %v", err)
+		}
 	}
-	entCompetition, err = entCompetition.Update().SetName(input.Name).SetCompetitionToProvider(entProvider).Save(ctx)
+	memberships, err := r.teamMembershipsFromInput(ctx, scope, input.UserToTeamMemberships)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update team: %v", err)
+		return nil, err
 	}
-	err = r.client.Action.Create().
-		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated competition %s", entCompetition.Name)).
-		SetActionToUser(authUser).
-		Exec(ctx)
-	if err != nil {
-		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	// Default the active team to the first membership
+	if entTeam == nil && len(memberships) > 0 {
+		entTeam = memberships[0].team
 	}
-	return entCompetition, nil
-}
-
-// DeleteCompetition is the resolver for the deleteCompetition field.
-func (r *mutationResolver) DeleteCompetition(ctx context.Context, id string) (bool, error) {
-	authUser, err := api.ForContext(ctx)
-	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+	if entTeam == nil && !scope.IsGlobal() {
+		return nil, fmt.Errorf("competition admins can only manage users on the teams of their competitions")
 	}
-	gCtx, err := GinContextFromContext(ctx)
+	entCustomRole, err := r.customRoleFromInput(ctx, input.UserToCustomRole)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, err
 	}
-	clientIp, err := api.ForContextIp(gCtx)
-	if err != nil {
-		logrus.Warnf("unable to get ip from context: %v", err)
+	if err = checkCanGrant(ctx, authUser, rolePermissions(user.Role(input.Role), entCustomRole)); err != nil {
+		return nil, err
 	}
-	err = r.client.Action.Create().
-		SetIPAddress(clientIp).
-		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteCompetition\" endpoint").
-		SetActionToUser(authUser).
-		Exec(ctx)
+	adminCompetitionUuids, _, err := adminCompetitionsFromInput(ctx, authUser, nil, input.UserToAdminCompetitions)
 	if err != nil {
-		logrus.Warnf("failed to log API_CALL: %v", err)
+		return nil, err
 	}
-	competitionUuid, err := uuid.Parse(id)
-	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	// Only local users sign in with their password
+	if input.Provider == model.AuthProviderLocal {
+		if err = utils.LoadPasswordPolicy().Validate(input.Password, input.Username); err != nil {
+			return nil, err
+		}
 	}
-	_, err = r.client.Competition.Delete().Where(competition.IDEQ(competitionUuid)).Exec(ctx)
+	hashedPassword, err := utils.HashPassword(input.Password)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete competition: %v", err)
+		return nil, fmt.Errorf("failed to hash user password: %v", err)
+	}
+	entUserCreate := r.client.User.Create().
+		SetUsername(strings.ToLower(input.Username)).
+		SetPassword(hashedPassword).
+		SetFirstName(input.FirstName).
+		SetLastName(input.LastName).
+		SetRole(user.Role(input.Role)).
+		SetProvider(user.Provider(input.Provider))
+	if input.MustChangePassword != nil {
+		entUserCreate = entUserCreate.SetMustChangePassword(*input.MustChangePassword)
+	}
+	if entTeam != nil {
+		entUserCreate = entUserCreate.SetUserToTeam(entTeam)
+	}
+	if entCustomRole != nil {
+		entUserCreate = entUserCreate.SetUserToCustomRole(entCustomRole)
+	}
+	if len(adminCompetitionUuids) > 0 {
+		entUserCreate = entUserCreate.AddUserToAdminCompetitionIDs(adminCompetitionUuids...)
+	}
+	entUser, err := entUserCreate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %v", err)
+	}
+	if memberships != nil {
+		if err = r.setTeamMemberships(ctx, entUser, memberships); err != nil {
+			return nil, err
+		}
+	}
+	if err = utils.EnsureTeamMembership(ctx, r.client, entUser, entTeam); err != nil {
+		return nil, err
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted competition %s", id)).
-		SetActionToUser(authUser).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created user %s", entUser.Username)).
+		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
 	}
-	return true, nil
+	return entUser, nil
 }
 
-// CreateVMObject is the resolver for the createVmObject field.
-func (r *mutationResolver) CreateVMObject(ctx context.Context, input model.VMObjectInput) (*ent.VmObject, error) {
+// UpdateUser is the resolver for the updateUser field.
+func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UserInput) (*ent.User, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -1069,53 +1220,140 @@ func (r *mutationResolver) CreateVMObject(ctx context.Context, input model.VMObj
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateVMObject\" endpoint").
+		SetMessage("called \"UpdateUser\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query user: ID must not be nil")
+	}
+	userUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user UUID: %v", err)
+	}
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %v", err)
+	}
+	canManage, err := canManageUser(ctx, authUser, entUser)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, fmt.Errorf("user is not authorized to manage %s", entUser.Username)
+	}
 	var entTeam *ent.Team = nil
-	if input.VMObjectToTeam != nil {
-		teamUuid, err := uuid.Parse(*input.VMObjectToTeam)
+	if input.UserToTeam != nil {
+		teamUuid, err := uuid.Parse(*input.UserToTeam)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse team UUID: %v", err)
 		}
-		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
+		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query team: %v", err)
 		}
 	}
-	entVmObject, err := r.client.VmObject.Create().
-		SetName(input.Name).
-		SetIdentifier(input.Identifier).
-		SetIPAddresses(input.IPAddresses).
-		SetVmObjectToTeam(entTeam).
+	memberships, err := r.teamMembershipsFromInput(ctx, scope, input.UserToTeamMemberships)
+	if err != nil {
+		return nil, err
+	}
+	// Default the active team to the first membership
+	if entTeam == nil && len(memberships) > 0 {
+		entTeam = memberships[0].team
+	}
+	if entTeam == nil && !scope.IsGlobal() {
+		return nil, fmt.Errorf("competition admins can only manage users on the teams of their competitions")
+	}
+	entCustomRole, err := r.customRoleFromInput(ctx, input.UserToCustomRole)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCanGrant(ctx, authUser, rolePermissions(user.Role(input.Role), entCustomRole)); err != nil {
+		return nil, err
+	}
+	currentCustomRole, err := entUser.QueryUserToCustomRole().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query custom role from user: %v", err)
+	}
+	previousTeam, err := entUser.QueryUserToTeam().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query team from user: %v", err)
+	}
+	adminCompetitionUuids, adminCompetitionsChanged, err := adminCompetitionsFromInput(ctx, authUser, entUser, input.UserToAdminCompetitions)
+	if err != nil {
+		return nil, err
+	}
+	roleChanged := entUser.Role != user.Role(input.Role) || (currentCustomRole == nil) != (entCustomRole == nil) || (currentCustomRole != nil && currentCustomRole.ID != entCustomRole.ID) || adminCompetitionsChanged
+	entUserUpdate := entUser.Update().
+		SetFirstName(input.FirstName).
+		SetLastName(input.LastName).
+		SetRole(user.Role(input.Role)).
+		SetProvider(user.Provider(input.Provider))
+	if entCustomRole != nil {
+		entUserUpdate = entUserUpdate.SetUserToCustomRole(entCustomRole)
+	} else {
+		entUserUpdate = entUserUpdate.ClearUserToCustomRole()
+	}
+	if adminCompetitionsChanged {
+		entUserUpdate = entUserUpdate.ClearUserToAdminCompetitions().AddUserToAdminCompetitionIDs(adminCompetitionUuids...)
+	}
+	if input.MustChangePassword != nil {
+		entUserUpdate = entUserUpdate.SetMustChangePassword(*input.MustChangePassword)
+	}
+	if entTeam != nil {
+		entUserUpdate = entUserUpdate.
+			SetUserToTeam(entTeam)
+	} else {
+		entUserUpdate = entUserUpdate.ClearUserToTeam()
+	}
+	entUser, err = entUserUpdate.
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create vm object: %v", err)
+		return nil, fmt.Errorf("failed to update user: %v", err)
+	}
+	if memberships != nil {
+		if err = r.setTeamMemberships(ctx, entUser, memberships); err != nil {
+			return nil, err
+		}
+		if err = utils.EnsureTeamMembership(ctx, r.client, entUser, entTeam); err != nil {
+			return nil, err
+		}
+	} else if err = utils.ReplaceActiveTeamMembership(ctx, r.client, entUser, previousTeam, entTeam); err != nil {
+		return nil, err
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created vm object %s", entVmObject.Name)).
-		SetActionToUser(authUser).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated user %s", entUser.Username)).
+		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
 	}
-	return entVmObject, nil
+	// Sessions were authorized for the old role, make the user sign in again
+	if roleChanged {
+		if err = r.revokeUserSessions(ctx, authUser, entUser, clientIp, "role changed"); err != nil {
+			return nil, fmt.Errorf("user was updated but %v", err)
+		}
+	}
+	return entUser, nil
 }
 
-// BatchCreateVMObjects is the resolver for the batchCreateVmObjects field.
-func (r *mutationResolver) BatchCreateVMObjects(ctx context.Context, input []*model.VMObjectInput) ([]*ent.VmObject, error) {
+// DeleteUser is the resolver for the deleteUser field.
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1124,72 +1362,72 @@ func (r *mutationResolver) BatchCreateVMObjects(ctx context.Context, input []*mo
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"BatchCreateVMObjects\" endpoint").
+		SetMessage("called \"DeleteUser\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entVmObjects := make([]*ent.VmObjectCreate, len(input))
-	tx, err := r.client.Tx(ctx)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create ent transactional client: %v", err)
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	for i, inputVmObject := range input {
-		var entTeam *ent.Team = nil
-		if inputVmObject.VMObjectToTeam != nil {
-			teamUuid, err := uuid.Parse(*inputVmObject.VMObjectToTeam)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse team UUID: %v", err)
-			}
-			entTeam, err = tx.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to query team: %v", err)
-			}
-		}
-		entVmObject := tx.VmObject.Create().
-			SetName(inputVmObject.Name).
-			SetIdentifier(inputVmObject.Identifier).
-			SetIPAddresses(inputVmObject.IPAddresses).
-			SetVmObjectToTeam(entTeam)
-		entVmObjects[i] = entVmObject
+	userUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	newEntVmObjects, err := tx.VmObject.CreateBulk(entVmObjects...).Save(ctx)
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
 	if err != nil {
-		if rerr := tx.Rollback(); rerr != nil {
-			err = fmt.Errorf("%w: rolling back transaction: failed to bulk create vm objects: %v", err, rerr)
-		}
-		return nil, err
+		return false, fmt.Errorf("failed to query user: %v", err)
 	}
-	err = tx.Commit()
+	canManage, err := canManageUser(ctx, authUser, entUser)
 	if err != nil {
-		return nil, fmt.Errorf("failed to commit transation. rolled back database: %v", err)
+		return false, err
 	}
-	unwrappedVmObjects := make([]*ent.VmObject, len(newEntVmObjects))
-	for i, entVmObject := range newEntVmObjects {
-		unwrappedVmObjects[i] = entVmObject.Unwrap()
+	if !canManage {
+		return false, fmt.Errorf("user is not authorized to manage %s", entUser.Username)
+	}
+	// Must maintain at least one admin user in the database (count all admin users who's ID's don't match the one we're deleting)
+	if userCount, err := r.client.User.Query().Where(
+		user.And(
+			user.IDNEQ(userUuid),
+			user.RoleEQ(user.RoleADMIN),
+		),
+	).Count(viewer.SystemContext(ctx)); err != nil {
+		return false, fmt.Errorf("failed to count users: %v", err)
+	} else if userCount <= 0 {
+		return false, fmt.Errorf("at least one admin user must exist")
+	}
+	// Deleting the user deletes their sessions, but their subscriptions have to be closed too
+	_, err = r.sessions.Revoke(ctx, token.HasTokenToUserWith(user.IDEQ(userUuid)))
+	if err != nil {
+		return false, err
+	}
+	_, err = r.client.User.Delete().Where(user.IDEQ(userUuid)).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete user: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created %d vm objects", len(unwrappedVmObjects))).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted user %s", id)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
 	}
-	return unwrappedVmObjects, nil
+	return true, nil
 }
 
-// UpdateVMObject is the resolver for the updateVmObject field.
-func (r *mutationResolver) UpdateVMObject(ctx context.Context, input model.VMObjectInput) (*ent.VmObject, error) {
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, id string, password string, mustChangePassword *bool) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1198,54 +1436,67 @@ func (r *mutationResolver) UpdateVMObject(ctx context.Context, input model.VMObj
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateVMObjects\" endpoint").
+		SetMessage("called \"ChangePassword\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query vm object: ID must not be nil")
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	vmObjectUuid, err := uuid.Parse(*input.ID)
+	userUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid)).Only(ctx)
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query vm object: %v", err)
+		return false, fmt.Errorf("failed to query user: %v", err)
 	}
-	teamUuid, err := uuid.Parse(*input.VMObjectToTeam)
+	canManage, err := canManageUser(ctx, authUser, entUser)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+		return false, err
+	}
+	if !canManage {
+		return false, fmt.Errorf("user is not authorized to manage %s", entUser.Username)
 	}
-	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
+	if err = utils.LoadPasswordPolicy().Validate(password, entUser.Username); err != nil {
+		return false, err
+	}
+	hashedPassword, err := utils.HashPassword(password)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query team: %v", err)
+		return false, fmt.Errorf("failed to hash password: %v", err)
 	}
-	entVmObject, err = entVmObject.Update().
-		SetName(input.Name).
-		SetIdentifier(input.Identifier).
-		SetIPAddresses(input.IPAddresses).
-		SetVmObjectToTeam(entTeam).
-		Save(ctx)
+
+	entUserUpdate := entUser.Update().SetPassword(hashedPassword)
+	if mustChangePassword != nil {
+		entUserUpdate = entUserUpdate.SetMustChangePassword(*mustChangePassword)
+	}
+	err = entUserUpdate.Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update vm object: %v", err)
+		return false, fmt.Errorf("failed to update password: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated vm object %s", entVmObject.Name)).
+		SetType(action.TypeCHANGE_PASSWORD).
+		SetMessage(fmt.Sprintf("changed password for user %s", entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log CHANGE_PASSWORD: %v", err)
 	}
-	return entVmObject, nil
+	if err = r.revokeUserSessions(ctx, authUser, entUser, clientIp, "password changed"); err != nil {
+		return false, fmt.Errorf("password was changed but %v", err)
+	}
+	if err = r.revokeUserAccessTokens(ctx, authUser, entUser, clientIp, "password changed"); err != nil {
+		return false, fmt.Errorf("password was changed but %v", err)
+	}
+	return true, nil
 }
 
-// DeleteVMObject is the resolver for the deleteVmObject field.
-func (r *mutationResolver) DeleteVMObject(ctx context.Context, id string) (bool, error) {
+// ResetUserTotp is the resolver for the resetUserTotp field.
+func (r *mutationResolver) ResetUserTotp(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get user from context: %v", err)
@@ -1261,41 +1512,56 @@ func (r *mutationResolver) DeleteVMObject(ctx context.Context, id string) (bool,
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteVMObject\" endpoint").
+		SetMessage("called \"ResetUserTotp\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	vmObjectUuid, err := uuid.Parse(id)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	userUuid, err := uuid.Parse(id)
 	if err != nil {
 		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	_, err = r.client.VmObject.Delete().Where(vmobject.IDEQ(vmObjectUuid)).Exec(ctx)
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete vm object: %v", err)
+		return false, fmt.Errorf("failed to query user: %v", err)
+	}
+	canManage, err := canManageUser(ctx, authUser, entUser)
+	if err != nil {
+		return false, err
+	}
+	if !canManage {
+		return false, fmt.Errorf("user is not authorized to manage %s", entUser.Username)
+	}
+	err = entUser.Update().SetTotpEnabled(false).ClearTotpSecret().ClearTotpRecoveryCodes().Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to reset totp: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted vm object %s", id)).
+		SetType(action.TypeMFA_DISABLE).
+		SetMessage(fmt.Sprintf("reset totp for user %s", entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+		logrus.Warnf("failed to log MFA_DISABLE: %v", err)
 	}
 	return true, nil
 }
 
-// CreateProvider is the resolver for the createProvider field.
-func (r *mutationResolver) CreateProvider(ctx context.Context, input model.ProviderInput) (*ent.Provider, error) {
+// RevokeAllSessions is the resolver for the revokeAllSessions field.
+func (r *mutationResolver) RevokeAllSessions(ctx context.Context, userID string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1304,37 +1570,47 @@ func (r *mutationResolver) CreateProvider(ctx context.Context, input model.Provi
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateProvider\" endpoint").
+		SetMessage("called \"RevokeAllSessions\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entProvider, err := r.client.Provider.Create().SetName(input.Name).SetType(input.Type).SetConfig(input.Config).Save(ctx)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create provider: %v", err)
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	err = r.client.Action.Create().
-		SetIPAddress(clientIp).
-		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created provider %s", entProvider.Name)).
-		SetActionToUser(authUser).
-		Exec(ctx)
+	userUuid, err := uuid.Parse(userID)
 	if err != nil {
-		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	return entProvider, nil
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query user: %v", err)
+	}
+	canManage, err := canManageUser(ctx, authUser, entUser)
+	if err != nil {
+		return false, err
+	}
+	if !canManage {
+		return false, fmt.Errorf("user is not authorized to manage %s", entUser.Username)
+	}
+	err = r.revokeUserSessions(ctx, authUser, entUser, clientIp, "signed out everywhere by an admin")
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-// UpdateProvider is the resolver for the updateProvider field.
-func (r *mutationResolver) UpdateProvider(ctx context.Context, input model.ProviderInput) (*ent.Provider, error) {
+// UnlockAccount is the resolver for the unlockAccount field.
+func (r *mutationResolver) UnlockAccount(ctx context.Context, typeArg model.LockoutType, identifier string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user from context: %v", err)
+		return false, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gin context from resolver context")
+		return false, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1343,52 +1619,47 @@ func (r *mutationResolver) UpdateProvider(ctx context.Context, input model.Provi
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateProvider\" endpoint").
+		SetMessage("called \"UnlockAccount\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query provider: ID must not be nil")
+	if !typeArg.IsValid() {
+		return false, fmt.Errorf("invalid lockout type")
 	}
-	providerUuid, err := uuid.Parse(*input.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+	subject := ratelimit.Subject{Type: ratelimit.SubjectType(typeArg), Identifier: identifier}
+	if typeArg == model.LockoutTypeUsername {
+		subject = ratelimit.Username(identifier)
 	}
-	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	unlocked, err := r.loginLimiter.Unlock(ctx, subject)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query provider: %v", err)
+		return false, err
 	}
-	entProvider, err = entProvider.Update().
-		SetName(input.Name).
-		SetType(input.Type).
-		SetConfig(input.Config).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update provider: %v", err)
+	if !unlocked {
+		return false, fmt.Errorf("%s \"%s\" is not locked out", typeArg, identifier)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated provider %s", entProvider.Name)).
+		SetType(action.TypeACCOUNT_UNLOCKED).
+		SetMessage(fmt.Sprintf("unlocked %s \"%s\"", typeArg, identifier)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+		logrus.Warnf("failed to log ACCOUNT_UNLOCKED: %v", err)
 	}
-	return entProvider, nil
+	return true, nil
 }
 
-// DeleteProvider is the resolver for the deleteProvider field.
-func (r *mutationResolver) DeleteProvider(ctx context.Context, id string) (bool, error) {
+// Impersonate is the resolver for the impersonate field.
+func (r *mutationResolver) Impersonate(ctx context.Context, userID *string, teamID *string) (*ent.User, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1397,46 +1668,85 @@ func (r *mutationResolver) DeleteProvider(ctx context.Context, id string) (bool,
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteProvider\" endpoint").
+		SetMessage("called \"Impersonate\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	providerUuid, err := uuid.Parse(id)
+	// Impersonation is tied to the browser session, so personal access tokens can't impersonate
+	entToken, err := api.ForContextSession(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+		return nil, fmt.Errorf("impersonating requires signing in to Compsole: %v", err)
 	}
-	if competitionCount, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).QueryProviderToCompetitions().Count(ctx); err != nil {
-		return false, fmt.Errorf("failed to query competitions from provider")
-	} else if competitionCount > 0 {
-		return false, fmt.Errorf("cannot delete provider while competitions actively reference it")
+	var entUser *ent.User
+	switch {
+	case userID != nil && teamID == nil:
+		userUuid, err := uuid.Parse(*userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user UUID: %v", err)
+		}
+		entUser, err = r.client.User.Query().Where(user.IDEQ(userUuid)).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query user: %v", err)
+		}
+	case teamID != nil && userID == nil:
+		teamUuid, err := uuid.Parse(*teamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+		}
+		// View the team as the first competitor who has it as their active team
+		entUser, err = r.client.User.Query().
+			Where(
+				user.HasUserToTeamWith(team.IDEQ(teamUuid)),
+				user.RoleEQ(user.RoleUSER),
+			).
+			Order(ent.Asc(user.FieldUsername)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("team has no competitors to impersonate")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query team members: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("exactly one of userId and teamId must be provided")
 	}
-	_, err = r.client.Provider.Delete().Where(provider.IDEQ(providerUuid)).Exec(ctx)
+	if entUser.ID == authUser.ID {
+		return nil, fmt.Errorf("can't impersonate yourself")
+	}
+	canManage, err := canManageUser(ctx, authUser, entUser)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete provider: %v", err)
+		return nil, err
+	}
+	if !canManage {
+		return nil, fmt.Errorf("user is not authorized to impersonate %s", entUser.Username)
+	}
+	err = entToken.Update().SetTokenToImpersonatedUser(entUser).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update session: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted provider %s", id)).
+		SetType(action.TypeIMPERSONATE).
+		SetMessage(fmt.Sprintf("started impersonating user %s", entUser.Username)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+		logrus.Warnf("failed to log IMPERSONATE: %v", err)
 	}
-	return true, nil
+	return entUser, nil
 }
 
-// LoadProvider is the resolver for the loadProvider field.
-func (r *mutationResolver) LoadProvider(ctx context.Context, id string) (bool, error) {
+// GenerateCompetitionUsers is the resolver for the generateCompetitionUsers field.
+func (r *mutationResolver) GenerateCompetitionUsers(ctx context.Context, competitionID string, usersPerTeam int) ([]*model.CompetitionUser, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1445,32 +1755,85 @@ func (r *mutationResolver) LoadProvider(ctx context.Context, id string) (bool, e
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"LoadProvider\" endpoint").
+		SetMessage("called \"CreateTeam\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	providerUuid, err := uuid.Parse(id)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserWrite)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse provider UUID: %v", err)
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	competitionUuid, err := uuid.Parse(competitionID)
 	if err != nil {
-		return false, fmt.Errorf("failed to query provider: %v", err)
+		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
 	}
-	// Generate the provider
-	p, err := providers.NewProvider(ctx, entProvider.Type, entProvider.Config)
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to create provider from config: %v", err)
+		return nil, fmt.Errorf("failed to query competition: %v", err)
 	}
-	// Store the provider in provider map
-	r.providers.Set(entProvider.ID, p)
-	return true, nil
+	entTeams, err := r.client.Team.Query().Where(team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid))).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query teams for competition: %v", err)
+	}
+	passwordPolicy := utils.LoadPasswordPolicy()
+	competitionUsers := make([]*model.CompetitionUser, 0)
+	for _, entTeam := range entTeams {
+		for i := 0; i < usersPerTeam; i++ {
+			// user details:
+			// username = [comp_name][team_num][user_letter]
+			//   ex. comp01a
+			username := fmt.Sprintf(
+				"%s%02d%c",
+				entCompetition.Name,
+				entTeam.TeamNumber, rune('a'+i),
+			)
+			if usersPerTeam == 1 {
+				// Don't use a letter at the end if only 1 per team
+				username = fmt.Sprintf(
+					"%s%02d",
+					entCompetition.Name,
+					entTeam.TeamNumber,
+				)
+			}
+			// password = randomly generated (noun + num + adj + num + noun), adjusted to follow the password policy
+			password := passwordPolicy.Generate()
+			hashedPassword, err := utils.HashPassword(password)
+			if err != nil {
+				logrus.Errorf("failed to create user: %v", err)
+				continue
+			}
+			entUser, err := r.client.User.Create().
+				SetFirstName("Team").
+				SetLastName(strconv.Itoa(entTeam.TeamNumber)).
+				SetUsername(strings.ToLower(username)).
+				SetPassword(hashedPassword).
+				SetProvider(user.ProviderLOCAL).
+				SetRole(user.RoleUSER).
+				SetUserToTeam(entTeam).
+				Save(ctx)
+			if err != nil {
+				// log failed users and keep trying to make them
+				logrus.Errorf("failed to create user: %v", err)
+				continue
+			}
+			if err = utils.EnsureTeamMembership(ctx, r.client, entUser, entTeam); err != nil {
+				logrus.Errorf("failed to add user to team: %v", err)
+			}
+			competitionUsers = append(competitionUsers, &model.CompetitionUser{
+				ID:         entUser.ID.String(),
+				Username:   entUser.Username,
+				Password:   password,
+				UserToTeam: entTeam,
+			})
+		}
+	}
+	return competitionUsers, nil
 }
 
-// CreateServiceAccount is the resolver for the createServiceAccount field.
-func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input model.ServiceAccountInput) (*model.ServiceAccountDetails, error) {
+// CreateCustomRole is the resolver for the createCustomRole field.
+func (r *mutationResolver) CreateCustomRole(ctx context.Context, input model.CustomRoleInput) (*ent.CustomRole, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -1486,41 +1849,41 @@ func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input model
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"CreateServiceAccount\" endpoint").
+		SetMessage("called \"CreateCustomRole\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entServiceAccount, err := r.client.ServiceAccount.Create().
-		SetDisplayName(input.DisplayName).
-		SetActive(input.Active).
-		SetAPIKey(uuid.New()).
-		SetAPISecret(uuid.New()).
+	if input.Name == "" {
+		return nil, fmt.Errorf("failed to create custom role: name must not be empty")
+	}
+	rolePermissions := permissionsFromModel(input.Permissions)
+	if err = checkCanGrant(ctx, authUser, rolePermissions); err != nil {
+		return nil, err
+	}
+	entCustomRole, err := r.client.CustomRole.Create().
+		SetName(input.Name).
+		SetDescription(input.Description).
+		SetPermissions(permissions.Strings(rolePermissions)).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create service account: %v", err)
+		return nil, fmt.Errorf("failed to create custom role: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeCREATE_OBJECT).
-		SetMessage(fmt.Sprintf("created service account %s", entServiceAccount.DisplayName)).
+		SetMessage(fmt.Sprintf("created custom role %s", entCustomRole.Name)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
 	}
-	return &model.ServiceAccountDetails{
-		ID:          entServiceAccount.ID.String(),
-		DisplayName: entServiceAccount.DisplayName,
-		APIKey:      entServiceAccount.APIKey.String(),
-		APISecret:   entServiceAccount.APISecret.String(),
-		Active:      entServiceAccount.Active,
-	}, nil
+	return entCustomRole, nil
 }
 
-// UpdateServiceAccount is the resolver for the updateServiceAccount field.
-func (r *mutationResolver) UpdateServiceAccount(ctx context.Context, input model.ServiceAccountInput) (*ent.ServiceAccount, error) {
+// UpdateCustomRole is the resolver for the updateCustomRole field.
+func (r *mutationResolver) UpdateCustomRole(ctx context.Context, input model.CustomRoleInput) (*ent.CustomRole, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -1536,47 +1899,52 @@ func (r *mutationResolver) UpdateServiceAccount(ctx context.Context, input model
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"UpdateServiceAccount\" endpoint").
+		SetMessage("called \"UpdateCustomRole\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
 	if input.ID == nil {
-		return nil, fmt.Errorf("failed to query service account: ID must not be nil")
+		return nil, fmt.Errorf("failed to query custom role: ID must not be nil")
 	}
-	serviceAccountUuid, err := uuid.Parse(*input.ID)
+	if input.Name == "" {
+		return nil, fmt.Errorf("failed to update custom role: name must not be empty")
+	}
+	customRoleUuid, err := uuid.Parse(*input.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse service account UUID: %v", err)
+		return nil, fmt.Errorf("failed to parse custom role UUID: %v", err)
 	}
-	entServiceAccount, err := r.client.ServiceAccount.Query().
-		Where(
-			serviceaccount.IDEQ(serviceAccountUuid),
-		).Only(ctx)
+	entCustomRole, err := r.client.CustomRole.Get(ctx, customRoleUuid)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query service account: %v", err)
+		return nil, fmt.Errorf("failed to query custom role: %v", err)
 	}
-	entServiceAccount, err = entServiceAccount.Update().
-		SetDisplayName(input.DisplayName).
-		SetActive(input.Active).
+	rolePermissions := permissionsFromModel(input.Permissions)
+	if err = checkCanGrant(ctx, authUser, rolePermissions); err != nil {
+		return nil, err
+	}
+	entCustomRole, err = entCustomRole.Update().
+		SetName(input.Name).
+		SetDescription(input.Description).
+		SetPermissions(permissions.Strings(rolePermissions)).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update service account: %v", err)
+		return nil, fmt.Errorf("failed to update custom role: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeUPDATE_OBJECT).
-		SetMessage(fmt.Sprintf("updated service account %s", entServiceAccount.DisplayName)).
+		SetMessage(fmt.Sprintf("updated custom role %s", entCustomRole.Name)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
 	}
-	return entServiceAccount, nil
+	return entCustomRole, nil
 }
 
-// DeleteServiceAccount is the resolver for the deleteServiceAccount field.
-func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string) (bool, error) {
+// DeleteCustomRole is the resolver for the deleteCustomRole field.
+func (r *mutationResolver) DeleteCustomRole(ctx context.Context, id string) (bool, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get user from context: %v", err)
@@ -1592,27 +1960,25 @@ func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string)
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"DeleteServiceAccount\" endpoint").
+		SetMessage("called \"DeleteCustomRole\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	serviceAccountUuid, err := uuid.Parse(id)
+	customRoleUuid, err := uuid.Parse(id)
 	if err != nil {
 		return false, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	_, err = r.client.ServiceAccount.Delete().
-		Where(
-			serviceaccount.IDEQ(serviceAccountUuid),
-		).Exec(ctx)
+	// Users with the role fall back to the permissions of their built-in role
+	err = r.client.CustomRole.DeleteOneID(customRoleUuid).Exec(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to delete service account: %v", err)
+		return false, fmt.Errorf("failed to delete custom role: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeDELETE_OBJECT).
-		SetMessage(fmt.Sprintf("deleted service account %s", id)).
+		SetMessage(fmt.Sprintf("deleted custom role %s", id)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
@@ -1621,15 +1987,15 @@ func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string)
 	return true, nil
 }
 
-// LockoutVM is the resolver for the lockoutVm field.
-func (r *mutationResolver) LockoutVM(ctx context.Context, id string, locked bool) (bool, error) {
+// CreateTeam is the resolver for the createTeam field.
+func (r *mutationResolver) CreateTeam(ctx context.Context, input model.TeamInput) (*ent.Team, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1638,42 +2004,59 @@ func (r *mutationResolver) LockoutVM(ctx context.Context, id string, locked bool
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"LockoutVM\" endpoint").
+		SetMessage("called \"CreateTeam\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	vmObjectUuid, err := uuid.Parse(id)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamWrite)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	if err := allowlist.Validate(input.AllowedCidrs); err != nil {
+		return nil, err
 	}
-	err = r.client.VmObject.Update().Where(vmobject.IDEQ(vmObjectUuid)).SetLocked(locked).Exec(ctx)
+	competitionUuid, err := uuid.Parse(input.TeamToCompetition)
 	if err != nil {
-		return false, fmt.Errorf("failed to set vm object lock state: %v", err)
+		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+	}
+	teamExists, err := r.client.Team.Query().Where(team.And(team.TeamNumberEQ(input.TeamNumber), team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid)))).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query if team already exists: %v", err)
+	}
+	if teamExists {
+		return nil, fmt.Errorf("failed to create team: team already exists")
+	}
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query competition: %v", err)
+	}
+	entTeam, err := r.client.Team.Create().SetTeamNumber(input.TeamNumber).SetName(*input.Name).SetAllowedCidrs(input.AllowedCidrs).SetTeamToCompetition(entCompetition).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_LOCKOUT).
-		SetMessage(fmt.Sprintf("set lockout to %t for vm %s", locked, id)).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created team %s", entTeam.Name)).
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
 	}
-	r.rdb.Publish(ctx, "lockout", vmObjectUuid.String())
-	return true, nil
+	return entTeam, nil
 }
 
-// BatchLockout is the resolver for the batchLockout field.
-func (r *mutationResolver) BatchLockout(ctx context.Context, vmObjects []string, locked bool) (bool, error) {
+// BatchCreateTeams is the resolver for the batchCreateTeams field.
+func (r *mutationResolver) BatchCreateTeams(ctx context.Context, input []*model.TeamInput) ([]*ent.Team, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1682,47 +2065,2053 @@ func (r *mutationResolver) BatchLockout(ctx context.Context, vmObjects []string,
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"BatchLockout\" endpoint").
+		SetMessage("called \"BatchCreateTeams\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	for _, id := range vmObjects {
-		vmObjectUuid, err := uuid.Parse(id)
-		if err != nil {
-			logrus.Errorf("failed to parse UUID: %v", err)
-			continue
-		}
-		err = r.client.VmObject.Update().Where(vmobject.IDEQ(vmObjectUuid)).SetLocked(locked).Exec(ctx)
-		if err != nil {
-			logrus.Errorf("failed to set vm object lock state: %v", err)
-			continue
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	for _, inputTeam := range input {
+		if err := allowlist.Validate(inputTeam.AllowedCidrs); err != nil {
+			return nil, err
 		}
-		err = r.client.Action.Create().
+	}
+	entTeams := make([]*ent.TeamCreate, len(input))
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ent transactional client: %v", err)
+	}
+	for i, inputTeam := range input {
+		competitionUuid, err := uuid.Parse(inputTeam.TeamToCompetition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+		}
+		teamExists, err := tx.Team.Query().Where(team.And(team.TeamNumberEQ(inputTeam.TeamNumber), team.HasTeamToCompetitionWith(competition.IDEQ(competitionUuid)))).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query if team already exists: %v", err)
+		}
+		if teamExists {
+			return nil, fmt.Errorf("failed to create team: team already exists")
+		}
+		entCompetition, err := tx.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query competition: %v", err)
+		}
+		entTeam := tx.Team.Create().SetTeamNumber(inputTeam.TeamNumber).SetName(*inputTeam.Name).SetAllowedCidrs(inputTeam.AllowedCidrs).SetTeamToCompetition(entCompetition)
+		entTeams[i] = entTeam
+	}
+	newEntTeams, err := tx.Team.CreateBulk(entTeams...).Save(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: failed to bulk create teams: %v", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transation. rolled back database: %v", err)
+	}
+	unwrappedTeams := make([]*ent.Team, len(newEntTeams))
+	for i, entTeam := range newEntTeams {
+		unwrappedTeams[i] = entTeam.Unwrap()
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("batch created %d teams", len(unwrappedTeams))).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return unwrappedTeams, nil
+}
+
+// UpdateTeam is the resolver for the updateTeam field.
+func (r *mutationResolver) UpdateTeam(ctx context.Context, input model.TeamInput) (*ent.Team, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateTeam\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query team: ID must not be nil")
+	}
+	if err := allowlist.Validate(input.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	teamUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+	}
+	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team: %v", err)
+	}
+	competitionUuid, err := uuid.Parse(input.TeamToCompetition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+	}
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query competition: %v", err)
+	}
+	entTeamUpdate := entTeam.Update().SetName(*input.Name).SetTeamToCompetition(entCompetition)
+	if input.AllowedCidrs != nil {
+		entTeamUpdate.SetAllowedCidrs(input.AllowedCidrs)
+	}
+	entTeam, err = entTeamUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update team: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated team %s", entTeam.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entTeam, nil
+}
+
+// DeleteTeam is the resolver for the deleteTeam field.
+func (r *mutationResolver) DeleteTeam(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteTeam\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamWrite)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	teamUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	deleted, err := r.client.Team.Delete().Where(team.IDEQ(teamUuid), scope.Teams()).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete team: %v", err)
+	}
+	if deleted == 0 {
+		return false, fmt.Errorf("failed to delete team: team not found")
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted team %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETED_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// CreateCompetition is the resolver for the createCompetition field.
+func (r *mutationResolver) CreateCompetition(ctx context.Context, input model.CompetitionInput) (*ent.Competition, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateCompetition\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if err := allowlist.Validate(input.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	providerUuid, err := uuid.Parse(input.CompetitionToProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+	}
+	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provider: %v", err)
+	}
+	entCompetition, err := r.client.Competition.Create().
+		SetName(input.Name).
+		SetNillableConsoleLimitPerVM(input.ConsoleLimitPerVM).
+		SetNillableConsoleLimitPerUser(input.ConsoleLimitPerUser).
+		SetNillableConsoleLimitPerTeam(input.ConsoleLimitPerTeam).
+		SetAllowedCidrs(input.AllowedCidrs).
+		SetCompetitionToProvider(entProvider).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create competition: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created competition %s", entCompetition.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return entCompetition, nil
+}
+
+// UpdateCompetition is the resolver for the updateCompetition field.
+func (r *mutationResolver) UpdateCompetition(ctx context.Context, input model.CompetitionInput) (*ent.Competition, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateCompetition\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.CompetitionWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query competition: ID must not be nil")
+	}
+	if err := allowlist.Validate(input.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	competitionUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+	}
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query competition: %v", err)
+	}
+	providerUuid, err := uuid.Parse(input.CompetitionToProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+	}
+	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provider: %v", err)
+	}
+	// Providers are shared between competitions, so only admins of every competition can move between them
+	if !scope.IsGlobal() {
+		currentProviderUuid, err := entCompetition.QueryCompetitionToProvider().OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query provider from competition: %v", err)
+		}
+		if currentProviderUuid != entProvider.ID {
+			return nil, fmt.Errorf("competition admins can't change the provider of a competition")
+		}
+	}
+	entCompetitionUpdate := entCompetition.Update().
+		SetName(input.Name).
+		SetNillableConsoleLimitPerVM(input.ConsoleLimitPerVM).
+		SetNillableConsoleLimitPerUser(input.ConsoleLimitPerUser).
+		SetNillableConsoleLimitPerTeam(input.ConsoleLimitPerTeam).
+		SetCompetitionToProvider(entProvider)
+	if input.AllowedCidrs != nil {
+		entCompetitionUpdate.SetAllowedCidrs(input.AllowedCidrs)
+	}
+	entCompetition, err = entCompetitionUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update team: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated competition %s", entCompetition.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entCompetition, nil
+}
+
+// DeleteCompetition is the resolver for the deleteCompetition field.
+func (r *mutationResolver) DeleteCompetition(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteCompetition\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	competitionUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	_, err = r.client.Competition.Delete().Where(competition.IDEQ(competitionUuid)).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete competition: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted competition %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// SetCompetitionSchedule is the resolver for the setCompetitionSchedule field.
+func (r *mutationResolver) SetCompetitionSchedule(ctx context.Context, id string, input model.CompetitionScheduleInput) (*ent.Competition, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"SetCompetitionSchedule\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.CompetitionWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	competitionUuid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse competition UUID: %v", err)
+	}
+	if input.StartsAt != nil && input.EndsAt != nil && !input.EndsAt.After(*input.StartsAt) {
+		return nil, fmt.Errorf("competition must end after it starts")
+	}
+	for _, inputBreak := range input.Breaks {
+		if !inputBreak.EndsAt.After(inputBreak.StartsAt) {
+			return nil, fmt.Errorf("breaks must end after they start")
+		}
+	}
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query competition: %v", err)
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ent transactional client: %v", err)
+	}
+	competitionUpdate := tx.Competition.UpdateOne(entCompetition)
+	if input.StartsAt != nil {
+		competitionUpdate.SetStartsAt(*input.StartsAt)
+	} else {
+		competitionUpdate.ClearStartsAt()
+	}
+	if input.EndsAt != nil {
+		competitionUpdate.SetEndsAt(*input.EndsAt)
+	} else {
+		competitionUpdate.ClearEndsAt()
+	}
+	err = competitionUpdate.Exec(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: failed to update competition: %v", err, rerr)
+		}
+		return nil, err
+	}
+	_, err = tx.CompetitionBreak.Delete().
+		Where(competitionbreak.HasCompetitionBreakToCompetitionWith(competition.IDEQ(entCompetition.ID))).
+		Exec(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: failed to delete breaks: %v", err, rerr)
+		}
+		return nil, err
+	}
+	entBreaks := make([]*ent.CompetitionBreakCreate, len(input.Breaks))
+	for i, inputBreak := range input.Breaks {
+		entBreaks[i] = tx.CompetitionBreak.Create().
+			SetNillableName(inputBreak.Name).
+			SetStartsAt(inputBreak.StartsAt).
+			SetEndsAt(inputBreak.EndsAt).
+			SetCompetitionBreakToCompetitionID(entCompetition.ID)
+	}
+	_, err = tx.CompetitionBreak.CreateBulk(entBreaks...).Save(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: failed to create breaks: %v", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction. rolled back database: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated schedule of competition %s", entCompetition.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return r.client.Competition.Get(ctx, entCompetition.ID)
+}
+
+// CreateVMObject is the resolver for the createVmObject field.
+func (r *mutationResolver) CreateVMObject(ctx context.Context, input model.VMObjectInput) (*ent.VmObject, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateVMObject\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	var entTeam *ent.Team = nil
+	if input.VMObjectToTeam != nil {
+		teamUuid, err := uuid.Parse(*input.VMObjectToTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+		}
+		entTeam, err = r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query team: %v", err)
+		}
+	}
+	if entTeam == nil && !scope.IsGlobal() {
+		return nil, fmt.Errorf("competition admins can only manage vm objects on the teams of their competitions")
+	}
+	entVmObject, err := r.client.VmObject.Create().
+		SetName(input.Name).
+		SetIdentifier(input.Identifier).
+		SetIPAddresses(input.IPAddresses).
+		SetNillableRedTeamAccess(input.RedTeamAccess).
+		SetVmObjectToTeam(entTeam).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vm object: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created vm object %s", entVmObject.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return entVmObject, nil
+}
+
+// BatchCreateVMObjects is the resolver for the batchCreateVmObjects field.
+func (r *mutationResolver) BatchCreateVMObjects(ctx context.Context, input []*model.VMObjectInput) ([]*ent.VmObject, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"BatchCreateVMObjects\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	entVmObjects := make([]*ent.VmObjectCreate, len(input))
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ent transactional client: %v", err)
+	}
+	for i, inputVmObject := range input {
+		var entTeam *ent.Team = nil
+		if inputVmObject.VMObjectToTeam != nil {
+			teamUuid, err := uuid.Parse(*inputVmObject.VMObjectToTeam)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+			}
+			entTeam, err = tx.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query team: %v", err)
+			}
+		}
+		if entTeam == nil && !scope.IsGlobal() {
+			return nil, fmt.Errorf("competition admins can only manage vm objects on the teams of their competitions")
+		}
+		entVmObject := tx.VmObject.Create().
+			SetName(inputVmObject.Name).
+			SetIdentifier(inputVmObject.Identifier).
+			SetIPAddresses(inputVmObject.IPAddresses).
+			SetNillableRedTeamAccess(inputVmObject.RedTeamAccess).
+			SetVmObjectToTeam(entTeam)
+		entVmObjects[i] = entVmObject
+	}
+	newEntVmObjects, err := tx.VmObject.CreateBulk(entVmObjects...).Save(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: failed to bulk create vm objects: %v", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transation. rolled back database: %v", err)
+	}
+	unwrappedVmObjects := make([]*ent.VmObject, len(newEntVmObjects))
+	for i, entVmObject := range newEntVmObjects {
+		unwrappedVmObjects[i] = entVmObject.Unwrap()
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created %d vm objects", len(unwrappedVmObjects))).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return unwrappedVmObjects, nil
+}
+
+// UpdateVMObject is the resolver for the updateVmObject field.
+func (r *mutationResolver) UpdateVMObject(ctx context.Context, input model.VMObjectInput) (*ent.VmObject, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateVMObjects\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query vm object: ID must not be nil")
+	}
+	vmObjectUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+	}
+	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	teamUuid, err := uuid.Parse(*input.VMObjectToTeam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse team UUID: %v", err)
+	}
+	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team: %v", err)
+	}
+	entVmObject, err = entVmObject.Update().
+		SetName(input.Name).
+		SetIdentifier(input.Identifier).
+		SetIPAddresses(input.IPAddresses).
+		SetNillableRedTeamAccess(input.RedTeamAccess).
+		SetVmObjectToTeam(entTeam).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update vm object: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated vm object %s", entVmObject.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entVmObject, nil
+}
+
+// DeleteVMObject is the resolver for the deleteVmObject field.
+func (r *mutationResolver) DeleteVMObject(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteVMObject\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmWrite)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	deleted, err := r.client.VmObject.Delete().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete vm object: %v", err)
+	}
+	if deleted == 0 {
+		return false, fmt.Errorf("failed to delete vm object: vm object not found")
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted vm object %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// SetVMConsoleLimits is the resolver for the setVmConsoleLimits field.
+func (r *mutationResolver) SetVMConsoleLimits(ctx context.Context, id string, perVM *int, perUser *int, perTeam *int) (*ent.VmObject, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"SetVmConsoleLimits\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	inScope, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	if !inScope {
+		return nil, fmt.Errorf("failed to query vm object: vm object not found")
+	}
+	vmObjectUpdate := r.client.VmObject.UpdateOneID(vmObjectUuid)
+	// Null limits are inherited from the competition
+	if perVM != nil {
+		vmObjectUpdate = vmObjectUpdate.SetConsoleLimitPerVM(*perVM)
+	} else {
+		vmObjectUpdate = vmObjectUpdate.ClearConsoleLimitPerVM()
+	}
+	if perUser != nil {
+		vmObjectUpdate = vmObjectUpdate.SetConsoleLimitPerUser(*perUser)
+	} else {
+		vmObjectUpdate = vmObjectUpdate.ClearConsoleLimitPerUser()
+	}
+	if perTeam != nil {
+		vmObjectUpdate = vmObjectUpdate.SetConsoleLimitPerTeam(*perTeam)
+	} else {
+		vmObjectUpdate = vmObjectUpdate.ClearConsoleLimitPerTeam()
+	}
+	entVmObject, err := vmObjectUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update vm object: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated console limits for vm object %s", entVmObject.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entVmObject, nil
+}
+
+// CreateVMCredential is the resolver for the createVmCredential field.
+func (r *mutationResolver) CreateVMCredential(ctx context.Context, input model.VMCredentialInput) (*ent.VmCredential, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateVmCredential\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(input.VMCredentialToVMObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+	}
+	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	if exists, err := entVmObject.QueryVmObjectToVmCredentials().Where(vmcredential.ProtocolEQ(vmcredential.Protocol(input.Protocol))).Exist(ctx); err != nil {
+		return nil, fmt.Errorf("failed to query vm credentials: %v", err)
+	} else if exists {
+		return nil, fmt.Errorf("vm object already has %s credentials", input.Protocol)
+	}
+	vmCredentialCreate := r.client.VmCredential.Create().
+		SetProtocol(vmcredential.Protocol(input.Protocol)).
+		SetPort(input.Port).
+		SetUsername(input.Username).
+		SetDomain(input.Domain).
+		SetIgnoreCert(input.IgnoreCert).
+		SetVmCredentialToVmObject(entVmObject)
+	if input.Password != nil {
+		vmCredentialCreate = vmCredentialCreate.SetPassword(*input.Password)
+	}
+	if input.PrivateKey != nil {
+		vmCredentialCreate = vmCredentialCreate.SetPrivateKey(*input.PrivateKey)
+	}
+	entVmCredential, err := vmCredentialCreate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vm credential: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created %s credentials for vm object %s", entVmCredential.Protocol, entVmObject.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return entVmCredential, nil
+}
+
+// UpdateVMCredential is the resolver for the updateVmCredential field.
+func (r *mutationResolver) UpdateVMCredential(ctx context.Context, input model.VMCredentialInput) (*ent.VmCredential, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateVmCredential\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query vm credential: ID must not be nil")
+	}
+	vmCredentialUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm credential UUID: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(input.VMCredentialToVMObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+	}
+	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	if exists, err := entVmObject.QueryVmObjectToVmCredentials().Where(
+		vmcredential.ProtocolEQ(vmcredential.Protocol(input.Protocol)),
+		vmcredential.IDNEQ(vmCredentialUuid),
+	).Exist(ctx); err != nil {
+		return nil, fmt.Errorf("failed to query vm credentials: %v", err)
+	} else if exists {
+		return nil, fmt.Errorf("vm object already has %s credentials", input.Protocol)
+	}
+	entVmCredential, err := r.client.VmCredential.Query().Where(vmcredential.IDEQ(vmCredentialUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm credential: %v", err)
+	}
+	vmCredentialUpdate := entVmCredential.Update().
+		SetProtocol(vmcredential.Protocol(input.Protocol)).
+		SetPort(input.Port).
+		SetUsername(input.Username).
+		SetDomain(input.Domain).
+		SetIgnoreCert(input.IgnoreCert).
+		SetVmCredentialToVmObject(entVmObject)
+	if input.Password != nil {
+		vmCredentialUpdate = vmCredentialUpdate.SetPassword(*input.Password)
+	}
+	if input.PrivateKey != nil {
+		vmCredentialUpdate = vmCredentialUpdate.SetPrivateKey(*input.PrivateKey)
+	}
+	entVmCredential, err = vmCredentialUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update vm credential: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated %s credentials for vm object %s", entVmCredential.Protocol, entVmObject.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entVmCredential, nil
+}
+
+// DeleteVMCredential is the resolver for the deleteVmCredential field.
+func (r *mutationResolver) DeleteVMCredential(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteVmCredential\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	vmCredentialUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	_, err = r.client.VmCredential.Delete().Where(vmcredential.IDEQ(vmCredentialUuid)).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete vm credential: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted vm credential %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// CreateConsoleShare is the resolver for the createConsoleShare field.
+func (r *mutationResolver) CreateConsoleShare(ctx context.Context, vmObjectID string, consoleType model.ConsoleType, expiresAt time.Time, password *string) (*model.ConsoleShareDetails, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateConsoleShare\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if !utils.ConsoleType(consoleType).IsProxied() {
+		return nil, fmt.Errorf("only consoles proxied through Compsole can be shared")
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiresAt must be in the future")
+	}
+	vmObjectUuid, err := uuid.Parse(vmObjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+	}
+	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
+	}
+	hashedPassword := ""
+	if password != nil && *password != "" {
+		hashedPassword, err = utils.HashPassword(*password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %v", err)
+		}
+	}
+	shareToken, err := utils.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	entConsoleShare, err := r.client.ConsoleShare.Create().
+		SetTokenHash(utils.HashToken(shareToken)).
+		SetConsoleType(string(consoleType)).
+		SetPassword(hashedPassword).
+		SetExpiresAt(expiresAt).
+		SetConsoleShareToVmObject(entVmObject).
+		SetConsoleShareToUser(authUser).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create console share: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created %s console share link %s for vm %s (expires %s)", consoleType, entConsoleShare.ID, entVmObject.Name, expiresAt.Format(time.RFC3339))).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return &model.ConsoleShareDetails{
+		ID:          entConsoleShare.ID.String(),
+		ConsoleType: consoleType,
+		ExpiresAt:   entConsoleShare.ExpiresAt,
+		Token:       shareToken,
+	}, nil
+}
+
+// RevokeConsoleShare is the resolver for the revokeConsoleShare field.
+func (r *mutationResolver) RevokeConsoleShare(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"RevokeConsoleShare\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	consoleShareUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	err = r.client.ConsoleShare.UpdateOneID(consoleShareUuid).SetRevoked(true).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke console share: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("revoked console share link %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// CreateProvider is the resolver for the createProvider field.
+func (r *mutationResolver) CreateProvider(ctx context.Context, input model.ProviderInput) (*ent.Provider, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateProvider\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entProvider, err := r.client.Provider.Create().SetName(input.Name).SetType(input.Type).SetConfig(input.Config).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provider: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created provider %s", entProvider.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return entProvider, nil
+}
+
+// UpdateProvider is the resolver for the updateProvider field.
+func (r *mutationResolver) UpdateProvider(ctx context.Context, input model.ProviderInput) (*ent.Provider, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateProvider\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query provider: ID must not be nil")
+	}
+	providerUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provider UUID: %v", err)
+	}
+	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provider: %v", err)
+	}
+	entProvider, err = entProvider.Update().
+		SetName(input.Name).
+		SetType(input.Type).
+		SetConfig(input.Config).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update provider: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated provider %s", entProvider.Name)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entProvider, nil
+}
+
+// DeleteProvider is the resolver for the deleteProvider field.
+func (r *mutationResolver) DeleteProvider(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteProvider\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	providerUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	// Competitions the user can't see still reference the provider
+	if competitionCount, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).QueryProviderToCompetitions().Count(viewer.SystemContext(ctx)); err != nil {
+		return false, fmt.Errorf("failed to query competitions from provider")
+	} else if competitionCount > 0 {
+		return false, fmt.Errorf("cannot delete provider while competitions actively reference it")
+	}
+	_, err = r.client.Provider.Delete().Where(provider.IDEQ(providerUuid)).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete provider: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted provider %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// LoadProvider is the resolver for the loadProvider field.
+func (r *mutationResolver) LoadProvider(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"LoadProvider\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	providerUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse provider UUID: %v", err)
+	}
+	entProvider, err := r.client.Provider.Query().Where(provider.IDEQ(providerUuid)).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query provider: %v", err)
+	}
+	// Generate the provider
+	p, err := providers.NewProvider(ctx, entProvider.Type, entProvider.Config)
+	if err != nil {
+		return false, fmt.Errorf("failed to create provider from config: %v", err)
+	}
+	// Store the provider in provider map
+	r.providers.Set(entProvider.ID, p)
+	return true, nil
+}
+
+// CreateServiceAccount is the resolver for the createServiceAccount field.
+func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input model.ServiceAccountInput) (*model.ServiceAccountDetails, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"CreateServiceAccount\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	// Service accounts created without scopes keep the full access they had before scopes existed
+	scopes := api.AllScopeStrings()
+	if input.Scopes != nil {
+		scopes = scopesFromModel(input.Scopes)
+	}
+	competitionUuids, err := parseUuids(input.ServiceAccountToCompetitions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse competition UUIDs: %v", err)
+	}
+	teamUuids, err := parseUuids(input.ServiceAccountToTeams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse team UUIDs: %v", err)
+	}
+	apiSecret := uuid.New()
+	entServiceAccount, err := r.client.ServiceAccount.Create().
+		SetDisplayName(input.DisplayName).
+		SetActive(input.Active).
+		SetAPIKey(uuid.New()).
+		SetAPISecretHash(utils.HashToken(apiSecret.String())).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetScopes(scopes).
+		AddServiceAccountToCompetitionIDs(competitionUuids...).
+		AddServiceAccountToTeamIDs(teamUuids...).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service account: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCREATE_OBJECT).
+		SetMessage(fmt.Sprintf("created service account %s", entServiceAccount.DisplayName)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CREATE_OBJECT: %v", err)
+	}
+	return serviceAccountDetails(ctx, entServiceAccount, apiSecret)
+}
+
+// UpdateServiceAccount is the resolver for the updateServiceAccount field.
+func (r *mutationResolver) UpdateServiceAccount(ctx context.Context, input model.ServiceAccountInput) (*ent.ServiceAccount, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UpdateServiceAccount\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if input.ID == nil {
+		return nil, fmt.Errorf("failed to query service account: ID must not be nil")
+	}
+	serviceAccountUuid, err := uuid.Parse(*input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account UUID: %v", err)
+	}
+	entServiceAccount, err := r.client.ServiceAccount.Query().
+		Where(
+			serviceaccount.IDEQ(serviceAccountUuid),
+		).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service account: %v", err)
+	}
+	serviceAccountUpdate := entServiceAccount.Update().
+		SetDisplayName(input.DisplayName).
+		SetActive(input.Active)
+	if input.ExpiresAt != nil {
+		serviceAccountUpdate = serviceAccountUpdate.SetExpiresAt(*input.ExpiresAt)
+	} else {
+		serviceAccountUpdate = serviceAccountUpdate.ClearExpiresAt()
+	}
+	if input.Scopes != nil {
+		serviceAccountUpdate = serviceAccountUpdate.SetScopes(scopesFromModel(input.Scopes))
+	}
+	if input.ServiceAccountToCompetitions != nil {
+		competitionUuids, err := parseUuids(input.ServiceAccountToCompetitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse competition UUIDs: %v", err)
+		}
+		serviceAccountUpdate = serviceAccountUpdate.ClearServiceAccountToCompetitions().AddServiceAccountToCompetitionIDs(competitionUuids...)
+	}
+	if input.ServiceAccountToTeams != nil {
+		teamUuids, err := parseUuids(input.ServiceAccountToTeams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse team UUIDs: %v", err)
+		}
+		serviceAccountUpdate = serviceAccountUpdate.ClearServiceAccountToTeams().AddServiceAccountToTeamIDs(teamUuids...)
+	}
+	entServiceAccount, err = serviceAccountUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update service account: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_OBJECT).
+		SetMessage(fmt.Sprintf("updated service account %s", entServiceAccount.DisplayName)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_OBJECT: %v", err)
+	}
+	return entServiceAccount, nil
+}
+
+// DeleteServiceAccount is the resolver for the deleteServiceAccount field.
+func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"DeleteServiceAccount\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	serviceAccountUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	_, err = r.client.ServiceAccount.Delete().
+		Where(
+			serviceaccount.IDEQ(serviceAccountUuid),
+		).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete service account: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeDELETE_OBJECT).
+		SetMessage(fmt.Sprintf("deleted service account %s", id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log DELETE_OBJECT: %v", err)
+	}
+	return true, nil
+}
+
+// RotateServiceAccountSecret is the resolver for the rotateServiceAccountSecret field.
+func (r *mutationResolver) RotateServiceAccountSecret(ctx context.Context, id string, overlapMinutes *int) (*model.ServiceAccountDetails, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"RotateServiceAccountSecret\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if overlapMinutes != nil && *overlapMinutes < 0 {
+		return nil, fmt.Errorf("overlapMinutes must not be negative")
+	}
+	serviceAccountUuid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account UUID: %v", err)
+	}
+	entServiceAccount, err := r.client.ServiceAccount.Query().
+		Where(
+			serviceaccount.IDEQ(serviceAccountUuid),
+		).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service account: %v", err)
+	}
+	apiSecret := uuid.New()
+	serviceAccountUpdate := entServiceAccount.Update().
+		SetAPISecretHash(utils.HashToken(apiSecret.String())).
+		AddSecretGeneration(1)
+	overlap := ""
+	if overlapMinutes != nil && *overlapMinutes > 0 {
+		// Both secrets work until the overlap ends so the new secret can be deployed without downtime. Sessions started
+		// with the old secret end with the overlap (see api.ServiceTokenUsable).
+		serviceAccountUpdate = serviceAccountUpdate.
+			SetPreviousAPISecretHash(entServiceAccount.APISecretHash).
+			SetPreviousAPISecretExpiresAt(time.Now().Add(time.Duration(*overlapMinutes) * time.Minute))
+		overlap = fmt.Sprintf(" (old secret works for %d more minutes)", *overlapMinutes)
+	} else {
+		serviceAccountUpdate = serviceAccountUpdate.
+			ClearPreviousAPISecretHash().
+			ClearPreviousAPISecretExpiresAt()
+	}
+	entServiceAccount, err = serviceAccountUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update service account: %v", err)
+	}
+	if overlap == "" {
+		// Without an overlap, sessions started with the old secret end immediately
+		_, err = r.client.ServiceToken.Delete().
+			Where(
+				servicetoken.HasTokenToServiceAccountWith(serviceaccount.IDEQ(entServiceAccount.ID)),
+			).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke service account sessions: %v", err)
+		}
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeROTATE_SECRET).
+		SetMessage(fmt.Sprintf("rotated secret for service account %s%s", entServiceAccount.DisplayName, overlap)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log ROTATE_SECRET: %v", err)
+	}
+	return serviceAccountDetails(ctx, entServiceAccount, apiSecret)
+}
+
+// LockoutVM is the resolver for the lockoutVm field.
+func (r *mutationResolver) LockoutVM(ctx context.Context, id string, locked bool) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"LockoutVM\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmLockout)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	updated, err := r.client.VmObject.Update().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).SetLocked(locked).Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to set vm object lock state: %v", err)
+	}
+	if updated == 0 {
+		return false, fmt.Errorf("failed to set vm object lock state: vm object not found")
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_LOCKOUT).
+		SetMessage(fmt.Sprintf("set lockout to %t for vm %s", locked, id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+	}
+	r.rdb.Publish(ctx, "lockout", vmObjectUuid.String())
+	return true, nil
+}
+
+// BatchLockout is the resolver for the batchLockout field.
+func (r *mutationResolver) BatchLockout(ctx context.Context, vmObjects []string, locked bool) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"BatchLockout\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmLockout)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	for _, id := range vmObjects {
+		vmObjectUuid, err := uuid.Parse(id)
+		if err != nil {
+			logrus.Errorf("failed to parse UUID: %v", err)
+			continue
+		}
+		updated, err := r.client.VmObject.Update().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).SetLocked(locked).Save(ctx)
+		if err != nil {
+			logrus.Errorf("failed to set vm object lock state: %v", err)
+			continue
+		}
+		if updated == 0 {
+			logrus.Errorf("failed to set vm object lock state: vm object %s not found", id)
+			continue
+		}
+		err = r.client.Action.Create().
+			SetIPAddress(clientIp).
+			SetType(action.TypeUPDATE_LOCKOUT).
+			SetMessage(fmt.Sprintf("set lockout to %t for vm %s", locked, id)).
+			SetActionToUser(authUser).
+			Exec(ctx)
+		if err != nil {
+			logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+			continue
+		}
+		r.rdb.Publish(ctx, "lockout", vmObjectUuid.String())
+	}
+	return true, nil
+}
+
+// LockoutCompetition is the resolver for the lockoutCompetition field.
+func (r *mutationResolver) LockoutCompetition(ctx context.Context, id string, locked bool) (bool, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"LockoutCompetitions\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmLockout)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	competitionUuid, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	if !scope.AllowsCompetition(competitionUuid) {
+		return false, fmt.Errorf("failed to query competition: competition not found")
+	}
+	err = r.client.VmObject.Update().
+		Where(
+			vmobject.HasVmObjectToTeamWith(
+				team.HasTeamToCompetitionWith(
+					competition.IDEQ(competitionUuid),
+				),
+			),
+		).SetLocked(locked).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to set competition vm objects lock state: %v", err)
+	}
+	updatedVmUuids, err := r.client.VmObject.Query().
+		Where(
+			vmobject.HasVmObjectToTeamWith(
+				team.HasTeamToCompetitionWith(
+					competition.IDEQ(competitionUuid),
+				),
+			),
+		).IDs(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query updated vm objects: %v", err)
+	}
+	for _, vmUuid := range updatedVmUuids {
+		r.rdb.Publish(ctx, "lockout", vmUuid.String())
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeUPDATE_LOCKOUT).
+		SetMessage(fmt.Sprintf("set lockout to %t for competition %s", locked, id)).
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+	}
+	return true, nil
+}
+
+// ID is the resolver for the ID field.
+func (r *personalAccessTokenResolver) ID(ctx context.Context, obj *ent.PersonalAccessToken) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// Scope is the resolver for the Scope field.
+func (r *personalAccessTokenResolver) Scope(ctx context.Context, obj *ent.PersonalAccessToken) (model.PersonalAccessTokenScope, error) {
+	return model.PersonalAccessTokenScope(obj.Scope), nil
+}
+
+// ID is the resolver for the ID field.
+func (r *providerResolver) ID(ctx context.Context, obj *ent.Provider) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// Loaded is the resolver for the Loaded field.
+func (r *providerResolver) Loaded(ctx context.Context, obj *ent.Provider) (bool, error) {
+	if obj == nil {
+		return false, nil
+	}
+	_, err := r.providers.Get(obj.ID)
+	if err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Console is the resolver for the console field.
+func (r *queryResolver) Console(ctx context.Context, vmObjectID string, consoleType model.ConsoleType) (string, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"Console\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+
+	vmObjectUuid, err := uuid.Parse(vmObjectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse valid uuid from input vmObjectId: %v", err)
+	}
+	if _, err := api.ForContextImpersonator(ctx); err == nil {
+		return "", fmt.Errorf("consoles can't be opened while impersonating a user")
+	}
+	allowed, err := allowlist.Check(ctx, r.client, entUser, clientIp, "open a console")
+	if err != nil {
+		return "", fmt.Errorf("failed to check ip allowlist: %v", err)
+	}
+	if !allowed {
+		return "", fmt.Errorf("consoles can't be opened from this network")
+	}
+
+	// Get VM DB object
+	entVmObject, err := r.client.VmObject.Get(ctx, vmObjectUuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to query vm object: %v", err)
+	}
+	// Check if user has access to VM
+	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser, permissions.VmConsole)
+	if err != nil {
+		return "", fmt.Errorf("failed to check access to vm: %v", err)
+	}
+	if !canAccessVm {
+		return "", fmt.Errorf("user does not have permission to access this vm")
+	}
+	lockedOut, err := utils.UserIsLockedOut(ctx, entVmObject, entUser, permissions.VmConsole)
+	if err != nil {
+		return "", fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
+		return "", fmt.Errorf("VM is currently locked out")
+	}
+	canUseVm, err := schedule.CanUseVmObject(viewer.SystemContext(ctx), entUser, entVmObject)
+	if err != nil {
+		return "", fmt.Errorf("failed to check competition schedule: %v", err)
+	}
+	if !canUseVm {
+		return "", fmt.Errorf("competition is not open right now")
+	}
+	// Reuse the console the user was already issued
+	var consoleUrl string
+	cached := false
+	if !utils.ConsoleType(consoleType).IsProxied() {
+		consoleUrl, cached, err = r.consoleCache.Get(ctx, entVmObject.ID, entUser.ID, utils.ConsoleType(consoleType))
+		if err != nil {
+			logrus.Warnf("failed to check console cache: %v", err)
+		}
+	}
+	if cached {
+		err = r.client.Action.Create().
 			SetIPAddress(clientIp).
-			SetType(action.TypeUPDATE_LOCKOUT).
-			SetMessage(fmt.Sprintf("set lockout to %t for vm %s", locked, id)).
-			SetActionToUser(authUser).
+			SetType(action.TypeCONSOLE_ACCESS).
+			SetMessage(fmt.Sprintf("access console for vm %s", entVmObject.Name)).
+			SetActionToUser(entUser).
 			Exec(ctx)
 		if err != nil {
-			logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
-			continue
+			logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
 		}
-		r.rdb.Publish(ctx, "lockout", vmObjectUuid.String())
+		return consoleUrl, nil
 	}
-	return true, nil
+	if utils.ConsoleType(consoleType).IsProxied() {
+		// The limits are enforced when the websocket is opened, this rejects the request before then
+		if err := utils.CheckConsoleLimits(ctx, entVmObject, entUser); err != nil {
+			return "", err
+		}
+	}
+	// Proxied consoles are bridged through Compsole, access is logged once the websocket is opened
+	switch utils.ConsoleType(consoleType) {
+	case utils.SerialConsole:
+		return fmt.Sprintf("/api/console/serial/%s", entVmObject.ID), nil
+	case utils.GuacamoleSSHConsole:
+		return fmt.Sprintf("/api/console/ssh/%s", entVmObject.ID), nil
+	case utils.GuacamoleRDPConsole:
+		return fmt.Sprintf("/api/console/rdp/%s", entVmObject.ID), nil
+	}
+	// Get DB objects
+	entCompetition, err := entVmObject.QueryVmObjectToTeam().QueryTeamToCompetition().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query competition from vm object: %v", err)
+	}
+	entProvider, err := entCompetition.QueryCompetitionToProvider().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query provider from competition: %v", err)
+	}
+	// Generate the provider
+	provider, err := r.providers.Get(entProvider.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load provider: %v", err)
+	}
+	// Compsole can't see when these consoles close, so the session is leased to count against console limits. It's
+	// created before the console so it counts against the limits of any concurrent requests.
+	entConsoleSession, err := utils.OpenConsoleSession(ctx, r.rdb, entVmObject, entUser, r.client.ConsoleSession.Create().
+		SetConsoleType(string(consoleType)).
+		SetIPAddress(clientIp).
+		SetEndedAt(time.Now().Add(utils.ConsoleSessionLease())))
+	if err != nil {
+		return "", err
+	}
+	consoleUrl, err = provider.GetConsoleUrl(ctx, entVmObject, utils.ConsoleType(consoleType))
+	if err != nil {
+		// The console was never opened
+		if err := entConsoleSession.Update().SetEndedAt(time.Now()).Exec(ctx); err != nil {
+			logrus.Warnf("failed to end console session: %v", err)
+		}
+		return "", err
+	}
+	err = r.consoleCache.Set(ctx, entVmObject.ID, entUser.ID, utils.ConsoleType(consoleType), consoleUrl, provider.ConsoleUrlLifetime())
+	if err != nil {
+		logrus.Warnf("failed to cache console url: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeCONSOLE_ACCESS).
+		SetMessage(fmt.Sprintf("access console for vm %s", entVmObject.Name)).
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
+	}
+	return consoleUrl, nil
 }
 
-// LockoutCompetition is the resolver for the lockoutCompetition field.
-func (r *mutationResolver) LockoutCompetition(ctx context.Context, id string, locked bool) (bool, error) {
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"Me\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	return entUser, nil
+}
+
+// Impersonator is the resolver for the impersonator field.
+func (r *queryResolver) Impersonator(ctx context.Context) (*ent.User, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"Impersonator\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entImpersonator, err := api.ForContextImpersonator(ctx)
+	if err != nil {
+		// Not impersonating anyone
+		return nil, nil
+	}
+	return entImpersonator, nil
+}
+
+// MyWebauthnCredentials is the resolver for the myWebauthnCredentials field.
+func (r *queryResolver) MyWebauthnCredentials(ctx context.Context) ([]*ent.WebauthnCredential, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"MyWebauthnCredentials\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entCredentials, err := authUser.QueryUserToWebauthnCredentials().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query passkeys: %v", err)
+	}
+	return entCredentials, nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*ent.Token, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"MySessions\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entTokens, err := authUser.QueryUserToToken().
+		Where(token.ExpireAtGTE(time.Now().Unix())).
+		Order(ent.Desc(token.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %v", err)
+	}
+	return entTokens, nil
+}
+
+// MyPersonalAccessTokens is the resolver for the myPersonalAccessTokens field.
+func (r *queryResolver) MyPersonalAccessTokens(ctx context.Context) ([]*ent.PersonalAccessToken, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1731,72 +4120,59 @@ func (r *mutationResolver) LockoutCompetition(ctx context.Context, id string, lo
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"LockoutCompetitions\" endpoint").
+		SetMessage("called \"MyPersonalAccessTokens\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	competitionUuid, err := uuid.Parse(id)
+	entPersonalAccessTokens, err := authUser.QueryUserToPersonalAccessTokens().
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse UUID: %v", err)
+		return nil, fmt.Errorf("failed to query personal access tokens: %v", err)
 	}
-	err = r.client.VmObject.Update().
-		Where(
-			vmobject.HasVmObjectToTeamWith(
-				team.HasTeamToCompetitionWith(
-					competition.IDEQ(competitionUuid),
-				),
-			),
-		).SetLocked(locked).Exec(ctx)
+	return entPersonalAccessTokens, nil
+}
+
+// VMObject is the resolver for the vmObject field.
+func (r *queryResolver) VMObject(ctx context.Context, vmObjectID string) (*ent.VmObject, error) {
+	entUser, err := api.ForContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to set competition vm objects lock state: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
-	updatedVmUuids, err := r.client.VmObject.Query().
-		Where(
-			vmobject.HasVmObjectToTeamWith(
-				team.HasTeamToCompetitionWith(
-					competition.IDEQ(competitionUuid),
-				),
-			),
-		).IDs(ctx)
+	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to query updated vm objects: %v", err)
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
-	for _, vmUuid := range updatedVmUuids {
-		r.rdb.Publish(ctx, "lockout", vmUuid.String())
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeUPDATE_LOCKOUT).
-		SetMessage(fmt.Sprintf("set lockout to %t for competition %s", locked, id)).
-		SetActionToUser(authUser).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"VMObject\" endpoint").
+		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log UPDATE_LOCKOUT: %v", err)
+		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	return true, nil
-}
-
-// ID is the resolver for the ID field.
-func (r *providerResolver) ID(ctx context.Context, obj *ent.Provider) (string, error) {
-	return obj.ID.String(), nil
-}
-
-// Loaded is the resolver for the Loaded field.
-func (r *providerResolver) Loaded(ctx context.Context, obj *ent.Provider) (bool, error) {
-	if obj == nil {
-		return false, nil
+	// Get VM DB object
+	vmObjectUuid, err := uuid.Parse(vmObjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse valid uuid from input vmObjectId: %v", err)
 	}
-	_, err := r.providers.Get(obj.ID)
+	// The privacy policies only return the VMs the user can see
+	entVmObject, err := r.client.VmObject.Get(ctx, vmObjectUuid)
 	if err != nil {
-		return false, nil
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
 	}
-	return true, nil
+	return entVmObject, nil
 }
 
-// Console is the resolver for the console field.
-func (r *queryResolver) Console(ctx context.Context, vmObjectID string, consoleType model.ConsoleType) (string, error) {
+// PowerState is the resolver for the powerState field.
+func (r *queryResolver) PowerState(ctx context.Context, vmObjectID string) (model.PowerState, error) {
 	entUser, err := api.ForContext(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get user from context: %v", err)
@@ -1812,7 +4188,7 @@ func (r *queryResolver) Console(ctx context.Context, vmObjectID string, consoleT
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"Console\" endpoint").
+		SetMessage("called \"PowerState\" endpoint").
 		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
@@ -1830,14 +4206,18 @@ func (r *queryResolver) Console(ctx context.Context, vmObjectID string, consoleT
 		return "", fmt.Errorf("failed to query vm object: %v", err)
 	}
 	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser, permissions.VmRead)
 	if err != nil {
 		return "", fmt.Errorf("failed to check access to vm: %v", err)
 	}
 	if !canAccessVm {
 		return "", fmt.Errorf("user does not have permission to access this vm")
 	}
-	if entUser.Role != user.RoleADMIN && entVmObject.Locked {
+	lockedOut, err := utils.UserIsLockedOut(ctx, entVmObject, entUser, permissions.VmRead)
+	if err != nil {
+		return "", fmt.Errorf("failed to check vm lockout: %v", err)
+	}
+	if lockedOut {
 		return "", fmt.Errorf("VM is currently locked out")
 	}
 	// Get DB objects
@@ -1856,18 +4236,22 @@ func (r *queryResolver) Console(ctx context.Context, vmObjectID string, consoleT
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypeCONSOLE_ACCESS).
-		SetMessage(fmt.Sprintf("access console for vm %s", entVmObject.Name)).
+		SetType(action.TypePOWER_STATE).
+		SetMessage(fmt.Sprintf("get power state for vm %s", entVmObject.Name)).
 		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log CONSOLE_ACCESS: %v", err)
+		logrus.Warnf("failed to log POWER_STATE: %v", err)
+	}
+	vmPowerState, err := provider.GetPowerState(ctx, entVmObject)
+	if err != nil {
+		return model.PowerStateUnknown, fmt.Errorf("failed to get vm object power state")
 	}
-	return provider.GetConsoleUrl(ctx, entVmObject, utils.ConsoleType(consoleType))
+	return model.PowerState(vmPowerState), nil
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
+// MyVMObjects is the resolver for the myVmObjects field.
+func (r *queryResolver) MyVMObjects(ctx context.Context) ([]*ent.VmObject, error) {
 	entUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -1883,17 +4267,31 @@ func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"Me\" endpoint").
+		SetMessage("called \"MyVMObjects\" endpoint").
 		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	return entUser, nil
+	// Only the active team's VMs are listed, users switch teams with setActiveTeam
+	vmObjectPredicate := vmobject.HasVmObjectToTeamWith(team.HasTeamToUsersWith(user.IDEQ(entUser.ID)))
+	// Red team can also see the VMs they were given access to
+	hasRedTeamConsole, err := permissions.Has(ctx, entUser, permissions.RedTeamConsole)
+	if err != nil {
+		return nil, err
+	}
+	if hasRedTeamConsole {
+		vmObjectPredicate = vmobject.Or(vmObjectPredicate, vmobject.RedTeamAccessEQ(true))
+	}
+	entVmObjects, err := r.client.VmObject.Query().Where(vmObjectPredicate).Order(ent.Asc(vmobject.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm objects from user: %v", err)
+	}
+	return entVmObjects, nil
 }
 
-// VMObject is the resolver for the vmObject field.
-func (r *queryResolver) VMObject(ctx context.Context, vmObjectID string) (*ent.VmObject, error) {
+// MyTeam is the resolver for the myTeam field.
+func (r *queryResolver) MyTeam(ctx context.Context) (*ent.Team, error) {
 	entUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -1909,41 +4307,200 @@ func (r *queryResolver) VMObject(ctx context.Context, vmObjectID string) (*ent.V
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"VMObject\" endpoint").
+		SetMessage("called \"MyTeam\" endpoint").
 		SetActionToUser(entUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	// Get VM DB object
-	vmObjectUuid, err := uuid.Parse(vmObjectID)
+	entTeam, err := entUser.QueryUserToTeam().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user doesn't have an active team")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team from user: %v", err)
+	}
+	return entTeam, nil
+}
+
+// MyTeamMemberships is the resolver for the myTeamMemberships field.
+func (r *queryResolver) MyTeamMemberships(ctx context.Context) ([]*ent.TeamMembership, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"MyTeamMemberships\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entMemberships, err := entUser.QueryUserToTeamMemberships().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team memberships from user: %v", err)
+	}
+	return entMemberships, nil
+}
+
+// MyCompetition is the resolver for the myCompetition field.
+func (r *queryResolver) MyCompetition(ctx context.Context) (*ent.Competition, error) {
+	entUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"MyCompetition\" endpoint").
+		SetActionToUser(entUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	entCompetition, err := entUser.QueryUserToTeam().QueryTeamToCompetition().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user doesn't have an active team")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query competition from user: %v", err)
+	}
+	return entCompetition, nil
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context) ([]*ent.User, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"Users\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	entUsers, err := r.client.User.Query().Where(scope.Users()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %v", err)
+	}
+	return entUsers, nil
+}
+
+// GetUser is the resolver for the getUser field.
+func (r *queryResolver) GetUser(ctx context.Context, id string) (*ent.User, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"GetUser\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.UserRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	userUuid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
+	}
+	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid), scope.Users()).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %v", err)
+	}
+	return entUser, nil
+}
+
+// WebauthnCredentials is the resolver for the webauthnCredentials field.
+func (r *queryResolver) WebauthnCredentials(ctx context.Context, userID string) ([]*ent.WebauthnCredential, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"WebauthnCredentials\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse valid uuid from input vmObjectId: %v", err)
+		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entVmObject, err := r.client.VmObject.Get(ctx, vmObjectUuid)
+	userUuid, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query vm object: %v", err)
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	entCredentials, err := r.client.WebauthnCredential.Query().Where(webauthncredential.HasWebauthnCredentialToUserWith(user.IDEQ(userUuid))).All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check access to vm: %v", err)
-	}
-	if !canAccessVm {
-		return nil, fmt.Errorf("user does not have permission to access this vm")
+		return nil, fmt.Errorf("failed to query passkeys: %v", err)
 	}
-	return entVmObject, nil
+	return entCredentials, nil
 }
 
-// PowerState is the resolver for the powerState field.
-func (r *queryResolver) PowerState(ctx context.Context, vmObjectID string) (model.PowerState, error) {
-	entUser, err := api.ForContext(ctx)
+// LockedAccounts is the resolver for the lockedAccounts field.
+func (r *queryResolver) LockedAccounts(ctx context.Context) ([]*model.AccountLockout, error) {
+	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get user from context: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
 	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get gin context from resolver context")
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
 	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
@@ -1952,67 +4509,71 @@ func (r *queryResolver) PowerState(ctx context.Context, vmObjectID string) (mode
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"PowerState\" endpoint").
-		SetActionToUser(entUser).
+		SetMessage("called \"LockedAccounts\" endpoint").
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-
-	vmObjectUuid, err := uuid.Parse(vmObjectID)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse valid uuid from input vmObjectId: %v", err)
-	}
-
-	// Get VM DB object
-	entVmObject, err := r.client.VmObject.Get(ctx, vmObjectUuid)
-	if err != nil {
-		return "", fmt.Errorf("failed to query vm object: %v", err)
-	}
-	// Check if user has access to VM
-	canAccessVm, err := utils.UserCanAccessVM(ctx, entVmObject, entUser)
+	lockouts, err := r.loginLimiter.Lockouts(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to check access to vm: %v", err)
-	}
-	if !canAccessVm {
-		return "", fmt.Errorf("user does not have permission to access this vm")
+		return nil, err
 	}
-	if entUser.Role != user.RoleADMIN && entVmObject.Locked {
-		return "", fmt.Errorf("VM is currently locked out")
+	accountLockouts := make([]*model.AccountLockout, len(lockouts))
+	for i, lockout := range lockouts {
+		accountLockouts[i] = &model.AccountLockout{
+			Type:        model.LockoutType(lockout.Type),
+			Identifier:  lockout.Identifier,
+			Failures:    lockout.Failures,
+			LockedUntil: lockout.LockedUntil,
+		}
 	}
-	// Get DB objects
-	entCompetition, err := entVmObject.QueryVmObjectToTeam().QueryTeamToCompetition().Only(ctx)
+	return accountLockouts, nil
+}
+
+// UserSessions is the resolver for the userSessions field.
+func (r *queryResolver) UserSessions(ctx context.Context, userID string) ([]*ent.Token, error) {
+	authUser, err := api.ForContext(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to query competition from vm object: %v", err)
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
-	entProvider, err := entCompetition.QueryCompetitionToProvider().Only(ctx)
+	gCtx, err := GinContextFromContext(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to query provider from competition: %v", err)
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
 	}
-	// Generate the provider
-	provider, err := r.providers.Get(entProvider.ID)
+	clientIp, err := api.ForContextIp(gCtx)
 	if err != nil {
-		return "", fmt.Errorf("failed to load provider: %v", err)
+		logrus.Warnf("unable to get ip from context: %v", err)
 	}
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
-		SetType(action.TypePOWER_STATE).
-		SetMessage(fmt.Sprintf("get power state for vm %s", entVmObject.Name)).
-		SetActionToUser(entUser).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"UserSessions\" endpoint").
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
-		logrus.Warnf("failed to log POWER_STATE: %v", err)
+		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	vmPowerState, err := provider.GetPowerState(ctx, entVmObject)
+	userUuid, err := uuid.Parse(userID)
 	if err != nil {
-		return model.PowerStateUnknown, fmt.Errorf("failed to get vm object power state")
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	return model.PowerState(vmPowerState), nil
+	entTokens, err := r.client.Token.Query().
+		Where(
+			token.HasTokenToUserWith(user.IDEQ(userUuid)),
+			token.ExpireAtGTE(time.Now().Unix()),
+		).
+		Order(ent.Desc(token.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %v", err)
+	}
+	return entTokens, nil
 }
 
-// MyVMObjects is the resolver for the myVmObjects field.
-func (r *queryResolver) MyVMObjects(ctx context.Context) ([]*ent.VmObject, error) {
-	entUser, err := api.ForContext(ctx)
+// UserPersonalAccessTokens is the resolver for the userPersonalAccessTokens field.
+func (r *queryResolver) UserPersonalAccessTokens(ctx context.Context, userID string) ([]*ent.PersonalAccessToken, error) {
+	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
@@ -2027,22 +4588,29 @@ func (r *queryResolver) MyVMObjects(ctx context.Context) ([]*ent.VmObject, error
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"MyVMObjects\" endpoint").
-		SetActionToUser(entUser).
+		SetMessage("called \"UserPersonalAccessTokens\" endpoint").
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entVmObjects, err := entUser.QueryUserToTeam().QueryTeamToVmObjects().Order(ent.Asc(vmobject.FieldName)).All(ctx)
+	userUuid, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query vm objects from user: %v", err)
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	return entVmObjects, nil
+	entPersonalAccessTokens, err := r.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.HasPersonalAccessTokenToUserWith(user.IDEQ(userUuid))).
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query personal access tokens: %v", err)
+	}
+	return entPersonalAccessTokens, nil
 }
 
-// MyTeam is the resolver for the myTeam field.
-func (r *queryResolver) MyTeam(ctx context.Context) (*ent.Team, error) {
-	entUser, err := api.ForContext(ctx)
+// CustomRoles is the resolver for the customRoles field.
+func (r *queryResolver) CustomRoles(ctx context.Context) ([]*ent.CustomRole, error) {
+	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
@@ -2057,22 +4625,23 @@ func (r *queryResolver) MyTeam(ctx context.Context) (*ent.Team, error) {
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"MyTeam\" endpoint").
-		SetActionToUser(entUser).
+		SetMessage("called \"CustomRoles\" endpoint").
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entTeam, err := entUser.QueryUserToTeam().Only(ctx)
+
+	entCustomRoles, err := r.client.CustomRole.Query().Order(ent.Asc(customrole.FieldName)).All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query team from user: %v", err)
+		return nil, fmt.Errorf("failed to query custom roles: %v", err)
 	}
-	return entTeam, nil
+	return entCustomRoles, nil
 }
 
-// MyCompetition is the resolver for the myCompetition field.
-func (r *queryResolver) MyCompetition(ctx context.Context) (*ent.Competition, error) {
-	entUser, err := api.ForContext(ctx)
+// GetCustomRole is the resolver for the getCustomRole field.
+func (r *queryResolver) GetCustomRole(ctx context.Context, id string) (*ent.CustomRole, error) {
+	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
 	}
@@ -2087,21 +4656,26 @@ func (r *queryResolver) MyCompetition(ctx context.Context) (*ent.Competition, er
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"MyCompetition\" endpoint").
-		SetActionToUser(entUser).
+		SetMessage("called \"GetCustomRole\" endpoint").
+		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entCompetition, err := entUser.QueryUserToTeam().QueryTeamToCompetition().Only(ctx)
+
+	customRoleUuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query competition from user: %v", err)
+		return nil, fmt.Errorf("failed to parse custom role UUID: %v", err)
 	}
-	return entCompetition, nil
+	entCustomRole, err := r.client.CustomRole.Get(ctx, customRoleUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom role: %v", err)
+	}
+	return entCustomRole, nil
 }
 
-// Users is the resolver for the users field.
-func (r *queryResolver) Users(ctx context.Context) ([]*ent.User, error) {
+// VMObjects is the resolver for the vmObjects field.
+func (r *queryResolver) VMObjects(ctx context.Context) ([]*ent.VmObject, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -2117,21 +4691,25 @@ func (r *queryResolver) Users(ctx context.Context) ([]*ent.User, error) {
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"Users\" endpoint").
+		SetMessage("called \"VmObjects\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entUsers, err := r.client.User.Query().All(ctx)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmRead)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query users: %v", err)
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
 	}
-	return entUsers, nil
+	entVmObjects, err := r.client.VmObject.Query().Where(scope.VmObjects()).Order(ent.Asc(vmobject.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm objects: %v", err)
+	}
+	return entVmObjects, nil
 }
 
-// GetUser is the resolver for the getUser field.
-func (r *queryResolver) GetUser(ctx context.Context, id string) (*ent.User, error) {
+// GetVMObject is the resolver for the getVmObject field.
+func (r *queryResolver) GetVMObject(ctx context.Context, id string) (*ent.VmObject, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -2147,25 +4725,29 @@ func (r *queryResolver) GetUser(ctx context.Context, id string) (*ent.User, erro
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"GetUser\" endpoint").
+		SetMessage("called \"GetVmObject\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	userUuid, err := uuid.Parse(id)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.VmRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	vmObjectUuid, err := uuid.Parse(id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	entUser, err := r.client.User.Query().Where(user.IDEQ(userUuid)).Only(ctx)
+	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid), scope.VmObjects()).Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user: %v", err)
+		return nil, fmt.Errorf("failed to query vm object: %v", err)
 	}
-	return entUser, nil
+	return entVmObject, nil
 }
 
-// VMObjects is the resolver for the vmObjects field.
-func (r *queryResolver) VMObjects(ctx context.Context) ([]*ent.VmObject, error) {
+// VMCredentials is the resolver for the vmCredentials field.
+func (r *queryResolver) VMCredentials(ctx context.Context, vmObjectID string) ([]*ent.VmCredential, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -2181,21 +4763,25 @@ func (r *queryResolver) VMObjects(ctx context.Context) ([]*ent.VmObject, error)
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"VmObjects\" endpoint").
+		SetMessage("called \"VmCredentials\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entVmObjects, err := r.client.VmObject.Query().Order(ent.Asc(vmobject.FieldName)).All(ctx)
+	vmObjectUuid, err := uuid.Parse(vmObjectID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query vm objects: %v", err)
+		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	return entVmObjects, nil
+	entVmCredentials, err := r.client.VmCredential.Query().Where(vmcredential.HasVmCredentialToVmObjectWith(vmobject.IDEQ(vmObjectUuid))).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vm credentials: %v", err)
+	}
+	return entVmCredentials, nil
 }
 
-// GetVMObject is the resolver for the getVmObject field.
-func (r *queryResolver) GetVMObject(ctx context.Context, id string) (*ent.VmObject, error) {
+// ConsoleShares is the resolver for the consoleShares field.
+func (r *queryResolver) ConsoleShares(ctx context.Context, vmObjectID *string) ([]*ent.ConsoleShare, error) {
 	authUser, err := api.ForContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user from context: %v", err)
@@ -2211,21 +4797,25 @@ func (r *queryResolver) GetVMObject(ctx context.Context, id string) (*ent.VmObje
 	err = r.client.Action.Create().
 		SetIPAddress(clientIp).
 		SetType(action.TypeAPI_CALL).
-		SetMessage("called \"GetVmObject\" endpoint").
+		SetMessage("called \"ConsoleShares\" endpoint").
 		SetActionToUser(authUser).
 		Exec(ctx)
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	vmObjectUuid, err := uuid.Parse(id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse UUID: %v", err)
+	consoleShareQuery := r.client.ConsoleShare.Query()
+	if vmObjectID != nil {
+		vmObjectUuid, err := uuid.Parse(*vmObjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+		}
+		consoleShareQuery = consoleShareQuery.Where(consoleshare.HasConsoleShareToVmObjectWith(vmobject.IDEQ(vmObjectUuid)))
 	}
-	entVmObject, err := r.client.VmObject.Query().Where(vmobject.IDEQ(vmObjectUuid)).Only(ctx)
+	entConsoleShares, err := consoleShareQuery.Order(ent.Desc(consoleshare.FieldCreatedAt)).All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query vm object: %v", err)
+		return nil, fmt.Errorf("failed to query console shares: %v", err)
 	}
-	return entVmObject, nil
+	return entConsoleShares, nil
 }
 
 // Teams is the resolver for the teams field.
@@ -2251,7 +4841,11 @@ func (r *queryResolver) Teams(ctx context.Context) ([]*ent.Team, error) {
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entTeams, err := r.client.Team.Query().All(ctx)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	entTeams, err := r.client.Team.Query().Where(scope.Teams()).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query teams: %v", err)
 	}
@@ -2281,11 +4875,15 @@ func (r *queryResolver) GetTeam(ctx context.Context, id string) (*ent.Team, erro
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.TeamRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
 	teamUuid, err := uuid.Parse(id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid)).Only(ctx)
+	entTeam, err := r.client.Team.Query().Where(team.IDEQ(teamUuid), scope.Teams()).Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query team: %v", err)
 	}
@@ -2315,7 +4913,11 @@ func (r *queryResolver) Competitions(ctx context.Context) ([]*ent.Competition, e
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
-	entCompetitions, err := r.client.Competition.Query().All(ctx)
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.CompetitionRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
+	entCompetitions, err := r.client.Competition.Query().Where(scope.Competitions()).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query competitions: %v", err)
 	}
@@ -2345,11 +4947,15 @@ func (r *queryResolver) GetCompetition(ctx context.Context, id string) (*ent.Com
 	if err != nil {
 		logrus.Warnf("failed to log API_CALL: %v", err)
 	}
+	scope, err := permissions.ScopeFor(ctx, authUser, permissions.CompetitionRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission scope: %v", err)
+	}
 	competitionUuid, err := uuid.Parse(id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse UUID: %v", err)
 	}
-	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid)).Only(ctx)
+	entCompetition, err := r.client.Competition.Query().Where(competition.IDEQ(competitionUuid), scope.Competitions()).Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query competition: %v", err)
 	}
@@ -2600,6 +5206,73 @@ func (r *queryResolver) Actions(ctx context.Context, offset int, limit int, type
 	}, nil
 }
 
+// ConsoleSessions is the resolver for the consoleSessions field.
+func (r *queryResolver) ConsoleSessions(ctx context.Context, offset int, limit int, search *string, vmObjectID *string, userID *string) (*model.ConsoleSessionsResult, error) {
+	authUser, err := api.ForContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user from context: %v", err)
+	}
+	gCtx, err := GinContextFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gin context from resolver context")
+	}
+	clientIp, err := api.ForContextIp(gCtx)
+	if err != nil {
+		logrus.Warnf("unable to get ip from context: %v", err)
+	}
+	err = r.client.Action.Create().
+		SetIPAddress(clientIp).
+		SetType(action.TypeAPI_CALL).
+		SetMessage("called \"ConsoleSessions\" endpoint").
+		SetActionToUser(authUser).
+		Exec(ctx)
+	if err != nil {
+		logrus.Warnf("failed to log API_CALL: %v", err)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+	predicates := make([]predicate.ConsoleSession, 0)
+	if search != nil && *search != "" {
+		predicates = append(predicates, consolesession.TranscriptContainsFold(*search))
+	}
+	if vmObjectID != nil {
+		vmObjectUuid, err := uuid.Parse(*vmObjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse vm object UUID: %v", err)
+		}
+		predicates = append(predicates, consolesession.HasConsoleSessionToVmObjectWith(vmobject.IDEQ(vmObjectUuid)))
+	}
+	if userID != nil {
+		userUuid, err := uuid.Parse(*userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user UUID: %v", err)
+		}
+		predicates = append(predicates, consolesession.HasConsoleSessionToUserWith(user.IDEQ(userUuid)))
+	}
+	entConsoleSessions, err := r.client.ConsoleSession.Query().
+		Where(predicates...).
+		Order(ent.Desc(consolesession.FieldStartedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list console sessions: %v", err)
+	}
+	totalConsoleSessions, err := r.client.ConsoleSession.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query console sessions for count: %v", err)
+	}
+	return &model.ConsoleSessionsResult{
+		Results:      entConsoleSessions,
+		Offset:       offset,
+		Limit:        limit,
+		Page:         offset / limit,
+		TotalPages:   int(math.Ceil(float64(totalConsoleSessions) / float64(limit))),
+		TotalResults: totalConsoleSessions,
+	}, nil
+}
+
 // ID is the resolver for the ID field.
 func (r *serviceAccountResolver) ID(ctx context.Context, obj *ent.ServiceAccount) (string, error) {
 	return obj.ID.String(), nil
@@ -2610,6 +5283,31 @@ func (r *serviceAccountResolver) APIKey(ctx context.Context, obj *ent.ServiceAcc
 	return obj.APIKey.String(), nil
 }
 
+// Scopes is the resolver for the Scopes field.
+func (r *serviceAccountResolver) Scopes(ctx context.Context, obj *ent.ServiceAccount) ([]model.ServiceAccountScope, error) {
+	return scopesToModel(obj.Scopes), nil
+}
+
+// ID is the resolver for the ID field.
+func (r *sessionResolver) ID(ctx context.Context, obj *ent.Token) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// ExpiresAt is the resolver for the ExpiresAt field.
+func (r *sessionResolver) ExpiresAt(ctx context.Context, obj *ent.Token) (*time.Time, error) {
+	expiresAt := time.Unix(obj.ExpireAt, 0)
+	return &expiresAt, nil
+}
+
+// Current is the resolver for the Current field.
+func (r *sessionResolver) Current(ctx context.Context, obj *ent.Token) (bool, error) {
+	currentSession, err := api.ForContextSession(ctx)
+	if err != nil {
+		return false, nil
+	}
+	return currentSession.ID == obj.ID, nil
+}
+
 // Lockout is the resolver for the lockout field.
 func (r *subscriptionResolver) Lockout(ctx context.Context, id string) (<-chan *ent.VmObject, error) {
 	vmObjectLockout := make(chan *ent.VmObject, 1)
@@ -2632,6 +5330,7 @@ func (r *subscriptionResolver) Lockout(ctx context.Context, id string) (<-chan *
 					sub.Close()
 					return
 				}
+				// Not found if the user can't see the vm
 				entVmObject, err := r.client.VmObject.Get(ctx, uuid)
 				if err != nil {
 					sub.Close()
@@ -2704,6 +5403,16 @@ func (r *teamResolver) ID(ctx context.Context, obj *ent.Team) (string, error) {
 	return obj.ID.String(), nil
 }
 
+// ID is the resolver for the ID field.
+func (r *teamMembershipResolver) ID(ctx context.Context, obj *ent.TeamMembership) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// Role is the resolver for the Role field.
+func (r *teamMembershipResolver) Role(ctx context.Context, obj *ent.TeamMembership) (model.TeamMembershipRole, error) {
+	return model.TeamMembershipRole(obj.Role), nil
+}
+
 // ID is the resolver for the ID field.
 func (r *userResolver) ID(ctx context.Context, obj *ent.User) (string, error) {
 	return obj.ID.String(), nil
@@ -2719,20 +5428,75 @@ func (r *userResolver) Provider(ctx context.Context, obj *ent.User) (model.AuthP
 	return model.AuthProvider(obj.Provider), nil
 }
 
+// Permissions is the resolver for the Permissions field.
+func (r *userResolver) Permissions(ctx context.Context, obj *ent.User) ([]model.Permission, error) {
+	userPermissions, err := permissions.ForUser(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return permissionsToModel(userPermissions), nil
+}
+
+// ID is the resolver for the ID field.
+func (r *vmCredentialResolver) ID(ctx context.Context, obj *ent.VmCredential) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// Protocol is the resolver for the Protocol field.
+func (r *vmCredentialResolver) Protocol(ctx context.Context, obj *ent.VmCredential) (model.VMCredentialProtocol, error) {
+	return model.VMCredentialProtocol(obj.Protocol), nil
+}
+
+// HasPassword is the resolver for the HasPassword field.
+func (r *vmCredentialResolver) HasPassword(ctx context.Context, obj *ent.VmCredential) (bool, error) {
+	return obj.Password != "", nil
+}
+
+// HasPrivateKey is the resolver for the HasPrivateKey field.
+func (r *vmCredentialResolver) HasPrivateKey(ctx context.Context, obj *ent.VmCredential) (bool, error) {
+	return obj.PrivateKey != "", nil
+}
+
 // ID is the resolver for the ID field.
 func (r *vmObjectResolver) ID(ctx context.Context, obj *ent.VmObject) (string, error) {
 	return obj.ID.String(), nil
 }
 
+// ID is the resolver for the ID field.
+func (r *webauthnCredentialResolver) ID(ctx context.Context, obj *ent.WebauthnCredential) (string, error) {
+	return obj.ID.String(), nil
+}
+
 // Action returns generated.ActionResolver implementation.
 func (r *Resolver) Action() generated.ActionResolver { return &actionResolver{r} }
 
 // Competition returns generated.CompetitionResolver implementation.
 func (r *Resolver) Competition() generated.CompetitionResolver { return &competitionResolver{r} }
 
+// CompetitionBreak returns generated.CompetitionBreakResolver implementation.
+func (r *Resolver) CompetitionBreak() generated.CompetitionBreakResolver {
+	return &competitionBreakResolver{r}
+}
+
+// ConsoleSession returns generated.ConsoleSessionResolver implementation.
+func (r *Resolver) ConsoleSession() generated.ConsoleSessionResolver {
+	return &consoleSessionResolver{r}
+}
+
+// ConsoleShare returns generated.ConsoleShareResolver implementation.
+func (r *Resolver) ConsoleShare() generated.ConsoleShareResolver { return &consoleShareResolver{r} }
+
+// CustomRole returns generated.CustomRoleResolver implementation.
+func (r *Resolver) CustomRole() generated.CustomRoleResolver { return &customRoleResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// PersonalAccessToken returns generated.PersonalAccessTokenResolver implementation.
+func (r *Resolver) PersonalAccessToken() generated.PersonalAccessTokenResolver {
+	return &personalAccessTokenResolver{r}
+}
+
 // Provider returns generated.ProviderResolver implementation.
 func (r *Resolver) Provider() generated.ProviderResolver { return &providerResolver{r} }
 
@@ -2744,25 +5508,50 @@ func (r *Resolver) ServiceAccount() generated.ServiceAccountResolver {
 	return &serviceAccountResolver{r}
 }
 
+// Session returns generated.SessionResolver implementation.
+func (r *Resolver) Session() generated.SessionResolver { return &sessionResolver{r} }
+
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
 // Team returns generated.TeamResolver implementation.
 func (r *Resolver) Team() generated.TeamResolver { return &teamResolver{r} }
 
+// TeamMembership returns generated.TeamMembershipResolver implementation.
+func (r *Resolver) TeamMembership() generated.TeamMembershipResolver {
+	return &teamMembershipResolver{r}
+}
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
+// VmCredential returns generated.VmCredentialResolver implementation.
+func (r *Resolver) VmCredential() generated.VmCredentialResolver { return &vmCredentialResolver{r} }
+
 // VmObject returns generated.VmObjectResolver implementation.
 func (r *Resolver) VmObject() generated.VmObjectResolver { return &vmObjectResolver{r} }
 
+// WebauthnCredential returns generated.WebauthnCredentialResolver implementation.
+func (r *Resolver) WebauthnCredential() generated.WebauthnCredentialResolver {
+	return &webauthnCredentialResolver{r}
+}
+
 type actionResolver struct{ *Resolver }
 type competitionResolver struct{ *Resolver }
+type competitionBreakResolver struct{ *Resolver }
+type consoleSessionResolver struct{ *Resolver }
+type consoleShareResolver struct{ *Resolver }
+type customRoleResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type personalAccessTokenResolver struct{ *Resolver }
 type providerResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type serviceAccountResolver struct{ *Resolver }
+type sessionResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type teamResolver struct{ *Resolver }
+type teamMembershipResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
+type vmCredentialResolver struct{ *Resolver }
 type vmObjectResolver struct{ *Resolver }
+type webauthnCredentialResolver struct{ *Resolver }
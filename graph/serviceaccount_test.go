@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BradHacker/compsole/api"
+	"github.com/BradHacker/compsole/compsole/signing"
+	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/ent/servicetoken"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func TestRotateServiceAccountSecretEndsOldSessions(t *testing.T) {
+	ctx, r := newTestResolver(t)
+	admin := r.client.User.Create().SetUsername("admin").SetPassword("hash").SetRole(user.RoleADMIN).SetProvider(user.ProviderLOCAL).SaveX(ctx)
+	entServiceAccount := r.client.ServiceAccount.Create().
+		SetDisplayName("service account").
+		SetAPIKey(uuid.New()).
+		SetAPISecretHash(utils.HashToken(uuid.New().String())).
+		SetActive(true).
+		SaveX(ctx)
+	// newSession starts a session the way the REST login does and returns its token
+	newSession := func(secretGeneration int) string {
+		tokenString, err := signing.Sign(&api.CompsoleJWTClaims{
+			ApiKey: entServiceAccount.APIKey.String(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        uuid.NewString(),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to sign service token: %v", err)
+		}
+		r.client.ServiceToken.Create().
+			SetTokenToServiceAccount(entServiceAccount).
+			SetToken(tokenString).
+			SetRefreshToken(uuid.NewString()).
+			SetIssuedAt(time.Now().Unix()).
+			SetSecretGeneration(secretGeneration).
+			ExecX(ctx)
+		return tokenString
+	}
+	// authenticate returns the status code of a REST request made with the token
+	authenticate := func(tokenString string) int {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.GET("/rest/test", api.ServiceMiddleware(r.client), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		req := httptest.NewRequest(http.MethodGet, "/rest/test", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+	rotate := func(overlapMinutes int) {
+		asUser(t, r, admin, func(ctx context.Context) {
+			if _, err := (&mutationResolver{r}).RotateServiceAccountSecret(ctx, entServiceAccount.ID.String(), &overlapMinutes); err != nil {
+				t.Fatalf("failed to rotate secret: %v", err)
+			}
+		})
+		entServiceAccount = r.client.ServiceAccount.GetX(ctx, entServiceAccount.ID)
+	}
+
+	oldSession := newSession(0)
+	rotate(30)
+	if code := authenticate(oldSession); code != http.StatusOK {
+		t.Errorf("got status %d for a session from the previous secret during the overlap, want %d", code, http.StatusOK)
+	}
+	newSecretSession := newSession(entServiceAccount.SecretGeneration)
+
+	// End the overlap
+	entServiceAccount = entServiceAccount.Update().SetPreviousAPISecretExpiresAt(time.Now().Add(-time.Minute)).SaveX(ctx)
+	if code := authenticate(oldSession); code != http.StatusUnauthorized {
+		t.Errorf("got status %d for a session from the previous secret after the overlap, want %d", code, http.StatusUnauthorized)
+	}
+	if r.client.ServiceToken.Query().Where(servicetoken.TokenEQ(oldSession)).ExistX(ctx) {
+		t.Errorf("session from the previous secret wasn't deleted")
+	}
+	if code := authenticate(newSecretSession); code != http.StatusOK {
+		t.Errorf("got status %d for a session from the current secret, want %d", code, http.StatusOK)
+	}
+
+	// Rotating without an overlap ends every session
+	rotate(0)
+	if count := r.client.ServiceToken.Query().CountX(ctx); count != 0 {
+		t.Errorf("got %d sessions after rotating without an overlap, want 0", count)
+	}
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/user"
+	"github.com/BradHacker/compsole/graph/model"
+)
+
+func TestUpdateUserMovesTeamMembership(t *testing.T) {
+	ctx, r := newTestResolver(t)
+	entProvider := r.client.Provider.Create().SetName("provider").SetType("TEST").SetConfig("{}").SaveX(ctx)
+	entCompetition := r.client.Competition.Create().SetName("competition").SetCompetitionToProvider(entProvider).SaveX(ctx)
+	teamA := r.client.Team.Create().SetTeamNumber(1).SetTeamToCompetition(entCompetition).SaveX(ctx)
+	teamB := r.client.Team.Create().SetTeamNumber(2).SetTeamToCompetition(entCompetition).SaveX(ctx)
+	newUser := func(username string, role user.Role, entTeam *ent.Team) *ent.User {
+		userCreate := r.client.User.Create().SetUsername(username).SetPassword("hash").SetRole(role).SetProvider(user.ProviderLOCAL)
+		if entTeam != nil {
+			userCreate.SetUserToTeam(entTeam)
+		}
+		return userCreate.SaveX(ctx)
+	}
+	admin := newUser("admin", user.RoleADMIN, nil)
+	competitor := newUser("competitor", user.RoleUSER, teamA)
+	r.client.TeamMembership.Create().SetTeamMembershipToUser(competitor).SetTeamMembershipToTeam(teamA).ExecX(ctx)
+
+	// Only the active team is set, so the competitor is moved instead of being added to a second team
+	asUser(t, r, admin, func(ctx context.Context) {
+		id, teamId := competitor.ID.String(), teamB.ID.String()
+		_, err := (&mutationResolver{r}).UpdateUser(ctx, model.UserInput{
+			ID:         &id,
+			Username:   competitor.Username,
+			Role:       model.RoleUser,
+			Provider:   model.AuthProviderLocal,
+			UserToTeam: &teamId,
+		})
+		if err != nil {
+			t.Fatalf("failed to update user: %v", err)
+		}
+	})
+
+	asUser(t, r, competitor, func(ctx context.Context) {
+		if _, err := (&mutationResolver{r}).SetActiveTeam(ctx, teamA.ID.String()); err == nil {
+			t.Errorf("switched back to the team the user was moved off of")
+		}
+		entUser, err := (&mutationResolver{r}).SetActiveTeam(ctx, teamB.ID.String())
+		if err != nil {
+			t.Fatalf("failed to switch to the new team: %v", err)
+		}
+		if activeTeamId := entUser.QueryUserToTeam().OnlyIDX(ctx); activeTeamId != teamB.ID {
+			t.Errorf("got active team %s, want %s", activeTeamId, teamB.ID)
+		}
+	})
+	if memberships := competitor.QueryUserToTeamMemberships().CountX(ctx); memberships != 1 {
+		t.Errorf("got %d team memberships, want 1", memberships)
+	}
+}
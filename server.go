@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"expvar"
+	"flag"
+	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql/schema"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -15,19 +20,35 @@ import (
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/BradHacker/compsole/api"
 	"github.com/BradHacker/compsole/api/auth"
+	"github.com/BradHacker/compsole/api/console"
 	"github.com/BradHacker/compsole/api/rest"
+	"github.com/BradHacker/compsole/compsole/challenge"
+	"github.com/BradHacker/compsole/compsole/consolecache"
+	"github.com/BradHacker/compsole/compsole/permissions"
+	"github.com/BradHacker/compsole/compsole/providers"
+	"github.com/BradHacker/compsole/compsole/ratelimit"
+	"github.com/BradHacker/compsole/compsole/schedule"
+	"github.com/BradHacker/compsole/compsole/sessions"
+	"github.com/BradHacker/compsole/compsole/signing"
 	"github.com/BradHacker/compsole/compsole/utils"
+	"github.com/BradHacker/compsole/compsole/viewer"
 	_ "github.com/BradHacker/compsole/docs"
 	"github.com/BradHacker/compsole/ent"
+	"github.com/BradHacker/compsole/ent/personalaccesstoken"
+	_ "github.com/BradHacker/compsole/ent/runtime"
+	"github.com/BradHacker/compsole/ent/serviceaccount"
+	"github.com/BradHacker/compsole/ent/signingkey"
 	"github.com/BradHacker/compsole/ent/user"
 	"github.com/BradHacker/compsole/graph"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 //	@title			Compsole API
@@ -66,11 +87,34 @@ func playgroundHandler() gin.HandlerFunc {
 	}
 }
 
+// Defining the metrics handler (users with the "logs:read" permission only)
+func metricsHandler() gin.HandlerFunc {
+	h := expvar.Handler()
+
+	return func(c *gin.Context) {
+		entUser, err := api.ForContext(c.Request.Context())
+		if err != nil {
+			api.ReturnError(c, http.StatusUnauthorized, "not authenticated", err)
+			return
+		}
+		canViewMetrics, err := permissions.Has(c.Request.Context(), entUser, permissions.LogsRead)
+		if err != nil {
+			api.ReturnError(c, http.StatusInternalServerError, "failed to check permissions", err)
+			return
+		}
+		if !canViewMetrics {
+			api.ReturnError(c, http.StatusForbidden, "missing the \"logs:read\" permission", fmt.Errorf("user can't view metrics"))
+			return
+		}
+		h.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
 // Defining the Graphql handler
-func graphqlHandler(client *ent.Client, rdb *redis.Client) gin.HandlerFunc {
+func graphqlHandler(client *ent.Client, rdb *redis.Client, compsoleProviders *providers.ProviderMap, sessionManager *sessions.Manager) gin.HandlerFunc {
 	// NewExecutableSchema and Config are in the generated.go file
 	// Resolver is in the resolver.go file
-	h := handler.New(graph.NewSchema(context.Background(), client, rdb))
+	h := handler.New(graph.NewSchema(context.Background(), client, rdb, compsoleProviders, sessionManager))
 
 	h.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
@@ -84,11 +128,33 @@ func graphqlHandler(client *ent.Client, rdb *redis.Client) gin.HandlerFunc {
 			EnableCompression: false,
 		},
 		KeepAlivePingInterval: 1 * time.Second,
+		// Subscriptions are closed when the session they were opened with is revoked
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+			entToken, err := api.ForContextSession(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return sessionManager.Track(ctx, entToken.ID), nil
+		},
 	})
 	h.AddTransport(transport.GET{})
 	h.AddTransport(transport.POST{})
 	h.AddTransport(transport.MultipartForm{})
 
+	// Read-only personal access tokens can only run queries. Impersonation is also read-only, apart from stopping it.
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		operation := graphql.GetOperationContext(ctx).Operation
+		if operation != nil && operation.Operation != ast.Query {
+			if entPersonalAccessToken, err := api.ForContextPersonalAccessToken(ctx); err == nil && entPersonalAccessToken.Scope == personalaccesstoken.ScopeREAD_ONLY {
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "read-only personal access tokens can't run %s operations", operation.Operation))
+			}
+			if _, err := api.ForContextImpersonator(ctx); err == nil && !onlyStopsImpersonating(operation) {
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "%s operations are disabled while impersonating a user", operation.Operation))
+			}
+		}
+		return next(ctx)
+	})
+
 	h.SetQueryCache(lru.New(1000))
 
 	h.Use(extension.Introspection{})
@@ -101,6 +167,87 @@ func graphqlHandler(client *ent.Client, rdb *redis.Client) gin.HandlerFunc {
 	}
 }
 
+// onlyStopsImpersonating returns whether the operation only selects the stopImpersonating mutation
+func onlyStopsImpersonating(operation *ast.OperationDefinition) bool {
+	if operation.Operation != ast.Mutation || len(operation.SelectionSet) == 0 {
+		return false
+	}
+	for _, selection := range operation.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || (field.Name != "stopImpersonating" && field.Name != "__typename") {
+			return false
+		}
+	}
+	return true
+}
+
+// keysCommand manages the JWT signing keys. Usage:
+//
+//	compsole_server keys list
+//	compsole_server keys rotate [-algorithm RS256|EdDSA] [-verify-for 8h]
+func keysCommand(ctx context.Context, client *ent.Client, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: keys (list|rotate)")
+	}
+	switch args[0] {
+	case "list":
+		entSigningKeys, err := client.SigningKey.Query().Order(ent.Desc(signingkey.FieldCreatedAt)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query signing keys: %v", err)
+		}
+		for _, entSigningKey := range entSigningKeys {
+			status := "signing"
+			if entSigningKey.ExpiresAt != nil && entSigningKey.ExpiresAt.Before(time.Now()) {
+				status = "expired"
+			} else if entSigningKey.RetiredAt != nil {
+				status = "verifying"
+			}
+			fmt.Printf("%s\t%s\t%s\t%s\n", entSigningKey.ID, entSigningKey.Algorithm, entSigningKey.CreatedAt.Format(time.RFC3339), status)
+		}
+		return nil
+	case "rotate":
+		defaultAlgorithm, err := signing.DefaultAlgorithm()
+		if err != nil {
+			return err
+		}
+		// Retired keys have to verify tokens until the longest lived tokens (REST refresh tokens) they signed expire
+		cookieTimeout := 60
+		if envValue, exists := os.LookupEnv("COOKIE_TIMEOUT"); exists {
+			if atoiValue, err := strconv.Atoi(envValue); err == nil {
+				cookieTimeout = atoiValue
+			}
+		}
+		refreshWindow := 60
+		if envValue, exists := os.LookupEnv("REFRESH_WINDOW"); exists {
+			if atoiValue, err := strconv.Atoi(envValue); err == nil {
+				refreshWindow = atoiValue
+			}
+		}
+		defaultVerifyFor := time.Duration(refreshWindow) * time.Hour
+		if cookieLifetime := time.Duration(cookieTimeout) * time.Minute; cookieLifetime > defaultVerifyFor {
+			defaultVerifyFor = cookieLifetime
+		}
+
+		flags := flag.NewFlagSet("keys rotate", flag.ContinueOnError)
+		algorithm := flags.String("algorithm", string(defaultAlgorithm), "the algorithm of the new key (RS256 or EdDSA)")
+		verifyFor := flags.Duration("verify-for", defaultVerifyFor, "how long the old keys keep verifying tokens")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if err := signingkey.AlgorithmValidator(signingkey.Algorithm(*algorithm)); err != nil {
+			return fmt.Errorf("algorithm must be RS256 or EdDSA")
+		}
+		entSigningKey, err := signing.Rotate(ctx, client, signingkey.Algorithm(*algorithm), *verifyFor)
+		if err != nil {
+			return err
+		}
+		logrus.Infof("Generated %s signing key %s, the previous keys verify tokens for %s", entSigningKey.Algorithm, entSigningKey.ID, *verifyFor)
+		return nil
+	default:
+		return fmt.Errorf("unknown keys command \"%s\", expected list or rotate", args[0])
+	}
+}
+
 func main() {
 	// Print the banner
 	utils.PrintBanner()
@@ -115,7 +262,8 @@ func main() {
 		client = ent.PGOpen(pgHost)
 	}
 
-	ctx := context.Background()
+	// Startup tasks and the background jobs act on Compsole's behalf, not a user's
+	ctx := viewer.SystemContext(context.Background())
 	defer ctx.Done()
 	defer client.Close()
 
@@ -124,6 +272,14 @@ func main() {
 		logrus.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Key management runs against the database without starting the server
+	if len(os.Args) > 1 && os.Args[1] == "keys" {
+		if err := keysCommand(ctx, client, os.Args[2:]); err != nil {
+			logrus.Fatalf("failed to run keys command: %v", err)
+		}
+		return
+	}
+
 	// Create the default admin if no admin user exists
 	logrus.Info("Checking if an admin account exists")
 	entAdminUser, err := client.User.Query().Where(user.RoleEQ(user.RoleADMIN)).First(ctx)
@@ -131,6 +287,13 @@ func main() {
 		logrus.Warn("No admin account found, creating default admin...")
 		defaultUsername := os.Getenv("DEFAULT_ADMIN_USERNAME")
 		defaultPassword := os.Getenv("DEFAULT_ADMIN_PASSWORD")
+		// The default admin is still created with a weak password so nobody is locked out, but it has to be changed on
+		// first sign in
+		mustChangePassword := false
+		if err := utils.LoadPasswordPolicy().Validate(defaultPassword, defaultUsername); err != nil {
+			logrus.Warnf("DEFAULT_ADMIN_PASSWORD doesn't follow the password policy (%v), it must be changed on first sign in", err)
+			mustChangePassword = true
+		}
 		hashedPassword, err := utils.HashPassword(defaultPassword)
 		if err != nil {
 			logrus.Errorf("failed to hash default admin password: %v", err)
@@ -145,6 +308,7 @@ func main() {
 			SetPassword(password).
 			SetRole(user.RoleADMIN).
 			SetProvider(user.ProviderLOCAL).
+			SetMustChangePassword(mustChangePassword).
 			Exec(ctx)
 		if err != nil {
 			logrus.Errorf("failed to create default admin: %v", err)
@@ -160,6 +324,40 @@ func main() {
 		}).Infof("Found admin user")
 	}
 
+	// Service accounts created before scopes existed had full access, so they keep it until an admin limits them
+	backfilled, err := client.ServiceAccount.Update().Where(serviceaccount.ScopesIsNil()).SetScopes(api.AllScopeStrings()).Save(ctx)
+	if err != nil {
+		logrus.Errorf("failed to grant scopes to existing service accounts: %v", err)
+	} else if backfilled > 0 {
+		logrus.Infof("Granted every scope to %d existing service accounts", backfilled)
+	}
+
+	// Service accounts created before secrets were hashed still have their plaintext secret
+	entPlaintextServiceAccounts, err := client.ServiceAccount.Query().Where(serviceaccount.APISecretNotNil()).All(ctx)
+	if err != nil {
+		logrus.Errorf("failed to query service accounts with plaintext secrets: %v", err)
+	}
+	for _, entServiceAccount := range entPlaintextServiceAccounts {
+		err = entServiceAccount.Update().
+			SetAPISecretHash(utils.HashToken(entServiceAccount.APISecret.String())).
+			ClearAPISecret().
+			Exec(ctx)
+		if err != nil {
+			logrus.Errorf("failed to hash secret for service account \"%s\": %v", entServiceAccount.DisplayName, err)
+		}
+	}
+	if len(entPlaintextServiceAccounts) > 0 {
+		logrus.Infof("Hashed the secrets of %d existing service accounts", len(entPlaintextServiceAccounts))
+	}
+
+	// Users created before team memberships existed are only on their active team
+	backfilledMembers, err := utils.BackfillTeamMemberships(ctx, client)
+	if err != nil {
+		logrus.Errorf("failed to backfill team memberships: %v", err)
+	} else if backfilledMembers > 0 {
+		logrus.Infof("Added %d existing users to their team", backfilledMembers)
+	}
+
 	redisUri := os.Getenv("REDIS_URI")
 	redisPassword := os.Getenv("REDIS_PASSWORD")
 	var rdb *redis.Client
@@ -179,6 +377,14 @@ func main() {
 		logrus.Fatalf("No REDIS_URI has been set")
 	}
 
+	consoleCache := consolecache.New(rdb)
+	loginLimiter := ratelimit.New(rdb)
+	loginChallenges := challenge.New(rdb)
+	sessionManager := sessions.New(client, rdb)
+	go sessionManager.Listen(ctx)
+	go sessionManager.RunPurge(ctx)
+	go schedule.New(client, rdb).Run(ctx)
+
 	go func() {
 		sub := rdb.Subscribe(ctx, "lockout")
 		_, err = sub.Receive(ctx)
@@ -191,6 +397,12 @@ func main() {
 			select {
 			case message := <-ch:
 				logrus.Debugf("Message %s received from %s", message.Payload, message.Channel)
+				// Consoles issued before the lockout changed are no longer valid
+				if vmObjectUuid, err := uuid.Parse(message.Payload); err == nil {
+					if err := consoleCache.Invalidate(ctx, vmObjectUuid); err != nil {
+						logrus.Warnf("failed to invalidate cached consoles for vm %s: %v", message.Payload, err)
+					}
+				}
 			// close when context done
 			case <-ctx.Done():
 				logrus.Infof("Main Channel CTX Closing, Closing Sub Channel")
@@ -201,6 +413,21 @@ func main() {
 	}()
 
 	router := gin.Default()
+	// Handlers pass the gin context to ent, which needs the viewer the middlewares put in the request context
+	router.ContextWithFallback = true
+
+	// Only trust X-Forwarded-For from the reverse proxies in front of compsole, otherwise clients could spoof their IP
+	// past the team allowlists and rate limits
+	var trustedProxies []string
+	if env_value, exists := os.LookupEnv("TRUSTED_PROXIES"); exists && env_value != "" {
+		for _, proxy := range strings.Split(env_value, ",") {
+			trustedProxies = append(trustedProxies, strings.TrimSpace(proxy))
+		}
+	}
+	router.RemoteIPHeaders = []string{"X-Forwarded-For"}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		logrus.Fatalf("failed to parse TRUSTED_PROXIES: %v", err)
+	}
 
 	cors_urls := []string{"http://localhost", "http://localhost:3000"}
 	if env_value, exists := os.LookupEnv("CORS_ALLOWED_ORIGINS"); exists {
@@ -222,12 +449,26 @@ func main() {
 		port = defaultPort
 	}
 
-	gqlHandler := graphqlHandler(client, rdb)
+	// Load all of the providers
+	compsoleProviders, err := providers.LoadProviders(ctx, client)
+	if err != nil {
+		logrus.Fatalf("failed to load providers: %v", err)
+	}
 
-	_, exists := os.LookupEnv("JWT_SECRET")
-	if !exists {
-		logrus.Error("env var JWT_SECRET not set for authentication")
-		return
+	// Any proxied consoles still open were closed when the server stopped
+	err = console.CloseStaleConsoleSessions(ctx, client)
+	if err != nil {
+		logrus.Warnf("failed to close stale console sessions: %v", err)
+	}
+
+	gqlHandler := graphqlHandler(client, rdb, compsoleProviders, sessionManager)
+
+	if err := signing.Init(ctx, client); err != nil {
+		logrus.Fatalf("failed to load signing keys: %v", err)
+	}
+	go signing.RunRefresh(ctx)
+	if _, exists := os.LookupEnv("JWT_SECRET"); exists {
+		logrus.Info("JWT_SECRET is set, tokens signed before signing keys existed are still accepted")
 	}
 
 	router.Use(api.UnauthenticatedMiddleware())
@@ -235,10 +476,14 @@ func main() {
 	apiGroup := router.Group("/api")
 
 	authGroup := apiGroup.Group("/auth")
-	auth.RegisterAuthEndpoints(client, authGroup)
+	err = auth.RegisterAuthEndpoints(client, loginLimiter, loginChallenges, sessionManager, authGroup)
+	if err != nil {
+		logrus.Fatalf("failed to register auth endpoints: %v", err)
+	}
 
 	gqlApi := apiGroup.Group("/graphql")
 	gqlApi.Use(api.Middleware(client))
+	gqlApi.Use(api.PersonalAccessTokenMiddleware(client))
 	gqlApi.Use(graph.GinContextToContextMiddleware())
 	gqlApi.POST("/query", gqlHandler)
 	gqlApi.GET("/query", gqlHandler)
@@ -246,8 +491,16 @@ func main() {
 		gqlApi.GET("/playground", playgroundHandler())
 	}
 
+	consoleApi := apiGroup.Group("/console")
+	consoleApi.Use(api.Middleware(client))
+	console.RegisterConsoleEndpoints(client, rdb, compsoleProviders, loginLimiter, consoleApi)
+
+	apiGroup.GET("/metrics", api.Middleware(client), metricsHandler())
+
 	restApi := apiGroup.Group("/rest")
-	rest.RegisterRESTEndpoints(client, restApi)
+	rest.RegisterRESTEndpoints(client, loginLimiter, compsoleProviders, consoleCache, sessionManager, restApi)
+
+	router.GET("/.well-known/jwks.json", auth.JWKS())
 
 	// Swagger Docs
 	router.GET("/api/docs/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
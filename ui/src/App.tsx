@@ -1,4 +1,5 @@
 import {
+  Alert,
   AppBar,
   Avatar,
   Box,
@@ -13,10 +14,23 @@ import {
 } from '@mui/material'
 import React, { MouseEvent, useEffect, useState } from 'react'
 import { Link, Outlet, useNavigate, useLocation } from 'react-router-dom'
-import { Role, useGetCurrentUserQuery, User } from './api/generated/graphql'
+import {
+  Permission,
+  Role,
+  useGetCurrentUserQuery,
+  useSetActiveTeamMutation,
+  useStopImpersonatingMutation,
+  User,
+} from './api/generated/graphql'
 import { Loading } from './pages/loading'
-import { UserContext } from './user-context'
+import {
+  hasPermission,
+  isCompetitionAdmin,
+  teamLabel,
+  UserContext,
+} from './user-context'
 import SettingsIcon from '@mui/icons-material/Settings'
+import GroupsIcon from '@mui/icons-material/Groups'
 import PowerSettingsNewIcon from '@mui/icons-material/PowerSettingsNew'
 import { Logout } from './api'
 
@@ -32,6 +46,8 @@ function App() {
   } = useGetCurrentUserQuery({
     fetchPolicy: 'no-cache',
   })
+  const [setActiveTeam] = useSetActiveTeamMutation()
+  const [stopImpersonating] = useStopImpersonatingMutation()
   const navigate = useNavigate()
   const location = useLocation()
   const { enqueueSnackbar } = useSnackbar()
@@ -54,9 +70,28 @@ function App() {
           from: location.pathname,
         },
       })
-    else if (!currentUserLoading && !currentUserError && currentUser)
-      setUser(currentUser.me)
-  }, [currentUser, currentUserLoading, currentUserError, navigate, location])
+    else if (!currentUserLoading && !currentUserError && currentUser) {
+      setUser(currentUser.me as User)
+      // Everything else is blocked until the password has been changed
+      if (
+        !currentUser.impersonator &&
+        currentUser.me.MustChangePassword &&
+        location.pathname !== '/account'
+      ) {
+        enqueueSnackbar('You must change your password before continuing', {
+          variant: 'warning',
+        })
+        navigate('/account')
+      }
+    }
+  }, [
+    currentUser,
+    currentUserLoading,
+    currentUserError,
+    navigate,
+    location,
+    enqueueSnackbar,
+  ])
 
   const handleAccountSettings = () => {
     navigate('/account')
@@ -83,6 +118,45 @@ function App() {
     )
   }
 
+  // Users on more than one team only see the VMs of the team they switched to
+  const handleSwitchTeam = (teamId: string) => {
+    setUserMenuOpen(false)
+    setActiveTeam({
+      variables: {
+        teamId,
+      },
+    }).then(
+      () => {
+        enqueueSnackbar('Switched teams', {
+          variant: 'success',
+        })
+        refetchCurrentUser()
+      },
+      (err) => {
+        enqueueSnackbar(`Failed to switch teams: ${err.message}`, {
+          variant: 'error',
+        })
+      }
+    )
+  }
+
+  const handleStopImpersonating = () => {
+    stopImpersonating().then(
+      () => {
+        enqueueSnackbar('Stopped impersonating', {
+          variant: 'success',
+        })
+        refetchCurrentUser()
+        navigate('/')
+      },
+      (err) => {
+        enqueueSnackbar(`Failed to stop impersonating: ${err.message}`, {
+          variant: 'error',
+        })
+      }
+    )
+  }
+
   return !currentUserLoading && user ? (
     <UserContext.Provider
       value={{
@@ -124,18 +198,16 @@ function App() {
               <Button onClick={() => navigate('/')} color="inherit">
                 Dashboard
               </Button>
-              {user && user.Role === Role.Admin && (
-                <>
-                  <Button onClick={() => navigate('/admin')} color="inherit">
-                    Admin
-                  </Button>
-                  <Button
-                    onClick={() => navigate('/admin/logs')}
-                    color="inherit"
-                  >
-                    Logs
-                  </Button>
-                </>
+              {user &&
+                (user.Role === Role.Admin || isCompetitionAdmin(user)) && (
+                <Button onClick={() => navigate('/admin')} color="inherit">
+                  Admin
+                </Button>
+              )}
+              {user && hasPermission(user, Permission.LogsRead) && (
+                <Button onClick={() => navigate('/admin/logs')} color="inherit">
+                  Logs
+                </Button>
               )}
               <Divider orientation="vertical" variant="middle" flexItem />
               {/*
@@ -182,6 +254,27 @@ function App() {
                       Hello, {user.FirstName} {user.LastName}
                     </Typography>
                   </MenuItem>
+                  {user.UserToTeamMemberships.length > 1 && [
+                    <Divider key="teams-start" />,
+                    ...user.UserToTeamMemberships.map((membership) => (
+                      <MenuItem
+                        key={membership.ID}
+                        selected={
+                          membership.TeamMembershipToTeam.ID ===
+                          user.UserToTeam?.ID
+                        }
+                        onClick={() =>
+                          handleSwitchTeam(membership.TeamMembershipToTeam.ID)
+                        }
+                      >
+                        <GroupsIcon sx={{ mr: 1 }} />
+                        <Typography textAlign="left">
+                          {teamLabel(membership.TeamMembershipToTeam)}
+                        </Typography>
+                      </MenuItem>
+                    )),
+                    <Divider key="teams-end" />,
+                  ]}
                   <MenuItem onClick={handleAccountSettings}>
                     <SettingsIcon sx={{ mr: 1 }} />
                     <Typography textAlign="left">Account Settings</Typography>
@@ -197,6 +290,25 @@ function App() {
         </AppBar>
       </Box>
       <Box sx={{ pt: '64px', minHeight: 'calc(100vh)' }}>
+        {currentUser?.impersonator && (
+          <Alert
+            severity="warning"
+            sx={{ borderRadius: 0 }}
+            action={
+              <Button
+                color="inherit"
+                size="small"
+                onClick={handleStopImpersonating}
+              >
+                Stop Impersonating
+              </Button>
+            }
+          >
+            Viewing Compsole as {user.FirstName} {user.LastName} (
+            {user.Username}). Changes and consoles are disabled until you stop
+            impersonating.
+          </Alert>
+        )}
         <Outlet />
       </Box>
     </UserContext.Provider>
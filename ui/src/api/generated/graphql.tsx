@@ -25,6 +25,8 @@ export type AccountInput = {
 
 export type Action = {
   __typename?: 'Action';
+  /** The admin who performed the action while impersonating ActionToUser */
+  ActionToImpersonator?: Maybe<User>;
   ActionToUser?: Maybe<User>;
   ID: Scalars['ID']['output'];
   IpAddress: Scalars['String']['output'];
@@ -34,16 +36,27 @@ export type Action = {
 };
 
 export enum ActionType {
+  AccountLocked = 'ACCOUNT_LOCKED',
+  AccountUnlocked = 'ACCOUNT_UNLOCKED',
   ApiCall = 'API_CALL',
   ChangePassword = 'CHANGE_PASSWORD',
   ChangeSelfPassword = 'CHANGE_SELF_PASSWORD',
   ConsoleAccess = 'CONSOLE_ACCESS',
+  CreateAccessToken = 'CREATE_ACCESS_TOKEN',
   CreateObject = 'CREATE_OBJECT',
   DeleteObject = 'DELETE_OBJECT',
+  FailedMfa = 'FAILED_MFA',
   FailedSignIn = 'FAILED_SIGN_IN',
+  Impersonate = 'IMPERSONATE',
+  IpNotAllowed = 'IP_NOT_ALLOWED',
+  MfaDisable = 'MFA_DISABLE',
+  MfaEnroll = 'MFA_ENROLL',
   PowerOff = 'POWER_OFF',
   PowerOn = 'POWER_ON',
   Reboot = 'REBOOT',
+  RevokeAccessToken = 'REVOKE_ACCESS_TOKEN',
+  RevokeSession = 'REVOKE_SESSION',
+  RotateSecret = 'ROTATE_SECRET',
   Shutdown = 'SHUTDOWN',
   SignIn = 'SIGN_IN',
   SignOut = 'SIGN_OUT',
@@ -65,24 +78,58 @@ export type ActionsResult = {
 
 export enum AuthProvider {
   Gitlab = 'GITLAB',
+  Ldap = 'LDAP',
+  Oidc = 'OIDC',
+  Saml = 'SAML',
   Local = 'LOCAL',
   Undefined = 'UNDEFINED'
 }
 
 export type Competition = {
   __typename?: 'Competition';
+  /** Networks (in CIDR notation) the competition's users can connect from. Empty allows any network. */
+  AllowedCidrs: Array<Scalars['String']['output']>;
+  CompetitionToBreaks: Array<CompetitionBreak>;
   CompetitionToProvider: Provider;
   CompetitionToTeams: Array<Maybe<Team>>;
+  /** Competitors are locked out of their VMs and can't sign in after EndsAt */
+  EndsAt?: Maybe<Scalars['Time']['output']>;
   ID: Scalars['ID']['output'];
   Name: Scalars['String']['output'];
+  /** Competitors are locked out of their VMs and can't sign in before StartsAt */
+  StartsAt?: Maybe<Scalars['Time']['output']>;
+};
+
+/** A window during a competition when competitors are locked out of their VMs */
+export type CompetitionBreak = {
+  __typename?: 'CompetitionBreak';
+  EndsAt: Scalars['Time']['output'];
+  ID: Scalars['ID']['output'];
+  Name: Scalars['String']['output'];
+  StartsAt: Scalars['Time']['output'];
+};
+
+export type CompetitionBreakInput = {
+  EndsAt: Scalars['Time']['input'];
+  Name?: InputMaybe<Scalars['String']['input']>;
+  StartsAt: Scalars['Time']['input'];
 };
 
 export type CompetitionInput = {
+  /** Networks in CIDR notation (eg. "10.0.1.0/24"). Leave null to keep the existing allowlist on update operations. */
+  AllowedCidrs?: InputMaybe<Array<Scalars['String']['input']>>;
   CompetitionToProvider: Scalars['ID']['input'];
   ID?: InputMaybe<Scalars['ID']['input']>;
   Name: Scalars['String']['input'];
 };
 
+/** Leave StartsAt or EndsAt null for a competition without a start or an end. The breaks replace any existing breaks. */
+export type CompetitionScheduleInput = {
+  Breaks: Array<CompetitionBreakInput>;
+  EndsAt?: InputMaybe<Scalars['Time']['input']>;
+  StartsAt?: InputMaybe<Scalars['Time']['input']>;
+};
+
 export type CompetitionUser = {
   __typename?: 'CompetitionUser';
   ID: Scalars['ID']['output'];
@@ -99,34 +146,75 @@ export enum ConsoleType {
   Spice = 'SPICE'
 }
 
+export type CustomRole = {
+  __typename?: 'CustomRole';
+  CustomRoleToUsers: Array<User>;
+  Description: Scalars['String']['output'];
+  ID: Scalars['ID']['output'];
+  Name: Scalars['String']['output'];
+  /** Granted on top of the permissions of the user's built-in role */
+  Permissions: Array<Permission>;
+};
+
+export type CustomRoleInput = {
+  Description: Scalars['String']['input'];
+  ID?: InputMaybe<Scalars['ID']['input']>;
+  Name: Scalars['String']['input'];
+  Permissions: Array<Permission>;
+};
+
 export type Mutation = {
   __typename?: 'Mutation';
   batchCreateTeams: Array<Team>;
   batchCreateVmObjects: Array<VmObject>;
   batchLockout: Scalars['Boolean']['output'];
   changePassword: Scalars['Boolean']['output'];
+  /** Requires the current password. Signs the user out of their other sessions and revokes their personal access tokens. */
   changeSelfPassword: Scalars['Boolean']['output'];
   createCompetition: Competition;
+  createCustomRole: CustomRole;
+  /** Personal access tokens can't be created by requests authenticated with a personal access token */
+  createPersonalAccessToken: PersonalAccessTokenDetails;
   createProvider: Provider;
   createServiceAccount: ServiceAccountDetails;
   createTeam: Team;
   createUser: User;
   createVmObject: VmObject;
   deleteCompetition: Scalars['Boolean']['output'];
+  deleteCustomRole: Scalars['Boolean']['output'];
   deleteProvider: Scalars['Boolean']['output'];
   deleteServiceAccount: Scalars['Boolean']['output'];
   deleteTeam: Scalars['Boolean']['output'];
   deleteUser: Scalars['Boolean']['output'];
   deleteVmObject: Scalars['Boolean']['output'];
   generateCompetitionUsers: Array<CompetitionUser>;
+  /**
+   * Views Compsole as the user, or as a member of the team, for the rest of the session or until stopImpersonating is
+   * called. Every other mutation and consoles are disabled while impersonating.
+   */
+  impersonate: User;
   loadProvider: Scalars['Boolean']['output'];
   lockoutCompetition: Scalars['Boolean']['output'];
   lockoutVm: Scalars['Boolean']['output'];
   powerOff: Scalars['Boolean']['output'];
   powerOn: Scalars['Boolean']['output'];
   reboot: Scalars['Boolean']['output'];
+  /** Users can revoke their own tokens, admins can revoke any token */
+  revokePersonalAccessToken: Scalars['Boolean']['output'];
+  /** Generates a new secret for the service account. The old secret keeps working for overlapMinutes (default 0). */
+  rotateServiceAccountSecret: ServiceAccountDetails;
+  /** Switches the current user's active team to another team they are a member of */
+  setActiveTeam: User;
+  /**
+   * The VMs are locked and unlocked automatically as the competition opens and closes. Lockouts made in between stay
+   * until the next time it opens or closes.
+   */
+  setCompetitionSchedule: Competition;
+  /** Goes back to viewing Compsole as the admin. The only mutation allowed while impersonating. */
+  stopImpersonating: Scalars['Boolean']['output'];
   updateAccount: User;
   updateCompetition: Competition;
+  updateCustomRole: CustomRole;
   updateProvider: Provider;
   updateServiceAccount: ServiceAccount;
   updateTeam: Team;
@@ -153,11 +241,13 @@ export type MutationBatchLockoutArgs = {
 
 export type MutationChangePasswordArgs = {
   id: Scalars['ID']['input'];
+  mustChangePassword?: InputMaybe<Scalars['Boolean']['input']>;
   password: Scalars['String']['input'];
 };
 
 
 export type MutationChangeSelfPasswordArgs = {
+  currentPassword: Scalars['String']['input'];
   password: Scalars['String']['input'];
 };
 
@@ -167,6 +257,16 @@ export type MutationCreateCompetitionArgs = {
 };
 
 
+export type MutationCreateCustomRoleArgs = {
+  input: CustomRoleInput;
+};
+
+
+export type MutationCreatePersonalAccessTokenArgs = {
+  input: PersonalAccessTokenInput;
+};
+
+
 export type MutationCreateProviderArgs = {
   input: ProviderInput;
 };
@@ -197,6 +297,11 @@ export type MutationDeleteCompetitionArgs = {
 };
 
 
+export type MutationDeleteCustomRoleArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
 export type MutationDeleteProviderArgs = {
   id: Scalars['ID']['input'];
 };
@@ -228,6 +333,12 @@ export type MutationGenerateCompetitionUsersArgs = {
 };
 
 
+export type MutationImpersonateArgs = {
+  teamId?: InputMaybe<Scalars['ID']['input']>;
+  userId?: InputMaybe<Scalars['ID']['input']>;
+};
+
+
 export type MutationLoadProviderArgs = {
   id: Scalars['ID']['input'];
 };
@@ -261,6 +372,28 @@ export type MutationRebootArgs = {
 };
 
 
+export type MutationRevokePersonalAccessTokenArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+export type MutationRotateServiceAccountSecretArgs = {
+  id: Scalars['ID']['input'];
+  overlapMinutes?: InputMaybe<Scalars['Int']['input']>;
+};
+
+
+export type MutationSetActiveTeamArgs = {
+  teamId: Scalars['ID']['input'];
+};
+
+
+export type MutationSetCompetitionScheduleArgs = {
+  id: Scalars['ID']['input'];
+  input: CompetitionScheduleInput;
+};
+
+
 export type MutationUpdateAccountArgs = {
   input: AccountInput;
 };
@@ -271,6 +404,11 @@ export type MutationUpdateCompetitionArgs = {
 };
 
 
+export type MutationUpdateCustomRoleArgs = {
+  input: CustomRoleInput;
+};
+
+
 export type MutationUpdateProviderArgs = {
   input: ProviderInput;
 };
@@ -295,6 +433,60 @@ export type MutationUpdateVmObjectArgs = {
   input: VmObjectInput;
 };
 
+export enum Permission {
+  CompetitionRead = 'COMPETITION_READ',
+  CompetitionWrite = 'COMPETITION_WRITE',
+  LogsRead = 'LOGS_READ',
+  ProviderRead = 'PROVIDER_READ',
+  ProviderWrite = 'PROVIDER_WRITE',
+  RedTeamConsole = 'RED_TEAM_CONSOLE',
+  RoleWrite = 'ROLE_WRITE',
+  ServiceAccountRead = 'SERVICE_ACCOUNT_READ',
+  ServiceAccountWrite = 'SERVICE_ACCOUNT_WRITE',
+  TeamRead = 'TEAM_READ',
+  TeamWrite = 'TEAM_WRITE',
+  UserImpersonate = 'USER_IMPERSONATE',
+  UserRead = 'USER_READ',
+  UserWrite = 'USER_WRITE',
+  VmConsole = 'VM_CONSOLE',
+  VmLockout = 'VM_LOCKOUT',
+  VmPower = 'VM_POWER',
+  VmRead = 'VM_READ',
+  VmWrite = 'VM_WRITE'
+}
+
+export type PersonalAccessToken = {
+  __typename?: 'PersonalAccessToken';
+  CreatedAt: Scalars['Time']['output'];
+  ExpiresAt?: Maybe<Scalars['Time']['output']>;
+  ID: Scalars['ID']['output'];
+  LastUsedAt?: Maybe<Scalars['Time']['output']>;
+  LastUsedIp: Scalars['String']['output'];
+  Name: Scalars['String']['output'];
+  Prefix: Scalars['String']['output'];
+  Scope: PersonalAccessTokenScope;
+};
+
+export type PersonalAccessTokenDetails = {
+  __typename?: 'PersonalAccessTokenDetails';
+  PersonalAccessToken: PersonalAccessToken;
+  /** The token is only returned when it is created. Send it as `Authorization: Bearer <token>` to /api/graphql/query. */
+  Token: Scalars['String']['output'];
+};
+
+export type PersonalAccessTokenInput = {
+  /** Leave null for a token which never expires. */
+  ExpiresAt?: InputMaybe<Scalars['Time']['input']>;
+  Name: Scalars['String']['input'];
+  /** READ_ONLY tokens can only run queries */
+  Scope: PersonalAccessTokenScope;
+};
+
+export enum PersonalAccessTokenScope {
+  Full = 'FULL',
+  ReadOnly = 'READ_ONLY'
+}
+
 export enum PowerState {
   PoweredOff = 'POWERED_OFF',
   PoweredOn = 'POWERED_ON',
@@ -331,21 +523,29 @@ export type Query = {
   actions: ActionsResult;
   competitions: Array<Competition>;
   console: Scalars['String']['output'];
+  customRoles: Array<CustomRole>;
   getCompetition: Competition;
+  getCustomRole: CustomRole;
   getProvider: Provider;
   getServiceAccount: ServiceAccount;
   getTeam: Team;
   getUser: User;
   getVmObject: VmObject;
+  /** The admin viewing Compsole as the current user, or null when nobody is impersonating them */
+  impersonator?: Maybe<User>;
   listProviderVms: Array<SkeletonVmObject>;
   me: User;
   myCompetition: Competition;
+  myPersonalAccessTokens: Array<PersonalAccessToken>;
+  /** The active team */
   myTeam: Team;
+  myTeamMemberships: Array<TeamMembership>;
   myVmObjects: Array<VmObject>;
   powerState: PowerState;
   providers: Array<Provider>;
   serviceAccounts: Array<ServiceAccount>;
   teams: Array<Team>;
+  userPersonalAccessTokens: Array<PersonalAccessToken>;
   users: Array<User>;
   validateConfig: Scalars['Boolean']['output'];
   vmObject: VmObject;
@@ -371,6 +571,11 @@ export type QueryGetCompetitionArgs = {
 };
 
 
+export type QueryGetCustomRoleArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
 export type QueryGetProviderArgs = {
   id: Scalars['ID']['input'];
 };
@@ -406,6 +611,11 @@ export type QueryPowerStateArgs = {
 };
 
 
+export type QueryUserPersonalAccessTokensArgs = {
+  userId: Scalars['ID']['input'];
+};
+
+
 export type QueryValidateConfigArgs = {
   config: Scalars['String']['input'];
   type: Scalars['String']['input'];
@@ -423,8 +633,11 @@ export enum RebootType {
 
 export enum Role {
   Admin = 'ADMIN',
+  BlackTeam = 'BLACK_TEAM',
+  RedTeam = 'RED_TEAM',
   Undefined = 'UNDEFINED',
-  User = 'USER'
+  User = 'USER',
+  WhiteTeam = 'WHITE_TEAM'
 }
 
 export type ServiceAccount = {
@@ -432,7 +645,16 @@ export type ServiceAccount = {
   Active: Scalars['Boolean']['output'];
   ApiKey: Scalars['String']['output'];
   DisplayName: Scalars['String']['output'];
+  ExpiresAt?: Maybe<Scalars['Time']['output']>;
   ID: Scalars['ID']['output'];
+  LastUsedAt?: Maybe<Scalars['Time']['output']>;
+  LastUsedIp: Scalars['String']['output'];
+  /** The previous secret still works until this time after the secret was rotated. */
+  PreviousApiSecretExpiresAt?: Maybe<Scalars['Time']['output']>;
+  Scopes: Array<ServiceAccountScope>;
+  /** When any competitions or teams are set, the service account can only access those competitions and teams. */
+  ServiceAccountToCompetitions: Array<Competition>;
+  ServiceAccountToTeams: Array<Team>;
 };
 
 export type ServiceAccountDetails = {
@@ -441,13 +663,50 @@ export type ServiceAccountDetails = {
   ApiKey: Scalars['String']['output'];
   ApiSecret: Scalars['String']['output'];
   DisplayName: Scalars['String']['output'];
+  ExpiresAt?: Maybe<Scalars['Time']['output']>;
   ID: Scalars['ID']['output'];
+  Scopes: Array<ServiceAccountScope>;
+  ServiceAccountToCompetitions: Array<Competition>;
+  ServiceAccountToTeams: Array<Team>;
 };
 
 export type ServiceAccountInput = {
   Active: Scalars['Boolean']['input'];
   DisplayName: Scalars['String']['input'];
+  /** Leave null for a service account which never expires. */
+  ExpiresAt?: InputMaybe<Scalars['Time']['input']>;
   ID?: InputMaybe<Scalars['ID']['input']>;
+  /** Leave null to grant every scope on create or to keep the existing scopes on update operations. */
+  Scopes?: InputMaybe<Array<ServiceAccountScope>>;
+  /** Limits the service account to these competitions and teams. Leave null to keep the existing limits on update operations. */
+  ServiceAccountToCompetitions?: InputMaybe<Array<Scalars['ID']['input']>>;
+  ServiceAccountToTeams?: InputMaybe<Array<Scalars['ID']['input']>>;
+};
+
+export enum ServiceAccountScope {
+  CompetitionRead = 'COMPETITION_READ',
+  CompetitionWrite = 'COMPETITION_WRITE',
+  ProviderRead = 'PROVIDER_READ',
+  ProviderWrite = 'PROVIDER_WRITE',
+  TeamRead = 'TEAM_READ',
+  TeamWrite = 'TEAM_WRITE',
+  UserRead = 'USER_READ',
+  UserWrite = 'USER_WRITE',
+  VmPower = 'VM_POWER',
+  VmRead = 'VM_READ',
+  VmWrite = 'VM_WRITE'
+}
+
+export type Session = {
+  __typename?: 'Session';
+  CreatedAt: Scalars['Time']['output'];
+  Current: Scalars['Boolean']['output'];
+  ExpiresAt: Scalars['Time']['output'];
+  ID: Scalars['ID']['output'];
+  IpAddress: Scalars['String']['output'];
+  LastUsedAt?: Maybe<Scalars['Time']['output']>;
+  Method: Scalars['String']['output'];
+  UserAgent: Scalars['String']['output'];
 };
 
 export type SkeletonVmObject = {
@@ -475,6 +734,8 @@ export type SubscriptionPowerStateArgs = {
 
 export type Team = {
   __typename?: 'Team';
+  /** Networks (in CIDR notation) the team's users can connect from, on top of the competition's. Empty allows any network. */
+  AllowedCidrs: Array<Scalars['String']['output']>;
   ID: Scalars['ID']['output'];
   Name?: Maybe<Scalars['String']['output']>;
   TeamNumber: Scalars['Int']['output'];
@@ -483,20 +744,51 @@ export type Team = {
 };
 
 export type TeamInput = {
+  /** Networks in CIDR notation (eg. "10.0.1.0/24"). Leave null to keep the existing allowlist on update operations. */
+  AllowedCidrs?: InputMaybe<Array<Scalars['String']['input']>>;
   ID?: InputMaybe<Scalars['ID']['input']>;
   Name?: InputMaybe<Scalars['String']['input']>;
   TeamNumber: Scalars['Int']['input'];
   TeamToCompetition: Scalars['ID']['input'];
 };
 
+export type TeamMembership = {
+  __typename?: 'TeamMembership';
+  ID: Scalars['ID']['output'];
+  Role: TeamMembershipRole;
+  TeamMembershipToTeam: Team;
+  TeamMembershipToUser: User;
+};
+
+export type TeamMembershipInput = {
+  Role: TeamMembershipRole;
+  Team: Scalars['ID']['input'];
+};
+
+export enum TeamMembershipRole {
+  Captain = 'CAPTAIN',
+  Member = 'MEMBER',
+  /** Observers can view their team's VMs but can't open consoles on or power them */
+  Observer = 'OBSERVER'
+}
+
 export type User = {
   __typename?: 'User';
   FirstName: Scalars['String']['output'];
   ID: Scalars['ID']['output'];
   LastName: Scalars['String']['output'];
+  MustChangePassword: Scalars['Boolean']['output'];
+  /** The permissions of the user's built-in role and custom role */
+  Permissions: Array<Permission>;
   Provider: AuthProvider;
   Role: Role;
+  TotpEnabled: Scalars['Boolean']['output'];
+  /** The competitions the user can manage as a competition-scoped admin */
+  UserToAdminCompetitions: Array<Competition>;
+  UserToCustomRole?: Maybe<CustomRole>;
+  /** The active team, which is one of the user's team memberships. Users access the VMs of their active team. */
   UserToTeam?: Maybe<Team>;
+  UserToTeamMemberships: Array<TeamMembership>;
   Username: Scalars['String']['output'];
 };
 
@@ -504,11 +796,19 @@ export type UserInput = {
   FirstName: Scalars['String']['input'];
   ID?: InputMaybe<Scalars['ID']['input']>;
   LastName: Scalars['String']['input'];
+  /** Forces the user to change their password the next time they sign in */
+  MustChangePassword?: InputMaybe<Scalars['Boolean']['input']>;
   /** Value will be ignore on update operations. Use ChangePassword mutation instead. */
   Password: Scalars['String']['input'];
   Provider: AuthProvider;
   Role: Role;
+  /** Makes the user a competition-scoped admin of these competitions. Leave null to keep the existing competitions on update operations. */
+  UserToAdminCompetitions?: InputMaybe<Array<Scalars['ID']['input']>>;
+  UserToCustomRole?: InputMaybe<Scalars['ID']['input']>;
+  /** The active team. Users are added to it as a member if it isn't one of their team memberships. When UserToTeamMemberships is null on update operations, users are taken off of their previous active team. */
   UserToTeam?: InputMaybe<Scalars['ID']['input']>;
+  /** Replaces the user's team memberships. Leave null to keep the existing memberships on update operations. */
+  UserToTeamMemberships?: InputMaybe<Array<TeamMembershipInput>>;
   Username: Scalars['String']['input'];
 };
 
@@ -519,6 +819,8 @@ export type VmObject = {
   Identifier: Scalars['String']['output'];
   Locked?: Maybe<Scalars['Boolean']['output']>;
   Name: Scalars['String']['output'];
+  /** Users with the RED_TEAM_CONSOLE permission can open consoles on this VM */
+  RedTeamAccess: Scalars['Boolean']['output'];
   VmObjectToTeam?: Maybe<Team>;
 };
 
@@ -528,10 +830,11 @@ export type VmObjectInput = {
   Identifier: Scalars['String']['input'];
   Locked?: InputMaybe<Scalars['Boolean']['input']>;
   Name: Scalars['String']['input'];
+  RedTeamAccess?: InputMaybe<Scalars['Boolean']['input']>;
   VmObjectToTeam?: InputMaybe<Scalars['ID']['input']>;
 };
 
-export type ActionFragmentFragment = { __typename?: 'Action', ID: string, IpAddress: string, Type: ActionType, Message: string, PerformedAt: any, ActionToUser?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role } | null };
+export type ActionFragmentFragment = { __typename?: 'Action', ID: string, IpAddress: string, Type: ActionType, Message: string, PerformedAt: any, ActionToUser?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } | null, ActionToImpersonator?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } | null };
 
 export type ListActionsQueryVariables = Exact<{
   offset: Scalars['Int']['input'];
@@ -540,9 +843,11 @@ export type ListActionsQueryVariables = Exact<{
 }>;
 
 
-export type ListActionsQuery = { __typename?: 'Query', actions: { __typename?: 'ActionsResult', offset: number, limit: number, page: number, totalPages: number, totalResults: number, types: Array<ActionType>, results: Array<{ __typename?: 'Action', ID: string, IpAddress: string, Type: ActionType, Message: string, PerformedAt: any, ActionToUser?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role } | null } | null> } };
+export type ListActionsQuery = { __typename?: 'Query', actions: { __typename?: 'ActionsResult', offset: number, limit: number, page: number, totalPages: number, totalResults: number, types: Array<ActionType>, results: Array<{ __typename?: 'Action', ID: string, IpAddress: string, Type: ActionType, Message: string, PerformedAt: any, ActionToUser?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } | null, ActionToImpersonator?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } | null } | null> } };
+
+export type CompetitionFragmentFragment = { __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } };
 
-export type CompetitionFragmentFragment = { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } };
+export type CompetitionScheduleFragmentFragment = { __typename?: 'Competition', StartsAt?: any | null, EndsAt?: any | null, CompetitionToBreaks: Array<{ __typename?: 'CompetitionBreak', ID: string, Name: string, StartsAt: any, EndsAt: any }> };
 
 export type GetCompTeamSearchValuesQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -552,28 +857,28 @@ export type GetCompTeamSearchValuesQuery = { __typename?: 'Query', teams: Array<
 export type ListCompetitionsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type ListCompetitionsQuery = { __typename?: 'Query', competitions: Array<{ __typename?: 'Competition', ID: string, Name: string, CompetitionToTeams: Array<{ __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number } | null>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } }> };
+export type ListCompetitionsQuery = { __typename?: 'Query', competitions: Array<{ __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, CompetitionToTeams: Array<{ __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number } | null>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } }> };
 
 export type GetCompetitionQueryVariables = Exact<{
   id: Scalars['ID']['input'];
 }>;
 
 
-export type GetCompetitionQuery = { __typename?: 'Query', getCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
+export type GetCompetitionQuery = { __typename?: 'Query', getCompetition: { __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, StartsAt?: any | null, EndsAt?: any | null, CompetitionToBreaks: Array<{ __typename?: 'CompetitionBreak', ID: string, Name: string, StartsAt: any, EndsAt: any }>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
 
 export type UpdateCompetitionMutationVariables = Exact<{
   competition: CompetitionInput;
 }>;
 
 
-export type UpdateCompetitionMutation = { __typename?: 'Mutation', updateCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
+export type UpdateCompetitionMutation = { __typename?: 'Mutation', updateCompetition: { __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
 
 export type CreateCompetitionMutationVariables = Exact<{
   competition: CompetitionInput;
 }>;
 
 
-export type CreateCompetitionMutation = { __typename?: 'Mutation', createCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
+export type CreateCompetitionMutation = { __typename?: 'Mutation', createCompetition: { __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
 
 export type LockoutCompetitionMutationVariables = Exact<{
   competitionId: Scalars['ID']['input'];
@@ -590,6 +895,63 @@ export type DeleteCompetitionMutationVariables = Exact<{
 
 export type DeleteCompetitionMutation = { __typename?: 'Mutation', deleteCompetition: boolean };
 
+export type SetCompetitionScheduleMutationVariables = Exact<{
+  competitionId: Scalars['ID']['input'];
+  schedule: CompetitionScheduleInput;
+}>;
+
+
+export type SetCompetitionScheduleMutation = { __typename?: 'Mutation', setCompetitionSchedule: { __typename?: 'Competition', ID: string, Name: string, AllowedCidrs: Array<string>, StartsAt?: any | null, EndsAt?: any | null, CompetitionToBreaks: Array<{ __typename?: 'CompetitionBreak', ID: string, Name: string, StartsAt: any, EndsAt: any }>, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } };
+
+export type CustomRoleFragmentFragment = { __typename?: 'CustomRole', ID: string, Name: string, Description: string, Permissions: Array<Permission> };
+
+export type ListCustomRolesQueryVariables = Exact<{ [key: string]: never; }>;
+
+
+export type ListCustomRolesQuery = { __typename?: 'Query', customRoles: Array<{ __typename?: 'CustomRole', ID: string, Name: string, Description: string, Permissions: Array<Permission> }> };
+
+export type CreateCustomRoleMutationVariables = Exact<{
+  input: CustomRoleInput;
+}>;
+
+
+export type CreateCustomRoleMutation = { __typename?: 'Mutation', createCustomRole: { __typename?: 'CustomRole', ID: string, Name: string, Description: string, Permissions: Array<Permission> } };
+
+export type UpdateCustomRoleMutationVariables = Exact<{
+  input: CustomRoleInput;
+}>;
+
+
+export type UpdateCustomRoleMutation = { __typename?: 'Mutation', updateCustomRole: { __typename?: 'CustomRole', ID: string, Name: string, Description: string, Permissions: Array<Permission> } };
+
+export type DeleteCustomRoleMutationVariables = Exact<{
+  id: Scalars['ID']['input'];
+}>;
+
+
+export type DeleteCustomRoleMutation = { __typename?: 'Mutation', deleteCustomRole: boolean };
+
+export type PersonalAccessTokenFragmentFragment = { __typename?: 'PersonalAccessToken', ID: string, Name: string, Prefix: string, Scope: PersonalAccessTokenScope, CreatedAt: any, ExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string };
+
+export type ListMyPersonalAccessTokensQueryVariables = Exact<{ [key: string]: never; }>;
+
+
+export type ListMyPersonalAccessTokensQuery = { __typename?: 'Query', myPersonalAccessTokens: Array<{ __typename?: 'PersonalAccessToken', ID: string, Name: string, Prefix: string, Scope: PersonalAccessTokenScope, CreatedAt: any, ExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string }> };
+
+export type CreatePersonalAccessTokenMutationVariables = Exact<{
+  input: PersonalAccessTokenInput;
+}>;
+
+
+export type CreatePersonalAccessTokenMutation = { __typename?: 'Mutation', createPersonalAccessToken: { __typename?: 'PersonalAccessTokenDetails', Token: string, PersonalAccessToken: { __typename?: 'PersonalAccessToken', ID: string, Name: string, Prefix: string, Scope: PersonalAccessTokenScope, CreatedAt: any, ExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string } } };
+
+export type RevokePersonalAccessTokenMutationVariables = Exact<{
+  id: Scalars['ID']['input'];
+}>;
+
+
+export type RevokePersonalAccessTokenMutation = { __typename?: 'Mutation', revokePersonalAccessToken: boolean };
+
 export type ProviderFragmentFragment = { __typename?: 'Provider', ID: string, Name: string, Type: string, Config: string, Loaded: boolean };
 
 export type ListProvidersQueryVariables = Exact<{ [key: string]: never; }>;
@@ -647,35 +1009,35 @@ export type LoadProviderMutationVariables = Exact<{
 
 export type LoadProviderMutation = { __typename?: 'Mutation', loadProvider: boolean };
 
-export type ServiceAccountFragmentFragment = { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean };
+export type ServiceAccountFragmentFragment = { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean, ExpiresAt?: any | null, PreviousApiSecretExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> };
 
-export type ServiceAccountDetailsFragmentFragment = { __typename?: 'ServiceAccountDetails', ID: string, DisplayName: string, ApiKey: string, ApiSecret: string, Active: boolean };
+export type ServiceAccountDetailsFragmentFragment = { __typename?: 'ServiceAccountDetails', ID: string, DisplayName: string, ApiKey: string, ApiSecret: string, Active: boolean, ExpiresAt?: any | null, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> };
 
 export type ListServiceAccountsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type ListServiceAccountsQuery = { __typename?: 'Query', serviceAccounts: Array<{ __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean }> };
+export type ListServiceAccountsQuery = { __typename?: 'Query', serviceAccounts: Array<{ __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean, ExpiresAt?: any | null, PreviousApiSecretExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> }> };
 
 export type GetServiceAccountQueryVariables = Exact<{
   id: Scalars['ID']['input'];
 }>;
 
 
-export type GetServiceAccountQuery = { __typename?: 'Query', getServiceAccount: { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean } };
+export type GetServiceAccountQuery = { __typename?: 'Query', getServiceAccount: { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean, ExpiresAt?: any | null, PreviousApiSecretExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> } };
 
 export type UpdateServiceAccountMutationVariables = Exact<{
   input: ServiceAccountInput;
 }>;
 
 
-export type UpdateServiceAccountMutation = { __typename?: 'Mutation', updateServiceAccount: { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean } };
+export type UpdateServiceAccountMutation = { __typename?: 'Mutation', updateServiceAccount: { __typename?: 'ServiceAccount', ID: string, DisplayName: string, ApiKey: string, Active: boolean, ExpiresAt?: any | null, PreviousApiSecretExpiresAt?: any | null, LastUsedAt?: any | null, LastUsedIp: string, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> } };
 
 export type CreateServiceAccountMutationVariables = Exact<{
   input: ServiceAccountInput;
 }>;
 
 
-export type CreateServiceAccountMutation = { __typename?: 'Mutation', createServiceAccount: { __typename?: 'ServiceAccountDetails', ID: string, DisplayName: string, ApiKey: string, ApiSecret: string, Active: boolean } };
+export type CreateServiceAccountMutation = { __typename?: 'Mutation', createServiceAccount: { __typename?: 'ServiceAccountDetails', ID: string, DisplayName: string, ApiKey: string, ApiSecret: string, Active: boolean, ExpiresAt?: any | null, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> } };
 
 export type DeleteServiceAccountMutationVariables = Exact<{
   id: Scalars['ID']['input'];
@@ -684,40 +1046,50 @@ export type DeleteServiceAccountMutationVariables = Exact<{
 
 export type DeleteServiceAccountMutation = { __typename?: 'Mutation', deleteServiceAccount: boolean };
 
-export type TeamFragmentFragment = { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } };
+export type RotateServiceAccountSecretMutationVariables = Exact<{
+  id: Scalars['ID']['input'];
+  overlapMinutes?: InputMaybe<Scalars['Int']['input']>;
+}>;
+
+
+export type RotateServiceAccountSecretMutation = { __typename?: 'Mutation', rotateServiceAccountSecret: { __typename?: 'ServiceAccountDetails', ID: string, DisplayName: string, ApiKey: string, ApiSecret: string, Active: boolean, ExpiresAt?: any | null, Scopes: Array<ServiceAccountScope>, ServiceAccountToCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }>, ServiceAccountToTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null }> } };
+
+export type TeamFragmentFragment = { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } };
+
+export type TeamMembershipFragmentFragment = { __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
 
 export type ListTeamsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type ListTeamsQuery = { __typename?: 'Query', teams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } }> };
+export type ListTeamsQuery = { __typename?: 'Query', teams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } }> };
 
 export type GetTeamQueryVariables = Exact<{
   id: Scalars['ID']['input'];
 }>;
 
 
-export type GetTeamQuery = { __typename?: 'Query', getTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
+export type GetTeamQuery = { __typename?: 'Query', getTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
 
 export type UpdateTeamMutationVariables = Exact<{
   team: TeamInput;
 }>;
 
 
-export type UpdateTeamMutation = { __typename?: 'Mutation', updateTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
+export type UpdateTeamMutation = { __typename?: 'Mutation', updateTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
 
 export type CreateTeamMutationVariables = Exact<{
   team: TeamInput;
 }>;
 
 
-export type CreateTeamMutation = { __typename?: 'Mutation', createTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
+export type CreateTeamMutation = { __typename?: 'Mutation', createTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
 
 export type BatchCreateTeamsMutationVariables = Exact<{
   teams: Array<TeamInput> | TeamInput;
 }>;
 
 
-export type BatchCreateTeamsMutation = { __typename?: 'Mutation', batchCreateTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } }> };
+export type BatchCreateTeamsMutation = { __typename?: 'Mutation', batchCreateTeams: Array<{ __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } }> };
 
 export type DeleteTeamMutationVariables = Exact<{
   teamId: Scalars['ID']['input'];
@@ -726,42 +1098,42 @@ export type DeleteTeamMutationVariables = Exact<{
 
 export type DeleteTeamMutation = { __typename?: 'Mutation', deleteTeam: boolean };
 
-export type UserFragmentFragment = { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role };
+export type UserFragmentFragment = { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> };
 
-export type AdminUserFragmentFragment = { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null };
+export type AdminUserFragmentFragment = { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null, UserToCustomRole?: { __typename?: 'CustomRole', ID: string, Name: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }> };
 
-export type CompetitionUserFragmentFragment = { __typename?: 'CompetitionUser', ID: string, Username: string, Password: string, UserToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
+export type CompetitionUserFragmentFragment = { __typename?: 'CompetitionUser', ID: string, Username: string, Password: string, UserToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } };
 
 export type GetCurrentUserQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type GetCurrentUserQuery = { __typename?: 'Query', me: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role } };
+export type GetCurrentUserQuery = { __typename?: 'Query', me: { __typename?: 'User', MustChangePassword: boolean, ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string }> }, impersonator?: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string } | null };
 
 export type ListUsersQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type ListUsersQuery = { __typename?: 'Query', users: Array<{ __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null }> };
+export type ListUsersQuery = { __typename?: 'Query', users: Array<{ __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null, UserToCustomRole?: { __typename?: 'CustomRole', ID: string, Name: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }> }> };
 
 export type GetUserQueryVariables = Exact<{
   id: Scalars['ID']['input'];
 }>;
 
 
-export type GetUserQuery = { __typename?: 'Query', getUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null } };
+export type GetUserQuery = { __typename?: 'Query', getUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null, UserToCustomRole?: { __typename?: 'CustomRole', ID: string, Name: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }> } };
 
 export type UpdateUserMutationVariables = Exact<{
   user: UserInput;
 }>;
 
 
-export type UpdateUserMutation = { __typename?: 'Mutation', updateUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null } };
+export type UpdateUserMutation = { __typename?: 'Mutation', updateUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null, UserToCustomRole?: { __typename?: 'CustomRole', ID: string, Name: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }> } };
 
 export type CreateUserMutationVariables = Exact<{
   user: UserInput;
 }>;
 
 
-export type CreateUserMutation = { __typename?: 'Mutation', createUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null } };
+export type CreateUserMutation = { __typename?: 'Mutation', createUser: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission>, UserToTeam?: { __typename?: 'Team', ID: string, Name?: string | null, TeamNumber: number, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } | null, UserToCustomRole?: { __typename?: 'CustomRole', ID: string, Name: string } | null, UserToTeamMemberships: Array<{ __typename?: 'TeamMembership', ID: string, Role: TeamMembershipRole, TeamMembershipToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }>, UserToAdminCompetitions: Array<{ __typename?: 'Competition', ID: string, Name: string }> } };
 
 export type ChangePasswordMutationVariables = Exact<{
   id: Scalars['ID']['input'];
@@ -783,9 +1155,17 @@ export type UpdateAccountMutationVariables = Exact<{
 }>;
 
 
-export type UpdateAccountMutation = { __typename?: 'Mutation', updateAccount: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role } };
+export type UpdateAccountMutation = { __typename?: 'Mutation', updateAccount: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } };
+
+export type SetActiveTeamMutationVariables = Exact<{
+  teamId: Scalars['ID']['input'];
+}>;
+
+
+export type SetActiveTeamMutation = { __typename?: 'Mutation', setActiveTeam: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } };
 
 export type ChangeSelfPasswordMutationVariables = Exact<{
+  currentPassword: Scalars['String']['input'];
   newPassword: Scalars['String']['input'];
 }>;
 
@@ -798,26 +1178,39 @@ export type GenerateCompetitionUsersMutationVariables = Exact<{
 }>;
 
 
-export type GenerateCompetitionUsersMutation = { __typename?: 'Mutation', generateCompetitionUsers: Array<{ __typename?: 'CompetitionUser', ID: string, Username: string, Password: string, UserToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }> };
+export type GenerateCompetitionUsersMutation = { __typename?: 'Mutation', generateCompetitionUsers: Array<{ __typename?: 'CompetitionUser', ID: string, Username: string, Password: string, UserToTeam: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, AllowedCidrs: Array<string>, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string } } }> };
+
+export type ImpersonateMutationVariables = Exact<{
+  userId?: InputMaybe<Scalars['ID']['input']>;
+  teamId?: InputMaybe<Scalars['ID']['input']>;
+}>;
+
+
+export type ImpersonateMutation = { __typename?: 'Mutation', impersonate: { __typename?: 'User', ID: string, Username: string, FirstName: string, LastName: string, Provider: AuthProvider, Role: Role, Permissions: Array<Permission> } };
 
-export type VmObjectFragmentFragment = { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null };
+export type StopImpersonatingMutationVariables = Exact<{ [key: string]: never; }>;
+
+
+export type StopImpersonatingMutation = { __typename?: 'Mutation', stopImpersonating: boolean };
+
+export type VmObjectFragmentFragment = { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null };
 
 export type MyVmObjectsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type MyVmObjectsQuery = { __typename?: 'Query', myVmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
+export type MyVmObjectsQuery = { __typename?: 'Query', myVmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
 
 export type AllVmObjectsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type AllVmObjectsQuery = { __typename?: 'Query', vmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
+export type AllVmObjectsQuery = { __typename?: 'Query', vmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
 
 export type GetVmObjectQueryVariables = Exact<{
   vmObjectId: Scalars['ID']['input'];
 }>;
 
 
-export type GetVmObjectQuery = { __typename?: 'Query', vmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
+export type GetVmObjectQuery = { __typename?: 'Query', vmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
 
 export type GetVmConsoleQueryVariables = Exact<{
   vmObjectId: Scalars['ID']['input'];
@@ -861,21 +1254,21 @@ export type UpdateVmObjectMutationVariables = Exact<{
 }>;
 
 
-export type UpdateVmObjectMutation = { __typename?: 'Mutation', updateVmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
+export type UpdateVmObjectMutation = { __typename?: 'Mutation', updateVmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
 
 export type CreateVmObjectMutationVariables = Exact<{
   vmObject: VmObjectInput;
 }>;
 
 
-export type CreateVmObjectMutation = { __typename?: 'Mutation', createVmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
+export type CreateVmObjectMutation = { __typename?: 'Mutation', createVmObject: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
 
 export type BatchCreateVmObjectsMutationVariables = Exact<{
   vmObjects: Array<VmObjectInput> | VmObjectInput;
 }>;
 
 
-export type BatchCreateVmObjectsMutation = { __typename?: 'Mutation', batchCreateVmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
+export type BatchCreateVmObjectsMutation = { __typename?: 'Mutation', batchCreateVmObjects: Array<{ __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null }> };
 
 export type LockoutVmMutationVariables = Exact<{
   vmObjectId: Scalars['ID']['input'];
@@ -890,7 +1283,7 @@ export type LockoutSubscriptionVariables = Exact<{
 }>;
 
 
-export type LockoutSubscription = { __typename?: 'Subscription', lockout: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
+export type LockoutSubscription = { __typename?: 'Subscription', lockout: { __typename?: 'VmObject', ID: string, Identifier: string, Name: string, IPAddresses: Array<string>, Locked?: boolean | null, RedTeamAccess: boolean, VmObjectToTeam?: { __typename?: 'Team', ID: string, TeamNumber: number, Name?: string | null, TeamToCompetition: { __typename?: 'Competition', ID: string, Name: string, CompetitionToProvider: { __typename?: 'Provider', ID: string, Name: string, Type: string } } } | null } };
 
 export type PowerStateSubscriptionVariables = Exact<{
   vmObjectId: Scalars['ID']['input'];
@@ -922,6 +1315,7 @@ export const UserFragmentFragmentDoc = gql`
   LastName
   Provider
   Role
+  Permissions
 }
     `;
 export const ActionFragmentFragmentDoc = gql`
@@ -934,12 +1328,16 @@ export const ActionFragmentFragmentDoc = gql`
   ActionToUser {
     ...UserFragment
   }
+  ActionToImpersonator {
+    ...UserFragment
+  }
 }
     ${UserFragmentFragmentDoc}`;
 export const CompetitionFragmentFragmentDoc = gql`
     fragment CompetitionFragment on Competition {
   ID
   Name
+  AllowedCidrs
   CompetitionToProvider {
     ID
     Name
@@ -947,6 +1345,38 @@ export const CompetitionFragmentFragmentDoc = gql`
   }
 }
     `;
+export const CompetitionScheduleFragmentFragmentDoc = gql`
+    fragment CompetitionScheduleFragment on Competition {
+  StartsAt
+  EndsAt
+  CompetitionToBreaks {
+    ID
+    Name
+    StartsAt
+    EndsAt
+  }
+}
+    `;
+export const CustomRoleFragmentFragmentDoc = gql`
+    fragment CustomRoleFragment on CustomRole {
+  ID
+  Name
+  Description
+  Permissions
+}
+    `;
+export const PersonalAccessTokenFragmentFragmentDoc = gql`
+    fragment PersonalAccessTokenFragment on PersonalAccessToken {
+  ID
+  Name
+  Prefix
+  Scope
+  CreatedAt
+  ExpiresAt
+  LastUsedAt
+  LastUsedIp
+}
+    `;
 export const ProviderFragmentFragmentDoc = gql`
     fragment ProviderFragment on Provider {
   ID
@@ -962,6 +1392,20 @@ export const ServiceAccountFragmentFragmentDoc = gql`
   DisplayName
   ApiKey
   Active
+  ExpiresAt
+  PreviousApiSecretExpiresAt
+  LastUsedAt
+  LastUsedIp
+  Scopes
+  ServiceAccountToCompetitions {
+    ID
+    Name
+  }
+  ServiceAccountToTeams {
+    ID
+    TeamNumber
+    Name
+  }
 }
     `;
 export const ServiceAccountDetailsFragmentFragmentDoc = gql`
@@ -971,8 +1415,40 @@ export const ServiceAccountDetailsFragmentFragmentDoc = gql`
   ApiKey
   ApiSecret
   Active
+  ExpiresAt
+  Scopes
+  ServiceAccountToCompetitions {
+    ID
+    Name
+  }
+  ServiceAccountToTeams {
+    ID
+    TeamNumber
+    Name
+  }
 }
     `;
+export const TeamFragmentFragmentDoc = gql`
+    fragment TeamFragment on Team {
+  ID
+  TeamNumber
+  Name
+  AllowedCidrs
+  TeamToCompetition {
+    ID
+    Name
+  }
+}
+    `;
+export const TeamMembershipFragmentFragmentDoc = gql`
+    fragment TeamMembershipFragment on TeamMembership {
+  ID
+  Role
+  TeamMembershipToTeam {
+    ...TeamFragment
+  }
+}
+    ${TeamFragmentFragmentDoc}`;
 export const AdminUserFragmentFragmentDoc = gql`
     fragment AdminUserFragment on User {
   ...UserFragment
@@ -985,19 +1461,20 @@ export const AdminUserFragmentFragmentDoc = gql`
       Name
     }
   }
-}
-    ${UserFragmentFragmentDoc}`;
-export const TeamFragmentFragmentDoc = gql`
-    fragment TeamFragment on Team {
-  ID
-  TeamNumber
-  Name
-  TeamToCompetition {
+  UserToCustomRole {
+    ID
+    Name
+  }
+  UserToTeamMemberships {
+    ...TeamMembershipFragment
+  }
+  UserToAdminCompetitions {
     ID
     Name
   }
 }
-    `;
+    ${UserFragmentFragmentDoc}
+${TeamMembershipFragmentFragmentDoc}`;
 export const CompetitionUserFragmentFragmentDoc = gql`
     fragment CompetitionUserFragment on CompetitionUser {
   ID
@@ -1015,6 +1492,7 @@ export const VmObjectFragmentFragmentDoc = gql`
   Name
   IPAddresses
   Locked
+  RedTeamAccess
   VmObjectToTeam {
     ID
     TeamNumber
@@ -1174,9 +1652,11 @@ export const GetCompetitionDocument = gql`
     query GetCompetition($id: ID!) {
   getCompetition(id: $id) {
     ...CompetitionFragment
+    ...CompetitionScheduleFragment
   }
 }
-    ${CompetitionFragmentFragmentDoc}`;
+    ${CompetitionFragmentFragmentDoc}
+${CompetitionScheduleFragmentFragmentDoc}`;
 
 /**
  * __useGetCompetitionQuery__
@@ -1339,6 +1819,284 @@ export function useDeleteCompetitionMutation(baseOptions?: Apollo.MutationHookOp
 export type DeleteCompetitionMutationHookResult = ReturnType<typeof useDeleteCompetitionMutation>;
 export type DeleteCompetitionMutationResult = Apollo.MutationResult<DeleteCompetitionMutation>;
 export type DeleteCompetitionMutationOptions = Apollo.BaseMutationOptions<DeleteCompetitionMutation, DeleteCompetitionMutationVariables>;
+export const SetCompetitionScheduleDocument = gql`
+    mutation SetCompetitionSchedule($competitionId: ID!, $schedule: CompetitionScheduleInput!) {
+  setCompetitionSchedule(id: $competitionId, input: $schedule) {
+    ...CompetitionFragment
+    ...CompetitionScheduleFragment
+  }
+}
+    ${CompetitionFragmentFragmentDoc}
+${CompetitionScheduleFragmentFragmentDoc}`;
+export type SetCompetitionScheduleMutationFn = Apollo.MutationFunction<SetCompetitionScheduleMutation, SetCompetitionScheduleMutationVariables>;
+
+/**
+ * __useSetCompetitionScheduleMutation__
+ *
+ * To run a mutation, you first call `useSetCompetitionScheduleMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useSetCompetitionScheduleMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [setCompetitionScheduleMutation, { data, loading, error }] = useSetCompetitionScheduleMutation({
+ *   variables: {
+ *      competitionId: // value for 'competitionId'
+ *      schedule: // value for 'schedule'
+ *   },
+ * });
+ */
+export function useSetCompetitionScheduleMutation(baseOptions?: Apollo.MutationHookOptions<SetCompetitionScheduleMutation, SetCompetitionScheduleMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<SetCompetitionScheduleMutation, SetCompetitionScheduleMutationVariables>(SetCompetitionScheduleDocument, options);
+      }
+export type SetCompetitionScheduleMutationHookResult = ReturnType<typeof useSetCompetitionScheduleMutation>;
+export type SetCompetitionScheduleMutationResult = Apollo.MutationResult<SetCompetitionScheduleMutation>;
+export type SetCompetitionScheduleMutationOptions = Apollo.BaseMutationOptions<SetCompetitionScheduleMutation, SetCompetitionScheduleMutationVariables>;
+export const ListCustomRolesDocument = gql`
+    query ListCustomRoles {
+  customRoles {
+    ...CustomRoleFragment
+  }
+}
+    ${CustomRoleFragmentFragmentDoc}`;
+
+/**
+ * __useListCustomRolesQuery__
+ *
+ * To run a query within a React component, call `useListCustomRolesQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListCustomRolesQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListCustomRolesQuery({
+ *   variables: {
+ *   },
+ * });
+ */
+export function useListCustomRolesQuery(baseOptions?: Apollo.QueryHookOptions<ListCustomRolesQuery, ListCustomRolesQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListCustomRolesQuery, ListCustomRolesQueryVariables>(ListCustomRolesDocument, options);
+      }
+export function useListCustomRolesLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListCustomRolesQuery, ListCustomRolesQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListCustomRolesQuery, ListCustomRolesQueryVariables>(ListCustomRolesDocument, options);
+        }
+export function useListCustomRolesSuspenseQuery(baseOptions?: Apollo.SuspenseQueryHookOptions<ListCustomRolesQuery, ListCustomRolesQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useSuspenseQuery<ListCustomRolesQuery, ListCustomRolesQueryVariables>(ListCustomRolesDocument, options);
+        }
+export type ListCustomRolesQueryHookResult = ReturnType<typeof useListCustomRolesQuery>;
+export type ListCustomRolesLazyQueryHookResult = ReturnType<typeof useListCustomRolesLazyQuery>;
+export type ListCustomRolesSuspenseQueryHookResult = ReturnType<typeof useListCustomRolesSuspenseQuery>;
+export type ListCustomRolesQueryResult = Apollo.QueryResult<ListCustomRolesQuery, ListCustomRolesQueryVariables>;
+export const CreateCustomRoleDocument = gql`
+    mutation CreateCustomRole($input: CustomRoleInput!) {
+  createCustomRole(input: $input) {
+    ...CustomRoleFragment
+  }
+}
+    ${CustomRoleFragmentFragmentDoc}`;
+export type CreateCustomRoleMutationFn = Apollo.MutationFunction<CreateCustomRoleMutation, CreateCustomRoleMutationVariables>;
+
+/**
+ * __useCreateCustomRoleMutation__
+ *
+ * To run a mutation, you first call `useCreateCustomRoleMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCreateCustomRoleMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [createCustomRoleMutation, { data, loading, error }] = useCreateCustomRoleMutation({
+ *   variables: {
+ *      input: // value for 'input'
+ *   },
+ * });
+ */
+export function useCreateCustomRoleMutation(baseOptions?: Apollo.MutationHookOptions<CreateCustomRoleMutation, CreateCustomRoleMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CreateCustomRoleMutation, CreateCustomRoleMutationVariables>(CreateCustomRoleDocument, options);
+      }
+export type CreateCustomRoleMutationHookResult = ReturnType<typeof useCreateCustomRoleMutation>;
+export type CreateCustomRoleMutationResult = Apollo.MutationResult<CreateCustomRoleMutation>;
+export type CreateCustomRoleMutationOptions = Apollo.BaseMutationOptions<CreateCustomRoleMutation, CreateCustomRoleMutationVariables>;
+export const UpdateCustomRoleDocument = gql`
+    mutation UpdateCustomRole($input: CustomRoleInput!) {
+  updateCustomRole(input: $input) {
+    ...CustomRoleFragment
+  }
+}
+    ${CustomRoleFragmentFragmentDoc}`;
+export type UpdateCustomRoleMutationFn = Apollo.MutationFunction<UpdateCustomRoleMutation, UpdateCustomRoleMutationVariables>;
+
+/**
+ * __useUpdateCustomRoleMutation__
+ *
+ * To run a mutation, you first call `useUpdateCustomRoleMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useUpdateCustomRoleMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [updateCustomRoleMutation, { data, loading, error }] = useUpdateCustomRoleMutation({
+ *   variables: {
+ *      input: // value for 'input'
+ *   },
+ * });
+ */
+export function useUpdateCustomRoleMutation(baseOptions?: Apollo.MutationHookOptions<UpdateCustomRoleMutation, UpdateCustomRoleMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<UpdateCustomRoleMutation, UpdateCustomRoleMutationVariables>(UpdateCustomRoleDocument, options);
+      }
+export type UpdateCustomRoleMutationHookResult = ReturnType<typeof useUpdateCustomRoleMutation>;
+export type UpdateCustomRoleMutationResult = Apollo.MutationResult<UpdateCustomRoleMutation>;
+export type UpdateCustomRoleMutationOptions = Apollo.BaseMutationOptions<UpdateCustomRoleMutation, UpdateCustomRoleMutationVariables>;
+export const DeleteCustomRoleDocument = gql`
+    mutation DeleteCustomRole($id: ID!) {
+  deleteCustomRole(id: $id)
+}
+    `;
+export type DeleteCustomRoleMutationFn = Apollo.MutationFunction<DeleteCustomRoleMutation, DeleteCustomRoleMutationVariables>;
+
+/**
+ * __useDeleteCustomRoleMutation__
+ *
+ * To run a mutation, you first call `useDeleteCustomRoleMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useDeleteCustomRoleMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [deleteCustomRoleMutation, { data, loading, error }] = useDeleteCustomRoleMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useDeleteCustomRoleMutation(baseOptions?: Apollo.MutationHookOptions<DeleteCustomRoleMutation, DeleteCustomRoleMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<DeleteCustomRoleMutation, DeleteCustomRoleMutationVariables>(DeleteCustomRoleDocument, options);
+      }
+export type DeleteCustomRoleMutationHookResult = ReturnType<typeof useDeleteCustomRoleMutation>;
+export type DeleteCustomRoleMutationResult = Apollo.MutationResult<DeleteCustomRoleMutation>;
+export type DeleteCustomRoleMutationOptions = Apollo.BaseMutationOptions<DeleteCustomRoleMutation, DeleteCustomRoleMutationVariables>;
+export const ListMyPersonalAccessTokensDocument = gql`
+    query ListMyPersonalAccessTokens {
+  myPersonalAccessTokens {
+    ...PersonalAccessTokenFragment
+  }
+}
+    ${PersonalAccessTokenFragmentFragmentDoc}`;
+
+/**
+ * __useListMyPersonalAccessTokensQuery__
+ *
+ * To run a query within a React component, call `useListMyPersonalAccessTokensQuery` and pass it any options that fit your needs.
+ * When your component renders, `useListMyPersonalAccessTokensQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useListMyPersonalAccessTokensQuery({
+ *   variables: {
+ *   },
+ * });
+ */
+export function useListMyPersonalAccessTokensQuery(baseOptions?: Apollo.QueryHookOptions<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useQuery<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>(ListMyPersonalAccessTokensDocument, options);
+      }
+export function useListMyPersonalAccessTokensLazyQuery(baseOptions?: Apollo.LazyQueryHookOptions<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useLazyQuery<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>(ListMyPersonalAccessTokensDocument, options);
+        }
+export function useListMyPersonalAccessTokensSuspenseQuery(baseOptions?: Apollo.SuspenseQueryHookOptions<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>) {
+          const options = {...defaultOptions, ...baseOptions}
+          return Apollo.useSuspenseQuery<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>(ListMyPersonalAccessTokensDocument, options);
+        }
+export type ListMyPersonalAccessTokensQueryHookResult = ReturnType<typeof useListMyPersonalAccessTokensQuery>;
+export type ListMyPersonalAccessTokensLazyQueryHookResult = ReturnType<typeof useListMyPersonalAccessTokensLazyQuery>;
+export type ListMyPersonalAccessTokensSuspenseQueryHookResult = ReturnType<typeof useListMyPersonalAccessTokensSuspenseQuery>;
+export type ListMyPersonalAccessTokensQueryResult = Apollo.QueryResult<ListMyPersonalAccessTokensQuery, ListMyPersonalAccessTokensQueryVariables>;
+export const CreatePersonalAccessTokenDocument = gql`
+    mutation CreatePersonalAccessToken($input: PersonalAccessTokenInput!) {
+  createPersonalAccessToken(input: $input) {
+    Token
+    PersonalAccessToken {
+      ...PersonalAccessTokenFragment
+    }
+  }
+}
+    ${PersonalAccessTokenFragmentFragmentDoc}`;
+export type CreatePersonalAccessTokenMutationFn = Apollo.MutationFunction<CreatePersonalAccessTokenMutation, CreatePersonalAccessTokenMutationVariables>;
+
+/**
+ * __useCreatePersonalAccessTokenMutation__
+ *
+ * To run a mutation, you first call `useCreatePersonalAccessTokenMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useCreatePersonalAccessTokenMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [createPersonalAccessTokenMutation, { data, loading, error }] = useCreatePersonalAccessTokenMutation({
+ *   variables: {
+ *      input: // value for 'input'
+ *   },
+ * });
+ */
+export function useCreatePersonalAccessTokenMutation(baseOptions?: Apollo.MutationHookOptions<CreatePersonalAccessTokenMutation, CreatePersonalAccessTokenMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<CreatePersonalAccessTokenMutation, CreatePersonalAccessTokenMutationVariables>(CreatePersonalAccessTokenDocument, options);
+      }
+export type CreatePersonalAccessTokenMutationHookResult = ReturnType<typeof useCreatePersonalAccessTokenMutation>;
+export type CreatePersonalAccessTokenMutationResult = Apollo.MutationResult<CreatePersonalAccessTokenMutation>;
+export type CreatePersonalAccessTokenMutationOptions = Apollo.BaseMutationOptions<CreatePersonalAccessTokenMutation, CreatePersonalAccessTokenMutationVariables>;
+export const RevokePersonalAccessTokenDocument = gql`
+    mutation RevokePersonalAccessToken($id: ID!) {
+  revokePersonalAccessToken(id: $id)
+}
+    `;
+export type RevokePersonalAccessTokenMutationFn = Apollo.MutationFunction<RevokePersonalAccessTokenMutation, RevokePersonalAccessTokenMutationVariables>;
+
+/**
+ * __useRevokePersonalAccessTokenMutation__
+ *
+ * To run a mutation, you first call `useRevokePersonalAccessTokenMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useRevokePersonalAccessTokenMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [revokePersonalAccessTokenMutation, { data, loading, error }] = useRevokePersonalAccessTokenMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *   },
+ * });
+ */
+export function useRevokePersonalAccessTokenMutation(baseOptions?: Apollo.MutationHookOptions<RevokePersonalAccessTokenMutation, RevokePersonalAccessTokenMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<RevokePersonalAccessTokenMutation, RevokePersonalAccessTokenMutationVariables>(RevokePersonalAccessTokenDocument, options);
+      }
+export type RevokePersonalAccessTokenMutationHookResult = ReturnType<typeof useRevokePersonalAccessTokenMutation>;
+export type RevokePersonalAccessTokenMutationResult = Apollo.MutationResult<RevokePersonalAccessTokenMutation>;
+export type RevokePersonalAccessTokenMutationOptions = Apollo.BaseMutationOptions<RevokePersonalAccessTokenMutation, RevokePersonalAccessTokenMutationVariables>;
 export const ListProvidersDocument = gql`
     query ListProviders {
   providers {
@@ -1803,6 +2561,40 @@ export function useDeleteServiceAccountMutation(baseOptions?: Apollo.MutationHoo
 export type DeleteServiceAccountMutationHookResult = ReturnType<typeof useDeleteServiceAccountMutation>;
 export type DeleteServiceAccountMutationResult = Apollo.MutationResult<DeleteServiceAccountMutation>;
 export type DeleteServiceAccountMutationOptions = Apollo.BaseMutationOptions<DeleteServiceAccountMutation, DeleteServiceAccountMutationVariables>;
+export const RotateServiceAccountSecretDocument = gql`
+    mutation RotateServiceAccountSecret($id: ID!, $overlapMinutes: Int) {
+  rotateServiceAccountSecret(id: $id, overlapMinutes: $overlapMinutes) {
+    ...ServiceAccountDetailsFragment
+  }
+}
+    ${ServiceAccountDetailsFragmentFragmentDoc}`;
+export type RotateServiceAccountSecretMutationFn = Apollo.MutationFunction<RotateServiceAccountSecretMutation, RotateServiceAccountSecretMutationVariables>;
+
+/**
+ * __useRotateServiceAccountSecretMutation__
+ *
+ * To run a mutation, you first call `useRotateServiceAccountSecretMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useRotateServiceAccountSecretMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [rotateServiceAccountSecretMutation, { data, loading, error }] = useRotateServiceAccountSecretMutation({
+ *   variables: {
+ *      id: // value for 'id'
+ *      overlapMinutes: // value for 'overlapMinutes'
+ *   },
+ * });
+ */
+export function useRotateServiceAccountSecretMutation(baseOptions?: Apollo.MutationHookOptions<RotateServiceAccountSecretMutation, RotateServiceAccountSecretMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<RotateServiceAccountSecretMutation, RotateServiceAccountSecretMutationVariables>(RotateServiceAccountSecretDocument, options);
+      }
+export type RotateServiceAccountSecretMutationHookResult = ReturnType<typeof useRotateServiceAccountSecretMutation>;
+export type RotateServiceAccountSecretMutationResult = Apollo.MutationResult<RotateServiceAccountSecretMutation>;
+export type RotateServiceAccountSecretMutationOptions = Apollo.BaseMutationOptions<RotateServiceAccountSecretMutation, RotateServiceAccountSecretMutationVariables>;
 export const ListTeamsDocument = gql`
     query ListTeams {
   teams {
@@ -2016,9 +2808,26 @@ export const GetCurrentUserDocument = gql`
     query GetCurrentUser {
   me {
     ...UserFragment
+    MustChangePassword
+    UserToTeam {
+      ID
+    }
+    UserToTeamMemberships {
+      ...TeamMembershipFragment
+    }
+    UserToAdminCompetitions {
+      ID
+    }
+  }
+  impersonator {
+    ID
+    Username
+    FirstName
+    LastName
   }
 }
-    ${UserFragmentFragmentDoc}`;
+    ${UserFragmentFragmentDoc}
+${TeamMembershipFragmentFragmentDoc}`;
 
 /**
  * __useGetCurrentUserQuery__
@@ -2292,9 +3101,42 @@ export function useUpdateAccountMutation(baseOptions?: Apollo.MutationHookOption
 export type UpdateAccountMutationHookResult = ReturnType<typeof useUpdateAccountMutation>;
 export type UpdateAccountMutationResult = Apollo.MutationResult<UpdateAccountMutation>;
 export type UpdateAccountMutationOptions = Apollo.BaseMutationOptions<UpdateAccountMutation, UpdateAccountMutationVariables>;
+export const SetActiveTeamDocument = gql`
+    mutation SetActiveTeam($teamId: ID!) {
+  setActiveTeam(teamId: $teamId) {
+    ...UserFragment
+  }
+}
+    ${UserFragmentFragmentDoc}`;
+export type SetActiveTeamMutationFn = Apollo.MutationFunction<SetActiveTeamMutation, SetActiveTeamMutationVariables>;
+
+/**
+ * __useSetActiveTeamMutation__
+ *
+ * To run a mutation, you first call `useSetActiveTeamMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useSetActiveTeamMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [setActiveTeamMutation, { data, loading, error }] = useSetActiveTeamMutation({
+ *   variables: {
+ *      teamId: // value for 'teamId'
+ *   },
+ * });
+ */
+export function useSetActiveTeamMutation(baseOptions?: Apollo.MutationHookOptions<SetActiveTeamMutation, SetActiveTeamMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<SetActiveTeamMutation, SetActiveTeamMutationVariables>(SetActiveTeamDocument, options);
+      }
+export type SetActiveTeamMutationHookResult = ReturnType<typeof useSetActiveTeamMutation>;
+export type SetActiveTeamMutationResult = Apollo.MutationResult<SetActiveTeamMutation>;
+export type SetActiveTeamMutationOptions = Apollo.BaseMutationOptions<SetActiveTeamMutation, SetActiveTeamMutationVariables>;
 export const ChangeSelfPasswordDocument = gql`
-    mutation ChangeSelfPassword($newPassword: String!) {
-  changeSelfPassword(password: $newPassword)
+    mutation ChangeSelfPassword($currentPassword: String!, $newPassword: String!) {
+  changeSelfPassword(currentPassword: $currentPassword, password: $newPassword)
 }
     `;
 export type ChangeSelfPasswordMutationFn = Apollo.MutationFunction<ChangeSelfPasswordMutation, ChangeSelfPasswordMutationVariables>;
@@ -2312,6 +3154,7 @@ export type ChangeSelfPasswordMutationFn = Apollo.MutationFunction<ChangeSelfPas
  * @example
  * const [changeSelfPasswordMutation, { data, loading, error }] = useChangeSelfPasswordMutation({
  *   variables: {
+ *      currentPassword: // value for 'currentPassword'
  *      newPassword: // value for 'newPassword'
  *   },
  * });
@@ -2360,6 +3203,70 @@ export function useGenerateCompetitionUsersMutation(baseOptions?: Apollo.Mutatio
 export type GenerateCompetitionUsersMutationHookResult = ReturnType<typeof useGenerateCompetitionUsersMutation>;
 export type GenerateCompetitionUsersMutationResult = Apollo.MutationResult<GenerateCompetitionUsersMutation>;
 export type GenerateCompetitionUsersMutationOptions = Apollo.BaseMutationOptions<GenerateCompetitionUsersMutation, GenerateCompetitionUsersMutationVariables>;
+export const ImpersonateDocument = gql`
+    mutation Impersonate($userId: ID, $teamId: ID) {
+  impersonate(userId: $userId, teamId: $teamId) {
+    ...UserFragment
+  }
+}
+    ${UserFragmentFragmentDoc}`;
+export type ImpersonateMutationFn = Apollo.MutationFunction<ImpersonateMutation, ImpersonateMutationVariables>;
+
+/**
+ * __useImpersonateMutation__
+ *
+ * To run a mutation, you first call `useImpersonateMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useImpersonateMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [impersonateMutation, { data, loading, error }] = useImpersonateMutation({
+ *   variables: {
+ *      userId: // value for 'userId'
+ *      teamId: // value for 'teamId'
+ *   },
+ * });
+ */
+export function useImpersonateMutation(baseOptions?: Apollo.MutationHookOptions<ImpersonateMutation, ImpersonateMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<ImpersonateMutation, ImpersonateMutationVariables>(ImpersonateDocument, options);
+      }
+export type ImpersonateMutationHookResult = ReturnType<typeof useImpersonateMutation>;
+export type ImpersonateMutationResult = Apollo.MutationResult<ImpersonateMutation>;
+export type ImpersonateMutationOptions = Apollo.BaseMutationOptions<ImpersonateMutation, ImpersonateMutationVariables>;
+export const StopImpersonatingDocument = gql`
+    mutation StopImpersonating {
+  stopImpersonating
+}
+    `;
+export type StopImpersonatingMutationFn = Apollo.MutationFunction<StopImpersonatingMutation, StopImpersonatingMutationVariables>;
+
+/**
+ * __useStopImpersonatingMutation__
+ *
+ * To run a mutation, you first call `useStopImpersonatingMutation` within a React component and pass it any options that fit your needs.
+ * When your component renders, `useStopImpersonatingMutation` returns a tuple that includes:
+ * - A mutate function that you can call at any time to execute the mutation
+ * - An object with fields that represent the current status of the mutation's execution
+ *
+ * @param baseOptions options that will be passed into the mutation, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options-2;
+ *
+ * @example
+ * const [stopImpersonatingMutation, { data, loading, error }] = useStopImpersonatingMutation({
+ *   variables: {
+ *   },
+ * });
+ */
+export function useStopImpersonatingMutation(baseOptions?: Apollo.MutationHookOptions<StopImpersonatingMutation, StopImpersonatingMutationVariables>) {
+        const options = {...defaultOptions, ...baseOptions}
+        return Apollo.useMutation<StopImpersonatingMutation, StopImpersonatingMutationVariables>(StopImpersonatingDocument, options);
+      }
+export type StopImpersonatingMutationHookResult = ReturnType<typeof useStopImpersonatingMutation>;
+export type StopImpersonatingMutationResult = Apollo.MutationResult<StopImpersonatingMutation>;
+export type StopImpersonatingMutationOptions = Apollo.BaseMutationOptions<StopImpersonatingMutation, StopImpersonatingMutationVariables>;
 export const MyVmObjectsDocument = gql`
     query MyVmObjects {
   myVmObjects {
@@ -35,17 +35,24 @@ export const client = new ApolloClient({
   credentials: 'include',
 })
 
+export interface MFAChallenge {
+  mfa_required: true
+  methods: ('totp' | 'webauthn')[]
+}
+
 export const LocalLogin = (
   username: string,
-  password: string
+  password: string,
+  loginUrl = '/api/auth/local/login'
 ): Promise<
   | User
+  | MFAChallenge
   | {
       error: string
     }
 > => {
   return new Promise((resolve, reject) =>
-    fetch(`${import.meta.env.VITE_APP_SERVER_URL}/api/auth/local/login`, {
+    fetch(`${import.meta.env.VITE_APP_SERVER_URL}${loginUrl}`, {
       method: 'POST',
       body: JSON.stringify({
         username,
@@ -55,6 +62,37 @@ export const LocalLogin = (
         'Content-Type': 'application/json',
       },
       credentials: 'include',
+    })
+      .then((res) => res.json())
+      .then((res) => {
+        if (res.error) {
+          console.error(`Auth error: ${res.error}`)
+          reject({
+            error: res.error,
+          })
+        } else resolve(res as User | MFAChallenge)
+      })
+  )
+}
+
+export const MFALogin = (
+  code: string
+): Promise<
+  | User
+  | {
+      error: string
+    }
+> => {
+  return new Promise((resolve, reject) =>
+    fetch(`${import.meta.env.VITE_APP_SERVER_URL}/api/auth/mfa/login`, {
+      method: 'POST',
+      body: JSON.stringify({
+        code,
+      }),
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      credentials: 'include',
     })
       .then((res) => res.json())
       .then((res) => {
@@ -68,6 +106,93 @@ export const LocalLogin = (
   )
 }
 
+export interface LoginMethod {
+  name: string
+  login_url: string
+  password: boolean
+  passkey: boolean
+}
+
+const base64UrlToBuffer = (value: string): ArrayBuffer => {
+  const base64 = value.replace(/-/g, '+').replace(/_/g, '/')
+  const padded = base64.padEnd(
+    base64.length + ((4 - (base64.length % 4)) % 4),
+    '='
+  )
+  return Uint8Array.from(atob(padded), (c) => c.charCodeAt(0)).buffer
+}
+
+const bufferToBase64Url = (buffer: ArrayBuffer): string =>
+  btoa(String.fromCharCode(...new Uint8Array(buffer)))
+    .replace(/\+/g, '-')
+    .replace(/\//g, '_')
+    .replace(/=+$/, '')
+
+// PasskeyLogin signs in with a passkey. If a local login returned `mfa_required` the passkey is used as the second
+// factor, otherwise any passkey registered to the account can sign in without a password.
+export const PasskeyLogin = async (): Promise<User> => {
+  const options = await fetch(
+    `${import.meta.env.VITE_APP_SERVER_URL}/api/auth/webauthn/login/begin`,
+    {
+      method: 'POST',
+      credentials: 'include',
+    }
+  ).then((res) => res.json())
+  if (options.error) throw { error: options.error }
+  const publicKey = options.publicKey
+  const credential = (await navigator.credentials.get({
+    publicKey: {
+      ...publicKey,
+      challenge: base64UrlToBuffer(publicKey.challenge),
+      allowCredentials: publicKey.allowCredentials?.map(
+        (allowed: { id: string }) => ({
+          ...allowed,
+          id: base64UrlToBuffer(allowed.id),
+        })
+      ),
+    },
+  })) as PublicKeyCredential | null
+  if (!credential) throw { error: 'Passkey sign in was cancelled' }
+  const response = credential.response as AuthenticatorAssertionResponse
+  const res = await fetch(
+    `${import.meta.env.VITE_APP_SERVER_URL}/api/auth/webauthn/login/finish`,
+    {
+      method: 'POST',
+      body: JSON.stringify({
+        id: credential.id,
+        rawId: bufferToBase64Url(credential.rawId),
+        type: credential.type,
+        response: {
+          authenticatorData: bufferToBase64Url(response.authenticatorData),
+          clientDataJSON: bufferToBase64Url(response.clientDataJSON),
+          signature: bufferToBase64Url(response.signature),
+          userHandle: response.userHandle
+            ? bufferToBase64Url(response.userHandle)
+            : undefined,
+        },
+      }),
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      credentials: 'include',
+    }
+  ).then((res) => res.json())
+  if (res.error) {
+    console.error(`Auth error: ${res.error}`)
+    throw { error: res.error }
+  }
+  return res as User
+}
+
+export const LoginMethods = (): Promise<LoginMethod[]> => {
+  return fetch(`${import.meta.env.VITE_APP_SERVER_URL}/api/auth/methods`, {
+    method: 'GET',
+    credentials: 'include',
+  })
+    .then((res) => res.json())
+    .then((res) => res as LoginMethod[])
+}
+
 export const Logout = (): Promise<boolean> => {
   return new Promise((resolve, reject) =>
     fetch(`${import.meta.env.VITE_APP_SERVER_URL}/api/auth/logout`, {
@@ -41,7 +41,9 @@ export default function OpenStackSerial({
       // Load web links addon
       term?.loadAddon(webLinksAddon)
 
-      const ws = new WebSocket(consoleUrl, ['binary', 'base64'])
+      // Serial consoles are proxied through Compsole so the session can be recorded
+      const ws = new WebSocket(`${import.meta.env.VITE_APP_WS_URL}${consoleUrl}`)
+      ws.binaryType = 'arraybuffer'
 
       term?.onData(function (data) {
         ws.send(str2ab(data))
@@ -61,12 +63,8 @@ export default function OpenStackSerial({
       }
 
       ws.onmessage = function (e) {
-        if (e.data instanceof Blob) {
-          const f = new FileReader()
-          f.onload = function () {
-            term?.write(String(f.result))
-          }
-          f.readAsText(e.data)
+        if (e.data instanceof ArrayBuffer) {
+          term?.write(new Uint8Array(e.data))
         } else {
           term?.write(e.data)
         }
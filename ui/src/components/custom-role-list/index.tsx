@@ -0,0 +1,337 @@
+import {
+  AddTwoTone,
+  ClearTwoTone,
+  DeleteTwoTone,
+  EditTwoTone,
+  Save,
+} from '@mui/icons-material'
+import {
+  Autocomplete,
+  Box,
+  Button,
+  Chip,
+  IconButton,
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableRow,
+  TextField,
+  Tooltip,
+  Typography,
+} from '@mui/material'
+import { useSnackbar } from 'notistack'
+import React, { useEffect, useState } from 'react'
+import {
+  CustomRoleInput,
+  Permission,
+  useCreateCustomRoleMutation,
+  useDeleteCustomRoleMutation,
+  useListCustomRolesQuery,
+  useUpdateCustomRoleMutation,
+} from '../../api/generated/graphql'
+
+const emptyCustomRole: CustomRoleInput = {
+  Name: '',
+  Description: '',
+  Permissions: [],
+}
+
+// Displays permissions the way they are stored (eg. VM_READ is "vm:read")
+export const permissionLabel = (permission: Permission): string =>
+  permission
+    .toLowerCase()
+    .replace(/_(read|write|console|power|lockout)$/, ':$1')
+
+export const CustomRoleList: React.FC = (): React.ReactElement => {
+  const {
+    data: listCustomRolesData,
+    error: listCustomRolesError,
+    refetch: refetchCustomRoles,
+  } = useListCustomRolesQuery({
+    fetchPolicy: 'no-cache',
+  })
+  const [
+    createCustomRole,
+    {
+      data: createCustomRoleData,
+      loading: createCustomRoleLoading,
+      error: createCustomRoleError,
+      reset: resetCreateCustomRole,
+    },
+  ] = useCreateCustomRoleMutation()
+  const [
+    updateCustomRole,
+    {
+      data: updateCustomRoleData,
+      loading: updateCustomRoleLoading,
+      error: updateCustomRoleError,
+      reset: resetUpdateCustomRole,
+    },
+  ] = useUpdateCustomRoleMutation()
+  const [
+    deleteCustomRole,
+    {
+      data: deleteCustomRoleData,
+      error: deleteCustomRoleError,
+      reset: resetDeleteCustomRole,
+    },
+  ] = useDeleteCustomRoleMutation()
+  const [customRole, setCustomRole] =
+    useState<CustomRoleInput>(emptyCustomRole)
+  const { enqueueSnackbar } = useSnackbar()
+
+  useEffect(() => {
+    if (listCustomRolesError)
+      enqueueSnackbar(
+        `Failed to list custom roles: ${listCustomRolesError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (createCustomRoleError)
+      enqueueSnackbar(
+        `Failed to create custom role: ${createCustomRoleError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (updateCustomRoleError)
+      enqueueSnackbar(
+        `Failed to update custom role: ${updateCustomRoleError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (deleteCustomRoleError)
+      enqueueSnackbar(
+        `Failed to delete custom role: ${deleteCustomRoleError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+  }, [
+    listCustomRolesError,
+    createCustomRoleError,
+    updateCustomRoleError,
+    deleteCustomRoleError,
+    enqueueSnackbar,
+  ])
+
+  useEffect(() => {
+    if (createCustomRoleData?.createCustomRole) {
+      enqueueSnackbar(
+        `Created custom role "${createCustomRoleData.createCustomRole.Name}"`,
+        {
+          variant: 'success',
+        }
+      )
+      resetCreateCustomRole()
+    } else if (updateCustomRoleData?.updateCustomRole) {
+      enqueueSnackbar(
+        `Updated custom role "${updateCustomRoleData.updateCustomRole.Name}"`,
+        {
+          variant: 'success',
+        }
+      )
+      resetUpdateCustomRole()
+    } else if (deleteCustomRoleData?.deleteCustomRole) {
+      enqueueSnackbar('Deleted custom role', {
+        variant: 'success',
+      })
+      resetDeleteCustomRole()
+    } else return
+    setCustomRole(emptyCustomRole)
+    refetchCustomRoles()
+  }, [
+    createCustomRoleData,
+    updateCustomRoleData,
+    deleteCustomRoleData,
+    resetCreateCustomRole,
+    resetUpdateCustomRole,
+    resetDeleteCustomRole,
+    refetchCustomRoles,
+    enqueueSnackbar,
+  ])
+
+  const handleSaveCustomRole = () => {
+    if (!customRole.Name)
+      return enqueueSnackbar('Custom roles must have a name', {
+        variant: 'warning',
+      })
+    if (customRole.ID)
+      updateCustomRole({
+        variables: {
+          input: customRole,
+        },
+      })
+    else
+      createCustomRole({
+        variables: {
+          input: customRole,
+        },
+      })
+  }
+
+  const handleDeleteCustomRole = (id: string, name: string) => {
+    if (
+      window.confirm(
+        `Are you sure you want to delete "${name}"? Users with this role will only keep the permissions of their built-in role.`
+      )
+    )
+      deleteCustomRole({
+        variables: {
+          id,
+        },
+      })
+  }
+
+  return (
+    <Box>
+      <Typography variant="h6">Custom Roles</Typography>
+      <Typography variant="body2" color="text.secondary">
+        Custom roles grant users permissions on top of their built-in role.
+        You can only grant permissions you have yourself.
+      </Typography>
+      <Box
+        component="form"
+        sx={{
+          display: 'flex',
+          flexWrap: 'wrap',
+          alignItems: 'center',
+          '& .MuiTextField-root': {
+            m: 1,
+            minWidth: '30%',
+            flexGrow: 1,
+          },
+        }}
+        noValidate
+        autoComplete="off"
+      >
+        <TextField
+          label="Name"
+          variant="filled"
+          value={customRole.Name}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+            setCustomRole({
+              ...customRole,
+              Name: e.target.value,
+            })
+          }
+        />
+        <TextField
+          label="Description"
+          variant="filled"
+          value={customRole.Description}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+            setCustomRole({
+              ...customRole,
+              Description: e.target.value,
+            })
+          }
+        />
+        <Autocomplete
+          multiple
+          options={Object.values(Permission)}
+          getOptionLabel={permissionLabel}
+          renderInput={(params) => (
+            <TextField {...params} label="Permissions" variant="filled" />
+          )}
+          onChange={(event, value) =>
+            setCustomRole({ ...customRole, Permissions: value })
+          }
+          value={customRole.Permissions}
+          sx={{
+            width: '100%',
+          }}
+        />
+        <Button
+          type="button"
+          variant="contained"
+          startIcon={customRole.ID ? <Save /> : <AddTwoTone />}
+          sx={{
+            m: 1,
+          }}
+          disabled={createCustomRoleLoading || updateCustomRoleLoading}
+          onClick={handleSaveCustomRole}
+        >
+          {customRole.ID ? 'Save Role' : 'Create Role'}
+        </Button>
+        {customRole.ID && (
+          <Button
+            type="button"
+            variant="outlined"
+            startIcon={<ClearTwoTone />}
+            sx={{
+              m: 1,
+            }}
+            onClick={() => setCustomRole(emptyCustomRole)}
+          >
+            Cancel
+          </Button>
+        )}
+      </Box>
+      <Table size="small">
+        <TableHead>
+          <TableRow>
+            <TableCell>Name</TableCell>
+            <TableCell>Description</TableCell>
+            <TableCell>Permissions</TableCell>
+            <TableCell />
+          </TableRow>
+        </TableHead>
+        <TableBody>
+          {listCustomRolesData?.customRoles.length === 0 && (
+            <TableRow>
+              <TableCell colSpan={4}>
+                <Typography variant="body2" color="text.secondary">
+                  There aren't any custom roles
+                </Typography>
+              </TableCell>
+            </TableRow>
+          )}
+          {listCustomRolesData?.customRoles.map((role) => (
+            <TableRow key={role.ID}>
+              <TableCell>{role.Name}</TableCell>
+              <TableCell>{role.Description}</TableCell>
+              <TableCell>
+                {role.Permissions.map((permission) => (
+                  <Chip
+                    key={permission}
+                    label={permissionLabel(permission)}
+                    size="small"
+                    sx={{ m: 0.25 }}
+                  />
+                ))}
+              </TableCell>
+              <TableCell align="right">
+                <Tooltip title="Edit">
+                  <IconButton
+                    onClick={() =>
+                      setCustomRole({
+                        ID: role.ID,
+                        Name: role.Name,
+                        Description: role.Description,
+                        Permissions: role.Permissions,
+                      })
+                    }
+                  >
+                    <EditTwoTone />
+                  </IconButton>
+                </Tooltip>
+                <Tooltip title="Delete">
+                  <IconButton
+                    color="error"
+                    onClick={() => handleDeleteCustomRole(role.ID, role.Name)}
+                  >
+                    <DeleteTwoTone />
+                  </IconButton>
+                </Tooltip>
+              </TableCell>
+            </TableRow>
+          ))}
+        </TableBody>
+      </Table>
+    </Box>
+  )
+}
@@ -49,6 +49,23 @@ const createUserData = (user: ListUsersQuery['users'][0]): UserListData => {
   }
 }
 
+const roleColor = (
+  role: Role
+): 'error' | 'warning' | 'secondary' | 'info' | 'default' => {
+  switch (role) {
+    case Role.Admin:
+      return 'error'
+    case Role.BlackTeam:
+      return 'secondary'
+    case Role.WhiteTeam:
+      return 'default'
+    case Role.RedTeam:
+      return 'info'
+    default:
+      return 'warning'
+  }
+}
+
 const headCells: readonly EnhancedHeadCell<UserListData>[] = [
   {
     id: 'id',
@@ -200,7 +217,7 @@ export const UserList: React.FC<{
                 <TableCell align="center">
                   <Chip
                     label={row.role}
-                    color={row.role === Role.Admin ? 'error' : 'warning'}
+                    color={roleColor(row.role)}
                     size="small"
                   />
                 </TableCell>
@@ -17,6 +17,7 @@ import {
 import { UserContext } from '../../user-context'
 import { useSnackbar } from 'notistack'
 import { LockResetTwoTone, Save } from '@mui/icons-material'
+import { PersonalAccessTokens } from './personal-access-tokens'
 
 export const Account: React.FC = (): React.ReactElement => {
   const { user, refetchUser } = useContext(UserContext)
@@ -42,6 +43,7 @@ export const Account: React.FC = (): React.ReactElement => {
       reset: resetChangeSelfPassword,
     },
   ] = useChangeSelfPasswordMutation()
+  const [currentPassword, setCurrentPassword] = useState<string>('')
   const [password, setPassword] = useState<string>('')
   const [confirmPassword, setConfirmPassword] = useState<string>('')
   const { enqueueSnackbar } = useSnackbar()
@@ -75,18 +77,22 @@ export const Account: React.FC = (): React.ReactElement => {
       refetchUser()
     }
     if (changeSelfPasswordData?.changeSelfPassword) {
+      setCurrentPassword('')
       setPassword('')
       setConfirmPassword('')
       enqueueSnackbar('Updated account password', {
         variant: 'success',
       })
       resetChangeSelfPassword()
+      // Clears MustChangePassword so the rest of the app is unlocked
+      refetchUser()
     }
   }, [
     updateAccountData,
     changeSelfPasswordData,
     setAccount,
     resetUpdateAccount,
+    setCurrentPassword,
     setPassword,
     setConfirmPassword,
     resetChangeSelfPassword,
@@ -109,6 +115,7 @@ export const Account: React.FC = (): React.ReactElement => {
       })
     changeSelfPassword({
       variables: {
+        currentPassword,
         newPassword: password,
       },
     })
@@ -180,6 +187,15 @@ export const Account: React.FC = (): React.ReactElement => {
         noValidate
         autoComplete="off"
       >
+        <TextField
+          label="Current Password"
+          type="password"
+          variant="filled"
+          value={currentPassword}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+            setCurrentPassword(e.target.value)
+          }
+        />
         <TextField
           label="New Password"
           type="password"
@@ -210,6 +226,12 @@ export const Account: React.FC = (): React.ReactElement => {
           Change Password
         </Button>
       </Box>
+      <Divider
+        sx={{
+          my: 2,
+        }}
+      />
+      <PersonalAccessTokens />
 
       <Box
         sx={{
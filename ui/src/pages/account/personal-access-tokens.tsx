@@ -0,0 +1,328 @@
+import { AddTwoTone, CheckTwoTone, DeleteTwoTone } from '@mui/icons-material'
+import {
+  Box,
+  Button,
+  IconButton,
+  Modal,
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableRow,
+  TextField,
+  ToggleButton,
+  ToggleButtonGroup,
+  Tooltip,
+  Typography,
+} from '@mui/material'
+import { useSnackbar } from 'notistack'
+import React, { useEffect, useState } from 'react'
+import {
+  PersonalAccessTokenInput,
+  PersonalAccessTokenScope,
+  useCreatePersonalAccessTokenMutation,
+  useListMyPersonalAccessTokensQuery,
+  useRevokePersonalAccessTokenMutation,
+} from '../../api/generated/graphql'
+
+const emptyPersonalAccessToken: PersonalAccessTokenInput = {
+  Name: '',
+  Scope: PersonalAccessTokenScope.ReadOnly,
+  ExpiresAt: null,
+}
+
+export const PersonalAccessTokens: React.FC = (): React.ReactElement => {
+  const {
+    data: listMyPersonalAccessTokensData,
+    error: listMyPersonalAccessTokensError,
+    refetch: refetchMyPersonalAccessTokens,
+  } = useListMyPersonalAccessTokensQuery({
+    fetchPolicy: 'no-cache',
+  })
+  const [
+    createPersonalAccessToken,
+    {
+      data: createPersonalAccessTokenData,
+      loading: createPersonalAccessTokenLoading,
+      error: createPersonalAccessTokenError,
+      reset: resetCreatePersonalAccessToken,
+    },
+  ] = useCreatePersonalAccessTokenMutation()
+  const [
+    revokePersonalAccessToken,
+    {
+      data: revokePersonalAccessTokenData,
+      error: revokePersonalAccessTokenError,
+      reset: resetRevokePersonalAccessToken,
+    },
+  ] = useRevokePersonalAccessTokenMutation()
+  const [personalAccessToken, setPersonalAccessToken] =
+    useState<PersonalAccessTokenInput>(emptyPersonalAccessToken)
+  // datetime-local inputs take the local time without a timezone
+  const [expiresAt, setExpiresAt] = useState<string>('')
+  const { enqueueSnackbar } = useSnackbar()
+
+  useEffect(() => {
+    if (listMyPersonalAccessTokensError)
+      enqueueSnackbar(
+        `Failed to list access tokens: ${listMyPersonalAccessTokensError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (createPersonalAccessTokenError)
+      enqueueSnackbar(
+        `Failed to create access token: ${createPersonalAccessTokenError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (revokePersonalAccessTokenError)
+      enqueueSnackbar(
+        `Failed to revoke access token: ${revokePersonalAccessTokenError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+  }, [
+    listMyPersonalAccessTokensError,
+    createPersonalAccessTokenError,
+    revokePersonalAccessTokenError,
+    enqueueSnackbar,
+  ])
+
+  useEffect(() => {
+    if (revokePersonalAccessTokenData?.revokePersonalAccessToken) {
+      enqueueSnackbar('Revoked access token', {
+        variant: 'success',
+      })
+      resetRevokePersonalAccessToken()
+      refetchMyPersonalAccessTokens()
+    }
+  }, [
+    revokePersonalAccessTokenData,
+    resetRevokePersonalAccessToken,
+    refetchMyPersonalAccessTokens,
+    enqueueSnackbar,
+  ])
+
+  const handleCreatePersonalAccessToken = () => {
+    if (!personalAccessToken.Name)
+      return enqueueSnackbar('Access tokens must have a name', {
+        variant: 'warning',
+      })
+    createPersonalAccessToken({
+      variables: {
+        input: personalAccessToken,
+      },
+    })
+  }
+
+  const handleRevokePersonalAccessToken = (id: string, name: string) => {
+    if (window.confirm(`Are you sure you want to revoke "${name}"?`))
+      revokePersonalAccessToken({
+        variables: {
+          id,
+        },
+      })
+  }
+
+  const closeCreatedModal = () => {
+    setPersonalAccessToken(emptyPersonalAccessToken)
+    setExpiresAt('')
+    resetCreatePersonalAccessToken()
+    refetchMyPersonalAccessTokens()
+  }
+
+  return (
+    <Box>
+      <Modal open={!!createPersonalAccessTokenData?.createPersonalAccessToken}>
+        <Box
+          sx={{
+            position: 'absolute',
+            top: '50%',
+            left: '50%',
+            transform: 'translate(-50%, -50%)',
+            width: '50%',
+            bgcolor: '#2a273f',
+            borderRadius: 2,
+            boxShadow: '0 0 2rem #000',
+            p: 4,
+          }}
+        >
+          <Typography variant="body1" component="h2">
+            Please copy this token down.{' '}
+            <b>This is the only time you will see it!</b>
+          </Typography>
+          <TextField
+            InputProps={{
+              readOnly: true,
+            }}
+            label="Access Token"
+            variant="filled"
+            value={
+              createPersonalAccessTokenData?.createPersonalAccessToken.Token ??
+              ''
+            }
+            sx={{
+              width: '100%',
+              mt: 1,
+            }}
+          />
+          <Typography variant="caption" component="p" sx={{ mt: 1 }}>
+            Send it as <code>Authorization: Bearer &lt;token&gt;</code> to{' '}
+            <code>/api/graphql/query</code>
+          </Typography>
+          <Button
+            type="button"
+            variant="contained"
+            startIcon={<CheckTwoTone />}
+            sx={{ width: '100%', mt: 2 }}
+            onClick={closeCreatedModal}
+          >
+            I Copied It
+          </Button>
+        </Box>
+      </Modal>
+      <Typography variant="h6">Personal Access Tokens</Typography>
+      <Box
+        component="form"
+        sx={{
+          display: 'flex',
+          flexWrap: 'wrap',
+          alignItems: 'center',
+          '& .MuiTextField-root': {
+            m: 1,
+            minWidth: '30%',
+            flexGrow: 1,
+          },
+        }}
+        noValidate
+        autoComplete="off"
+      >
+        <TextField
+          label="Name"
+          variant="filled"
+          value={personalAccessToken.Name}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+            setPersonalAccessToken({
+              ...personalAccessToken,
+              Name: e.target.value,
+            })
+          }
+        />
+        <TextField
+          label="Expires At"
+          type="datetime-local"
+          variant="filled"
+          InputLabelProps={{
+            shrink: true,
+          }}
+          helperText="Leave empty for a token which never expires"
+          value={expiresAt}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) => {
+            setExpiresAt(e.target.value)
+            setPersonalAccessToken({
+              ...personalAccessToken,
+              ExpiresAt: e.target.value
+                ? new Date(e.target.value).toISOString()
+                : null,
+            })
+          }}
+        />
+        <ToggleButtonGroup
+          color="primary"
+          exclusive
+          value={personalAccessToken.Scope}
+          onChange={(e, value: PersonalAccessTokenScope | null) => {
+            if (value)
+              setPersonalAccessToken({ ...personalAccessToken, Scope: value })
+          }}
+          sx={{ m: 1 }}
+        >
+          <ToggleButton value={PersonalAccessTokenScope.ReadOnly}>
+            Read Only
+          </ToggleButton>
+          <ToggleButton value={PersonalAccessTokenScope.Full}>
+            Full
+          </ToggleButton>
+        </ToggleButtonGroup>
+        <Button
+          type="button"
+          variant="contained"
+          startIcon={<AddTwoTone />}
+          sx={{
+            m: 1,
+          }}
+          disabled={createPersonalAccessTokenLoading}
+          onClick={handleCreatePersonalAccessToken}
+        >
+          Create Token
+        </Button>
+      </Box>
+      <Table size="small">
+        <TableHead>
+          <TableRow>
+            <TableCell>Name</TableCell>
+            <TableCell>Token</TableCell>
+            <TableCell>Scope</TableCell>
+            <TableCell>Expires</TableCell>
+            <TableCell>Last Used</TableCell>
+            <TableCell />
+          </TableRow>
+        </TableHead>
+        <TableBody>
+          {listMyPersonalAccessTokensData?.myPersonalAccessTokens.length ===
+            0 && (
+            <TableRow>
+              <TableCell colSpan={6}>
+                <Typography variant="body2" color="text.secondary">
+                  You don't have any access tokens
+                </Typography>
+              </TableCell>
+            </TableRow>
+          )}
+          {listMyPersonalAccessTokensData?.myPersonalAccessTokens.map(
+            (token) => (
+              <TableRow key={token.ID}>
+                <TableCell>{token.Name}</TableCell>
+                <TableCell>
+                  <code>{token.Prefix}...</code>
+                </TableCell>
+                <TableCell>
+                  {token.Scope === PersonalAccessTokenScope.ReadOnly
+                    ? 'Read Only'
+                    : 'Full'}
+                </TableCell>
+                <TableCell>
+                  {token.ExpiresAt
+                    ? new Date(token.ExpiresAt).toLocaleString()
+                    : 'Never'}
+                </TableCell>
+                <TableCell>
+                  {token.LastUsedAt
+                    ? `${new Date(token.LastUsedAt).toLocaleString()} (${
+                        token.LastUsedIp
+                      })`
+                    : 'Never'}
+                </TableCell>
+                <TableCell align="right">
+                  <Tooltip title="Revoke">
+                    <IconButton
+                      color="error"
+                      onClick={() =>
+                        handleRevokePersonalAccessToken(token.ID, token.Name)
+                      }
+                    >
+                      <DeleteTwoTone />
+                    </IconButton>
+                  </Tooltip>
+                </TableCell>
+              </TableRow>
+            )
+          )}
+        </TableBody>
+      </Table>
+    </Box>
+  )
+}
@@ -1,5 +1,7 @@
 import {
+  AddTwoTone,
   ArrowBackTwoTone,
+  DeleteTwoTone,
   LockOpenTwoTone,
   LockTwoTone,
   Save,
@@ -16,6 +18,7 @@ import {
   Button,
   ButtonGroup,
   Box,
+  IconButton,
 } from '@mui/material'
 import { useSnackbar } from 'notistack'
 import React, { useEffect, useState } from 'react'
@@ -28,8 +31,22 @@ import {
   ListProvidersQuery,
   useCreateCompetitionMutation,
   useLockoutCompetitionMutation,
+  CompetitionScheduleInput,
+  useSetCompetitionScheduleMutation,
 } from '../../api/generated/graphql'
 
+// datetime-local inputs take the local time without a timezone
+const toDateTimeLocal = (time: string | null | undefined): string => {
+  if (!time) return ''
+  const date = new Date(time)
+  return new Date(date.getTime() - date.getTimezoneOffset() * 60000)
+    .toISOString()
+    .slice(0, 16)
+}
+
+const fromDateTimeLocal = (value: string): string | null =>
+  value ? new Date(value).toISOString() : null
+
 export const CompetitionForm: React.FC = (): React.ReactElement => {
   const { id } = useParams()
   const [
@@ -68,11 +85,25 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
       error: lockoutCompetitionError,
     },
   ] = useLockoutCompetitionMutation()
+  const [
+    setCompetitionSchedule,
+    {
+      data: setCompetitionScheduleData,
+      loading: setCompetitionScheduleLoading,
+      error: setCompetitionScheduleError,
+    },
+  ] = useSetCompetitionScheduleMutation()
   const [competition, setCompetition] = useState<CompetitionInput>({
     ID: '',
     Name: '',
+    AllowedCidrs: [],
     CompetitionToProvider: '',
   })
+  const [schedule, setSchedule] = useState<CompetitionScheduleInput>({
+    StartsAt: null,
+    EndsAt: null,
+    Breaks: [],
+  })
   const [viewProvider, setViewProvider] = useState<
     ListProvidersQuery['providers'][0] | null
   >(null)
@@ -159,11 +190,14 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
 
   useEffect(() => {
     if (getCompetitionData) {
+      // GetCompetition also returns the schedule, which isn't part of CompetitionInput
       setCompetition({
-        ...getCompetitionData.getCompetition,
+        ID: getCompetitionData.getCompetition.ID,
+        Name: getCompetitionData.getCompetition.Name,
+        AllowedCidrs: getCompetitionData.getCompetition.AllowedCidrs,
         CompetitionToProvider:
           getCompetitionData.getCompetition.CompetitionToProvider.ID,
-      } as CompetitionInput)
+      })
       if (listProvidersData)
         setViewProvider(
           listProvidersData.providers.find(
@@ -176,10 +210,40 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
       setCompetition({
         ID: '',
         Name: '',
+        AllowedCidrs: [],
         CompetitionToProvider: '',
       })
   }, [getCompetitionData, listProvidersData])
 
+  useEffect(() => {
+    if (getCompetitionData)
+      setSchedule({
+        StartsAt: getCompetitionData.getCompetition.StartsAt,
+        EndsAt: getCompetitionData.getCompetition.EndsAt,
+        Breaks: getCompetitionData.getCompetition.CompetitionToBreaks.map(
+          (competitionBreak) => ({
+            Name: competitionBreak.Name,
+            StartsAt: competitionBreak.StartsAt,
+            EndsAt: competitionBreak.EndsAt,
+          })
+        ),
+      })
+  }, [getCompetitionData])
+
+  useEffect(() => {
+    if (setCompetitionScheduleError)
+      enqueueSnackbar(
+        `Failed to update competition schedule: ${setCompetitionScheduleError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    else if (setCompetitionScheduleData)
+      enqueueSnackbar('Competition schedule updated', {
+        variant: 'success',
+      })
+  }, [setCompetitionScheduleData, setCompetitionScheduleError, enqueueSnackbar])
+
   useEffect(() => {
     if (lockoutCompetitionError)
       enqueueSnackbar(
@@ -209,6 +273,33 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
       })
   }
 
+  const submitSchedule = () => {
+    if (!id) return
+    if (schedule.Breaks.some((b) => !b.StartsAt || !b.EndsAt)) {
+      enqueueSnackbar('Breaks must have a start and an end', {
+        variant: 'warning',
+      })
+      return
+    }
+    setCompetitionSchedule({
+      variables: {
+        competitionId: id,
+        schedule,
+      },
+    })
+  }
+
+  const setBreak = (
+    index: number,
+    value: Partial<CompetitionScheduleInput['Breaks'][0]>
+  ) =>
+    setSchedule({
+      ...schedule,
+      Breaks: schedule.Breaks.map((b, i) =>
+        i === index ? { ...b, ...value } : b
+      ),
+    })
+
   return (
     <Container component="main" sx={{ p: 2 }}>
       {id && (getCompetitionLoading || getCompetitionError) ? (
@@ -296,6 +387,35 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
             },
           }}
         />
+        <Autocomplete
+          freeSolo
+          multiple
+          options={[]}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Allowed Networks"
+              helperText="Networks in CIDR notation (eg. 10.0.1.0/24). Leave empty to allow any network."
+            />
+          )}
+          onChange={(event, value) => {
+            setCompetition({
+              ...competition,
+              AllowedCidrs: value as string[],
+            })
+          }}
+          value={competition.AllowedCidrs ?? []}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
       </Box>
       {id && (
         <Box
@@ -365,6 +485,142 @@ export const CompetitionForm: React.FC = (): React.ReactElement => {
           </ButtonGroup>
         </Box>
       )}
+      {id && (
+        <>
+          <Divider
+            sx={{
+              my: 2,
+            }}
+          />
+          <Typography variant="h5" sx={{ mx: 1 }}>
+            Schedule
+          </Typography>
+          <Typography variant="body2" sx={{ mx: 1, color: 'text.secondary' }}>
+            VMs are locked before the start, after the end and during breaks,
+            and competitors can&apos;t sign in while the competition is closed.
+            Leave the start or end empty for a competition without one.
+          </Typography>
+          <Box
+            component="form"
+            sx={{
+              display: 'flex',
+              flexWrap: 'wrap',
+              alignItems: 'center',
+              '& .MuiTextField-root': {
+                m: 1,
+                minWidth: '25%',
+                flexGrow: 1,
+              },
+            }}
+            noValidate
+            autoComplete="off"
+          >
+            <TextField
+              label="Starts At"
+              variant="filled"
+              type="datetime-local"
+              InputLabelProps={{ shrink: true }}
+              value={toDateTimeLocal(schedule.StartsAt)}
+              onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                setSchedule({
+                  ...schedule,
+                  StartsAt: fromDateTimeLocal(e.target.value),
+                })
+              }
+            />
+            <TextField
+              label="Ends At"
+              variant="filled"
+              type="datetime-local"
+              InputLabelProps={{ shrink: true }}
+              value={toDateTimeLocal(schedule.EndsAt)}
+              onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                setSchedule({
+                  ...schedule,
+                  EndsAt: fromDateTimeLocal(e.target.value),
+                })
+              }
+            />
+            {schedule.Breaks.map((competitionBreak, i) => (
+              <Box
+                key={i}
+                sx={{ display: 'flex', alignItems: 'center', width: '100%' }}
+              >
+                <TextField
+                  label="Break Name"
+                  variant="filled"
+                  value={competitionBreak.Name ?? ''}
+                  onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                    setBreak(i, { Name: e.target.value })
+                  }
+                />
+                <TextField
+                  label="Break Starts At"
+                  variant="filled"
+                  type="datetime-local"
+                  InputLabelProps={{ shrink: true }}
+                  value={toDateTimeLocal(competitionBreak.StartsAt)}
+                  onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                    setBreak(i, {
+                      StartsAt: fromDateTimeLocal(e.target.value),
+                    })
+                  }
+                />
+                <TextField
+                  label="Break Ends At"
+                  variant="filled"
+                  type="datetime-local"
+                  InputLabelProps={{ shrink: true }}
+                  value={toDateTimeLocal(competitionBreak.EndsAt)}
+                  onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                    setBreak(i, {
+                      EndsAt: fromDateTimeLocal(e.target.value),
+                    })
+                  }
+                />
+                <IconButton
+                  aria-label="remove break"
+                  color="error"
+                  onClick={() =>
+                    setSchedule({
+                      ...schedule,
+                      Breaks: schedule.Breaks.filter((_, j) => j !== i),
+                    })
+                  }
+                >
+                  <DeleteTwoTone />
+                </IconButton>
+              </Box>
+            ))}
+          </Box>
+          <Box sx={{ display: 'flex', m: 1 }}>
+            <Button
+              variant="outlined"
+              startIcon={<AddTwoTone />}
+              onClick={() =>
+                setSchedule({
+                  ...schedule,
+                  Breaks: [
+                    ...schedule.Breaks,
+                    { Name: '', StartsAt: null, EndsAt: null },
+                  ],
+                })
+              }
+            >
+              Add Break
+            </Button>
+            <Button
+              variant="contained"
+              color="secondary"
+              sx={{ ml: 'auto' }}
+              disabled={setCompetitionScheduleLoading}
+              onClick={submitSchedule}
+            >
+              Save Schedule
+            </Button>
+          </Box>
+        </>
+      )}
       <Box
         sx={{
           position: 'fixed',
@@ -10,6 +10,7 @@ import {
   Factory,
   Lock,
   Engineering,
+  AdminPanelSettings,
 } from '@mui/icons-material'
 import {
   Box,
@@ -31,7 +32,7 @@ import {
 import React, { useContext, useEffect, useState } from 'react'
 import { Outlet, useLocation, useNavigate } from 'react-router-dom'
 import { Role } from '../../api/generated/graphql'
-import { UserContext } from '../../user-context'
+import { isCompetitionAdmin, UserContext } from '../../user-context'
 import { IngestVMs } from '../../components/ingest-vms'
 import { UserList } from '../../components/user-list'
 import { CompetitionList } from '../../components/competition-list'
@@ -41,6 +42,7 @@ import { ProviderList } from '../../components/provider-list'
 import { GenerateUsers } from '../../components/generate-users'
 import { LockoutForm } from '../../components/lockout-form'
 import { ServiceAccountList } from '../../components/service-account-list'
+import { CustomRoleList } from '../../components/custom-role-list'
 
 interface TabPanelProps {
   children?: React.ReactNode
@@ -142,6 +144,9 @@ const DeleteObjectModal: React.FC<DeleteObjectModalProps> = ({
 }
 
 export const AdminProtected: React.FC = (): React.ReactElement => {
+  const { user } = useContext(UserContext)
+  // Competition admins can only manage the competitions they administer
+  const isAdmin = user.Role === Role.Admin
   const [selectedTab, setSelectedTab] = React.useState(0)
   const [deleteModalData, setDeleteModalData] = useState<{
     objectName: string
@@ -274,42 +279,48 @@ export const AdminProtected: React.FC = (): React.ReactElement => {
                 <ListItemText primary="VM Objects" />
               </ListItemButton>
             </ListItem>
-            <ListItem disablePadding>
-              <ListItemButton
-                onClick={() => handleTabChange(4)}
-                selected={selectedTab === 4}
-              >
-                <ListItemIcon>
-                  <Link />
-                </ListItemIcon>
-                <ListItemText primary="Providers" />
-              </ListItemButton>
-            </ListItem>
-            <ListItem disablePadding>
-              <ListItemButton
-                onClick={() => handleTabChange(5)}
-                selected={selectedTab === 5}
-              >
-                <ListItemIcon>
-                  <Engineering />
-                </ListItemIcon>
-                <ListItemText primary="Service Accounts" />
-              </ListItemButton>
-            </ListItem>
+            {isAdmin && (
+              <ListItem disablePadding>
+                <ListItemButton
+                  onClick={() => handleTabChange(4)}
+                  selected={selectedTab === 4}
+                >
+                  <ListItemIcon>
+                    <Link />
+                  </ListItemIcon>
+                  <ListItemText primary="Providers" />
+                </ListItemButton>
+              </ListItem>
+            )}
+            {isAdmin && (
+              <ListItem disablePadding>
+                <ListItemButton
+                  onClick={() => handleTabChange(5)}
+                  selected={selectedTab === 5}
+                >
+                  <ListItemIcon>
+                    <Engineering />
+                  </ListItemIcon>
+                  <ListItemText primary="Service Accounts" />
+                </ListItemButton>
+              </ListItem>
+            )}
           </List>
           <Divider />
           <List>
-            <ListItem disablePadding>
-              <ListItemButton
-                onClick={() => handleTabChange(6)}
-                selected={selectedTab === 6}
-              >
-                <ListItemIcon>
-                  <ImportExport />
-                </ListItemIcon>
-                <ListItemText primary="Ingest VMs" />
-              </ListItemButton>
-            </ListItem>
+            {isAdmin && (
+              <ListItem disablePadding>
+                <ListItemButton
+                  onClick={() => handleTabChange(6)}
+                  selected={selectedTab === 6}
+                >
+                  <ListItemIcon>
+                    <ImportExport />
+                  </ListItemIcon>
+                  <ListItemText primary="Ingest VMs" />
+                </ListItemButton>
+              </ListItem>
+            )}
             <ListItem disablePadding>
               <ListItemButton
                 onClick={() => handleTabChange(7)}
@@ -332,6 +343,19 @@ export const AdminProtected: React.FC = (): React.ReactElement => {
                 <ListItemText primary="Manage Lockouts" />
               </ListItemButton>
             </ListItem>
+            {isAdmin && (
+              <ListItem disablePadding>
+                <ListItemButton
+                  onClick={() => handleTabChange(9)}
+                  selected={selectedTab === 9}
+                >
+                  <ListItemIcon>
+                    <AdminPanelSettings />
+                  </ListItemIcon>
+                  <ListItemText primary="Custom Roles" />
+                </ListItemButton>
+              </ListItem>
+            )}
           </List>
         </Box>
       </Drawer>
@@ -381,7 +405,10 @@ export const AdminProtected: React.FC = (): React.ReactElement => {
         <TabPanel value={selectedTab} index={8}>
           <LockoutForm />
         </TabPanel>
-        {selectedTab < 6 && (
+        <TabPanel value={selectedTab} index={9}>
+          <CustomRoleList />
+        </TabPanel>
+        {selectedTab < 6 && (isAdmin || selectedTab !== 1) && (
           <Fab
             sx={{
               position: 'fixed',
@@ -405,7 +432,7 @@ export const Admin: React.FC = (): React.ReactElement => {
   const { user } = useContext(UserContext)
   return (
     <React.Fragment>
-      {user && user.Role === Role.Admin ? (
+      {user.Role === Role.Admin || isCompetitionAdmin(user) ? (
         <Outlet />
       ) : (
         <Container
@@ -4,6 +4,7 @@ import {
   PersonOffTwoTone,
   PersonTwoTone,
   Save,
+  SyncLockTwoTone,
 } from '@mui/icons-material'
 import {
   Container,
@@ -18,6 +19,7 @@ import {
   ToggleButtonGroup,
   Modal,
   Box,
+  Autocomplete,
 } from '@mui/material'
 import { useSnackbar } from 'notistack'
 import React, { useEffect, useState } from 'react'
@@ -28,8 +30,21 @@ import {
   useCreateServiceAccountMutation,
   ServiceAccountInput,
   GetServiceAccountQuery,
+  ServiceAccountScope,
+  useListCompetitionsQuery,
+  useListTeamsQuery,
+  useRotateServiceAccountSecretMutation,
 } from '../../api/generated/graphql'
 
+// datetime-local inputs take the local time without a timezone
+const toDateTimeLocal = (time: string | null | undefined): string => {
+  if (!time) return ''
+  const date = new Date(time)
+  return new Date(date.getTime() - date.getTimezoneOffset() * 60000)
+    .toISOString()
+    .slice(0, 16)
+}
+
 export const ServiceAccountForm: React.FC = (): React.ReactElement => {
   const { id } = useParams()
   // Queries
@@ -60,12 +75,32 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
       reset: resetCreateServiceAccount,
     },
   ] = useCreateServiceAccountMutation()
+  const [
+    rotateServiceAccountSecret,
+    {
+      data: rotateServiceAccountSecretData,
+      loading: rotateServiceAccountSecretLoading,
+      error: rotateServiceAccountSecretError,
+      reset: resetRotateServiceAccountSecret,
+    },
+  ] = useRotateServiceAccountSecretMutation()
+  const { data: listCompetitionsData, error: listCompetitionsError } =
+    useListCompetitionsQuery({
+      fetchPolicy: 'no-cache',
+    })
+  const { data: listTeamsData, error: listTeamsError } = useListTeamsQuery({
+    fetchPolicy: 'no-cache',
+  })
   // State
   const [serviceAccount, setServiceAccount] = useState<ServiceAccountInput>({
     ID: '',
     DisplayName: '',
     Active: true,
+    Scopes: Object.values(ServiceAccountScope),
+    ServiceAccountToCompetitions: [],
+    ServiceAccountToTeams: [],
   })
+  const [overlapMinutes, setOverlapMinutes] = useState<number>(0)
   const [showCreatedModal, setShowCreatedModal] = useState<boolean>(false)
   const [timeTillDismissShowModal, setTimeTillDismissShowModal] =
     useState<number>(0)
@@ -106,11 +141,23 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
       //   1000
       // );
     }
+    if (!rotateServiceAccountSecretLoading && rotateServiceAccountSecretData) {
+      enqueueSnackbar(
+        `Rotated secret for service account "${rotateServiceAccountSecretData.rotateServiceAccountSecret.DisplayName}"`,
+        {
+          variant: 'success',
+        }
+      )
+      setShowCreatedModal(true)
+      setTimeTillDismissShowModal(5)
+    }
   }, [
     updateServiceAccountData,
     updateServiceAccountLoading,
     createServiceAccountData,
     createServiceAccountLoading,
+    rotateServiceAccountSecretData,
+    rotateServiceAccountSecretLoading,
     enqueueSnackbar,
     navigate,
   ])
@@ -137,23 +184,56 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
           variant: 'error',
         }
       )
+    if (rotateServiceAccountSecretError)
+      enqueueSnackbar(
+        `Failed to rotate service account secret: ${rotateServiceAccountSecretError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (listCompetitionsError)
+      enqueueSnackbar(
+        `Failed to list competitions: ${listCompetitionsError.message}`,
+        {
+          variant: 'error',
+        }
+      )
+    if (listTeamsError)
+      enqueueSnackbar(`Failed to list teams: ${listTeamsError.message}`, {
+        variant: 'error',
+      })
   }, [
     getServiceAccountError,
     updateServiceAccountError,
     createServiceAccountError,
+    rotateServiceAccountSecretError,
+    listCompetitionsError,
+    listTeamsError,
     enqueueSnackbar,
   ])
 
   useEffect(() => {
     if (getServiceAccountData) {
+      const {
+        ServiceAccountToCompetitions,
+        ServiceAccountToTeams,
+        ...existingServiceAccount
+      } = getServiceAccountData.getServiceAccount
       setServiceAccount({
-        ...getServiceAccountData.getServiceAccount,
+        ...existingServiceAccount,
+        ServiceAccountToCompetitions: ServiceAccountToCompetitions.map(
+          (c) => c.ID
+        ),
+        ServiceAccountToTeams: ServiceAccountToTeams.map((t) => t.ID),
       } as ServiceAccountInput)
     } else
       setServiceAccount({
         ID: '',
         DisplayName: '',
         Active: true,
+        Scopes: Object.values(ServiceAccountScope),
+        ServiceAccountToCompetitions: [],
+        ServiceAccountToTeams: [],
       })
   }, [getServiceAccountData])
 
@@ -182,6 +262,11 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
             ID: serviceAccount.ID,
             DisplayName: serviceAccount.DisplayName,
             Active: serviceAccount.Active,
+            ExpiresAt: serviceAccount.ExpiresAt ?? null,
+            Scopes: serviceAccount.Scopes,
+            ServiceAccountToCompetitions:
+              serviceAccount.ServiceAccountToCompetitions,
+            ServiceAccountToTeams: serviceAccount.ServiceAccountToTeams,
           },
         },
       })
@@ -194,7 +279,15 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
   }
 
   const closeCreatedModal = () => {
-    if (createServiceAccountData?.createServiceAccount) {
+    if (rotateServiceAccountSecretData?.rotateServiceAccountSecret && id) {
+      setShowCreatedModal(false)
+      resetRotateServiceAccountSecret()
+      getServiceAccount({
+        variables: {
+          id,
+        },
+      })
+    } else if (createServiceAccountData?.createServiceAccount) {
       setShowCreatedModal(false)
       resetCreateServiceAccount()
       navigate(
@@ -237,8 +330,10 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
               label="API Key"
               variant="filled"
               value={
-                createServiceAccountData?.createServiceAccount.ApiKey ??
-                'xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx'
+                (
+                  createServiceAccountData?.createServiceAccount ??
+                  rotateServiceAccountSecretData?.rotateServiceAccountSecret
+                )?.ApiKey ?? 'xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx'
               }
               sx={{
                 width: '100%',
@@ -253,8 +348,10 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
               label="API Secret"
               variant="filled"
               value={
-                createServiceAccountData?.createServiceAccount.ApiSecret ??
-                'xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx'
+                (
+                  createServiceAccountData?.createServiceAccount ??
+                  rotateServiceAccountSecretData?.rotateServiceAccountSecret
+                )?.ApiSecret ?? 'xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx'
               }
               sx={{
                 width: '100%',
@@ -375,6 +472,176 @@ export const ServiceAccountForm: React.FC = (): React.ReactElement => {
               }
             />
           )}
+        <TextField
+          label="Expires At"
+          variant="filled"
+          type="datetime-local"
+          InputLabelProps={{ shrink: true }}
+          helperText="Leave empty for a service account which never expires"
+          value={toDateTimeLocal(serviceAccount.ExpiresAt)}
+          onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+            setServiceAccount({
+              ...serviceAccount,
+              ExpiresAt: e.target.value
+                ? new Date(e.target.value).toISOString()
+                : null,
+            })
+          }
+        />
+        {id && getServiceAccountData && (
+          <TextField
+            InputProps={{
+              readOnly: true,
+            }}
+            label="Last Used"
+            variant="filled"
+            value={
+              getServiceAccountData.getServiceAccount.LastUsedAt
+                ? `${new Date(
+                    getServiceAccountData.getServiceAccount.LastUsedAt
+                  ).toLocaleString()} from ${
+                    getServiceAccountData.getServiceAccount.LastUsedIp
+                  }`
+                : 'Never'
+            }
+          />
+        )}
+        {id && (
+          <Box
+            sx={{
+              display: 'flex',
+              alignItems: 'center',
+              flexGrow: 1,
+              minWidth: '40%',
+            }}
+          >
+            <TextField
+              label="Overlap (minutes)"
+              variant="filled"
+              type="number"
+              helperText={
+                getServiceAccountData?.getServiceAccount
+                  .PreviousApiSecretExpiresAt &&
+                new Date(
+                  getServiceAccountData.getServiceAccount.PreviousApiSecretExpiresAt
+                ) > new Date()
+                  ? `The previous secret works until ${new Date(
+                      getServiceAccountData.getServiceAccount.PreviousApiSecretExpiresAt
+                    ).toLocaleString()}`
+                  : 'How long the current secret keeps working after rotating'
+              }
+              value={overlapMinutes}
+              onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                setOverlapMinutes(Math.max(0, parseInt(e.target.value) || 0))
+              }
+            />
+            <Button
+              variant="outlined"
+              color="warning"
+              startIcon={<SyncLockTwoTone />}
+              disabled={rotateServiceAccountSecretLoading}
+              onClick={() =>
+                rotateServiceAccountSecret({
+                  variables: {
+                    id,
+                    overlapMinutes,
+                  },
+                })
+              }
+            >
+              Rotate Secret
+            </Button>
+          </Box>
+        )}
+        <Autocomplete
+          multiple
+          options={Object.values(ServiceAccountScope)}
+          getOptionLabel={(scope) => scope.toLowerCase().replace('_', ':')}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Scopes"
+              variant="filled"
+              helperText="The REST endpoints this service account can use"
+            />
+          )}
+          onChange={(event, value) =>
+            setServiceAccount({ ...serviceAccount, Scopes: value })
+          }
+          value={serviceAccount.Scopes ?? []}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
+        <Autocomplete
+          multiple
+          options={listCompetitionsData?.competitions ?? []}
+          getOptionLabel={(c) => c.Name}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Limit to Competitions"
+              variant="filled"
+              helperText="Leave competitions and teams empty to allow access to everything"
+            />
+          )}
+          onChange={(event, value) =>
+            setServiceAccount({
+              ...serviceAccount,
+              ServiceAccountToCompetitions: value.map((c) => c.ID),
+            })
+          }
+          isOptionEqualToValue={(option, value) => option.ID === value.ID}
+          value={(listCompetitionsData?.competitions ?? []).filter((c) =>
+            serviceAccount.ServiceAccountToCompetitions?.includes(c.ID)
+          )}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
+        <Autocomplete
+          multiple
+          options={listTeamsData?.teams ?? []}
+          groupBy={(t) => t.TeamToCompetition.Name}
+          getOptionLabel={(t) => t.Name ?? `Team ${t.TeamNumber}`}
+          renderInput={(params) => (
+            <TextField {...params} label="Limit to Teams" variant="filled" />
+          )}
+          onChange={(event, value) =>
+            setServiceAccount({
+              ...serviceAccount,
+              ServiceAccountToTeams: value.map((t) => t.ID),
+            })
+          }
+          isOptionEqualToValue={(option, value) => option.ID === value.ID}
+          value={(listTeamsData?.teams ?? []).filter((t) =>
+            serviceAccount.ServiceAccountToTeams?.includes(t.ID)
+          )}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
       </Box>
       <Box
         sx={{
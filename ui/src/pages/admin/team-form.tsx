@@ -1,4 +1,8 @@
-import { ArrowBackTwoTone, Save } from '@mui/icons-material'
+import {
+  ArrowBackTwoTone,
+  Save,
+  VisibilityTwoTone,
+} from '@mui/icons-material'
 import {
   Container,
   TextField,
@@ -12,7 +16,7 @@ import {
   Box,
 } from '@mui/material'
 import { useSnackbar } from 'notistack'
-import React, { useEffect, useState } from 'react'
+import React, { useContext, useEffect, useState } from 'react'
 import { useNavigate, useParams } from 'react-router-dom'
 import {
   useGetTeamLazyQuery,
@@ -21,10 +25,14 @@ import {
   TeamInput,
   ListCompetitionsQuery,
   useListCompetitionsQuery,
+  Permission,
+  useImpersonateMutation,
 } from '../../api/generated/graphql'
+import { hasPermission, UserContext } from '../../user-context'
 
 export const TeamForm: React.FC = (): React.ReactElement => {
   const { id } = useParams()
+  const { user: currentUser, refetchUser } = useContext(UserContext)
   const [
     getTeam,
     { data: getTeamData, loading: getTeamLoading, error: getTeamError },
@@ -45,6 +53,7 @@ export const TeamForm: React.FC = (): React.ReactElement => {
       error: createTeamError,
     },
   ] = useCreateTeamMutation()
+  const [impersonate] = useImpersonateMutation()
   const { data: listCompetitionsData, error: listCompetitionsError } =
     useListCompetitionsQuery({
       fetchPolicy: 'no-cache',
@@ -53,6 +62,7 @@ export const TeamForm: React.FC = (): React.ReactElement => {
     ID: '',
     Name: '',
     TeamNumber: 0,
+    AllowedCidrs: [],
     TeamToCompetition: '',
   })
   const [viewCompetition, setViewCompetition] = useState<
@@ -138,6 +148,7 @@ export const TeamForm: React.FC = (): React.ReactElement => {
         ID: '',
         Name: '',
         TeamNumber: 0,
+        AllowedCidrs: [],
         TeamToCompetition: '',
       })
   }, [getTeamData, listCompetitionsData])
@@ -157,6 +168,32 @@ export const TeamForm: React.FC = (): React.ReactElement => {
       })
   }
 
+  // Impersonation reloads Compsole as the team, so start from the dashboard
+  const impersonateTeam = () => {
+    if (!id) return
+    impersonate({
+      variables: {
+        teamId: id,
+      },
+    }).then(
+      (res) => {
+        enqueueSnackbar(
+          `Viewing Compsole as ${res.data?.impersonate.Username ?? 'N/A'}`,
+          {
+            variant: 'success',
+          }
+        )
+        refetchUser()
+        navigate('/')
+      },
+      (err) => {
+        enqueueSnackbar(`Failed to impersonate team: ${err.message}`, {
+          variant: 'error',
+        })
+      }
+    )
+  }
+
   return (
     <Container component="main" sx={{ p: 2 }}>
       {id && (getTeamLoading || getTeamError) ? (
@@ -193,6 +230,18 @@ export const TeamForm: React.FC = (): React.ReactElement => {
                 'N/A'}
             </Typography>
           )}
+          {id &&
+            getTeamData &&
+            hasPermission(currentUser, Permission.UserImpersonate) && (
+            <Button
+              variant="outlined"
+              startIcon={<VisibilityTwoTone />}
+              sx={{ ml: 'auto' }}
+              onClick={impersonateTeam}
+            >
+              View as Team
+            </Button>
+          )}
         </Box>
       )}
       <Divider
@@ -257,6 +306,35 @@ export const TeamForm: React.FC = (): React.ReactElement => {
             },
           }}
         />
+        <Autocomplete
+          freeSolo
+          multiple
+          options={[]}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Allowed Networks"
+              helperText="Networks in CIDR notation (eg. 10.0.1.0/24). Leave empty to allow any network."
+            />
+          )}
+          onChange={(event, value) => {
+            setTeam({
+              ...team,
+              AllowedCidrs: value as string[],
+            })
+          }}
+          value={team.AllowedCidrs ?? []}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
       </Box>
       <Box
         sx={{
@@ -2,9 +2,12 @@ import {
   Add,
   AdminPanelSettingsTwoTone,
   ArrowBackTwoTone,
+  BugReportTwoTone,
+  GavelTwoTone,
   LockResetTwoTone,
   Save,
   SupervisorAccountTwoTone,
+  VisibilityTwoTone,
 } from '@mui/icons-material'
 import {
   Container,
@@ -21,7 +24,7 @@ import {
   Box,
 } from '@mui/material'
 import { useSnackbar } from 'notistack'
-import React, { useEffect, useState } from 'react'
+import React, { useContext, useEffect, useState } from 'react'
 import { useNavigate, useParams } from 'react-router-dom'
 import {
   GetCompTeamSearchValuesQuery,
@@ -32,11 +35,20 @@ import {
   UserInput,
   useUpdateUserMutation,
   useChangePasswordMutation,
+  useListCustomRolesQuery,
+  useListCompetitionsQuery,
   AuthProvider,
+  TeamMembershipRole,
+  Permission,
+  useImpersonateMutation,
 } from '../../api/generated/graphql'
+import { hasPermission, teamLabel, UserContext } from '../../user-context'
 
 export const UserForm: React.FC = (): React.ReactElement => {
   const { id } = useParams()
+  const { user: currentUser, refetchUser } = useContext(UserContext)
+  // Competition admins can't grant custom roles or make other competition admins
+  const isAdmin = currentUser.Role === Role.Admin
   const [
     getUser,
     { data: getUserData, loading: getUserLoading, error: getUserError },
@@ -71,6 +83,17 @@ export const UserForm: React.FC = (): React.ReactElement => {
       error: changePasswordError,
     },
   ] = useChangePasswordMutation()
+  const [impersonate] = useImpersonateMutation()
+  const { data: listCustomRolesData, error: listCustomRolesError } =
+    useListCustomRolesQuery({
+      fetchPolicy: 'no-cache',
+      skip: !isAdmin,
+    })
+  const { data: listCompetitionsData, error: listCompetitionsError } =
+    useListCompetitionsQuery({
+      fetchPolicy: 'no-cache',
+      skip: !isAdmin,
+    })
   const [user, setUser] = useState<UserInput>({
     ID: '',
     Username: '',
@@ -103,7 +126,20 @@ export const UserForm: React.FC = (): React.ReactElement => {
       enqueueSnackbar(
         `Couldn't get competitions and teams: ${getCompTeamSearchValuesError.message}`
       )
-  }, [getCompTeamSearchValuesError, enqueueSnackbar])
+    if (listCustomRolesError)
+      enqueueSnackbar(
+        `Couldn't get custom roles: ${listCustomRolesError.message}`
+      )
+    if (listCompetitionsError)
+      enqueueSnackbar(
+        `Couldn't get competitions: ${listCompetitionsError.message}`
+      )
+  }, [
+    getCompTeamSearchValuesError,
+    listCustomRolesError,
+    listCompetitionsError,
+    enqueueSnackbar,
+  ])
 
   useEffect(() => {
     if (!updateUserLoading && updateUserData)
@@ -168,9 +204,24 @@ export const UserForm: React.FC = (): React.ReactElement => {
   useEffect(() => {
     if (getUserData) {
       setUser({
-        ...getUserData.getUser,
+        ID: getUserData.getUser.ID,
+        Username: getUserData.getUser.Username,
+        FirstName: getUserData.getUser.FirstName,
+        LastName: getUserData.getUser.LastName,
+        Provider: getUserData.getUser.Provider,
+        Role: getUserData.getUser.Role,
         UserToTeam: getUserData.getUser.UserToTeam?.ID,
-      } as UserInput)
+        UserToTeamMemberships: getUserData.getUser.UserToTeamMemberships.map(
+          (m) => ({
+            Team: m.TeamMembershipToTeam.ID,
+            Role: m.Role,
+          })
+        ),
+        UserToCustomRole: getUserData.getUser.UserToCustomRole?.ID,
+        UserToAdminCompetitions:
+          getUserData.getUser.UserToAdminCompetitions.map((c) => c.ID),
+        Password: '',
+      })
       if (getUserData?.getUser.UserToTeam && getCompTeamSearchValuesData)
         setViewTeam(
           getCompTeamSearchValuesData.teams.find(
@@ -189,6 +240,16 @@ export const UserForm: React.FC = (): React.ReactElement => {
       })
   }, [getUserData, getCompTeamSearchValuesData])
 
+  const sortedTeams = [...(getCompTeamSearchValuesData?.teams || [])].sort(
+    (a, b) =>
+      `${a.TeamToCompetition.Name}${String(a.TeamNumber).padStart(
+        2,
+        '0'
+      )}`.localeCompare(
+        `${b.TeamToCompetition.Name}${String(b.TeamNumber).padStart(2, '0')}`
+      )
+  )
+
   const submitUser = () => {
     if (user.ID)
       updateUser({
@@ -223,6 +284,32 @@ export const UserForm: React.FC = (): React.ReactElement => {
     }
   }
 
+  // Impersonation reloads Compsole as the user, so start from the dashboard
+  const impersonateUser = () => {
+    if (!id) return
+    impersonate({
+      variables: {
+        userId: id,
+      },
+    }).then(
+      (res) => {
+        enqueueSnackbar(
+          `Viewing Compsole as ${res.data?.impersonate.Username ?? 'N/A'}`,
+          {
+            variant: 'success',
+          }
+        )
+        refetchUser()
+        navigate('/')
+      },
+      (err) => {
+        enqueueSnackbar(`Failed to impersonate user: ${err.message}`, {
+          variant: 'error',
+        })
+      }
+    )
+  }
+
   return (
     <Container component="main" sx={{ p: 2 }}>
       {id && (getUserLoading || getUserError) ? (
@@ -257,6 +344,19 @@ export const UserForm: React.FC = (): React.ReactElement => {
               {getUserData?.getUser.Username ?? 'N/A'}
             </Typography>
           )}
+          {id &&
+            getUserData &&
+            getUserData.getUser.ID !== currentUser.ID &&
+            hasPermission(currentUser, Permission.UserImpersonate) && (
+            <Button
+              variant="outlined"
+              startIcon={<VisibilityTwoTone />}
+              sx={{ ml: 'auto' }}
+              onClick={impersonateUser}
+            >
+              View as User
+            </Button>
+          )}
         </Box>
       )}
       <Divider
@@ -323,34 +423,154 @@ export const UserForm: React.FC = (): React.ReactElement => {
           >
             <AdminPanelSettingsTwoTone sx={{ mr: 1 }} /> Admin
           </ToggleButton>
+          <ToggleButton value={Role.BlackTeam} color="warning">
+            <GavelTwoTone sx={{ mr: 1 }} /> Black Team
+          </ToggleButton>
+          <ToggleButton value={Role.WhiteTeam} color="info">
+            <VisibilityTwoTone sx={{ mr: 1 }} /> White Team
+          </ToggleButton>
+          <ToggleButton value={Role.RedTeam} color="secondary">
+            <BugReportTwoTone sx={{ mr: 1 }} /> Red Team
+          </ToggleButton>
           <ToggleButton value={Role.User} aria-label="centered" color="primary">
             <SupervisorAccountTwoTone sx={{ mr: 1 }} /> User
           </ToggleButton>
         </ToggleButtonGroup>
+        {isAdmin && (
+          <>
+            <Autocomplete
+              options={listCustomRolesData?.customRoles ?? []}
+              getOptionLabel={(r) => r.Name}
+              renderInput={(params) => (
+                <TextField
+                  {...params}
+                  label="Custom Role"
+                  helperText="Grants permissions on top of the built-in role"
+                />
+              )}
+              onChange={(event, value) =>
+                setUser({
+                  ...user,
+                  UserToCustomRole: value?.ID,
+                })
+              }
+              isOptionEqualToValue={(option, value) => option.ID === value.ID}
+              value={
+                listCustomRolesData?.customRoles.find(
+                  (r) => r.ID === user.UserToCustomRole
+                ) ?? null
+              }
+              sx={{
+                m: 1,
+                minWidth: '30%',
+                flexGrow: 1,
+                '& .MuiTextField-root': {
+                  m: 0,
+                  minWidth: '30%',
+                  flexGrow: 1,
+                },
+              }}
+            />
+            <Autocomplete
+              multiple
+              options={listCompetitionsData?.competitions ?? []}
+              getOptionLabel={(c) => c.Name}
+              renderInput={(params) => (
+                <TextField
+                  {...params}
+                  label="Competition Admin Of"
+                  helperText="Lets the user manage the teams, VMs, users and lockouts of these competitions"
+                />
+              )}
+              onChange={(event, value) =>
+                setUser({
+                  ...user,
+                  UserToAdminCompetitions: value.map((c) => c.ID),
+                })
+              }
+              isOptionEqualToValue={(option, value) => option.ID === value.ID}
+              value={
+                listCompetitionsData?.competitions.filter((c) =>
+                  user.UserToAdminCompetitions?.includes(c.ID)
+                ) ?? []
+              }
+              sx={{
+                m: 1,
+                minWidth: '30%',
+                flexGrow: 1,
+                '& .MuiTextField-root': {
+                  m: 0,
+                  minWidth: '30%',
+                  flexGrow: 1,
+                },
+              }}
+            />
+          </>
+        )}
         <Autocomplete
-          options={
-            [...(getCompTeamSearchValuesData?.teams || [])].sort((a, b) =>
-              `${a.TeamToCompetition.Name}${String(a.TeamNumber).padStart(
-                2,
-                '0'
-              )}`.localeCompare(
-                `${b.TeamToCompetition.Name}${String(b.TeamNumber).padStart(
-                  2,
-                  '0'
-                )}`
-              )
-            ) ?? []
-          }
+          multiple
+          options={sortedTeams}
           groupBy={(t) => t.TeamToCompetition?.Name ?? 'N/A'}
-          getOptionLabel={(t) =>
-            `${t.TeamToCompetition.Name} - ${t.Name || `Team ${t.TeamNumber}`}`
+          getOptionLabel={teamLabel}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Teams"
+              helperText="The teams the user is a member of"
+            />
+          )}
+          onChange={(event, value) =>
+            setUser({
+              ...user,
+              UserToTeamMemberships: value.map(
+                (t) =>
+                  user.UserToTeamMemberships?.find((m) => m.Team === t.ID) ?? {
+                    Team: t.ID,
+                    Role: TeamMembershipRole.Member,
+                  }
+              ),
+            })
           }
-          renderInput={(params) => <TextField {...params} label="Team" />}
+          isOptionEqualToValue={(option, value) => option.ID === value.ID}
+          value={sortedTeams.filter((t) =>
+            user.UserToTeamMemberships?.some((m) => m.Team === t.ID)
+          )}
+          sx={{
+            m: 1,
+            minWidth: '50%',
+            flexGrow: 1,
+            '& .MuiTextField-root': {
+              m: 0,
+              minWidth: '40%',
+              flexGrow: 1,
+            },
+          }}
+        />
+        <Autocomplete
+          options={sortedTeams}
+          groupBy={(t) => t.TeamToCompetition?.Name ?? 'N/A'}
+          getOptionLabel={teamLabel}
+          renderInput={(params) => (
+            <TextField
+              {...params}
+              label="Active Team"
+              helperText="The team whose VMs the user can access"
+            />
+          )}
           onChange={(event, value) => {
             setViewTeam(value)
             setUser({
               ...user,
               UserToTeam: value?.ID,
+              // The active team has to be one of the user's teams
+              UserToTeamMemberships:
+                value &&
+                !user.UserToTeamMemberships?.some((m) => m.Team === value.ID)
+                  ? [
+                    ...(user.UserToTeamMemberships ?? []),
+                    { Team: value.ID, Role: TeamMembershipRole.Member },
+                  ]
+                  : user.UserToTeamMemberships,
             })
           }}
           isOptionEqualToValue={(option, value) => option.ID === value.ID}
@@ -366,6 +586,55 @@ export const UserForm: React.FC = (): React.ReactElement => {
             },
           }}
         />
+        {user.UserToTeamMemberships?.map((membership) => {
+          const membershipTeam = sortedTeams.find(
+            (t) => t.ID === membership.Team
+          )
+          return (
+            <Box
+              key={membership.Team}
+              sx={{
+                m: 1,
+                width: '100%',
+                display: 'flex',
+                alignItems: 'center',
+                justifyContent: 'space-between',
+              }}
+            >
+              <Typography variant="body2">
+                {membershipTeam ? teamLabel(membershipTeam) : membership.Team}
+              </Typography>
+              <ToggleButtonGroup
+                size="small"
+                value={membership.Role}
+                exclusive
+                onChange={(
+                  e: any, // eslint-disable-line @typescript-eslint/no-explicit-any
+                  newRole: TeamMembershipRole | null
+                ) =>
+                  newRole &&
+                  setUser({
+                    ...user,
+                    UserToTeamMemberships: user.UserToTeamMemberships?.map(
+                      (m) =>
+                        m.Team === membership.Team ? { ...m, Role: newRole } : m
+                    ),
+                  })
+                }
+              >
+                <ToggleButton value={TeamMembershipRole.Member}>
+                  Member
+                </ToggleButton>
+                <ToggleButton value={TeamMembershipRole.Captain}>
+                  Captain
+                </ToggleButton>
+                <ToggleButton value={TeamMembershipRole.Observer}>
+                  Observer
+                </ToggleButton>
+              </ToggleButtonGroup>
+            </Box>
+          )
+        })}
         {(!id || id === 'new') && (
           <TextField
             required
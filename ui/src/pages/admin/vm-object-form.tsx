@@ -17,6 +17,8 @@ import {
   ToggleButtonGroup,
   ToggleButton,
   Box,
+  FormControlLabel,
+  Switch,
 } from '@mui/material'
 import { useSnackbar } from 'notistack'
 import React, { useEffect, useState } from 'react'
@@ -78,6 +80,7 @@ export const VmObjectForm: React.FC = (): React.ReactElement => {
     Identifier: '',
     Name: '',
     VmObjectToTeam: undefined,
+    RedTeamAccess: false,
   })
   const [viewTeam, setViewTeam] = useState<
     GetCompTeamSearchValuesQuery['teams'][0] | null
@@ -178,6 +181,7 @@ export const VmObjectForm: React.FC = (): React.ReactElement => {
         Identifier: '',
         IPAddresses: [],
         VmObjectToTeam: '',
+        RedTeamAccess: false,
       })
   }, [getVmObjectData, getCompTeamData])
 
@@ -336,6 +340,20 @@ export const VmObjectForm: React.FC = (): React.ReactElement => {
           }}
         />
       </Box>
+      <FormControlLabel
+        control={
+          <Switch
+            checked={vmObject.RedTeamAccess ?? false}
+            onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+              setVmObject({ ...vmObject, RedTeamAccess: e.target.checked })
+            }
+          />
+        }
+        label="Allow red team console access"
+        sx={{
+          m: 1,
+        }}
+      />
 
       {id && (
         <ToggleButtonGroup
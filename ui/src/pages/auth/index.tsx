@@ -10,9 +10,15 @@ import {
 import LockOutlinedIcon from '@mui/icons-material/LockOutlined'
 import * as React from 'react'
 import { Outlet, useLocation, useNavigate } from 'react-router-dom'
-import { LocalLogin } from '../../api'
+import {
+  LocalLogin,
+  LoginMethod,
+  LoginMethods,
+  MFALogin,
+  PasskeyLogin,
+} from '../../api'
 import Logo from '../../res/logo512.png'
-import { useEffect } from 'react'
+import { useEffect, useState } from 'react'
 import { useSnackbar } from 'notistack'
 
 export const Auth: React.FC = (): React.ReactElement => {
@@ -38,41 +44,70 @@ export const Signin: React.FC = (): React.ReactElement => {
   const navigate = useNavigate()
   const location = useLocation()
   const { enqueueSnackbar } = useSnackbar()
+  const [loginMethods, setLoginMethods] = useState<LoginMethod[]>([])
+  // The second factors available after the password was accepted
+  const [mfaMethods, setMfaMethods] = useState<string[]>([])
+  const mfaRequired = mfaMethods.length > 0
+
+  const onSignedIn = () => {
+    if (location?.state) {
+      // eslint-disable-next-line @typescript-eslint/no-explicit-any
+      if ((location.state as any).from instanceof Location) {
+        if (
+          location.state &&
+          // eslint-disable-next-line @typescript-eslint/no-explicit-any
+          ((location?.state as any).from as Location).pathname.indexOf(
+            '/auth/'
+          ) >= 0
+        ) {
+          navigate('/')
+          // eslint-disable-next-line @typescript-eslint/no-explicit-any
+        } else navigate((location.state as any).from as Location)
+        // eslint-disable-next-line @typescript-eslint/no-explicit-any
+      } else if (typeof (location.state as any).from === 'string')
+        // eslint-disable-next-line @typescript-eslint/no-explicit-any
+        navigate((location.state as any).from)
+    } else navigate('/')
+  }
+
+  const onError = (err: { error?: string }) => {
+    enqueueSnackbar({
+      message: err.error || 'Unknown error occurred',
+      variant: 'error',
+    })
+  }
 
   const handleSubmit = (event: React.FormEvent<HTMLFormElement>) => {
     event.preventDefault()
     const data = new FormData(event.currentTarget)
+    if (mfaRequired) {
+      MFALogin(data.get('code')?.toString() ?? '').then(onSignedIn, (err) => {
+        // Each password check only allows one code attempt
+        setMfaMethods([])
+        onError(err)
+      })
+      return
+    }
+    // Password based login methods (eg. LDAP) submit the same form to their own login url
+    const loginUrl =
+      (event.nativeEvent as SubmitEvent).submitter?.getAttribute(
+        'data-login-url'
+      ) ?? undefined
     LocalLogin(
       data.get('username')?.toString() ?? '',
-      data.get('password')?.toString() ?? ''
-    ).then(
-      () => {
-        if (location?.state) {
-          // eslint-disable-next-line @typescript-eslint/no-explicit-any
-          if ((location.state as any).from instanceof Location) {
-            if (
-              location.state &&
-              // eslint-disable-next-line @typescript-eslint/no-explicit-any
-              ((location?.state as any).from as Location).pathname.indexOf(
-                '/auth/'
-              ) >= 0
-            ) {
-              navigate('/')
-              // eslint-disable-next-line @typescript-eslint/no-explicit-any
-            } else navigate((location.state as any).from as Location)
-            // eslint-disable-next-line @typescript-eslint/no-explicit-any
-          } else if (typeof (location.state as any).from === 'string')
-            // eslint-disable-next-line @typescript-eslint/no-explicit-any
-            navigate((location.state as any).from)
-        } else navigate('/')
-      },
-      (err: { error?: string }) => {
-        enqueueSnackbar({
-          message: err.error || 'Unknown error occurred',
-          variant: 'error',
-        })
-      }
-    )
+      data.get('password')?.toString() ?? '',
+      loginUrl
+    ).then((res) => {
+      if ('mfa_required' in res) setMfaMethods(res.methods)
+      else onSignedIn()
+    }, onError)
+  }
+
+  const handlePasskey = () => {
+    PasskeyLogin().then(onSignedIn, (err) => {
+      setMfaMethods([])
+      onError(err)
+    })
   }
 
   // Set the title of the tab only on first load
@@ -80,6 +115,10 @@ export const Signin: React.FC = (): React.ReactElement => {
     document.title = 'Sign In - Compsole'
   }, [])
 
+  useEffect(() => {
+    LoginMethods().then(setLoginMethods, () => setLoginMethods([]))
+  }, [])
+
   return (
     <React.Fragment>
       <Avatar sx={{ m: 1, bgcolor: 'secondary.main' }}>
@@ -88,7 +127,51 @@ export const Signin: React.FC = (): React.ReactElement => {
       <Typography component="h1" variant="h5">
         Sign in
       </Typography>
-      <Box component="form" onSubmit={handleSubmit} noValidate sx={{ mt: 1 }}>
+      {mfaRequired && (
+        <Box component="form" onSubmit={handleSubmit} noValidate sx={{ mt: 1 }}>
+          {mfaMethods.includes('totp') && (
+            <React.Fragment>
+              <Typography variant="body2">
+                Enter the code from your authenticator app or a recovery code
+              </Typography>
+              <TextField
+                margin="normal"
+                required
+                fullWidth
+                id="code"
+                label="Code"
+                name="code"
+                autoComplete="one-time-code"
+                autoFocus
+              />
+              <Button
+                type="submit"
+                fullWidth
+                variant="contained"
+                sx={{ mt: 3, mb: 2 }}
+              >
+                Verify
+              </Button>
+            </React.Fragment>
+          )}
+          {mfaMethods.includes('webauthn') && (
+            <Button
+              fullWidth
+              variant="outlined"
+              sx={{ mt: 2, mb: 2 }}
+              onClick={handlePasskey}
+            >
+              Use a Passkey
+            </Button>
+          )}
+        </Box>
+      )}
+      <Box
+        component="form"
+        onSubmit={handleSubmit}
+        noValidate
+        sx={{ mt: 1, display: mfaRequired ? 'none' : undefined }}
+      >
         <TextField
           margin="normal"
           required
@@ -117,6 +200,40 @@ export const Signin: React.FC = (): React.ReactElement => {
         >
           Sign In
         </Button>
+        {loginMethods.map((loginMethod) =>
+          loginMethod.passkey ? (
+            <Button
+              key={loginMethod.login_url}
+              fullWidth
+              variant="outlined"
+              sx={{ mb: 2 }}
+              onClick={handlePasskey}
+            >
+              Sign In with a {loginMethod.name}
+            </Button>
+          ) : loginMethod.password ? (
+            <Button
+              key={loginMethod.login_url}
+              type="submit"
+              fullWidth
+              variant="outlined"
+              sx={{ mb: 2 }}
+              data-login-url={loginMethod.login_url}
+            >
+              Sign In with {loginMethod.name}
+            </Button>
+          ) : (
+            <Button
+              key={loginMethod.login_url}
+              fullWidth
+              variant="outlined"
+              sx={{ mb: 2 }}
+              href={`${import.meta.env.VITE_APP_SERVER_URL}${loginMethod.login_url}`}
+            >
+              Sign In with {loginMethod.name}
+            </Button>
+          )
+        )}
         <Grid container justifyContent="flex-end">
           {/* <Grid item xs>
             <Link href="#" variant="body2">
@@ -39,9 +39,9 @@ import React, { useContext, useEffect, useState } from 'react'
 import { useParams } from 'react-router-dom'
 import {
   ConsoleType,
+  Permission,
   PowerState,
   RebootType,
-  Role,
   useGetVmConsoleLazyQuery,
   useGetVmObjectLazyQuery,
   useLockoutSubscription,
@@ -50,7 +50,7 @@ import {
   usePowerStateSubscription,
   useRebootVmMutation,
 } from '../../api/generated/graphql'
-import { UserContext } from '../../user-context'
+import { hasPermission, UserContext } from '../../user-context'
 import ConsoleTypeSelect from '../../components/console-type'
 import Console from '../../components/console'
 
@@ -256,8 +256,8 @@ export const ConsolePage: React.FC = (): React.ReactElement => {
   }
 
   const isVmLocked = (): boolean => {
-    // Never lock VM's for admins
-    if (user.Role === Role.Admin) return false
+    // Never lock VM's for users with console access to every VM
+    if (hasPermission(user, Permission.VmConsole)) return false
     // VM is still loading
     if (getVmObjectData === undefined) return false
     // Got lockout message from websocket
@@ -382,12 +382,18 @@ export const ConsolePage: React.FC = (): React.ReactElement => {
   }, [rebootVmData, powerOnVmData, powerOffVmData, enqueueSnackbar])
 
   useEffect(() => {
-    if (lockoutData?.lockout.Locked && user.Role !== Role.Admin) {
+    if (
+      lockoutData?.lockout.Locked &&
+      !hasPermission(user, Permission.VmConsole)
+    ) {
       enqueueSnackbar('VM Locked', {
         variant: 'warning',
         autoHideDuration: 2000,
       })
-    } else if (getVmObjectData && user.Role !== Role.Admin) {
+    } else if (
+      getVmObjectData &&
+      !hasPermission(user, Permission.VmConsole)
+    ) {
       enqueueSnackbar('VM Unlocked', {
         variant: 'success',
         autoHideDuration: 2000,
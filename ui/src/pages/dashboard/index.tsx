@@ -20,13 +20,18 @@ import React, { useContext, useEffect } from 'react'
 import {
   AllVmObjectsQuery,
   MyVmObjectsQuery,
+  Permission,
   Role,
   Team,
   useAllVmObjectsLazyQuery,
   useGetCompTeamSearchValuesLazyQuery,
   useMyVmObjectsLazyQuery,
 } from '../../api/generated/graphql'
-import { UserContext } from '../../user-context'
+import {
+  hasPermission,
+  isCompetitionAdmin,
+  UserContext,
+} from '../../user-context'
 
 const VmCard: React.FC<{
   vmObject?:
@@ -107,7 +112,10 @@ export const Dashboard: React.FC = (): React.ReactElement => {
       loading: myVmObjectsLoading,
       error: myVmObjectsError,
     },
-  ] = useMyVmObjectsLazyQuery()
+  ] = useMyVmObjectsLazyQuery({
+    // The VMs change when the user switches teams
+    fetchPolicy: 'network-only',
+  })
   const [
     getAllVmObjects,
     {
@@ -131,11 +139,11 @@ export const Dashboard: React.FC = (): React.ReactElement => {
   }, [])
 
   useEffect(() => {
-    if (user.Role === Role.User) getMyVmObjects()
-    else if (user.Role === Role.Admin) {
+    if (hasPermission(user, Permission.VmRead) || isCompetitionAdmin(user)) {
       getAllVmObjects()
-      getSearchValues()
-    }
+      if (hasPermission(user, Permission.TeamRead) || isCompetitionAdmin(user))
+        getSearchValues()
+    } else if (user.Role !== Role.Undefined) getMyVmObjects()
   }, [user, getMyVmObjects, getAllVmObjects, getSearchValues])
 
   useEffect(() => {
@@ -189,7 +197,9 @@ export const Dashboard: React.FC = (): React.ReactElement => {
       }}
     >
       <Stack spacing={2}>
-        {user && user.Role === Role.Admin && (
+        {user &&
+          (hasPermission(user, Permission.TeamRead) ||
+            isCompetitionAdmin(user)) && (
           <Autocomplete
             options={
               [...(getSearchValuesData?.teams || [])].sort((a, b) =>
@@ -233,7 +243,10 @@ export const Dashboard: React.FC = (): React.ReactElement => {
               <Grid item key={vmObject.ID} xs={4}>
                 <VmCard
                   vmObject={vmObject}
-                  isAdmin={user.Role === Role.Admin}
+                  isAdmin={
+                    hasPermission(user, Permission.VmRead) ||
+                    isCompetitionAdmin(user)
+                  }
                 />
               </Grid>
             ))}
@@ -47,7 +47,12 @@ const createActionData = (
     type: action?.Type ?? ActionType.Undefined,
     message: action?.Message ?? '',
     performedAt: new Date(action?.PerformedAt ?? ''),
-    username: action?.ActionToUser?.Username ?? '',
+    // Actions performed while impersonating show both the admin and the user
+    username: action?.ActionToImpersonator
+      ? `${action.ActionToImpersonator.Username} as ${
+          action.ActionToUser?.Username ?? ''
+        }`
+      : action?.ActionToUser?.Username ?? '',
   }
 }
 
@@ -116,6 +121,8 @@ const ActionTypeMap: { [key in ActionType]: string } = {
   UPDATE_OBJECT: 'Update Object',
   DELETE_OBJECT: 'Delete Object',
   UPDATE_LOCKOUT: 'Update Lockout',
+  IMPERSONATE: 'Impersonate',
+  IP_NOT_ALLOWED: 'IP Not Allowed',
   UNDEFINED: 'Undefined',
 }
 
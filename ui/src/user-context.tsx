@@ -1,5 +1,10 @@
 import * as React from 'react'
-import { AuthProvider, Role, User } from './api/generated/graphql'
+import {
+  AuthProvider,
+  Permission,
+  Role,
+  User,
+} from './api/generated/graphql'
 
 export const UserContext = React.createContext<{
   user: User
@@ -12,6 +17,25 @@ export const UserContext = React.createContext<{
     LastName: '',
     Role: Role.Undefined,
     Provider: AuthProvider.Undefined,
+    Permissions: [],
+    UserToTeamMemberships: [],
+    UserToAdminCompetitions: [],
   } as User,
   refetchUser: () => null,
 })
+
+// Whether the user's role or custom role grants the permission
+export const hasPermission = (user: User, permission: Permission): boolean =>
+  user.Permissions?.includes(permission) ?? false
+
+// Whether the user administers any competitions, which lets them manage those competitions from the admin panel
+export const isCompetitionAdmin = (user: User): boolean =>
+  (user.UserToAdminCompetitions?.length ?? 0) > 0
+
+// Displays the team with its competition (eg. "CCDC - Team 1")
+export const teamLabel = (team: {
+  Name?: string | null
+  TeamNumber: number
+  TeamToCompetition: { Name: string }
+}): string =>
+  `${team.TeamToCompetition.Name} - ${team.Name || `Team ${team.TeamNumber}`}`